@@ -0,0 +1,204 @@
+package dicom
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidQueryLevel = errors.New("Invalid QueryRetrieveLevel")
+	ErrMissingUniqueKey  = errors.New("Missing or invalid unique key")
+)
+
+// Query/Retrieve levels
+const (
+	PatientLevel = "PATIENT"
+	StudyLevel   = "STUDY"
+	SeriesLevel  = "SERIES"
+	ImageLevel   = "IMAGE"
+)
+
+// Query/Retrieve Information Models (PS 3.4 C.6)
+type InformationModel int
+
+const (
+	PatientRoot InformationModel = iota
+	StudyRoot
+)
+
+// The levels of a model, from the top
+func (model InformationModel) levels() []string {
+	if model == StudyRoot {
+		return []string{StudyLevel, SeriesLevel, ImageLevel}
+	}
+	return []string{PatientLevel, StudyLevel, SeriesLevel, ImageLevel}
+}
+
+// The unique key of each level
+var uniqueKeys = map[string]Tag{
+	PatientLevel: TagPatientID,
+	StudyLevel:   TagStudyInstanceUID,
+	SeriesLevel:  TagSeriesInstanceUID,
+	ImageLevel:   TagSOPInstanceUID,
+}
+
+// The number of related entities at each level, returned when the query asks
+// for them
+var relatedCounts = map[Tag]struct{ level, related string }{
+	TagNumberOfPatientRelatedStudies:   {PatientLevel, StudyLevel},
+	TagNumberOfPatientRelatedSeries:    {PatientLevel, SeriesLevel},
+	TagNumberOfPatientRelatedInstances: {PatientLevel, ImageLevel},
+	TagNumberOfStudyRelatedSeries:      {StudyLevel, SeriesLevel},
+	TagNumberOfStudyRelatedInstances:   {StudyLevel, ImageLevel},
+	TagNumberOfSeriesRelatedInstances:  {SeriesLevel, ImageLevel},
+}
+
+// An archive answering hierarchical C-FIND queries over the files of a
+// store, grouped into patients, studies, series and images by their unique
+// keys
+type Archive struct {
+	Model   InformationModel
+	Options MatchOptions
+	Store   *Store
+}
+
+// Create an archive of the files of store for a model
+func NewArchive(model InformationModel, store *Store) *Archive {
+	return &Archive{Model: model, Store: store}
+}
+
+// Answer a query, returning a response identifier per matching entity at the
+// QueryRetrieveLevel of the query, in the order the entities were added.
+// Queries below the top level must hold a single value for the unique key of
+// every level above theirs (PS 3.4 C.4.1.2.1).
+func (a *Archive) Query(query *DicomFile) ([]*DicomFile, error) {
+
+	level, err := query.lookupString(TagQueryRetrieveLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	levels := a.Model.levels()
+	depth := indexOfLevel(levels, level)
+	if depth < 0 {
+		return nil, ErrInvalidQueryLevel
+	}
+
+	for _, above := range levels[:depth] {
+		uid, err := query.lookupString(uniqueKeys[above])
+		if err != nil || uid == "" || strings.ContainsAny(uid, "*?\\") {
+			return nil, ErrMissingUniqueKey
+		}
+	}
+
+	var keys []*DicomElement
+	for i := range query.Elements {
+		key := &query.Elements[i]
+		if _, ok := relatedCounts[key.Tag()]; ok {
+			continue
+		}
+		switch key.Tag() {
+		case TagQueryRetrieveLevel, TagSpecificCharacterSet:
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	var matches []*DicomFile
+	seen := map[string]bool{}
+	for _, file := range a.Store.candidates(keys) {
+		id := file.entityID(levels[:depth+1])
+		if id == "" || seen[id] {
+			continue
+		}
+		if !matchElements(keys, file.topLevel(), a.Options) {
+			continue
+		}
+		seen[id] = true
+		matches = append(matches, file)
+	}
+
+	responses := make([]*DicomFile, len(matches))
+	for i, file := range matches {
+		responses[i] = BuildResponse(file, query)
+		a.setRelatedCounts(responses[i], file)
+	}
+
+	return responses, nil
+}
+
+// Fill in the number of related entities asked for by the query
+func (a *Archive) setRelatedCounts(resp, file *DicomFile) {
+
+	levels := a.Model.levels()
+
+	for i := range resp.Elements {
+		elem := &resp.Elements[i]
+		count, ok := relatedCounts[elem.Tag()]
+		if !ok {
+			continue
+		}
+
+		depth := indexOfLevel(levels, count.level)
+		related := indexOfLevel(levels, count.related)
+		if depth < 0 || related < 0 {
+			continue
+		}
+
+		id := file.entityID(levels[:depth+1])
+		entities := map[string]bool{}
+		for _, other := range a.related(file, levels[depth]) {
+			if other.entityID(levels[:depth+1]) == id {
+				if rid := other.entityID(levels[:related+1]); rid != "" {
+					entities[rid] = true
+				}
+			}
+		}
+
+		elem.Value = Strings{strconv.Itoa(len(entities))}
+	}
+}
+
+// Return the files that may belong to the same entity as file at a level,
+// using the index of the unique key of the level when there is one
+func (a *Archive) related(file *DicomFile, level string) []*DicomFile {
+	tag := uniqueKeys[level]
+	if _, ok := a.Store.indexes[tag]; !ok {
+		return a.Store.Files()
+	}
+	id, _ := file.lookupString(tag)
+	return a.Store.Lookup(tag, id)
+}
+
+// Return the unique keys of the file for levels, joined, or an empty string
+// if one of them is missing
+func (file *DicomFile) entityID(levels []string) string {
+	ids := make([]string, len(levels))
+	for i, level := range levels {
+		id, err := file.lookupString(uniqueKeys[level])
+		if err != nil || id == "" {
+			return ""
+		}
+		ids[i] = id
+	}
+	return strings.Join(ids, "\\")
+}
+
+// Return pointers to the top level elements of the file
+func (file *DicomFile) topLevel() []*DicomElement {
+	elems := make([]*DicomElement, len(file.Elements))
+	for i := range file.Elements {
+		elems[i] = &file.Elements[i]
+	}
+	return elems
+}
+
+func indexOfLevel(levels []string, level string) int {
+	for i, l := range levels {
+		if l == level {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,123 @@
+package dicom
+
+import (
+	"testing"
+)
+
+func TestArchiveQuery(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newFile := func(patient, study, series, instance string) *DicomFile {
+		f := file.Clone()
+		for tag, value := range map[Tag]string{
+			TagPatientID:         patient,
+			TagStudyInstanceUID:  study,
+			TagSeriesInstanceUID: series,
+			TagSOPInstanceUID:    instance,
+		} {
+			f.Elements[f.indexOf(tag)].Value = Strings{value}
+		}
+		return f
+	}
+
+	store := NewStore()
+	store.Add(newFile("P1", "1.1", "1.1.1", "1.1.1.1"))
+	store.Add(newFile("P1", "1.1", "1.1.1", "1.1.1.2"))
+	store.Add(newFile("P1", "1.1", "1.1.2", "1.1.2.1"))
+	store.Add(newFile("P2", "1.2", "1.2.1", "1.2.1.1"))
+
+	archive := NewArchive(StudyRoot, store)
+
+	newQuery := func(level string, keys map[Tag]Value) *DicomFile {
+		query := &DicomFile{}
+		elem, _ := p.NewElement(TagQueryRetrieveLevel, Strings{level})
+		query.insertElement(elem)
+		for tag, value := range keys {
+			elem, err := p.NewElement(tag, value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			query.insertElement(elem)
+		}
+		return query
+	}
+
+	resp, err := archive.Query(newQuery(StudyLevel, map[Tag]Value{
+		TagStudyInstanceUID:              nil,
+		TagPatientName:                   Strings{"TOUT*"},
+		TagNumberOfStudyRelatedSeries:    nil,
+		TagNumberOfStudyRelatedInstances: nil,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("Expected 2 studies, got %d", len(resp))
+	}
+	if series, _ := resp[0].lookupString(TagNumberOfStudyRelatedSeries); series != "2" {
+		t.Errorf("Expected 2 related series, got %s", series)
+	}
+	if instances, _ := resp[0].lookupString(TagNumberOfStudyRelatedInstances); instances != "3" {
+		t.Errorf("Expected 3 related instances, got %s", instances)
+	}
+
+	resp, err = archive.Query(newQuery(SeriesLevel, map[Tag]Value{
+		TagStudyInstanceUID:  Strings{"1.1"},
+		TagSeriesInstanceUID: nil,
+	}))
+	if err != nil || len(resp) != 2 {
+		t.Errorf("Expected 2 series, got %d: %v", len(resp), err)
+	}
+
+	// list of UID matching
+	query := newQuery(ImageLevel, map[Tag]Value{
+		TagStudyInstanceUID:  Strings{"1.1"},
+		TagSeriesInstanceUID: Strings{"1.1.1"},
+		TagSOPInstanceUID:    nil,
+	})
+	query.Elements[query.indexOf(TagSOPInstanceUID)].Value = Strings{"1.1.1.2", "1.2.1.1"}
+
+	resp, err = archive.Query(query)
+	if err != nil || len(resp) != 1 {
+		t.Errorf("Expected 1 image, got %d: %v", len(resp), err)
+	}
+
+	if _, err := archive.Query(newQuery(ImageLevel, map[Tag]Value{TagStudyInstanceUID: Strings{"1.1"}})); err != ErrMissingUniqueKey {
+		t.Errorf("Expected ErrMissingUniqueKey, got %v", err)
+	}
+
+	if _, err := archive.Query(newQuery(PatientLevel, nil)); err != ErrInvalidQueryLevel {
+		t.Errorf("Expected ErrInvalidQueryLevel, got %v", err)
+	}
+
+}
+
+func TestMatchKey(t *testing.T) {
+
+	cases := []struct {
+		vr         string
+		key, value Strings
+		match      bool
+	}{
+		{"DA", Strings{"20050101-20051231"}, Strings{"20050329"}, true},
+		{"DA", Strings{"-20041231"}, Strings{"20050329"}, false},
+		{"UI", Strings{"1.2", "1.3"}, Strings{"1.3"}, true},
+		{"CS", Strings{"C?"}, Strings{"CT"}, true},
+		{"CS", Strings{"MR"}, Strings{"CT"}, false},
+		{"LO", Strings{""}, Strings{"anything"}, true},
+	}
+
+	for _, c := range cases {
+		key := &DicomElement{Vr: c.vr, Value: c.key}
+		elem := &DicomElement{Vr: c.vr, Value: c.value}
+		if MatchKey(key, elem, MatchOptions{}) != c.match {
+			t.Errorf("Incorrect match of %v against %v", c.key, c.value)
+		}
+	}
+
+}
@@ -0,0 +1,158 @@
+package dicom
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"testing"
+)
+
+// Read an example file, or a file built from one by fn
+func benchmarkFile(b *testing.B, name string, fn func(*DicomFile) error) []byte {
+
+	buff, err := ioutil.ReadFile("examples/" + name)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if fn == nil {
+		return buff
+	}
+
+	parser, _ := NewParser()
+	data, err := parser.Parse(buff)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if err := fn(data); err != nil {
+		b.Fatal(err)
+	}
+
+	out := new(bytes.Buffer)
+	if err := data.Write(out); err != nil {
+		b.Fatal(err)
+	}
+	return out.Bytes()
+}
+
+// Re-encode a file with another transfer syntax
+func withTransferSyntax(ts string) func(*DicomFile) error {
+	return func(data *DicomFile) error {
+		return data.SetByPath("TransferSyntaxUID", ts)
+	}
+}
+
+// Add a content tree like that of a large structured report: a thousand
+// containers of ten text items
+func withContentTree(data *DicomFile) error {
+
+	parser := standardParser()
+	var containers Sequence
+	for i := 0; i < 1000; i++ {
+		var texts Sequence
+		for j := 0; j < 10; j++ {
+			item := &Item{}
+			for _, e := range []struct {
+				tag   Tag
+				value Value
+			}{
+				{TagRelationshipType, Strings{"CONTAINS"}},
+				{TagValueType, Strings{"TEXT"}},
+				{TagTextValue, Strings{fmt.Sprintf("Finding %d of section %d", j, i)}},
+			} {
+				elem, err := parser.NewElement(e.tag, e.value)
+				if err != nil {
+					return err
+				}
+				item.Elements = append(item.Elements, elem)
+			}
+			texts = append(texts, item)
+		}
+
+		elem, err := parser.NewElement(TagContentSequence, texts)
+		if err != nil {
+			return err
+		}
+		containers = append(containers, &Item{Elements: []*DicomElement{elem}})
+	}
+
+	elem, err := parser.NewElement(TagContentSequence, containers)
+	if err != nil {
+		return err
+	}
+	data.insertElement(elem)
+	return nil
+}
+
+func benchmarkParse(b *testing.B, buff []byte, options ...func(*Parser) error) {
+
+	parser, _ := NewParser(options...)
+	b.SetBytes(int64(len(buff)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := parser.Parse(buff); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkWrite(b *testing.B, buff []byte) {
+
+	parser, _ := NewParser()
+	data, err := parser.Parse(buff)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(buff)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if err := data.Write(ioutil.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Native OW pixel data, explicit VR little endian
+func BenchmarkParseExplicit(b *testing.B) {
+	benchmarkParse(b, benchmarkFile(b, "I_000038.dcm", nil))
+}
+
+func BenchmarkParseImplicit(b *testing.B) {
+	benchmarkParse(b, benchmarkFile(b, "I_000038.dcm", withTransferSyntax(ImplicitVRLittleEndian)))
+}
+
+func BenchmarkParseBigEndian(b *testing.B) {
+	benchmarkParse(b, benchmarkFile(b, "I_000038.dcm", withTransferSyntax(ExplicitVRBigEndian)))
+}
+
+// JPEG fragments of a multi-frame ultrasound
+func BenchmarkParseMultiFrame(b *testing.B) {
+	benchmarkParse(b, benchmarkFile(b, "I_000036.dcm", nil))
+}
+
+func BenchmarkParseSequences(b *testing.B) {
+	benchmarkParse(b, benchmarkFile(b, "IM-0001-0001.dcm", withContentTree))
+}
+
+func BenchmarkParseSequencesLazy(b *testing.B) {
+	benchmarkParse(b, benchmarkFile(b, "IM-0001-0001.dcm", withContentTree), LazySequences())
+}
+
+func BenchmarkWriteExplicit(b *testing.B) {
+	benchmarkWrite(b, benchmarkFile(b, "I_000038.dcm", nil))
+}
+
+func BenchmarkWriteImplicit(b *testing.B) {
+	benchmarkWrite(b, benchmarkFile(b, "I_000038.dcm", withTransferSyntax(ImplicitVRLittleEndian)))
+}
+
+func BenchmarkWriteMultiFrame(b *testing.B) {
+	benchmarkWrite(b, benchmarkFile(b, "I_000036.dcm", nil))
+}
+
+func BenchmarkWriteSequences(b *testing.B) {
+	benchmarkWrite(b, benchmarkFile(b, "IM-0001-0001.dcm", withContentTree))
+}
@@ -0,0 +1,167 @@
+// Command dcmqr queries a Query/Retrieve SCP with C-FIND, or asks it to send
+// the matching instances to another AE with C-MOVE
+//
+//	dcmqr [flags] -k PatientName=DOE* -k StudyInstanceUID
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"github.com/gillesdemey/go-dicom/dicomnet"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// A flag that may be given several times
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+var (
+	host      = flag.String("host", "localhost", "host of the SCP")
+	port      = flag.Int("port", 104, "port of the SCP")
+	calledAE  = flag.String("called-ae", "ANY-SCP", "AE title of the SCP")
+	callingAE = flag.String("calling-ae", "DCMQR", "AE title of this SCU")
+	level     = flag.String("L", dicom.StudyLevel, "QueryRetrieveLevel: PATIENT, STUDY, SERIES or IMAGE")
+	model     = flag.String("model", "study", "information model: patient or study root")
+	move      = flag.String("move", "", "send the matching instances to the AE with this title with C-MOVE instead of printing them")
+	jsonOut   = flag.Bool("json", false, "print the results as JSON, one object per line, instead of a table")
+	keys      stringList
+)
+
+func main() {
+
+	flag.Var(&keys, "k", "query key, ie. PatientName=DOE* or StudyInstanceUID to return it; may be repeated")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: dcmqr [flags]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, "dcmqr:", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+
+	findClass, moveClass := dicomnet.StudyRootFind, dicomnet.StudyRootMove
+	switch *model {
+	case "study":
+	case "patient":
+		findClass, moveClass = dicomnet.PatientRootFind, dicomnet.PatientRootMove
+	default:
+		return fmt.Errorf("unknown information model %s", *model)
+	}
+
+	query, err := dicomnet.NewIdentifier(*level, keys...)
+	if err != nil {
+		return err
+	}
+	var paths []string
+	for _, key := range keys {
+		paths = append(paths, strings.SplitN(key, "=", 2)[0])
+	}
+
+	sopClass := findClass
+	if *move != "" {
+		sopClass = moveClass
+	}
+	contexts := []dicomnet.PresentationContext{
+		{AbstractSyntax: sopClass, TransferSyntaxes: []string{dicom.ExplicitVRLittleEndian, dicom.ImplicitVRLittleEndian}},
+	}
+	config := dicomnet.Config{CallingAE: *callingAE, CalledAE: *calledAE}
+
+	a, err := dicomnet.Dial(net.JoinHostPort(*host, strconv.Itoa(*port)), config, contexts)
+	if err != nil {
+		return err
+	}
+	defer a.Release()
+
+	if *move != "" {
+		ops, status, err := a.Move(sopClass, *move, query)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%v: %d completed, %d failed, %d with warnings\n", status, ops.Completed, ops.Failed, ops.Warning)
+		if status.Failure() {
+			return fmt.Errorf("C-MOVE failed")
+		}
+		return nil
+	}
+
+	if *jsonOut {
+		// printed as they arrive
+		var status dicomnet.Status
+		var writeErr error
+		for rsp := range a.FindStream(sopClass, query) {
+			if rsp.Identifier == nil {
+				status, err = rsp.Status, rsp.Err
+			} else if writeErr == nil {
+				writeErr = rsp.Identifier.WriteJSON(os.Stdout, dicom.JSONOptions{Keywords: true})
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if writeErr != nil {
+			return writeErr
+		}
+		if status.Failure() {
+			return fmt.Errorf("C-FIND failed: %v", status)
+		}
+		return nil
+	}
+
+	results, status, err := a.Find(sopClass, query)
+	if err != nil {
+		return err
+	}
+	printTable(results, paths)
+
+	if status.Failure() {
+		return fmt.Errorf("C-FIND failed: %v", status)
+	}
+	return nil
+}
+
+// Print a row per result with a column per key
+func printTable(results []*dicom.DicomFile, paths []string) {
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(paths, "\t"))
+	for _, result := range results {
+		var values []string
+		for _, path := range paths {
+			var vs []string
+			elems, _ := result.GetByPath(path)
+			for _, elem := range elems {
+				if s, err := elem.GetStrings(); err == nil {
+					vs = append(vs, strings.TrimRight(strings.Join(s, "\\"), " \x00"))
+				} else {
+					vs = append(vs, fmt.Sprint(elem.Value))
+				}
+			}
+			values = append(values, strings.Join(vs, ","))
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t"))
+	}
+	w.Flush()
+}
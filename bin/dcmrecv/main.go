@@ -0,0 +1,118 @@
+// Command dcmrecv is a storage SCP writing the files it receives to a
+// Patient/Study/Series directory hierarchy
+//
+//	dcmrecv [flags]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"github.com/gillesdemey/go-dicom/dicomnet"
+	"log"
+	"net"
+	"os"
+	fp "path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	port   = flag.Int("port", 104, "port to listen on")
+	aet    = flag.String("ae", "", "AE title of this SCP, any called AE title is accepted if empty")
+	out    = flag.String("out", ".", "directory the files are written to")
+	maxPDU = flag.Uint("max-pdu", dicomnet.DefaultMaxPDULength, "maximum length of the PDUs received")
+	coerce = flag.String("coerce", "", "JSON file of coercion rules applied to the files received, with the calling AE title as source")
+)
+
+func main() {
+
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: dcmrecv [flags]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	logger := log.New(os.Stderr, "dcmrecv: ", log.LstdFlags)
+
+	var coercion *dicom.Coercion
+	if *coerce != "" {
+		f, err := os.Open(*coerce)
+		if err != nil {
+			logger.Fatal(err)
+		}
+		coercion, err = dicom.ReadCoercion(f)
+		f.Close()
+		if err != nil {
+			logger.Fatalf("%s: %v", *coerce, err)
+		}
+	}
+
+	server := &dicomnet.Server{
+		AETitle:      *aet,
+		MaxPDULength: uint32(*maxPDU),
+		ErrorLog:     logger,
+		Coercion:     coercion,
+		Store: func(a *dicomnet.Association, file *dicom.DicomFile) dicomnet.Status {
+			path, err := store(file)
+			if err != nil {
+				logger.Printf("%s: %v", a.CallingAE(), err)
+				return dicomnet.StatusOutOfResources
+			}
+			logger.Printf("%s: %s", a.CallingAE(), path)
+			return dicomnet.StatusSuccess
+		},
+	}
+
+	logger.Fatal(server.ListenAndServe(net.JoinHostPort("", strconv.Itoa(*port))))
+}
+
+// Write a file to <patient>/<study>/<series>/<instance>.dcm
+func store(file *dicom.DicomFile) (string, error) {
+
+	dir := *out
+	for _, tag := range []dicom.Tag{dicom.TagPatientID, dicom.TagStudyInstanceUID, dicom.TagSeriesInstanceUID} {
+		dir = fp.Join(dir, pathComponent(file, tag))
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	path := fp.Join(dir, pathComponent(file, dicom.TagSOPInstanceUID)+".dcm")
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+
+	if err := file.Write(f); err != nil {
+		f.Close()
+		return "", err
+	}
+
+	return path, f.Close()
+}
+
+// The value of an element, made safe for a file name
+func pathComponent(file *dicom.DicomFile, tag dicom.Tag) string {
+
+	s := ""
+	if elem, err := file.LookupElementByTag(tag); err == nil {
+		s, _ = elem.GetString()
+	}
+	s = strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '.' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, strings.Trim(s, " \x00"))
+
+	if s == "" || s == "." || s == ".." {
+		return "UNKNOWN"
+	}
+	return s
+}
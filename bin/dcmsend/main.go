@@ -0,0 +1,168 @@
+// Command dcmsend sends DICOM files to a storage SCP with C-STORE
+//
+//	dcmsend [flags] <files or folders>
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"github.com/gillesdemey/go-dicom/dicomnet"
+	"io/ioutil"
+	"net"
+	"os"
+	fp "path/filepath"
+	"strconv"
+	"sync"
+)
+
+var (
+	host      = flag.String("host", "localhost", "host of the storage SCP")
+	port      = flag.Int("port", 104, "port of the storage SCP")
+	calledAE  = flag.String("called-ae", "ANY-SCP", "AE title of the storage SCP")
+	callingAE = flag.String("calling-ae", "DCMSEND", "AE title of this SCU")
+	jobs      = flag.Int("j", 1, "number of parallel associations")
+	retries   = flag.Int("retries", 2, "number of times an instance is sent again after a network error")
+	maxPDU    = flag.Uint("max-pdu", dicomnet.DefaultMaxPDULength, "maximum length of the PDUs received")
+)
+
+// The outcome of sending a file
+type result struct {
+	path   string
+	status dicomnet.Status
+	err    error
+}
+
+func main() {
+
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: dcmsend [flags] <files or folders>")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var paths []string
+	for _, arg := range flag.Args() {
+		err := fp.Walk(arg, func(path string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() {
+				paths = append(paths, path)
+			}
+			return err
+		})
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "dcmsend:", err)
+			os.Exit(1)
+		}
+	}
+
+	if *jobs < 1 {
+		*jobs = 1
+	}
+
+	config := dicomnet.Config{
+		CallingAE:    *callingAE,
+		CalledAE:     *calledAE,
+		MaxPDULength: uint32(*maxPDU),
+	}
+	addr := net.JoinHostPort(*host, strconv.Itoa(*port))
+
+	// each job sends every jobs-th file over its own association
+	results := make([]result, len(paths))
+	var wg sync.WaitGroup
+	for j := 0; j < *jobs && j < len(paths); j++ {
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			var indexes []int
+			for i := j; i < len(paths); i += *jobs {
+				indexes = append(indexes, i)
+			}
+			send(addr, config, paths, indexes, results)
+		}(j)
+	}
+	wg.Wait()
+
+	var succeeded, warnings, failed int
+	for _, r := range results {
+		switch {
+		case r.err != nil:
+			failed++
+			fmt.Printf("%s: %v\n", r.path, r.err)
+		case r.status.Failure():
+			failed++
+			fmt.Printf("%s: %v\n", r.path, r.status)
+		case r.status.Warning():
+			warnings++
+			fmt.Printf("%s: %v\n", r.path, r.status)
+		default:
+			succeeded++
+			fmt.Printf("%s: %v\n", r.path, r.status)
+		}
+	}
+	fmt.Printf("%d sent, %d with warnings, %d failed\n", succeeded+warnings, warnings, failed)
+
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// Send the files at the given indexes over one association, opened again
+// after network errors
+func send(addr string, config dicomnet.Config, paths []string, indexes []int, results []result) {
+
+	parser, _ := dicom.NewParser()
+	files := map[int]*dicom.DicomFile{}
+	var parsed []*dicom.DicomFile
+	for _, i := range indexes {
+		results[i].path = paths[i]
+		buff, err := ioutil.ReadFile(paths[i])
+		if err == nil {
+			files[i], err = parser.Parse(buff)
+		}
+		if err != nil {
+			results[i].err = err
+			continue
+		}
+		parsed = append(parsed, files[i])
+	}
+	if len(parsed) == 0 {
+		return
+	}
+	contexts := dicomnet.StorageContexts(parsed...)
+
+	var a *dicomnet.Association
+	for _, i := range indexes {
+		file, ok := files[i]
+		if !ok {
+			continue
+		}
+
+		for attempt := 0; attempt <= *retries; attempt++ {
+			var err error
+			if a == nil {
+				a, err = dicomnet.Dial(addr, config, contexts)
+			}
+			if err == nil {
+				results[i].status, err = a.Store(file)
+			}
+			results[i].err = err
+			if err == nil || errors.Is(err, dicomnet.ErrNoPresentationContext) {
+				break
+			}
+			if a != nil {
+				a.Abort()
+				a = nil
+			}
+		}
+	}
+
+	if a != nil {
+		a.Release()
+	}
+}
@@ -1,107 +1,255 @@
 package main
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"flag"
+	"fmt"
 	"github.com/gillesdemey/go-dicom"
+	"io"
 	"io/ioutil"
 	"os"
 	fp "path/filepath"
+	"runtime"
 	"strings"
 	"sync"
 )
 
 var (
-	file   = flag.String("file", "", "the DICOM file you want to parse")
-	silent = flag.Bool("silent", false, "wether or not to print all Data Elements")
-	out    = flag.String("out", "", "where to write the program's output")
-	folder = flag.String("folder", "", "Folder with DICOM images to extract")
+	file    = flag.String("file", "", "the DICOM file you want to parse, - for the standard input")
+	silent  = flag.Bool("silent", false, "wether or not to print all Data Elements")
+	out     = flag.String("out", "", "where to write the program's output")
+	folder  = flag.String("folder", "", "Folder with DICOM images to extract")
+	filter  = flag.String("filter", "", "only process the files matching a filter, ie. 'Modality == \"CT\"'")
+	jobs    = flag.Int("jobs", runtime.NumCPU(), "number of files of the folder processed in parallel")
+	summary = flag.String("summary", "", "print a summary of the files processed at the end: csv or json")
 )
 
+var match *dicom.Filter
+
+// The elements of a file are logged as a block
+var logMutex sync.Mutex
+
 func init() {
 	flag.Parse()
+
+	if *filter != "" {
+		var err error
+		if match, err = dicom.CompileFilter(*filter); err != nil {
+			fmt.Fprintln(os.Stderr, "dicom:", err)
+			os.Exit(2)
+		}
+	}
+
+	if *summary != "" && *summary != "csv" && *summary != "json" {
+		fmt.Fprintln(os.Stderr, "dicom: -summary must be csv or json")
+		os.Exit(2)
+	}
+}
+
+// The outcome of processing a file
+type result struct {
+	Path           string
+	SOPInstanceUID string
+	Modality       string
+	Status         string // "OK", or the error
 }
 
 func main() {
 
+	var results []*result
+
 	// file input
 	if *file != "" {
-		processFile(*file, silent, out)
+		if r := processFile(*file); r != nil {
+			results = append(results, r)
+		}
 	}
 
 	// folder input, find .dcm files
 	if *folder != "" {
-		err := fp.Walk(*folder, fileWalker)
+		rs, err := processFolder(*folder)
 		if err != nil {
-			panic(err)
+			fmt.Fprintln(os.Stderr, "dicom:", err)
 		}
+		results = append(results, rs...)
 	}
-}
 
-func fileWalker(path string, info os.FileInfo, err error) error {
-	if err != nil {
-		panic(err)
+	failed := false
+	for _, r := range results {
+		if r.Status != "OK" {
+			failed = true
+			fmt.Fprintf(os.Stderr, "dicom: %s: %s\n", r.Path, r.Status)
+		}
 	}
 
-	// don't parse nested directories
-	if info.IsDir() {
-		return nil
+	if err := writeSummary(results); err != nil {
+		fmt.Fprintln(os.Stderr, "dicom:", err)
+		failed = true
 	}
 
-	// not a DICOM file
-	if fp.Ext(info.Name()) != ".dcm" {
-		return nil
+	if failed {
+		os.Exit(1)
 	}
+}
+
+// Process the .dcm files of a folder with a pool of workers, in the order
+// they are found
+func processFolder(folder string) ([]*result, error) {
 
-	processFile(path, silent, out)
+	var paths []string
+	err := fp.Walk(folder, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// keep walking past unreadable entries
+			fmt.Fprintln(os.Stderr, "dicom:", err)
+			return nil
+		}
+		if !info.IsDir() && fp.Ext(info.Name()) == ".dcm" {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+
+	results := make([]*result, len(paths))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for j := 0; j < *jobs || j == 0; j++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = processFile(paths[i])
+			}
+		}()
+	}
+	for i := range paths {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	// files left out by the filter
+	processed := results[:0]
+	for _, r := range results {
+		if r != nil {
+			processed = append(processed, r)
+		}
+	}
 
-	return err
+	return processed, err
 }
 
-func processFile(path string, silent *bool, out *string) {
+// Process a file, returning nil if it does not match the filter
+func processFile(path string) *result {
+
+	r := &result{Path: path, Status: "OK"}
 
-	buff, err := ioutil.ReadFile(path)
+	var buff []byte
+	var err error
+	if path == "-" {
+		buff, err = io.ReadAll(os.Stdin)
+	} else {
+		buff, err = ioutil.ReadFile(path)
+	}
 	if err != nil {
-		panic(err)
+		r.Status = err.Error()
+		return r
 	}
 
-	done := new(sync.WaitGroup)
-	done.Add(1)
+	parser, _ := dicom.NewParser()
+	data, err := parser.Parse(buff)
+	if data != nil {
+		r.SOPInstanceUID = lookup(data, dicom.TagSOPInstanceUID)
+		r.Modality = lookup(data, dicom.TagModality)
+	}
+	if err != nil {
+		r.Status = err.Error()
+		return r
+	}
 
-	go func() {
-		gw := new(sync.WaitGroup)
+	if match != nil && !match.Match(data) {
+		return nil
+	}
 
-		dcm := &dicom.DicomFile{}
+	// the file parses, so the pipeline does not panic
+	var elemsFile *os.File
+	if *out != "" {
+		basename := fp.Base(path)
+		filename := strings.TrimSuffix(basename, fp.Ext(basename))
+		if path == "-" {
+			filename = "stdin"
+		}
+		outDir := fp.Join(*out, filename)
 
-		// parser
-		ppln := dcm.Parse(buff)
+		// ensure out directory exists
+		if err := os.MkdirAll(outDir, 0755); err != nil {
+			r.Status = err.Error()
+			return r
+		}
 
-		if *silent == false {
-			ppln = dcm.Log(ppln, gw)
+		elemsFile, err = os.Create(fp.Join(outDir, filename+".txt"))
+		if err != nil {
+			r.Status = err.Error()
+			return r
 		}
+	}
 
-		if *out != "" {
-			basename := fp.Base(path)
-			filename := strings.TrimSuffix(basename, fp.Ext(basename))
-			outDir := fp.Join(*out, filename)
+	if *silent == false {
+		logMutex.Lock()
+		defer logMutex.Unlock()
+	}
 
-			// ensure out directory exists
-			err := os.MkdirAll(outDir, 0755)
-			if err != nil {
-				panic(err)
-			}
+	gw := new(sync.WaitGroup)
 
-			elemsFile, err := os.Create(fp.Join(outDir, filename+".txt"))
-			if err != nil {
-				panic(err)
-			}
+	dcm := &dicom.DicomFile{}
+
+	// parser
+	ppln := dcm.Parse(buff)
 
-			ppln = dcm.WriteToFile(ppln, gw, elemsFile)
-			ppln = dcm.WriteImagesToFolder(ppln, gw, outDir)
+	if *silent == false {
+		ppln = dcm.Log(ppln, gw)
+	}
+
+	if elemsFile != nil {
+		ppln = dcm.WriteToFile(ppln, gw, elemsFile)
+	}
+
+	dcm.Discard(ppln, gw)
+	gw.Wait()
+
+	return r
+}
+
+func lookup(data *dicom.DicomFile, tag dicom.Tag) string {
+	elem, err := data.LookupElementByTag(tag)
+	if err != nil {
+		return ""
+	}
+	s, _ := elem.GetString()
+	return strings.TrimRight(s, " \x00")
+}
+
+// Write the summary of the files processed to stdout, in the format of the
+// -summary flag
+func writeSummary(results []*result) error {
+
+	switch *summary {
+	case "csv":
+		w := csv.NewWriter(os.Stdout)
+		w.Write([]string{"Path", "SOPInstanceUID", "Modality", "Status"})
+		for _, r := range results {
+			w.Write([]string{r.Path, r.SOPInstanceUID, r.Modality, r.Status})
+		}
+		w.Flush()
+		return w.Error()
+	case "json":
+		if results == nil {
+			results = []*result{}
 		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(results)
+	}
 
-		dcm.Discard(ppln, gw)
-		gw.Wait()
-		done.Done()
-	}()
-	done.Wait()
+	return nil
 }
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"github.com/gillesdemey/go-dicom"
+	"io/ioutil"
+	"os"
+	fp "path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The studies of the folder served, with only the attributes browsed
+type index struct {
+	Studies   []*study
+	studies   map[string]*study
+	series    map[string]*series
+	instances map[string]*instance
+}
+
+type study struct {
+	UID, PatientName, PatientID, Date, Description, AccessionNumber string
+	Series                                                          []*series
+}
+
+type series struct {
+	UID, Number, Modality, Description string
+	Instances                          []*instance
+}
+
+type instance struct {
+	UID    string
+	Number int
+	Frames int
+	path   string
+}
+
+// Index the files of a folder, logging those that do not parse
+func scan(folder string) (*index, error) {
+
+	idx := &index{
+		studies:   map[string]*study{},
+		series:    map[string]*series{},
+		instances: map[string]*instance{},
+	}
+
+	err := fp.Walk(folder, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			logger.Print(err)
+			return nil
+		}
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".") {
+			return nil
+		}
+		if err := idx.add(path); err != nil {
+			logger.Printf("%s: %v", path, err)
+		}
+		return nil
+	})
+
+	sort.Slice(idx.Studies, func(i, j int) bool { return idx.Studies[i].Date > idx.Studies[j].Date })
+	for _, st := range idx.Studies {
+		sort.SliceStable(st.Series, func(i, j int) bool { return atoi(st.Series[i].Number) < atoi(st.Series[j].Number) })
+		for _, se := range st.Series {
+			sort.SliceStable(se.Instances, func(i, j int) bool { return se.Instances[i].Number < se.Instances[j].Number })
+		}
+	}
+
+	return idx, err
+}
+
+func (idx *index) add(path string) error {
+
+	buff, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	parser, _ := dicom.NewParser()
+	file, err := parser.Parse(buff)
+	if err != nil {
+		return err
+	}
+
+	sopUID := lookup(file, dicom.TagSOPInstanceUID)
+	if sopUID == "" {
+		return dicom.ErrNoSOPInstanceUID
+	}
+
+	studyUID := lookup(file, dicom.TagStudyInstanceUID)
+	st, ok := idx.studies[studyUID]
+	if !ok {
+		st = &study{
+			UID:             studyUID,
+			PatientName:     lookup(file, dicom.TagPatientName),
+			PatientID:       lookup(file, dicom.TagPatientID),
+			Date:            lookup(file, dicom.TagStudyDate),
+			Description:     lookup(file, dicom.TagStudyDescription),
+			AccessionNumber: lookup(file, dicom.TagAccessionNumber),
+		}
+		idx.studies[studyUID] = st
+		idx.Studies = append(idx.Studies, st)
+	}
+
+	seriesUID := lookup(file, dicom.TagSeriesInstanceUID)
+	se, ok := idx.series[seriesUID]
+	if !ok {
+		se = &series{
+			UID:         seriesUID,
+			Number:      lookup(file, dicom.TagSeriesNumber),
+			Modality:    lookup(file, dicom.TagModality),
+			Description: lookup(file, dicom.TagSeriesDescription),
+		}
+		idx.series[seriesUID] = se
+		st.Series = append(st.Series, se)
+	}
+
+	if _, ok := idx.instances[sopUID]; ok {
+		return nil
+	}
+	inst := &instance{
+		UID:    sopUID,
+		Number: atoi(lookup(file, dicom.TagInstanceNumber)),
+		path:   path,
+	}
+	if _, err := file.LookupElementByTag(dicom.TagPixelData); err == nil {
+		inst.Frames = 1
+		if n := atoi(lookup(file, dicom.TagNumberOfFrames)); n > 1 {
+			inst.Frames = n
+		}
+	}
+	idx.instances[sopUID] = inst
+	se.Instances = append(se.Instances, inst)
+
+	return nil
+}
+
+// The first instance of a series with pixel data, or nil
+func (se *series) Thumbnail() *instance {
+	for _, inst := range se.Instances {
+		if inst.Frames > 0 {
+			return inst
+		}
+	}
+	return nil
+}
+
+func lookup(file *dicom.DicomFile, tag dicom.Tag) string {
+	elem, err := file.LookupElementByTag(tag)
+	if err != nil {
+		return ""
+	}
+	s, _ := elem.GetString()
+	return strings.TrimRight(s, " \x00")
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(strings.TrimSpace(s))
+	return n
+}
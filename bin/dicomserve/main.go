@@ -0,0 +1,217 @@
+// Command dicomserve serves the DICOM files of a folder over HTTP, with a
+// browser of their studies and series and the frames rendered as PNG images
+//
+//	dicomserve [flags] <folder>
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"html/template"
+	"image/png"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var (
+	addr   = flag.String("addr", "localhost:8080", "address to listen on")
+	window = flag.String("window", "file", "default window of monochrome images: the first window of the file, auto to use the range of values of each frame, or center,width")
+)
+
+var logger = log.New(os.Stderr, "dicomserve: ", log.LstdFlags)
+
+type server struct {
+	index *index
+}
+
+func main() {
+
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: dicomserve [flags] <folder>")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if _, err := renderOptions(*window); err != nil {
+		logger.Fatal(err)
+	}
+
+	idx, err := scan(flag.Arg(0))
+	if err != nil {
+		logger.Fatal(err)
+	}
+	logger.Printf("%d studies, %d series, %d instances", len(idx.Studies), len(idx.series), len(idx.instances))
+
+	s := &server{index: idx}
+	http.HandleFunc("/", s.studies)
+	http.HandleFunc("/study/", s.study)
+	http.HandleFunc("/series/", s.series)
+	http.HandleFunc("/frame/", s.frame)
+	http.HandleFunc("/dump/", s.dump)
+
+	logger.Printf("listening on http://%s/", *addr)
+	logger.Fatal(http.ListenAndServe(*addr, nil))
+}
+
+func (s *server) studies(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	execute(w, studiesPage, s.index)
+}
+
+// /study/<StudyInstanceUID>
+func (s *server) study(w http.ResponseWriter, r *http.Request) {
+	st, ok := s.index.studies[strings.TrimPrefix(r.URL.Path, "/study/")]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	execute(w, studyPage, st)
+}
+
+// /series/<SeriesInstanceUID>
+func (s *server) series(w http.ResponseWriter, r *http.Request) {
+	se, ok := s.index.series[strings.TrimPrefix(r.URL.Path, "/series/")]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	execute(w, seriesPage, struct {
+		*series
+		Window string
+	}{se, r.URL.Query().Get("window")})
+}
+
+// /frame/<SOPInstanceUID>/<frame number>, from 1, with an optional window
+// parameter overriding the -window flag
+func (s *server) frame(w http.ResponseWriter, r *http.Request) {
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/frame/"), "/")
+	if len(parts) != 2 {
+		http.NotFound(w, r)
+		return
+	}
+	inst, ok := s.index.instances[parts[0]]
+	n, err := strconv.Atoi(parts[1])
+	if !ok || err != nil || n < 1 || n > inst.Frames {
+		http.NotFound(w, r)
+		return
+	}
+
+	win := r.URL.Query().Get("window")
+	if win == "" {
+		win = *window
+	}
+	opts, err := renderOptions(win)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	file, err := readFile(inst.path)
+	if err != nil {
+		serverError(w, err)
+		return
+	}
+	img, err := file.Image(n-1, opts)
+	if err != nil {
+		serverError(w, err)
+		return
+	}
+
+	buff := new(bytes.Buffer)
+	if err := png.Encode(buff, img); err != nil {
+		serverError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "image/png")
+	w.Write(buff.Bytes())
+}
+
+// /dump/<SOPInstanceUID>, the elements of a file as printed by dcmdump
+func (s *server) dump(w http.ResponseWriter, r *http.Request) {
+
+	inst, ok := s.index.instances[strings.TrimPrefix(r.URL.Path, "/dump/")]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	file, err := readFile(inst.path)
+	if err != nil {
+		serverError(w, err)
+		return
+	}
+	buff := new(bytes.Buffer)
+	if err := file.WriteDcmdump(buff); err != nil {
+		serverError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write(buff.Bytes())
+}
+
+func readFile(path string) (*dicom.DicomFile, error) {
+	buff, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	parser, _ := dicom.NewParser()
+	return parser.Parse(buff)
+}
+
+// The render options of a window: file, auto or center,width
+func renderOptions(s string) (dicom.RenderOptions, error) {
+
+	var opts dicom.RenderOptions
+	switch s {
+	case "file":
+		return opts, nil
+	case "auto":
+		opts.AutoWindow = true
+		return opts, nil
+	}
+
+	parts := strings.Split(s, ",")
+	if len(parts) != 2 {
+		return opts, fmt.Errorf("invalid window %s", s)
+	}
+	center, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return opts, fmt.Errorf("invalid window %s", s)
+	}
+	width, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil || width < 1 {
+		return opts, fmt.Errorf("invalid window %s", s)
+	}
+	opts.Window = dicom.Window{Center: center, Width: width}
+
+	return opts, nil
+}
+
+func execute(w http.ResponseWriter, t *template.Template, data interface{}) {
+	buff := new(bytes.Buffer)
+	if err := t.Execute(buff, data); err != nil {
+		serverError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(buff.Bytes())
+}
+
+func serverError(w http.ResponseWriter, err error) {
+	logger.Print(err)
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
@@ -0,0 +1,61 @@
+package main
+
+import "html/template"
+
+const layout = `{{define "header"}}<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>dicomserve</title>
+<style>
+body { font-family: sans-serif; margin: 1em 2em; }
+table { border-collapse: collapse; }
+td, th { padding: 0.3em 0.8em; text-align: left; border-bottom: 1px solid #ddd; }
+.frames img, .thumb { max-width: 256px; max-height: 256px; margin: 2px; background: #000; }
+</style>
+</head>
+<body>
+<p><a href="/">Studies</a></p>
+{{end}}
+{{define "footer"}}</body>
+</html>
+{{end}}`
+
+var studiesPage = template.Must(template.New("studies").Parse(layout + `{{template "header"}}
+<h1>Studies</h1>
+<table>
+<tr><th>Patient</th><th>Patient ID</th><th>Date</th><th>Accession</th><th>Description</th><th>Series</th></tr>
+{{range .Studies}}<tr>
+<td><a href="/study/{{.UID}}">{{or .PatientName "(no name)"}}</a></td>
+<td>{{.PatientID}}</td><td>{{.Date}}</td><td>{{.AccessionNumber}}</td><td>{{.Description}}</td><td>{{len .Series}}</td>
+</tr>
+{{end}}</table>
+{{template "footer"}}`))
+
+var studyPage = template.Must(template.New("study").Parse(layout + `{{template "header"}}
+<h1>{{.PatientName}} {{.Date}} {{.Description}}</h1>
+<table>
+<tr><th></th><th>Number</th><th>Modality</th><th>Description</th><th>Instances</th></tr>
+{{range .Series}}<tr>
+<td>{{$uid := .UID}}{{with .Thumbnail}}<a href="/series/{{$uid}}"><img class="thumb" src="/frame/{{.UID}}/1"></a>{{end}}</td>
+<td><a href="/series/{{.UID}}">{{.Number}}</a></td><td>{{.Modality}}</td><td>{{.Description}}</td><td>{{len .Instances}}</td>
+</tr>
+{{end}}</table>
+{{template "footer"}}`))
+
+var seriesPage = template.Must(template.New("series").Funcs(template.FuncMap{"frames": frameNumbers}).Parse(layout + `{{template "header"}}
+<h1>Series {{.Number}} {{.Modality}} {{.Description}}</h1>
+<form>Window <input name="window" value="{{.Window}}" placeholder="file, auto or center,width"> <input type="submit" value="Apply"></form>
+{{range .Instances}}<h3>Instance {{.Number}} <a href="/dump/{{.UID}}">elements</a></h3>
+<div class="frames">{{$uid := .UID}}{{range frames .Frames}}<img src="/frame/{{$uid}}/{{.}}{{with $.Window}}?window={{.}}{{end}}" loading="lazy">{{end}}</div>
+{{end}}
+{{template "footer"}}`))
+
+// The numbers of the frames of an instance, from 1
+func frameNumbers(count int) []int {
+	numbers := make([]int, count)
+	for i := range numbers {
+		numbers[i] = i + 1
+	}
+	return numbers
+}
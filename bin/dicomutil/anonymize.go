@@ -0,0 +1,100 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"os"
+	"regexp"
+)
+
+func init() {
+	commands["anonymize"] = &command{
+		usage: "anonymize [-profile basic] [-keep-dates] [-date-offset days] [-keep-uids] [-keep-device] [-clean] [-clean-pattern regexp] [-uid-map file | -uid-key key] <in> <out>\tde-identify a file",
+		run:   runAnonymize,
+	}
+}
+
+func runAnonymize(args []string) error {
+
+	fs := flag.NewFlagSet("anonymize", flag.ExitOnError)
+	profile := fs.String("profile", "basic", "de-identification profile, only basic is supported")
+	keepDates := fs.Bool("keep-dates", false, "keep dates and times")
+	dateOffset := fs.Int("date-offset", 0, "keep dates and times, shifting the dates by this number of days")
+	keepUIDs := fs.Bool("keep-uids", false, "keep the UIDs")
+	keepDevice := fs.Bool("keep-device", false, "keep the elements identifying the device")
+	clean := fs.Bool("clean", false, "keep descriptions and comments, removing the names and IDs of the patient from them")
+	var cleanPatterns stringList
+	fs.Var(&cleanPatterns, "clean-pattern", "regular expression removed from descriptions and comments with -clean; may be repeated")
+	uidMap := fs.String("uid-map", "", "read and update the UID replacements in this file, to anonymize the files of a study consistently across runs")
+	uidKey := fs.String("uid-key", "", "derive the replacements of the UIDs from them with this secret key, to anonymize the files of a study consistently without a -uid-map")
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		return fmt.Errorf("anonymize takes an input and an output file")
+	}
+	if *profile != "basic" {
+		return fmt.Errorf("unknown profile %s", *profile)
+	}
+	if *uidMap != "" && *uidKey != "" {
+		return fmt.Errorf("-uid-map and -uid-key are exclusive")
+	}
+
+	opts := dicom.DeidentifyOptions{
+		KeepDates:          *keepDates,
+		DateOffset:         *dateOffset,
+		KeepUIDs:           *keepUIDs,
+		KeepDeviceIdentity: *keepDevice,
+		CleanDescriptors:   *clean,
+	}
+	for _, pattern := range cleanPatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return err
+		}
+		opts.CleanPatterns = append(opts.CleanPatterns, re)
+	}
+
+	uids := dicom.UIDMap{}
+	if *uidMap != "" {
+		f, err := os.Open(*uidMap)
+		if err == nil {
+			uids, err = dicom.LoadUIDMap(f)
+			f.Close()
+		}
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("%s: %w", *uidMap, err)
+		}
+	}
+
+	data, err := readFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	opts.UIDs = uids
+	if *uidKey != "" {
+		opts.UIDs = dicom.HashUIDMapper(*uidKey)
+	}
+	if err := data.Deidentify(opts); err != nil {
+		return err
+	}
+
+	if err := writeFile(fs.Arg(1), data); err != nil {
+		return err
+	}
+
+	if *uidMap != "" {
+		f, err := os.Create(*uidMap)
+		if err != nil {
+			return err
+		}
+		if err := uids.Save(f); err != nil {
+			f.Close()
+			return err
+		}
+		return f.Close()
+	}
+
+	return nil
+}
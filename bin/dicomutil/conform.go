@@ -0,0 +1,331 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"os"
+	"os/exec"
+	fp "path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	commands["conform"] = &command{
+		usage: "conform [-dcmdump path] [-dcm2json path] [-json] <files or folders>\tcompare the dumps of files with those of DCMTK, exit status 1 if they diverge",
+		run:   runConform,
+	}
+}
+
+// A divergence between the output of this package and of a DCMTK tool, for
+// the element or JSON member at path. Missing values are empty.
+type divergence struct {
+	File   string `json:"file"`
+	Tool   string `json:"tool"`
+	Path   string `json:"path"`
+	Field  string `json:"field,omitempty"`
+	Ours   string `json:"ours"`
+	Theirs string `json:"theirs"`
+}
+
+func (d *divergence) String() string {
+	path := d.Path
+	if d.Field != "" {
+		path += " " + d.Field
+	}
+	return fmt.Sprintf("%s: %s: %s: %q != %q", d.File, d.Tool, path, d.Ours, d.Theirs)
+}
+
+func runConform(args []string) error {
+
+	fs := flag.NewFlagSet("conform", flag.ExitOnError)
+	dcmdump := fs.String("dcmdump", "dcmdump", "path of the dcmdump tool, none if empty")
+	dcm2json := fs.String("dcm2json", "dcm2json", "path of the dcm2json tool, none if empty")
+	asJSON := fs.Bool("json", false, "print the divergences as JSON objects, one per line")
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		return fmt.Errorf("conform takes at least a file or folder")
+	}
+
+	diverged := false
+	report := func(diffs []divergence) {
+		for i := range diffs {
+			diverged = true
+			if *asJSON {
+				b, _ := json.Marshal(&diffs[i])
+				fmt.Println(string(b))
+			} else {
+				fmt.Println(diffs[i].String())
+			}
+		}
+	}
+
+	for _, root := range fs.Args() {
+		err := fp.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			if *dcmdump != "" {
+				diffs, err := conformDcmdump(*dcmdump, path)
+				if err != nil {
+					return err
+				}
+				report(diffs)
+			}
+			if *dcm2json != "" {
+				diffs, err := conformJSON(*dcm2json, path)
+				if err != nil {
+					return err
+				}
+				report(diffs)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if diverged {
+		return exitStatus(1)
+	}
+
+	return nil
+}
+
+// Run a tool of DCMTK on a file. Returns nil if the tool fails to read the
+// file, and an error if it cannot be run.
+func runTool(tool, path string) ([]byte, error) {
+	out, err := exec.Command(tool, path).Output()
+	if _, ok := err.(*exec.ExitError); ok {
+		return nil, nil
+	}
+	return out, err
+}
+
+// Compare the output of dcmdump and WriteDcmdump
+func conformDcmdump(tool, path string) ([]divergence, error) {
+
+	theirs, err := runTool(tool, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ours []byte
+	data, err := readFile(path)
+	if err == nil {
+		buff := new(bytes.Buffer)
+		if err = data.WriteDcmdump(buff); err == nil {
+			ours = buff.Bytes()
+		}
+	}
+
+	if diffs := compareFailures(path, "dcmdump", err, theirs); diffs != nil || theirs == nil {
+		return diffs, nil
+	}
+
+	return compareEntries(path, "dcmdump", parseDcmdump(ours), parseDcmdump(theirs)), nil
+}
+
+// Compare the output of dcm2json and WriteJSON
+func conformJSON(tool, path string) ([]divergence, error) {
+
+	out, err := runTool(tool, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ours []byte
+	data, err := readFile(path)
+	if err == nil {
+		buff := new(bytes.Buffer)
+		if err = data.WriteJSON(buff, dicom.JSONOptions{}); err == nil {
+			ours = buff.Bytes()
+		}
+	}
+
+	if diffs := compareFailures(path, "dcm2json", err, out); diffs != nil || out == nil {
+		return diffs, nil
+	}
+
+	var a, b interface{}
+	if err := json.Unmarshal(ours, &a); err != nil {
+		return []divergence{{File: path, Tool: "dcm2json", Field: "json", Ours: err.Error()}}, nil
+	}
+	if err := json.Unmarshal(out, &b); err != nil {
+		return []divergence{{File: path, Tool: "dcm2json", Field: "json", Theirs: err.Error()}}, nil
+	}
+
+	var diffs []divergence
+	compareJSON("", a, b, func(member, ours, theirs string) {
+		diffs = append(diffs, divergence{File: path, Tool: "dcm2json", Path: member, Ours: ours, Theirs: theirs})
+	})
+	return diffs, nil
+}
+
+// Report a file read by only one of the implementations. Returns nil if both
+// read it, or both failed.
+func compareFailures(path, tool string, err error, theirs []byte) []divergence {
+	switch {
+	case err != nil && theirs != nil:
+		return []divergence{{File: path, Tool: tool, Field: "error", Ours: err.Error()}}
+	case err == nil && theirs == nil:
+		return []divergence{{File: path, Tool: tool, Field: "error", Theirs: tool + " failed"}}
+	}
+	return nil
+}
+
+// An element of a dump: its VR, value, length and VM
+type dumpEntry struct {
+	vr, value, length, vm string
+}
+
+// Parse the element lines of a dump, by path: the tags of the element and
+// its parents, with the index of the element among those with the same tag
+// and parent, ie. (0040,0275)[0]/(fffe,e000)[0]/(0040,0007)[0]
+func parseDcmdump(dump []byte) map[string]dumpEntry {
+
+	entries := map[string]dumpEntry{}
+	var parents []string
+	seen := map[string]int{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(dump))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimLeft(line, " ")
+		if !strings.HasPrefix(trimmed, "(") || len(trimmed) < 15 {
+			continue
+		}
+
+		depth := (len(line) - len(trimmed)) / 2
+		if depth > len(parents) {
+			depth = len(parents)
+		}
+		parents = parents[:depth]
+
+		prefix := strings.Join(parents, "/")
+		tag := trimmed[:11]
+		key := prefix + "/" + tag
+		path := strings.TrimPrefix(key+"["+strconv.Itoa(seen[key])+"]", "/")
+		seen[key]++
+		parents = append(parents, path[strings.LastIndex(path, "/")+1:])
+
+		entry := dumpEntry{vr: trimmed[12:14]}
+		rest := trimmed[15:]
+		if i := strings.LastIndex(rest, " #"); i >= 0 {
+			entry.value = strings.TrimRight(rest[:i], " ")
+			comment := strings.Fields(strings.Replace(rest[i+2:], ",", " ", 1))
+			if len(comment) >= 2 {
+				entry.length, entry.vm = comment[0], comment[1]
+			}
+		}
+		entries[path] = entry
+	}
+
+	return entries
+}
+
+// Compare the entries of two dumps, in path order
+func compareEntries(file, tool string, ours, theirs map[string]dumpEntry) []divergence {
+
+	paths := make([]string, 0, len(ours)+len(theirs))
+	for path := range ours {
+		paths = append(paths, path)
+	}
+	for path := range theirs {
+		if _, ok := ours[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var diffs []divergence
+	for _, path := range paths {
+		a, inOurs := ours[path]
+		b, inTheirs := theirs[path]
+		if !inOurs || !inTheirs {
+			diffs = append(diffs, divergence{file, tool, path, "element", entryString(a, inOurs), entryString(b, inTheirs)})
+			continue
+		}
+		for _, field := range []struct{ name, a, b string }{
+			{"vr", a.vr, b.vr},
+			{"value", a.value, b.value},
+			{"length", a.length, b.length},
+			{"vm", a.vm, b.vm},
+		} {
+			if field.a != field.b {
+				diffs = append(diffs, divergence{file, tool, path, field.name, field.a, field.b})
+			}
+		}
+	}
+
+	return diffs
+}
+
+func entryString(e dumpEntry, ok bool) string {
+	if !ok {
+		return ""
+	}
+	return e.vr + " " + e.value
+}
+
+// Compare two decoded JSON documents, calling fn with the path and values of
+// every difference
+func compareJSON(path string, a, b interface{}, fn func(path, ours, theirs string)) {
+
+	switch a := a.(type) {
+	case map[string]interface{}:
+		if b, ok := b.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(a)+len(b))
+			for key := range a {
+				keys = append(keys, key)
+			}
+			for key := range b {
+				if _, ok := a[key]; !ok {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				compareJSON(strings.TrimPrefix(path+"."+key, "."), a[key], b[key], fn)
+			}
+			return
+		}
+	case []interface{}:
+		if b, ok := b.([]interface{}); ok {
+			for i := 0; i < len(a) || i < len(b); i++ {
+				var x, y interface{}
+				if i < len(a) {
+					x = a[i]
+				}
+				if i < len(b) {
+					y = b[i]
+				}
+				compareJSON(fmt.Sprintf("%s[%d]", path, i), x, y, fn)
+			}
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		fn(path, jsonString(a), jsonString(b))
+	}
+}
+
+func jsonString(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func init() {
+	commands["dcmdump"] = &command{
+		usage: "dcmdump <file>\tprint the file as the dcmdump tool of DCMTK does",
+		run:   runDcmdump,
+	}
+}
+
+func runDcmdump(args []string) error {
+
+	fs := flag.NewFlagSet("dcmdump", flag.ExitOnError)
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("dcmdump takes one file")
+	}
+
+	data, err := readFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	return data.WriteDcmdump(os.Stdout)
+}
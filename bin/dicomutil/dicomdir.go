@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"os"
+	fp "path/filepath"
+	"strings"
+)
+
+func init() {
+	commands["dicomdir"] = &command{
+		usage: "dicomdir [-verify] <folder>\twrite the DICOMDIR of a file set, or verify it against the files",
+		run:   runDICOMDIR,
+	}
+}
+
+func runDICOMDIR(args []string) error {
+
+	fs := flag.NewFlagSet("dicomdir", flag.ExitOnError)
+	verify := fs.Bool("verify", false, "verify the existing DICOMDIR instead of writing it, exit status 1 if it does not match the files")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("dicomdir takes one folder")
+	}
+	root := fs.Arg(0)
+	dirPath := fp.Join(root, "DICOMDIR")
+
+	var files []dicom.FileSetFile
+	err := fp.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || path == dirPath {
+			return err
+		}
+		rel, err := fp.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		data, err := readFile(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "dicomutil: skipping", err)
+			return nil
+		}
+		files = append(files, dicom.FileSetFile{ID: strings.Split(fp.ToSlash(rel), "/"), File: data})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if *verify {
+		dir, err := readFile(dirPath)
+		if err != nil {
+			return err
+		}
+		problems, err := dicom.VerifyDICOMDIR(dir, files)
+		if err != nil {
+			return err
+		}
+		for _, p := range problems {
+			fmt.Println(p)
+		}
+		if len(problems) > 0 {
+			return exitStatus(1)
+		}
+		return nil
+	}
+
+	dir, err := dicom.BuildDICOMDIR(files)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(dirPath, dir)
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"strings"
+)
+
+func init() {
+	commands["diff"] = &command{
+		usage: "diff [-ignore tags] [-ignore-private] <a> <b>\tprint the differences between two files, exit status 1 if they differ",
+		run:   runDiff,
+	}
+}
+
+func runDiff(args []string) error {
+
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	ignore := fs.String("ignore", "", "comma separated keywords or (gggg,eeee) tags to leave out, ie. SOPInstanceUID,InstanceCreationTime")
+	ignorePrivate := fs.Bool("ignore-private", false, "leave out private elements")
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		return fmt.Errorf("diff takes two files")
+	}
+
+	opts := dicom.EqualOptions{IgnorePrivate: *ignorePrivate}
+	if *ignore != "" {
+		for _, name := range strings.Split(*ignore, ",") {
+			tag, err := parseTag(name)
+			if err != nil {
+				return err
+			}
+			opts.IgnoreTags = append(opts.IgnoreTags, tag)
+		}
+	}
+
+	a, err := readFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	b, err := readFile(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	diffs := dicom.Diff(a, b, opts)
+	for _, d := range diffs {
+		fmt.Println(d)
+	}
+
+	if len(diffs) > 0 {
+		return exitStatus(1)
+	}
+
+	return nil
+}
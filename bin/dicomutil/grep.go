@@ -0,0 +1,138 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"io/ioutil"
+	"os"
+	fp "path/filepath"
+	"reflect"
+	"regexp"
+)
+
+func init() {
+	commands["grep"] = &command{
+		usage: "grep -tag path [-value regexp] ... <files or folders>\tprint the files whose elements match, exit status 1 if none do",
+		run:   runGrep,
+	}
+}
+
+// A condition on the elements of a file: one of the elements at path has a
+// value matching re, or exists when re is nil
+type grepCondition struct {
+	path string
+	re   *regexp.Regexp
+}
+
+func runGrep(args []string) error {
+
+	var tags, values stringList
+	fs := flag.NewFlagSet("grep", flag.ExitOnError)
+	fs.Var(&tags, "tag", "path of the elements matched, ie. PatientID or RequestAttributesSequence.AccessionNumber; may be repeated")
+	fs.Var(&values, "value", "regular expression matching a value of the elements of the -tag in the same position; tags without a value match files holding them")
+	fs.Parse(args)
+
+	if len(tags) == 0 || fs.NArg() == 0 {
+		return fmt.Errorf("grep takes at least a -tag and a file or folder")
+	}
+	if len(values) > len(tags) {
+		return fmt.Errorf("grep takes a -tag for every -value")
+	}
+
+	conditions := make([]grepCondition, len(tags))
+	for i, tag := range tags {
+		conditions[i].path = tag
+		if i < len(values) {
+			re, err := regexp.Compile(values[i])
+			if err != nil {
+				return err
+			}
+			conditions[i].re = re
+		}
+	}
+
+	// pixel data is never matched
+	parser, err := dicom.NewParser(dicom.HeaderOnly())
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, root := range fs.Args() {
+		err := fp.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+
+			buff, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			data, err := parser.Parse(buff)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "dicomutil: %s: %v\n", path, err)
+				return nil
+			}
+
+			for _, c := range conditions {
+				if !c.match(data) {
+					return nil
+				}
+			}
+			found = true
+			fmt.Println(path)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !found {
+		return exitStatus(1)
+	}
+	return nil
+}
+
+func (c *grepCondition) match(data *dicom.DicomFile) bool {
+
+	elems, err := data.GetByPath(c.path)
+	if err != nil {
+		return false
+	}
+	if c.re == nil {
+		return true
+	}
+
+	for _, elem := range elems {
+		for _, v := range elementValues(elem) {
+			if c.re.MatchString(v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// The values of an element as strings. Binary values, sequences and pixel
+// data have none.
+func elementValues(elem *dicom.DicomElement) []string {
+
+	switch v := elem.Value.(type) {
+	case nil, dicom.Bytes, dicom.Sequence, *dicom.PixelData:
+		return nil
+	case dicom.Strings:
+		return v
+	}
+
+	rv := reflect.ValueOf(elem.Value)
+	values := make([]string, rv.Len())
+	for i := range values {
+		values[i] = fmt.Sprint(rv.Index(i).Interface())
+	}
+	return values
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"os"
+	fp "path/filepath"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	commands["images"] = &command{
+		usage: "images [-format png|jpeg] [-window file|auto|C,W] [-out dir] <files>\twrite the frames of the files as images",
+		run:   runImages,
+	}
+}
+
+func runImages(args []string) error {
+
+	fs := flag.NewFlagSet("images", flag.ExitOnError)
+	format := fs.String("format", "png", "image format, png or jpeg")
+	window := fs.String("window", "file", "window of monochrome images: the first window of the file, auto to use the range of values of each frame, or center,width")
+	out := fs.String("out", ".", "directory to write the images to")
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		return fmt.Errorf("images takes at least one file")
+	}
+
+	var opts dicom.RenderOptions
+	switch *window {
+	case "file":
+	case "auto":
+		opts.AutoWindow = true
+	default:
+		w, err := parseWindow(*window)
+		if err != nil {
+			return err
+		}
+		opts.Window = w
+	}
+
+	var encode func(f *os.File, img image.Image) error
+	switch *format {
+	case "png":
+		encode = func(f *os.File, img image.Image) error { return png.Encode(f, img) }
+	case "jpeg", "jpg":
+		*format = "jpg"
+		encode = func(f *os.File, img image.Image) error { return jpeg.Encode(f, img, &jpeg.Options{Quality: 95}) }
+	default:
+		return fmt.Errorf("unknown format %s", *format)
+	}
+
+	if err := os.MkdirAll(*out, 0755); err != nil {
+		return err
+	}
+
+	for _, path := range fs.Args() {
+		data, err := readFile(path)
+		if err != nil {
+			return err
+		}
+
+		images, err := data.Images(opts)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		basename := strings.TrimSuffix(fp.Base(path), fp.Ext(path))
+		for i, img := range images {
+			name := fp.Join(*out, fmt.Sprintf("%s_%03d.%s", basename, i+1, *format))
+			f, err := os.Create(name)
+			if err != nil {
+				return err
+			}
+			if err := encode(f, img); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Parse a window given as center,width
+func parseWindow(s string) (dicom.Window, error) {
+
+	parts := strings.Split(s, ",")
+	if len(parts) != 2 {
+		return dicom.Window{}, fmt.Errorf("invalid window %s", s)
+	}
+
+	center, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return dicom.Window{}, fmt.Errorf("invalid window %s", s)
+	}
+	width, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil || width < 1 {
+		return dicom.Window{}, fmt.Errorf("invalid window %s", s)
+	}
+
+	return dicom.Window{Center: center, Width: width}, nil
+}
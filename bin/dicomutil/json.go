@@ -0,0 +1,39 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"os"
+)
+
+func init() {
+	commands["json"] = &command{
+		usage: "json [-keywords] [-indent] <file>\tprint the data set as DICOM JSON",
+		run:   runJSON,
+	}
+}
+
+func runJSON(args []string) error {
+
+	fs := flag.NewFlagSet("json", flag.ExitOnError)
+	keywords := fs.Bool("keywords", false, "write an object keyed by keyword instead of DICOM JSON")
+	indent := fs.Bool("indent", false, "indent the output")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("json takes one file")
+	}
+
+	data, err := readFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	opts := dicom.JSONOptions{Keywords: *keywords}
+	if *indent {
+		opts.Indent = "  "
+	}
+
+	return data.WriteJSON(os.Stdout, opts)
+}
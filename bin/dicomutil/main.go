@@ -0,0 +1,114 @@
+// Command dicomutil inspects and edits DICOM files
+//
+//	dicomutil <command> [flags] <files>
+package main
+
+import (
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"io/ioutil"
+	"os"
+	"sort"
+)
+
+// A subcommand, run with the arguments following its name
+type command struct {
+	usage string
+	run   func(args []string) error
+}
+
+var commands = map[string]*command{}
+
+// An error carrying the exit status of a command, without a message
+type exitStatus int
+
+func (s exitStatus) Error() string {
+	return fmt.Sprintf("exit status %d", int(s))
+}
+
+func main() {
+
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+
+	if err := cmd.run(os.Args[2:]); err != nil {
+		if status, ok := err.(exitStatus); ok {
+			os.Exit(int(status))
+		}
+		fmt.Fprintln(os.Stderr, "dicomutil:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(os.Stderr, "usage: dicomutil <command> [flags] <files>")
+	fmt.Fprintln(os.Stderr)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %s\n", commands[name].usage)
+	}
+}
+
+// Read and parse a DICOM file, from the standard input if path is -
+func readFile(path string) (*dicom.DicomFile, error) {
+
+	parser, err := dicom.NewParser()
+	if err != nil {
+		return nil, err
+	}
+
+	var data *dicom.DicomFile
+	if path == "-" {
+		data, err = parser.ParseReader(os.Stdin)
+	} else {
+		var buff []byte
+		if buff, err = ioutil.ReadFile(path); err != nil {
+			return nil, err
+		}
+		data, err = parser.Parse(buff)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return data, nil
+}
+
+// Write a DICOM file
+func writeFile(path string, data *dicom.DicomFile) error {
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := data.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// Parse a keyword or a (gggg,eeee) tag
+func parseTag(s string) (dicom.Tag, error) {
+	tag, err := dicom.ParseTag(s)
+	if err != nil {
+		return tag, fmt.Errorf("%s: %w", s, err)
+	}
+	return tag, nil
+}
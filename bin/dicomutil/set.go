@@ -0,0 +1,63 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+)
+
+func init() {
+	commands["set"] = &command{
+		usage: "set [-tag path=value]... [-delete path]... <in> <out>\tedit the elements of a file",
+		run:   runSet,
+	}
+}
+
+// A flag that may be given several times
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+func runSet(args []string) error {
+
+	var sets, deletes stringList
+	fs := flag.NewFlagSet("set", flag.ExitOnError)
+	fs.Var(&sets, "tag", "set the element at a path, ie. PatientID=ANON or RequestAttributesSequence[0].(0040,1001)=1; separate multiple values with \\")
+	fs.Var(&deletes, "delete", "delete the elements at a path, ie. AccessionNumber")
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		return fmt.Errorf("set takes an input and an output file")
+	}
+
+	data, err := readFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range deletes {
+		if _, err := data.DeleteByPath(path); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	for _, set := range sets {
+		i := strings.IndexByte(set, '=')
+		if i < 0 {
+			return fmt.Errorf("%s: expected path=value", set)
+		}
+		path, value := set[:i], set[i+1:]
+		if err := data.SetByPath(path, strings.Split(value, "\\")...); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	return writeFile(fs.Arg(1), data)
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"os"
+	fp "path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+func init() {
+	commands["stats"] = &command{
+		usage: "stats [-tags n] [-json] <folders>\tcount the files of folders by modality, SOP class and transfer syntax, and how often each tag is used",
+		run:   runStats,
+	}
+}
+
+// The statistics of a set of files
+type stats struct {
+	Files            int
+	Failed           int
+	Studies          int
+	Series           int
+	Modalities       []count
+	SOPClasses       []count
+	TransferSyntaxes []count
+	Tags             []count // the number of files using each tag
+}
+
+type count struct {
+	Value string
+	Name  string `json:",omitempty"`
+	Count int
+}
+
+// Counts by value while collecting, and the names of the values
+type counter struct {
+	counts map[string]int
+	names  map[string]string
+}
+
+func newCounter() *counter {
+	return &counter{counts: map[string]int{}, names: map[string]string{}}
+}
+
+func (c *counter) add(value, name string) {
+	c.counts[value]++
+	if name != "" {
+		c.names[value] = name
+	}
+}
+
+// The counts, most frequent first, and at most n of them if n > 0
+func (c *counter) sorted(n int) []count {
+
+	counts := make([]count, 0, len(c.counts))
+	for value, n := range c.counts {
+		counts = append(counts, count{value, c.names[value], n})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Value < counts[j].Value
+	})
+
+	if n > 0 && len(counts) > n {
+		counts = counts[:n]
+	}
+	return counts
+}
+
+func runStats(args []string) error {
+
+	fs := flag.NewFlagSet("stats", flag.ExitOnError)
+	maxTags := fs.Int("tags", 20, "number of most used tags printed, 0 for all")
+	jsonOut := fs.Bool("json", false, "print the statistics as JSON")
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		return fmt.Errorf("stats takes at least one folder")
+	}
+
+	s := &stats{}
+	studies, series := map[string]bool{}, map[string]bool{}
+	modalities, sopClasses, syntaxes, tags := newCounter(), newCounter(), newCounter(), newCounter()
+
+	for _, folder := range fs.Args() {
+		err := fp.Walk(folder, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+
+			s.Files++
+			data, err := readFile(path)
+			if err != nil {
+				s.Failed++
+				fmt.Fprintln(os.Stderr, "dicomutil:", err)
+				return nil
+			}
+
+			studies[lookupString(data, dicom.TagStudyInstanceUID)] = true
+			series[lookupString(data, dicom.TagSeriesInstanceUID)] = true
+			modalities.add(lookupString(data, dicom.TagModality), "")
+			sopClass := lookupString(data, dicom.TagSOPClassUID)
+			if iod, err := dicom.LookupIOD(sopClass); err == nil {
+				sopClasses.add(sopClass, iod.Name)
+			} else {
+				sopClasses.add(sopClass, "")
+			}
+			syntaxes.add(lookupString(data, dicom.TagTransferSyntaxUID), "")
+
+			used := map[dicom.Tag]bool{}
+			data.Walk(func(path dicom.TagPath, elem *dicom.DicomElement) error {
+				if tag := elem.Tag(); !used[tag] {
+					used[tag] = true
+					tags.add(tag.String(), elem.Name)
+				}
+				return nil
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	delete(studies, "")
+	delete(series, "")
+	s.Studies, s.Series = len(studies), len(series)
+	s.Modalities = modalities.sorted(0)
+	s.SOPClasses = sopClasses.sorted(0)
+	s.TransferSyntaxes = syntaxes.sorted(0)
+	s.Tags = tags.sorted(*maxTags)
+
+	if *jsonOut {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(s)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "Files\t%d\t(%d failed)\n", s.Files, s.Failed)
+	fmt.Fprintf(w, "Studies\t%d\n", s.Studies)
+	fmt.Fprintf(w, "Series\t%d\n", s.Series)
+	for _, section := range []struct {
+		title  string
+		counts []count
+	}{
+		{"Modality", s.Modalities},
+		{"SOP Class", s.SOPClasses},
+		{"Transfer Syntax", s.TransferSyntaxes},
+		{"Tag", s.Tags},
+	} {
+		fmt.Fprintf(w, "\n%s\n", section.title)
+		for _, c := range section.counts {
+			value := c.Value
+			if value == "" {
+				value = "(none)"
+			}
+			fmt.Fprintf(w, "  %s\t%d\t%s\n", value, c.Count, c.Name)
+		}
+	}
+
+	return w.Flush()
+}
+
+// The value of an element, or "" if it is missing
+func lookupString(data *dicom.DicomFile, tag dicom.Tag) string {
+	elem, err := data.LookupElementByTag(tag)
+	if err != nil {
+		return ""
+	}
+	s, _ := elem.GetString()
+	return strings.TrimRight(s, " \x00")
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func init() {
+	commands["xml"] = &command{
+		usage: "xml <file>\tprint the data set in the Native DICOM Model XML",
+		run:   runXML,
+	}
+}
+
+func runXML(args []string) error {
+
+	fs := flag.NewFlagSet("xml", flag.ExitOnError)
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("xml takes one file")
+	}
+
+	data, err := readFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	return data.WriteXML(os.Stdout)
+}
@@ -0,0 +1,248 @@
+// Command dicomwatch watches a folder for DICOM files and dispatches every
+// file arriving to sinks: a Patient/Study/Series directory hierarchy, a
+// storage SCP or a STOW-RS service. Files are removed once every sink
+// accepted them, and moved to a dead letter folder when they do not parse or
+// a sink keeps failing.
+//
+//	dicomwatch [flags] -sink dir:/archive -sink cstore:PACS@pacs:104 <folder>
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"io/ioutil"
+	"log"
+	"os"
+	fp "path/filepath"
+	"strings"
+	"time"
+)
+
+// A flag that may be given several times
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+var (
+	interval   = flag.Duration("interval", 2*time.Second, "how often the folder is polled; files are processed once unchanged for an interval")
+	retries    = flag.Int("retries", 3, "number of times a failing sink is retried before the file is moved to the dead letter folder")
+	deadLetter = flag.String("dead-letter", "", "folder the failing files are moved to, <folder>/failed if empty")
+	callingAE  = flag.String("calling-ae", "DICOMWATCH", "AE title used by the cstore sinks")
+	coerce     = flag.String("coerce", "", "JSON file of coercion rules applied to the files before dispatching them, with the name of the folder as source")
+	sinkFlags  stringList
+)
+
+var logger = log.New(os.Stderr, "dicomwatch: ", log.LstdFlags)
+
+var coercion *dicom.Coercion
+
+// A file seen in the folder, and its progress through the sinks
+type entry struct {
+	size     int64
+	modTime  time.Time
+	stable   bool
+	attempts int
+	next     time.Time    // of the next attempt
+	done     map[int]bool // the sinks that accepted the file
+	cause    error        // why the file goes to the dead letter folder
+}
+
+func main() {
+
+	flag.Var(&sinkFlags, "sink", "where to dispatch the files: dir:<folder>, cstore:<AE>@<host>:<port> or stow:<url>; may be repeated")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: dicomwatch [flags] <folder>")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 || len(sinkFlags) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	folder := flag.Arg(0)
+	if *deadLetter == "" {
+		*deadLetter = fp.Join(folder, "failed")
+	}
+
+	var sinks []sink
+	for _, s := range sinkFlags {
+		sk, err := parseSink(s)
+		if err != nil {
+			logger.Fatal(err)
+		}
+		sinks = append(sinks, sk)
+	}
+
+	if *coerce != "" {
+		f, err := os.Open(*coerce)
+		if err != nil {
+			logger.Fatal(err)
+		}
+		coercion, err = dicom.ReadCoercion(f)
+		f.Close()
+		if err != nil {
+			logger.Fatalf("%s: %v", *coerce, err)
+		}
+	}
+
+	entries := map[string]*entry{}
+	for {
+		if err := poll(folder, entries, sinks); err != nil {
+			logger.Print(err)
+		}
+		time.Sleep(*interval)
+	}
+}
+
+// List the files of the folder and process those that did not change since
+// the last poll
+func poll(folder string, entries map[string]*entry, sinks []sink) error {
+
+	infos, err := ioutil.ReadDir(folder)
+	if err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	for _, info := range infos {
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".") {
+			continue
+		}
+		path := fp.Join(folder, info.Name())
+		seen[path] = true
+
+		e, ok := entries[path]
+		if !ok {
+			entries[path] = &entry{size: info.Size(), modTime: info.ModTime(), done: map[int]bool{}}
+			continue
+		}
+		if !e.stable {
+			// still being written
+			e.stable = e.size == info.Size() && e.modTime.Equal(info.ModTime())
+			e.size, e.modTime = info.Size(), info.ModTime()
+			if !e.stable {
+				continue
+			}
+		}
+		if time.Now().Before(e.next) {
+			continue
+		}
+
+		if process(path, e, sinks) {
+			delete(entries, path)
+		}
+	}
+
+	// files removed by someone else
+	for path := range entries {
+		if !seen[path] {
+			delete(entries, path)
+		}
+	}
+
+	return nil
+}
+
+// Dispatch a file to the sinks that did not accept it yet. Returns whether
+// the file is done with, removed or moved to the dead letter folder.
+func process(path string, e *entry, sinks []sink) bool {
+
+	// only the move failed last time
+	if e.cause != nil {
+		return e.bury(path, e.cause)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		logger.Print(err)
+		return false
+	}
+
+	parser, _ := dicom.NewParser()
+	file, err := parser.Parse(data)
+	if err != nil {
+		return e.bury(path, err)
+	}
+
+	if coercion != nil {
+		applied, err := coercion.Apply(file, fp.Base(fp.Dir(path)))
+		if err != nil {
+			return e.bury(path, err)
+		}
+		// the sinks send the bytes of the file
+		if len(applied) > 0 {
+			buff := new(bytes.Buffer)
+			if err := file.Write(buff); err != nil {
+				return e.bury(path, err)
+			}
+			data = buff.Bytes()
+			logger.Printf("%s: coerced by %s", path, strings.Join(applied, ", "))
+		}
+	}
+
+	var failure error
+	for i, s := range sinks {
+		if e.done[i] {
+			continue
+		}
+		if err := s.send(file, data); err != nil {
+			failure = fmt.Errorf("%v: %w", s, err)
+			logger.Printf("%s: %v", path, failure)
+			continue
+		}
+		e.done[i] = true
+		logger.Printf("%s: sent to %v", path, s)
+	}
+
+	if failure == nil {
+		if err := os.Remove(path); err != nil {
+			logger.Print(err)
+		}
+		return true
+	}
+
+	e.attempts++
+	if e.attempts > *retries {
+		return e.bury(path, failure)
+	}
+
+	// back off exponentially
+	e.next = time.Now().Add(*interval << uint(e.attempts))
+	return false
+}
+
+// Move a file to the dead letter folder, next to a .err file holding the
+// error. Returns whether the file was moved: if not, the move is retried
+// after the poll interval, and the file is not processed again.
+func (e *entry) bury(path string, cause error) bool {
+
+	e.cause = cause
+	e.next = time.Now().Add(*interval)
+
+	if err := os.MkdirAll(*deadLetter, 0755); err != nil {
+		logger.Printf("%s: not moved to %s: %v", path, *deadLetter, err)
+		return false
+	}
+
+	dest := fp.Join(*deadLetter, fp.Base(path))
+	if err := os.Rename(path, dest); err != nil {
+		logger.Printf("%s: not moved to %s: %v", path, *deadLetter, err)
+		return false
+	}
+	logger.Printf("%s: moved to %s: %v", path, *deadLetter, cause)
+
+	if err := ioutil.WriteFile(dest+".err", []byte(cause.Error()+"\n"), 0644); err != nil {
+		logger.Print(err)
+	}
+	return true
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"github.com/gillesdemey/go-dicom/dicomnet"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	fp "path/filepath"
+	"strings"
+)
+
+// A destination of the files
+type sink interface {
+	send(file *dicom.DicomFile, data []byte) error
+	String() string
+}
+
+// Parse a -sink flag
+func parseSink(s string) (sink, error) {
+
+	i := strings.IndexByte(s, ':')
+	if i < 0 {
+		return nil, fmt.Errorf("invalid sink %s", s)
+	}
+	kind, arg := s[:i], s[i+1:]
+
+	switch kind {
+	case "dir":
+		return dirSink(arg), nil
+	case "cstore":
+		j := strings.IndexByte(arg, '@')
+		if j < 0 {
+			return nil, fmt.Errorf("invalid sink %s, expected cstore:<AE>@<host>:<port>", s)
+		}
+		return &storeSink{calledAE: arg[:j], addr: arg[j+1:]}, nil
+	case "stow":
+		return stowSink(arg), nil
+	case "mongo":
+		return nil, fmt.Errorf("sink %s: MongoDB is not supported", s)
+	}
+
+	return nil, fmt.Errorf("unknown sink %s", s)
+}
+
+// Write the files to a <PatientID>/<StudyInstanceUID>/<SeriesInstanceUID>
+// hierarchy under a folder
+type dirSink string
+
+func (root dirSink) send(file *dicom.DicomFile, data []byte) error {
+
+	dir := string(root)
+	for _, tag := range []dicom.Tag{dicom.TagPatientID, dicom.TagStudyInstanceUID, dicom.TagSeriesInstanceUID} {
+		dir = fp.Join(dir, pathComponent(file, tag))
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(fp.Join(dir, pathComponent(file, dicom.TagSOPInstanceUID)+".dcm"), data, 0644)
+}
+
+func (root dirSink) String() string {
+	return "dir:" + string(root)
+}
+
+// Send the files to a storage SCP, over an association per file
+type storeSink struct {
+	calledAE, addr string
+}
+
+func (s *storeSink) send(file *dicom.DicomFile, data []byte) error {
+
+	config := dicomnet.Config{CallingAE: *callingAE, CalledAE: s.calledAE}
+	a, err := dicomnet.Dial(s.addr, config, dicomnet.StorageContexts(file))
+	if err != nil {
+		return err
+	}
+
+	status, err := a.Store(file)
+	if err != nil {
+		a.Abort()
+		return err
+	}
+	a.Release()
+
+	if status.Failure() {
+		return fmt.Errorf("C-STORE: %v", status)
+	}
+	return nil
+}
+
+func (s *storeSink) String() string {
+	return "cstore:" + s.calledAE + "@" + s.addr
+}
+
+// Post the files to a STOW-RS service (PS 3.18 10.5), ie.
+// http://pacs/dicom-web/studies
+type stowSink string
+
+func (url stowSink) send(file *dicom.DicomFile, data []byte) error {
+
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+	part, err := w.CreatePart(textproto.MIMEHeader{"Content-Type": {"application/dicom"}})
+	if err != nil {
+		return err
+	}
+	part.Write(data)
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", string(url), body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", fmt.Sprintf("multipart/related; type=\"application/dicom\"; boundary=%s", w.Boundary()))
+	req.Header.Set("Accept", "application/dicom+json")
+
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	ioutil.ReadAll(rsp.Body)
+
+	if rsp.StatusCode != http.StatusOK && rsp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("STOW-RS: %s", rsp.Status)
+	}
+	return nil
+}
+
+func (url stowSink) String() string {
+	return "stow:" + string(url)
+}
+
+// The value of an element, made safe for a file name
+func pathComponent(file *dicom.DicomFile, tag dicom.Tag) string {
+
+	s := ""
+	if elem, err := file.LookupElementByTag(tag); err == nil {
+		s, _ = elem.GetString()
+	}
+	s = strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '.' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, strings.Trim(s, " \x00"))
+
+	if s == "" || s == "." || s == ".." {
+		return "UNKNOWN"
+	}
+	return s
+}
@@ -3,7 +3,10 @@ package dicom
 import (
 	"bytes"
 	"encoding/binary"
-	"fmt"
+	"io"
+	"math"
+	"strings"
+	"sync"
 )
 
 type dicomBuffer struct {
@@ -11,6 +14,11 @@ type dicomBuffer struct {
 	bo       binary.ByteOrder
 	implicit bool
 	p        uint32 // element start position
+	warnings []Warning
+	elements int           // read so far
+	charset  *characterSet // of the Specific Character Set, nil for raw values
+
+	encapsulated bool // pixel data of the transfer syntax is encapsulated
 }
 
 // The default DicomBuffer reads a buffer with Little Endian byteorder
@@ -21,12 +29,46 @@ func newDicomBuffer(b []byte) *dicomBuffer {
 		binary.LittleEndian,
 		false,
 		0,
+		nil,
+		0,
+		nil,
+		false,
 	}
 }
 
+// Buffers are reused across parses, lazy sequences and the values decoded
+// from JSON and XML, which need one for every binary value
+var dicomBuffers = sync.Pool{
+	New: func() interface{} { return &dicomBuffer{Buffer: new(bytes.Buffer)} },
+}
+
+// Return a pooled buffer like newDicomBuffer, to release once read. The
+// values read keep referencing b, never the buffer.
+func getDicomBuffer(b []byte) *dicomBuffer {
+	buffer := dicomBuffers.Get().(*dicomBuffer)
+	*buffer.Buffer = *bytes.NewBuffer(b)
+	buffer.bo = binary.LittleEndian
+	return buffer
+}
+
+// Return the buffer to the pool, dropping its data and state
+func (buffer *dicomBuffer) release() {
+	b := buffer.Buffer
+	*b = bytes.Buffer{}
+	*buffer = dicomBuffer{Buffer: b}
+	dicomBuffers.Put(buffer)
+}
+
+// Decode the values of a binary VR, little endian, from b
+func decodeValue(vr string, b []byte) Value {
+	buffer := getDicomBuffer(b)
+	defer buffer.release()
+	return buffer.readValue(vr, uint32(len(b)))
+}
+
 // Read the VR from the DICOM ditionary
 // The VL is a 32-bit unsigned integer
-func (buffer *dicomBuffer) readImplicit(elem *DicomElement, p *Parser) (string, uint32) {
+func (buffer *dicomBuffer) readImplicit(elem *DicomElement, p *Parser) (string, uint32, error) {
 
 	var vr string
 
@@ -37,31 +79,33 @@ func (buffer *dicomBuffer) readImplicit(elem *DicomElement, p *Parser) (string,
 		vr = entry.vr
 	}
 
+	// the ambiguous VRs of the dictionary are OW and US in implicit VR
+	// (PS 3.5 A.1), and its UP offsets of DICOMDIRs are UL
+	switch vr {
+	case "OX":
+		vr = "OW"
+	case "XS":
+		vr = "US"
+	case "UP":
+		vr = "UL"
+	}
+
 	vl, ulen, err := decodeValueLength(buffer, vr, false)
 	elem.undefLen = ulen
-	if err == ErrOddLength {
-		fmt.Printf("WARN (implicit): attempted to read odd length VL for %+v\n", elem)
-		panic(ErrOddLength)
-	}
 
-	return vr, vl
+	return vr, vl, err
 }
 
 // The VR is represented by the next two consecutive bytes
 // The VL depends on the VR value
-func (buffer *dicomBuffer) readExplicit(elem *DicomElement) (string, uint32) {
+func (buffer *dicomBuffer) readExplicit(elem *DicomElement) (string, uint32, error) {
 	vr := string(buffer.Next(2))
 	buffer.p += 2
 
 	vl, ulen, err := decodeValueLength(buffer, vr, true)
 	elem.undefLen = ulen
 
-	if err == ErrOddLength {
-		fmt.Printf("WARN (explicit): attempted to read odd length VL for %+v\n", elem)
-		panic(ErrOddLength)
-	}
-
-	return vr, vl
+	return vr, vl, err
 }
 
 func decodeValueLength(buffer *dicomBuffer, vr string, explicit bool) (uint32, bool, error) {
@@ -77,7 +121,7 @@ func decodeValueLength(buffer *dicomBuffer, vr string, explicit bool) (uint32, b
 
 		// long value representations
 		switch vr {
-		case "NA", "OB", "OD", "OF", "OL", "OW", "SQ", "UN", "UC", "UR", "UT":
+		case "NA", "OB", "OD", "OF", "OL", "OV", "OW", "SQ", "SV", "UN", "UC", "UR", "UT", "UV":
 			buffer.Next(2) // ignore two bytes for "future use" (0000H)
 			buffer.p += 2
 
@@ -112,7 +156,7 @@ func decodeValueLength(buffer *dicomBuffer, vr string, explicit bool) (uint32, b
 
 	// Error when encountering odd length
 	if vl > 0 && vl%2 != 0 {
-		return 0, ulen, ErrOddLength
+		return vl, ulen, ErrOddLength
 	}
 
 	return vl, ulen, nil
@@ -146,82 +190,204 @@ func (buffer *dicomBuffer) readTag(p *Parser) *DicomElement {
 
 }
 
+// Read the value of a data element of vr and length vl. Bytes left over
+// after the last complete numeric value are skipped.
+func (buffer *dicomBuffer) readValue(vr string, vl uint32) Value {
+
+	switch vr {
+	case "AT":
+		b := buffer.readValues(vl, 4)
+		v := make(Tags, len(b)/4)
+		for i := range v {
+			v[i] = Tag{buffer.bo.Uint16(b[4*i:]), buffer.bo.Uint16(b[4*i+2:])}
+		}
+		return v
+	case "UL", "OL":
+		b := buffer.readValues(vl, 4)
+		v := make(UInt32s, len(b)/4)
+		for i := range v {
+			v[i] = buffer.bo.Uint32(b[4*i:])
+		}
+		return v
+	case "SL":
+		b := buffer.readValues(vl, 4)
+		v := make(Int32s, len(b)/4)
+		for i := range v {
+			v[i] = int32(buffer.bo.Uint32(b[4*i:]))
+		}
+		return v
+	case "UV", "OV":
+		b := buffer.readValues(vl, 8)
+		v := make(UInt64s, len(b)/8)
+		for i := range v {
+			v[i] = buffer.bo.Uint64(b[8*i:])
+		}
+		return v
+	case "SV":
+		b := buffer.readValues(vl, 8)
+		v := make(Int64s, len(b)/8)
+		for i := range v {
+			v[i] = int64(buffer.bo.Uint64(b[8*i:]))
+		}
+		return v
+	case "US", "OW":
+		b := buffer.readValues(vl, 2)
+		v := make(UInt16s, len(b)/2)
+		for i := range v {
+			v[i] = buffer.bo.Uint16(b[2*i:])
+		}
+		return v
+	case "SS":
+		b := buffer.readValues(vl, 2)
+		v := make(Int16s, len(b)/2)
+		for i := range v {
+			v[i] = int16(buffer.bo.Uint16(b[2*i:]))
+		}
+		return v
+	case "FL", "OF":
+		b := buffer.readValues(vl, 4)
+		v := make(Float32s, len(b)/4)
+		for i := range v {
+			v[i] = math.Float32frombits(buffer.bo.Uint32(b[4*i:]))
+		}
+		return v
+	case "FD", "OD":
+		b := buffer.readValues(vl, 8)
+		v := make(Float64s, len(b)/8)
+		for i := range v {
+			v[i] = math.Float64frombits(buffer.bo.Uint64(b[8*i:]))
+		}
+		return v
+	case "OB", "UN":
+		return Bytes(buffer.readUInt8Array(vl))
+	case "NA":
+		return nil
+	case "SQ":
+		// items are read by the parser
+		return Sequence{}
+	}
+
+	if vl == 0 {
+		return Strings{}
+	}
+
+	// decoded before splitting, multi-byte characters may contain a backslash
+	var str string
+	if buffer.charset != nil && usesCharacterSet(vr) {
+		str = buffer.charset.decode(buffer.readUInt8Array(vl))
+	} else {
+		str = buffer.readString(vl)
+	}
+	if isTextVR(vr) {
+		return Strings{str}
+	}
+	return Strings(strings.Split(str, "\\"))
+}
+
+// Size in bytes of the values of binary VRs, 1 for other VRs
+func valueSize(vr string) uint32 {
+	switch vr {
+	case "US", "SS", "OW":
+		return 2
+	case "AT", "UL", "SL", "FL", "OL", "OF":
+		return 4
+	case "FD", "OD", "SV", "UV", "OV":
+		return 8
+	}
+	return 1
+}
+
+// Skip n bytes
+func (buffer *dicomBuffer) skip(n uint32) {
+	buffer.Next(int(n))
+	buffer.p += n
+}
+
 // Read x consecutive bytes as a string
 func (buffer *dicomBuffer) readString(vl uint32) string {
 	chunk := buffer.Next(int(vl))
-	chunk = bytes.Trim(chunk, "\x00")   // trim those pesky null bytes
-	chunk = bytes.Trim(chunk, "\u200B") // trim zero-width characters
-	buffer.p += vl
+	buffer.p += uint32(len(chunk))
 	return string(chunk)
 }
 
-// Read 4 consecutive bytes as a float32
-func (buffer *dicomBuffer) readFloat() (val float32) {
-	buf := bytes.NewBuffer(buffer.Next(4))
-	binary.Read(buf, buffer.bo, &val)
-	buffer.p += 4
-	return
+// Read n bytes, zeros past the end of the buffer
+func (buffer *dicomBuffer) read(n int) []byte {
+	b := buffer.Next(n)
+	buffer.p += uint32(n)
+	if len(b) < n {
+		return make([]byte, n)
+	}
+	return b
 }
 
-// Read 8 consecutive bytes as a float64
-func (buffer *dicomBuffer) readFloat64() (val float64) {
-	buf := bytes.NewBuffer(buffer.Next(8))
-	binary.Read(buf, buffer.bo, &val)
-	buffer.p += 8
-	return
+// Return the next n bytes without consuming them
+func (buffer *dicomBuffer) peek(n int) ([]byte, error) {
+	b := buffer.Bytes()
+	if len(b) < n {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return b[:n], nil
 }
 
-// Read 2 bytes as a hexadecimal value
-func (buffer *dicomBuffer) readHex() uint16 {
-	return buffer.readUInt16()
+// Return the tag of the next element without consuming it
+func (buffer *dicomBuffer) peekTag() (Tag, error) {
+	b, err := buffer.peek(4)
+	if err != nil {
+		return Tag{}, err
+	}
+	return Tag{buffer.bo.Uint16(b), buffer.bo.Uint16(b[2:])}, nil
 }
 
-// Read 4 bytes as an UInt32
-func (buffer *dicomBuffer) readUInt32() (val uint32) {
-	buf := bytes.NewBuffer(buffer.Next(4))
-	binary.Read(buf, buffer.bo, &val)
-	buffer.p += 4
-	return
+// Reports whether the value of elem, the next bytes of the buffer, is
+// encapsulated pixel data of defined length, as written by some
+// implementations: items in a transfer syntax with encapsulated pixel data
+func (buffer *dicomBuffer) definedLengthFragments(elem *DicomElement) bool {
+	if elem.Tag() != TagPixelData || elem.undefLen || !buffer.encapsulated || elem.Vl < 8 {
+		return false
+	}
+	tag, err := buffer.peekTag()
+	return err == nil && tag == TagItem
 }
 
-// Read 4 bytes as an int32
-func (buffer *dicomBuffer) readInt32() (val int32) {
-	buf := bytes.NewBuffer(buffer.Next(4))
-	binary.Read(buf, buffer.bo, &val)
-	buffer.p += 4
-	return
+// Skip the padding byte following an odd length fragment, if the next item
+// follows it
+func (buffer *dicomBuffer) skipPadding() {
+	b, err := buffer.peek(5)
+	if err != nil || b[0] != 0x00 || buffer.bo.Uint16(b[1:]) != pixeldata_group {
+		return
+	}
+	buffer.Next(1)
+	buffer.p++
 }
 
-// Read 2 bytes as an UInt16
-func (buffer *dicomBuffer) readUInt16() (val uint16) {
-	buf := bytes.NewBuffer(buffer.Next(2))
-	binary.Read(buf, buffer.bo, &val)
-	buffer.p += 2
-	return
+// Read 2 bytes as a hexadecimal value
+func (buffer *dicomBuffer) readHex() uint16 {
+	return buffer.readUInt16()
 }
 
-// Read 2 bytes as an int16
-func (buffer *dicomBuffer) readInt16() (val int16) {
-	buf := bytes.NewBuffer(buffer.Next(2))
-	binary.Read(buf, buffer.bo, &val)
-	buffer.p += 2
-	return
+// Read 4 bytes as an UInt32
+func (buffer *dicomBuffer) readUInt32() uint32 {
+	return buffer.bo.Uint32(buffer.read(4))
 }
 
-// Read x number of bytes as an array of UInt16 values
-func (buffer *dicomBuffer) readUInt16Array(vl uint32) []uint16 {
-	slice := make([]uint16, int(vl)/2)
+// Read 2 bytes as an UInt16
+func (buffer *dicomBuffer) readUInt16() uint16 {
+	return buffer.bo.Uint16(buffer.read(2))
+}
 
-	for i := 0; i < len(slice); i++ {
-		slice[i] = buffer.readUInt16()
-	}
-	buffer.p += vl
-	return slice
+// Read the bytes of the values of a binary VR of size bytes per value,
+// skipping the bytes left over after the last complete value. The values are
+// decoded from these bytes in one go, as pixel data or LUTs hold millions.
+func (buffer *dicomBuffer) readValues(vl uint32, size uint32) []byte {
+	b := buffer.Next(int(vl / size * size))
+	buffer.p += uint32(len(b))
+	buffer.skip(vl % size)
+	return b
 }
 
 // Read x number of bytes as an array of UInt8 values
 func (buffer *dicomBuffer) readUInt8Array(vl uint32) []byte {
 	chunk := buffer.Next(int(vl))
-	buffer.p += vl
+	buffer.p += uint32(len(chunk))
 	return chunk
 }
@@ -1,6 +1,7 @@
 package dicom
 
 import (
+	"encoding/binary"
 	"testing"
 )
 
@@ -28,7 +29,7 @@ func TestReadStringWithNullBytes(t *testing.T) {
 	b := newDicomBuffer([]byte{0x00, 0x4f, 0x73, 0x69, 0x72, 0x69, 0x58, 0x00})
 	l := uint32(b.Len())
 
-	if s := b.readString(l); s != expect {
+	if s := trimString(b.readString(l), "LO"); s != expect {
 		t.Errorf("Incorrect string comparison %#x. Should be %#x.", s, expect)
 	}
 
@@ -44,8 +45,163 @@ func TestReadStringWithZeroWidthCharacter(t *testing.T) {
 	b := newDicomBuffer([]byte{0x4f, 0x73, 0x69, 0x72, 0x69, 0x58, 0xE2, 0x80, 0x8B})
 	l := uint32(b.Len())
 
-	if s := b.readString(l); s != expect {
+	if s := trimString(b.readString(l), "LO"); s != expect {
 		t.Errorf("Incorrect string comparison %#x. Should be %#x.", s, expect)
 	}
 
 }
+
+func TestTrimString(t *testing.T) {
+
+	cases := []struct {
+		s, vr, expect string
+	}{
+		{"OsiriX\x00", "LO", "OsiriX"},
+		{"OsiriX\u200B", "LO", "OsiriX"}, // zero-width space
+		{"\x00OsiriX\x00", "LO", "OsiriX"},
+		{"\x00  indented text", "LT", "  indented text"},
+		{"1.2.840.10008.1.2\x00", "UI", "1.2.840.10008.1.2"},
+		{" ORIGINAL ", "CS", "ORIGINAL"},
+		{"  indented text ", "LT", "  indented text"},
+		{"DOE^JOHN ", "PN", "DOE^JOHN"},
+	}
+
+	for _, c := range cases {
+		if s := trimString(c.s, c.vr); s != c.expect {
+			t.Errorf("Incorrect %s string %q. Should be %q.", c.vr, s, c.expect)
+		}
+	}
+
+}
+
+func TestReadValueKeepsTexts(t *testing.T) {
+
+	b := newDicomBuffer([]byte("C:\\dir "))
+
+	if v := b.readValue("LT", uint32(b.Len())).(Strings); len(v) != 1 || v[0] != "C:\\dir " {
+		t.Errorf("Incorrect LT value %q", v)
+	}
+
+}
+
+func TestReadNumbers(t *testing.T) {
+
+	b := newDicomBuffer([]byte{
+		0x01, 0x02, 0x03, 0x04, // US
+		0xfe, 0xff, // SS
+		0x00, 0x00, 0x80, 0x3f, // FL 1.0
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0xbf, // FD -1.5
+		0x01, 0x00, 0x00, 0x00, 0xff, // UL and a trailing byte
+	})
+
+	if v := b.readValue("US", 4).(UInt16s); len(v) != 2 || v[0] != 0x0201 || v[1] != 0x0403 {
+		t.Errorf("Incorrect US %v", v)
+	}
+	if v := b.readValue("SS", 2).(Int16s); v[0] != -2 {
+		t.Errorf("Incorrect SS %v", v)
+	}
+	if v := b.readValue("FL", 4).(Float32s); v[0] != 1 {
+		t.Errorf("Incorrect FL %v", v)
+	}
+	if v := b.readValue("FD", 8).(Float64s); v[0] != -1.5 {
+		t.Errorf("Incorrect FD %v", v)
+	}
+	if v := b.readValue("UL", 5).(UInt32s); len(v) != 1 || v[0] != 1 {
+		t.Errorf("Incorrect UL %v", v)
+	}
+	if b.Len() != 0 || b.p != 23 {
+		t.Errorf("The trailing byte should be skipped, %d left at %d", b.Len(), b.p)
+	}
+
+}
+
+func TestPeek(t *testing.T) {
+
+	// (0010,0010) PatientName
+	b := newDicomBuffer([]byte{0x10, 0x00, 0x10, 0x00, 'P', 'N'})
+
+	if tag, err := b.peekTag(); err != nil || tag != TagPatientName {
+		t.Errorf("Incorrect tag %v, %v", tag, err)
+	}
+	if p, err := b.peek(6); err != nil || string(p[4:]) != "PN" {
+		t.Errorf("Incorrect bytes %v, %v", p, err)
+	}
+	if b.Len() != 6 {
+		t.Errorf("Peeking consumed %d bytes", 6-b.Len())
+	}
+	if _, err := b.peek(7); err == nil {
+		t.Error("Peeking past the end should fail")
+	}
+
+}
+
+// Read the header and value of an arbitrary element, which must not panic.
+// The bytes read must match the value length, and the number of binary
+// values the size of their VR.
+func FuzzReadElement(f *testing.F) {
+
+	// PatientName DOE^JOHN, in explicit and implicit VR
+	f.Add([]byte("\x10\x00\x10\x00PN\x08\x00DOE^JOHN"), true, false)
+	f.Add([]byte("\x28\x00\x10\x00\x02\x00\x00\x00\x00\x02"), false, false)
+	f.Add([]byte("\x00\x28\x00\x30DS\x00\x080.5\\0.5 "), true, true)
+
+	parser, _ := NewParser()
+	f.Fuzz(func(t *testing.T, data []byte, explicit, bigEndian bool) {
+		buffer := newDicomBuffer(data)
+		if bigEndian {
+			buffer.bo = binary.BigEndian
+		}
+
+		elem := buffer.readTag(parser)
+		var vr string
+		var vl uint32
+		var err error
+		if explicit {
+			vr, vl, err = buffer.readExplicit(elem)
+		} else {
+			vr, vl, err = buffer.readImplicit(elem, parser)
+		}
+		if err != nil {
+			return
+		}
+
+		// the header or the value are truncated
+		header := uint32(len(data) - buffer.Len())
+		complete := buffer.p == header && vl <= uint32(buffer.Len())
+
+		value := buffer.readValue(vr, vl)
+		if !complete {
+			return
+		}
+		if buffer.p != header+vl {
+			t.Errorf("Read %d bytes of a %s value of %d bytes", buffer.p-header, vr, vl)
+		}
+		switch v := value.(type) {
+		case Bytes:
+			if uint32(len(v)) != vl {
+				t.Errorf("Read %d bytes of a %s value of %d bytes", len(v), vr, vl)
+			}
+		case Strings, Sequence, nil:
+		default:
+			if n := vl / valueSize(vr); uint32(v.Len()) != n {
+				t.Errorf("Read %d %s values of %d bytes, expected %d", v.Len(), vr, vl, n)
+			}
+		}
+	})
+}
+
+func TestDecodeValueReusesBuffers(t *testing.T) {
+
+	b := make([]byte, 16)
+	binary.LittleEndian.PutUint16(b[2:], 0x1234)
+
+	if v := decodeValue("US", b).(UInt16s); len(v) != 8 || v[1] != 0x1234 {
+		t.Errorf("Incorrect US values %v", v)
+	}
+
+	// only the values are allocated, and boxed in a Value
+	if allocs := testing.AllocsPerRun(100, func() { decodeValue("US", b) }); allocs > 2 {
+		t.Errorf("Expected 2 allocations per value, got %.0f", allocs)
+	}
+
+}
@@ -0,0 +1,247 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+//go:generate go run gencharset.go
+
+var (
+	ErrUnknownCharacterSet = errors.New("Unknown character set")
+	ErrNotInCharacterSet   = errors.New("Character not in the Specific Character Set")
+)
+
+// A character set of the Specific Character Set (PS 3.3 C.12.1.1.2), whose
+// values are decoded to UTF-8 when parsed, and encoded back when written
+type characterSet struct {
+	decode func([]byte) string
+	encode func(string) ([]byte, error)
+}
+
+// The character sets supported, by defined term. The values of the default
+// repertoire and of UTF-8 are kept as they are.
+var characterSets = map[string]*characterSet{
+	"":           nil,
+	"ISO_IR 6":   nil,
+	"ISO_IR 192": nil,
+	"ISO_IR 100": {decodeLatin1, encodeLatin1},
+	"GB18030":    {decodeGB18030, encodeGB18030},
+	"GBK":        {decodeGB18030, encodeGBK},
+}
+
+// Return the character set of a Specific Character Set element
+func characterSetOf(elem *DicomElement) (*characterSet, error) {
+
+	values, err := elem.GetStrings()
+	if err != nil {
+		return nil, err
+	}
+	name := ""
+	if len(values) == 1 {
+		name = strings.TrimSpace(values[0])
+	} else if len(values) > 1 {
+		// code extensions are not supported
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCharacterSet, strings.Join(values, "\\"))
+	}
+
+	cs, ok := characterSets[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCharacterSet, name)
+	}
+	return cs, nil
+}
+
+// Return the character set of the file, nil for the default repertoire or
+// an unknown character set
+func (file *DicomFile) characterSet() *characterSet {
+	if i := file.indexOf(TagSpecificCharacterSet); i >= 0 {
+		cs, _ := characterSetOf(&file.Elements[i])
+		return cs
+	}
+	return nil
+}
+
+// Reports whether the values of a VR are in the Specific Character Set
+func usesCharacterSet(vr string) bool {
+	switch vr {
+	case "SH", "LO", "ST", "LT", "PN", "UC", "UT":
+		return true
+	}
+	return false
+}
+
+func decodeLatin1(b []byte) string {
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
+
+func encodeLatin1(s string) ([]byte, error) {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r > 0xff {
+			return nil, fmt.Errorf("%w: %q", ErrNotInCharacterSet, r)
+		}
+		b = append(b, byte(r))
+	}
+	return b, nil
+}
+
+// Decode GB18030 (GB 18030-2005): ASCII, two-byte codes, and four-byte codes
+// of the BMP and of the supplementary planes. Invalid codes are replaced by
+// U+FFFD.
+func decodeGB18030(b []byte) string {
+
+	var sb strings.Builder
+	for i := 0; i < len(b); {
+		c := b[i]
+		switch {
+		case c < 0x80:
+			sb.WriteByte(c)
+			i++
+		case c == 0x80 || c == 0xff || i+1 == len(b):
+			sb.WriteRune(utf8.RuneError)
+			i++
+		case b[i+1] >= 0x30 && b[i+1] <= 0x39:
+			if i+3 >= len(b) || b[i+2] < 0x81 || b[i+2] > 0xfe || b[i+3] < 0x30 || b[i+3] > 0x39 {
+				sb.WriteRune(utf8.RuneError)
+				i++
+				continue
+			}
+			sb.WriteRune(decodeGB18030FourByte(b[i : i+4]))
+			i += 4
+		default:
+			r := decodeGB18030TwoByte(c, b[i+1])
+			sb.WriteRune(r)
+			// the ASCII trail of an invalid code is a character of its own
+			if r == utf8.RuneError && b[i+1] < 0x80 {
+				i++
+				continue
+			}
+			i += 2
+		}
+	}
+	return sb.String()
+}
+
+func decodeGB18030TwoByte(lead, trail byte) rune {
+	if trail < 0x40 || trail == 0x7f || trail == 0xff {
+		return utf8.RuneError
+	}
+	i := int(lead-0x81)*190 + int(trail-0x40)
+	if trail > 0x7f {
+		i--
+	}
+	if r := gb18030TwoByte[i]; r != 0 {
+		return rune(r)
+	}
+	return utf8.RuneError
+}
+
+func decodeGB18030FourByte(b []byte) rune {
+
+	linear := func(first byte) int {
+		return ((int(b[0]-first)*10+int(b[1]-0x30))*126+int(b[2]-0x81))*10 + int(b[3]-0x30)
+	}
+
+	switch {
+	case b[0] <= 0x84:
+		index := linear(0x81)
+		if index >= 39420 {
+			return utf8.RuneError
+		}
+		// the last range starting at or before the index
+		k := sort.Search(len(gb18030Ranges), func(k int) bool { return int(gb18030Ranges[k][0]) > index }) - 1
+		return rune(int(gb18030Ranges[k][1]) + index - int(gb18030Ranges[k][0]))
+	case b[0] >= 0x90 && b[0] <= 0xe3:
+		if r := rune(0x10000 + linear(0x90)); r <= utf8.MaxRune {
+			return r
+		}
+	}
+	return utf8.RuneError
+}
+
+// The GB18030 codes of the characters, built on first use
+var gb18030Encoder struct {
+	once    sync.Once
+	twoByte map[rune]uint16 // the index in gb18030TwoByte
+	ranges  [][2]uint16     // gb18030Ranges sorted by character
+}
+
+func encodeGB18030(s string) ([]byte, error) {
+	return appendGB18030(s, true)
+}
+
+// Encode GBK, the two-byte codes of GB18030
+func encodeGBK(s string) ([]byte, error) {
+	return appendGB18030(s, false)
+}
+
+func appendGB18030(s string, fourByte bool) ([]byte, error) {
+
+	enc := &gb18030Encoder
+	enc.once.Do(func() {
+		enc.twoByte = make(map[rune]uint16, len(gb18030TwoByte))
+		for i, r := range gb18030TwoByte {
+			if _, ok := enc.twoByte[rune(r)]; r != 0 && !ok {
+				enc.twoByte[rune(r)] = uint16(i)
+			}
+		}
+		enc.ranges = append([][2]uint16(nil), gb18030Ranges[:]...)
+		sort.Slice(enc.ranges, func(i, j int) bool { return enc.ranges[i][1] < enc.ranges[j][1] })
+	})
+
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r < 0x80 {
+			b = append(b, byte(r))
+			continue
+		}
+		if i, ok := enc.twoByte[r]; ok {
+			lead, trail := i/190+0x81, i%190+0x40
+			if trail >= 0x7f {
+				trail++
+			}
+			b = append(b, byte(lead), byte(trail))
+			continue
+		}
+		if !fourByte {
+			return nil, fmt.Errorf("%w: %q", ErrNotInCharacterSet, r)
+		}
+
+		var index, first int
+		if r > 0xffff {
+			index, first = int(r-0x10000), 0x90
+		} else {
+			k := sort.Search(len(enc.ranges), func(k int) bool { return rune(enc.ranges[k][1]) > r }) - 1
+			if k < 0 {
+				return nil, fmt.Errorf("%w: %q", ErrNotInCharacterSet, r)
+			}
+			index, first = int(enc.ranges[k][0])+int(r)-int(enc.ranges[k][1]), 0x81
+		}
+		b = append(b, byte(first+index/12600), byte(0x30+index/1260%10), byte(0x81+index/10%126), byte(0x30+index%10))
+	}
+	return b, nil
+}
+
+// Decode the values of the text elements following a Specific Character Set
+// element with its character set. Unknown character sets are warnings, and
+// the values are then read as they are.
+func (p *Parser) setCharacterSet(buffer *dicomBuffer, elem *DicomElement) error {
+	cs, err := characterSetOf(elem)
+	if err != nil {
+		if p.strict {
+			return &ConformanceError{elem.Tag(), elem.P, err}
+		}
+		p.warn(buffer, elem, err)
+	}
+	buffer.charset = cs
+	return nil
+}
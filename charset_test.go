@@ -0,0 +1,138 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"unicode/utf8"
+)
+
+// Write a file with a Specific Character Set and a person name element
+func writeName(t *testing.T, charset string, tag Tag, name Strings) ([]byte, error) {
+
+	p, _ := NewParser()
+	file := &DicomFile{}
+	for _, attr := range []itemValue{
+		{TagTransferSyntaxUID, Strings{explicit_vr_little_endian}},
+		{TagSpecificCharacterSet, Strings{charset}},
+		{tag, name},
+	} {
+		elem, err := p.NewElement(attr.tag, attr.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.insertElement(elem)
+	}
+	buffer := new(bytes.Buffer)
+	err := file.Write(buffer)
+	return buffer.Bytes(), err
+}
+
+func TestCharacterSets(t *testing.T) {
+
+	// the examples of PS 3.5 Annex H, I and J
+	for _, test := range []struct {
+		charset string
+		tag     Tag
+		name    Strings
+		encoded []byte
+	}{
+		{"ISO_IR 100", TagPatientName, Strings{"Buc^Jérôme"}, []byte{0x42, 0x75, 0x63, 0x5e, 0x4a, 0xe9, 0x72, 0xf4, 0x6d, 0x65}},
+		{"ISO_IR 192", TagPatientName, Strings{"Wang^XiaoDong=王^小東="}, []byte("Wang^XiaoDong=王^小東=")},
+		{"GB18030", TagPatientName, Strings{"Wang^XiaoDong=王^小东="}, []byte{
+			0x57, 0x61, 0x6e, 0x67, 0x5e, 0x58, 0x69, 0x61, 0x6f, 0x44, 0x6f, 0x6e, 0x67, 0x3d,
+			0xcd, 0xf5, 0x5e, 0xd0, 0xa1, 0xb6, 0xab, 0x3d}},
+		{"GBK", TagPatientName, Strings{"Wang^XiaoDong=王^小东="}, []byte{
+			0x57, 0x61, 0x6e, 0x67, 0x5e, 0x58, 0x69, 0x61, 0x6f, 0x44, 0x6f, 0x6e, 0x67, 0x3d,
+			0xcd, 0xf5, 0x5e, 0xd0, 0xa1, 0xb6, 0xab, 0x3d}},
+		// a trail byte of 0x5c, four-byte codes of the BMP and beyond
+		{"GB18030", TagOtherPatientNames, Strings{"乗", "¥😀"}, []byte{0x81, 0x5c, 0x5c, 0x81, 0x30, 0x84, 0x36, 0x94, 0x39, 0xfc, 0x36}},
+	} {
+		raw, err := writeName(t, test.charset, test.tag, test.name)
+		if err != nil {
+			t.Fatalf("%s: %v", test.charset, err)
+		}
+		if !bytes.Contains(raw, test.encoded) {
+			t.Errorf("%s: %q not encoded as %x", test.charset, test.name, test.encoded)
+		}
+
+		p, _ := NewParser()
+		file, err := p.Parse(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		elem, err := file.LookupElementByTag(test.tag)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values := elem.MustGetStrings()
+		if len(values) != len(test.name) {
+			t.Fatalf("%s: expected %q, got %q", test.charset, test.name, values)
+		}
+		for i := range values {
+			if values[i] != test.name[i] {
+				t.Errorf("%s: expected %q, got %q", test.charset, test.name[i], values[i])
+			}
+		}
+	}
+}
+
+func TestCharacterNotInCharacterSet(t *testing.T) {
+	for charset, name := range map[string]string{
+		"ISO_IR 100": "王^小东",
+		"GBK":        "¥",
+	} {
+		if _, err := writeName(t, charset, TagPatientName, Strings{name}); !errors.Is(err, ErrNotInCharacterSet) {
+			t.Errorf("%s: expected ErrNotInCharacterSet, got %v", charset, err)
+		}
+	}
+}
+
+func TestUnknownCharacterSet(t *testing.T) {
+
+	raw, err := writeName(t, "ISO_IR 13", TagPatientName, Strings{"Yamada^Tarou"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, _ := NewParser()
+	file, err := p.Parse(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(file.Warnings) != 1 || !errors.Is(&file.Warnings[0], ErrUnknownCharacterSet) {
+		t.Errorf("Expected an unknown character set warning, got %v", file.Warnings)
+	}
+}
+
+func TestGB18030RoundTrip(t *testing.T) {
+	for r := rune(0x80); r <= 0x10ffff; r++ {
+		if (r >= 0xd800 && r < 0xe000) || (r > 0xffff && r%0x100 != 0) {
+			continue
+		}
+		encoded, err := encodeGB18030(string(r))
+		if err != nil {
+			t.Fatalf("%U: %v", r, err)
+		}
+		if decoded, _ := utf8.DecodeRuneInString(decodeGB18030(encoded)); decoded != r {
+			t.Fatalf("%U encoded as %x decoded as %U", r, encoded, decoded)
+		}
+	}
+}
+
+func TestGB18030InvalidTrail(t *testing.T) {
+	for _, c := range []struct {
+		b        []byte
+		expected string
+	}{
+		{[]byte{0x81, 'A'}, "\u4e04"},
+		{[]byte{0x81, '=', 'B'}, "�=B"},
+		{[]byte{0x81, 0x7f, 'B'}, "�\x7fB"},
+		{[]byte{0x81, 0xff, 'B'}, "�B"},
+		{[]byte{0xa8, 0xbc}, "ḿ"},
+		{[]byte{0x81, 0x35, 0xf4, 0x37}, "\ue7c7"},
+	} {
+		if s := decodeGB18030(c.b); s != c.expected {
+			t.Errorf("%x decoded as %q, expected %q", c.b, s, c.expected)
+		}
+	}
+}
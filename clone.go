@@ -0,0 +1,80 @@
+package dicom
+
+// Return a deep copy of the element, including nested sequence items and
+// pixel data
+func (e *DicomElement) Clone() *DicomElement {
+	clone := *e
+	clone.Value = cloneValue(e.Value)
+	return &clone
+}
+
+func cloneValue(v Value) Value {
+	switch v := v.(type) {
+	case Strings:
+		return append(Strings(nil), v...)
+	case UInt16s:
+		return append(UInt16s(nil), v...)
+	case Int16s:
+		return append(Int16s(nil), v...)
+	case UInt32s:
+		return append(UInt32s(nil), v...)
+	case Int32s:
+		return append(Int32s(nil), v...)
+	case UInt64s:
+		return append(UInt64s(nil), v...)
+	case Int64s:
+		return append(Int64s(nil), v...)
+	case Float32s:
+		return append(Float32s(nil), v...)
+	case Float64s:
+		return append(Float64s(nil), v...)
+	case Bytes:
+		return append(Bytes(nil), v...)
+	case Tags:
+		return append(Tags(nil), v...)
+	case Sequence:
+		return v.Clone()
+	case *PixelData:
+		clone := &PixelData{Offsets: append([]uint32(nil), v.Offsets...)}
+		for _, fragment := range v.Fragments {
+			clone.Fragments = append(clone.Fragments, append([]byte(nil), fragment...))
+		}
+		return clone
+	}
+	return v
+}
+
+// Return a deep copy of the file
+func (file *DicomFile) Clone() *DicomFile {
+
+	clone := &DicomFile{}
+	clone.Warnings = append([]Warning(nil), file.Warnings...)
+	if file.Elements == nil {
+		return clone
+	}
+
+	clone.Elements = make([]DicomElement, len(file.Elements))
+	for i := range file.Elements {
+		clone.Elements[i] = *file.Elements[i].Clone()
+	}
+
+	return clone
+}
+
+// Copy the OB and UN values and the pixel data fragments of the file, which
+// reference the buffer parsed, so that the buffer may be reused or released
+// while the file is kept
+func (file *DicomFile) Detach() {
+	file.LoadSequences()
+	file.Walk(func(path TagPath, elem *DicomElement) error {
+		switch v := elem.Value.(type) {
+		case Bytes:
+			elem.Value = append(Bytes(nil), v...)
+		case *PixelData:
+			for i, fragment := range v.Fragments {
+				v.Fragments[i] = append([]byte(nil), fragment...)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,61 @@
+package dicom
+
+import (
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+
+	parser, _ := NewParser()
+	data, err := parser.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clone := data.Clone()
+	if len(clone.Elements) != len(data.Elements) {
+		t.Fatalf("Incorrect number of elements: %d", len(clone.Elements))
+	}
+
+	// mutate nested values of the clone
+	elems, _ := clone.GetByPath("ReferencedStudySequence[0].ReferencedSOPInstanceUID")
+	elems[0].Value.(Strings)[0] = "1.2.3"
+
+	pixels, _ := clone.LookupElementByTag(TagPixelData)
+	fragment := pixels.Value.(*PixelData).Fragments[0]
+	fragment[0] = ^fragment[0]
+
+	elems, _ = data.GetByPath("ReferencedStudySequence[0].ReferencedSOPInstanceUID")
+	if elems[0].Value.(Strings)[0] == "1.2.3" {
+		t.Error("Mutating a cloned sequence item changed the original")
+	}
+
+	pixels, _ = data.LookupElementByTag(TagPixelData)
+	if pixels.Value.(*PixelData).Fragments[0][0] == fragment[0] {
+		t.Error("Mutating cloned pixel data changed the original")
+	}
+
+}
+
+func TestDetach(t *testing.T) {
+
+	buff := readFile()
+	parser, _ := NewParser()
+	data, err := parser.Parse(buff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pixels, _ := data.LookupElementByTag(TagPixelData)
+	fragment := append([]byte(nil), pixels.Value.(*PixelData).Fragments[0]...)
+
+	data.Detach()
+	for i := range buff {
+		buff[i] = 0
+	}
+
+	if got := pixels.Value.(*PixelData).Fragments[0]; string(got) != string(fragment) {
+		t.Error("Detached pixel data changed along with the buffer parsed")
+	}
+
+}
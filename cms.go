@@ -0,0 +1,196 @@
+package dicom
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var (
+	ErrNoRecipient           = errors.New("Content not encrypted for the certificate")
+	ErrUnsupportedEncryption = errors.New("Unsupported encryption")
+)
+
+// Object identifiers of RFC 5652, 3560 and 3565
+var (
+	oidData          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidEnvelopedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 3}
+	oidRSAEncryption = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidRSAESOAEP     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 7}
+	oidAES256CBC     = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+// The CMS structures of an EnvelopedData (RFC 5652 6.1), with key transport
+// recipients only
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue // [0] EXPLICIT
+}
+
+type envelopedData struct {
+	Version              int
+	RecipientInfos       []keyTransRecipientInfo `asn1:"set"`
+	EncryptedContentInfo encryptedContentInfo
+}
+
+type keyTransRecipientInfo struct {
+	Version                int
+	Recipient              issuerAndSerialNumber
+	KeyEncryptionAlgorithm algorithmIdentifier
+	EncryptedKey           []byte
+}
+
+type issuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type algorithmIdentifier struct {
+	Algorithm  asn1.ObjectIdentifier
+	Parameters asn1.RawValue `asn1:"optional"`
+}
+
+type encryptedContentInfo struct {
+	ContentType                asn1.ObjectIdentifier
+	ContentEncryptionAlgorithm algorithmIdentifier
+	EncryptedContent           []byte `asn1:"tag:0,optional"`
+}
+
+// Encrypt content as a CMS EnvelopedData for the RSA keys of the
+// certificates, with AES-256-CBC and a key transported with RSAES-OAEP
+func encryptCMS(content []byte, recipients []*x509.Certificate) ([]byte, error) {
+
+	key := make([]byte, 32)
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	// PKCS #7 padding
+	n := aes.BlockSize - len(content)%aes.BlockSize
+	padded := append(append([]byte(nil), content...), bytes.Repeat([]byte{byte(n)}, n)...)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(padded, padded)
+
+	ivParam, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+	// the default parameters of RSAES-OAEP, SHA-1 and MGF1 with SHA-1
+	oaepParams, err := asn1.Marshal(struct{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	data := envelopedData{
+		EncryptedContentInfo: encryptedContentInfo{
+			ContentType:                oidData,
+			ContentEncryptionAlgorithm: algorithmIdentifier{oidAES256CBC, asn1.RawValue{FullBytes: ivParam}},
+			EncryptedContent:           padded,
+		},
+	}
+
+	for _, cert := range recipients {
+		pub, ok := cert.PublicKey.(*rsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s key", ErrUnsupportedEncryption, cert.PublicKeyAlgorithm)
+		}
+		encrypted, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, pub, key, nil)
+		if err != nil {
+			return nil, err
+		}
+		data.RecipientInfos = append(data.RecipientInfos, keyTransRecipientInfo{
+			Recipient:              issuerAndSerialNumber{asn1.RawValue{FullBytes: cert.RawIssuer}, cert.SerialNumber},
+			KeyEncryptionAlgorithm: algorithmIdentifier{oidRSAESOAEP, asn1.RawValue{FullBytes: oaepParams}},
+			EncryptedKey:           encrypted,
+		})
+	}
+
+	inner, err := asn1.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(contentInfo{oidEnvelopedData, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: inner}})
+}
+
+// Decrypt a CMS EnvelopedData with the private key of a recipient
+func decryptCMS(der []byte, key crypto.Decrypter, cert *x509.Certificate) ([]byte, error) {
+
+	var info contentInfo
+	if _, err := asn1.Unmarshal(trimDER(der), &info); err != nil {
+		return nil, err
+	}
+	if !info.ContentType.Equal(oidEnvelopedData) {
+		return nil, fmt.Errorf("%w: content type %v", ErrUnsupportedEncryption, info.ContentType)
+	}
+	var data envelopedData
+	if _, err := asn1.Unmarshal(info.Content.Bytes, &data); err != nil {
+		return nil, err
+	}
+
+	var contentKey []byte
+	for _, recipient := range data.RecipientInfos {
+		if !bytes.Equal(recipient.Recipient.Issuer.FullBytes, cert.RawIssuer) || recipient.Recipient.SerialNumber.Cmp(cert.SerialNumber) != 0 {
+			continue
+		}
+		var opts crypto.DecrypterOpts
+		switch algorithm := recipient.KeyEncryptionAlgorithm.Algorithm; {
+		case algorithm.Equal(oidRSAESOAEP):
+			opts = &rsa.OAEPOptions{Hash: crypto.SHA1}
+		case algorithm.Equal(oidRSAEncryption):
+			opts = &rsa.PKCS1v15DecryptOptions{}
+		default:
+			return nil, fmt.Errorf("%w: key encryption %v", ErrUnsupportedEncryption, algorithm)
+		}
+		var err error
+		if contentKey, err = key.Decrypt(rand.Reader, recipient.EncryptedKey, opts); err != nil {
+			return nil, err
+		}
+		break
+	}
+	if contentKey == nil {
+		return nil, ErrNoRecipient
+	}
+
+	encrypted := data.EncryptedContentInfo
+	if !encrypted.ContentEncryptionAlgorithm.Algorithm.Equal(oidAES256CBC) {
+		return nil, fmt.Errorf("%w: content encryption %v", ErrUnsupportedEncryption, encrypted.ContentEncryptionAlgorithm.Algorithm)
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(encrypted.ContentEncryptionAlgorithm.Parameters.FullBytes, &iv); err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(contentKey)
+	if err != nil {
+		return nil, err
+	}
+	content := encrypted.EncryptedContent
+	if len(iv) != aes.BlockSize || len(content) == 0 || len(content)%aes.BlockSize != 0 {
+		return nil, ErrBrokenFile
+	}
+	content = append([]byte(nil), content...)
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(content, content)
+
+	n := int(content[len(content)-1])
+	if n == 0 || n > aes.BlockSize {
+		return nil, ErrBrokenFile
+	}
+	return content[:len(content)-n], nil
+}
@@ -0,0 +1,204 @@
+package dicom
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"sync"
+)
+
+// The attributes of the Image Pixel module describing the frames of an
+// image
+type FrameInfo struct {
+	Rows, Columns             int
+	SamplesPerPixel           int
+	BitsAllocated             int
+	BitsStored                int
+	Signed                    bool
+	PhotometricInterpretation string
+}
+
+// Decodes frames encoded with a transfer syntax. Decode returns the native
+// pixel data of a frame: its samples of BitsAllocated bits in little endian
+// order, color-by-pixel, as described by info.
+type PixelDecoder interface {
+	Decode(frame []byte, info FrameInfo) ([]byte, error)
+}
+
+// Encodes the native pixel data of a frame, as returned by PixelDecoder,
+// with a transfer syntax
+type PixelEncoder interface {
+	Encode(pixels []byte, info FrameInfo) ([]byte, error)
+}
+
+type pixelCodec struct {
+	decoder PixelDecoder
+	encoder PixelEncoder
+}
+
+var (
+	pixelCodecs   = map[string]pixelCodec{}
+	pixelCodecsMu sync.RWMutex
+)
+
+func init() {
+	RegisterPixelCodec(jpegBaseline, jpegDecoder{}, nil)
+	RegisterPixelCodec(jpegExtended, jpegDecoder{}, nil)
+}
+
+// Register the decoder and the encoder of an encapsulated transfer syntax,
+// ie. JPEG-LS, JPEG 2000 or RLE Lossless, replacing those registered before.
+// Either may be nil. JPEG Baseline and Extended 8-bit frames are decoded by
+// default with image/jpeg. Safe for concurrent use.
+func RegisterPixelCodec(transferSyntaxUID string, decoder PixelDecoder, encoder PixelEncoder) {
+	pixelCodecsMu.Lock()
+	defer pixelCodecsMu.Unlock()
+	pixelCodecs[transferSyntaxUID] = pixelCodec{decoder, encoder}
+}
+
+func lookupPixelCodec(transferSyntaxUID string) pixelCodec {
+	pixelCodecsMu.RLock()
+	defer pixelCodecsMu.RUnlock()
+	return pixelCodecs[transferSyntaxUID]
+}
+
+// The pixel data of an image: its frames, native or encoded with the
+// transfer syntax of the file, and how to decode them
+type ImageData struct {
+	TransferSyntaxUID string
+	Encapsulated      bool
+	Info              FrameInfo
+	Frames            [][]byte
+}
+
+// Return the pixel data of the file, split into frames
+func (file *DicomFile) ImageData() (*ImageData, error) {
+
+	attrs, err := file.imageAttrs()
+	if err != nil {
+		return nil, err
+	}
+	ts, err := file.lookupString(TagTransferSyntaxUID)
+	if err != nil {
+		return nil, err
+	}
+	frames, err := file.pixelFrames(attrs.frames)
+	if err != nil {
+		return nil, err
+	}
+
+	elem, _ := file.LookupElementByTag(TagPixelData)
+	_, encapsulated := elem.Value.(*PixelData)
+
+	return &ImageData{
+		TransferSyntaxUID: ts,
+		Encapsulated:      encapsulated,
+		Info:              attrs.frameInfo(),
+		Frames:            frames,
+	}, nil
+}
+
+// Return the description of the frames of the file by its Image Pixel
+// module, which holds without the pixel data, ie. in the metadata of an
+// instance
+func (file *DicomFile) FrameInfo() (FrameInfo, error) {
+	attrs, err := file.imageAttrs()
+	if err != nil {
+		return FrameInfo{}, err
+	}
+	return attrs.frameInfo(), nil
+}
+
+func (attrs *imageAttrs) frameInfo() FrameInfo {
+	return FrameInfo{
+		Rows:                      attrs.rows,
+		Columns:                   attrs.columns,
+		SamplesPerPixel:           attrs.samples,
+		BitsAllocated:             attrs.bitsAllocated,
+		BitsStored:                attrs.bitsStored,
+		Signed:                    attrs.signed,
+		PhotometricInterpretation: attrs.photometric,
+	}
+}
+
+// Return the native pixel data of the frames, decoded with the decoder
+// registered for the transfer syntax when encapsulated. Native frames are
+// returned as they are. Returns ErrUnsupportedTransferSyntax if no decoder
+// is registered.
+func (data *ImageData) DecodeFrames() ([][]byte, error) {
+
+	if !data.Encapsulated {
+		return data.Frames, nil
+	}
+
+	codec := lookupPixelCodec(data.TransferSyntaxUID)
+	if codec.decoder == nil {
+		return nil, fmt.Errorf("%w: no decoder for %s", ErrUnsupportedTransferSyntax, data.TransferSyntaxUID)
+	}
+
+	frames := make([][]byte, len(data.Frames))
+	for i, frame := range data.Frames {
+		var err error
+		if frames[i], err = codec.decoder.Decode(frame, data.Info); err != nil {
+			return nil, fmt.Errorf("frame %d: %w", i+1, err)
+		}
+	}
+
+	return frames, nil
+}
+
+// Encode native frames with the encoder registered for a transfer syntax,
+// as the encapsulated frames of an ImageData
+func EncodeFrames(frames [][]byte, info FrameInfo, transferSyntaxUID string) (*ImageData, error) {
+
+	codec := lookupPixelCodec(transferSyntaxUID)
+	if codec.encoder == nil {
+		return nil, fmt.Errorf("%w: no encoder for %s", ErrUnsupportedTransferSyntax, transferSyntaxUID)
+	}
+
+	data := &ImageData{TransferSyntaxUID: transferSyntaxUID, Encapsulated: true, Info: info}
+	for i, frame := range frames {
+		encoded, err := codec.encoder.Encode(frame, info)
+		if err != nil {
+			return nil, fmt.Errorf("frame %d: %w", i+1, err)
+		}
+		data.Frames = append(data.Frames, encoded)
+	}
+
+	return data, nil
+}
+
+// Decodes 8-bit JPEG Baseline and Extended frames with image/jpeg
+type jpegDecoder struct{}
+
+func (jpegDecoder) Decode(frame []byte, info FrameInfo) ([]byte, error) {
+
+	img, err := jpeg.Decode(bytes.NewReader(frame))
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	if bounds.Dx() != info.Columns || bounds.Dy() != info.Rows {
+		return nil, fmt.Errorf("%w: %dx%d frame of a %dx%d image", ErrUnsupportedImage, bounds.Dx(), bounds.Dy(), info.Columns, info.Rows)
+	}
+
+	if gray, ok := img.(*image.Gray); ok {
+		pixels := make([]byte, 0, bounds.Dx()*bounds.Dy())
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			pixels = append(pixels, gray.Pix[(y-bounds.Min.Y)*gray.Stride:][:bounds.Dx()]...)
+		}
+		return pixels, nil
+	}
+
+	// color frames as RGB
+	pixels := make([]byte, 0, 3*bounds.Dx()*bounds.Dy())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, _ := img.At(x, y).RGBA()
+			pixels = append(pixels, byte(r>>8), byte(g>>8), byte(b>>8))
+		}
+	}
+	return pixels, nil
+}
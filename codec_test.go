@@ -0,0 +1,169 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+)
+
+// A transfer syntax of the tests, encoding frames by inverting their bytes
+const invertedTransferSyntax = "1.2.826.0.1.3680043.9.7433.1.1"
+
+type invertCodec struct{}
+
+func (invertCodec) Decode(frame []byte, info FrameInfo) ([]byte, error) {
+	if len(frame) != info.Rows*info.Columns {
+		return nil, ErrPixelDataTruncated
+	}
+	return invert(frame), nil
+}
+
+func (invertCodec) Encode(pixels []byte, info FrameInfo) ([]byte, error) {
+	return invert(pixels), nil
+}
+
+func invert(b []byte) []byte {
+	inverted := make([]byte, len(b))
+	for i, v := range b {
+		inverted[i] = ^v
+	}
+	return inverted
+}
+
+// A 2x2 8-bit monochrome image of frames encoded with ts
+func encapsulatedImage(t *testing.T, ts string, frames ...[]byte) *DicomFile {
+	file := &DicomFile{}
+	for _, attr := range []itemValue{
+		{TagTransferSyntaxUID, Strings{ts}},
+		{TagSamplesPerPixel, UInt16s{1}},
+		{TagPhotometricInterpretation, Strings{"MONOCHROME2"}},
+		{TagNumberOfFrames, Strings{fmt.Sprint(len(frames))}},
+		{TagRows, UInt16s{2}},
+		{TagColumns, UInt16s{2}},
+		{TagBitsAllocated, UInt16s{8}},
+		{TagBitsStored, UInt16s{8}},
+		{TagHighBit, UInt16s{7}},
+		{TagPixelRepresentation, UInt16s{0}},
+		{TagPixelData, &PixelData{Fragments: frames}},
+	} {
+		if err := file.setValue(attr.tag, attr.value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return file
+}
+
+func TestPixelCodec(t *testing.T) {
+
+	frames := [][]byte{{0, 64, 128, 255}, {1, 2, 3, 4}}
+	file := encapsulatedImage(t, invertedTransferSyntax, invert(frames[0]), invert(frames[1]))
+
+	data, err := file.ImageData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !data.Encapsulated || len(data.Frames) != 2 || data.Info.Rows != 2 || data.Info.BitsAllocated != 8 {
+		t.Errorf("Incorrect image data %+v", data)
+	}
+	if _, err := data.DecodeFrames(); !errors.Is(err, ErrUnsupportedTransferSyntax) {
+		t.Errorf("Expected ErrUnsupportedTransferSyntax, got %v", err)
+	}
+
+	RegisterPixelCodec(invertedTransferSyntax, invertCodec{}, invertCodec{})
+	defer RegisterPixelCodec(invertedTransferSyntax, nil, nil)
+
+	decoded, err := data.DecodeFrames()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range frames {
+		if !bytes.Equal(decoded[i], frames[i]) {
+			t.Errorf("Frame %d: expected %v, got %v", i+1, frames[i], decoded[i])
+		}
+	}
+
+	encoded, err := EncodeFrames(decoded, data.Info, invertedTransferSyntax)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range frames {
+		if !bytes.Equal(encoded.Frames[i], data.Frames[i]) {
+			t.Errorf("Frame %d: incorrect encoding %v", i+1, encoded.Frames[i])
+		}
+	}
+
+	images, err := file.Images(RenderOptions{Window: Window{Center: 127.5, Width: 256}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 2 {
+		t.Fatalf("Expected 2 images, got %d", len(images))
+	}
+	if gray := images[0].(*image.Gray); gray.GrayAt(1, 1).Y != 255 || gray.GrayAt(0, 0).Y != 0 {
+		t.Errorf("Incorrect pixels %v", gray.Pix)
+	}
+}
+
+func TestJPEGDecoder(t *testing.T) {
+
+	img := image.NewGray(image.Rect(0, 0, 2, 2))
+	for i := range img.Pix {
+		img.Pix[i] = 128
+	}
+	buff := new(bytes.Buffer)
+	if err := jpeg.Encode(buff, img, &jpeg.Options{Quality: 100}); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := encapsulatedImage(t, jpegBaseline, buff.Bytes()).ImageData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	frames, err := data.DecodeFrames()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) != 1 || !bytes.Equal(frames[0], []byte{128, 128, 128, 128}) {
+		t.Errorf("Incorrect frames %v", frames)
+	}
+
+	rgb := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	for i := range rgb.Pix {
+		rgb.Pix[i] = 255
+	}
+	rgb.Set(0, 0, color.Black)
+	buff.Reset()
+	if err := jpeg.Encode(buff, rgb, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (jpegDecoder{}).Decode(buff.Bytes(), FrameInfo{Rows: 2, Columns: 2}); !errors.Is(err, ErrUnsupportedImage) {
+		t.Errorf("Expected ErrUnsupportedImage, got %v", err)
+	}
+}
+
+func TestExtendedOffsetTable(t *testing.T) {
+
+	// two frames in three fragments, without Basic Offset Table
+	file := encapsulatedImage(t, invertedTransferSyntax, []byte{0, 64}, []byte{128, 255}, []byte{1, 2, 3, 4})
+	if err := file.setValue(TagNumberOfFrames, Strings{"2"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.ImageData(); !errors.Is(err, ErrInconsistentFrames) {
+		t.Errorf("Expected ErrInconsistentFrames, got %v", err)
+	}
+
+	if err := file.setValue(TagExtendedOffsetTable, UInt64s{0, 20}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := file.ImageData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Frames) != 2 || !bytes.Equal(data.Frames[0], []byte{0, 64, 128, 255}) || !bytes.Equal(data.Frames[1], []byte{1, 2, 3, 4}) {
+		t.Errorf("Incorrect frames %v", data.Frames)
+	}
+}
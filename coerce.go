@@ -0,0 +1,214 @@
+package dicom
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var ErrInvalidCoercion = errors.New("Invalid coercion rule")
+
+// Rules changing the attributes of incoming data sets, ie. to fix the
+// AccessionNumbers of a modality or to map institution names, applied by a
+// storage SCP or a watch folder before storing them. Every rule whose
+// conditions hold is applied, in order.
+type Coercion struct {
+	Rules []*CoercionRule
+}
+
+// A rule of a Coercion: its conditions, and the changes it makes, applied in
+// the order of the fields. Paths are element paths as accepted by GetByPath.
+type CoercionRule struct {
+	Name string `json:"name,omitempty"`
+
+	// The sources the rule applies to, ie. the calling AE titles of a
+	// storage SCP; any source if empty
+	Sources []string `json:"sources,omitempty"`
+
+	// The data sets the rule applies to, every one if nil
+	When *Filter `json:"-"`
+
+	Remove []string            `json:"remove,omitempty"` // elements removed
+	Clear  []string            `json:"clear,omitempty"`  // elements kept, with an empty value
+	Map    []*CoercionMap      `json:"map,omitempty"`    // values replaced
+	Set    map[string][]string `json:"set,omitempty"`    // elements set, created if missing
+}
+
+// Replace the values of the elements at Path: values found in Values are
+// replaced with theirs, others matching Pattern are replaced with
+// Replacement, which may refer to the submatches as $1
+type CoercionMap struct {
+	Path        string            `json:"path"`
+	Values      map[string]string `json:"values,omitempty"`
+	Pattern     *regexp.Regexp    `json:"-"`
+	Replacement string            `json:"replacement,omitempty"`
+}
+
+// The JSON form of rules: filter expressions and patterns as strings
+type coercionRuleJSON struct {
+	CoercionRule
+	When string            `json:"when,omitempty"`
+	Map  []coercionMapJSON `json:"map,omitempty"`
+}
+
+type coercionMapJSON struct {
+	CoercionMap
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// Read the rules of a Coercion from a JSON array, ie.
+//
+//	[{"sources": ["CT01"], "when": "Modality == \"CT\"",
+//	  "map": [{"path": "AccessionNumber", "pattern": "^ACC-(\\d+)$", "replacement": "$1"}],
+//	  "set": {"InstitutionName": ["General Hospital"]}}]
+//
+// When is a filter expression as accepted by CompileFilter, and pattern a
+// regular expression.
+func ReadCoercion(r io.Reader) (*Coercion, error) {
+
+	var rules []coercionRuleJSON
+	if err := json.NewDecoder(r).Decode(&rules); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCoercion, err)
+	}
+
+	c := &Coercion{}
+	for i := range rules {
+		rule := rules[i].CoercionRule
+		if expr := rules[i].When; expr != "" {
+			filter, err := CompileFilter(expr)
+			if err != nil {
+				return nil, fmt.Errorf("%w: rule %d: %v", ErrInvalidCoercion, i, err)
+			}
+			rule.When = filter
+		}
+		for _, m := range rules[i].Map {
+			mapping := m.CoercionMap
+			if m.Pattern != "" {
+				re, err := regexp.Compile(m.Pattern)
+				if err != nil {
+					return nil, fmt.Errorf("%w: rule %d: %v", ErrInvalidCoercion, i, err)
+				}
+				mapping.Pattern = re
+			}
+			rule.Map = append(rule.Map, &mapping)
+		}
+		c.Rules = append(c.Rules, &rule)
+	}
+
+	return c, nil
+}
+
+// Apply the rules to a data set received from source, ie. the calling AE
+// title. Returns the names of the rules applied, and stops at the first
+// change that fails.
+func (c *Coercion) Apply(file *DicomFile, source string) ([]string, error) {
+
+	var applied []string
+	for i, rule := range c.Rules {
+		if !rule.matches(file, source) {
+			continue
+		}
+		name := rule.Name
+		if name == "" {
+			name = fmt.Sprintf("rule %d", i)
+		}
+		if err := rule.apply(file); err != nil {
+			return applied, fmt.Errorf("%s: %w", name, err)
+		}
+		applied = append(applied, name)
+	}
+
+	return applied, nil
+}
+
+func (rule *CoercionRule) matches(file *DicomFile, source string) bool {
+
+	if len(rule.Sources) > 0 {
+		found := false
+		for _, s := range rule.Sources {
+			found = found || strings.TrimSpace(s) == strings.TrimSpace(source)
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return rule.When == nil || rule.When.Match(file)
+}
+
+func (rule *CoercionRule) apply(file *DicomFile) error {
+
+	for _, path := range rule.Remove {
+		if _, err := file.DeleteByPath(path); err != nil {
+			return err
+		}
+	}
+
+	for _, path := range rule.Clear {
+		elems, err := file.GetByPath(path)
+		if err != nil && err != ErrNotFound {
+			return err
+		}
+		for _, elem := range elems {
+			if err := setFromStrings(elem, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, m := range rule.Map {
+		if err := m.apply(file); err != nil {
+			return err
+		}
+	}
+
+	paths := make([]string, 0, len(rule.Set))
+	for path := range rule.Set {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if err := file.SetByPath(path, rule.Set[path]...); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *CoercionMap) apply(file *DicomFile) error {
+
+	elems, err := file.GetByPath(m.Path)
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, elem := range elems {
+		values, ok := elem.Value.(Strings)
+		if !ok {
+			continue
+		}
+		mapped := make([]string, len(values))
+		for i, v := range values {
+			if to, ok := m.Values[v]; ok {
+				mapped[i] = to
+			} else if m.Pattern != nil && m.Pattern.MatchString(v) {
+				mapped[i] = m.Pattern.ReplaceAllString(v, m.Replacement)
+			} else {
+				mapped[i] = v
+			}
+		}
+		if err := elem.SetStrings(mapped...); err != nil {
+			return fmt.Errorf("%s: %w", m.Path, err)
+		}
+	}
+
+	return nil
+}
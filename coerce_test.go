@@ -0,0 +1,98 @@
+package dicom
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func coercionFile(t *testing.T) *DicomFile {
+	file := &DicomFile{}
+	for _, v := range [][2]string{
+		{"AccessionNumber", "ACC-1234"},
+		{"Modality", "CT"},
+		{"InstitutionName", "GENERAL HOSP"},
+		{"OperatorsName", "Smith^John"},
+		{"PatientID", "12345"},
+	} {
+		if err := file.SetByPath(v[0], v[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return file
+}
+
+func TestCoercion(t *testing.T) {
+
+	rules := `[
+		{"name": "accession", "sources": ["CT01"], "when": "Modality == \"CT\"",
+		 "map": [{"path": "AccessionNumber", "pattern": "^ACC-(\\d+)$", "replacement": "$1"}]},
+		{"name": "institution",
+		 "map": [{"path": "InstitutionName", "values": {"GENERAL HOSP": "General Hospital"}}],
+		 "set": {"StationName": ["CT01"]}},
+		{"name": "operators", "clear": ["OperatorsName"], "remove": ["PatientID"]},
+		{"name": "mr only", "when": "Modality == \"MR\"", "set": {"InstitutionName": ["MR"]}}
+	]`
+	c, err := ReadCoercion(strings.NewReader(rules))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file := coercionFile(t)
+	applied, err := c.Apply(file, "CT01 ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(applied, ",") != "accession,institution,operators" {
+		t.Errorf("Incorrect rules applied %v", applied)
+	}
+
+	for tag, value := range map[Tag]string{
+		TagAccessionNumber: "1234",
+		TagInstitutionName: "General Hospital",
+		TagStationName:     "CT01",
+		TagOperatorsName:   "",
+	} {
+		elem, err := file.LookupElementByTag(tag)
+		if err != nil {
+			t.Errorf("%s: %v", tag, err)
+			continue
+		}
+		if s, _ := elem.GetString(); s != value {
+			t.Errorf("Incorrect %s %q", tag, s)
+		}
+	}
+	if _, err := file.LookupElementByTag(TagPatientID); err == nil {
+		t.Errorf("PatientID not removed")
+	}
+
+	// rules restricted to a source are skipped for others
+	file = coercionFile(t)
+	if applied, err = c.Apply(file, "MR01"); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(applied, ",") != "institution,operators" {
+		t.Errorf("Incorrect rules applied %v", applied)
+	}
+	if s, _ := file.lookupString(TagAccessionNumber); s != "ACC-1234" {
+		t.Errorf("Incorrect AccessionNumber %q", s)
+	}
+}
+
+func TestInvalidCoercion(t *testing.T) {
+
+	for _, rules := range []string{
+		`{"name": "not an array"}`,
+		`[{"when": "Modality =="}]`,
+		`[{"map": [{"path": "AccessionNumber", "pattern": "("}]}]`,
+	} {
+		if _, err := ReadCoercion(strings.NewReader(rules)); !errors.Is(err, ErrInvalidCoercion) {
+			t.Errorf("%s: incorrect error %v", rules, err)
+		}
+	}
+
+	c := &Coercion{Rules: []*CoercionRule{{Name: "bad path", Set: map[string][]string{"NotAKeyword": {"x"}}}}}
+	if _, err := c.Apply(coercionFile(t), ""); err == nil || !strings.HasPrefix(err.Error(), "bad path") {
+		t.Errorf("Incorrect error %v", err)
+	}
+}
@@ -0,0 +1,214 @@
+package dicom
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Tolerances of the comparison of geometry attributes: of distances in mm,
+// and of direction cosines
+const (
+	distanceTolerance = 1e-2
+	cosineTolerance   = 1e-4
+)
+
+// An inconsistency between the instances of a series
+type SeriesProblem struct {
+	SOPInstanceUID string // the instance, empty for the whole series
+	Problem        string
+}
+
+// Stringer
+func (p SeriesProblem) String() string {
+	if p.SOPInstanceUID == "" {
+		return p.Problem
+	}
+	return p.SOPInstanceUID + ": " + p.Problem
+}
+
+// Check that the instances of the series can be stacked into a volume, as
+// CheckSeries does
+func (s *FileSetSeries) Check() []SeriesProblem {
+	files := make([]*DicomFile, len(s.Instances))
+	for i, f := range s.Instances {
+		files[i] = f.File
+	}
+	return CheckSeries(files)
+}
+
+// Check that the instances of a series can be stacked into a volume, ie.
+// before a 3D reconstruction or RT planning: SOPInstanceUIDs are unique,
+// InstanceNumbers have no gaps nor duplicates, and SeriesInstanceUID,
+// FrameOfReferenceUID, Rows, Columns, ImageOrientationPatient and
+// PixelSpacing are those of the first instance. The instances with an
+// ImagePositionPatient must be evenly spaced along the normal of their
+// orientation. Returns no problems for a consistent series.
+func CheckSeries(files []*DicomFile) []SeriesProblem {
+
+	if len(files) == 0 {
+		return nil
+	}
+
+	var problems []SeriesProblem
+	uids := make([]string, len(files))
+	seen := map[string]bool{}
+	for i, file := range files {
+		uids[i], _ = file.lookupString(TagSOPInstanceUID)
+		if seen[uids[i]] {
+			problems = append(problems, SeriesProblem{uids[i], "duplicate SOPInstanceUID"})
+		}
+		seen[uids[i]] = true
+	}
+
+	// attributes which must be those of the first instance
+	for _, tag := range []Tag{TagSeriesInstanceUID, TagFrameOfReferenceUID, TagRows, TagColumns} {
+		first := lookupValue(files[0], tag)
+		for i, file := range files[1:] {
+			if v := lookupValue(file, tag); v != first {
+				problems = append(problems, SeriesProblem{uids[i+1], fmt.Sprintf("%s %q, %q in the first instance", tag, v, first)})
+			}
+		}
+	}
+	for _, attr := range []struct {
+		tag       Tag
+		n         int
+		tolerance float64
+	}{
+		{TagImageOrientationPatient, 6, cosineTolerance},
+		{TagPixelSpacing, 2, distanceTolerance},
+	} {
+		first, _ := files[0].lookupDecimals(attr.tag, attr.n)
+		for i, file := range files[1:] {
+			v, _ := file.lookupDecimals(attr.tag, attr.n)
+			if !decimalsEqual(v, first, attr.tolerance) {
+				problems = append(problems, SeriesProblem{uids[i+1], fmt.Sprintf("%s %v, %v in the first instance", attr.tag, v, first)})
+			}
+		}
+	}
+
+	problems = append(problems, checkInstanceNumbers(files, uids)...)
+	problems = append(problems, checkSliceSpacing(files, uids)...)
+
+	return problems
+}
+
+// The value of an element as a string, for comparisons
+func lookupValue(file *DicomFile, tag Tag) string {
+	elem, err := file.LookupElementByTag(tag)
+	if err != nil || elem.Value == nil {
+		return ""
+	}
+	if s, ok := elem.Value.(Strings); ok {
+		values := make([]string, len(s))
+		for i, v := range s {
+			values[i] = strings.TrimSpace(strings.TrimRight(v, "\x00"))
+		}
+		return strings.Join(values, "\\")
+	}
+	return fmt.Sprint(elem.Value)
+}
+
+func decimalsEqual(a, b []float64, tolerance float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if math.Abs(a[i]-b[i]) > tolerance {
+			return false
+		}
+	}
+	return true
+}
+
+// Report duplicate InstanceNumbers, and the numbers missing between the
+// lowest and the highest
+func checkInstanceNumbers(files []*DicomFile, uids []string) []SeriesProblem {
+
+	var problems []SeriesProblem
+	numbers := map[int]bool{}
+	for i, file := range files {
+		n, ok, err := file.lookupInt(TagInstanceNumber)
+		if err != nil || !ok {
+			problems = append(problems, SeriesProblem{uids[i], "missing or invalid InstanceNumber"})
+			continue
+		}
+		if numbers[n] {
+			problems = append(problems, SeriesProblem{uids[i], fmt.Sprintf("duplicate InstanceNumber %d", n)})
+		}
+		numbers[n] = true
+	}
+	if len(numbers) == 0 {
+		return problems
+	}
+
+	sorted := make([]int, 0, len(numbers))
+	for n := range numbers {
+		sorted = append(sorted, n)
+	}
+	sort.Ints(sorted)
+	for i := 1; i < len(sorted); i++ {
+		if gap := sorted[i] - sorted[i-1]; gap > 1 {
+			problems = append(problems, SeriesProblem{"", fmt.Sprintf("InstanceNumbers %d to %d missing", sorted[i-1]+1, sorted[i]-1)})
+		}
+	}
+
+	return problems
+}
+
+// Report slices at the same position, or with a spacing other than the
+// smallest one, along the normal of the orientation of the first instance
+func checkSliceSpacing(files []*DicomFile, uids []string) []SeriesProblem {
+
+	orientation, err := files[0].lookupDecimals(TagImageOrientationPatient, 6)
+	if err != nil {
+		return nil
+	}
+	normal := cross(
+		[3]float64{orientation[0], orientation[1], orientation[2]},
+		[3]float64{orientation[3], orientation[4], orientation[5]},
+	)
+
+	type slice struct {
+		uid      string
+		distance float64
+	}
+	var slices []slice
+	for i, file := range files {
+		if p, err := file.lookupDecimals(TagImagePositionPatient, 3); err == nil {
+			slices = append(slices, slice{uids[i], dot(normal, [3]float64{p[0], p[1], p[2]})})
+		}
+	}
+	if len(slices) < 2 {
+		return nil
+	}
+	sort.Slice(slices, func(i, j int) bool { return slices[i].distance < slices[j].distance })
+
+	var problems []SeriesProblem
+	spacing := math.Inf(1)
+	for i := 1; i < len(slices); i++ {
+		d := slices[i].distance - slices[i-1].distance
+		if d <= distanceTolerance {
+			problems = append(problems, SeriesProblem{slices[i].uid, "ImagePositionPatient of " + slices[i-1].uid})
+		} else if d < spacing {
+			spacing = d
+		}
+	}
+	for i := 1; i < len(slices); i++ {
+		d := slices[i].distance - slices[i-1].distance
+		if d > distanceTolerance && d-spacing > distanceTolerance {
+			problems = append(problems, SeriesProblem{slices[i].uid, fmt.Sprintf("slice spacing %g, %g elsewhere", d, spacing)})
+		}
+	}
+
+	return problems
+}
+
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
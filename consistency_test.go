@@ -0,0 +1,65 @@
+package dicom
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// A series of n axial slices, 2.5 mm apart
+func makeSeries(t *testing.T, n int) []*DicomFile {
+
+	files := make([]*DicomFile, n)
+	for i := range files {
+		file := &DicomFile{}
+		for path, values := range map[string][]string{
+			"SOPInstanceUID":          {fmt.Sprintf("1.2.3.%d", i+1)},
+			"SeriesInstanceUID":       {"1.2.3"},
+			"FrameOfReferenceUID":     {"1.2.4"},
+			"InstanceNumber":          {fmt.Sprint(i + 1)},
+			"ImageOrientationPatient": {"1", "0", "0", "0", "1", "0"},
+			"ImagePositionPatient":    {"-100", "-100", fmt.Sprint(2.5 * float64(i))},
+			"PixelSpacing":            {"0.5", "0.5"},
+		} {
+			if err := file.SetByPath(path, values...); err != nil {
+				t.Fatal(err)
+			}
+		}
+		files[i] = file
+	}
+
+	return files
+}
+
+func TestCheckSeries(t *testing.T) {
+
+	if problems := CheckSeries(makeSeries(t, 5)); len(problems) > 0 {
+		t.Errorf("Problems in a consistent series: %v", problems)
+	}
+
+	files := makeSeries(t, 5)
+	files[1].SetByPath("FrameOfReferenceUID", "1.2.5")
+	files[2].SetByPath("PixelSpacing", "0.5", "0.6")
+	files[3].SetByPath("SOPInstanceUID", "1.2.3.1")
+	files = append(files[:4:4], makeSeries(t, 7)[6])
+
+	var got []string
+	for _, p := range CheckSeries(files) {
+		got = append(got, p.String())
+	}
+	for _, want := range []string{
+		"1.2.3.2: (0020,0052)",
+		"1.2.3.3: (0028,0030)",
+		"1.2.3.1: duplicate SOPInstanceUID",
+		"InstanceNumbers 5 to 6 missing",
+		"1.2.3.7: slice spacing 7.5, 2.5 elsewhere",
+	} {
+		found := false
+		for _, p := range got {
+			found = found || strings.HasPrefix(p, want)
+		}
+		if !found {
+			t.Errorf("Problem %q not reported in %v", want, got)
+		}
+	}
+}
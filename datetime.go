@@ -0,0 +1,207 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidDateTime = errors.New("Invalid DT value")
+	ErrInvalidTimezone = errors.New("Invalid timezone offset")
+)
+
+// Return the timezone of the dates and times of the file, from its
+// TimezoneOffsetFromUTC, or UTC if absent
+func (file *DicomFile) Location() (*time.Location, error) {
+
+	offset, err := file.lookupString(TagTimezoneOffsetFromUTC)
+	if err != nil {
+		return nil, err
+	}
+	if offset == "" {
+		return time.UTC, nil
+	}
+
+	return parseOffset(offset)
+}
+
+// Return the time of a DA, TM or DT element. DA and TM elements are combined
+// with their TM or DA counterpart, ie. StudyDate and StudyTime, and are in
+// the timezone of the file. DT values are in the timezone of their own
+// offset, or else of the file.
+func (file *DicomFile) LookupTime(tag Tag) (time.Time, error) {
+
+	elem, err := file.LookupElementByTag(tag)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrNotFound, tag)
+	}
+	loc, err := file.Location()
+	if err != nil {
+		return time.Time{}, err
+	}
+	s, err := file.lookupString(tag)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	date, tm := s, ""
+	switch elem.Vr {
+	case "DT":
+		return parseDateTime(s, loc)
+	case "DA":
+		if other, ok := counterpart(tag, "DA"); ok {
+			if tm, err = file.lookupString(other); err != nil {
+				return time.Time{}, err
+			}
+		}
+	case "TM":
+		other, ok := counterpart(tag, "TM")
+		if !ok || file.indexOf(other) < 0 {
+			return time.Time{}, fmt.Errorf("%w: date of %s", ErrNotFound, tag)
+		}
+		tm = s
+		if date, err = file.lookupString(other); err != nil {
+			return time.Time{}, err
+		}
+	default:
+		return time.Time{}, fmt.Errorf("%w: %s is %s", ErrWrongValueType, tag, elem.Vr)
+	}
+
+	d, err := parseDate(date)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc)
+	if tm != "" {
+		since, err := parseTime(tm)
+		if err != nil {
+			return time.Time{}, err
+		}
+		t = t.Add(since)
+	}
+
+	return t, nil
+}
+
+// Set a DA, TM or DT element to t. DA and TM elements are set along with
+// their TM or DA counterpart, in the timezone of the file, which is set to
+// the timezone of t if the file has no TimezoneOffsetFromUTC. DT values are
+// written with the offset of t.
+func (file *DicomFile) SetTime(tag Tag, t time.Time) error {
+
+	entry, err := standardParser().getDictEntry(tag.Group, tag.Element)
+	if err != nil {
+		return err
+	}
+	if entry.vr == "DT" {
+		return file.setValue(tag, Strings{FormatDT(t)})
+	}
+	if entry.vr != "DA" && entry.vr != "TM" {
+		return fmt.Errorf("%w: %s is %s", ErrWrongValueType, tag, entry.vr)
+	}
+
+	if file.indexOf(TagTimezoneOffsetFromUTC) < 0 {
+		if err := file.setValue(TagTimezoneOffsetFromUTC, Strings{t.Format("-0700")}); err != nil {
+			return err
+		}
+	} else {
+		loc, err := file.Location()
+		if err != nil {
+			return err
+		}
+		t = t.In(loc)
+	}
+
+	layouts := map[string]string{"DA": "20060102", "TM": "150405.000000"}
+	if err := file.setValue(tag, Strings{t.Format(layouts[entry.vr])}); err != nil {
+		return err
+	}
+	other, ok := counterpart(tag, entry.vr)
+	if !ok {
+		return nil
+	}
+	otherVR := "TM"
+	if entry.vr == "TM" {
+		otherVR = "DA"
+	}
+	return file.setValue(other, Strings{t.Format(layouts[otherVR])})
+}
+
+// Format t as a DT value with its timezone offset,
+// YYYYMMDDHHMMSS.FFFFFF&ZZXX
+func FormatDT(t time.Time) string {
+	return t.Format("20060102150405.000000-0700")
+}
+
+// Parse a DT value, YYYY[MM[DD[HH[MM[SS[.F{1-6}]]]]]][&ZZXX], in the timezone
+// of its offset, or else of loc
+func parseDateTime(s string, loc *time.Location) (time.Time, error) {
+
+	if i := strings.LastIndexAny(s, "+-"); i >= 4 {
+		offset, err := parseOffset(s[i:])
+		if err != nil {
+			return time.Time{}, ErrInvalidDateTime
+		}
+		s, loc = s[:i], offset
+	}
+
+	frac := ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s, frac = s[:i], s[i:]
+	}
+	if len(s) < 4 || len(s) > 14 || len(s)%2 != 0 || frac != "" && (len(s) != 14 || len(frac) < 2 || len(frac) > 7) {
+		return time.Time{}, ErrInvalidDateTime
+	}
+
+	// the missing components are the first month, day, and so on
+	t, err := time.ParseInLocation("20060102150405", s+"0101000000"[len(s)-4:]+frac, loc)
+	if err != nil {
+		return time.Time{}, ErrInvalidDateTime
+	}
+	return t, nil
+}
+
+// Parse a timezone offset, &ZZXX, as a fixed timezone
+func parseOffset(s string) (*time.Location, error) {
+
+	if len(s) != 5 || s[0] != '+' && s[0] != '-' {
+		return nil, ErrInvalidTimezone
+	}
+	hours, err := strconv.Atoi(s[1:3])
+	if err != nil || hours > 14 {
+		return nil, ErrInvalidTimezone
+	}
+	minutes, err := strconv.Atoi(s[3:])
+	if err != nil || minutes > 59 {
+		return nil, ErrInvalidTimezone
+	}
+
+	offset := hours*3600 + minutes*60
+	if s[0] == '-' {
+		offset = -offset
+	}
+	return time.FixedZone(s, offset), nil
+}
+
+// Return the TM tag of a DA tag, or the DA tag of a TM tag, by their names in
+// the dictionary, ie. StudyTime for StudyDate
+func counterpart(tag Tag, vr string) (Tag, bool) {
+
+	entry, err := standardParser().getDictEntry(tag.Group, tag.Element)
+	if err != nil {
+		return Tag{}, false
+	}
+	suffix, other := "Date", "Time"
+	if vr == "TM" {
+		suffix, other = other, suffix
+	}
+	if !strings.HasSuffix(entry.name, suffix) {
+		return Tag{}, false
+	}
+
+	t, ok := standardNames()[strings.TrimSuffix(entry.name, suffix)+other]
+	return t, ok
+}
@@ -0,0 +1,110 @@
+package dicom
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLookupTime(t *testing.T) {
+
+	// the same instant acquired in Paris and in New York
+	paris, newYork := &DicomFile{}, &DicomFile{}
+	for _, attr := range []struct {
+		file  *DicomFile
+		tag   Tag
+		value string
+	}{
+		{paris, TagTimezoneOffsetFromUTC, "+0100"},
+		{paris, TagAcquisitionDate, "20240115"},
+		{paris, TagAcquisitionTime, "153000.25"},
+		{paris, TagAcquisitionDateTime, "20240115153000.25"},
+		{newYork, TagTimezoneOffsetFromUTC, "-0500"},
+		{newYork, TagAcquisitionDate, "20240115"},
+		{newYork, TagAcquisitionTime, "093000.25"},
+		{newYork, TagAcquisitionDateTime, "20240115153000.25+0100"},
+	} {
+		if err := attr.file.setValue(attr.tag, Strings{attr.value}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := time.Date(2024, 1, 15, 14, 30, 0, 250000000, time.UTC)
+	for _, file := range []*DicomFile{paris, newYork} {
+		for _, tag := range []Tag{TagAcquisitionDate, TagAcquisitionTime, TagAcquisitionDateTime} {
+			tm, err := file.LookupTime(tag)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tm.Equal(expected) {
+				t.Errorf("%s: expected %v, got %v", tag, expected, tm)
+			}
+		}
+	}
+
+	// UTC without TimezoneOffsetFromUTC
+	file := &DicomFile{}
+	file.setValue(TagStudyDate, Strings{"20240115"})
+	if tm, err := file.LookupTime(TagStudyDate); err != nil || !tm.Equal(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected midnight UTC, got %v, %v", tm, err)
+	}
+	file.setValue(TagTimezoneOffsetFromUTC, Strings{"0100"})
+	if _, err := file.LookupTime(TagStudyDate); !errors.Is(err, ErrInvalidTimezone) {
+		t.Errorf("Expected ErrInvalidTimezone, got %v", err)
+	}
+}
+
+func TestSetTime(t *testing.T) {
+
+	tm := time.Date(2024, 7, 1, 8, 5, 0, 0, time.FixedZone("", 2*3600))
+
+	file := &DicomFile{}
+	if err := file.SetTime(TagContentDate, tm); err != nil {
+		t.Fatal(err)
+	}
+	if err := file.SetTime(TagAcquisitionDateTime, tm.UTC()); err != nil {
+		t.Fatal(err)
+	}
+	for tag, expected := range map[Tag]string{
+		TagTimezoneOffsetFromUTC: "+0200",
+		TagContentDate:           "20240701",
+		TagContentTime:           "080500.000000",
+		TagAcquisitionDateTime:   "20240701060500.000000+0000",
+	} {
+		if s, _ := file.lookupString(tag); s != expected {
+			t.Errorf("Expected %s %s, got %s", tag, expected, s)
+		}
+	}
+
+	// converted to the timezone of the file
+	if err := file.SetTime(TagStudyTime, tm.UTC()); err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := file.lookupString(TagStudyTime); s != "080500.000000" {
+		t.Errorf("Incorrect study time %s", s)
+	}
+	if back, err := file.LookupTime(TagStudyDate); err != nil || !back.Equal(tm) {
+		t.Errorf("Expected %v, got %v, %v", tm, back, err)
+	}
+}
+
+func TestParseDateTime(t *testing.T) {
+
+	for s, expected := range map[string]time.Time{
+		"2024":                       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		"202407":                     time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+		"2024070108":                 time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC),
+		"20240701080530.5-0330":      time.Date(2024, 7, 1, 11, 35, 30, 500000000, time.UTC),
+		"20240701080530.123456+0000": time.Date(2024, 7, 1, 8, 5, 30, 123456000, time.UTC),
+	} {
+		if tm, err := parseDateTime(s, time.UTC); err != nil || !tm.Equal(expected) {
+			t.Errorf("%s: expected %v, got %v, %v", s, expected, tm, err)
+		}
+	}
+
+	for _, s := range []string{"202", "20241301", "2024070108.5", "20240701080530.1234567", "20240701+01"} {
+		if _, err := parseDateTime(s, time.UTC); err != ErrInvalidDateTime {
+			t.Errorf("%s: expected ErrInvalidDateTime, got %v", s, err)
+		}
+	}
+}
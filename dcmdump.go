@@ -0,0 +1,369 @@
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Column widths of the dcmdump output (DCM_OptPrintValueLength and
+// DCM_OptPrintLineLength of DCMTK)
+const (
+	dcmdumpValueWidth = 40
+	dcmdumpLineWidth  = 70
+)
+
+// Names given by dcmdump to the transfer syntaxes, in the headers
+var dcmdumpTransferSyntaxes = map[string]string{
+	implicit_vr_little_endian: "Little Endian Implicit",
+	explicit_vr_little_endian: "Little Endian Explicit",
+	explicit_vr_big_endian:    "Big Endian Explicit",
+	deflated_explicit_vr_le:   "Deflated Explicit VR Little Endian",
+	"1.2.840.10008.1.2.4.50":  "JPEG Baseline",
+	"1.2.840.10008.1.2.4.51":  "JPEG Extended, Process 2+4",
+	"1.2.840.10008.1.2.4.57":  "JPEG Lossless, Non-hierarchical, Process 14",
+	"1.2.840.10008.1.2.4.70":  "JPEG Lossless, Non-hierarchical, 1st Order Prediction",
+	"1.2.840.10008.1.2.4.80":  "JPEG-LS Lossless",
+	"1.2.840.10008.1.2.4.81":  "JPEG-LS Lossy (Near-lossless)",
+	"1.2.840.10008.1.2.4.90":  "JPEG 2000 (Lossless only)",
+	"1.2.840.10008.1.2.4.91":  "JPEG 2000",
+	"1.2.840.10008.1.2.5":     "RLE Lossless",
+}
+
+// Names dcmdump prints for well-known UIDs, besides the storage SOP classes
+var dcmdumpUIDNames = map[string]string{
+	implicit_vr_little_endian:    "LittleEndianImplicit",
+	explicit_vr_little_endian:    "LittleEndianExplicit",
+	explicit_vr_big_endian:       "BigEndianExplicit",
+	deflated_explicit_vr_le:      "DeflatedLittleEndianExplicit",
+	"1.2.840.10008.1.2.4.50":     "JPEGBaseline",
+	"1.2.840.10008.1.2.4.51":     "JPEGExtended:Process2+4",
+	"1.2.840.10008.1.2.4.57":     "JPEGLossless:Non-hierarchical:Process14",
+	"1.2.840.10008.1.2.4.70":     "JPEGLossless:Non-hierarchical-1stOrderPrediction",
+	"1.2.840.10008.1.2.4.80":     "JPEGLSLossless",
+	"1.2.840.10008.1.2.4.81":     "JPEGLSLossy",
+	"1.2.840.10008.1.2.4.90":     "JPEG2000LosslessOnly",
+	"1.2.840.10008.1.2.4.91":     "JPEG2000",
+	"1.2.840.10008.1.2.5":        "RLELossless",
+	"1.2.840.10008.1.1":          "VerificationSOPClass",
+	mediaStorageDirectoryStorage: "MediaStorageDirectoryStorage",
+}
+
+// The name dcmdump prints for a UID: the names of the storage SOP classes
+// are those of the standard without spaces nor dashes, ie. CTImageStorage
+func dcmdumpUIDName(uid string) (string, bool) {
+
+	if name, ok := dcmdumpUIDNames[uid]; ok {
+		return name, true
+	}
+
+	if iod, ok := sopClasses[uid]; ok {
+		return strings.NewReplacer(" ", "", "-", "").Replace(iod.Name), true
+	}
+
+	return "", false
+}
+
+// Write the file in the format of the dcmdump tool of DCMTK, with its
+// default options: one element per line with the value, length, VM and name
+// columns, nested items indented and marked with delimitation lines, and
+// values longer than 70 characters shortened. Known UIDs are printed as
+// names, ie. =CTImageStorage.
+//
+// Items are printed with the length of their sequence, which is not kept
+// by the parser.
+func (file *DicomFile) WriteDcmdump(w io.Writer) error {
+
+	if err := file.parseSequences(); err != nil {
+		return err
+	}
+	bo, implicit, err := file.getTransferSyntax()
+	if err != nil {
+		return err
+	}
+	ts, _ := file.lookupString(TagTransferSyntaxUID)
+	tsName := dcmdumpTransferSyntaxes[ts]
+	if tsName == "" {
+		tsName = ts
+	}
+
+	d := &dcmdumper{buf: new(bytes.Buffer), bo: bo, implicit: implicit, charset: file.characterSet()}
+	d.buf.WriteString("\n# Dicom-File Format\n")
+
+	inMeta := false
+	for i := range file.Elements {
+		elem := &file.Elements[i]
+		if elem.Group == 0x0002 && !inMeta {
+			inMeta = true
+			d.buf.WriteString("\n# Dicom-Meta-Information-Header\n# Used TransferSyntax: Little Endian Explicit\n")
+			d.bo, d.implicit = binary.LittleEndian, false
+		}
+		if elem.Group != 0x0002 && !d.dataSet {
+			d.buf.WriteString("\n# Dicom-Data-Set\n# Used TransferSyntax: " + tsName + "\n")
+			d.bo, d.implicit = bo, implicit
+			d.dataSet = true
+		}
+		d.element(elem, 0)
+	}
+	if !d.dataSet {
+		d.buf.WriteString("\n# Dicom-Data-Set\n# Used TransferSyntax: " + tsName + "\n")
+	}
+
+	_, err = w.Write(d.buf.Bytes())
+	return err
+}
+
+type dcmdumper struct {
+	buf      *bytes.Buffer
+	bo       binary.ByteOrder
+	implicit bool
+	charset  *characterSet
+	dataSet  bool // whether the data set header was written
+}
+
+// Encode a value as written in the file
+func (d *dcmdumper) encodeValue(elem *DicomElement, vr string) ([]byte, error) {
+	writer := newDicomWriter(d.bo, d.implicit)
+	writer.charset = d.charset
+	return writer.encodeValue(elem, vr)
+}
+
+// Write a line: the tag and VR, the value padded to its column, the length,
+// VM and name
+func (d *dcmdumper) line(level int, tag Tag, vr, value, length string, vm int, name string) {
+
+	if len(value) < dcmdumpValueWidth {
+		value += strings.Repeat(" ", dcmdumpValueWidth-len(value))
+	}
+	fmt.Fprintf(d.buf, "%s(%04x,%04x) %s %s # %3s,%2d %s\n",
+		strings.Repeat("  ", level), tag.Group, tag.Element, vr, value, length, vm, name)
+}
+
+func (d *dcmdumper) element(elem *DicomElement, level int) {
+
+	tag := elem.Tag()
+	vr := writtenVR(elem)
+	name := elem.Name
+	if name == "" || name == unknown_group_name || name == private_group_name {
+		name = "Unknown Tag & Data"
+		if tag.Group%2 == 1 && tag.Element >= 0x0010 && tag.Element <= 0x00ff {
+			name = "PrivateCreator"
+		}
+	}
+
+	switch v := elem.value().(type) {
+	case Sequence:
+		d.sequence(elem, v, level, name)
+		return
+	case *PixelData:
+		d.pixelSequence(v, level, vr, name)
+		return
+	}
+
+	encoded, err := d.encodeValue(elem, vr)
+	length := strconv.Itoa(len(encoded))
+	if err != nil {
+		length = "?"
+	}
+
+	value, vm := d.value(elem, vr)
+	d.line(level, tag, vr, value, length, vm, name)
+}
+
+// Format a value the way dcmdump does, returning it with its VM
+func (d *dcmdumper) value(elem *DicomElement, vr string) (string, int) {
+
+	var values []string
+	switch v := elem.Value.(type) {
+	case Strings:
+		for _, s := range v {
+			values = append(values, strings.TrimRight(s, " \x00"))
+		}
+		if strings.Join(values, "") == "" {
+			return "(no value available)", 0
+		}
+		if vr == "UI" && len(values) == 1 {
+			if name, ok := dcmdumpUIDName(values[0]); ok {
+				return "=" + name, 1
+			}
+		}
+		s := "[" + strings.Join(values, "\\") + "]"
+		if len(s) > dcmdumpLineWidth {
+			s = s[:dcmdumpLineWidth-4] + "..."
+		}
+		return s, len(values)
+	case UInt16s:
+		for _, u := range v {
+			values = append(values, strconv.FormatUint(uint64(u), 10))
+		}
+	case Int16s:
+		for _, i := range v {
+			values = append(values, strconv.FormatInt(int64(i), 10))
+		}
+	case UInt32s:
+		for _, u := range v {
+			values = append(values, strconv.FormatUint(uint64(u), 10))
+		}
+	case Int32s:
+		for _, i := range v {
+			values = append(values, strconv.FormatInt(int64(i), 10))
+		}
+	case UInt64s:
+		for _, u := range v {
+			values = append(values, strconv.FormatUint(u, 10))
+		}
+	case Int64s:
+		for _, i := range v {
+			values = append(values, strconv.FormatInt(i, 10))
+		}
+	case Float32s:
+		for _, f := range v {
+			values = append(values, strconv.FormatFloat(float64(f), 'g', 8, 32))
+		}
+	case Float64s:
+		for _, f := range v {
+			values = append(values, strconv.FormatFloat(f, 'g', 17, 64))
+		}
+	case Tags:
+		for _, t := range v {
+			values = append(values, fmt.Sprintf("(%04x,%04x)", t.Group, t.Element))
+		}
+	case Bytes:
+		if len(v) == 0 {
+			return "(no value available)", 0
+		}
+		return hexValues(v, vr, d.bo), 1
+	}
+
+	if len(values) == 0 {
+		return "(no value available)", 0
+	}
+
+	return shortenValue(strings.Join(values, "\\")), len(values)
+}
+
+// Bytes as hexadecimal words for OW, or bytes otherwise, shortened to the
+// values that fit on the line
+func hexValues(b []byte, vr string, bo binary.ByteOrder) string {
+
+	size := 1
+	if vr == "OW" {
+		size = 2
+	}
+	max := (dcmdumpLineWidth - 3) / (2*size + 1)
+
+	var values []string
+	for i := 0; i+size <= len(b) && len(values) < max; i += size {
+		if size == 2 {
+			values = append(values, fmt.Sprintf("%04x", bo.Uint16(b[i:])))
+		} else {
+			values = append(values, fmt.Sprintf("%02x", b[i]))
+		}
+	}
+
+	s := strings.Join(values, "\\")
+	if len(b) > max*size {
+		s += "..."
+	}
+	return s
+}
+
+func shortenValue(s string) string {
+	if len(s) > dcmdumpLineWidth {
+		return s[:dcmdumpLineWidth-4] + "..."
+	}
+	return s
+}
+
+func (d *dcmdumper) sequence(elem *DicomElement, seq Sequence, level int, name string) {
+
+	kind, length := "undefined", "u/l"
+	if !elem.undefLen {
+		kind, length = "explicit", strconv.Itoa(int(elem.Vl))
+	}
+	d.line(level, elem.Tag(), "SQ", fmt.Sprintf("(Sequence with %s length #=%d)", kind, len(seq)), length, 1, name)
+
+	for _, item := range seq {
+		itemLength := "u/l"
+		if !elem.undefLen {
+			itemLength = strconv.Itoa(d.itemLength(item))
+		}
+		d.line(level+1, TagItem, "na", fmt.Sprintf("(Item with %s length #=%d)", kind, len(item.Elements)), itemLength, 1, "Item")
+		for _, child := range item.Elements {
+			d.element(child, level+2)
+		}
+		marker := "(ItemDelimitationItem)"
+		if !elem.undefLen {
+			marker = "(ItemDelimitationItem for re-encoding)"
+		}
+		d.line(level+1, TagItemDelimitationItem, "na", marker, "0", 0, "ItemDelimitationItem")
+	}
+
+	marker := "(SequenceDelimitationItem)"
+	if !elem.undefLen {
+		marker = "(SequenceDelimitationItem for re-encod.)"
+	}
+	d.line(level, TagSequenceDelimitationItem, "na", marker, "0", 0, "SequenceDelimitationItem")
+}
+
+func (d *dcmdumper) pixelSequence(pixels *PixelData, level int, vr, name string) {
+
+	d.line(level, TagPixelData, vr, fmt.Sprintf("(PixelSequence #=%d)", len(pixels.Fragments)+1), "u/l", 1, name)
+
+	offsets := make([]byte, 4*len(pixels.Offsets))
+	for i, offset := range pixels.Offsets {
+		binary.LittleEndian.PutUint32(offsets[4*i:], offset)
+	}
+	for _, fragment := range append([][]byte{offsets}, pixels.Fragments...) {
+		value := "(no value available)"
+		if len(fragment) > 0 {
+			value = hexValues(fragment, "OB", d.bo)
+		}
+		d.line(level+1, TagItem, "pi", value, strconv.Itoa(len(padBytes(fragment))), 1, "Item")
+	}
+
+	d.line(level, TagSequenceDelimitationItem, "na", "(SequenceDelimitationItem)", "0", 0, "SequenceDelimitationItem")
+}
+
+// The encoded length of the elements of an item, with the lengths of
+// explicit length sequences as read
+func (d *dcmdumper) itemLength(item *Item) int {
+	length := 0
+	for _, elem := range item.Elements {
+		length += d.encodedLength(elem)
+	}
+	return length
+}
+
+func (d *dcmdumper) encodedLength(elem *DicomElement) int {
+
+	vr := writtenVR(elem)
+	header := 8
+	if !d.implicit && isLongVR(vr) {
+		header = 12
+	}
+
+	switch v := elem.value().(type) {
+	case Sequence:
+		if !elem.undefLen {
+			return header + int(elem.Vl)
+		}
+		length := header + 8
+		for _, item := range v {
+			length += 16 + d.itemLength(item)
+		}
+		return length
+	case *PixelData:
+		length := header + 16 + 4*len(v.Offsets)
+		for _, fragment := range v.Fragments {
+			length += 8 + len(padBytes(fragment))
+		}
+		return length
+	}
+
+	value, _ := d.encodeValue(elem, vr)
+	return header + len(value)
+}
@@ -0,0 +1,48 @@
+package dicom
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriteDcmdump(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := file.WriteDcmdump(buf); err != nil {
+		t.Fatal(err)
+	}
+	dump := buf.String()
+
+	for _, line := range []string{
+		"# Used TransferSyntax: JPEG 2000",
+		"(0002,0010) UI =JPEG2000                                #  22, 1 TransferSyntaxUID",
+		"(0010,0010) PN [TOUTATIX]                               #   8, 1 PatientName",
+		"(0028,0010) US 512                                      #   2, 1 Rows",
+		"(0020,0037) DS [1\\0\\0\\0\\1\\0]                            #  12, 6 ImageOrientationPatient",
+		"(0040,0275) SQ (Sequence with explicit length #=1)      # 156, 1 RequestAttributesSequence",
+		"  (fffe,e000) na (Item with explicit length #=4)          # 148, 1 Item",
+		"    (0040,0007) LO [CTA CORONARY ANGIO W/CON]               #  24, 1 ScheduledProcedureStepDescription",
+		"  (fffe,e00d) na (ItemDelimitationItem for re-encoding)   #   0, 0 ItemDelimitationItem",
+		"(7fe0,0010) OB (PixelSequence #=2)                      # u/l, 1 PixelData",
+		"  (fffe,e000) pi 00\\00\\00\\00                              #   4, 1 Item",
+		"(fffe,e0dd) na (SequenceDelimitationItem)               #   0, 0 SequenceDelimitationItem",
+	} {
+		if !strings.Contains(dump, line+"\n") {
+			t.Errorf("Line missing from the dump: %s", line)
+		}
+	}
+
+	for _, line := range strings.Split(dump, "\n") {
+		if i := strings.Index(line, " #"); i > 0 && len(strings.TrimLeft(line[:i], " ")) > 16+dcmdumpLineWidth {
+			t.Errorf("Value not shortened: %s", line)
+		}
+	}
+
+}
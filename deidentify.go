@@ -0,0 +1,571 @@
+package dicom
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// An action of the de-identification profiles on an element (PS 3.15 E.1-1)
+type Action byte
+
+const (
+	ActionDummy  Action = 'D' // replace the value with a dummy value of the same VR
+	ActionEmpty  Action = 'Z' // keep the element, with an empty value
+	ActionRemove Action = 'X' // remove the element
+	ActionKeep   Action = 'K' // keep the element as is
+	ActionUID    Action = 'U' // replace the UIDs consistently within the data set
+	ActionClean  Action = 'C' // keep the element, with identifying text removed
+)
+
+// The actions of a de-identification profile, by tag
+type Profile map[Tag]Action
+
+// The actions of the Basic Application Level Confidentiality Profile on the
+// elements it handles, at any depth. Private elements, curves and overlay
+// data are removed as well. Copy it to make a custom profile.
+var BasicProfile = Profile{
+	TagPatientName:                      ActionEmpty,
+	TagPatientID:                        ActionEmpty,
+	TagVerifyingObserverName:            ActionDummy,
+	TagPersonName:                       ActionDummy,
+	TagPersonIdentificationCodeSequence: ActionDummy,
+	TagContentCreatorName:               ActionEmpty,
+	TagVerifyingObserverIdentificationCodeSequence: ActionEmpty,
+	TagPatientBirthDate:                            ActionEmpty,
+	TagPatientSex:                                  ActionEmpty,
+	TagReferringPhysicianName:                      ActionEmpty,
+	TagAccessionNumber:                             ActionEmpty,
+	TagStudyID:                                     ActionEmpty,
+	TagStudyDate:                                   ActionEmpty,
+	TagStudyTime:                                   ActionEmpty,
+	TagContentDate:                                 ActionEmpty,
+	TagContentTime:                                 ActionEmpty,
+	TagPatientBirthTime:                            ActionRemove,
+	TagPatientBirthName:                            ActionRemove,
+	TagPatientMotherBirthName:                      ActionRemove,
+	TagPatientAddress:                              ActionRemove,
+	TagPatientTelephoneNumbers:                     ActionRemove,
+	TagPatientComments:                             ActionRemove,
+	TagPatientAge:                                  ActionRemove,
+	TagPatientSize:                                 ActionRemove,
+	TagPatientWeight:                               ActionRemove,
+	TagPatientState:                                ActionRemove,
+	TagPatientInsurancePlanCodeSequence:            ActionRemove,
+	TagOtherPatientIDs:                             ActionRemove,
+	TagOtherPatientNames:                           ActionRemove,
+	TagIssuerOfPatientID:                           ActionRemove,
+	TagMedicalRecordLocator:                        ActionRemove,
+	TagMilitaryRank:                                ActionRemove,
+	TagOccupation:                                  ActionRemove,
+	TagEthnicGroup:                                 ActionRemove,
+	TagCountryOfResidence:                          ActionRemove,
+	TagRegionOfResidence:                           ActionRemove,
+	TagAdditionalPatientHistory:                    ActionRemove,
+	TagMedicalAlerts:                               ActionRemove,
+	TagAllergies:                                   ActionRemove,
+	TagPregnancyStatus:                             ActionRemove,
+	TagSmokingStatus:                               ActionRemove,
+	TagLastMenstrualDate:                           ActionRemove,
+	TagResponsiblePerson:                           ActionRemove,
+	TagReferencedPatientSequence:                   ActionRemove,
+	TagReferringPhysicianAddress:                   ActionRemove,
+	TagPerformingPhysicianName:                     ActionRemove,
+	TagPerformingPhysicianIdentificationSequence:   ActionRemove,
+	TagOperatorsName:                               ActionRemove,
+	TagNameOfPhysiciansReadingStudy:                ActionRemove,
+	TagPhysiciansOfRecord:                          ActionRemove,
+	TagRequestingPhysician:                         ActionRemove,
+	TagInstitutionName:                             ActionRemove,
+	TagInstitutionAddress:                          ActionRemove,
+	TagInstitutionalDepartmentName:                 ActionRemove,
+	TagStationName:                                 ActionRemove,
+	TagDeviceSerialNumber:                          ActionRemove,
+	TagDeviceUID:                                   ActionUID,
+	TagDeviceDescription:                           ActionRemove,
+	TagDetectorID:                                  ActionRemove,
+	TagGantryID:                                    ActionRemove,
+	TagGeneratorID:                                 ActionRemove,
+	TagPlateID:                                     ActionRemove,
+	TagCassetteID:                                  ActionRemove,
+	TagAcquisitionDeviceProcessingDescription:      ActionRemove,
+	TagAdmittingDiagnosesDescription:               ActionRemove,
+	TagAdmissionID:                                 ActionRemove,
+	TagIssuerOfAdmissionID:                         ActionRemove,
+	TagReasonForStudy:                              ActionRemove,
+	TagRequestAttributesSequence:                   ActionRemove,
+	TagRequestedProcedureDescription:               ActionRemove,
+	TagRequestedProcedureID:                        ActionRemove,
+	TagScheduledProcedureStepDescription:           ActionRemove,
+	TagScheduledProcedureStepID:                    ActionRemove,
+	TagPerformedProcedureStepID:                    ActionRemove,
+	TagPerformedProcedureStepDescription:           ActionRemove,
+	TagPerformedProcedureStepStartDate:             ActionRemove,
+	TagPerformedProcedureStepStartTime:             ActionRemove,
+	TagStudyDescription:                            ActionRemove,
+	TagStudyComments:                               ActionRemove,
+	TagSeriesDescription:                           ActionRemove,
+	TagProtocolName:                                ActionRemove,
+	TagDerivationDescription:                       ActionRemove,
+	TagImageComments:                               ActionRemove,
+	TagContrastBolusAgent:                          ActionRemove,
+	TagSeriesDate:                                  ActionRemove,
+	TagSeriesTime:                                  ActionRemove,
+	TagAcquisitionDate:                             ActionRemove,
+	TagAcquisitionTime:                             ActionRemove,
+	TagAcquisitionDateTime:                         ActionRemove,
+	TagInstanceCreationDate:                        ActionRemove,
+	TagInstanceCreationTime:                        ActionRemove,
+	TagOverlayDate:                                 ActionRemove,
+	TagOverlayTime:                                 ActionRemove,
+	TagCurveDate:                                   ActionRemove,
+	TagCurveTime:                                   ActionRemove,
+	TagTimezoneOffsetFromUTC:                       ActionRemove,
+	TagStudyInstanceUID:                            ActionUID,
+	TagSeriesInstanceUID:                           ActionUID,
+	TagSOPInstanceUID:                              ActionUID,
+	TagMediaStorageSOPInstanceUID:                  ActionUID,
+	TagReferencedSOPInstanceUID:                    ActionUID,
+	TagFrameOfReferenceUID:                         ActionUID,
+	TagReferencedFrameOfReferenceUID:               ActionUID,
+	TagSynchronizationFrameOfReferenceUID:          ActionUID,
+	TagStorageMediaFileSetUID:                      ActionUID,
+	TagInstanceCreatorUID:                          ActionUID,
+	TagIrradiationEventUID:                         ActionUID,
+	TagConcatenationUID:                            ActionUID,
+	TagDimensionOrganizationUID:                    ActionUID,
+	TagReferencedSOPInstanceUIDInFile:              ActionUID,
+	TagSOPInstanceUIDOfConcatenationSource:         ActionUID,
+	TagFailedSOPInstanceUIDList:                    ActionUID,
+	TagSourceFrameOfReferenceUID:                   ActionUID,
+	TagRelatedFrameOfReferenceUID:                  ActionUID,
+	TagFiducialUID:                                 ActionUID,
+	TagDoseReferenceUID:                            ActionUID,
+	TagObservationUID:                              ActionUID,
+	TagUID:                                         ActionUID,
+	TagSpecimenUID:                                 ActionUID,
+	TagTransactionUID:                              ActionUID,
+}
+
+// The elements kept with the Retain Device Identity Option (PS 3.15 E.3.8)
+var deviceElements = map[Tag]bool{
+	TagStationName:        true,
+	TagDeviceSerialNumber: true,
+	TagDeviceUID:          true,
+	TagDeviceDescription:  true,
+	TagDetectorID:         true,
+	TagGantryID:           true,
+	TagGeneratorID:        true,
+	TagPlateID:            true,
+	TagCassetteID:         true,
+}
+
+// The elements cleaned with the Clean Descriptors Option (PS 3.15 E.3.5)
+var descriptorElements = map[Tag]bool{
+	TagStudyDescription:                       true,
+	TagSeriesDescription:                      true,
+	TagProtocolName:                           true,
+	TagImageComments:                          true,
+	TagStudyComments:                          true,
+	TagDerivationDescription:                  true,
+	TagContrastBolusAgent:                     true,
+	TagRequestedProcedureDescription:          true,
+	TagPerformedProcedureStepDescription:      true,
+	TagScheduledProcedureStepDescription:      true,
+	TagAcquisitionDeviceProcessingDescription: true,
+	TagAdditionalPatientHistory:               true,
+}
+
+// Options of Deidentify, modifying the profile as the options of PS 3.15 E.3
+type DeidentifyOptions struct {
+	// Keep dates and times, as with the Retain Longitudinal Temporal
+	// Information with Full Dates Option (PS 3.15 E.3.6)
+	KeepDates bool
+
+	// Keep dates and times, shifting the dates by a number of days, as with
+	// the Retain Longitudinal Temporal Information with Modified Dates
+	// Option. Use the same offset for the files of a patient.
+	DateOffset int
+
+	// Keep the UIDs, as with the Retain UIDs Option (PS 3.15 E.3.9)
+	KeepUIDs bool
+
+	// Keep the elements identifying the device, as with the Retain Device
+	// Identity Option (PS 3.15 E.3.8)
+	KeepDeviceIdentity bool
+
+	// Keep descriptions and comments, removing the names and IDs of the
+	// patient and the matches of CleanPatterns from them, as with the Clean
+	// Descriptors Option (PS 3.15 E.3.5)
+	CleanDescriptors bool
+	CleanPatterns    []*regexp.Regexp
+
+	// The replacements of the UIDs. Share a UIDMap between the files of a
+	// study, or use a HashUIDMapper with the same key, to keep their
+	// references consistent; nil to use a new UIDMap.
+	UIDs UIDMapper
+
+	// The actions on the elements, BasicProfile if nil
+	Profile Profile
+
+	// Encrypt the original values of the top level elements removed or
+	// modified for the RSA keys of these certificates, in the Encrypted
+	// Attributes Sequence (PS 3.15 E.1.1), so that their holders can restore
+	// them with Reidentify
+	EncryptFor []*x509.Certificate
+}
+
+// De-identify the file with the Basic Application Level Confidentiality
+// Profile (PS 3.15 E), or the profile of the options: identifying elements
+// are removed, emptied or replaced with dummy values, private elements,
+// curves and overlay data are removed and UIDs are replaced.
+// PatientIdentityRemoved, DeidentificationMethod and
+// DeidentificationMethodCodeSequence record the process.
+func (file *DicomFile) Deidentify(opts DeidentifyOptions) error {
+
+	if err := file.LoadSequences(); err != nil {
+		return err
+	}
+	if opts.UIDs == nil {
+		opts.UIDs = UIDMap{}
+	}
+	if opts.Profile == nil {
+		opts.Profile = BasicProfile
+	}
+
+	var clean []*regexp.Regexp
+	if opts.CleanDescriptors {
+		clean = append(file.identifiers(), opts.CleanPatterns...)
+	}
+
+	// the elements of the data set, as they were
+	var originals []*DicomElement
+	if len(opts.EncryptFor) > 0 {
+		for i := range file.Elements {
+			if file.Elements[i].Group != 0x0002 {
+				originals = append(originals, file.Elements[i].Clone())
+			}
+		}
+	}
+
+	elems := make([]*DicomElement, 0, len(file.Elements))
+	for _, elem := range file.topLevel() {
+		if deidentifyElement(elem, &opts, clean) {
+			elems = append(elems, elem)
+		}
+	}
+
+	kept := make([]DicomElement, len(elems))
+	for i, elem := range elems {
+		kept[i] = *elem
+	}
+	file.Elements = kept
+
+	if originals != nil {
+		if err := file.encryptAttributes(originals, opts.EncryptFor); err != nil {
+			return err
+		}
+	}
+
+	var seq Sequence
+	var methods Strings
+	for _, method := range opts.methods() {
+		item, err := newItem([]itemValue{
+			{TagCodeValue, Strings{method.code}},
+			{TagCodingSchemeDesignator, Strings{"DCM"}},
+			{TagCodeMeaning, Strings{method.meaning}},
+		})
+		if err != nil {
+			return err
+		}
+		seq = append(seq, item)
+		methods = append(methods, method.meaning)
+	}
+
+	for _, attr := range []struct {
+		tag   Tag
+		value Value
+	}{
+		{TagPatientIdentityRemoved, Strings{"YES"}},
+		{TagDeidentificationMethod, methods},
+		{TagDeidentificationMethodCodeSequence, seq},
+	} {
+		elem, err := standardParser().NewElement(attr.tag, attr.value)
+		if err != nil {
+			return err
+		}
+		file.setElement(elem)
+	}
+
+	return nil
+}
+
+// De-identify a file with a profile, BasicProfile if nil, and the default
+// options of Deidentify
+func Anonymize(file *DicomFile, profile Profile) error {
+	return file.Deidentify(DeidentifyOptions{Profile: profile})
+}
+
+// A de-identification method, with its code of PS 3.16 CID 7050
+type deidentificationMethod struct {
+	code, meaning string
+}
+
+// The profile and options applied
+func (opts *DeidentifyOptions) methods() []deidentificationMethod {
+
+	methods := []deidentificationMethod{{"113100", "Basic Application Confidentiality Profile"}}
+	if opts.CleanDescriptors {
+		methods = append(methods, deidentificationMethod{"113105", "Clean Descriptors Option"})
+	}
+	if opts.DateOffset != 0 {
+		methods = append(methods, deidentificationMethod{"113107", "Retain Longitudinal Temporal Information Modified Dates Option"})
+	} else if opts.KeepDates {
+		methods = append(methods, deidentificationMethod{"113106", "Retain Longitudinal Temporal Information Full Dates Option"})
+	}
+	if opts.KeepDeviceIdentity {
+		methods = append(methods, deidentificationMethod{"113109", "Retain Device Identity Option"})
+	}
+	if opts.KeepUIDs {
+		methods = append(methods, deidentificationMethod{"113110", "Retain UIDs Option"})
+	}
+
+	return methods
+}
+
+// The action of the profile on an element, as modified by the options.
+// Reports whether the profile handles the element.
+func (opts *DeidentifyOptions) action(elem *DicomElement) (Action, bool) {
+
+	action, ok := opts.Profile[elem.Tag()]
+	switch {
+	case !ok:
+	case (opts.KeepDates || opts.DateOffset != 0) && (elem.Vr == "DA" || elem.Vr == "TM" || elem.Vr == "DT"):
+		action = ActionKeep
+	case opts.KeepUIDs && elem.Vr == "UI":
+		action = ActionKeep
+	case opts.KeepDeviceIdentity && deviceElements[elem.Tag()]:
+		action = ActionKeep
+	case opts.CleanDescriptors && descriptorElements[elem.Tag()]:
+		action = ActionClean
+	}
+
+	return action, ok
+}
+
+// Apply the profile to an element and the items of its sequences, cleaning
+// the matches of clean out of descriptors. Reports whether the element is
+// kept.
+func deidentifyElement(elem *DicomElement, opts *DeidentifyOptions, clean []*regexp.Regexp) bool {
+
+	action, ok := opts.action(elem)
+
+	switch {
+	case !ok:
+		if elem.Group%2 == 1 {
+			return false
+		}
+		if elem.Element == 0x0000 && elem.Group != 0x0002 {
+			return false // group lengths are invalidated
+		}
+		if elem.Group&0xFF00 == 0x5000 {
+			return false // curves
+		}
+		if elem.Group&0xFF00 == 0x6000 && (elem.Element == 0x3000 || elem.Element == 0x4000) {
+			return false // overlay data and comments
+		}
+	case action == ActionKeep:
+		if opts.DateOffset != 0 {
+			shiftDates(elem, opts.DateOffset)
+		}
+		return true
+	case action == ActionClean:
+		if values, ok := elem.Value.(Strings); ok {
+			cleaned := make(Strings, len(values))
+			for i, value := range values {
+				for _, re := range clean {
+					value = re.ReplaceAllString(value, "")
+				}
+				cleaned[i] = strings.TrimSpace(value)
+			}
+			elem.Value = cleaned
+		}
+		return true
+	case action == ActionRemove:
+		return false
+	case action == ActionEmpty:
+		elem.Value = nil
+		return true
+	case action == ActionDummy && elem.Vr != "UI":
+		elem.Value = dummyValue(elem.Vr)
+		return true
+	case action == ActionUID || action == ActionDummy:
+		if values, ok := elem.Value.(Strings); ok {
+			remapped := make(Strings, len(values))
+			for i, uid := range values {
+				remapped[i] = opts.UIDs.Remap(uid)
+			}
+			elem.Value = remapped
+		}
+		return true
+	}
+
+	if seq, ok := elem.Value.(Sequence); ok {
+		for _, item := range seq {
+			kept := item.Elements[:0]
+			for _, child := range item.Elements {
+				if deidentifyElement(child, opts, clean) {
+					kept = append(kept, child)
+				}
+			}
+			item.Elements = kept
+		}
+	}
+
+	return true
+}
+
+// Shift the dates of DA and DT values by days, emptying the values that are
+// not dates
+func shiftDates(elem *DicomElement, days int) {
+
+	values, ok := elem.Value.(Strings)
+	if !ok || elem.Vr != "DA" && elem.Vr != "DT" {
+		return
+	}
+
+	shifted := make(Strings, len(values))
+	for i, value := range values {
+		value = strings.TrimSpace(value)
+		if len(value) < 8 {
+			continue
+		}
+		date, err := time.Parse("20060102", value[:8])
+		if err != nil {
+			continue
+		}
+		shifted[i] = date.AddDate(0, 0, days).Format("20060102") + value[8:]
+	}
+	elem.Value = shifted
+}
+
+// A pattern matching the names and IDs of the patient as words, if any
+func (file *DicomFile) identifiers() []*regexp.Regexp {
+
+	var words []string
+	for _, tag := range []Tag{TagPatientName, TagPatientID, TagPatientBirthName, TagOtherPatientIDs, TagOtherPatientNames} {
+		value, _ := file.lookupString(tag)
+		for _, word := range strings.FieldsFunc(value, func(r rune) bool {
+			return r == '^' || r == '=' || r == '\\' || r == ' '
+		}) {
+			if len(word) > 1 {
+				words = append(words, regexp.QuoteMeta(word))
+			}
+		}
+	}
+
+	if len(words) == 0 {
+		return nil
+	}
+	return []*regexp.Regexp{regexp.MustCompile(`(?i)\b(?:` + strings.Join(words, "|") + `)\b`)}
+}
+
+// A value of vr to replace an identifying value with
+func dummyValue(vr string) Value {
+	switch vr {
+	case "SQ":
+		return Sequence{}
+	case "DA":
+		return Strings{"19000101"}
+	case "TM":
+		return Strings{"000000"}
+	case "DT":
+		return Strings{"19000101000000"}
+	case "AS":
+		return Strings{"000D"}
+	case "DS", "IS":
+		return Strings{"0"}
+	}
+	if isStringVR(vr) {
+		return Strings{"ANONYMIZED"}
+	}
+
+	// a zero of binary VRs
+	return decodeValue(vr, make([]byte, valueSize(vr)))
+}
+
+// Replaces UIDs, giving the same replacement to every occurrence of a UID
+type UIDMapper interface {
+	Remap(uid string) string
+}
+
+// Replacement UIDs, by original UID
+type UIDMap map[string]string
+
+// Return the replacement of a UID, generating a new 2.25 UID (PS 3.5 B.2)
+// the first time a UID is seen
+func (m UIDMap) Remap(uid string) string {
+
+	uid = strings.TrimRight(uid, " \x00")
+	if uid == "" {
+		return uid
+	}
+
+	if replacement, ok := m[uid]; ok {
+		return replacement
+	}
+
+	replacement := newUID()
+	m[uid] = replacement
+	return replacement
+}
+
+// Replaces UIDs with 2.25 UIDs derived from them with an HMAC-SHA256 keyed
+// with a secret. Files anonymized separately with the same key keep their
+// references, without sharing a map; the key must be kept secret, as the
+// original UIDs can be checked against the replacements with it.
+type HashUIDMapper []byte
+
+func (key HashUIDMapper) Remap(uid string) string {
+
+	uid = strings.TrimRight(uid, " \x00")
+	if uid == "" {
+		return uid
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(uid))
+	return "2.25." + new(big.Int).SetBytes(mac.Sum(nil)[:16]).String()
+}
+
+// Return a new UID derived from a random 128 bit number
+func newUID() string {
+	n, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		panic(err)
+	}
+	return "2.25." + n.String()
+}
+
+// Read a UID map saved with Save
+func LoadUIDMap(r io.Reader) (UIDMap, error) {
+	m := UIDMap{}
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Write the map to w as a JSON object
+func (m UIDMap) Save(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(m)
+}
@@ -0,0 +1,245 @@
+package dicom
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestDeidentify(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+	original, _ := file.lookupString(TagStudyInstanceUID)
+
+	uids := UIDMap{}
+	if err := file.Deidentify(DeidentifyOptions{UIDs: uids}); err != nil {
+		t.Fatal(err)
+	}
+
+	if dump := file.DebugString(DumpOptions{}); strings.Contains(dump, "TOUTATIX") {
+		t.Error("PatientName found in the de-identified file")
+	}
+	if name, err := file.lookupString(TagPatientName); err != nil || name != "" {
+		t.Errorf("PatientName not emptied: %q %v", name, err)
+	}
+	if date, _ := file.lookupString(TagStudyDate); date != "" {
+		t.Errorf("StudyDate not emptied: %q", date)
+	}
+	if removed, _ := file.lookupString(TagPatientIdentityRemoved); removed != "YES" {
+		t.Errorf("Incorrect PatientIdentityRemoved %q", removed)
+	}
+
+	uid, _ := file.lookupString(TagStudyInstanceUID)
+	if uid == original || uid != uids[original] || ValidateUID(uid) != nil {
+		t.Errorf("Incorrect StudyInstanceUID %q", uid)
+	}
+
+	// the same map gives the same UIDs to another file of the study
+	other, _ := p.Parse(readFile())
+	other.Deidentify(DeidentifyOptions{KeepDates: true, UIDs: uids})
+	if u, _ := other.lookupString(TagStudyInstanceUID); u != uid {
+		t.Errorf("Inconsistent StudyInstanceUID %q, expected %q", u, uid)
+	}
+	if date, _ := other.lookupString(TagStudyDate); date == "" {
+		t.Error("StudyDate not kept")
+	}
+
+	buffer := new(bytes.Buffer)
+	if err := file.Write(buffer); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Parse(buffer.Bytes()); err != nil {
+		t.Error(err)
+	}
+
+}
+
+func TestUIDMap(t *testing.T) {
+
+	uids := UIDMap{}
+	a := uids.Remap("1.2.3")
+	if uids.Remap("1.2.3\x00") != a || uids.Remap("1.2.4") == a {
+		t.Error("Inconsistent remapping")
+	}
+
+	buffer := new(bytes.Buffer)
+	if err := uids.Save(buffer); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadUIDMap(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Remap("1.2.3") != a || len(loaded) != 2 {
+		t.Errorf("Incorrect loaded map %v", loaded)
+	}
+
+}
+
+func TestDeidentifyProfile(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sex, _ := file.lookupString(TagPatientSex)
+	file.insertElement(&DicomElement{Group: 0x5000, Element: 0x3000, Vr: "OW", Value: UInt16s{1}})
+	file.insertElement(&DicomElement{Group: 0x6000, Element: 0x3000, Vr: "OW", Value: UInt16s{1}})
+	file.insertElement(&DicomElement{Group: 0x6000, Element: 0x0010, Vr: "US", Value: UInt16s{512}})
+
+	profile := Profile{}
+	for tag, action := range BasicProfile {
+		profile[tag] = action
+	}
+	profile[TagPatientSex] = ActionKeep
+	profile[TagPatientName] = ActionDummy
+	profile[TagStudyDate] = ActionDummy
+
+	if err := file.Deidentify(DeidentifyOptions{Profile: profile}); err != nil {
+		t.Fatal(err)
+	}
+
+	if s, _ := file.lookupString(TagPatientSex); s != sex {
+		t.Errorf("PatientSex not kept: %q", s)
+	}
+	if name, _ := file.lookupString(TagPatientName); name != "ANONYMIZED" {
+		t.Errorf("Incorrect dummy PatientName %q", name)
+	}
+	if date, _ := file.lookupString(TagStudyDate); date != "19000101" {
+		t.Errorf("Incorrect dummy StudyDate %q", date)
+	}
+	for _, tag := range []Tag{{0x5000, 0x3000}, {0x6000, 0x3000}} {
+		if _, err := file.LookupElementByTag(tag); err == nil {
+			t.Errorf("%v not removed", tag)
+		}
+	}
+	if _, err := file.LookupElementByTag(Tag{0x6000, 0x0010}); err != nil {
+		t.Error("OverlayRows removed")
+	}
+
+	elem, err := file.LookupElementByTag(TagDeidentificationMethodCodeSequence)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seq, _ := elem.GetSequence()
+	if len(seq) != 1 {
+		t.Fatalf("%d codes, expected 1", len(seq))
+	}
+	if code, _ := seq[0].Elements[0].GetString(); code != "113100" {
+		t.Errorf("Incorrect method code %q", code)
+	}
+
+}
+
+func TestAnonymize(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	profile := Profile{TagPatientName: ActionDummy}
+	if err := Anonymize(file, profile); err != nil {
+		t.Fatal(err)
+	}
+	if name, _ := file.lookupString(TagPatientName); name != "ANONYMIZED" {
+		t.Errorf("Incorrect dummy PatientName %q", name)
+	}
+	if removed, _ := file.lookupString(TagPatientIdentityRemoved); removed != "YES" {
+		t.Errorf("Incorrect PatientIdentityRemoved %q", removed)
+	}
+
+	if err := Anonymize(file, nil); err != nil {
+		t.Fatal(err)
+	}
+	if name, _ := file.lookupString(TagPatientName); name != "" {
+		t.Errorf("PatientName not emptied with BasicProfile: %q", name)
+	}
+
+}
+
+func TestDeidentifyOptions(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+	uid, _ := file.lookupString(TagStudyInstanceUID)
+	for i := range file.Elements {
+		if file.Elements[i].Tag() == TagStudyDescription {
+			file.Elements[i].Value = Strings{"CTA of Toutatix 7DkT2Tp, bolus 25"}
+		}
+	}
+
+	opts := DeidentifyOptions{
+		DateOffset:         3,
+		KeepUIDs:           true,
+		KeepDeviceIdentity: true,
+		CleanDescriptors:   true,
+		CleanPatterns:      []*regexp.Regexp{regexp.MustCompile(`,.*`)},
+	}
+	if err := file.Deidentify(opts); err != nil {
+		t.Fatal(err)
+	}
+
+	if date, _ := file.lookupString(TagStudyDate); date != "20050401" {
+		t.Errorf("Incorrect shifted StudyDate %q", date)
+	}
+	if u, _ := file.lookupString(TagStudyInstanceUID); u != uid {
+		t.Errorf("StudyInstanceUID not kept: %q", u)
+	}
+	if station, _ := file.lookupString(TagStationName); station != "CT54023" {
+		t.Errorf("StationName not kept: %q", station)
+	}
+	if description, _ := file.lookupString(TagStudyDescription); description != "CTA of" {
+		t.Errorf("Incorrect cleaned StudyDescription %q", description)
+	}
+
+	elem, _ := file.LookupElementByTag(TagDeidentificationMethodCodeSequence)
+	var codes []string
+	if seq, err := elem.GetSequence(); err == nil {
+		for _, item := range seq {
+			code, _ := item.Elements[0].GetString()
+			codes = append(codes, code)
+		}
+	}
+	if strings.Join(codes, ",") != "113100,113105,113107,113109,113110" {
+		t.Errorf("Incorrect method codes %v", codes)
+	}
+
+}
+
+func TestHashUIDMapper(t *testing.T) {
+
+	p, _ := NewParser()
+	a, _ := p.Parse(readFile())
+	b, _ := p.Parse(readFile())
+	a.SetByPath("SOPInstanceUID", "1.2.3.4")
+	b.SetByPath("ReferencedImageSequence[0].ReferencedSOPInstanceUID", "1.2.3.4")
+
+	// files of a study anonymized separately with the same key
+	a.Deidentify(DeidentifyOptions{UIDs: HashUIDMapper("secret")})
+	b.Deidentify(DeidentifyOptions{UIDs: HashUIDMapper("secret")})
+
+	uid, _ := a.lookupString(TagSOPInstanceUID)
+	refs, err := b.GetByPath("ReferencedImageSequence[0].ReferencedSOPInstanceUID")
+	if err != nil || len(refs) != 1 {
+		t.Fatalf("ReferencedSOPInstanceUID not found: %v", err)
+	}
+	if ref, _ := refs[0].GetString(); ref != uid || ValidateUID(uid) != nil {
+		t.Errorf("Incorrect reference %q to %q", ref, uid)
+	}
+
+	if HashUIDMapper("other").Remap("1.2.3.4") == uid {
+		t.Error("The UIDs should depend on the key")
+	}
+
+}
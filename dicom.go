@@ -1,21 +1,39 @@
 package dicom
 
 import (
+	"bytes"
+	"compress/flate"
+	"context"
 	"encoding/binary"
 	"errors"
+	"fmt"
+	"io"
 )
 
 type DicomFile struct {
 	Elements []DicomElement
+	Warnings []Warning // recoverable problems found while parsing
 }
 
 // Errors
 var (
 	ErrIllegalTag            = errors.New("Illegal tag found in PixelData")
 	ErrTagNotFound           = errors.New("Could not find tag in dicom dictionary")
+	ErrNotFound              = errors.New("Element not found")
 	ErrBrokenFile            = errors.New("Invalid DICOM file")
 	ErrOddLength             = errors.New("Encountered odd length Value Length")
 	ErrUndefLengthNotAllowed = errors.New("UC, UR and UT may not have an Undefined Length, i.e.,a Value Length of FFFFFFFFH.")
+
+	ErrUnsupportedTransferSyntax = errors.New("Unsupported Transfer Syntax")
+	ErrPixelDataTruncated        = errors.New("PixelData is shorter than its Value Length")
+)
+
+// Transfer syntaxes of the data sets parsed and written
+const (
+	ImplicitVRLittleEndian         = implicit_vr_little_endian
+	ExplicitVRLittleEndian         = explicit_vr_little_endian
+	ExplicitVRBigEndian            = explicit_vr_big_endian
+	DeflatedExplicitVRLittleEndian = deflated_explicit_vr_le
 )
 
 const (
@@ -23,178 +41,361 @@ const (
 	implicit_vr_little_endian = "1.2.840.10008.1.2"
 	explicit_vr_little_endian = "1.2.840.10008.1.2.1"
 	explicit_vr_big_endian    = "1.2.840.10008.1.2.2"
+	deflated_explicit_vr_le   = "1.2.840.10008.1.2.1.99"
 )
 
-// Parse a byte array, returns a DICOM file struct
-func (p *Parser) Parse(buff []byte) (*DicomFile, <-chan DicomMessage) {
+// Parse a byte array, returns a DICOM file struct. The OB and UN values and
+// the pixel data fragments are not copied but reference buff, which must not
+// be modified while the file is in use; see Detach.
+func (p *Parser) Parse(buff []byte) (*DicomFile, error) {
+	file := &DicomFile{}
+	err := measureParse(len(buff), func() error {
+		return profile("parse", func(context.Context) error {
+			_, err := p.parse(buff, file, ignoreElement)
+			return err
+		})
+	})
+	return file, err
+}
+
+// Parse a file read from r up to EOF, ie. a pipe or the standard input,
+// whose length is not known in advance. The file is read into memory before
+// parsing; with MaxDataSetLength, reading stops past the maximum length.
+func (p *Parser) ParseReader(r io.Reader) (*DicomFile, error) {
+
+	if p.maxDataSetLength > 0 {
+		r = io.LimitReader(r, int64(p.maxDataSetLength)+1)
+	}
+	buff, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Parse(buff)
+}
+
+// Parse a data set without preamble nor file meta information, encoded with
+// the transfer syntax ts, as exchanged over the network
+func (p *Parser) ParseDataSet(buff []byte, ts string) (*DicomFile, error) {
+
+	bo, implicit, err := transferSyntax(ts)
+	if err != nil {
+		return nil, err
+	}
+	if ts == deflated_explicit_vr_le {
+		if buff, err = p.inflate(buff); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.checkDataSetLength(buff); err != nil {
+		return nil, err
+	}
 
-	c := make(chan DicomMessage)
-	waitMsg := make(chan bool)
+	file := &DicomFile{}
+	buffer := getDicomBuffer(buff)
+	defer buffer.release()
+	buffer.bo = bo
+	buffer.implicit = implicit
+	buffer.encapsulated = isEncapsulated(ts)
+
+	err = measureParse(len(buff), func() error {
+		return profile("parse", func(context.Context) error {
+			for buffer.Len() != 0 {
+				elem, err := p.readElement(buffer, 0, ignoreElement)
+				if err != nil {
+					return err
+				}
+				file.appendDataElement(elem)
+			}
+			return nil
+		})
+	})
+	file.Warnings = buffer.warnings
+
+	return file, err
+}
+
+// Parse a byte array into file. Every data element, including the elements
+// nested in sequences and pixel data items, is passed to emit as it is read;
+// parsing stops at the first error of emit, which parse returns.
+// Returns the number of bytes parsed, less than len(buff) when stopping at
+// the pixel data of a HeaderOnly parser. The data set of a deflated file is
+// inflated as a whole, and counts as parsed.
+func (p *Parser) parse(buff []byte, file *DicomFile, emit func(*DicomElement) error) (int, error) {
 
-	buffer := newDicomBuffer(buff) //*di.Bytes)
+	if err := p.checkDataSetLength(buff); err != nil {
+		return 0, err
+	}
+
+	buffer := getDicomBuffer(buff)
+	defer buffer.release()
+	defer func() {
+		file.Warnings = buffer.warnings
+	}()
 
 	buffer.Next(128) // skip preamble
-	buffer.p = +128
+	buffer.p += 128
 
 	// check for magic word
 	if magicWord := string(buffer.Next(4)); magicWord != magic_word {
-		panic(ErrBrokenFile)
+		return 0, ErrBrokenFile
 	}
-	file := &DicomFile{}
-
-	go func() {
+	buffer.p += 4
 
-		// (0002,0000) MetaElementGroupLength
-		metaElem := buffer.readDataElement(p)
-		metaLength := int(metaElem.Value[0].(uint32))
-		p.appendDataElement(file, metaElem)
+	// (0002,0000) MetaElementGroupLength
+	metaElem, err := buffer.readDataElement(p)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := metaElem.GetUInt32(); metaElem.Tag() != TagFileMetaInformationGroupLength || err != nil {
+		return 0, ErrBrokenFile
+	}
+	file.appendDataElement(metaElem)
+	if err := emit(metaElem); err != nil {
+		return 0, err
+	}
 
-		// Read meta tags
-		start := buffer.Len()
-		for start-buffer.Len() < metaLength {
-			elem := buffer.readDataElement(p)
-			p.appendDataElement(file, elem)
-			c <- DicomMessage{elem, waitMsg}
-			<-waitMsg
+	// Read meta tags, up to the first element of another group as the group
+	// length is not always right
+	for {
+		if tag, err := buffer.peekTag(); err != nil || tag.Group != 0x0002 {
+			break
 		}
-
-		// read endianness and explicit VR
-		endianess, implicit, err := file.getTransferSyntax()
+		elem, err := p.readElement(buffer, 0, emit)
 		if err != nil {
-			panic(ErrBrokenFile)
+			return 0, err
 		}
+		file.appendDataElement(elem)
+	}
 
-		// modify buffer according to new TransferSyntaxUID
-		buffer.bo = endianess
-		buffer.implicit = implicit
+	// read endianness and explicit VR
+	endianess, implicit, err := file.getTransferSyntax()
+	if err == ErrNotFound {
+		return 0, ErrBrokenFile
+	} else if err != nil {
+		return 0, err
+	}
 
-		// Start with image meta data
-		for buffer.Len() != 0 {
+	// modify buffer according to new TransferSyntaxUID
+	buffer.bo = endianess
+	buffer.implicit = implicit
+	ts, _ := file.lookupString(TagTransferSyntaxUID)
+	buffer.encapsulated = isEncapsulated(ts)
+
+	// the positions of the elements of a deflated data set are those in the
+	// inflated data set
+	deflated := ts == deflated_explicit_vr_le
+	if deflated {
+		inflated, err := p.inflate(buffer.Bytes())
+		if err != nil {
+			return 0, err
+		}
+		buffer.Buffer = bytes.NewBuffer(inflated)
+		buffer.p = 0
+	}
 
-			elem := buffer.readDataElement(p)
-			p.appendDataElement(file, elem)
-			c <- DicomMessage{elem, waitMsg}
-			<-waitMsg
+	// Start with image meta data
+	for buffer.Len() != 0 {
+		if p.headerOnly && buffer.atPixelData() {
+			break
+		}
+		elem, err := p.readElement(buffer, 0, emit)
+		if err != nil {
+			return 0, err
+		}
+		file.appendDataElement(elem)
+	}
 
-			if elem.Vr == "SQ" {
-				p.readItems(file, buffer, elem, c)
-			}
+	if deflated {
+		return len(buff), nil
+	}
+	return len(buff) - buffer.Len(), nil
+}
 
-			if elem.Name == "PixelData" {
-				p.readPixelItems(file, buffer, elem, c)
-				break
-			}
+// Inflate a deflated data set (PS 3.5 A.5), checking its inflated length
+// against MaxDataSetLength
+func (p *Parser) inflate(buff []byte) ([]byte, error) {
 
-		}
+	var r io.Reader = flate.NewReader(bytes.NewReader(buff))
+	if p.maxDataSetLength > 0 {
+		r = io.LimitReader(r, int64(p.maxDataSetLength)+1)
+	}
+	inflated, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBrokenFile, err)
+	}
+	if err := p.checkDataSetLength(inflated); err != nil {
+		return nil, err
+	}
 
-		close(c)
-	}()
+	return inflated, nil
+}
 
-	return file, c
+// An emit function of the parser ignoring the elements
+func ignoreElement(*DicomElement) error {
+	return nil
 }
 
-func (p *Parser) readItems(file *DicomFile, buffer *dicomBuffer, sq *DicomElement, c chan DicomMessage) (uint32, error) {
-	waitMsg := make(chan bool)
+// Read a data element, along with the items of sequences and encapsulated
+// pixel data, which are stored as a Sequence and *PixelData value
+func (p *Parser) readElement(buffer *dicomBuffer, level uint8, emit func(*DicomElement) error) (*DicomElement, error) {
+
+	elem, err := buffer.readDataElement(p)
+	if err != nil {
+		return nil, err
+	}
+	elem.IndentLevel = level
+	if level == 0 && elem.Tag() == TagSpecificCharacterSet {
+		if err := p.setCharacterSet(buffer, elem); err != nil {
+			return nil, err
+		}
+	}
+	if err := emit(elem); err != nil {
+		return nil, err
+	}
 
-	sq.IndentLevel++
-	sqLength := sq.Vl
+	if elem.Vr == "SQ" {
+		if err := p.checkSequenceDepth(elem, level); err != nil {
+			return nil, err
+		}
+	}
 
-	if sqLength == 0 {
-		return 0, nil
+	if elem.Vr == "SQ" && p.lazySequences {
+		if err := p.skipSequence(buffer, elem, level+1); err != nil {
+			return nil, err
+		}
+	} else if elem.Vr == "SQ" {
+		seq, err := p.readSequence(buffer, elem, level+1, emit)
+		if err != nil {
+			return nil, err
+		}
+		elem.Value = seq
+	} else if elem.Tag() == TagPixelData && (elem.undefLen || elem.Value == nil && buffer.definedLengthFragments(elem)) {
+		pixels, err := p.readFragments(buffer, elem, level+1, emit)
+		if err != nil {
+			return nil, err
+		}
+		elem.Value = pixels
 	}
+	elem.elemLen = buffer.p - elem.P
 
-	elem := buffer.readDataElement(p)
-	elem.IndentLevel = sq.IndentLevel
+	return elem, nil
+}
 
-	sqAcum := elem.elemLen
-	itemLength := elem.Vl
-	itemAcum := uint32(0)
+// Read the items of a sequence
+func (p *Parser) readSequence(buffer *dicomBuffer, sq *DicomElement, level uint8, emit func(*DicomElement) error) (Sequence, error) {
 
-	if elem.Name == "Item" {
-		if elem.Vl > 0 {
-			for buffer.Len() != 0 {
+	seq := Sequence{}
+	err := p.readItems(buffer, sq, level, emit, func(item *DicomElement) error {
+		if err := emit(item); err != nil {
+			return err
+		}
+		elems, err := p.readItemElements(buffer, item, level, emit)
+		if err != nil {
+			return err
+		}
+		item.elemLen = buffer.p - item.P
+		seq = append(seq, &Item{elems})
+		return nil
+	})
 
-				p.appendDataElement(file, elem)
-				c <- DicomMessage{elem, waitMsg}
-				<-waitMsg
+	return seq, err
+}
 
-				if elem.Vr == "SQ" {
-					l, _ := p.readItems(file, buffer, elem, c)
-					sqAcum += l
-				}
+// Read the Basic Offset Table and fragments of encapsulated pixel data
+func (p *Parser) readFragments(buffer *dicomBuffer, elem *DicomElement, level uint8, emit func(*DicomElement) error) (*PixelData, error) {
 
-				if itemAcum == itemLength {
-					break
-				}
+	pixels := &PixelData{}
+	first := true
+	err := p.readItems(buffer, elem, level, emit, func(item *DicomElement) error {
+		if first {
+			item.Value = buffer.readValue("UL", item.Vl)
+			pixels.Offsets = MustGetAll[uint32](item)
+			first = false
+		} else {
+			item.Value = Bytes(buffer.readUInt8Array(item.Vl))
+			pixels.Fragments = append(pixels.Fragments, item.MustGetBytes())
+		}
+		if item.Vl%2 != 0 {
+			buffer.skipPadding()
+		}
+		item.elemLen = buffer.p - item.P
+		return emit(item)
+	})
 
-				if sqAcum == sqLength {
-					break
-				}
+	return pixels, err
+}
 
-				elem = buffer.readDataElement(p)
-				elem.IndentLevel = sq.IndentLevel
-				if elem.Name == "Item" {
-					itemLength = elem.Vl
-				}
-				itemAcum += elem.elemLen
-				sqAcum += elem.elemLen
+// Read item headers up to the Sequence Delimitation Item or the end of the
+// defined length of sq, calling readItem to read the content of each item
+func (p *Parser) readItems(buffer *dicomBuffer, sq *DicomElement, level uint8, emit func(*DicomElement) error, readItem func(*DicomElement) error) error {
 
-			}
+	start := buffer.Len()
 
-		} else if elem.undefLen == true {
-			//log.Println("____ ITEM UNDEF LEN ____")
-			for buffer.Len() != 0 {
+	for buffer.Len() != 0 {
 
-				if elem.Vr == "SQ" {
-					p.readItems(file, buffer, elem, c)
-				}
+		if !sq.undefLen && uint32(start-buffer.Len()) >= sq.Vl {
+			break
+		}
 
-				if elem.Name == "SequenceDelimitationItem" {
-					break
-				}
+		item, err := buffer.readDataElement(p)
+		if err != nil {
+			return err
+		}
+		item.IndentLevel = level
 
-				p.appendDataElement(file, elem)
-				c <- DicomMessage{elem, waitMsg}
-				<-waitMsg
+		if item.Tag() == TagSequenceDelimitationItem {
+			return emit(item)
+		}
 
-				elem = buffer.readDataElement(p)
-				elem.IndentLevel = sq.IndentLevel
+		if item.Tag() != TagItem {
+			return ErrBrokenFile
+		}
 
-			}
-		} else {
-			// ITEM 0 LEN
+		// report truncated fragments as truncated PixelData
+		if err := checkLength(buffer, sq.Tag(), item.Vl); err != nil {
+			return err
 		}
-	}
 
-	return sqAcum, nil
+		if err := readItem(item); err != nil {
+			return err
+		}
+	}
 
+	return nil
 }
 
-func (p *Parser) readPixelItems(file *DicomFile, buffer *dicomBuffer, sq *DicomElement, c chan DicomMessage) {
-	waitMsg := make(chan bool)
+// Read the data elements of a sequence item, up to the Item Delimitation
+// Item or the end of the defined length
+func (p *Parser) readItemElements(buffer *dicomBuffer, item *DicomElement, level uint8, emit func(*DicomElement) error) ([]*DicomElement, error) {
 
-	elem := buffer.readDataElement(p)
+	var elems []*DicomElement
+	start := buffer.Len()
 
 	for buffer.Len() != 0 {
-		if elem.Name == "Item" {
-			elem.Value = append(elem.Value, buffer.readUInt8Array(elem.Vl))
+
+		if !item.undefLen && uint32(start-buffer.Len()) >= item.Vl {
+			break
+		}
+
+		elem, err := p.readElement(buffer, level, emit)
+		if err != nil {
+			return nil, err
 		}
-		p.appendDataElement(file, elem)
-		c <- DicomMessage{elem, waitMsg}
-		<-waitMsg
-		elem = buffer.readDataElement(p)
 
+		if elem.Tag() == TagItemDelimitationItem {
+			break
+		}
+
+		elems = append(elems, elem)
 	}
-	p.appendDataElement(file, elem)
-	c <- DicomMessage{elem, waitMsg}
-	<-waitMsg
 
+	return elems, nil
 }
 
 // Append a dataElement to the DicomFile
-func (p *Parser) appendDataElement(file *DicomFile, elem *DicomElement) {
+func (file *DicomFile) appendDataElement(elem *DicomElement) {
 	file.Elements = append(file.Elements, *elem)
-
 }
 
 // Finds the SyntaxTrasnferUID and returns the endianess and implicit VR for the file
@@ -202,12 +403,21 @@ func (file *DicomFile) getTransferSyntax() (binary.ByteOrder, bool, error) {
 
 	var err error
 
-	elem, err := file.LookupElement("TransferSyntaxUID")
+	elem, err := file.LookupElementByTag(TagTransferSyntaxUID)
+	if err != nil {
+		return nil, true, err
+	}
+
+	ts, err := elem.GetString()
 	if err != nil {
 		return nil, true, err
 	}
 
-	ts := elem.Value[0].(string)
+	return transferSyntax(ts)
+}
+
+// Return the endianess and implicit VR of a transfer syntax
+func transferSyntax(ts string) (binary.ByteOrder, bool, error) {
 
 	// defaults are explicit VR, little endian
 	switch ts {
@@ -217,12 +427,24 @@ func (file *DicomFile) getTransferSyntax() (binary.ByteOrder, bool, error) {
 		return binary.LittleEndian, false, nil
 	case explicit_vr_big_endian:
 		return binary.BigEndian, false, nil
+	case deflated_explicit_vr_le:
+		// deflated by the parser and the writers
+		return binary.LittleEndian, false, nil
 	}
 
 	return binary.LittleEndian, false, nil
 
 }
 
+// Whether the pixel data of a transfer syntax is encapsulated (PS 3.5 A.4)
+func isEncapsulated(ts string) bool {
+	switch ts {
+	case implicit_vr_little_endian, explicit_vr_little_endian, explicit_vr_big_endian, deflated_explicit_vr_le:
+		return false
+	}
+	return true
+}
+
 // Lookup a tag by name
 func (file *DicomFile) LookupElement(name string) (*DicomElement, error) {
 
@@ -232,5 +454,5 @@ func (file *DicomFile) LookupElement(name string) (*DicomElement, error) {
 		}
 	}
 
-	return nil, ErrTagNotFound
+	return nil, ErrNotFound
 }
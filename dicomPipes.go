@@ -9,6 +9,10 @@ import (
 	"sync"
 )
 
+// An element sent down the channels of Parse and of the stages below, each
+// stage waiting for the next one to acknowledge it on wait.
+//
+// Deprecated: stages are chained by hand and panic on errors. Use a Pipeline.
 type DicomMessage struct {
 	msg  *DicomElement
 	wait chan bool
@@ -19,18 +23,30 @@ const (
 	JPEG_BASELINE_1 = "1.2.840.10008.1.2.4.50"
 )
 
-// generator
-func (di *DicomFile) Parse(buff []byte) <-chan DicomMessage {
+// generator, parses buff into di and streams every data element read.
+// Panics if the file can not be parsed.
+func (di *DicomFile) Parse(buff []byte, options ...func(*Parser) error) <-chan DicomMessage {
 
-	parser, err := NewParser()
+	parser, err := NewParser(options...)
 	if err != nil {
 		panic(err)
 	}
 
-	_, c := parser.Parse(buff)
-	if err != nil {
-		panic(err)
-	}
+	c := make(chan DicomMessage)
+	waitMsg := make(chan bool)
+
+	go func() {
+		_, err := parser.parse(buff, di, func(elem *DicomElement) error {
+			c <- DicomMessage{elem, waitMsg}
+			<-waitMsg
+			return nil
+		})
+		if err != nil {
+			panic(err)
+		}
+		close(c)
+	}()
+
 	return c
 }
 
@@ -53,6 +69,9 @@ func fileName(folder string, i int, ext string) string {
 }
 
 // Writes pixel data to folder
+//
+// Deprecated: the fragments are written as they are, with an extension
+// guessed from the transfer syntax. Use Images to decode the frames.
 func (di *DicomFile) WriteImagesToFolder(in <-chan DicomMessage, done *sync.WaitGroup, folder string) <-chan DicomMessage {
 
 	out := make(chan DicomMessage)
@@ -70,7 +89,7 @@ func (di *DicomFile) WriteImagesToFolder(in <-chan DicomMessage, done *sync.Wait
 			switch dcmMsg.msg.Name {
 
 			case "TransferSyntaxUID":
-				txUID = dcmMsg.msg.Value[0].(string)
+				txUID, _ = dcmMsg.msg.GetString()
 
 			case "PixelData":
 				inImg = true
@@ -95,7 +114,7 @@ func (di *DicomFile) WriteImagesToFolder(in <-chan DicomMessage, done *sync.Wait
 				if inImg == true {
 
 					if idx > 0 {
-						pb := dcmMsg.msg.Value[0].([]byte)
+						pb := dcmMsg.msg.MustGetBytes()
 						err := ioutil.WriteFile(fileName(folder, idx, fext), pb, 0644)
 						if err != nil {
 							panic(err)
@@ -1,10 +1,13 @@
 package dicom
 
 import (
+	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"testing"
+	"testing/iotest"
 )
 
 func readFile() []byte {
@@ -36,14 +39,14 @@ func TestParseFile(t *testing.T) {
 		t.Error(err)
 	}
 
-	pn := elem.Value.([]string)
+	pn := elem.Value.(Strings)
 
 	if pn[0] != "TOUTATIX" {
 		t.Errorf("Incorrect patient name: %s", pn)
 	}
 
 	if l := len(pn); l != 1 {
-		t.Errorf("Incorrect patient name length: %i", l)
+		t.Errorf("Incorrect patient name length: %d", l)
 	}
 
 	elem, err = data.LookupElement("TransferSyntaxUID")
@@ -51,22 +54,179 @@ func TestParseFile(t *testing.T) {
 		t.Error(err)
 	}
 
-	ts := elem.Value.([]string)
+	ts := elem.Value.(Strings)
 
 	if ts[0] != "1.2.840.10008.1.2.4.91" {
 		t.Errorf("Incorrect TransferSyntaxUID: %s", ts)
 	}
 
-	if l := len(data.Elements); l != 99 {
-		t.Errorf("Error parsing DICOM file, wrong number of elements: %i", l)
+	if l := len(data.Elements); l != 98 {
+		t.Errorf("Error parsing DICOM file, wrong number of elements: %d", l)
 	}
 
 }
 
+func TestParseSequence(t *testing.T) {
+
+	parser, _ := NewParser()
+
+	data, err := parser.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sq, err := data.LookupElement("ReferencedStudySequence")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seq, err := sq.GetSequence()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if l := len(seq); l != 1 {
+		t.Fatalf("Incorrect number of items: %d", l)
+	}
+
+	item := seq[0]
+	if len(item.Elements) != 2 {
+		t.Fatalf("Incorrect item %v", item)
+	}
+
+	uid := item.Elements[1]
+	if uid.Name != "ReferencedSOPInstanceUID" || uid.Value.(Strings)[0] != "1.2.840.113745.101000.1008000.38412.4675.7032121" {
+		t.Errorf("Incorrect nested element %v", uid)
+	}
+
+}
+
+func TestParseBrokenFile(t *testing.T) {
+
+	parser, _ := NewParser()
+
+	if _, err := parser.Parse(make([]byte, 256)); err != ErrBrokenFile {
+		t.Errorf("Expected ErrBrokenFile, got %v", err)
+	}
+
+}
+
+func TestRoundTrip(t *testing.T) {
+
+	parser, _ := NewParser()
+
+	for _, name := range []string{"examples/IM-0001-0001.dcm", "examples/I_000000.dcm"} {
+
+		buff, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := parser.Parse(buff)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", name, err)
+		}
+
+		out := new(bytes.Buffer)
+		if err := data.Write(out); err != nil {
+			t.Fatalf("failed to write %s: %s", name, err)
+		}
+
+		written, err := parser.Parse(out.Bytes())
+		if err != nil {
+			t.Fatalf("failed to parse written %s: %s", name, err)
+		}
+
+		if !Equal(data, written, EqualOptions{}) {
+			t.Errorf("%s changed after a round trip", name)
+		}
+	}
+
+}
+
+func TestDataSetRoundTrip(t *testing.T) {
+
+	parser, _ := NewParser()
+	data, err := parser.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the data set, without the file meta information
+	expected := &DicomFile{}
+	for i := range data.Elements {
+		if data.Elements[i].Group != 0x0002 {
+			expected.appendDataElement(&data.Elements[i])
+		}
+	}
+
+	for _, ts := range []string{ImplicitVRLittleEndian, ExplicitVRLittleEndian, ExplicitVRBigEndian} {
+		out := new(bytes.Buffer)
+		if err := data.WriteDataSet(out, ts); err != nil {
+			t.Fatalf("failed to write the data set in %s: %s", ts, err)
+		}
+
+		written, err := parser.ParseDataSet(out.Bytes(), ts)
+		if err != nil {
+			t.Fatalf("failed to parse the data set in %s: %s", ts, err)
+		}
+
+		if diffs := Diff(expected, written, EqualOptions{}); len(diffs) > 0 {
+			t.Errorf("Data set changed after a round trip in %s: %v", ts, diffs)
+		}
+	}
+
+}
+
+// Parse arbitrary input, write the files parsed and parse them again: the
+// files written must parse to the same elements and values
+func FuzzRoundTrip(f *testing.F) {
+
+	// the example without its pixel data, as long inputs slow the fuzzer down
+	parser, _ := NewParser()
+	data, err := parser.Parse(readFile())
+	if err != nil {
+		f.Fatal(err)
+	}
+	seed := &DicomFile{}
+	for i := range data.Elements {
+		if data.Elements[i].Tag() != TagPixelData {
+			seed.appendDataElement(&data.Elements[i])
+		}
+	}
+	out := new(bytes.Buffer)
+	if err := seed.Write(out); err != nil {
+		f.Fatal(err)
+	}
+	f.Add(out.Bytes())
+
+	parser, _ = NewParser(MaxElementLength(1<<20), MaxDataSetLength(1<<24), MaxElements(1<<16))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		data, err := parser.Parse(b)
+		if err != nil {
+			return
+		}
+		out := new(bytes.Buffer)
+		if err := data.Write(out); err != nil {
+			// values the parser accepts may not be encodable
+			return
+		}
+		written, err := parser.Parse(out.Bytes())
+		if err != nil {
+			t.Fatalf("failed to parse the file written: %s", err)
+		}
+		// the group length of the file meta information is recalculated
+		opts := EqualOptions{IgnoreTags: []Tag{TagFileMetaInformationGroupLength}}
+		if diffs := Diff(data, written, opts); len(diffs) > 0 {
+			t.Errorf("File changed after a round trip: %v", diffs)
+		}
+	})
+}
+
 func TestGetTransferSyntaxImplicitLittleEndian(t *testing.T) {
 
 	file := &DicomFile{}
-	file.appendDataElement(&DicomElement{0002, 0010, "TransferSyntaxUID", "UI", 0, []string{"1.2.840.10008.1.2"}})
+	file.appendDataElement(&DicomElement{Group: 0x0002, Element: 0x0010, Name: "TransferSyntaxUID", Vr: "UI", Value: Strings{"1.2.840.10008.1.2"}})
 
 	bo, implicit, err := file.getTransferSyntax()
 	if err != nil {
@@ -98,3 +258,215 @@ func BenchmarkParseSingle(b *testing.B) {
 		}
 	}
 }
+
+func TestParseKeepPadding(t *testing.T) {
+
+	parser, _ := NewParser(KeepPadding())
+	data, err := parser.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	elem, err := data.LookupElementByTag(TagSOPClassUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if uid := elem.MustGetString(); uid != CTImageStorage+"\x00" {
+		t.Errorf("Incorrect padded UID %q", uid)
+	}
+
+}
+
+func TestParseTruncatedPixelData(t *testing.T) {
+
+	file := readFile()
+	parser, _ := NewParser()
+
+	if _, err := parser.Parse(file[:len(file)-16]); !errors.Is(err, ErrPixelDataTruncated) {
+		t.Errorf("Expected ErrPixelDataTruncated, got %v", err)
+	}
+
+}
+
+func TestDeflatedTransferSyntax(t *testing.T) {
+
+	parser, _ := NewParser()
+	data, err := parser.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range data.Elements {
+		if data.Elements[i].Tag() == TagTransferSyntaxUID {
+			data.Elements[i].Value = Strings{deflated_explicit_vr_le}
+		}
+	}
+	// the JPEG 2000 pixel data does not deflate
+	data.Elements = data.Elements[:data.indexOf(TagPixelData)]
+
+	buff := new(bytes.Buffer)
+	if err := data.Write(buff); err != nil {
+		t.Fatal(err)
+	}
+	deflated, err := parser.Parse(buff.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Equal(data, deflated, EqualOptions{}) {
+		t.Errorf("Deflated file differs: %v", Diff(data, deflated, EqualOptions{}))
+	}
+	name := data.Elements[data.indexOf(TagPatientName)].MustGetString()
+	if bytes.Contains(buff.Bytes(), []byte(name)) {
+		t.Errorf("Data set not deflated")
+	}
+
+	// data sets as exchanged over the network
+	buff.Reset()
+	if err := data.WriteDataSet(buff, DeflatedExplicitVRLittleEndian); err != nil {
+		t.Fatal(err)
+	}
+	dataSet, err := parser.ParseDataSet(buff.Bytes(), DeflatedExplicitVRLittleEndian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := dataSet.lookupString(TagPatientName); s != name {
+		t.Errorf("Incorrect PatientName %q", s)
+	}
+
+	limited, _ := NewParser(MaxDataSetLength(1024))
+	if _, err := limited.ParseDataSet(buff.Bytes(), DeflatedExplicitVRLittleEndian); !errors.Is(err, ErrDataSetTooLong) {
+		t.Errorf("Expected ErrDataSetTooLong, got %v", err)
+	}
+	if _, err := parser.ParseDataSet(buff.Bytes()[:buff.Len()/2], DeflatedExplicitVRLittleEndian); !errors.Is(err, ErrBrokenFile) {
+		t.Errorf("Expected ErrBrokenFile, got %v", err)
+	}
+
+}
+
+func TestParseWrongMetaGroupLength(t *testing.T) {
+
+	// the FileMetaInformationGroupLength value follows its tag, VR and
+	// length at the end of the preamble
+	file := append([]byte(nil), readFile()...)
+	binary.LittleEndian.PutUint32(file[140:], 4)
+
+	parser, _ := NewParser()
+	data, err := parser.Parse(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := data.LookupElementByTag(TagTransferSyntaxUID); err != nil {
+		t.Error("The meta information should be read up to the end of the group")
+	}
+
+}
+
+func TestParseReader(t *testing.T) {
+
+	parser, _ := NewParser()
+	data, err := parser.ParseReader(iotest.OneByteReader(bytes.NewReader(readFile())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, _ := parser.Parse(readFile())
+	if !Equal(data, expected, EqualOptions{}) {
+		t.Error("File read differs from the file parsed")
+	}
+
+	parser, _ = NewParser(MaxDataSetLength(1024))
+	if _, err := parser.ParseReader(bytes.NewReader(readFile())); !errors.Is(err, ErrDataSetTooLong) {
+		t.Errorf("Incorrect error %v", err)
+	}
+}
+
+// An explicit VR little endian PixelData element of defined length holding
+// items, followed by Data Set Trailing Padding
+func definedLengthFragments(items ...[]byte) []byte {
+	var value []byte
+	for _, item := range items {
+		value = append(value, 0xFE, 0xFF, 0x00, 0xE0)
+		value = binary.LittleEndian.AppendUint32(value, uint32(len(item)))
+		value = append(value, item...)
+	}
+	b := []byte{0xE0, 0x7F, 0x10, 0x00, 'O', 'B', 0, 0}
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(value)))
+	b = append(b, value...)
+	return append(b, 0xFC, 0xFF, 0xFC, 0xFF, 'O', 'B', 0, 0, 0, 0, 0, 0)
+}
+
+func TestDefinedLengthFragments(t *testing.T) {
+
+	data := definedLengthFragments(nil, []byte{0xFF, 0xD8, 1, 2}, []byte{3, 4, 0xFF, 0xD9})
+	p, _ := NewParser()
+
+	file, err := p.ParseDataSet(data, "1.2.840.10008.1.2.4.50")
+	if err != nil {
+		t.Fatal(err)
+	}
+	elem, _ := file.LookupElementByTag(TagPixelData)
+	pixels, err := elem.GetPixelData()
+	if err != nil || len(pixels.Fragments) != 2 || pixels.Fragments[1][0] != 3 {
+		t.Fatalf("Incorrect pixel data %v", elem.Value)
+	}
+	if elem.EncodedLength() != uint32(len(data)-12) {
+		t.Errorf("Incorrect length %d", elem.EncodedLength())
+	}
+	if _, err := file.LookupElementByTag(Tag{0xFFFC, 0xFFFC}); err != nil {
+		t.Errorf("Element after the pixel data not read")
+	}
+
+	// written with an undefined length
+	buff := new(bytes.Buffer)
+	if err := file.WriteDataSet(buff, "1.2.840.10008.1.2.4.50"); err != nil {
+		t.Fatal(err)
+	}
+	written, err := p.ParseDataSet(buff.Bytes(), "1.2.840.10008.1.2.4.50")
+	if err != nil || !Equal(file, written, EqualOptions{}) {
+		t.Errorf("Pixel data differ once written: %v", err)
+	}
+
+	// the same bytes are native pixel data in other transfer syntaxes
+	file, err = p.ParseDataSet(data, ExplicitVRLittleEndian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	elem, _ = file.LookupElementByTag(TagPixelData)
+	if b, ok := elem.Value.(Bytes); !ok || len(b) != 32 {
+		t.Errorf("Incorrect native pixel data %v", elem.Value)
+	}
+}
+
+func TestOddFragments(t *testing.T) {
+
+	fragments := func(pad bool) []byte {
+		b := []byte{0xE0, 0x7F, 0x10, 0x00, 'O', 'B', 0, 0, 0xFF, 0xFF, 0xFF, 0xFF}
+		b = append(b, 0xFE, 0xFF, 0x00, 0xE0, 0, 0, 0, 0)
+		b = append(b, 0xFE, 0xFF, 0x00, 0xE0, 3, 0, 0, 0, 0xFF, 0xD8, 1)
+		if pad {
+			b = append(b, 0)
+		}
+		b = append(b, 0xFE, 0xFF, 0x00, 0xE0, 2, 0, 0, 0, 2, 3)
+		return append(b, 0xFE, 0xFF, 0xDD, 0xE0, 0, 0, 0, 0)
+	}
+
+	p, _ := NewParser()
+	strict, _ := NewParser(Strict())
+	for _, pad := range []bool{false, true} {
+		file, err := p.ParseDataSet(fragments(pad), "1.2.840.10008.1.2.4.50")
+		if err != nil {
+			t.Fatalf("padding %v: %s", pad, err)
+		}
+		pixels, _ := file.Elements[0].GetPixelData()
+		if len(pixels.Fragments) != 2 || !bytes.Equal(pixels.Fragments[0], []byte{0xFF, 0xD8, 1}) {
+			t.Errorf("padding %v: incorrect fragments %v", pad, pixels.Fragments)
+		}
+		if len(file.Warnings) != 1 || !errors.Is(&file.Warnings[0], ErrOddLength) {
+			t.Errorf("padding %v: incorrect warnings %v", pad, file.Warnings)
+		}
+
+		if _, err := strict.ParseDataSet(fragments(pad), "1.2.840.10008.1.2.4.50"); !errors.Is(err, ErrOddLength) {
+			t.Errorf("padding %v: incorrect error %v", pad, err)
+		}
+	}
+}
@@ -0,0 +1,333 @@
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidFileID = errors.New("Invalid File ID")
+
+const (
+	mediaStorageDirectoryStorage = "1.2.840.10008.1.3.10"
+
+	// Written in the file meta information of the files created by the
+	// package
+	ImplementationClassUID = "2.25.270025503692976699803666568792812986035"
+)
+
+// A file of a file set, with its File ID: the components of its path from
+// the root of the file set, ie. ["DICOM", "ST000001", "IM000001"]
+type FileSetFile struct {
+	ID   []string
+	File *DicomFile
+}
+
+// Check a File ID (PS 3.10 8.5, PS 3.12 Annex F): at most 8 components of at
+// most 8 uppercase letters, digits and underscores
+func ValidateFileID(id []string) error {
+
+	if len(id) == 0 || len(id) > 8 {
+		return fmt.Errorf("%w: %d components", ErrInvalidFileID, len(id))
+	}
+
+	for _, c := range id {
+		if c == "" || len(c) > 8 {
+			return fmt.Errorf("%w: %q", ErrInvalidFileID, strings.Join(id, "/"))
+		}
+		for _, r := range c {
+			if !(r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_') {
+				return fmt.Errorf("%w: %q", ErrInvalidFileID, strings.Join(id, "/"))
+			}
+		}
+	}
+
+	return nil
+}
+
+// The keys of the directory records of each level, besides the unique key
+var recordKeys = map[string][]Tag{
+	PatientLevel: {TagSpecificCharacterSet, TagPatientName, TagPatientID},
+	StudyLevel: {TagSpecificCharacterSet, TagStudyDate, TagStudyTime, TagAccessionNumber, TagStudyDescription,
+		TagStudyInstanceUID, TagStudyID},
+	SeriesLevel: {TagSpecificCharacterSet, TagModality, TagSeriesInstanceUID, TagSeriesNumber},
+	ImageLevel:  {TagSpecificCharacterSet, TagInstanceNumber},
+}
+
+// A directory record and the records of the level below
+type dirRecord struct {
+	item     *Item
+	children []*dirRecord
+}
+
+// Build the DICOMDIR of a file set: PATIENT, STUDY, SERIES and IMAGE
+// directory records for the files, in the order they are given, with the
+// offsets of the records computed for the file as written by Write.
+func BuildDICOMDIR(files []FileSetFile) (*DicomFile, error) {
+
+	p := standardParser()
+
+	var patients []*dirRecord
+	records := map[string]*dirRecord{}
+	for _, f := range files {
+		if err := ValidateFileID(f.ID); err != nil {
+			return nil, err
+		}
+
+		siblings := &patients
+		for i, level := range []string{PatientLevel, StudyLevel, SeriesLevel} {
+			id := f.File.entityID([]string{PatientLevel, StudyLevel, SeriesLevel}[:i+1])
+			if id == "" {
+				return nil, fmt.Errorf("%w: %s of %s", ErrMissingUniqueKey, level, strings.Join(f.ID, "/"))
+			}
+			record, ok := records[id]
+			if !ok {
+				item, err := newDirRecord(p, level, f.File)
+				if err != nil {
+					return nil, err
+				}
+				record = &dirRecord{item: item}
+				records[id] = record
+				*siblings = append(*siblings, record)
+			}
+			siblings = &record.children
+		}
+
+		item, err := newDirRecord(p, ImageLevel, f.File)
+		if err != nil {
+			return nil, err
+		}
+		sopClass, _ := f.File.lookupString(TagSOPClassUID)
+		sopInstance, _ := f.File.lookupString(TagSOPInstanceUID)
+		ts, _ := f.File.lookupString(TagTransferSyntaxUID)
+		for _, ref := range []struct {
+			tag   Tag
+			value Strings
+		}{
+			{TagReferencedFileID, Strings(f.ID)},
+			{TagReferencedSOPClassUIDInFile, Strings{sopClass}},
+			{TagReferencedSOPInstanceUIDInFile, Strings{sopInstance}},
+			{TagReferencedTransferSyntaxUIDInFile, Strings{ts}},
+		} {
+			elem, err := p.NewElement(ref.tag, ref.value)
+			if err != nil {
+				return nil, err
+			}
+			elem.IndentLevel = 1
+			item.insertElement(elem)
+		}
+		*siblings = append(*siblings, &dirRecord{item: item})
+	}
+
+	dir, err := newDICOMDIR(p)
+	if err != nil {
+		return nil, err
+	}
+
+	// the records, depth first
+	var seq Sequence
+	var flatten func(records []*dirRecord)
+	flatten = func(records []*dirRecord) {
+		for _, record := range records {
+			seq = append(seq, record.item)
+			flatten(record.children)
+		}
+	}
+	flatten(patients)
+
+	// offsets from the start of the file: the items of the sequence follow
+	// everything else, written with undefined lengths
+	drs := &dir.Elements[dir.indexOf(TagDirectoryRecordSequence)]
+	buffer := new(bytes.Buffer)
+	if err := dir.Write(buffer); err != nil {
+		return nil, err
+	}
+	start := uint32(buffer.Len() - 8) // before the sequence delimiter
+
+	offsets := map[*Item]uint32{}
+	pos := start
+	for _, item := range seq {
+		offsets[item] = pos
+		writer := newDicomWriter(binary.LittleEndian, false)
+		for _, elem := range item.Elements {
+			if err := writer.writeElement(elem); err != nil {
+				return nil, err
+			}
+		}
+		pos += 16 + uint32(writer.Len())
+	}
+
+	var link func(records []*dirRecord)
+	link = func(records []*dirRecord) {
+		for i, record := range records {
+			var next, lower uint32
+			if i+1 < len(records) {
+				next = offsets[records[i+1].item]
+			}
+			if len(record.children) > 0 {
+				lower = offsets[record.children[0].item]
+			}
+			setUInt32(record.item.Elements, TagOffsetOfTheNextDirectoryRecord, next)
+			setUInt32(record.item.Elements, TagOffsetOfReferencedLowerLevelDirectoryEntity, lower)
+			link(record.children)
+		}
+	}
+	link(patients)
+
+	var first, last uint32
+	if len(patients) > 0 {
+		first, last = offsets[patients[0].item], offsets[patients[len(patients)-1].item]
+	}
+	setUInt32(dir.topLevel(), TagOffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity, first)
+	setUInt32(dir.topLevel(), TagOffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity, last)
+	drs.Value = seq
+
+	return dir, nil
+}
+
+// Create an empty DICOMDIR, in explicit VR little endian
+func newDICOMDIR(p *Parser) (*DicomFile, error) {
+
+	dir := &DicomFile{}
+	for _, attr := range []struct {
+		tag   Tag
+		value Value
+	}{
+		{TagFileMetaInformationVersion, Bytes{0x00, 0x01}},
+		{TagMediaStorageSOPClassUID, Strings{mediaStorageDirectoryStorage}},
+		{TagMediaStorageSOPInstanceUID, Strings{newUID()}},
+		{TagTransferSyntaxUID, Strings{explicit_vr_little_endian}},
+		{TagImplementationClassUID, Strings{ImplementationClassUID}},
+		{TagFileSetID, Strings{}},
+		{TagOffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity, UInt32s{0}},
+		{TagOffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity, UInt32s{0}},
+		{TagFileSetConsistencyFlag, UInt16s{0}},
+		{TagDirectoryRecordSequence, Sequence{}},
+	} {
+		elem, err := p.NewElement(attr.tag, attr.value)
+		if err != nil {
+			return nil, err
+		}
+		dir.appendDataElement(elem)
+	}
+
+	return dir, nil
+}
+
+// Create a directory record of a level, with the keys of the file
+func newDirRecord(p *Parser, level string, file *DicomFile) (*Item, error) {
+
+	item := &Item{}
+	for _, attr := range []struct {
+		tag   Tag
+		value Value
+	}{
+		{TagOffsetOfTheNextDirectoryRecord, UInt32s{0}},
+		{TagRecordInUseFlag, UInt16s{0xffff}},
+		{TagOffsetOfReferencedLowerLevelDirectoryEntity, UInt32s{0}},
+		{TagDirectoryRecordType, Strings{level}},
+	} {
+		elem, err := p.NewElement(attr.tag, attr.value)
+		if err != nil {
+			return nil, err
+		}
+		elem.IndentLevel = 1
+		item.Elements = append(item.Elements, elem)
+	}
+
+	for _, tag := range recordKeys[level] {
+		i := file.indexOf(tag)
+		if i < 0 {
+			continue
+		}
+		elem := file.Elements[i].Clone()
+		elem.IndentLevel = 1
+		item.Elements = append(item.Elements, elem)
+	}
+
+	return item, nil
+}
+
+func indexOfTag(elems []*DicomElement, tag Tag) int {
+	for i, elem := range elems {
+		if elem.Tag() == tag {
+			return i
+		}
+	}
+	return -1
+}
+
+func setUInt32(elems []*DicomElement, tag Tag, v uint32) {
+	if i := indexOfTag(elems, tag); i >= 0 {
+		elems[i].Value = UInt32s{v}
+	}
+}
+
+// A file referenced by a DICOMDIR that does not match the file set
+type FileSetProblem struct {
+	ID      []string // the File ID
+	Problem string
+}
+
+// Stringer
+func (p FileSetProblem) String() string {
+	return strings.Join(p.ID, "/") + ": " + p.Problem
+}
+
+// Compare the records of a DICOMDIR with the files of the file set: files
+// that are referenced but missing, files that are not referenced, and
+// referenced files with another SOP Instance UID
+func VerifyDICOMDIR(dir *DicomFile, files []FileSetFile) ([]FileSetProblem, error) {
+
+	elem, err := dir.LookupElementByTag(TagDirectoryRecordSequence)
+	if err != nil {
+		return nil, err
+	}
+	seq, err := elem.GetSequence()
+	if err != nil {
+		return nil, err
+	}
+
+	present := map[string]*DicomFile{}
+	for _, f := range files {
+		present[strings.Join(f.ID, "/")] = f.File
+	}
+
+	var problems []FileSetProblem
+	referenced := map[string]bool{}
+	for _, item := range seq {
+		i := indexOfTag(item.Elements, TagReferencedFileID)
+		if i < 0 {
+			continue
+		}
+		var id []string
+		for _, c := range item.Elements[i].MustGetStrings() {
+			id = append(id, strings.TrimSpace(c))
+		}
+		key := strings.Join(id, "/")
+		referenced[key] = true
+
+		file, ok := present[key]
+		if !ok {
+			problems = append(problems, FileSetProblem{id, "referenced but missing"})
+			continue
+		}
+
+		if j := indexOfTag(item.Elements, TagReferencedSOPInstanceUIDInFile); j >= 0 {
+			uid := strings.Trim(item.Elements[j].MustGetString(), " \x00")
+			if actual, _ := file.lookupString(TagSOPInstanceUID); actual != uid {
+				problems = append(problems, FileSetProblem{id, fmt.Sprintf("SOPInstanceUID %s, referenced as %s", actual, uid)})
+			}
+		}
+	}
+
+	for _, f := range files {
+		if !referenced[strings.Join(f.ID, "/")] {
+			problems = append(problems, FileSetProblem{f.ID, "not referenced"})
+		}
+	}
+
+	return problems, nil
+}
@@ -0,0 +1,147 @@
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"testing"
+)
+
+func fileSet(t *testing.T) []FileSetFile {
+
+	p, _ := NewParser()
+	var files []FileSetFile
+	for i := 1; i <= 3; i++ {
+		buff, err := ioutil.ReadFile(fmt.Sprintf("examples/IM-0001-000%d.dcm", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		file, err := p.Parse(buff)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, FileSetFile{[]string{"DICOM", fmt.Sprintf("IM%06d", i)}, file})
+	}
+
+	return files
+}
+
+func TestBuildDICOMDIR(t *testing.T) {
+
+	files := fileSet(t)
+	dir, err := BuildDICOMDIR(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buffer := new(bytes.Buffer)
+	if err := dir.Write(buffer); err != nil {
+		t.Fatal(err)
+	}
+	written := buffer.Bytes()
+
+	p, _ := NewParser()
+	parsed, err := p.Parse(written)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seq := parsed.FindAllByTag(TagDirectoryRecordSequence)[0].MustGetSequence()
+	if len(seq) != 6 {
+		t.Fatalf("Expected 6 records, got %d", len(seq))
+	}
+
+	// every offset points to an item
+	isItem := func(offset uint32) bool {
+		return int(offset)+4 <= len(written) &&
+			binary.LittleEndian.Uint16(written[offset:]) == TagItem.Group &&
+			binary.LittleEndian.Uint16(written[offset+2:]) == TagItem.Element
+	}
+	first := parsed.FindAllByTag(TagOffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity)[0].MustGetUInt32()
+	if !isItem(first) {
+		t.Errorf("Incorrect offset of the first record %d", first)
+	}
+	for _, elem := range parsed.FindAllByTag(TagOffsetOfTheNextDirectoryRecord) {
+		if offset := elem.MustGetUInt32(); offset != 0 && !isItem(offset) {
+			t.Errorf("Incorrect offset of the next record %d", offset)
+		}
+	}
+
+	types := ""
+	for _, item := range seq {
+		types += item.Elements[indexOfTag(item.Elements, TagDirectoryRecordType)].MustGetString()[:2]
+	}
+	if types != "PASTSEIMIMIM" {
+		t.Errorf("Incorrect records %s", types)
+	}
+
+	problems, err := VerifyDICOMDIR(parsed, files)
+	if err != nil || len(problems) != 0 {
+		t.Errorf("Unexpected problems %v %v", problems, err)
+	}
+
+	problems, _ = VerifyDICOMDIR(parsed, files[1:])
+	if len(problems) != 1 || problems[0].String() != "DICOM/IM000001: referenced but missing" {
+		t.Errorf("Incorrect problems %v", problems)
+	}
+
+}
+
+func TestDICOMDIRImplicitVR(t *testing.T) {
+
+	dir, err := BuildDICOMDIR(fileSet(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, _ := NewParser()
+	ts, err := p.NewElement(TagTransferSyntaxUID, Strings{implicit_vr_little_endian})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir.setElement(ts)
+
+	buffer := new(bytes.Buffer)
+	if err := dir.Write(buffer); err != nil {
+		t.Fatal(err)
+	}
+	written := buffer.Bytes()
+
+	parsed, err := p.Parse(written)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := parsed.FindAllByTag(TagOffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity)[0]
+	expected := dir.FindAllByTag(TagOffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity)[0].MustGetUInt32()
+	if offset, err := first.GetUInt32(); first.Vr != "UL" || err != nil || offset != expected {
+		t.Errorf("Incorrect offset of the first record %s %v", first.Vr, first.Value)
+	}
+	for _, elem := range parsed.FindAllByTag(TagOffsetOfTheNextDirectoryRecord) {
+		if _, err := elem.GetUInt32(); err != nil {
+			t.Errorf("Offset of the next record read as %v", elem.Value)
+		}
+	}
+
+	buffer.Reset()
+	if err := parsed.Write(buffer); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buffer.Bytes(), written) {
+		t.Error("DICOMDIR in implicit VR not written back as read")
+	}
+
+}
+
+func TestValidateFileID(t *testing.T) {
+
+	if err := ValidateFileID([]string{"DICOM", "ST_01", "IM000001"}); err != nil {
+		t.Error(err)
+	}
+
+	for _, id := range [][]string{nil, {"im0001"}, {"IM-0001"}, {"IM0000001"}, {"A", ""}} {
+		if err := ValidateFileID(id); err == nil {
+			t.Errorf("Expected an error for %v", id)
+		}
+	}
+
+}
@@ -0,0 +1,153 @@
+package dicomgrpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"io"
+	"net/http"
+)
+
+// A client of the DicomStore service
+type Client struct {
+	url  string
+	http *http.Client
+}
+
+// Create a client of the server listening at addr, ie. "localhost:50051",
+// over HTTP/2 without TLS
+func NewClient(addr string) *Client {
+	var protocols http.Protocols
+	protocols.SetUnencryptedHTTP2(true)
+	return &Client{"http://" + addr, &http.Client{Transport: &http.Transport{Protocols: &protocols}}}
+}
+
+// An instance fetched: its data set in the DICOM JSON Model, without the
+// pixel data, and the pixel data by bulk data URI, ie. 7FE00010 for native
+// pixel data
+type Instance struct {
+	Metadata []byte
+	BulkData map[string][]byte
+}
+
+// Store a file, and return its SOP Instance UID
+func (c *Client) Store(ctx context.Context, file *dicom.DicomFile) (string, error) {
+
+	pr, pw := io.Pipe()
+	go func() {
+		w := bufio.NewWriterSize(chunkWriter{pw}, chunkSize)
+		err := file.Write(w)
+		if err == nil {
+			err = w.Flush()
+		}
+		pw.CloseWithError(err)
+	}()
+	defer pr.Close()
+
+	var rsp storeResponse
+	err := c.call(ctx, storePath, pr, func(msg []byte) error {
+		return rsp.unmarshal(msg)
+	})
+	return rsp.sopInstanceUID, err
+}
+
+// Sends the bytes written as the chunks of store requests
+type chunkWriter struct {
+	w io.Writer
+}
+
+func (cw chunkWriter) Write(b []byte) (int, error) {
+	if err := writeFrame(cw.w, (&storeRequest{b}).marshal()); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// Fetch the instance with a SOP Instance UID. Returns an error matching
+// dicom.ErrNotFound if the server has no such instance.
+func (c *Client) Fetch(ctx context.Context, sopInstanceUID string) (*Instance, error) {
+
+	instance := &Instance{BulkData: map[string][]byte{}}
+	err := c.call(ctx, fetchPath, requestBody(&fetchRequest{sopInstanceUID}), func(msg []byte) error {
+		var rsp fetchResponse
+		if err := rsp.unmarshal(msg); err != nil {
+			return err
+		}
+		if rsp.bulkData == nil {
+			instance.Metadata = []byte(rsp.metadata)
+		} else {
+			instance.BulkData[rsp.bulkData.uri] = append(instance.BulkData[rsp.bulkData.uri], rsp.bulkData.chunk...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return instance, nil
+}
+
+// Call fn with the metadata of every instance matching a filter expression,
+// of all instances if filter is empty, at most limit of them if limit is not
+// 0, as they are received
+func (c *Client) Query(ctx context.Context, filter string, limit int, fn func(metadata []byte) error) error {
+	return c.call(ctx, queryPath, requestBody(&queryRequest{filter, int32(limit)}), func(msg []byte) error {
+		var rsp queryResponse
+		if err := rsp.unmarshal(msg); err != nil {
+			return err
+		}
+		return fn([]byte(rsp.metadata))
+	})
+}
+
+// The body of a request with a single message
+func requestBody(m interface{ marshal() []byte }) io.Reader {
+	body := new(bytes.Buffer)
+	writeFrame(body, m.marshal())
+	return body
+}
+
+// Call a method, sending the messages of body, and calling fn with every
+// message of the response
+func (c *Client) call(ctx context.Context, path string, body io.Reader, fn func(msg []byte) error) error {
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.url+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/grpc")
+	req.Header.Set("TE", "trailers")
+
+	rsp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return &StatusError{CodeInternal, fmt.Sprintf("HTTP status %s", rsp.Status)}
+	}
+
+	for {
+		msg, err := readFrame(rsp.Body)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(msg); err != nil {
+			return err
+		}
+	}
+
+	// responses without messages may have their status in the headers
+	if status := rsp.Trailer.Get("Grpc-Status"); status != "" {
+		return parseStatus(status, rsp.Trailer.Get("Grpc-Message"))
+	}
+	if status := rsp.Header.Get("Grpc-Status"); status != "" {
+		return parseStatus(status, rsp.Header.Get("Grpc-Message"))
+	}
+	return &StatusError{CodeInternal, "response without gRPC status"}
+}
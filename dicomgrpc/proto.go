@@ -0,0 +1,225 @@
+package dicomgrpc
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var ErrInvalidMessage = errors.New("Invalid protocol buffer message")
+
+// Maximum size of the messages received, the default of gRPC
+const maxMessageSize = 4 << 20
+
+// The messages of dicom.proto, with their protocol buffer encoding
+
+type storeRequest struct {
+	chunk []byte
+}
+
+type storeResponse struct {
+	sopInstanceUID string
+}
+
+type fetchRequest struct {
+	sopInstanceUID string
+}
+
+// A fetch response holds either metadata or bulk data
+type fetchResponse struct {
+	metadata string
+	bulkData *bulkData
+}
+
+type bulkData struct {
+	uri   string
+	chunk []byte
+}
+
+type queryRequest struct {
+	filter string
+	limit  int32
+}
+
+type queryResponse struct {
+	metadata string
+}
+
+func (m *storeRequest) marshal() []byte  { return appendBytes(nil, 1, m.chunk) }
+func (m *storeResponse) marshal() []byte { return appendBytes(nil, 1, []byte(m.sopInstanceUID)) }
+func (m *fetchRequest) marshal() []byte  { return appendBytes(nil, 1, []byte(m.sopInstanceUID)) }
+func (m *queryResponse) marshal() []byte { return appendBytes(nil, 1, []byte(m.metadata)) }
+
+func (m *fetchResponse) marshal() []byte {
+	if m.bulkData != nil {
+		bulk := appendBytes(appendBytes(nil, 1, []byte(m.bulkData.uri)), 2, m.bulkData.chunk)
+		return appendBytes(nil, 2, bulk)
+	}
+	return appendBytes(nil, 1, []byte(m.metadata))
+}
+
+func (m *queryRequest) marshal() []byte {
+	b := appendBytes(nil, 1, []byte(m.filter))
+	b = binary.AppendUvarint(b, 2<<3|0)
+	return binary.AppendUvarint(b, uint64(int64(m.limit)))
+}
+
+func (m *storeRequest) unmarshal(b []byte) error {
+	return readFields(b, func(n int, _ uint64, v []byte) error {
+		if n == 1 {
+			m.chunk = v
+		}
+		return nil
+	})
+}
+
+func (m *storeResponse) unmarshal(b []byte) error {
+	return readFields(b, func(n int, _ uint64, v []byte) error {
+		if n == 1 {
+			m.sopInstanceUID = string(v)
+		}
+		return nil
+	})
+}
+
+func (m *fetchRequest) unmarshal(b []byte) error {
+	return readFields(b, func(n int, _ uint64, v []byte) error {
+		if n == 1 {
+			m.sopInstanceUID = string(v)
+		}
+		return nil
+	})
+}
+
+func (m *fetchResponse) unmarshal(b []byte) error {
+	return readFields(b, func(n int, _ uint64, v []byte) error {
+		switch n {
+		case 1:
+			m.metadata, m.bulkData = string(v), nil
+		case 2:
+			m.bulkData = &bulkData{}
+			return readFields(v, func(n int, _ uint64, v []byte) error {
+				switch n {
+				case 1:
+					m.bulkData.uri = string(v)
+				case 2:
+					m.bulkData.chunk = v
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+func (m *queryRequest) unmarshal(b []byte) error {
+	return readFields(b, func(n int, varint uint64, v []byte) error {
+		switch n {
+		case 1:
+			m.filter = string(v)
+		case 2:
+			m.limit = int32(varint)
+		}
+		return nil
+	})
+}
+
+func (m *queryResponse) unmarshal(b []byte) error {
+	return readFields(b, func(n int, _ uint64, v []byte) error {
+		if n == 1 {
+			m.metadata = string(v)
+		}
+		return nil
+	})
+}
+
+// Append a length delimited field n
+func appendBytes(b []byte, n int, v []byte) []byte {
+	b = binary.AppendUvarint(b, uint64(n)<<3|2)
+	b = binary.AppendUvarint(b, uint64(len(v)))
+	return append(b, v...)
+}
+
+// Call fn with the number and value of every field of a message: the value
+// of varints, or the bytes of length delimited fields. Fixed size fields are
+// skipped.
+func readFields(b []byte, fn func(n int, varint uint64, v []byte) error) error {
+
+	for len(b) > 0 {
+		key, k := binary.Uvarint(b)
+		if k <= 0 {
+			return ErrInvalidMessage
+		}
+		b = b[k:]
+
+		var varint uint64
+		var v []byte
+		switch key & 7 {
+		case 0:
+			varint, k = binary.Uvarint(b)
+			if k <= 0 {
+				return ErrInvalidMessage
+			}
+			b = b[k:]
+		case 1, 5:
+			size := 8
+			if key&7 == 5 {
+				size = 4
+			}
+			if len(b) < size {
+				return ErrInvalidMessage
+			}
+			b = b[size:]
+			continue
+		case 2:
+			length, k := binary.Uvarint(b)
+			if k <= 0 || length > uint64(len(b)-k) {
+				return ErrInvalidMessage
+			}
+			v, b = b[k:k+int(length)], b[k+int(length):]
+		default:
+			return fmt.Errorf("%w: wire type %d", ErrInvalidMessage, key&7)
+		}
+
+		if err := fn(int(key>>3), varint, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Write a message with the length prefix of gRPC, uncompressed
+func writeFrame(w io.Writer, msg []byte) error {
+	head := make([]byte, 5, 5+len(msg))
+	binary.BigEndian.PutUint32(head[1:], uint32(len(msg)))
+	_, err := w.Write(append(head, msg...))
+	return err
+}
+
+// Read a message with the length prefix of gRPC. Returns io.EOF at the end
+// of the stream.
+func readFrame(r io.Reader) ([]byte, error) {
+
+	head := make([]byte, 5)
+	if _, err := io.ReadFull(r, head); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, ErrInvalidMessage
+		}
+		return nil, err
+	}
+	if head[0] != 0 {
+		return nil, &StatusError{CodeUnimplemented, "compressed messages are not supported"}
+	}
+	length := binary.BigEndian.Uint32(head[1:])
+	if length > maxMessageSize {
+		return nil, &StatusError{CodeResourceExhausted, fmt.Sprintf("message of %d bytes", length)}
+	}
+
+	msg := make([]byte, length)
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, ErrInvalidMessage
+	}
+	return msg, nil
+}
@@ -0,0 +1,262 @@
+// Package dicomgrpc serves the instances of a dicom.Store with the
+// DicomStore service of dicom.proto, for services exchanging DICOM data sets
+// without DIMSE. Requests are served over HTTP/2 without TLS, with the
+// framing of gRPC, so that clients generated from dicom.proto can call it.
+package dicomgrpc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// The paths of the methods of the DicomStore service
+const (
+	storePath = "/dicom.v1.DicomStore/Store"
+	fetchPath = "/dicom.v1.DicomStore/Fetch"
+	queryPath = "/dicom.v1.DicomStore/Query"
+)
+
+// Size of the chunks of files and bulk data sent
+const chunkSize = 64 << 10
+
+// The URI of the bulk data of native pixel data
+const pixelDataURI = "7FE00010"
+
+// The maximum size of the files received in Store, by default
+const DefaultMaxFileSize = 1 << 30
+
+// A server of the DicomStore service, storing the instances received in
+// Store. Server is an http.Handler.
+type Server struct {
+	Store       *dicom.Store
+	MaxFileSize int // of the files received in Store, DefaultMaxFileSize if 0
+}
+
+// Serve the requests of the connections accepted on l with HTTP/2 without
+// TLS, until accepting a connection fails
+func (s *Server) Serve(l net.Listener) error {
+	var protocols http.Protocols
+	protocols.SetUnencryptedHTTP2(true)
+	return (&http.Server{Handler: s, Protocols: &protocols}).Serve(l)
+}
+
+// Listen on the TCP address addr and serve the requests
+func (s *Server) ListenAndServe(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+	return s.Serve(l)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != "POST" || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+		http.Error(w, "Not a gRPC request", http.StatusUnsupportedMediaType)
+		return
+	}
+	w.Header().Set("Content-Type", "application/grpc")
+
+	var err error
+	switch r.URL.Path {
+	case storePath:
+		err = s.store(w, r)
+	case fetchPath:
+		err = s.fetch(w, r)
+	case queryPath:
+		err = s.query(w, r)
+	default:
+		err = &StatusError{CodeUnimplemented, "unknown method " + r.URL.Path}
+	}
+
+	status := statusOf(err)
+	w.Header().Set(http.TrailerPrefix+"Grpc-Status", strconv.Itoa(int(status.Code)))
+	if status.Message != "" {
+		w.Header().Set(http.TrailerPrefix+"Grpc-Message", encodeMessage(status.Message))
+	}
+}
+
+// Read the single message of a request into m
+func readRequest(r *http.Request, m interface{ unmarshal([]byte) error }) error {
+	msg, err := readFrame(r.Body)
+	if err == io.EOF {
+		return &StatusError{CodeInvalidArgument, "no request message"}
+	}
+	if err != nil {
+		return err
+	}
+	return m.unmarshal(msg)
+}
+
+// Send a message of the response
+func send(w http.ResponseWriter, msg []byte) error {
+	if err := writeFrame(w, msg); err != nil {
+		return err
+	}
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+// Parse the file sent in chunks, and add it to the store. Files larger than
+// MaxFileSize are rejected with CodeResourceExhausted.
+func (s *Server) store(w http.ResponseWriter, r *http.Request) error {
+
+	maxSize := s.MaxFileSize
+	if maxSize == 0 {
+		maxSize = DefaultMaxFileSize
+	}
+
+	buff := new(bytes.Buffer)
+	for {
+		msg, err := readFrame(r.Body)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		var req storeRequest
+		if err := req.unmarshal(msg); err != nil {
+			return err
+		}
+		if buff.Len()+len(req.chunk) > maxSize {
+			return &StatusError{CodeResourceExhausted, fmt.Sprintf("file larger than %d bytes", maxSize)}
+		}
+		buff.Write(req.chunk)
+	}
+
+	p, err := dicom.NewParser()
+	if err != nil {
+		return err
+	}
+	file, err := p.Parse(buff.Bytes())
+	if err != nil {
+		return &StatusError{CodeInvalidArgument, err.Error()}
+	}
+	file.Detach()
+	if err := s.Store.Add(file); err != nil {
+		return &StatusError{CodeInvalidArgument, err.Error()}
+	}
+
+	elem, _ := file.LookupElementByTag(dicom.TagSOPInstanceUID)
+	uid, _ := elem.GetString()
+	return send(w, (&storeResponse{strings.TrimRight(uid, " \x00")}).marshal())
+}
+
+// Send the metadata, then the pixel data of an instance
+func (s *Server) fetch(w http.ResponseWriter, r *http.Request) error {
+
+	var req fetchRequest
+	if err := readRequest(r, &req); err != nil {
+		return err
+	}
+	file, ok := s.Store.Get(req.sopInstanceUID)
+	if !ok {
+		return &StatusError{CodeNotFound, "no instance " + req.sopInstanceUID}
+	}
+
+	metadata, err := metadataOf(file)
+	if err != nil {
+		return err
+	}
+	if err := send(w, (&fetchResponse{metadata: metadata}).marshal()); err != nil {
+		return err
+	}
+
+	elem, err := file.LookupElementByTag(dicom.TagPixelData)
+	if err != nil {
+		return nil
+	}
+	switch v := elem.Value.(type) {
+	case dicom.Bytes:
+		return sendBulkData(w, pixelDataURI, v)
+	case dicom.UInt16s:
+		b := make([]byte, 0, 2*len(v))
+		for _, u := range v {
+			b = binary.LittleEndian.AppendUint16(b, u)
+		}
+		return sendBulkData(w, pixelDataURI, b)
+	case *dicom.PixelData:
+		for i, fragment := range v.Fragments {
+			if err := sendBulkData(w, fmt.Sprintf("%s/%d", pixelDataURI, i+1), fragment); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Send bulk data in chunks, at least one
+func sendBulkData(w http.ResponseWriter, uri string, b []byte) error {
+	for first := true; first || len(b) > 0; first = false {
+		n := len(b)
+		if n > chunkSize {
+			n = chunkSize
+		}
+		if err := send(w, (&fetchResponse{bulkData: &bulkData{uri, b[:n]}}).marshal()); err != nil {
+			return err
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// Send the metadata of the instances matching a filter, of all of them if
+// the filter is empty
+func (s *Server) query(w http.ResponseWriter, r *http.Request) error {
+
+	var req queryRequest
+	if err := readRequest(r, &req); err != nil {
+		return err
+	}
+	files := s.Store.Files()
+	if req.filter != "" {
+		filter, err := dicom.CompileFilter(req.filter)
+		if err != nil {
+			return &StatusError{CodeInvalidArgument, err.Error()}
+		}
+		files = s.Store.Filter(filter)
+	}
+
+	for i, file := range files {
+		if req.limit > 0 && i >= int(req.limit) {
+			break
+		}
+		metadata, err := metadataOf(file)
+		if err != nil {
+			return err
+		}
+		if err := send(w, (&queryResponse{metadata}).marshal()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Return the data set of a file in the DICOM JSON Model, without its pixel
+// data
+func metadataOf(file *dicom.DicomFile) (string, error) {
+
+	meta := &dicom.DicomFile{}
+	for _, elem := range file.Elements {
+		if elem.Tag() != dicom.TagPixelData {
+			meta.Elements = append(meta.Elements, elem)
+		}
+	}
+
+	buff := new(strings.Builder)
+	if err := meta.WriteJSON(buff, dicom.JSONOptions{}); err != nil {
+		return "", err
+	}
+	return buff.String(), nil
+}
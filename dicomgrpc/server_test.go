@@ -0,0 +1,124 @@
+package dicomgrpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/gillesdemey/go-dicom"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func readExample(t *testing.T, name string) *dicom.DicomFile {
+
+	buff, err := ioutil.ReadFile("../examples/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, _ := dicom.NewParser()
+	file, err := p.Parse(buff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return file
+}
+
+func startServer(t *testing.T, s *Server) *Client {
+
+	srv := httptest.NewUnstartedServer(s)
+	srv.Config.Protocols = new(http.Protocols)
+	srv.Config.Protocols.SetUnencryptedHTTP2(true)
+	srv.Start()
+	t.Cleanup(srv.Close)
+
+	return NewClient(strings.TrimPrefix(srv.URL, "http://"))
+}
+
+func TestStoreFetch(t *testing.T) {
+
+	client := startServer(t, &Server{Store: dicom.NewStore()})
+	file := readExample(t, "IM-0001-0001.dcm")
+
+	uid, err := client.Store(context.Background(), file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	elem, _ := file.LookupElementByTag(dicom.TagSOPInstanceUID)
+	want, _ := elem.GetString()
+	if uid != strings.TrimRight(want, " \x00") {
+		t.Errorf("Store returned %q, expected %q", uid, want)
+	}
+
+	instance, err := client.Fetch(context.Background(), uid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(instance.Metadata, []byte(uid)) {
+		t.Errorf("Metadata without the SOP Instance UID: %s", instance.Metadata)
+	}
+	if bytes.Contains(instance.Metadata, []byte(`"7FE00010"`)) {
+		t.Error("Metadata with the pixel data")
+	}
+
+	elem, err = file.LookupElementByTag(dicom.TagPixelData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := elem.Value.(dicom.Bytes); ok && !bytes.Equal(instance.BulkData[pixelDataURI], v) {
+		t.Errorf("Fetched %d bytes of pixel data, expected %d", len(instance.BulkData[pixelDataURI]), len(v))
+	}
+	if len(instance.BulkData) == 0 {
+		t.Error("No bulk data fetched")
+	}
+}
+
+func TestStoreTooLarge(t *testing.T) {
+
+	client := startServer(t, &Server{Store: dicom.NewStore(), MaxFileSize: 1024})
+	file := readExample(t, "IM-0001-0001.dcm")
+
+	var status *StatusError
+	if _, err := client.Store(context.Background(), file); !errors.As(err, &status) || status.Code != CodeResourceExhausted {
+		t.Errorf("Storing a file larger than MaxFileSize returned %v", err)
+	}
+}
+
+func TestFetchUnknown(t *testing.T) {
+
+	client := startServer(t, &Server{Store: dicom.NewStore()})
+	_, err := client.Fetch(context.Background(), "1.2.3")
+	if !errors.Is(err, dicom.ErrNotFound) {
+		t.Errorf("Fetching an unknown instance returned %v", err)
+	}
+}
+
+func TestQuery(t *testing.T) {
+
+	client := startServer(t, &Server{Store: dicom.NewStore()})
+	for _, name := range []string{"IM-0001-0001.dcm", "IM-0001-0002.dcm", "IM-0001-0003.dcm"} {
+		if _, err := client.Store(context.Background(), readExample(t, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var n int
+	err := client.Query(context.Background(), "", 2, func(metadata []byte) error {
+		n++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("Query returned %d instances, expected 2", n)
+	}
+
+	err = client.Query(context.Background(), "Modality ==", 0, func([]byte) error { return nil })
+	if e, ok := err.(*StatusError); !ok || e.Code != CodeInvalidArgument {
+		t.Errorf("Query with an invalid filter returned %v", err)
+	}
+}
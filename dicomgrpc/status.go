@@ -0,0 +1,79 @@
+package dicomgrpc
+
+import (
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// A gRPC status code
+type Code uint32
+
+const (
+	CodeOK                Code = 0
+	CodeInvalidArgument   Code = 3
+	CodeNotFound          Code = 5
+	CodeResourceExhausted Code = 8
+	CodeUnimplemented     Code = 12
+	CodeInternal          Code = 13
+)
+
+// The status of a failed call. A status of CodeNotFound matches
+// dicom.ErrNotFound with errors.Is.
+type StatusError struct {
+	Code    Code
+	Message string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("gRPC status %d: %s", e.Code, e.Message)
+}
+
+func (e *StatusError) Is(target error) bool {
+	return e.Code == CodeNotFound && target == dicom.ErrNotFound
+}
+
+// Return the status of the result of a call
+func statusOf(err error) *StatusError {
+	switch e := err.(type) {
+	case nil:
+		return &StatusError{CodeOK, ""}
+	case *StatusError:
+		return e
+	}
+	if err == ErrInvalidMessage {
+		return &StatusError{CodeInvalidArgument, err.Error()}
+	}
+	return &StatusError{CodeInternal, err.Error()}
+}
+
+// Percent-encode a status message, as in the Grpc-Message header
+func encodeMessage(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < 0x20 || c > 0x7e || c == '%' {
+			fmt.Fprintf(&sb, "%%%02X", c)
+		} else {
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// Return the status of a response from its Grpc-Status and Grpc-Message
+// trailers, or headers for responses without messages
+func parseStatus(status, message string) error {
+	code, err := strconv.ParseUint(status, 10, 32)
+	if err != nil {
+		return &StatusError{CodeInternal, fmt.Sprintf("invalid gRPC status %q", status)}
+	}
+	if code == uint64(CodeOK) {
+		return nil
+	}
+	if decoded, err := url.PathUnescape(message); err == nil {
+		message = decoded
+	}
+	return &StatusError{Code(code), message}
+}
@@ -0,0 +1,367 @@
+// Package dicomnet implements the DICOM upper layer protocol and the DIMSE
+// services (PS 3.7, PS 3.8) on top of the dicom package.
+package dicomnet
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"io"
+	"net"
+)
+
+var (
+	ErrRejected              = errors.New("Association rejected")
+	ErrAborted               = errors.New("Association aborted")
+	ErrUnexpectedPDU         = errors.New("Unexpected PDU")
+	ErrNoPresentationContext = errors.New("No accepted presentation context")
+)
+
+// Written in the A-ASSOCIATE PDUs
+const ImplementationVersionName = "GO-DICOM"
+
+// The maximum length of the PDUs received, unless configured otherwise
+const DefaultMaxPDULength = 16384
+
+// The size of the buffer of the reads from the connection, unless configured
+// otherwise, so that PDU headers and small PDUs do not cost a read each
+const DefaultReadBufferSize = 64 << 10
+
+// The AE titles and limits of an association
+type Config struct {
+	CallingAE      string
+	CalledAE       string
+	MaxPDULength   uint32 // of the PDUs received, DefaultMaxPDULength if 0
+	ReadBufferSize int    // of the connection, DefaultReadBufferSize if 0
+}
+
+// An abstract syntax and the transfer syntaxes proposed for it
+type PresentationContext struct {
+	AbstractSyntax   string
+	TransferSyntaxes []string
+}
+
+// An established association, over which DIMSE messages are exchanged
+type Association struct {
+	conn      net.Conn
+	r         *bufio.Reader // buffered reads of conn
+	parser    *dicom.Parser
+	config    Config
+	contexts  map[byte]*presentationContext // accepted, with the transfer syntax picked
+	maxLength uint32                        // of the PDUs sent, 0 if unlimited
+	messageID uint16
+}
+
+// A DIMSE message: a command set and its data set, if any, encoded with the
+// transfer syntax of the presentation context
+type message struct {
+	contextID byte
+	command   *dicom.DicomFile
+	data      []byte
+}
+
+func newAssociation(conn net.Conn, config Config) (*Association, error) {
+
+	parser, err := dicom.NewParser()
+	if err != nil {
+		return nil, err
+	}
+
+	if config.MaxPDULength == 0 {
+		config.MaxPDULength = DefaultMaxPDULength
+	}
+	if config.ReadBufferSize == 0 {
+		config.ReadBufferSize = DefaultReadBufferSize
+	}
+
+	return &Association{
+		conn:     conn,
+		r:        bufio.NewReaderSize(conn, config.ReadBufferSize),
+		parser:   parser,
+		config:   config,
+		contexts: map[byte]*presentationContext{},
+	}, nil
+}
+
+// Open an association with the peer at addr, proposing the presentation
+// contexts. Returns ErrRejected if the peer rejects the association.
+func Dial(addr string, config Config, contexts []PresentationContext) (*Association, error) {
+
+	if len(contexts) == 0 || len(contexts) > 128 {
+		return nil, fmt.Errorf("%w: %d presentation contexts", ErrNoPresentationContext, len(contexts))
+	}
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		countAssociationFailure("requestor", err)
+		return nil, err
+	}
+
+	a, err := newAssociation(conn, config)
+	if err == nil {
+		err = a.request(contexts)
+	}
+	if err != nil {
+		countAssociationFailure("requestor", err)
+		conn.Close()
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Send the A-ASSOCIATE-RQ and read the answer of the peer
+func (a *Association) request(contexts []PresentationContext) error {
+
+	rq := &associate{
+		calledAE:              a.config.CalledAE,
+		callingAE:             a.config.CallingAE,
+		maxLength:             a.config.MaxPDULength,
+		implementationUID:     dicom.ImplementationClassUID,
+		implementationVersion: ImplementationVersionName,
+	}
+	proposed := map[byte]string{}
+	retrieve := false
+	for _, pc := range contexts {
+		retrieve = retrieve || pc.AbstractSyntax == PatientRootGet || pc.AbstractSyntax == StudyRootGet
+	}
+	for i, pc := range contexts {
+		id := byte(2*i + 1)
+		proposed[id] = pc.AbstractSyntax
+		rq.contexts = append(rq.contexts, &presentationContext{
+			id:               id,
+			abstractSyntax:   pc.AbstractSyntax,
+			transferSyntaxes: pc.TransferSyntaxes,
+		})
+		// the instances retrieved with C-GET are stored by the requestor
+		if retrieve && pc.AbstractSyntax != PatientRootGet && pc.AbstractSyntax != StudyRootGet {
+			rq.scpRoles = append(rq.scpRoles, pc.AbstractSyntax)
+		}
+	}
+
+	if err := writePDU(a.conn, pduAssociateRQ, rq.encode()); err != nil {
+		return err
+	}
+
+	pdu, err := readPDU(a.r, maxPDULength)
+	if err != nil {
+		return err
+	}
+
+	switch pdu.typ {
+	case pduAssociateAC:
+	case pduAssociateRJ:
+		if len(pdu.data) < 4 {
+			return ErrRejected
+		}
+		return fmt.Errorf("%w: result %d, source %d, reason %d", ErrRejected, pdu.data[1], pdu.data[2], pdu.data[3])
+	case pduAbort:
+		return ErrAborted
+	default:
+		return fmt.Errorf("%w: %#02x", ErrUnexpectedPDU, pdu.typ)
+	}
+
+	ac, err := decodeAssociate(pdu.data)
+	if err != nil {
+		return err
+	}
+
+	a.maxLength = ac.maxLength
+	for _, pc := range ac.contexts {
+		if pc.result != 0 || len(pc.transferSyntaxes) != 1 || proposed[pc.id] == "" {
+			continue
+		}
+		pc.abstractSyntax = proposed[pc.id]
+		a.contexts[pc.id] = pc
+	}
+
+	if len(a.contexts) == 0 {
+		a.Abort()
+		return ErrNoPresentationContext
+	}
+
+	return nil
+}
+
+// The AE title of the requestor of the association
+func (a *Association) CallingAE() string {
+	return a.config.CallingAE
+}
+
+// The AE title of the acceptor of the association
+func (a *Association) CalledAE() string {
+	return a.config.CalledAE
+}
+
+// Release the association and close the connection
+func (a *Association) Release() error {
+
+	defer a.conn.Close()
+
+	if err := writePDU(a.conn, pduReleaseRQ, make([]byte, 4)); err != nil {
+		return err
+	}
+
+	for {
+		pdu, err := readPDU(a.r, maxPDULength)
+		if err != nil {
+			return err
+		}
+		switch pdu.typ {
+		case pduReleaseRP:
+			return nil
+		case pduAbort:
+			return ErrAborted
+		case pduData:
+			// a late response, dropped
+		default:
+			return fmt.Errorf("%w: %#02x", ErrUnexpectedPDU, pdu.typ)
+		}
+	}
+}
+
+// Abort the association and close the connection
+func (a *Association) Abort() error {
+	defer a.conn.Close()
+	return writePDU(a.conn, pduAbort, make([]byte, 4))
+}
+
+// Return the accepted presentation context of an abstract syntax, with the
+// transfer syntax ts if there is one
+func (a *Association) contextFor(abstractSyntax, ts string) *presentationContext {
+
+	var found *presentationContext
+	for id := 1; id < 256; id += 2 {
+		pc, ok := a.contexts[byte(id)]
+		if !ok || pc.abstractSyntax != abstractSyntax {
+			continue
+		}
+		if pc.transferSyntaxes[0] == ts {
+			return pc
+		}
+		if found == nil {
+			found = pc
+		}
+	}
+
+	return found
+}
+
+// Send a message, split into P-DATA-TF PDUs no longer than the maximum
+// length of the peer
+func (a *Association) send(msg *message) error {
+
+	command := new(bytes.Buffer)
+	if err := msg.command.WriteDataSet(command, dicom.ImplicitVRLittleEndian); err != nil {
+		return err
+	}
+
+	if err := a.sendFragments(msg.contextID, true, command.Bytes()); err != nil {
+		return err
+	}
+
+	if msg.data != nil {
+		return a.sendFragments(msg.contextID, false, msg.data)
+	}
+
+	return nil
+}
+
+func (a *Association) sendFragments(contextID byte, command bool, data []byte) error {
+
+	size := len(data)
+	if a.maxLength > 12 {
+		size = int(a.maxLength) - 6
+	}
+
+	for {
+		n := len(data)
+		if n > size {
+			n = size
+		}
+		v := &pdv{contextID: contextID, command: command, last: n == len(data), data: data[:n]}
+		if err := writePDU(a.conn, pduData, v.encode()); err != nil {
+			return err
+		}
+		data = data[n:]
+		if len(data) == 0 {
+			return nil
+		}
+	}
+}
+
+// Read the next message. Returns io.EOF once the peer released the
+// association.
+func (a *Association) receive() (*message, error) {
+
+	var command, data []byte
+	var msg *message
+	for {
+		pdu, err := readPDU(a.r, maxPDULength)
+		if err != nil {
+			return nil, err
+		}
+
+		switch pdu.typ {
+		case pduData:
+		case pduReleaseRQ:
+			writePDU(a.conn, pduReleaseRP, make([]byte, 4))
+			a.conn.Close()
+			return nil, io.EOF
+		case pduAbort:
+			a.conn.Close()
+			return nil, ErrAborted
+		default:
+			return nil, fmt.Errorf("%w: %#02x", ErrUnexpectedPDU, pdu.typ)
+		}
+
+		pdvs, err := decodePData(pdu.data)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range pdvs {
+			if _, ok := a.contexts[v.contextID]; !ok {
+				return nil, fmt.Errorf("%w: presentation context %d", ErrNoPresentationContext, v.contextID)
+			}
+
+			if !v.command {
+				if msg == nil {
+					return nil, fmt.Errorf("%w: data set before the command", ErrUnexpectedPDU)
+				}
+				data = append(data, v.data...)
+				if v.last {
+					msg.data = data
+					return msg, nil
+				}
+				continue
+			}
+
+			command = append(command, v.data...)
+			if !v.last {
+				continue
+			}
+
+			cmd, err := a.parser.ParseDataSet(command, dicom.ImplicitVRLittleEndian)
+			if err != nil {
+				return nil, err
+			}
+			msg = &message{contextID: v.contextID, command: cmd}
+			if t, _ := commandUInt16(cmd, dicom.TagCommandDataSetType); t == noDataSet {
+				return msg, nil
+			}
+		}
+	}
+}
+
+// Parse the data set of a message
+func (a *Association) parseData(msg *message) (*dicom.DicomFile, error) {
+	return a.parser.ParseDataSet(msg.data, a.contexts[msg.contextID].transferSyntaxes[0])
+}
+
+func (a *Association) nextMessageID() uint16 {
+	a.messageID++
+	return a.messageID
+}
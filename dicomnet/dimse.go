@@ -0,0 +1,146 @@
+package dicomnet
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"sort"
+	"strings"
+)
+
+// Command fields (PS 3.7 E.1)
+const (
+	commandCStoreRQ  = 0x0001
+	commandCStoreRSP = 0x8001
+	commandCFindRQ   = 0x0020
+	commandCFindRSP  = 0x8020
+	commandCMoveRQ   = 0x0021
+	commandCMoveRSP  = 0x8021
+	commandCGetRQ    = 0x0010
+	commandCGetRSP   = 0x8010
+	commandCEchoRQ   = 0x0030
+	commandCEchoRSP  = 0x8030
+)
+
+// The CommandDataSetType of commands without a data set
+const (
+	hasDataSet = 0x0000
+	noDataSet  = 0x0101
+)
+
+// The status of a DIMSE response (PS 3.7 C)
+type Status uint16
+
+const (
+	StatusSuccess              Status = 0x0000
+	StatusSOPClassNotSupported Status = 0x0122
+	StatusOutOfResources       Status = 0xa700
+	StatusSubOperationFailures Status = 0xb000 // some sub-operations of a C-MOVE or C-GET failed
+	StatusCannotProcess        Status = 0xc000
+	StatusCancel               Status = 0xfe00
+	StatusPending              Status = 0xff00
+)
+
+// Whether the status is pending: more responses follow
+func (s Status) Pending() bool {
+	return s == StatusPending || s == 0xff01
+}
+
+// Whether the status is a warning: the operation succeeded with
+// modifications or some elements were ignored
+func (s Status) Warning() bool {
+	return s == 0x0001 || s&0xf000 == 0xb000
+}
+
+// Whether the status is a failure
+func (s Status) Failure() bool {
+	return s != StatusSuccess && !s.Warning() && !s.Pending() && s != StatusCancel
+}
+
+// Stringer
+func (s Status) String() string {
+	switch {
+	case s == StatusSuccess:
+		return "Success"
+	case s.Pending():
+		return "Pending"
+	case s == StatusCancel:
+		return "Cancel"
+	case s.Warning():
+		return fmt.Sprintf("Warning (%04X)", uint16(s))
+	}
+	return fmt.Sprintf("Failure (%04X)", uint16(s))
+}
+
+// A field of a command set
+type field struct {
+	tag   dicom.Tag
+	value dicom.Value
+}
+
+// Create a command set, with its CommandGroupLength
+func (a *Association) newCommand(fields ...field) (*dicom.DicomFile, error) {
+
+	cmd := &dicom.DicomFile{}
+	for _, f := range fields {
+		elem, err := a.parser.NewElement(f.tag, f.value)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Elements = append(cmd.Elements, *elem)
+	}
+	sort.Slice(cmd.Elements, func(i, j int) bool {
+		return cmd.Elements[i].Element < cmd.Elements[j].Element
+	})
+
+	buffer := new(bytes.Buffer)
+	if err := cmd.WriteDataSet(buffer, dicom.ImplicitVRLittleEndian); err != nil {
+		return nil, err
+	}
+
+	length, err := a.parser.NewElement(dicom.TagCommandGroupLength, dicom.UInt32s{uint32(buffer.Len())})
+	if err != nil {
+		return nil, err
+	}
+	cmd.Elements = append([]dicom.DicomElement{*length}, cmd.Elements...)
+
+	return cmd, nil
+}
+
+func commandUInt16(cmd *dicom.DicomFile, tag dicom.Tag) (uint16, bool) {
+	elem, err := cmd.LookupElementByTag(tag)
+	if err != nil {
+		return 0, false
+	}
+	v, err := elem.GetUInt16()
+	return v, err == nil
+}
+
+// Lookup a string element, without its padding
+func lookupString(file *dicom.DicomFile, tag dicom.Tag) string {
+	elem, err := file.LookupElementByTag(tag)
+	if err != nil {
+		return ""
+	}
+	s, _ := elem.GetString()
+	return strings.TrimRight(s, " \x00")
+}
+
+// Check that a message is the response of a request and return its status
+func responseStatus(msg *message, command, messageID uint16) (Status, error) {
+
+	if field, _ := commandUInt16(msg.command, dicom.TagCommandField); field != command {
+		return 0, fmt.Errorf("%w: command %#04x", ErrUnexpectedPDU, field)
+	}
+
+	if id, _ := commandUInt16(msg.command, dicom.TagMessageIDBeingRespondedTo); id != messageID {
+		return 0, fmt.Errorf("%w: response to message %d", ErrUnexpectedPDU, id)
+	}
+
+	status, ok := commandUInt16(msg.command, dicom.TagStatus)
+	if !ok {
+		return 0, fmt.Errorf("%w: response without status", ErrUnexpectedPDU)
+	}
+
+	return Status(status), nil
+}
@@ -0,0 +1,47 @@
+package dicomnet
+
+import (
+	"github.com/gillesdemey/go-dicom"
+	"time"
+)
+
+// The Verification SOP class (PS 3.4 A.4)
+const Verification = "1.2.840.10008.1.1"
+
+// The presentation context to propose to verify the connectivity with Echo
+var VerificationContext = PresentationContext{Verification, []string{dicom.ImplicitVRLittleEndian}}
+
+// Verify the connectivity with the peer with C-ECHO, and return the status
+// of the response. Returns ErrNoPresentationContext if the Verification SOP
+// class was not accepted.
+func (a *Association) Echo() (status Status, err error) {
+
+	defer observeOperation(commandCEchoRQ, "scu", time.Now(), &status, &err)
+
+	pc := a.contextFor(Verification, "")
+	if pc == nil {
+		return 0, ErrNoPresentationContext
+	}
+
+	id := a.nextMessageID()
+	cmd, err := a.newCommand(
+		field{dicom.TagAffectedSOPClassUID, dicom.Strings{Verification}},
+		field{dicom.TagCommandField, dicom.UInt16s{commandCEchoRQ}},
+		field{dicom.TagMessageID, dicom.UInt16s{id}},
+		field{dicom.TagCommandDataSetType, dicom.UInt16s{noDataSet}},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := a.send(&message{pc.id, cmd, nil}); err != nil {
+		return 0, err
+	}
+
+	rsp, err := a.receive()
+	if err != nil {
+		return 0, err
+	}
+
+	return responseStatus(rsp, commandCEchoRSP, id)
+}
@@ -0,0 +1,41 @@
+package dicomnet
+
+import (
+	"errors"
+	"github.com/gillesdemey/go-dicom"
+	"net"
+	"testing"
+)
+
+func TestEcho(t *testing.T) {
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go (&Server{}).Serve(l)
+
+	a, err := Dial(l.Addr().String(), Config{}, []PresentationContext{VerificationContext})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if status, err := a.Echo(); err != nil || status != StatusSuccess {
+			t.Errorf("Echo failed: %v %v", status, err)
+		}
+	}
+	if err := a.Release(); err != nil {
+		t.Error(err)
+	}
+
+	a, err = Dial(l.Addr().String(), Config{}, []PresentationContext{{StudyRootFind, []string{dicom.ImplicitVRLittleEndian}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Release()
+	if _, err := a.Echo(); !errors.Is(err, ErrNoPresentationContext) {
+		t.Errorf("Expected ErrNoPresentationContext, got %v", err)
+	}
+
+}
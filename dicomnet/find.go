@@ -0,0 +1,207 @@
+package dicomnet
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"strings"
+	"time"
+)
+
+// SOP classes of the Query/Retrieve Information Models (PS 3.4 C.6)
+const (
+	PatientRootFind = "1.2.840.10008.5.1.4.1.2.1.1"
+	PatientRootMove = "1.2.840.10008.5.1.4.1.2.1.2"
+	PatientRootGet  = "1.2.840.10008.5.1.4.1.2.1.3"
+	StudyRootFind   = "1.2.840.10008.5.1.4.1.2.2.1"
+	StudyRootMove   = "1.2.840.10008.5.1.4.1.2.2.2"
+	StudyRootGet    = "1.2.840.10008.5.1.4.1.2.2.3"
+)
+
+// The number of sub-operations of a C-MOVE, as reported by the SCP
+type SubOperations struct {
+	Remaining, Completed, Failed, Warning uint16
+}
+
+var subOperationTags = []dicom.Tag{
+	dicom.TagNumberOfRemainingSuboperations,
+	dicom.TagNumberOfCompletedSuboperations,
+	dicom.TagNumberOfFailedSuboperations,
+	dicom.TagNumberOfWarningSuboperations,
+}
+
+func (ops *SubOperations) counts() []*uint16 {
+	return []*uint16{&ops.Remaining, &ops.Completed, &ops.Failed, &ops.Warning}
+}
+
+// The sub-operations reported by a response, the number of remaining ones
+// being 0 in final responses
+func subOperations(rsp *message) SubOperations {
+	var ops SubOperations
+	for i, count := range ops.counts() {
+		*count, _ = commandUInt16(rsp.command, subOperationTags[i])
+	}
+	return ops
+}
+
+// The fields of a response reporting the sub-operations, the number of
+// remaining ones only in pending responses
+func (ops *SubOperations) fields(pending bool) []field {
+	var fields []field
+	for i, count := range ops.counts() {
+		if i > 0 || pending {
+			fields = append(fields, field{subOperationTags[i], dicom.UInt16s{*count}})
+		}
+	}
+	return fields
+}
+
+// A response to a C-FIND streamed by FindStream: the identifier of a
+// pending response, or the status of the final response and the error
+// ending the query, if any
+type FindResponse struct {
+	Identifier *dicom.DicomFile
+	Status     Status
+	Err        error
+}
+
+// Build the identifier of a query at a QueryRetrieveLevel from keys such as
+// PatientName=DOE* or StudyInstanceUID, whose attributes are returned by the
+// SCP. Keys are paths of SetByPath, with values separated by backslashes.
+func NewIdentifier(level string, keys ...string) (*dicom.DicomFile, error) {
+
+	identifier := &dicom.DicomFile{}
+	if err := identifier.SetByPath("QueryRetrieveLevel", level); err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		path, value := key, ""
+		if i := strings.IndexByte(key, '='); i >= 0 {
+			path, value = key[:i], key[i+1:]
+		}
+		if err := identifier.SetByPath(path, strings.Split(value, "\\")...); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	return identifier, nil
+}
+
+// Send a query with C-FIND for a Query/Retrieve SOP class, ie.
+// StudyRootFind, and return the identifiers of the pending responses along
+// with the status of the final response
+func (a *Association) Find(sopClass string, query *dicom.DicomFile) ([]*dicom.DicomFile, Status, error) {
+
+	var results []*dicom.DicomFile
+	status, err := a.find(sopClass, query, func(result *dicom.DicomFile) {
+		results = append(results, result)
+	})
+
+	return results, status, err
+}
+
+// Send a query with C-FIND as Find, streaming the identifiers of the pending
+// responses as they arrive. The last response holds the status of the final
+// response or the error ending the query, and the channel is then closed.
+// Drain the channel before using the association again.
+func (a *Association) FindStream(sopClass string, query *dicom.DicomFile) <-chan FindResponse {
+
+	responses := make(chan FindResponse)
+	go func() {
+		defer close(responses)
+		status, err := a.find(sopClass, query, func(result *dicom.DicomFile) {
+			responses <- FindResponse{Identifier: result}
+		})
+		responses <- FindResponse{Status: status, Err: err}
+	}()
+
+	return responses
+}
+
+// Send a query with C-FIND, calling found with the identifier of each
+// pending response, and return the status of the final response
+func (a *Association) find(sopClass string, query *dicom.DicomFile, found func(*dicom.DicomFile)) (status Status, err error) {
+
+	defer observeOperation(commandCFindRQ, "scu", time.Now(), &status, &err)
+
+	pc, id, err := a.sendRequest(sopClass, query,
+		field{dicom.TagCommandField, dicom.UInt16s{commandCFindRQ}},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		rsp, err := a.receive()
+		if err != nil {
+			return 0, err
+		}
+		status, err := responseStatus(rsp, commandCFindRSP, id)
+		if err != nil || !status.Pending() {
+			return status, err
+		}
+		if rsp.data != nil {
+			result, err := a.parser.ParseDataSet(rsp.data, pc.transferSyntaxes[0])
+			if err != nil {
+				return status, err
+			}
+			found(result)
+		}
+	}
+}
+
+// Ask the SCP to send the instances matching a query to the AE titled
+// destination with C-MOVE, and return the sub-operations and status of the
+// final response
+func (a *Association) Move(sopClass, destination string, query *dicom.DicomFile) (ops SubOperations, status Status, err error) {
+
+	defer observeOperation(commandCMoveRQ, "scu", time.Now(), &status, &err)
+
+	_, id, err := a.sendRequest(sopClass, query,
+		field{dicom.TagCommandField, dicom.UInt16s{commandCMoveRQ}},
+		field{dicom.TagMoveDestination, dicom.Strings{destination}},
+	)
+	if err != nil {
+		return SubOperations{}, 0, err
+	}
+
+	for {
+		rsp, err := a.receive()
+		if err != nil {
+			return ops, 0, err
+		}
+		status, err := responseStatus(rsp, commandCMoveRSP, id)
+		ops = subOperations(rsp)
+		if err != nil || !status.Pending() {
+			return ops, status, err
+		}
+	}
+}
+
+// Send a request with an identifier on the presentation context of its SOP
+// class, and return the presentation context and message ID
+func (a *Association) sendRequest(sopClass string, identifier *dicom.DicomFile, fields ...field) (*presentationContext, uint16, error) {
+
+	pc := a.contextFor(sopClass, "")
+	if pc == nil {
+		return nil, 0, ErrNoPresentationContext
+	}
+
+	data := new(bytes.Buffer)
+	if err := identifier.WriteDataSet(data, pc.transferSyntaxes[0]); err != nil {
+		return nil, 0, err
+	}
+
+	id := a.nextMessageID()
+	cmd, err := a.newCommand(append(fields,
+		field{dicom.TagAffectedSOPClassUID, dicom.Strings{sopClass}},
+		field{dicom.TagMessageID, dicom.UInt16s{id}},
+		field{dicom.TagPriority, dicom.UInt16s{0}},
+		field{dicom.TagCommandDataSetType, dicom.UInt16s{hasDataSet}},
+	)...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return pc, id, a.send(&message{pc.id, cmd, data.Bytes()})
+}
@@ -0,0 +1,149 @@
+package dicomnet
+
+import (
+	"github.com/gillesdemey/go-dicom"
+	"net"
+	"testing"
+)
+
+// A Query/Retrieve SCP answering queries over the example files
+func fakeQRSCP(t *testing.T, moved chan<- string) string {
+
+	store := dicom.NewStore()
+	for _, name := range []string{"IM-0001-0001.dcm", "IM-0001-0002.dcm", "I_000000.dcm"} {
+		store.Add(readExample(t, name))
+	}
+	archive := dicom.NewArchive(dicom.StudyRoot, store)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	s := &Server{
+		Find: func(a *Association, sopClass string, query *dicom.DicomFile) ([]*dicom.DicomFile, Status) {
+			results, err := archive.Query(query)
+			if err != nil {
+				return nil, StatusCannotProcess
+			}
+			return results, StatusSuccess
+		},
+		Move: func(a *Association, sopClass, destination string, query *dicom.DicomFile) (SubOperations, Status) {
+			moved <- destination
+			return SubOperations{Completed: 2}, StatusSuccess
+		},
+	}
+	go s.Serve(l)
+
+	return l.Addr().String()
+}
+
+func newQuery(t *testing.T, keys map[string]string) *dicom.DicomFile {
+	query := &dicom.DicomFile{}
+	for path, value := range keys {
+		if err := query.SetByPath(path, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return query
+}
+
+func TestFind(t *testing.T) {
+
+	addr := fakeQRSCP(t, nil)
+	contexts := []PresentationContext{{StudyRootFind, []string{dicom.ExplicitVRLittleEndian}}}
+	a, err := Dial(addr, Config{}, contexts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Release()
+
+	results, status, err := a.Find(StudyRootFind, newQuery(t, map[string]string{
+		"QueryRetrieveLevel": "STUDY",
+		"PatientID":          "7DkT2Tp",
+		"StudyInstanceUID":   "",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != StatusSuccess {
+		t.Errorf("Incorrect status %v", status)
+	}
+	if len(results) != 1 || lookupString(results[0], dicom.TagStudyInstanceUID) == "" {
+		t.Errorf("Incorrect results %v", results)
+	}
+
+	if _, status, _ := a.Find(StudyRootFind, newQuery(t, map[string]string{"QueryRetrieveLevel": "NONE"})); !status.Failure() {
+		t.Errorf("Expected a failure, got %v", status)
+	}
+
+	if _, _, err := a.Find(PatientRootFind, newQuery(t, nil)); err == nil {
+		t.Error("Expected an error without presentation context")
+	}
+
+}
+
+func TestMove(t *testing.T) {
+
+	moved := make(chan string, 1)
+	addr := fakeQRSCP(t, moved)
+	contexts := []PresentationContext{{StudyRootMove, []string{dicom.ImplicitVRLittleEndian}}}
+	a, err := Dial(addr, Config{}, contexts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Release()
+
+	ops, status, err := a.Move(StudyRootMove, "STORESCP", newQuery(t, map[string]string{
+		"QueryRetrieveLevel": "STUDY",
+		"StudyInstanceUID":   "1.2.3",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != StatusSuccess || ops.Completed != 2 {
+		t.Errorf("Incorrect status %v and sub-operations %+v", status, ops)
+	}
+	if destination := <-moved; destination != "STORESCP" {
+		t.Errorf("Incorrect destination %s", destination)
+	}
+
+}
+
+func TestFindStream(t *testing.T) {
+
+	addr := fakeQRSCP(t, nil)
+	contexts := []PresentationContext{{PatientRootFind, []string{dicom.ExplicitVRLittleEndian}}}
+	a, err := Dial(addr, Config{}, contexts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Release()
+
+	query, err := NewIdentifier(dicom.StudyLevel, "PatientID=7DkT2Tp", "StudyInstanceUID")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var results []*dicom.DicomFile
+	var final FindResponse
+	for rsp := range a.FindStream(PatientRootFind, query) {
+		if rsp.Identifier != nil {
+			results = append(results, rsp.Identifier)
+		} else {
+			final = rsp
+		}
+	}
+	if final.Err != nil || final.Status != StatusSuccess {
+		t.Errorf("Incorrect final response %v %v", final.Status, final.Err)
+	}
+	if len(results) != 1 || lookupString(results[0], dicom.TagStudyInstanceUID) == "" {
+		t.Errorf("Incorrect results %v", results)
+	}
+
+	if _, err := NewIdentifier(dicom.StudyLevel, "NoSuchKeyword=1"); err == nil {
+		t.Error("Expected an error for an unknown key")
+	}
+
+}
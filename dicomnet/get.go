@@ -0,0 +1,124 @@
+package dicomnet
+
+import (
+	"github.com/gillesdemey/go-dicom"
+	"time"
+)
+
+// Return the presentation contexts to propose to retrieve the instances of
+// storage SOP classes with C-GET for a Query/Retrieve SOP class, ie.
+// StudyRootGet, in uncompressed transfer syntaxes. Append the contexts of
+// other transfer syntaxes to receive them as they are. The SCP role is
+// proposed for every storage SOP class along with a C-GET SOP class.
+func GetContexts(sopClass string, storageClasses ...string) []PresentationContext {
+	contexts := []PresentationContext{{sopClass, uncompressed}}
+	for _, storageClass := range storageClasses {
+		contexts = append(contexts, PresentationContext{storageClass, uncompressed})
+	}
+	return contexts
+}
+
+// Ask the SCP to send the instances matching a query over the association
+// with C-GET, and return the sub-operations and status of the final
+// response. Each instance is passed to store, along with the file meta
+// information of the transfer, which returns the status of its C-STORE.
+// Propose the presentation contexts of the instances with GetContexts.
+func (a *Association) Get(sopClass string, query *dicom.DicomFile, store StoreHandler) (ops SubOperations, status Status, err error) {
+
+	defer observeOperation(commandCGetRQ, "scu", time.Now(), &status, &err)
+
+	_, id, err := a.sendRequest(sopClass, query,
+		field{dicom.TagCommandField, dicom.UInt16s{commandCGetRQ}},
+	)
+	if err != nil {
+		return SubOperations{}, 0, err
+	}
+
+	for {
+		msg, err := a.receive()
+		if err != nil {
+			return ops, 0, err
+		}
+		if command, _ := commandUInt16(msg.command, dicom.TagCommandField); command == commandCStoreRQ {
+			if err := a.storeSubOperation(msg, store); err != nil {
+				return ops, 0, err
+			}
+			continue
+		}
+		status, err := responseStatus(msg, commandCGetRSP, id)
+		ops = subOperations(msg)
+		if err != nil || !status.Pending() {
+			return ops, status, err
+		}
+	}
+}
+
+// Answer a C-STORE sub-operation of a C-GET with the status returned by
+// store
+func (a *Association) storeSubOperation(msg *message, store StoreHandler) error {
+
+	sopClass := lookupString(msg.command, dicom.TagAffectedSOPClassUID)
+	sopInstance := lookupString(msg.command, dicom.TagAffectedSOPInstanceUID)
+
+	status := StatusCannotProcess
+	file, err := a.parseData(msg)
+	if err == nil {
+		err = a.addFileMeta(file, msg.contextID, sopClass, sopInstance, a.CalledAE())
+	}
+	if err == nil {
+		status = store(a, file)
+	}
+
+	return a.respond(msg, commandCStoreRSP, status, nil,
+		field{dicom.TagAffectedSOPInstanceUID, dicom.Strings{sopInstance}},
+	)
+}
+
+// Answer a C-GET request, sending the matching instances with C-STORE and a
+// pending response after each, and return the status of the final response
+func (s *Server) handleGet(a *Association, msg *message) (Status, error) {
+
+	if s.Get == nil {
+		return StatusSOPClassNotSupported, a.respond(msg, commandCGetRSP, StatusSOPClassNotSupported, nil)
+	}
+
+	query, err := a.parseData(msg)
+	if err != nil {
+		s.logf("%s: %v", a.CallingAE(), err)
+		return StatusCannotProcess, a.respond(msg, commandCGetRSP, StatusCannotProcess, nil)
+	}
+
+	files, status := s.Get(a, lookupString(msg.command, dicom.TagAffectedSOPClassUID), query)
+	if status.Failure() {
+		return status, a.respond(msg, commandCGetRSP, status, nil)
+	}
+
+	ops := SubOperations{Remaining: uint16(len(files))}
+	for _, file := range files {
+		stored, err := a.Store(file)
+		ops.Remaining--
+		switch {
+		case err != nil:
+			s.logf("%s: %s: %v", a.CallingAE(), lookupString(file, dicom.TagSOPInstanceUID), err)
+			ops.Failed++
+		case stored.Failure():
+			ops.Failed++
+		case stored.Warning():
+			ops.Warning++
+		default:
+			ops.Completed++
+		}
+		if ops.Remaining > 0 {
+			if err := a.respond(msg, commandCGetRSP, StatusPending, nil, ops.fields(true)...); err != nil {
+				return StatusPending, err
+			}
+		}
+	}
+
+	status = StatusSuccess
+	if ops.Failed > 0 || ops.Warning > 0 {
+		status = StatusSubOperationFailures
+	}
+
+	return status, a.respond(msg, commandCGetRSP, status, nil, ops.fields(false)...)
+}
@@ -0,0 +1,70 @@
+package dicomnet
+
+import (
+	"github.com/gillesdemey/go-dicom"
+	"net"
+	"testing"
+)
+
+func TestGet(t *testing.T) {
+
+	files := []*dicom.DicomFile{readExample(t, "IM-0001-0001.dcm"), readExample(t, "IM-0001-0002.dcm")}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go (&Server{
+		Get: func(a *Association, sopClass string, query *dicom.DicomFile) ([]*dicom.DicomFile, Status) {
+			return files, StatusSuccess
+		},
+	}).Serve(l)
+
+	// the examples are JPEG 2000 compressed
+	contexts := append(GetContexts(StudyRootGet), StorageContexts(files...)...)
+	a, err := Dial(l.Addr().String(), Config{CalledAE: "QRSCP"}, contexts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Release()
+
+	query, err := NewIdentifier(dicom.StudyLevel, "StudyInstanceUID="+lookupString(files[0], dicom.TagStudyInstanceUID))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var received []*dicom.DicomFile
+	ops, status, err := a.Get(StudyRootGet, query, func(a *Association, file *dicom.DicomFile) Status {
+		received = append(received, file)
+		return StatusSuccess
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != StatusSuccess || ops.Completed != 2 || ops.Failed != 0 {
+		t.Errorf("Incorrect status %v and sub-operations %+v", status, ops)
+	}
+	if len(received) != 2 {
+		t.Fatalf("Expected 2 instances, got %d", len(received))
+	}
+	for i, file := range received {
+		if lookupString(file, dicom.TagMediaStorageSOPInstanceUID) != lookupString(files[i], dicom.TagSOPInstanceUID) {
+			t.Errorf("Instance %d: incorrect file meta information", i+1)
+		}
+		if source := lookupString(file, dicom.TagSourceApplicationEntityTitle); source != "QRSCP" {
+			t.Errorf("Instance %d: incorrect source %s", i+1, source)
+		}
+	}
+
+	ops, status, err = a.Get(StudyRootGet, query, func(a *Association, file *dicom.DicomFile) Status {
+		return StatusOutOfResources
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != StatusSubOperationFailures || ops.Failed != 2 {
+		t.Errorf("Incorrect status %v and sub-operations %+v", status, ops)
+	}
+
+}
@@ -0,0 +1,48 @@
+package dicomnet
+
+import (
+	"errors"
+	"github.com/gillesdemey/go-dicom"
+	"time"
+)
+
+// The names of the operations measured, by request command field
+var commandNames = map[uint16]string{
+	commandCStoreRQ: "C-STORE",
+	commandCFindRQ:  "C-FIND",
+	commandCMoveRQ:  "C-MOVE",
+	commandCGetRQ:   "C-GET",
+	commandCEchoRQ:  "C-ECHO",
+}
+
+// Record a DIMSE operation started at start, as role scu or scp, with the
+// metrics of the dicom package. Deferred with the addresses of the status
+// and error returned.
+func observeOperation(command uint16, role string, start time.Time, status *Status, err *error) {
+
+	result := "failure"
+	switch {
+	case *err != nil:
+		result = "error"
+	case *status == StatusSuccess:
+		result = "success"
+	case status.Warning():
+		result = "warning"
+	case *status == StatusCancel:
+		result = "cancel"
+	}
+
+	m := dicom.GetMetrics()
+	m.Count(dicom.MetricDIMSEOperations, dicom.Labels{"command": commandNames[command], "role": role, "status": result}, 1)
+	m.Observe(dicom.MetricDIMSEDuration, dicom.Labels{"command": commandNames[command], "role": role}, time.Since(start).Seconds())
+}
+
+// Record an association which could not be established, as role requestor
+// or acceptor
+func countAssociationFailure(role string, err error) {
+	reason := "error"
+	if errors.Is(err, ErrRejected) {
+		reason = "rejected"
+	}
+	dicom.GetMetrics().Count(dicom.MetricAssociationFailures, dicom.Labels{"role": role, "reason": reason}, 1)
+}
@@ -0,0 +1,81 @@
+package dicomnet
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+)
+
+// Metrics counting the measures by name and labels
+type countingMetrics struct {
+	mu     sync.Mutex
+	counts map[string]float64
+}
+
+func (m *countingMetrics) key(name string, labels dicom.Labels) string {
+	var pairs []string
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return fmt.Sprint(name, pairs)
+}
+
+func (m *countingMetrics) Count(name string, labels dicom.Labels, delta float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counts[m.key(name, labels)] += delta
+}
+
+func (m *countingMetrics) Observe(name string, labels dicom.Labels, value float64) {
+	m.Count(name, labels, 1)
+}
+
+func TestMetrics(t *testing.T) {
+
+	metrics := &countingMetrics{counts: map[string]float64{}}
+	dicom.SetMetrics(metrics)
+	defer dicom.SetMetrics(nil)
+
+	file := readExample(t, "IM-0001-0001.dcm")
+	a, err := Dial(fakeSCP(t, make(chan *dicom.DicomFile, 1)), Config{}, StorageContexts(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.Store(file); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Release(); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go (&Server{AETitle: "STORESCP"}).Serve(l)
+	if _, err := Dial(l.Addr().String(), Config{CalledAE: "OTHER"}, StorageContexts(file)); !errors.Is(err, ErrRejected) {
+		t.Fatalf("Expected ErrRejected, got %v", err)
+	}
+
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	for _, expected := range []struct {
+		name   string
+		labels dicom.Labels
+	}{
+		{dicom.MetricDIMSEOperations, dicom.Labels{"command": "C-STORE", "role": "scu", "status": "success"}},
+		{dicom.MetricDIMSEOperations, dicom.Labels{"command": "C-STORE", "role": "scp", "status": "success"}},
+		{dicom.MetricDIMSEDuration, dicom.Labels{"command": "C-STORE", "role": "scu"}},
+		{dicom.MetricAssociationFailures, dicom.Labels{"role": "requestor", "reason": "rejected"}},
+	} {
+		if n := metrics.counts[metrics.key(expected.name, expected.labels)]; n != 1 {
+			t.Errorf("Expected one %s %v, got %v", expected.name, expected.labels, n)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package dicomnet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var ErrInvalidPDU = errors.New("Invalid PDU")
+
+// PDU types (PS 3.8 9.3.1)
+const (
+	pduAssociateRQ = 0x01
+	pduAssociateAC = 0x02
+	pduAssociateRJ = 0x03
+	pduData        = 0x04
+	pduReleaseRQ   = 0x05
+	pduReleaseRP   = 0x06
+	pduAbort       = 0x07
+)
+
+// Item types of the A-ASSOCIATE PDUs (PS 3.8 9.3.2)
+const (
+	itemApplicationContext    = 0x10
+	itemPresentationContextRQ = 0x20
+	itemPresentationContextAC = 0x21
+	itemAbstractSyntax        = 0x30
+	itemTransferSyntax        = 0x40
+	itemUserInformation       = 0x50
+	itemMaxLength             = 0x51
+	itemImplementationUID     = 0x52
+	itemRoleSelection         = 0x54
+	itemImplementationVersion = 0x55
+)
+
+const applicationContextName = "1.2.840.10008.3.1.1.1"
+
+// The longest PDU read, regardless of the maximum length announced
+const maxPDULength = 1 << 24
+
+type pdu struct {
+	typ  byte
+	data []byte
+}
+
+// Read a PDU, failing on PDUs longer than max
+func readPDU(r io.Reader, max uint32) (*pdu, error) {
+
+	header := make([]byte, 6)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+
+	length := binary.BigEndian.Uint32(header[2:])
+	if length > max {
+		return nil, fmt.Errorf("%w: length %d", ErrInvalidPDU, length)
+	}
+
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+
+	return &pdu{header[0], data}, nil
+}
+
+func writePDU(w io.Writer, typ byte, data []byte) error {
+	header := []byte{typ, 0, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(header[2:], uint32(len(data)))
+	_, err := w.Write(append(header, data...))
+	return err
+}
+
+// A presentation context of an A-ASSOCIATE PDU. The requestor proposes
+// transfer syntaxes for the abstract syntax, the acceptor answers with a
+// result and the transfer syntax it picked.
+type presentationContext struct {
+	id               byte
+	result           byte
+	abstractSyntax   string
+	transferSyntaxes []string
+}
+
+// The variable fields of an A-ASSOCIATE-RQ or AC PDU
+type associate struct {
+	calledAE, callingAE   string
+	contexts              []*presentationContext
+	maxLength             uint32
+	implementationUID     string
+	implementationVersion string
+	scpRoles              []string // the SOP classes for which the requestor is SCP
+}
+
+func (a *associate) encode() []byte {
+
+	buf := new(bytes.Buffer)
+	buf.Write([]byte{0, 1}) // protocol version
+	buf.Write([]byte{0, 0})
+	buf.WriteString(padAE(a.calledAE))
+	buf.WriteString(padAE(a.callingAE))
+	buf.Write(make([]byte, 32))
+
+	writeItem(buf, itemApplicationContext, []byte(applicationContextName))
+
+	for _, pc := range a.contexts {
+		sub := new(bytes.Buffer)
+		sub.Write([]byte{pc.id, 0, pc.result, 0})
+		typ := byte(itemPresentationContextAC)
+		if pc.abstractSyntax != "" {
+			typ = itemPresentationContextRQ
+			writeItem(sub, itemAbstractSyntax, []byte(pc.abstractSyntax))
+		}
+		for _, ts := range pc.transferSyntaxes {
+			writeItem(sub, itemTransferSyntax, []byte(ts))
+		}
+		writeItem(buf, typ, sub.Bytes())
+	}
+
+	user := new(bytes.Buffer)
+	length := make([]byte, 4)
+	binary.BigEndian.PutUint32(length, a.maxLength)
+	writeItem(user, itemMaxLength, length)
+	writeItem(user, itemImplementationUID, []byte(a.implementationUID))
+	if a.implementationVersion != "" {
+		writeItem(user, itemImplementationVersion, []byte(a.implementationVersion))
+	}
+	for _, sopClass := range a.scpRoles {
+		role := []byte{byte(len(sopClass) >> 8), byte(len(sopClass))}
+		role = append(append(role, sopClass...), 0, 1) // SCU role 0, SCP role 1
+		writeItem(user, itemRoleSelection, role)
+	}
+	writeItem(buf, itemUserInformation, user.Bytes())
+
+	return buf.Bytes()
+}
+
+func decodeAssociate(data []byte) (*associate, error) {
+
+	if len(data) < 68 {
+		return nil, ErrInvalidPDU
+	}
+
+	a := &associate{
+		calledAE:  strings.TrimSpace(string(data[4:20])),
+		callingAE: strings.TrimSpace(string(data[20:36])),
+	}
+
+	err := readItems(data[68:], func(typ byte, item []byte) error {
+		switch typ {
+		case itemPresentationContextRQ, itemPresentationContextAC:
+			if len(item) < 4 {
+				return ErrInvalidPDU
+			}
+			pc := &presentationContext{id: item[0], result: item[2]}
+			err := readItems(item[4:], func(typ byte, sub []byte) error {
+				switch typ {
+				case itemAbstractSyntax:
+					pc.abstractSyntax = trimUID(sub)
+				case itemTransferSyntax:
+					pc.transferSyntaxes = append(pc.transferSyntaxes, trimUID(sub))
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			a.contexts = append(a.contexts, pc)
+		case itemUserInformation:
+			return readItems(item, func(typ byte, sub []byte) error {
+				switch typ {
+				case itemMaxLength:
+					if len(sub) != 4 {
+						return ErrInvalidPDU
+					}
+					a.maxLength = binary.BigEndian.Uint32(sub)
+				case itemImplementationUID:
+					a.implementationUID = trimUID(sub)
+				case itemImplementationVersion:
+					a.implementationVersion = strings.TrimSpace(string(sub))
+				case itemRoleSelection:
+					if len(sub) < 2 || len(sub) != 4+int(binary.BigEndian.Uint16(sub)) {
+						return ErrInvalidPDU
+					}
+					if sub[len(sub)-1] == 1 {
+						a.scpRoles = append(a.scpRoles, trimUID(sub[2:len(sub)-2]))
+					}
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+
+	return a, err
+}
+
+// Call fn with the type and data of every item
+func readItems(data []byte, fn func(typ byte, item []byte) error) error {
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return ErrInvalidPDU
+		}
+		length := int(binary.BigEndian.Uint16(data[2:]))
+		if len(data) < 4+length {
+			return ErrInvalidPDU
+		}
+		if err := fn(data[0], data[4:4+length]); err != nil {
+			return err
+		}
+		data = data[4+length:]
+	}
+	return nil
+}
+
+func writeItem(buf *bytes.Buffer, typ byte, data []byte) {
+	buf.Write([]byte{typ, 0, byte(len(data) >> 8), byte(len(data))})
+	buf.Write(data)
+}
+
+// Pad an AE title to 16 characters
+func padAE(ae string) string {
+	if len(ae) > 16 {
+		ae = ae[:16]
+	}
+	return ae + strings.Repeat(" ", 16-len(ae))
+}
+
+func trimUID(b []byte) string {
+	return strings.TrimRight(string(b), " \x00")
+}
+
+// A presentation data value of a P-DATA-TF PDU: a fragment of a command or
+// data set
+type pdv struct {
+	contextID byte
+	command   bool
+	last      bool
+	data      []byte
+}
+
+func decodePData(data []byte) ([]pdv, error) {
+
+	var pdvs []pdv
+	for len(data) > 0 {
+		if len(data) < 6 {
+			return nil, ErrInvalidPDU
+		}
+		length := binary.BigEndian.Uint32(data)
+		if length < 2 || uint32(len(data)-4) < length {
+			return nil, ErrInvalidPDU
+		}
+		header := data[5]
+		pdvs = append(pdvs, pdv{
+			contextID: data[4],
+			command:   header&0x01 != 0,
+			last:      header&0x02 != 0,
+			data:      data[6 : 4+length],
+		})
+		data = data[4+length:]
+	}
+
+	return pdvs, nil
+}
+
+func (v *pdv) encode() []byte {
+	buf := make([]byte, 6, 6+len(v.data))
+	binary.BigEndian.PutUint32(buf, uint32(2+len(v.data)))
+	buf[4] = v.contextID
+	if v.command {
+		buf[5] |= 0x01
+	}
+	if v.last {
+		buf[5] |= 0x02
+	}
+	return append(buf, v.data...)
+}
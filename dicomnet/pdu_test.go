@@ -0,0 +1,80 @@
+package dicomnet
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestAssociateRoundTrip(t *testing.T) {
+
+	rq := &associate{
+		calledAE:  "STORESCP",
+		callingAE: "STORESCU",
+		contexts: []*presentationContext{
+			{id: 1, abstractSyntax: "1.2.840.10008.5.1.4.1.1.4", transferSyntaxes: []string{"1.2.840.10008.1.2.1", "1.2.840.10008.1.2"}},
+			{id: 3, abstractSyntax: "1.2.840.10008.5.1.4.1.1.7", transferSyntaxes: []string{"1.2.840.10008.1.2.4.50"}},
+		},
+		maxLength:             16384,
+		implementationUID:     "1.2.3",
+		implementationVersion: "TEST",
+		scpRoles:              []string{"1.2.840.10008.5.1.4.1.1.4"},
+	}
+
+	buffer := new(bytes.Buffer)
+	if err := writePDU(buffer, pduAssociateRQ, rq.encode()); err != nil {
+		t.Fatal(err)
+	}
+
+	pdu, err := readPDU(buffer, maxPDULength)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pdu.typ != pduAssociateRQ {
+		t.Errorf("Incorrect PDU type %#02x", pdu.typ)
+	}
+
+	decoded, err := decodeAssociate(pdu.data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, rq) {
+		t.Errorf("Incorrect A-ASSOCIATE-RQ %+v", decoded)
+	}
+
+}
+
+func TestReadPDUTooLong(t *testing.T) {
+
+	buffer := new(bytes.Buffer)
+	writePDU(buffer, pduData, make([]byte, 100))
+	if _, err := readPDU(buffer, 99); err == nil {
+		t.Error("Expected an error")
+	}
+
+}
+
+func TestDecodePData(t *testing.T) {
+
+	var data []byte
+	for _, v := range []pdv{
+		{contextID: 1, command: true, last: true, data: []byte{1, 2}},
+		{contextID: 1, command: false, last: false, data: []byte{3, 4, 5, 6}},
+	} {
+		data = append(data, v.encode()...)
+	}
+
+	pdvs, err := decodePData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pdvs) != 2 || !pdvs[0].command || !pdvs[0].last || pdvs[1].command || pdvs[1].last ||
+		!bytes.Equal(pdvs[1].data, []byte{3, 4, 5, 6}) {
+		t.Errorf("Incorrect PDVs %+v", pdvs)
+	}
+
+	if _, err := decodePData(data[:len(data)-1]); err == nil {
+		t.Error("Expected an error for a truncated PDV")
+	}
+
+}
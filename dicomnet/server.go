@@ -0,0 +1,318 @@
+package dicomnet
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"io"
+	"log"
+	"net"
+	"time"
+)
+
+var ErrUnsupportedCommand = errors.New("Unsupported DIMSE command")
+
+// Called with the files received with C-STORE, along with the file meta
+// information of the transfer. Returns the status of the response.
+type StoreHandler func(a *Association, file *dicom.DicomFile) Status
+
+// Called with the identifiers of C-FIND requests. Returns the matches, sent
+// as pending responses, and the status of the final response.
+type FindHandler func(a *Association, sopClass string, query *dicom.DicomFile) ([]*dicom.DicomFile, Status)
+
+// Called with the identifiers of C-MOVE requests, to send the matching
+// instances to the AE titled destination. Returns the sub-operations and the
+// status of the final response.
+type MoveHandler func(a *Association, sopClass, destination string, query *dicom.DicomFile) (SubOperations, Status)
+
+// Called with the identifiers of C-GET requests. Returns the matching
+// instances, sent over the association with C-STORE, or the failure status
+// of the final response.
+type GetHandler func(a *Association, sopClass string, query *dicom.DicomFile) ([]*dicom.DicomFile, Status)
+
+// An SCP accepting associations and answering the requests of its peers.
+// C-ECHO requests are always answered with StatusSuccess; other requests
+// without a handler are refused with StatusSOPClassNotSupported.
+type Server struct {
+	AETitle        string // the called AE title accepted, any if empty
+	MaxPDULength   uint32 // of the PDUs received, DefaultMaxPDULength if 0
+	ReadBufferSize int    // of the connections, DefaultReadBufferSize if 0
+	Store          StoreHandler
+	Coercion       *dicom.Coercion // applied before Store, with the calling AE title as source
+	Find           FindHandler
+	Move           MoveHandler
+	Get            GetHandler
+	ErrorLog       *log.Logger // errors of the associations, discarded if nil
+}
+
+// Listen on the TCP address addr and serve the associations opened
+func (s *Server) ListenAndServe(addr string) error {
+
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	return s.Serve(l)
+}
+
+// Serve the associations opened on l, each in its own goroutine, until
+// accepting a connection fails
+func (s *Server) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serve(conn)
+	}
+}
+
+func (s *Server) serve(conn net.Conn) {
+
+	a, err := s.accept(conn)
+	if err != nil {
+		countAssociationFailure("acceptor", err)
+		s.logf("%v: %v", conn.RemoteAddr(), err)
+		conn.Close()
+		return
+	}
+
+	for {
+		msg, err := a.receive()
+		if err == io.EOF {
+			return
+		}
+		if err == nil {
+			start := time.Now()
+			var status Status
+			field, _ := commandUInt16(msg.command, dicom.TagCommandField)
+			switch field {
+			case commandCStoreRQ:
+				status, err = s.handleStore(a, msg)
+			case commandCFindRQ:
+				status, err = s.handleFind(a, msg)
+			case commandCMoveRQ:
+				status, err = s.handleMove(a, msg)
+			case commandCGetRQ:
+				status, err = s.handleGet(a, msg)
+			case commandCEchoRQ:
+				status, err = StatusSuccess, a.respond(msg, commandCEchoRSP, StatusSuccess, nil)
+			default:
+				err = fmt.Errorf("%w: %#04x", ErrUnsupportedCommand, field)
+			}
+			if _, ok := commandNames[field]; ok {
+				observeOperation(field, "scp", start, &status, &err)
+			}
+		}
+		if err != nil {
+			s.logf("%s: %v", a.CallingAE(), err)
+			a.Abort()
+			return
+		}
+	}
+}
+
+// Answer the A-ASSOCIATE-RQ of a peer. Every abstract syntax is accepted,
+// with the first transfer syntax proposed that the dicom package can parse.
+func (s *Server) accept(conn net.Conn) (*Association, error) {
+
+	size := s.ReadBufferSize
+	if size == 0 {
+		size = DefaultReadBufferSize
+	}
+	r := bufio.NewReaderSize(conn, size)
+
+	pdu, err := readPDU(r, maxPDULength)
+	if err != nil {
+		return nil, err
+	}
+	if pdu.typ != pduAssociateRQ {
+		writePDU(conn, pduAbort, make([]byte, 4))
+		return nil, fmt.Errorf("%w: %#02x", ErrUnexpectedPDU, pdu.typ)
+	}
+
+	rq, err := decodeAssociate(pdu.data)
+	if err != nil {
+		writePDU(conn, pduAbort, make([]byte, 4))
+		return nil, err
+	}
+
+	if s.AETitle != "" && rq.calledAE != s.AETitle {
+		// rejected permanent, by the service user, called AE title not recognized
+		writePDU(conn, pduAssociateRJ, []byte{0, 1, 1, 7})
+		return nil, fmt.Errorf("%w: called AE title %q", ErrRejected, rq.calledAE)
+	}
+
+	a, err := newAssociation(conn, Config{
+		CallingAE:      rq.callingAE,
+		CalledAE:       rq.calledAE,
+		MaxPDULength:   s.MaxPDULength,
+		ReadBufferSize: size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	a.r = r // which may hold the PDUs following the A-ASSOCIATE-RQ
+	a.maxLength = rq.maxLength
+
+	ac := &associate{
+		calledAE:              rq.calledAE,
+		callingAE:             rq.callingAE,
+		maxLength:             a.config.MaxPDULength,
+		implementationUID:     dicom.ImplementationClassUID,
+		implementationVersion: ImplementationVersionName,
+		scpRoles:              rq.scpRoles,
+	}
+	for _, pc := range rq.contexts {
+		answer := &presentationContext{id: pc.id, result: 4} // transfer syntaxes not supported
+		// the first transfer syntax proposed
+		if len(pc.transferSyntaxes) > 0 {
+			ts := pc.transferSyntaxes[0]
+			answer.result = 0
+			answer.transferSyntaxes = []string{ts}
+			a.contexts[pc.id] = &presentationContext{id: pc.id, abstractSyntax: pc.abstractSyntax, transferSyntaxes: []string{ts}}
+		}
+		ac.contexts = append(ac.contexts, answer)
+	}
+
+	if err := writePDU(conn, pduAssociateAC, ac.encode()); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Answer a C-STORE request, returning the status of the response
+func (s *Server) handleStore(a *Association, msg *message) (Status, error) {
+
+	sopClass := lookupString(msg.command, dicom.TagAffectedSOPClassUID)
+	sopInstance := lookupString(msg.command, dicom.TagAffectedSOPInstanceUID)
+
+	status := StatusSOPClassNotSupported
+	if s.Store != nil {
+		file, err := a.parseData(msg)
+		if err == nil {
+			err = a.addFileMeta(file, msg.contextID, sopClass, sopInstance, a.CallingAE())
+		}
+		if err == nil && s.Coercion != nil {
+			_, err = s.Coercion.Apply(file, a.CallingAE())
+		}
+		if err != nil {
+			s.logf("%s: %s: %v", a.CallingAE(), sopInstance, err)
+			status = StatusCannotProcess
+		} else {
+			status = s.Store(a, file)
+		}
+	}
+
+	return status, a.respond(msg, commandCStoreRSP, status, nil,
+		field{dicom.TagAffectedSOPInstanceUID, dicom.Strings{sopInstance}},
+	)
+}
+
+// Answer a C-FIND request with a pending response per match, returning the
+// status of the final response
+func (s *Server) handleFind(a *Association, msg *message) (Status, error) {
+
+	if s.Find == nil {
+		return StatusSOPClassNotSupported, a.respond(msg, commandCFindRSP, StatusSOPClassNotSupported, nil)
+	}
+
+	query, err := a.parseData(msg)
+	if err != nil {
+		s.logf("%s: %v", a.CallingAE(), err)
+		return StatusCannotProcess, a.respond(msg, commandCFindRSP, StatusCannotProcess, nil)
+	}
+
+	results, status := s.Find(a, lookupString(msg.command, dicom.TagAffectedSOPClassUID), query)
+	for _, result := range results {
+		if err := a.respond(msg, commandCFindRSP, StatusPending, result); err != nil {
+			return status, err
+		}
+	}
+
+	return status, a.respond(msg, commandCFindRSP, status, nil)
+}
+
+// Answer a C-MOVE request, returning the status of the final response
+func (s *Server) handleMove(a *Association, msg *message) (Status, error) {
+
+	if s.Move == nil {
+		return StatusSOPClassNotSupported, a.respond(msg, commandCMoveRSP, StatusSOPClassNotSupported, nil)
+	}
+
+	query, err := a.parseData(msg)
+	if err != nil {
+		s.logf("%s: %v", a.CallingAE(), err)
+		return StatusCannotProcess, a.respond(msg, commandCMoveRSP, StatusCannotProcess, nil)
+	}
+
+	ops, status := s.Move(a, lookupString(msg.command, dicom.TagAffectedSOPClassUID),
+		lookupString(msg.command, dicom.TagMoveDestination), query)
+
+	return status, a.respond(msg, commandCMoveRSP, status, nil, ops.fields(status.Pending())...)
+}
+
+// Send a response to a request, with an identifier if data is not nil
+func (a *Association) respond(msg *message, command uint16, status Status, data *dicom.DicomFile, fields ...field) error {
+
+	id, _ := commandUInt16(msg.command, dicom.TagMessageID)
+	dataSetType := uint16(noDataSet)
+	var encoded []byte
+	if data != nil {
+		buffer := new(bytes.Buffer)
+		if err := data.WriteDataSet(buffer, a.contexts[msg.contextID].transferSyntaxes[0]); err != nil {
+			return err
+		}
+		dataSetType = hasDataSet
+		encoded = buffer.Bytes()
+	}
+
+	rsp, err := a.newCommand(append(fields,
+		field{dicom.TagAffectedSOPClassUID, dicom.Strings{lookupString(msg.command, dicom.TagAffectedSOPClassUID)}},
+		field{dicom.TagCommandField, dicom.UInt16s{command}},
+		field{dicom.TagMessageIDBeingRespondedTo, dicom.UInt16s{id}},
+		field{dicom.TagCommandDataSetType, dicom.UInt16s{dataSetType}},
+		field{dicom.TagStatus, dicom.UInt16s{uint16(status)}},
+	)...)
+	if err != nil {
+		return err
+	}
+
+	return a.send(&message{msg.contextID, rsp, encoded})
+}
+
+// Prepend the file meta information of a data set received from the AE
+// titled source
+func (a *Association) addFileMeta(file *dicom.DicomFile, contextID byte, sopClass, sopInstance, source string) error {
+
+	var meta []dicom.DicomElement
+	for _, f := range []field{
+		{dicom.TagFileMetaInformationVersion, dicom.Bytes{0x00, 0x01}},
+		{dicom.TagMediaStorageSOPClassUID, dicom.Strings{sopClass}},
+		{dicom.TagMediaStorageSOPInstanceUID, dicom.Strings{sopInstance}},
+		{dicom.TagTransferSyntaxUID, dicom.Strings{a.contexts[contextID].transferSyntaxes[0]}},
+		{dicom.TagImplementationClassUID, dicom.Strings{dicom.ImplementationClassUID}},
+		{dicom.TagImplementationVersionName, dicom.Strings{ImplementationVersionName}},
+		{dicom.TagSourceApplicationEntityTitle, dicom.Strings{source}},
+	} {
+		elem, err := a.parser.NewElement(f.tag, f.value)
+		if err != nil {
+			return err
+		}
+		meta = append(meta, *elem)
+	}
+	file.Elements = append(meta, file.Elements...)
+
+	return nil
+}
+
+func (s *Server) logf(format string, args ...interface{}) {
+	if s.ErrorLog != nil {
+		s.ErrorLog.Printf(format, args...)
+	}
+}
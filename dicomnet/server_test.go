@@ -0,0 +1,102 @@
+package dicomnet
+
+import (
+	"errors"
+	"github.com/gillesdemey/go-dicom"
+	"net"
+	"testing"
+)
+
+func TestServerFileMeta(t *testing.T) {
+
+	file := readExample(t, "IM-0001-0002.dcm")
+	stored := make(chan *dicom.DicomFile, 1)
+	addr := fakeSCP(t, stored)
+
+	a, err := Dial(addr, Config{CallingAE: "MODALITY"}, StorageContexts(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Release()
+
+	if _, err := a.Store(file); err != nil {
+		t.Fatal(err)
+	}
+
+	received := <-stored
+	for tag, expected := range map[dicom.Tag]string{
+		dicom.TagMediaStorageSOPInstanceUID:   lookupString(file, dicom.TagSOPInstanceUID),
+		dicom.TagTransferSyntaxUID:            lookupString(file, dicom.TagTransferSyntaxUID),
+		dicom.TagSourceApplicationEntityTitle: "MODALITY",
+	} {
+		if s := lookupString(received, tag); s != expected {
+			t.Errorf("Incorrect %v %s, expected %s", tag, s, expected)
+		}
+	}
+
+}
+
+func TestServerCalledAE(t *testing.T) {
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go (&Server{AETitle: "STORESCP"}).Serve(l)
+
+	contexts := []PresentationContext{{"1.2.840.10008.5.1.4.1.1.4", []string{dicom.ImplicitVRLittleEndian}}}
+
+	if _, err := Dial(l.Addr().String(), Config{CalledAE: "OTHER"}, contexts); !errors.Is(err, ErrRejected) {
+		t.Errorf("Expected ErrRejected, got %v", err)
+	}
+
+	a, err := Dial(l.Addr().String(), Config{CalledAE: "STORESCP"}, contexts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Release(); err != nil {
+		t.Error(err)
+	}
+
+}
+
+func TestServerReadBuffer(t *testing.T) {
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	stored := make(chan *dicom.DicomFile, 1)
+	go (&Server{
+		ReadBufferSize: 16,
+		Store: func(a *Association, file *dicom.DicomFile) Status {
+			stored <- file
+			return StatusSuccess
+		},
+	}).Serve(l)
+
+	file := readExample(t, "IM-0001-0002.dcm")
+	a, err := Dial(l.Addr().String(), Config{ReadBufferSize: 16}, StorageContexts(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Release()
+
+	if _, err := a.Store(file); err != nil {
+		t.Fatal(err)
+	}
+	// the file meta information is that of the transfer
+	opts := dicom.EqualOptions{IgnoreTags: []dicom.Tag{
+		dicom.TagFileMetaInformationGroupLength,
+		dicom.TagImplementationClassUID,
+		dicom.TagImplementationVersionName,
+		dicom.TagSourceApplicationEntityTitle,
+	}}
+	if received := <-stored; !dicom.Equal(file, received, opts) {
+		t.Error("The file received differs from the file sent")
+	}
+
+}
@@ -0,0 +1,98 @@
+package dicomnet
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"time"
+)
+
+// Uncompressed transfer syntaxes, which the files are transcoded to when
+// their own transfer syntax is not accepted
+var uncompressed = []string{
+	dicom.ExplicitVRLittleEndian,
+	dicom.ImplicitVRLittleEndian,
+	dicom.ExplicitVRBigEndian,
+}
+
+func isUncompressed(ts string) bool {
+	for _, u := range uncompressed {
+		if ts == u {
+			return true
+		}
+	}
+	return false
+}
+
+// Return the presentation contexts to propose to store the files: the
+// uncompressed transfer syntaxes for each SOP class, and a presentation
+// context of its own for each other transfer syntax of the files
+func StorageContexts(files ...*dicom.DicomFile) []PresentationContext {
+
+	var contexts []PresentationContext
+	seen := map[string]bool{}
+	for _, file := range files {
+		sopClass := lookupString(file, dicom.TagSOPClassUID)
+		ts := lookupString(file, dicom.TagTransferSyntaxUID)
+		if !seen[sopClass] {
+			seen[sopClass] = true
+			contexts = append(contexts, PresentationContext{sopClass, uncompressed})
+		}
+		if !isUncompressed(ts) && !seen[sopClass+"/"+ts] {
+			seen[sopClass+"/"+ts] = true
+			contexts = append(contexts, PresentationContext{sopClass, []string{ts}})
+		}
+	}
+
+	return contexts
+}
+
+// Send a file with C-STORE and return the status of the response. Files in
+// an uncompressed transfer syntax are transcoded to the transfer syntax
+// accepted; others are sent as they are. Returns ErrNoPresentationContext if
+// no presentation context was accepted for the file.
+func (a *Association) Store(file *dicom.DicomFile) (status Status, err error) {
+
+	defer observeOperation(commandCStoreRQ, "scu", time.Now(), &status, &err)
+
+	sopClass := lookupString(file, dicom.TagSOPClassUID)
+	sopInstance := lookupString(file, dicom.TagSOPInstanceUID)
+	ts := lookupString(file, dicom.TagTransferSyntaxUID)
+
+	pc := a.contextFor(sopClass, ts)
+	if pc != nil && pc.transferSyntaxes[0] != ts && !(isUncompressed(ts) && isUncompressed(pc.transferSyntaxes[0])) {
+		pc = nil
+	}
+	if pc == nil {
+		return 0, fmt.Errorf("%w: %s in %s", ErrNoPresentationContext, sopClass, ts)
+	}
+
+	data := new(bytes.Buffer)
+	if err := file.WriteDataSet(data, pc.transferSyntaxes[0]); err != nil {
+		return 0, err
+	}
+
+	id := a.nextMessageID()
+	cmd, err := a.newCommand(
+		field{dicom.TagAffectedSOPClassUID, dicom.Strings{sopClass}},
+		field{dicom.TagCommandField, dicom.UInt16s{commandCStoreRQ}},
+		field{dicom.TagMessageID, dicom.UInt16s{id}},
+		field{dicom.TagPriority, dicom.UInt16s{0}},
+		field{dicom.TagCommandDataSetType, dicom.UInt16s{hasDataSet}},
+		field{dicom.TagAffectedSOPInstanceUID, dicom.Strings{sopInstance}},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := a.send(&message{pc.id, cmd, data.Bytes()}); err != nil {
+		return 0, err
+	}
+
+	rsp, err := a.receive()
+	if err != nil {
+		return 0, err
+	}
+
+	return responseStatus(rsp, commandCStoreRSP, id)
+}
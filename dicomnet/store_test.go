@@ -0,0 +1,127 @@
+package dicomnet
+
+import (
+	"github.com/gillesdemey/go-dicom"
+	"io/ioutil"
+	"net"
+	"testing"
+)
+
+func readExample(t *testing.T, name string) *dicom.DicomFile {
+
+	buff, err := ioutil.ReadFile("../examples/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, _ := dicom.NewParser()
+	file, err := p.Parse(buff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return file
+}
+
+// A storage SCP passing the files received to stored
+func fakeSCP(t *testing.T, stored chan<- *dicom.DicomFile) string {
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	s := &Server{
+		MaxPDULength: 1024,
+		Store: func(a *Association, file *dicom.DicomFile) Status {
+			stored <- file
+			return StatusSuccess
+		},
+	}
+	go s.Serve(l)
+
+	return l.Addr().String()
+}
+
+// The data set of a file, without its file meta information
+func dataSet(file *dicom.DicomFile) *dicom.DicomFile {
+	ds := &dicom.DicomFile{}
+	for _, elem := range file.Elements {
+		if elem.Group != 0x0002 {
+			ds.Elements = append(ds.Elements, elem)
+		}
+	}
+	return ds
+}
+
+func TestStore(t *testing.T) {
+
+	file := readExample(t, "IM-0001-0001.dcm")
+	stored := make(chan *dicom.DicomFile, 1)
+	addr := fakeSCP(t, stored)
+
+	a, err := Dial(addr, Config{CallingAE: "SCU", CalledAE: "SCP", MaxPDULength: 4096}, StorageContexts(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.maxLength != 1024 {
+		t.Errorf("Incorrect maximum length %d", a.maxLength)
+	}
+
+	status, err := a.Store(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != StatusSuccess {
+		t.Errorf("Incorrect status %v", status)
+	}
+
+	received := <-stored
+	if diffs := dicom.Diff(dataSet(file), dataSet(received), dicom.EqualOptions{}); len(diffs) != 0 {
+		t.Errorf("Incorrect data set received %v", diffs)
+	}
+
+	if err := a.Release(); err != nil {
+		t.Error(err)
+	}
+
+}
+
+func TestStoreWithoutContext(t *testing.T) {
+
+	file := readExample(t, "IM-0001-0001.dcm")
+	other := file.Clone()
+	for i := range other.Elements {
+		if other.Elements[i].Tag() == dicom.TagSOPClassUID {
+			other.Elements[i].Value = dicom.Strings{"1.2.840.10008.5.1.4.1.1.7"}
+		}
+	}
+
+	addr := fakeSCP(t, make(chan *dicom.DicomFile, 1))
+	a, err := Dial(addr, Config{}, StorageContexts(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Abort()
+
+	if _, err := a.Store(other); err == nil {
+		t.Error("Expected an error")
+	}
+
+}
+
+func TestStatus(t *testing.T) {
+
+	for status, expected := range map[Status]string{
+		StatusSuccess: "Success",
+		0xb000:        "Warning (B000)",
+		0xa700:        "Failure (A700)",
+		0xff01:        "Pending",
+		0xc123:        "Failure (C123)",
+	} {
+		if s := status.String(); s != expected {
+			t.Errorf("Incorrect string %s, expected %s", s, expected)
+		}
+	}
+
+}
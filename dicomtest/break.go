@@ -0,0 +1,72 @@
+package dicomtest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/gillesdemey/go-dicom"
+)
+
+// The value of the elements whose length is broken
+const breakMarker = "BROKEN"
+
+// Break the value length of an encoded file
+func breakFile(b []byte, opts *Options) ([]byte, error) {
+
+	var bo binary.AppendByteOrder = binary.LittleEndian
+	if opts.TransferSyntax == dicom.ExplicitVRBigEndian {
+		bo = binary.BigEndian
+	}
+	implicit := opts.TransferSyntax == dicom.ImplicitVRLittleEndian
+
+	switch opts.Break {
+	case BreakOddLength:
+		// StudyDescription, with the length of its value without padding
+		head := header(bo, implicit, dicom.TagStudyDescription, "LO")
+		i := bytes.Index(b, append(head, lengthOf(bo, implicit, "LO", len(breakMarker))...))
+		if i < 0 {
+			return nil, ErrCannotBreak
+		}
+		copy(b[i+len(head):], lengthOf(bo, implicit, "LO", len(breakMarker)-1))
+	case BreakTruncated:
+		// the last bytes of the pixel data, but its delimiter if encapsulated
+		n := 2
+		if !isNative(opts.TransferSyntax) {
+			n += 8
+		}
+		b = b[:len(b)-n]
+	case BreakUndefinedLength:
+		if implicit {
+			return nil, ErrCannotBreak
+		}
+		head := header(bo, implicit, dicom.TagTextComments, "UT")
+		i := bytes.Index(b, head)
+		if i < 0 {
+			return nil, ErrCannotBreak
+		}
+		copy(b[i+len(head):], lengthOf(bo, implicit, "UT", 0xffffffff))
+	}
+
+	return b, nil
+}
+
+// The tag and VR of an element header, before its value length
+func header(bo binary.AppendByteOrder, implicit bool, tag dicom.Tag, vr string) []byte {
+	b := bo.AppendUint16(nil, tag.Group)
+	b = bo.AppendUint16(b, tag.Element)
+	if implicit {
+		return b
+	}
+	b = append(b, vr...)
+	if vr == "UT" {
+		b = append(b, 0, 0)
+	}
+	return b
+}
+
+// The encoded value length of an element
+func lengthOf(bo binary.AppendByteOrder, implicit bool, vr string, n int) []byte {
+	if implicit || vr == "UT" {
+		return bo.AppendUint32(nil, uint32(n))
+	}
+	return bo.AppendUint16(nil, uint16(n))
+}
@@ -0,0 +1,223 @@
+// Package dicomtest generates DICOM files with controlled properties, for
+// tests which need edge cases the example files do not cover: transfer
+// syntaxes, nested sequences, character sets, pixel formats and broken
+// value lengths.
+package dicomtest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"github.com/gillesdemey/go-dicom"
+	"strconv"
+	"sync/atomic"
+)
+
+var ErrCannotBreak = errors.New("Cannot break the value length in this transfer syntax")
+
+// Secondary Capture Image Storage
+const sopClassUID = "1.2.840.10008.5.1.4.1.1.7"
+
+// A way to break the files generated
+type Break int
+
+const (
+	BreakNone            Break = iota
+	BreakOddLength             // an element with an odd value length
+	BreakTruncated             // the value of the last element ends past the end of the file
+	BreakUndefinedLength       // an UT element with an undefined length, in explicit VR
+)
+
+// The properties of the files generated. The zero value generates a 4x4
+// MONOCHROME2 image of 16 bits in Explicit VR Little Endian.
+type Options struct {
+	TransferSyntax string // Explicit VR Little Endian if empty
+	CharacterSet   string // the Specific Character Set, none if empty
+	PatientName    string // DOE^JOHN if empty, encoded with CharacterSet
+	SOPInstanceUID string // a new UID if empty
+
+	// Depth of the nested ReferencedSeriesSequence, none if 0
+	Depth int
+
+	// The pixel data, 4x4 pixels of 16 bits with one sample and one frame
+	// if 0. The frames of transfer syntaxes other than the native ones are
+	// encapsulated uncompressed, one fragment per frame.
+	Rows, Columns   int
+	BitsAllocated   int // 8 or 16
+	SamplesPerPixel int // 1 or 3
+	Frames          int
+
+	Break Break
+}
+
+var uids atomic.Uint64
+
+// Return a new UID, unique within the process
+func NewUID() string {
+	return "2.25." + strconv.FormatUint(uids.Add(1), 10)
+}
+
+// Generate a file
+func New(opts Options) *dicom.DicomFile {
+
+	opts.setDefaults()
+
+	file := &dicom.DicomFile{}
+	add := func(tag dicom.Tag, vr string, value dicom.Value) {
+		file.Elements = append(file.Elements, *element(tag, vr, value))
+	}
+
+	add(dicom.TagFileMetaInformationVersion, "OB", dicom.Bytes{0x00, 0x01})
+	add(dicom.TagMediaStorageSOPClassUID, "UI", dicom.Strings{sopClassUID})
+	add(dicom.TagMediaStorageSOPInstanceUID, "UI", dicom.Strings{opts.SOPInstanceUID})
+	add(dicom.TagTransferSyntaxUID, "UI", dicom.Strings{opts.TransferSyntax})
+	add(dicom.TagImplementationClassUID, "UI", dicom.Strings{"2.25.0"})
+
+	if opts.CharacterSet != "" {
+		add(dicom.TagSpecificCharacterSet, "CS", dicom.Strings{opts.CharacterSet})
+	}
+	add(dicom.TagSOPClassUID, "UI", dicom.Strings{sopClassUID})
+	add(dicom.TagSOPInstanceUID, "UI", dicom.Strings{opts.SOPInstanceUID})
+	add(dicom.TagModality, "CS", dicom.Strings{"OT"})
+	if opts.Break == BreakOddLength {
+		add(dicom.TagStudyDescription, "LO", dicom.Strings{breakMarker})
+	}
+	add(dicom.TagPatientName, "PN", dicom.Strings{opts.PatientName})
+	add(dicom.TagPatientID, "LO", dicom.Strings{"TEST"})
+	add(dicom.TagStudyInstanceUID, "UI", dicom.Strings{NewUID()})
+	add(dicom.TagSeriesInstanceUID, "UI", dicom.Strings{NewUID()})
+	if opts.Depth > 0 {
+		file.Elements = append(file.Elements, *nestedSequence(opts.Depth))
+	}
+	if opts.Break == BreakUndefinedLength {
+		add(dicom.TagTextComments, "UT", dicom.Strings{breakMarker})
+	}
+
+	photometric := "MONOCHROME2"
+	if opts.SamplesPerPixel == 3 {
+		photometric = "RGB"
+	}
+	add(dicom.TagSamplesPerPixel, "US", dicom.UInt16s{uint16(opts.SamplesPerPixel)})
+	add(dicom.TagPhotometricInterpretation, "CS", dicom.Strings{photometric})
+	if opts.SamplesPerPixel == 3 {
+		add(dicom.TagPlanarConfiguration, "US", dicom.UInt16s{0})
+	}
+	if opts.Frames > 1 {
+		add(dicom.TagNumberOfFrames, "IS", dicom.Strings{strconv.Itoa(opts.Frames)})
+	}
+	add(dicom.TagRows, "US", dicom.UInt16s{uint16(opts.Rows)})
+	add(dicom.TagColumns, "US", dicom.UInt16s{uint16(opts.Columns)})
+	add(dicom.TagBitsAllocated, "US", dicom.UInt16s{uint16(opts.BitsAllocated)})
+	add(dicom.TagBitsStored, "US", dicom.UInt16s{uint16(opts.BitsAllocated)})
+	add(dicom.TagHighBit, "US", dicom.UInt16s{uint16(opts.BitsAllocated - 1)})
+	add(dicom.TagPixelRepresentation, "US", dicom.UInt16s{0})
+	file.Elements = append(file.Elements, *pixelData(&opts))
+
+	return file
+}
+
+// Generate a file and encode it, broken as set by opts.Break
+func Generate(opts Options) ([]byte, error) {
+
+	opts.setDefaults()
+
+	out := new(bytes.Buffer)
+	if err := New(opts).Write(out); err != nil {
+		return nil, err
+	}
+
+	return breakFile(out.Bytes(), &opts)
+}
+
+func (opts *Options) setDefaults() {
+	if opts.TransferSyntax == "" {
+		opts.TransferSyntax = dicom.ExplicitVRLittleEndian
+	}
+	if opts.PatientName == "" {
+		opts.PatientName = "DOE^JOHN"
+	}
+	if opts.SOPInstanceUID == "" {
+		opts.SOPInstanceUID = NewUID()
+	}
+	if opts.Rows == 0 {
+		opts.Rows = 4
+	}
+	if opts.Columns == 0 {
+		opts.Columns = 4
+	}
+	if opts.BitsAllocated == 0 {
+		opts.BitsAllocated = 16
+	}
+	if opts.SamplesPerPixel == 0 {
+		opts.SamplesPerPixel = 1
+	}
+	if opts.Frames == 0 {
+		opts.Frames = 1
+	}
+}
+
+func element(tag dicom.Tag, vr string, value dicom.Value) *dicom.DicomElement {
+	return &dicom.DicomElement{Group: tag.Group, Element: tag.Element, Vr: vr, Value: value}
+}
+
+// A ReferencedSeriesSequence nesting depth sequences
+func nestedSequence(depth int) *dicom.DicomElement {
+
+	item := &dicom.Item{Elements: []*dicom.DicomElement{
+		element(dicom.TagSeriesInstanceUID, "UI", dicom.Strings{NewUID()}),
+	}}
+	if depth > 1 {
+		item.Elements = append(item.Elements, nestedSequence(depth-1))
+	}
+
+	return element(dicom.TagReferencedSeriesSequence, "SQ", dicom.Sequence{item})
+}
+
+// Whether the pixel data of a transfer syntax is native rather than
+// encapsulated
+func isNative(ts string) bool {
+	switch ts {
+	case dicom.ImplicitVRLittleEndian, dicom.ExplicitVRLittleEndian, dicom.ExplicitVRBigEndian:
+		return true
+	}
+	return false
+}
+
+// Pixel data of a gradient, frame after frame
+func pixelData(opts *Options) *dicom.DicomElement {
+
+	samples := opts.Rows * opts.Columns * opts.SamplesPerPixel
+	frames := make([][]byte, opts.Frames)
+	for i := range frames {
+		frame := make([]byte, 0, samples*opts.BitsAllocated/8)
+		for j := 0; j < samples; j++ {
+			if opts.BitsAllocated == 8 {
+				frame = append(frame, byte(i+j))
+			} else {
+				frame = binary.LittleEndian.AppendUint16(frame, uint16(i<<8+j))
+			}
+		}
+		frames[i] = frame
+	}
+
+	if !isNative(opts.TransferSyntax) {
+		pixels := &dicom.PixelData{}
+		var offset uint32
+		for _, frame := range frames {
+			pixels.Offsets = append(pixels.Offsets, offset)
+			pixels.Fragments = append(pixels.Fragments, frame)
+			offset += 8 + uint32(len(frame)+len(frame)%2)
+		}
+		return element(dicom.TagPixelData, "OB", pixels)
+	}
+
+	data := bytes.Join(frames, nil)
+	if opts.BitsAllocated == 8 {
+		return element(dicom.TagPixelData, "OB", dicom.Bytes(data))
+	}
+	words := make(dicom.UInt16s, len(data)/2)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint16(data[2*i:])
+	}
+	return element(dicom.TagPixelData, "OW", words)
+}
@@ -0,0 +1,92 @@
+package dicomtest
+
+import (
+	"errors"
+	"github.com/gillesdemey/go-dicom"
+	"testing"
+)
+
+func parse(t *testing.T, opts Options) (*dicom.DicomFile, error) {
+
+	b, err := Generate(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, _ := dicom.NewParser()
+	return p.Parse(b)
+}
+
+func TestTransferSyntaxes(t *testing.T) {
+
+	for _, ts := range []string{dicom.ImplicitVRLittleEndian, dicom.ExplicitVRLittleEndian, dicom.ExplicitVRBigEndian, "1.2.840.10008.1.2.5"} {
+		opts := Options{TransferSyntax: ts, Depth: 3, Frames: 2, SOPInstanceUID: NewUID()}
+		file, err := parse(t, opts)
+		if err != nil {
+			t.Fatalf("failed to parse a file in %s: %s", ts, err)
+		}
+		if !dicom.Equal(file, New(opts), dicom.EqualOptions{IgnoreTags: []dicom.Tag{dicom.TagFileMetaInformationGroupLength, dicom.TagStudyInstanceUID, dicom.TagSeriesInstanceUID}}) {
+			t.Errorf("File in %s differs from the file generated", ts)
+		}
+	}
+}
+
+func TestNestedSequences(t *testing.T) {
+
+	file := New(Options{Depth: 5})
+	paths := 0
+	file.Walk(func(path dicom.TagPath, elem *dicom.DicomElement) error {
+		if elem.Tag() == dicom.TagReferencedSeriesSequence {
+			paths++
+		}
+		return nil
+	})
+	if paths != 5 {
+		t.Errorf("Generated %d nested sequences, expected 5", paths)
+	}
+}
+
+func TestCharacterSet(t *testing.T) {
+
+	file, err := parse(t, Options{CharacterSet: "ISO_IR 100", PatientName: "Müller^Jörg"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	elem, _ := file.LookupElementByTag(dicom.TagPatientName)
+	if name, _ := elem.GetString(); name != "Müller^Jörg" {
+		t.Errorf("Incorrect patient name %q", name)
+	}
+}
+
+func TestPixelFormats(t *testing.T) {
+
+	file := New(Options{Rows: 2, Columns: 3, BitsAllocated: 8, SamplesPerPixel: 3})
+	elem, _ := file.LookupElementByTag(dicom.TagPixelData)
+	if b, ok := elem.Value.(dicom.Bytes); !ok || len(b) != 18 {
+		t.Errorf("Incorrect pixel data %v", elem.Value)
+	}
+
+	file = New(Options{Frames: 3, TransferSyntax: "1.2.840.10008.1.2.4.50"})
+	elem, _ = file.LookupElementByTag(dicom.TagPixelData)
+	if pixels, ok := elem.Value.(*dicom.PixelData); !ok || len(pixels.Fragments) != 3 {
+		t.Errorf("Incorrect pixel data %v", elem.Value)
+	}
+}
+
+func TestBreaks(t *testing.T) {
+
+	if _, err := parse(t, Options{Break: BreakOddLength}); !errors.Is(err, dicom.ErrOddLength) {
+		t.Errorf("Incorrect error for an odd length %v", err)
+	}
+	if _, err := parse(t, Options{Break: BreakUndefinedLength}); !errors.Is(err, dicom.ErrUndefLengthNotAllowed) {
+		t.Errorf("Incorrect error for an undefined length %v", err)
+	}
+	for _, ts := range []string{dicom.ImplicitVRLittleEndian, "1.2.840.10008.1.2.5"} {
+		if _, err := parse(t, Options{TransferSyntax: ts, Break: BreakTruncated}); err == nil {
+			t.Errorf("Truncated file in %s parsed", ts)
+		}
+	}
+
+	if _, err := Generate(Options{TransferSyntax: dicom.ImplicitVRLittleEndian, Break: BreakUndefinedLength}); err != ErrCannotBreak {
+		t.Errorf("Incorrect error %v", err)
+	}
+}
@@ -0,0 +1,288 @@
+// Package dicomweb implements a client of the DICOMweb services of PS 3.18:
+// QIDO-RS to search, WADO-RS to retrieve and STOW-RS to store instances.
+package dicomweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrRequestFailed   = errors.New("DICOMweb request failed")
+	ErrInvalidResponse = errors.New("Invalid DICOMweb response")
+)
+
+// A client of the DICOMweb services rooted at a URL
+type Client struct {
+	url  string
+	http *http.Client
+}
+
+// Create a client of the services rooted at url, ie.
+// http://pacs/dicom-web. Requests are sent with httpClient,
+// http.DefaultClient if nil.
+func NewClient(url string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{strings.TrimRight(url, "/"), httpClient}
+}
+
+// Search for studies with QIDO-RS (PS 3.18 10.6), ie. with the query
+// parameters url.Values{"PatientID": {"123"}, "includefield": {"StudyDescription"}},
+// and return the data sets of the matches
+func (c *Client) SearchStudies(ctx context.Context, query url.Values) ([]*dicom.DicomFile, error) {
+	return c.search(ctx, "/studies", query)
+}
+
+// Search for the series of a study with QIDO-RS, or of every study if
+// studyUID is empty
+func (c *Client) SearchSeries(ctx context.Context, studyUID string, query url.Values) ([]*dicom.DicomFile, error) {
+	return c.search(ctx, resourcePath(studyUID, "", "")+"/series", query)
+}
+
+// Search for the instances of a series with QIDO-RS, or of a study if
+// seriesUID is empty, or of every study if both are
+func (c *Client) SearchInstances(ctx context.Context, studyUID, seriesUID string, query url.Values) ([]*dicom.DicomFile, error) {
+	return c.search(ctx, resourcePath(studyUID, seriesUID, "")+"/instances", query)
+}
+
+func (c *Client) search(ctx context.Context, path string, query url.Values) ([]*dicom.DicomFile, error) {
+
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	rsp, err := c.do(ctx, "GET", path, "application/dicom+json", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	// no matches
+	if rsp.StatusCode == http.StatusNoContent {
+		return nil, nil
+	}
+
+	return decodeDataSets(rsp.Body)
+}
+
+// Retrieve the instances of a study, a series or a single instance with
+// WADO-RS (PS 3.18 10.4), seriesUID and instanceUID being empty to retrieve
+// a study, instanceUID to retrieve a series. The instances are retrieved in
+// the transfer syntax chosen by the server.
+func (c *Client) Retrieve(ctx context.Context, studyUID, seriesUID, instanceUID string) ([]*dicom.DicomFile, error) {
+
+	rsp, err := c.do(ctx, "GET", resourcePath(studyUID, seriesUID, instanceUID),
+		`multipart/related; type="application/dicom"; transfer-syntax=*`, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	p, err := dicom.NewParser()
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*dicom.DicomFile
+	err = readParts(rsp, func(header textproto.MIMEHeader, data []byte) error {
+		file, err := p.Parse(data)
+		if err != nil {
+			return err
+		}
+		files = append(files, file)
+		return nil
+	})
+
+	return files, err
+}
+
+// Retrieve the metadata of the instances of a study, a series or a single
+// instance with WADO-RS, as Retrieve. Bulk data is left out, the warnings
+// of the data sets naming its URIs.
+func (c *Client) RetrieveMetadata(ctx context.Context, studyUID, seriesUID, instanceUID string) ([]*dicom.DicomFile, error) {
+
+	rsp, err := c.do(ctx, "GET", resourcePath(studyUID, seriesUID, instanceUID)+"/metadata", "application/dicom+json", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	return decodeDataSets(rsp.Body)
+}
+
+// Retrieve frames of an instance, numbered from 1, with WADO-RS. The
+// frames are described by the metadata of the instance, retrieved first,
+// and are native unless the server returns them in an encapsulated
+// transfer syntax.
+func (c *Client) RetrieveFrames(ctx context.Context, studyUID, seriesUID, instanceUID string, frames ...int) (*dicom.ImageData, error) {
+
+	metadata, err := c.RetrieveMetadata(ctx, studyUID, seriesUID, instanceUID)
+	if err != nil {
+		return nil, err
+	}
+	if len(metadata) != 1 {
+		return nil, fmt.Errorf("%w: metadata of %d instances", ErrInvalidResponse, len(metadata))
+	}
+	info, err := metadata[0].FrameInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	numbers := make([]string, len(frames))
+	for i, n := range frames {
+		numbers[i] = strconv.Itoa(n)
+	}
+	path := resourcePath(studyUID, seriesUID, instanceUID) + "/frames/" + strings.Join(numbers, ",")
+	rsp, err := c.do(ctx, "GET", path, `multipart/related; type="application/octet-stream"; transfer-syntax=*`, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	data := &dicom.ImageData{TransferSyntaxUID: dicom.ExplicitVRLittleEndian, Info: info}
+	err = readParts(rsp, func(header textproto.MIMEHeader, frame []byte) error {
+		if _, params, err := mime.ParseMediaType(header.Get("Content-Type")); err == nil && params["transfer-syntax"] != "" {
+			data.TransferSyntaxUID = params["transfer-syntax"]
+		}
+		data.Frames = append(data.Frames, frame)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch data.TransferSyntaxUID {
+	case dicom.ImplicitVRLittleEndian, dicom.ExplicitVRLittleEndian, dicom.ExplicitVRBigEndian, dicom.DeflatedExplicitVRLittleEndian:
+	default:
+		data.Encapsulated = true
+	}
+
+	return data, nil
+}
+
+// Store files with STOW-RS (PS 3.18 10.5), and return the data set of the
+// response, which references the instances stored in its
+// ReferencedSOPSequence and those which failed in its FailedSOPSequence
+func (c *Client) Store(ctx context.Context, files ...*dicom.DicomFile) (*dicom.DicomFile, error) {
+
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+	for _, file := range files {
+		part, err := w.CreatePart(textproto.MIMEHeader{"Content-Type": {"application/dicom"}})
+		if err != nil {
+			return nil, err
+		}
+		if err := file.Write(part); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	contentType := fmt.Sprintf(`multipart/related; type="application/dicom"; boundary=%s`, w.Boundary())
+	rsp, err := c.do(ctx, "POST", "/studies", "application/dicom+json", contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	result := &dicom.DicomFile{}
+	b, err := ioutil.ReadAll(rsp.Body)
+	if err != nil || len(bytes.TrimSpace(b)) == 0 {
+		return result, err
+	}
+	return result, json.Unmarshal(b, result)
+}
+
+// Send a request, failing unless the response is successful. STOW-RS
+// responses with some failures (202 Accepted) are successful.
+func (c *Client) do(ctx context.Context, method, path, accept, contentType string, body io.Reader) (*http.Response, error) {
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	rsp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		rsp.Body.Close()
+		return nil, fmt.Errorf("%w: %s %s: %s", ErrRequestFailed, method, path, rsp.Status)
+	}
+
+	return rsp, nil
+}
+
+// The path of a study, series or instance, each UID being empty to stop at
+// the level above
+func resourcePath(studyUID, seriesUID, instanceUID string) string {
+	path := ""
+	for _, level := range []struct{ name, uid string }{
+		{"studies", studyUID},
+		{"series", seriesUID},
+		{"instances", instanceUID},
+	} {
+		if level.uid == "" {
+			break
+		}
+		path += "/" + level.name + "/" + url.PathEscape(level.uid)
+	}
+	return path
+}
+
+// Decode an array of data sets in the DICOM JSON Model
+func decodeDataSets(r io.Reader) ([]*dicom.DicomFile, error) {
+	var files []*dicom.DicomFile
+	if err := json.NewDecoder(r).Decode(&files); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	return files, nil
+}
+
+// Call fn with the header and body of every part of a multipart response
+func readParts(rsp *http.Response, fn func(header textproto.MIMEHeader, data []byte) error) error {
+
+	mediaType, params, err := mime.ParseMediaType(rsp.Header.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+		return fmt.Errorf("%w: content type %q", ErrInvalidResponse, rsp.Header.Get("Content-Type"))
+	}
+
+	r := multipart.NewReader(rsp.Body, params["boundary"])
+	for {
+		part, err := r.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+		}
+		data, err := ioutil.ReadAll(part)
+		if err != nil {
+			return err
+		}
+		if err := fn(part.Header, data); err != nil {
+			return err
+		}
+	}
+}
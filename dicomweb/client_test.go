@@ -0,0 +1,153 @@
+package dicomweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gillesdemey/go-dicom"
+	"github.com/gillesdemey/go-dicom/dicomtest"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"net/url"
+	"testing"
+)
+
+func lookupString(file *dicom.DicomFile, tag dicom.Tag) string {
+	elem, err := file.LookupElementByTag(tag)
+	if err != nil {
+		return ""
+	}
+	s, _ := elem.GetString()
+	return s
+}
+
+// Write parts of a content type as a multipart/related response
+func writeParts(w http.ResponseWriter, contentType string, parts ...[]byte) {
+	mw := multipart.NewWriter(w)
+	w.Header().Set("Content-Type", fmt.Sprintf(`multipart/related; type="application/dicom"; boundary=%s`, mw.Boundary()))
+	for _, data := range parts {
+		part, _ := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {contentType}})
+		part.Write(data)
+	}
+	mw.Close()
+}
+
+// A DICOMweb server holding a single 2 frame instance
+func fakeServer(t *testing.T, file *dicom.DicomFile) *httptest.Server {
+
+	study := lookupString(file, dicom.TagStudyInstanceUID)
+	series := lookupString(file, dicom.TagSeriesInstanceUID)
+	instance := "/studies/" + study + "/series/" + series + "/instances/" + lookupString(file, dicom.TagSOPInstanceUID)
+
+	encoded := new(bytes.Buffer)
+	if err := file.Write(encoded); err != nil {
+		t.Fatal(err)
+	}
+	image, err := file.ImageData()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/studies", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST":
+			_, params, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			part, err := multipart.NewReader(r.Body, params["boundary"]).NextPart()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			b, _ := ioutil.ReadAll(part)
+			p, _ := dicom.NewParser()
+			stored, err := p.Parse(b)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/dicom+json")
+			fmt.Fprintf(w, `{"00081199": {"vr": "SQ", "Value": [{"00081155": {"vr": "UI", "Value": [%q]}}]}}`,
+				lookupString(stored, dicom.TagSOPInstanceUID))
+		case r.URL.Query().Get("PatientID") == "TEST":
+			w.Header().Set("Content-Type", "application/dicom+json")
+			json.NewEncoder(w).Encode([]*dicom.DicomFile{file})
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	mux.HandleFunc(instance, func(w http.ResponseWriter, r *http.Request) {
+		writeParts(w, "application/dicom", encoded.Bytes())
+	})
+	mux.HandleFunc(instance+"/metadata", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/dicom+json")
+		json.NewEncoder(w).Encode([]*dicom.DicomFile{file})
+	})
+	mux.HandleFunc(instance+"/frames/2,1", func(w http.ResponseWriter, r *http.Request) {
+		writeParts(w, "application/octet-stream; transfer-syntax=1.2.840.10008.1.2.1", image.Frames[1], image.Frames[0])
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient(t *testing.T) {
+
+	file := dicomtest.New(dicomtest.Options{Frames: 2})
+	server := fakeServer(t, file)
+	c := NewClient(server.URL+"/", nil)
+	ctx := context.Background()
+
+	study := lookupString(file, dicom.TagStudyInstanceUID)
+	series := lookupString(file, dicom.TagSeriesInstanceUID)
+	instance := lookupString(file, dicom.TagSOPInstanceUID)
+
+	studies, err := c.SearchStudies(ctx, url.Values{"PatientID": {"TEST"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(studies) != 1 || lookupString(studies[0], dicom.TagStudyInstanceUID) != study {
+		t.Errorf("Incorrect studies %v", studies)
+	}
+	if studies, err := c.SearchStudies(ctx, url.Values{"PatientID": {"NONE"}}); err != nil || len(studies) != 0 {
+		t.Errorf("Expected no studies, got %v %v", studies, err)
+	}
+
+	files, err := c.Retrieve(ctx, study, series, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || !dicom.Equal(files[0], file, dicom.EqualOptions{IgnoreTags: []dicom.Tag{dicom.TagFileMetaInformationGroupLength}}) {
+		t.Errorf("Incorrect instances retrieved")
+	}
+
+	data, err := c.RetrieveFrames(ctx, study, series, instance, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.Encapsulated || len(data.Frames) != 2 || data.Info.Rows != 4 || data.Info.BitsAllocated != 16 {
+		t.Errorf("Incorrect image data %+v", data)
+	}
+	expected, _ := file.ImageData()
+	if !bytes.Equal(data.Frames[0], expected.Frames[1]) || !bytes.Equal(data.Frames[1], expected.Frames[0]) {
+		t.Errorf("Incorrect frames")
+	}
+
+	result, err := c.Store(ctx, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uid, _ := result.GetByPath("ReferencedSOPSequence[0].ReferencedSOPInstanceUID"); len(uid) != 1 || uid[0].MustGetString() != instance {
+		t.Errorf("Incorrect store response %v", result.Elements)
+	}
+
+	if _, err := c.Retrieve(ctx, study, series, "1.2.3"); !errors.Is(err, ErrRequestFailed) {
+		t.Errorf("Expected ErrRequestFailed, got %v", err)
+	}
+}
@@ -3343,6 +3343,18 @@ const dicomDictData = `#
 #
 (0008,0015)	DT	InstanceCoercionDateTime	1	CP_1216
 #
+(7FE0,0008)	OF	FloatPixelData	1	SUP_172
+(7FE0,0009)	OD	DoubleFloatPixelData	1	SUP_172
+#
+(0066,0129)	OL	TrackPointIndexList	1	SUP_181
+#
+(7FE0,0001)	OV	ExtendedOffsetTable	1	CP_1818
+(7FE0,0002)	OV	ExtendedOffsetTableLengths	1	CP_1818
+#
+(0020,9170)	SQ	UnassignedSharedConvertedAttributesSequence	1	SUP_169
+(0020,9171)	SQ	UnassignedPerFrameConvertedAttributesSequence	1	SUP_169
+(0020,9172)	SQ	ConversionSourceAttributesSequence	1	SUP_169
+#
 #---------------------------------------------------------------------------
 #
 # Private Creator Data Elements
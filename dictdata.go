@@ -0,0 +1,3745 @@
+// Code generated by gendict.go; DO NOT EDIT.
+
+package dicom
+
+// Entries of the default dictionary, sorted by tag
+var stdDictionary = []dictEntry{
+	{Tag{0x0000, 0x0000}, "UL", "CommandGroupLength", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x0001}, "UL", "ACR_NEMA_CommandGroupLengthToEnd", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x0001}, "UL", "RETIRED_CommandLengthToEnd", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x0002}, "UI", "AffectedSOPClassUID", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x0003}, "UI", "RequestedSOPClassUID", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x0010}, "CS", "ACR_NEMA_CommandRecognitionCode", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x0010}, "SH", "RETIRED_CommandRecognitionCode", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x0100}, "US", "CommandField", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x0110}, "US", "MessageID", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x0120}, "US", "MessageIDBeingRespondedTo", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x0200}, "LO", "ACR_NEMA_Initiator", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x0200}, "AE", "RETIRED_Initiator", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x0300}, "LO", "ACR_NEMA_Receiver", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x0300}, "AE", "RETIRED_Receiver", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x0400}, "LO", "ACR_NEMA_FindLocation", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x0400}, "AE", "RETIRED_FindLocation", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x0600}, "AE", "MoveDestination", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x0700}, "US", "Priority", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x0800}, "US", "CommandDataSetType", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x0850}, "US", "ACR_NEMA_NumberOfMatches", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x0850}, "US", "RETIRED_NumberOfMatches", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x0860}, "US", "ACR_NEMA_ResponseSequenceNumber", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x0860}, "US", "RETIRED_ResponseSequenceNumber", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x0900}, "US", "Status", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x0901}, "AT", "OffendingElement", "1-n", "DICOM_2011"},
+	{Tag{0x0000, 0x0902}, "LO", "ErrorComment", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x0903}, "US", "ErrorID", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x1000}, "UI", "AffectedSOPInstanceUID", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x1001}, "UI", "RequestedSOPInstanceUID", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x1002}, "US", "EventTypeID", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x1005}, "AT", "AttributeIdentifierList", "1-n", "DICOM_2011"},
+	{Tag{0x0000, 0x1008}, "US", "ActionTypeID", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x1020}, "US", "NumberOfRemainingSuboperations", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x1021}, "US", "NumberOfCompletedSuboperations", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x1022}, "US", "NumberOfFailedSuboperations", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x1023}, "US", "NumberOfWarningSuboperations", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x1030}, "AE", "MoveOriginatorApplicationEntityTitle", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x1031}, "US", "MoveOriginatorMessageID", "1", "DICOM_2011"},
+	{Tag{0x0000, 0x4000}, "LO", "ACR_NEMA_DialogReceiver", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x4000}, "AT", "RETIRED_DialogReceiver", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x4010}, "LO", "ACR_NEMA_TerminalType", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x4010}, "AT", "RETIRED_TerminalType", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x5010}, "LO", "ACR_NEMA_MessageSetID", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x5010}, "SH", "RETIRED_MessageSetID", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x5020}, "LO", "ACR_NEMA_EndMessageSet", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x5020}, "SH", "RETIRED_EndMessageID", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x5110}, "LO", "ACR_NEMA_DisplayFormat", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x5110}, "AT", "RETIRED_DisplayFormat", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x5120}, "LO", "ACR_NEMA_PagePositionID", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x5120}, "AT", "RETIRED_PagePositionID", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x5130}, "LO", "ACR_NEMA_TextFormatID", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x5130}, "CS", "RETIRED_TextFormatID", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x5140}, "CS", "ACR_NEMA_NormalReverse", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x5140}, "CS", "RETIRED_NormalReverse", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x5150}, "CS", "ACR_NEMA_AddGrayScale", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x5150}, "CS", "RETIRED_AddGrayScale", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x5160}, "CS", "ACR_NEMA_Borders", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x5160}, "CS", "RETIRED_Borders", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x5170}, "IS", "ACR_NEMA_Copies", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x5170}, "IS", "RETIRED_Copies", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x5180}, "LO", "ACR_NEMA_MagnificationType", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x5180}, "CS", "RETIRED_CommandMagnificationType", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x5190}, "LO", "ACR_NEMA_Erase", "1-n", "ACR/NEMA2"},
+	{Tag{0x0000, 0x5190}, "CS", "RETIRED_Erase", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x51A0}, "CS", "ACR_NEMA_Print", "1", "ACR/NEMA2"},
+	{Tag{0x0000, 0x51A0}, "CS", "RETIRED_Print", "1", "DICOM/retired"},
+	{Tag{0x0000, 0x51B0}, "US", "ACR_NEMA_Overlays", "1-n", "ACR/NEMA2"},
+	{Tag{0x0000, 0x51B0}, "US", "RETIRED_Overlays", "1-n", "DICOM/retired"},
+	{Tag{0x0002, 0x0000}, "UL", "FileMetaInformationGroupLength", "1", "DICOM_2011"},
+	{Tag{0x0002, 0x0001}, "OB", "FileMetaInformationVersion", "1", "DICOM_2011"},
+	{Tag{0x0002, 0x0002}, "UI", "MediaStorageSOPClassUID", "1", "DICOM_2011"},
+	{Tag{0x0002, 0x0003}, "UI", "MediaStorageSOPInstanceUID", "1", "DICOM_2011"},
+	{Tag{0x0002, 0x0010}, "UI", "TransferSyntaxUID", "1", "DICOM_2011"},
+	{Tag{0x0002, 0x0012}, "UI", "ImplementationClassUID", "1", "DICOM_2011"},
+	{Tag{0x0002, 0x0013}, "SH", "ImplementationVersionName", "1", "DICOM_2011"},
+	{Tag{0x0002, 0x0016}, "AE", "SourceApplicationEntityTitle", "1", "DICOM_2011"},
+	{Tag{0x0002, 0x0100}, "UI", "PrivateInformationCreatorUID", "1", "DICOM_2011"},
+	{Tag{0x0002, 0x0102}, "OB", "PrivateInformation", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x1130}, "CS", "FileSetID", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x1141}, "CS", "FileSetDescriptorFileID", "1-8", "DICOM_2011"},
+	{Tag{0x0004, 0x1142}, "CS", "SpecificCharacterSetOfFileSetDescriptorFile", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x1200}, "UP", "OffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x1202}, "UP", "OffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x1212}, "US", "FileSetConsistencyFlag", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x1220}, "SQ", "DirectoryRecordSequence", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x1400}, "UP", "OffsetOfTheNextDirectoryRecord", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x1410}, "US", "RecordInUseFlag", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x1420}, "UP", "OffsetOfReferencedLowerLevelDirectoryEntity", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x1430}, "CS", "DirectoryRecordType", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x1432}, "UI", "PrivateRecordUID", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x1500}, "CS", "ReferencedFileID", "1-8", "DICOM_2011"},
+	{Tag{0x0004, 0x1504}, "UP", "RETIRED_MRDRDirectoryRecordOffset", "1", "DICOM/retired"},
+	{Tag{0x0004, 0x1510}, "UI", "ReferencedSOPClassUIDInFile", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x1511}, "UI", "ReferencedSOPInstanceUIDInFile", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x1512}, "UI", "ReferencedTransferSyntaxUIDInFile", "1", "DICOM_2011"},
+	{Tag{0x0004, 0x151A}, "UI", "ReferencedRelatedGeneralSOPClassUIDInFile", "1-n", "DICOM_2011"},
+	{Tag{0x0004, 0x1600}, "UL", "RETIRED_NumberOfReferences", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x0001}, "UL", "ACR_NEMA_IdentifyingGroupLengthToEnd", "1", "ACR/NEMA2"},
+	{Tag{0x0008, 0x0001}, "UL", "RETIRED_LengthToEnd", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x0005}, "CS", "SpecificCharacterSet", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x0006}, "SQ", "LanguageCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0008}, "CS", "ImageType", "2-n", "DICOM_2011"},
+	{Tag{0x0008, 0x0010}, "LO", "ACR_NEMA_RecognitionCode", "1", "ACR/NEMA2"},
+	{Tag{0x0008, 0x0010}, "SH", "RETIRED_RecognitionCode", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x0012}, "DA", "InstanceCreationDate", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0013}, "TM", "InstanceCreationTime", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0014}, "UI", "InstanceCreatorUID", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0015}, "DT", "InstanceCoercionDateTime", "1", "CP_1216"},
+	{Tag{0x0008, 0x0016}, "UI", "SOPClassUID", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0018}, "UI", "SOPInstanceUID", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x001A}, "UI", "RelatedGeneralSOPClassUID", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x001B}, "UI", "OriginalSpecializedSOPClassUID", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0020}, "DA", "StudyDate", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0021}, "DA", "SeriesDate", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0022}, "DA", "AcquisitionDate", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0023}, "DA", "ContentDate", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0024}, "DA", "RETIRED_OverlayDate", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x0025}, "DA", "RETIRED_CurveDate", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x002A}, "DT", "AcquisitionDateTime", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0030}, "TM", "StudyTime", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0031}, "TM", "SeriesTime", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0032}, "TM", "AcquisitionTime", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0033}, "TM", "ContentTime", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0034}, "TM", "RETIRED_OverlayTime", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x0035}, "TM", "RETIRED_CurveTime", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x0040}, "US", "ACR_NEMA_OldDataSetType", "1", "ACR/NEMA2"},
+	{Tag{0x0008, 0x0040}, "US", "RETIRED_DataSetType", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x0041}, "LO", "ACR_NEMA_DataSetSubtype", "1", "ACR/NEMA2"},
+	{Tag{0x0008, 0x0041}, "LO", "RETIRED_DataSetSubtype", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x0042}, "CS", "RETIRED_NuclearMedicineSeriesType", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x0050}, "SH", "AccessionNumber", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0051}, "SQ", "IssuerOfAccessionNumberSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0052}, "CS", "QueryRetrieveLevel", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0054}, "AE", "RetrieveAETitle", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x0056}, "CS", "InstanceAvailability", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0058}, "UI", "FailedSOPInstanceUIDList", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x0060}, "CS", "Modality", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0061}, "CS", "ModalitiesInStudy", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x0062}, "UI", "SOPClassesInStudy", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x0064}, "CS", "ConversionType", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0068}, "CS", "PresentationIntentType", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0070}, "LO", "Manufacturer", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0080}, "LO", "InstitutionName", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0081}, "ST", "InstitutionAddress", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0082}, "SQ", "InstitutionCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0090}, "PN", "ReferringPhysicianName", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0092}, "ST", "ReferringPhysicianAddress", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0094}, "SH", "ReferringPhysicianTelephoneNumbers", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x0096}, "SQ", "ReferringPhysicianIdentificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0100}, "SH", "CodeValue", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0102}, "SH", "CodingSchemeDesignator", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0103}, "SH", "CodingSchemeVersion", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0104}, "LO", "CodeMeaning", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0105}, "CS", "MappingResource", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0106}, "DT", "ContextGroupVersion", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0107}, "DT", "ContextGroupLocalVersion", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x010B}, "CS", "ContextGroupExtensionFlag", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x010C}, "UI", "CodingSchemeUID", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x010D}, "UI", "ContextGroupExtensionCreatorUID", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x010F}, "CS", "ContextIdentifier", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0110}, "SQ", "CodingSchemeIdentificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0112}, "LO", "CodingSchemeRegistry", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0114}, "ST", "CodingSchemeExternalID", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0115}, "ST", "CodingSchemeName", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0116}, "ST", "CodingSchemeResponsibleOrganization", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0117}, "UI", "ContextUID", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x0201}, "SH", "TimezoneOffsetFromUTC", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1000}, "LO", "ACR_NEMA_NetworkID", "1", "ACR/NEMA2"},
+	{Tag{0x0008, 0x1000}, "AE", "RETIRED_NetworkID", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x1010}, "SH", "StationName", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1030}, "LO", "StudyDescription", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1032}, "SQ", "ProcedureCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x103E}, "LO", "SeriesDescription", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x103F}, "SQ", "SeriesDescriptionCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1040}, "LO", "InstitutionalDepartmentName", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1048}, "PN", "PhysiciansOfRecord", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x1049}, "SQ", "PhysiciansOfRecordIdentificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1050}, "PN", "PerformingPhysicianName", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x1052}, "SQ", "PerformingPhysicianIdentificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1060}, "PN", "NameOfPhysiciansReadingStudy", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x1062}, "SQ", "PhysiciansReadingStudyIdentificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1070}, "PN", "OperatorsName", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x1072}, "SQ", "OperatorIdentificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1080}, "LO", "AdmittingDiagnosesDescription", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x1084}, "SQ", "AdmittingDiagnosesCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1090}, "LO", "ManufacturerModelName", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1100}, "SQ", "RETIRED_ReferencedResultsSequence", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x1110}, "SQ", "ReferencedStudySequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1111}, "SQ", "ReferencedPerformedProcedureStepSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1115}, "SQ", "ReferencedSeriesSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1120}, "SQ", "ReferencedPatientSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1125}, "SQ", "ReferencedVisitSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1130}, "SQ", "RETIRED_ReferencedOverlaySequence", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x1134}, "SQ", "ReferencedStereometricInstanceSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x113A}, "SQ", "ReferencedWaveformSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1140}, "SQ", "ReferencedImageSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1145}, "SQ", "RETIRED_ReferencedCurveSequence", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x114A}, "SQ", "ReferencedInstanceSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x114B}, "SQ", "ReferencedRealWorldValueMappingInstanceSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1150}, "UI", "ReferencedSOPClassUID", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1155}, "UI", "ReferencedSOPInstanceUID", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x115A}, "UI", "SOPClassesSupported", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x1160}, "IS", "ReferencedFrameNumber", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x1161}, "UL", "SimpleFrameList", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x1162}, "UL", "CalculatedFrameList", "3-3n", "DICOM_2011"},
+	{Tag{0x0008, 0x1163}, "FD", "TimeRange", "2", "DICOM_2011"},
+	{Tag{0x0008, 0x1164}, "SQ", "FrameExtractionSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1167}, "UI", "MultiFrameSourceSOPInstanceUID", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1195}, "UI", "TransactionUID", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1197}, "US", "FailureReason", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1198}, "SQ", "FailedSOPSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1199}, "SQ", "ReferencedSOPSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1200}, "SQ", "StudiesContainingOtherReferencedInstancesSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x1250}, "SQ", "RelatedSeriesSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2110}, "CS", "RETIRED_LossyImageCompressionRetired", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x2111}, "ST", "DerivationDescription", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2112}, "SQ", "SourceImageSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2120}, "SH", "StageName", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2122}, "IS", "StageNumber", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2124}, "IS", "NumberOfStages", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2127}, "SH", "ViewName", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2128}, "IS", "ViewNumber", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2129}, "IS", "NumberOfEventTimers", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x212A}, "IS", "NumberOfViewsInStage", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2130}, "DS", "EventElapsedTimes", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x2132}, "LO", "EventTimerNames", "1-n", "DICOM_2011"},
+	{Tag{0x0008, 0x2133}, "SQ", "EventTimerSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2134}, "FD", "EventTimeOffset", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2135}, "SQ", "EventCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2142}, "IS", "StartTrim", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2143}, "IS", "StopTrim", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2144}, "IS", "RecommendedDisplayFrameRate", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2200}, "CS", "RETIRED_TransducerPosition", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x2204}, "CS", "RETIRED_TransducerOrientation", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x2208}, "CS", "RETIRED_AnatomicStructure", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x2218}, "SQ", "AnatomicRegionSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2220}, "SQ", "AnatomicRegionModifierSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2228}, "SQ", "PrimaryAnatomicStructureSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2229}, "SQ", "AnatomicStructureSpaceOrRegionSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2230}, "SQ", "PrimaryAnatomicStructureModifierSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x2240}, "SQ", "RETIRED_TransducerPositionSequence", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x2242}, "SQ", "RETIRED_TransducerPositionModifierSequence", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x2244}, "SQ", "RETIRED_TransducerOrientationSequence", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x2246}, "SQ", "RETIRED_TransducerOrientationModifierSequence", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x2251}, "SQ", "RETIRED_AnatomicStructureSpaceOrRegionCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x2253}, "SQ", "RETIRED_AnatomicPortalOfEntranceCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x2255}, "SQ", "RETIRED_AnatomicApproachDirectionCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x2256}, "ST", "RETIRED_AnatomicPerspectiveDescriptionTrial", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x2257}, "SQ", "RETIRED_AnatomicPerspectiveCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x2258}, "ST", "RETIRED_AnatomicLocationOfExaminingInstrumentDescriptionTrial", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x2259}, "SQ", "RETIRED_AnatomicLocationOfExaminingInstrumentCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x225A}, "SQ", "RETIRED_AnatomicStructureSpaceOrRegionModifierCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x225C}, "SQ", "RETIRED_OnAxisBackgroundAnatomicStructureCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x3001}, "SQ", "AlternateRepresentationSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x3010}, "UI", "IrradiationEventUID", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x4000}, "LT", "ACR_NEMA_IdentifyingComments", "1-n", "ACR/NEMA2"},
+	{Tag{0x0008, 0x4000}, "LT", "RETIRED_IdentifyingComments", "1", "DICOM/retired"},
+	{Tag{0x0008, 0x9007}, "CS", "FrameType", "4", "DICOM_2011"},
+	{Tag{0x0008, 0x9092}, "SQ", "ReferencedImageEvidenceSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9121}, "SQ", "ReferencedRawDataSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9123}, "UI", "CreatorVersionUID", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9124}, "SQ", "DerivationImageSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9154}, "SQ", "SourceImageEvidenceSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9205}, "CS", "PixelPresentation", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9206}, "CS", "VolumetricProperties", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9207}, "CS", "VolumeBasedCalculationTechnique", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9208}, "CS", "ComplexImageComponent", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9209}, "CS", "AcquisitionContrast", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9215}, "SQ", "DerivationCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9237}, "SQ", "ReferencedPresentationStateSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9410}, "SQ", "ReferencedOtherPlaneSequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9458}, "SQ", "FrameDisplaySequence", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9459}, "FL", "RecommendedDisplayFrameRateInFloat", "1", "DICOM_2011"},
+	{Tag{0x0008, 0x9460}, "CS", "SkipFrameRangeFlag", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x0010}, "PN", "PatientName", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x0020}, "LO", "PatientID", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x0021}, "LO", "IssuerOfPatientID", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x0022}, "CS", "TypeOfPatientID", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x0024}, "SQ", "IssuerOfPatientIDQualifiersSequence", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x0030}, "DA", "PatientBirthDate", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x0032}, "TM", "PatientBirthTime", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x0040}, "CS", "PatientSex", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x0050}, "SQ", "PatientInsurancePlanCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x0101}, "SQ", "PatientPrimaryLanguageCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x0102}, "SQ", "PatientPrimaryLanguageModifierCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x0200}, "CS", "QualityControlSubject", "1", "CP_1123"},
+	{Tag{0x0010, 0x1000}, "LO", "OtherPatientIDs", "1-n", "DICOM_2011"},
+	{Tag{0x0010, 0x1001}, "PN", "OtherPatientNames", "1-n", "DICOM_2011"},
+	{Tag{0x0010, 0x1002}, "SQ", "OtherPatientIDsSequence", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x1005}, "PN", "PatientBirthName", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x1010}, "AS", "PatientAge", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x1020}, "DS", "PatientSize", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x1021}, "SQ", "PatientSizeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x1030}, "DS", "PatientWeight", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x1040}, "LO", "PatientAddress", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x1050}, "LT", "ACR_NEMA_InsurancePlanIdentification", "1-n", "ACR/NEMA2"},
+	{Tag{0x0010, 0x1050}, "LO", "RETIRED_InsurancePlanIdentification", "1-n", "DICOM/retired"},
+	{Tag{0x0010, 0x1060}, "PN", "PatientMotherBirthName", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x1080}, "LO", "MilitaryRank", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x1081}, "LO", "BranchOfService", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x1090}, "LO", "MedicalRecordLocator", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2000}, "LO", "MedicalAlerts", "1-n", "DICOM_2011"},
+	{Tag{0x0010, 0x2110}, "LO", "Allergies", "1-n", "DICOM_2011"},
+	{Tag{0x0010, 0x2150}, "LO", "CountryOfResidence", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2152}, "LO", "RegionOfResidence", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2154}, "SH", "PatientTelephoneNumbers", "1-n", "DICOM_2011"},
+	{Tag{0x0010, 0x2160}, "SH", "EthnicGroup", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2180}, "SH", "Occupation", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x21A0}, "CS", "SmokingStatus", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x21B0}, "LT", "AdditionalPatientHistory", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x21C0}, "US", "PregnancyStatus", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x21D0}, "DA", "LastMenstrualDate", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x21F0}, "LO", "PatientReligiousPreference", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2201}, "LO", "PatientSpeciesDescription", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2202}, "SQ", "PatientSpeciesCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2203}, "CS", "PatientSexNeutered", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2210}, "CS", "AnatomicalOrientationType", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2292}, "LO", "PatientBreedDescription", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2293}, "SQ", "PatientBreedCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2294}, "SQ", "BreedRegistrationSequence", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2295}, "LO", "BreedRegistrationNumber", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2296}, "SQ", "BreedRegistryCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2297}, "PN", "ResponsiblePerson", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2298}, "CS", "ResponsiblePersonRole", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x2299}, "LO", "ResponsibleOrganization", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x4000}, "LT", "PatientComments", "1", "DICOM_2011"},
+	{Tag{0x0010, 0x9431}, "FL", "ExaminedBodyThickness", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0010}, "LO", "ClinicalTrialSponsorName", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0020}, "LO", "ClinicalTrialProtocolID", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0021}, "LO", "ClinicalTrialProtocolName", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0030}, "LO", "ClinicalTrialSiteID", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0031}, "LO", "ClinicalTrialSiteName", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0040}, "LO", "ClinicalTrialSubjectID", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0042}, "LO", "ClinicalTrialSubjectReadingID", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0050}, "LO", "ClinicalTrialTimePointID", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0051}, "ST", "ClinicalTrialTimePointDescription", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0060}, "LO", "ClinicalTrialCoordinatingCenterName", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0062}, "CS", "PatientIdentityRemoved", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0063}, "LO", "DeidentificationMethod", "1-n", "DICOM_2011"},
+	{Tag{0x0012, 0x0064}, "SQ", "DeidentificationMethodCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0071}, "LO", "ClinicalTrialSeriesID", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0072}, "LO", "ClinicalTrialSeriesDescription", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0081}, "LO", "ClinicalTrialProtocolEthicsCommitteeName", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0082}, "LO", "ClinicalTrialProtocolEthicsCommitteeApprovalNumber", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0083}, "SQ", "ConsentForClinicalTrialUseSequence", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0084}, "CS", "DistributionType", "1", "DICOM_2011"},
+	{Tag{0x0012, 0x0085}, "CS", "ConsentForDistributionFlag", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x0023}, "ST", "CADFileFormat", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x0024}, "ST", "ComponentReferenceSystem", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x0025}, "ST", "ComponentManufacturingProcedure", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x0028}, "ST", "ComponentManufacturer", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x0030}, "DS", "MaterialThickness", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x0032}, "DS", "MaterialPipeDiameter", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x0034}, "DS", "MaterialIsolationDiameter", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x0042}, "ST", "MaterialGrade", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x0044}, "ST", "MaterialPropertiesFileID", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x0045}, "ST", "MaterialPropertiesFileFormat", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x0046}, "LT", "MaterialNotes", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x0050}, "CS", "ComponentShape", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x0052}, "CS", "CurvatureType", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x0054}, "DS", "OuterDiameter", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x0056}, "DS", "InnerDiameter", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x1010}, "ST", "ActualEnvironmentalConditions", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x1020}, "DA", "ExpiryDate", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x1040}, "ST", "EnvironmentalConditions", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2002}, "SQ", "EvaluatorSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2004}, "IS", "EvaluatorNumber", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2006}, "PN", "EvaluatorName", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2008}, "IS", "EvaluationAttempt", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2012}, "SQ", "IndicationSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2014}, "IS", "IndicationNumber", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2016}, "SH", "IndicationLabel", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2018}, "ST", "IndicationDescription", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x201A}, "CS", "IndicationType", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x201C}, "CS", "IndicationDisposition", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x201E}, "SQ", "IndicationROISequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2030}, "SQ", "IndicationPhysicalPropertySequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2032}, "SH", "PropertyLabel", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2202}, "IS", "CoordinateSystemNumberOfAxes", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2204}, "SQ", "CoordinateSystemAxesSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2206}, "ST", "CoordinateSystemAxisDescription", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2208}, "CS", "CoordinateSystemDataSetMapping", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x220A}, "IS", "CoordinateSystemAxisNumber", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x220C}, "CS", "CoordinateSystemAxisType", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x220E}, "CS", "CoordinateSystemAxisUnits", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2210}, "OB", "CoordinateSystemAxisValues", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2220}, "SQ", "CoordinateSystemTransformSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2222}, "ST", "TransformDescription", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2224}, "IS", "TransformNumberOfAxes", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x2226}, "IS", "TransformOrderOfAxes", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x2228}, "CS", "TransformedAxisUnits", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x222A}, "DS", "CoordinateSystemTransformRotationAndScaleMatrix", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x222C}, "DS", "CoordinateSystemTransformTranslationMatrix", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x3011}, "DS", "InternalDetectorFrameTime", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3012}, "DS", "NumberOfFramesIntegrated", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3020}, "SQ", "DetectorTemperatureSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3022}, "ST", "SensorName", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3024}, "DS", "HorizontalOffsetOfSensor", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3026}, "DS", "VerticalOffsetOfSensor", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3028}, "DS", "SensorTemperature", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3040}, "SQ", "DarkCurrentSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3050}, "OX", "DarkCurrentCounts", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3060}, "SQ", "GainCorrectionReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3070}, "OX", "AirCounts", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3071}, "DS", "KVUsedInGainCalibration", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3072}, "DS", "MAUsedInGainCalibration", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3073}, "DS", "NumberOfFramesUsedForIntegration", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3074}, "LO", "FilterMaterialUsedInGainCalibration", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3075}, "DS", "FilterThicknessUsedInGainCalibration", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3076}, "DA", "DateOfGainCalibration", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3077}, "TM", "TimeOfGainCalibration", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3080}, "OB", "BadPixelImage", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x3099}, "LT", "CalibrationNotes", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4002}, "SQ", "PulserEquipmentSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4004}, "CS", "PulserType", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4006}, "LT", "PulserNotes", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4008}, "SQ", "ReceiverEquipmentSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x400A}, "CS", "AmplifierType", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x400C}, "LT", "ReceiverNotes", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x400E}, "SQ", "PreAmplifierEquipmentSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x400F}, "LT", "PreAmplifierNotes", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4010}, "SQ", "TransmitTransducerSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4011}, "SQ", "ReceiveTransducerSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4012}, "US", "NumberOfElements", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4013}, "CS", "ElementShape", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4014}, "DS", "ElementDimensionA", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4015}, "DS", "ElementDimensionB", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4016}, "DS", "ElementPitch", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4017}, "DS", "MeasuredBeamDimensionA", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4018}, "DS", "MeasuredBeamDimensionB", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4019}, "DS", "LocationOfMeasuredBeamDiameter", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x401A}, "DS", "NominalFrequency", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x401B}, "DS", "MeasuredCenterFrequency", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x401C}, "DS", "MeasuredBandwidth", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4020}, "SQ", "PulserSettingsSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4022}, "DS", "PulseWidth", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4024}, "DS", "ExcitationFrequency", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4026}, "CS", "ModulationType", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4028}, "DS", "Damping", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4030}, "SQ", "ReceiverSettingsSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4031}, "DS", "AcquiredSoundpathLength", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4032}, "CS", "AcquisitionCompressionType", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4033}, "IS", "AcquisitionSampleSize", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4034}, "DS", "RectifierSmoothing", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4035}, "SQ", "DACSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4036}, "CS", "DACType", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4038}, "DS", "DACGainPoints", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x403A}, "DS", "DACTimePoints", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x403C}, "DS", "DACAmplitude", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x4040}, "SQ", "PreAmplifierSettingsSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4050}, "SQ", "TransmitTransducerSettingsSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4051}, "SQ", "ReceiveTransducerSettingsSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4052}, "DS", "IncidentAngle", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4054}, "ST", "CouplingTechnique", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4056}, "ST", "CouplingMedium", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4057}, "DS", "CouplingVelocity", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4058}, "DS", "CrystalCenterLocationX", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4059}, "DS", "CrystalCenterLocationZ", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x405A}, "DS", "SoundPathLength", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x405C}, "ST", "DelayLawIdentifier", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4060}, "SQ", "GateSettingsSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4062}, "DS", "GateThreshold", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4064}, "DS", "VelocityOfSound", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4070}, "SQ", "CalibrationSettingsSequence", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4072}, "ST", "CalibrationProcedure", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4074}, "SH", "ProcedureVersion", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4076}, "DA", "ProcedureCreationDate", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x4078}, "DA", "ProcedureExpirationDate", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x407A}, "DA", "ProcedureLastModifiedDate", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x407C}, "TM", "CalibrationTime", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x407E}, "DA", "CalibrationDate", "1-n", "DICOM_2011"},
+	{Tag{0x0014, 0x5002}, "IS", "LINACEnergy", "1", "DICOM_2011"},
+	{Tag{0x0014, 0x5004}, "IS", "LINACOutput", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0010}, "LO", "ContrastBolusAgent", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0012}, "SQ", "ContrastBolusAgentSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0014}, "SQ", "ContrastBolusAdministrationRouteSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0015}, "CS", "BodyPartExamined", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0020}, "CS", "ScanningSequence", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x0021}, "CS", "SequenceVariant", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x0022}, "CS", "ScanOptions", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x0023}, "CS", "MRAcquisitionType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0024}, "SH", "SequenceName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0025}, "CS", "AngioFlag", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0026}, "SQ", "InterventionDrugInformationSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0027}, "TM", "InterventionDrugStopTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0028}, "DS", "InterventionDrugDose", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0029}, "SQ", "InterventionDrugCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x002A}, "SQ", "AdditionalDrugSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0030}, "LO", "RETIRED_Radionuclide", "1-n", "DICOM/retired"},
+	{Tag{0x0018, 0x0031}, "LO", "Radiopharmaceutical", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0032}, "DS", "RETIRED_EnergyWindowCenterline", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x0033}, "DS", "RETIRED_EnergyWindowTotalWidth", "1-n", "DICOM/retired"},
+	{Tag{0x0018, 0x0034}, "LO", "InterventionDrugName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0035}, "TM", "InterventionDrugStartTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0036}, "SQ", "InterventionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0037}, "CS", "RETIRED_TherapyType", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x0038}, "CS", "InterventionStatus", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0039}, "CS", "RETIRED_TherapyDescription", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x003A}, "ST", "InterventionDescription", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0040}, "IS", "CineRate", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0042}, "CS", "InitialCineRunState", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0050}, "DS", "SliceThickness", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0060}, "DS", "KVP", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0070}, "IS", "CountsAccumulated", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0071}, "CS", "AcquisitionTerminationCondition", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0072}, "DS", "EffectiveDuration", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0073}, "CS", "AcquisitionStartCondition", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0074}, "IS", "AcquisitionStartConditionData", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0075}, "IS", "AcquisitionTerminationConditionData", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0080}, "DS", "RepetitionTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0081}, "DS", "EchoTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0082}, "DS", "InversionTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0083}, "DS", "NumberOfAverages", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0084}, "DS", "ImagingFrequency", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0085}, "SH", "ImagedNucleus", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0086}, "IS", "EchoNumbers", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x0087}, "DS", "MagneticFieldStrength", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0088}, "DS", "SpacingBetweenSlices", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0089}, "IS", "NumberOfPhaseEncodingSteps", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0090}, "DS", "DataCollectionDiameter", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0091}, "IS", "EchoTrainLength", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0093}, "DS", "PercentSampling", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0094}, "DS", "PercentPhaseFieldOfView", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x0095}, "DS", "PixelBandwidth", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1000}, "LO", "DeviceSerialNumber", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1002}, "UI", "DeviceUID", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1003}, "LO", "DeviceID", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1004}, "LO", "PlateID", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1005}, "LO", "GeneratorID", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1006}, "LO", "GridID", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1007}, "LO", "CassetteID", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1008}, "LO", "GantryID", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1010}, "LO", "SecondaryCaptureDeviceID", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1011}, "LO", "RETIRED_HardcopyCreationDeviceID", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x1012}, "DA", "DateOfSecondaryCapture", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1014}, "TM", "TimeOfSecondaryCapture", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1016}, "LO", "SecondaryCaptureDeviceManufacturer", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1017}, "LO", "RETIRED_HardcopyDeviceManufacturer", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x1018}, "LO", "SecondaryCaptureDeviceManufacturerModelName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1019}, "LO", "SecondaryCaptureDeviceSoftwareVersions", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x101A}, "LO", "RETIRED_HardcopyDeviceSoftwareVersion", "1-n", "DICOM/retired"},
+	{Tag{0x0018, 0x101B}, "LO", "RETIRED_HardcopyDeviceManufacturerModelName", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x1020}, "LO", "SoftwareVersions", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1022}, "SH", "VideoImageFormatAcquired", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1023}, "LO", "DigitalImageFormatAcquired", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1030}, "LO", "ProtocolName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1040}, "LO", "ContrastBolusRoute", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1041}, "DS", "ContrastBolusVolume", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1042}, "TM", "ContrastBolusStartTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1043}, "TM", "ContrastBolusStopTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1044}, "DS", "ContrastBolusTotalDose", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1045}, "IS", "SyringeCounts", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1046}, "DS", "ContrastFlowRate", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1047}, "DS", "ContrastFlowDuration", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1048}, "CS", "ContrastBolusIngredient", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1049}, "DS", "ContrastBolusIngredientConcentration", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1050}, "DS", "SpatialResolution", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1060}, "DS", "TriggerTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1061}, "LO", "TriggerSourceOrType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1062}, "IS", "NominalInterval", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1063}, "DS", "FrameTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1064}, "LO", "CardiacFramingType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1065}, "DS", "FrameTimeVector", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1066}, "DS", "FrameDelay", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1067}, "DS", "ImageTriggerDelay", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1068}, "DS", "MultiplexGroupTimeOffset", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1069}, "DS", "TriggerTimeOffset", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x106A}, "CS", "SynchronizationTrigger", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x106C}, "US", "SynchronizationChannel", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x106E}, "UL", "TriggerSamplePosition", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1070}, "LO", "RadiopharmaceuticalRoute", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1071}, "DS", "RadiopharmaceuticalVolume", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1072}, "TM", "RadiopharmaceuticalStartTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1073}, "TM", "RadiopharmaceuticalStopTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1074}, "DS", "RadionuclideTotalDose", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1075}, "DS", "RadionuclideHalfLife", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1076}, "DS", "RadionuclidePositronFraction", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1077}, "DS", "RadiopharmaceuticalSpecificActivity", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1078}, "DT", "RadiopharmaceuticalStartDateTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1079}, "DT", "RadiopharmaceuticalStopDateTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1080}, "CS", "BeatRejectionFlag", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1081}, "IS", "LowRRValue", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1082}, "IS", "HighRRValue", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1083}, "IS", "IntervalsAcquired", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1084}, "IS", "IntervalsRejected", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1085}, "LO", "PVCRejection", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1086}, "IS", "SkipBeats", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1088}, "IS", "HeartRate", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1090}, "IS", "CardiacNumberOfImages", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1094}, "IS", "TriggerWindow", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1100}, "DS", "ReconstructionDiameter", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1110}, "DS", "DistanceSourceToDetector", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1111}, "DS", "DistanceSourceToPatient", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1114}, "DS", "EstimatedRadiographicMagnificationFactor", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1120}, "DS", "GantryDetectorTilt", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1121}, "DS", "GantryDetectorSlew", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1130}, "DS", "TableHeight", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1131}, "DS", "TableTraverse", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1134}, "CS", "TableMotion", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1135}, "DS", "TableVerticalIncrement", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1136}, "DS", "TableLateralIncrement", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1137}, "DS", "TableLongitudinalIncrement", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1138}, "DS", "TableAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x113A}, "CS", "TableType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1140}, "CS", "RotationDirection", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1141}, "DS", "RETIRED_AngularPosition", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x1142}, "DS", "RadialPosition", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1143}, "DS", "ScanArc", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1144}, "DS", "AngularStep", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1145}, "DS", "CenterOfRotationOffset", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1146}, "DS", "RETIRED_RotationOffset", "1-n", "DICOM/retired"},
+	{Tag{0x0018, 0x1147}, "CS", "FieldOfViewShape", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1149}, "IS", "FieldOfViewDimensions", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x1150}, "IS", "ExposureTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1151}, "IS", "XRayTubeCurrent", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1152}, "IS", "Exposure", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1153}, "IS", "ExposureInuAs", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1154}, "DS", "AveragePulseWidth", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1155}, "CS", "RadiationSetting", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1156}, "CS", "RectificationType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x115A}, "CS", "RadiationMode", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x115E}, "DS", "ImageAndFluoroscopyAreaDoseProduct", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1160}, "SH", "FilterType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1161}, "LO", "TypeOfFilters", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1162}, "DS", "IntensifierSize", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1164}, "DS", "ImagerPixelSpacing", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x1166}, "CS", "Grid", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1170}, "IS", "GeneratorPower", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1180}, "SH", "CollimatorGridName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1181}, "CS", "CollimatorType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1182}, "IS", "FocalDistance", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x1183}, "DS", "XFocusCenter", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x1184}, "DS", "YFocusCenter", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x1190}, "DS", "FocalSpots", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1191}, "CS", "AnodeTargetMaterial", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x11A0}, "DS", "BodyPartThickness", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x11A2}, "DS", "CompressionForce", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1200}, "DA", "DateOfLastCalibration", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1201}, "TM", "TimeOfLastCalibration", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1210}, "SH", "ConvolutionKernel", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1240}, "IS", "ACR_NEMA_UpperLowerPixelValues", "1-n", "ACR/NEMA2"},
+	{Tag{0x0018, 0x1240}, "IS", "RETIRED_UpperLowerPixelValues", "1-n", "DICOM/retired"},
+	{Tag{0x0018, 0x1242}, "IS", "ActualFrameDuration", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1243}, "IS", "CountRate", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1244}, "US", "PreferredPlaybackSequencing", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1250}, "SH", "ReceiveCoilName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1251}, "SH", "TransmitCoilName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1260}, "SH", "PlateType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1261}, "LO", "PhosphorType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1300}, "DS", "ScanVelocity", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1301}, "CS", "WholeBodyTechnique", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1302}, "IS", "ScanLength", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1310}, "US", "AcquisitionMatrix", "4", "DICOM_2011"},
+	{Tag{0x0018, 0x1312}, "CS", "InPlanePhaseEncodingDirection", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1314}, "DS", "FlipAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1315}, "CS", "VariableFlipAngleFlag", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1316}, "DS", "SAR", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1318}, "DS", "dBdt", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1400}, "LO", "AcquisitionDeviceProcessingDescription", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1401}, "LO", "AcquisitionDeviceProcessingCode", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1402}, "CS", "CassetteOrientation", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1403}, "CS", "CassetteSize", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1404}, "US", "ExposuresOnPlate", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1405}, "IS", "RelativeXRayExposure", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1411}, "DS", "ExposureIndex", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1412}, "DS", "TargetExposureIndex", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1413}, "DS", "DeviationIndex", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1450}, "DS", "ColumnAngulation", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1460}, "DS", "TomoLayerHeight", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1470}, "DS", "TomoAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1480}, "DS", "TomoTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1490}, "CS", "TomoType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1491}, "CS", "TomoClass", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1495}, "IS", "NumberOfTomosynthesisSourceImages", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1500}, "CS", "PositionerMotion", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1508}, "CS", "PositionerType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1510}, "DS", "PositionerPrimaryAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1511}, "DS", "PositionerSecondaryAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1520}, "DS", "PositionerPrimaryAngleIncrement", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1521}, "DS", "PositionerSecondaryAngleIncrement", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x1530}, "DS", "DetectorPrimaryAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1531}, "DS", "DetectorSecondaryAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1600}, "CS", "ShutterShape", "1-3", "DICOM_2011"},
+	{Tag{0x0018, 0x1602}, "IS", "ShutterLeftVerticalEdge", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1604}, "IS", "ShutterRightVerticalEdge", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1606}, "IS", "ShutterUpperHorizontalEdge", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1608}, "IS", "ShutterLowerHorizontalEdge", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1610}, "IS", "CenterOfCircularShutter", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x1612}, "IS", "RadiusOfCircularShutter", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1620}, "IS", "VerticesOfThePolygonalShutter", "2-2n", "DICOM_2011"},
+	{Tag{0x0018, 0x1622}, "US", "ShutterPresentationValue", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1623}, "US", "ShutterOverlayGroup", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1624}, "US", "ShutterPresentationColorCIELabValue", "3", "DICOM_2011"},
+	{Tag{0x0018, 0x1700}, "CS", "CollimatorShape", "1-3", "DICOM_2011"},
+	{Tag{0x0018, 0x1702}, "IS", "CollimatorLeftVerticalEdge", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1704}, "IS", "CollimatorRightVerticalEdge", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1706}, "IS", "CollimatorUpperHorizontalEdge", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1708}, "IS", "CollimatorLowerHorizontalEdge", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1710}, "IS", "CenterOfCircularCollimator", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x1712}, "IS", "RadiusOfCircularCollimator", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1720}, "IS", "VerticesOfThePolygonalCollimator", "2-2n", "DICOM_2011"},
+	{Tag{0x0018, 0x1800}, "CS", "AcquisitionTimeSynchronized", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1801}, "SH", "TimeSource", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1802}, "CS", "TimeDistributionProtocol", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x1803}, "LO", "NTPSourceAddress", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x2001}, "IS", "PageNumberVector", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x2002}, "SH", "FrameLabelVector", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x2003}, "DS", "FramePrimaryAngleVector", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x2004}, "DS", "FrameSecondaryAngleVector", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x2005}, "DS", "SliceLocationVector", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x2006}, "SH", "DisplayWindowLabelVector", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x2010}, "DS", "NominalScannedPixelSpacing", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x2020}, "CS", "DigitizingDeviceTransportDirection", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x2030}, "DS", "RotationOfScannedFilm", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x3100}, "CS", "IVUSAcquisition", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x3101}, "DS", "IVUSPullbackRate", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x3102}, "DS", "IVUSGatedRate", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x3103}, "IS", "IVUSPullbackStartFrameNumber", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x3104}, "IS", "IVUSPullbackStopFrameNumber", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x3105}, "IS", "LesionNumber", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x4000}, "LT", "ACR_NEMA_AcquisitionComments", "1-n", "ACR/NEMA2"},
+	{Tag{0x0018, 0x4000}, "LT", "RETIRED_AcquisitionComments", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x5000}, "SH", "OutputPower", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x5010}, "LO", "TransducerData", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x5012}, "DS", "FocusDepth", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x5020}, "LO", "ProcessingFunction", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x5021}, "LO", "RETIRED_PostprocessingFunction", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x5022}, "DS", "MechanicalIndex", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x5024}, "DS", "BoneThermalIndex", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x5026}, "DS", "CranialThermalIndex", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x5027}, "DS", "SoftTissueThermalIndex", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x5028}, "DS", "SoftTissueFocusThermalIndex", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x5029}, "DS", "SoftTissueSurfaceThermalIndex", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x5030}, "DS", "ACR_NEMA_DynamicRange", "1", "ACR/NEMA2"},
+	{Tag{0x0018, 0x5030}, "DS", "RETIRED_DynamicRange", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x5040}, "DS", "ACR_NEMA_TotalGain", "1", "ACR/NEMA2"},
+	{Tag{0x0018, 0x5040}, "DS", "RETIRED_TotalGain", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x5050}, "IS", "DepthOfScanField", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x5100}, "CS", "PatientPosition", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x5101}, "CS", "ViewPosition", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x5104}, "SQ", "ProjectionEponymousNameCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x5210}, "DS", "RETIRED_ImageTransformationMatrix", "6", "DICOM/retired"},
+	{Tag{0x0018, 0x5212}, "DS", "RETIRED_ImageTranslationVector", "3", "DICOM/retired"},
+	{Tag{0x0018, 0x6000}, "DS", "Sensitivity", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6011}, "SQ", "SequenceOfUltrasoundRegions", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6012}, "US", "RegionSpatialFormat", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6014}, "US", "RegionDataType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6016}, "UL", "RegionFlags", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6018}, "UL", "RegionLocationMinX0", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x601A}, "UL", "RegionLocationMinY0", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x601C}, "UL", "RegionLocationMaxX1", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x601E}, "UL", "RegionLocationMaxY1", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6020}, "SL", "ReferencePixelX0", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6022}, "SL", "ReferencePixelY0", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6024}, "US", "PhysicalUnitsXDirection", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6026}, "US", "PhysicalUnitsYDirection", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6028}, "FD", "ReferencePixelPhysicalValueX", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x602A}, "FD", "ReferencePixelPhysicalValueY", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x602C}, "FD", "PhysicalDeltaX", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x602E}, "FD", "PhysicalDeltaY", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6030}, "UL", "TransducerFrequency", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6031}, "CS", "TransducerType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6032}, "UL", "PulseRepetitionFrequency", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6034}, "FD", "DopplerCorrectionAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6036}, "FD", "SteeringAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6038}, "UL", "RETIRED_DopplerSampleVolumeXPositionRetired", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x6039}, "SL", "DopplerSampleVolumeXPosition", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x603A}, "UL", "RETIRED_DopplerSampleVolumeYPositionRetired", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x603B}, "SL", "DopplerSampleVolumeYPosition", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x603C}, "UL", "RETIRED_TMLinePositionX0Retired", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x603D}, "SL", "TMLinePositionX0", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x603E}, "UL", "RETIRED_TMLinePositionY0Retired", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x603F}, "SL", "TMLinePositionY0", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6040}, "UL", "RETIRED_TMLinePositionX1Retired", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x6041}, "SL", "TMLinePositionX1", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6042}, "UL", "RETIRED_TMLinePositionY1Retired", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x6043}, "SL", "TMLinePositionY1", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6044}, "US", "PixelComponentOrganization", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6046}, "UL", "PixelComponentMask", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6048}, "UL", "PixelComponentRangeStart", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x604A}, "UL", "PixelComponentRangeStop", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x604C}, "US", "PixelComponentPhysicalUnits", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x604E}, "US", "PixelComponentDataType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6050}, "UL", "NumberOfTableBreakPoints", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6052}, "UL", "TableOfXBreakPoints", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x6054}, "FD", "TableOfYBreakPoints", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x6056}, "UL", "NumberOfTableEntries", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x6058}, "UL", "TableOfPixelValues", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x605A}, "FL", "TableOfParameterValues", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x6060}, "FL", "RWaveTimeVector", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x7000}, "CS", "DetectorConditionsNominalFlag", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7001}, "DS", "DetectorTemperature", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7004}, "CS", "DetectorType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7005}, "CS", "DetectorConfiguration", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7006}, "LT", "DetectorDescription", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7008}, "LT", "DetectorMode", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x700A}, "SH", "DetectorID", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x700C}, "DA", "DateOfLastDetectorCalibration", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x700E}, "TM", "TimeOfLastDetectorCalibration", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7010}, "IS", "ExposuresOnDetectorSinceLastCalibration", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7011}, "IS", "ExposuresOnDetectorSinceManufactured", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7012}, "DS", "DetectorTimeSinceLastExposure", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7014}, "DS", "DetectorActiveTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7016}, "DS", "DetectorActivationOffsetFromExposure", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x701A}, "DS", "DetectorBinning", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x7020}, "DS", "DetectorElementPhysicalSize", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x7022}, "DS", "DetectorElementSpacing", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x7024}, "CS", "DetectorActiveShape", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7026}, "DS", "DetectorActiveDimensions", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x7028}, "DS", "DetectorActiveOrigin", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x702A}, "LO", "DetectorManufacturerName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x702B}, "LO", "DetectorManufacturerModelName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7030}, "DS", "FieldOfViewOrigin", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x7032}, "DS", "FieldOfViewRotation", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7034}, "CS", "FieldOfViewHorizontalFlip", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7036}, "FL", "PixelDataAreaOriginRelativeToFOV", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x7038}, "FL", "PixelDataAreaRotationAngleRelativeToFOV", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7040}, "LT", "GridAbsorbingMaterial", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7041}, "LT", "GridSpacingMaterial", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7042}, "DS", "GridThickness", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7044}, "DS", "GridPitch", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7046}, "IS", "GridAspectRatio", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x7048}, "DS", "GridPeriod", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x704C}, "DS", "GridFocalDistance", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7050}, "CS", "FilterMaterial", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x7052}, "DS", "FilterThicknessMinimum", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x7054}, "DS", "FilterThicknessMaximum", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x7056}, "FL", "FilterBeamPathLengthMinimum", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x7058}, "FL", "FilterBeamPathLengthMaximum", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x7060}, "CS", "ExposureControlMode", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7062}, "LT", "ExposureControlModeDescription", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7064}, "CS", "ExposureStatus", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x7065}, "DS", "PhototimerSetting", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x8150}, "DS", "ExposureTimeInuS", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x8151}, "DS", "XRayTubeCurrentInuA", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9004}, "CS", "ContentQualification", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9005}, "SH", "PulseSequenceName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9006}, "SQ", "MRImagingModifierSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9008}, "CS", "EchoPulseSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9009}, "CS", "InversionRecovery", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9010}, "CS", "FlowCompensation", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9011}, "CS", "MultipleSpinEcho", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9012}, "CS", "MultiPlanarExcitation", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9014}, "CS", "PhaseContrast", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9015}, "CS", "TimeOfFlightContrast", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9016}, "CS", "Spoiling", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9017}, "CS", "SteadyStatePulseSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9018}, "CS", "EchoPlanarPulseSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9019}, "FD", "TagAngleFirstAxis", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9020}, "CS", "MagnetizationTransfer", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9021}, "CS", "T2Preparation", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9022}, "CS", "BloodSignalNulling", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9024}, "CS", "SaturationRecovery", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9025}, "CS", "SpectrallySelectedSuppression", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9026}, "CS", "SpectrallySelectedExcitation", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9027}, "CS", "SpatialPresaturation", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9028}, "CS", "Tagging", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9029}, "CS", "OversamplingPhase", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9030}, "FD", "TagSpacingFirstDimension", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9032}, "CS", "GeometryOfKSpaceTraversal", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9033}, "CS", "SegmentedKSpaceTraversal", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9034}, "CS", "RectilinearPhaseEncodeReordering", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9035}, "FD", "TagThickness", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9036}, "CS", "PartialFourierDirection", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9037}, "CS", "CardiacSynchronizationTechnique", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9041}, "LO", "ReceiveCoilManufacturerName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9042}, "SQ", "MRReceiveCoilSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9043}, "CS", "ReceiveCoilType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9044}, "CS", "QuadratureReceiveCoil", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9045}, "SQ", "MultiCoilDefinitionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9046}, "LO", "MultiCoilConfiguration", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9047}, "SH", "MultiCoilElementName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9048}, "CS", "MultiCoilElementUsed", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9049}, "SQ", "MRTransmitCoilSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9050}, "LO", "TransmitCoilManufacturerName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9051}, "CS", "TransmitCoilType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9052}, "FD", "SpectralWidth", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x9053}, "FD", "ChemicalShiftReference", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x9054}, "CS", "VolumeLocalizationTechnique", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9058}, "US", "MRAcquisitionFrequencyEncodingSteps", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9059}, "CS", "Decoupling", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9060}, "CS", "DecoupledNucleus", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x9061}, "FD", "DecouplingFrequency", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x9062}, "CS", "DecouplingMethod", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9063}, "FD", "DecouplingChemicalShiftReference", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x9064}, "CS", "KSpaceFiltering", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9065}, "CS", "TimeDomainFiltering", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x9066}, "US", "NumberOfZeroFills", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x9067}, "CS", "BaselineCorrection", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9069}, "FD", "ParallelReductionFactorInPlane", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9070}, "FD", "CardiacRRIntervalSpecified", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9073}, "FD", "AcquisitionDuration", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9074}, "DT", "FrameAcquisitionDateTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9075}, "CS", "DiffusionDirectionality", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9076}, "SQ", "DiffusionGradientDirectionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9077}, "CS", "ParallelAcquisition", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9078}, "CS", "ParallelAcquisitionTechnique", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9079}, "FD", "InversionTimes", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x9080}, "ST", "MetaboliteMapDescription", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9081}, "CS", "PartialFourier", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9082}, "FD", "EffectiveEchoTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9083}, "SQ", "MetaboliteMapCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9084}, "SQ", "ChemicalShiftSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9085}, "CS", "CardiacSignalSource", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9087}, "FD", "DiffusionBValue", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9089}, "FD", "DiffusionGradientOrientation", "3", "DICOM_2011"},
+	{Tag{0x0018, 0x9090}, "FD", "VelocityEncodingDirection", "3", "DICOM_2011"},
+	{Tag{0x0018, 0x9091}, "FD", "VelocityEncodingMinimumValue", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9092}, "SQ", "VelocityEncodingAcquisitionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9093}, "US", "NumberOfKSpaceTrajectories", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9094}, "CS", "CoverageOfKSpace", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9095}, "UL", "SpectroscopyAcquisitionPhaseRows", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9096}, "FD", "RETIRED_ParallelReductionFactorInPlaneRetired", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x9098}, "FD", "TransmitterFrequency", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x9100}, "CS", "ResonantNucleus", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x9101}, "CS", "FrequencyCorrection", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9103}, "SQ", "MRSpectroscopyFOVGeometrySequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9104}, "FD", "SlabThickness", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9105}, "FD", "SlabOrientation", "3", "DICOM_2011"},
+	{Tag{0x0018, 0x9106}, "FD", "MidSlabPosition", "3", "DICOM_2011"},
+	{Tag{0x0018, 0x9107}, "SQ", "MRSpatialSaturationSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9112}, "SQ", "MRTimingAndRelatedParametersSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9114}, "SQ", "MREchoSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9115}, "SQ", "MRModifierSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9117}, "SQ", "MRDiffusionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9118}, "SQ", "CardiacSynchronizationSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9119}, "SQ", "MRAveragesSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9125}, "SQ", "MRFOVGeometrySequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9126}, "SQ", "VolumeLocalizationSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9127}, "UL", "SpectroscopyAcquisitionDataColumns", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9147}, "CS", "DiffusionAnisotropyType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9151}, "DT", "FrameReferenceDateTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9152}, "SQ", "MRMetaboliteMapSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9155}, "FD", "ParallelReductionFactorOutOfPlane", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9159}, "UL", "SpectroscopyAcquisitionOutOfPlanePhaseSteps", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9166}, "CS", "RETIRED_BulkMotionStatus", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x9168}, "FD", "ParallelReductionFactorSecondInPlane", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9169}, "CS", "CardiacBeatRejectionTechnique", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9170}, "CS", "RespiratoryMotionCompensationTechnique", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9171}, "CS", "RespiratorySignalSource", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9172}, "CS", "BulkMotionCompensationTechnique", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9173}, "CS", "BulkMotionSignalSource", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9174}, "CS", "ApplicableSafetyStandardAgency", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9175}, "LO", "ApplicableSafetyStandardDescription", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9176}, "SQ", "OperatingModeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9177}, "CS", "OperatingModeType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9178}, "CS", "OperatingMode", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9179}, "CS", "SpecificAbsorptionRateDefinition", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9180}, "CS", "GradientOutputType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9181}, "FD", "SpecificAbsorptionRateValue", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9182}, "FD", "GradientOutput", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9183}, "CS", "FlowCompensationDirection", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9184}, "FD", "TaggingDelay", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9185}, "ST", "RespiratoryMotionCompensationTechniqueDescription", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9186}, "SH", "RespiratorySignalSourceID", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9195}, "FD", "RETIRED_ChemicalShiftMinimumIntegrationLimitInHz", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x9196}, "FD", "RETIRED_ChemicalShiftMaximumIntegrationLimitInHz", "1", "DICOM/retired"},
+	{Tag{0x0018, 0x9197}, "SQ", "MRVelocityEncodingSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9198}, "CS", "FirstOrderPhaseCorrection", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9199}, "CS", "WaterReferencedPhaseCorrection", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9200}, "CS", "MRSpectroscopyAcquisitionType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9214}, "CS", "RespiratoryCyclePosition", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9217}, "FD", "VelocityEncodingMaximumValue", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9218}, "FD", "TagSpacingSecondDimension", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9219}, "SS", "TagAngleSecondAxis", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9220}, "FD", "FrameAcquisitionDuration", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9226}, "SQ", "MRImageFrameTypeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9227}, "SQ", "MRSpectroscopyFrameTypeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9231}, "US", "MRAcquisitionPhaseEncodingStepsInPlane", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9232}, "US", "MRAcquisitionPhaseEncodingStepsOutOfPlane", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9234}, "UL", "SpectroscopyAcquisitionPhaseColumns", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9236}, "CS", "CardiacCyclePosition", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9239}, "SQ", "SpecificAbsorptionRateSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9240}, "US", "RFEchoTrainLength", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9241}, "US", "GradientEchoTrainLength", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9250}, "CS", "ArterialSpinLabelingContrast", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9251}, "SQ", "MRArterialSpinLabelingSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9252}, "LO", "ASLTechniqueDescription", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9253}, "US", "ASLSlabNumber", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9254}, "FD", "ASLSlabThickness", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9255}, "FD", "ASLSlabOrientation", "3", "DICOM_2011"},
+	{Tag{0x0018, 0x9256}, "FD", "ASLMidSlabPosition", "3", "DICOM_2011"},
+	{Tag{0x0018, 0x9257}, "CS", "ASLContext", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9258}, "UL", "ASLPulseTrainDuration", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9259}, "CS", "ASLCrusherFlag", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x925A}, "FD", "ASLCrusherFlow", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x925B}, "LO", "ASLCrusherDescription", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x925C}, "CS", "ASLBolusCutoffFlag", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x925D}, "SQ", "ASLBolusCutoffTimingSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x925E}, "LO", "ASLBolusCutoffTechnique", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x925F}, "UL", "ASLBolusCutoffDelayTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9260}, "SQ", "ASLSlabSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9295}, "FD", "ChemicalShiftMinimumIntegrationLimitInppm", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9296}, "FD", "ChemicalShiftMaximumIntegrationLimitInppm", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9301}, "SQ", "CTAcquisitionTypeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9302}, "CS", "AcquisitionType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9303}, "FD", "TubeAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9304}, "SQ", "CTAcquisitionDetailsSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9305}, "FD", "RevolutionTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9306}, "FD", "SingleCollimationWidth", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9307}, "FD", "TotalCollimationWidth", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9308}, "SQ", "CTTableDynamicsSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9309}, "FD", "TableSpeed", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9310}, "FD", "TableFeedPerRotation", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9311}, "FD", "SpiralPitchFactor", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9312}, "SQ", "CTGeometrySequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9313}, "FD", "DataCollectionCenterPatient", "3", "DICOM_2011"},
+	{Tag{0x0018, 0x9314}, "SQ", "CTReconstructionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9315}, "CS", "ReconstructionAlgorithm", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9316}, "CS", "ConvolutionKernelGroup", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9317}, "FD", "ReconstructionFieldOfView", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x9318}, "FD", "ReconstructionTargetCenterPatient", "3", "DICOM_2011"},
+	{Tag{0x0018, 0x9319}, "FD", "ReconstructionAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9320}, "SH", "ImageFilter", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9321}, "SQ", "CTExposureSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9322}, "FD", "ReconstructionPixelSpacing", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x9323}, "CS", "ExposureModulationType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9324}, "FD", "EstimatedDoseSaving", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9325}, "SQ", "CTXRayDetailsSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9326}, "SQ", "CTPositionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9327}, "FD", "TablePosition", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9328}, "FD", "ExposureTimeInms", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9329}, "SQ", "CTImageFrameTypeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9330}, "FD", "XRayTubeCurrentInmA", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9332}, "FD", "ExposureInmAs", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9333}, "CS", "ConstantVolumeFlag", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9334}, "CS", "FluoroscopyFlag", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9335}, "FD", "DistanceSourceToDataCollectionCenter", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9337}, "US", "ContrastBolusAgentNumber", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9338}, "SQ", "ContrastBolusIngredientCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9340}, "SQ", "ContrastAdministrationProfileSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9341}, "SQ", "ContrastBolusUsageSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9342}, "CS", "ContrastBolusAgentAdministered", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9343}, "CS", "ContrastBolusAgentDetected", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9344}, "CS", "ContrastBolusAgentPhase", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9345}, "FD", "CTDIvol", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9346}, "SQ", "CTDIPhantomTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9351}, "FL", "CalciumScoringMassFactorPatient", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9352}, "FL", "CalciumScoringMassFactorDevice", "3", "DICOM_2011"},
+	{Tag{0x0018, 0x9353}, "FL", "EnergyWeightingFactor", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9360}, "SQ", "CTAdditionalXRaySourceSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9401}, "SQ", "ProjectionPixelCalibrationSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9402}, "FL", "DistanceSourceToIsocenter", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9403}, "FL", "DistanceObjectToTableTop", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9404}, "FL", "ObjectPixelSpacingInCenterOfBeam", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x9405}, "SQ", "PositionerPositionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9406}, "SQ", "TablePositionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9407}, "SQ", "CollimatorShapeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9410}, "CS", "PlanesInAcquisition", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9412}, "SQ", "XAXRFFrameCharacteristicsSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9417}, "SQ", "FrameAcquisitionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9420}, "CS", "XRayReceptorType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9423}, "LO", "AcquisitionProtocolName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9424}, "LT", "AcquisitionProtocolDescription", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9425}, "CS", "ContrastBolusIngredientOpaque", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9426}, "FL", "DistanceReceptorPlaneToDetectorHousing", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9427}, "CS", "IntensifierActiveShape", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9428}, "FL", "IntensifierActiveDimensions", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x9429}, "FL", "PhysicalDetectorSize", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x9430}, "FL", "PositionOfIsocenterProjection", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x9432}, "SQ", "FieldOfViewSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9433}, "LO", "FieldOfViewDescription", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9434}, "SQ", "ExposureControlSensingRegionsSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9435}, "CS", "ExposureControlSensingRegionShape", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9436}, "SS", "ExposureControlSensingRegionLeftVerticalEdge", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9437}, "SS", "ExposureControlSensingRegionRightVerticalEdge", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9438}, "SS", "ExposureControlSensingRegionUpperHorizontalEdge", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9439}, "SS", "ExposureControlSensingRegionLowerHorizontalEdge", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9440}, "SS", "CenterOfCircularExposureControlSensingRegion", "2", "DICOM_2011"},
+	{Tag{0x0018, 0x9441}, "US", "RadiusOfCircularExposureControlSensingRegion", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9442}, "SS", "VerticesOfThePolygonalExposureControlSensingRegion", "2-n", "DICOM_2011"},
+	{Tag{0x0018, 0x9447}, "FL", "ColumnAngulationPatient", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9449}, "FL", "BeamAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9451}, "SQ", "FrameDetectorParametersSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9452}, "FL", "CalculatedAnatomyThickness", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9455}, "SQ", "CalibrationSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9456}, "SQ", "ObjectThicknessSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9457}, "CS", "PlaneIdentification", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9461}, "FL", "FieldOfViewDimensionsInFloat", "1-2", "DICOM_2011"},
+	{Tag{0x0018, 0x9462}, "SQ", "IsocenterReferenceSystemSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9463}, "FL", "PositionerIsocenterPrimaryAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9464}, "FL", "PositionerIsocenterSecondaryAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9465}, "FL", "PositionerIsocenterDetectorRotationAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9466}, "FL", "TableXPositionToIsocenter", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9467}, "FL", "TableYPositionToIsocenter", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9468}, "FL", "TableZPositionToIsocenter", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9469}, "FL", "TableHorizontalRotationAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9470}, "FL", "TableHeadTiltAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9471}, "FL", "TableCradleTiltAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9472}, "SQ", "FrameDisplayShutterSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9473}, "FL", "AcquiredImageAreaDoseProduct", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9474}, "CS", "CArmPositionerTabletopRelationship", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9476}, "SQ", "XRayGeometrySequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9477}, "SQ", "IrradiationEventIdentificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9504}, "SQ", "XRay3DFrameTypeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9506}, "SQ", "ContributingSourcesSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9507}, "SQ", "XRay3DAcquisitionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9508}, "FL", "PrimaryPositionerScanArc", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9509}, "FL", "SecondaryPositionerScanArc", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9510}, "FL", "PrimaryPositionerScanStartAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9511}, "FL", "SecondaryPositionerScanStartAngle", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9514}, "FL", "PrimaryPositionerIncrement", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9515}, "FL", "SecondaryPositionerIncrement", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9516}, "DT", "StartAcquisitionDateTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9517}, "DT", "EndAcquisitionDateTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9524}, "LO", "ApplicationName", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9525}, "LO", "ApplicationVersion", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9526}, "LO", "ApplicationManufacturer", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9527}, "CS", "AlgorithmType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9528}, "LO", "AlgorithmDescription", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9530}, "SQ", "XRay3DReconstructionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9531}, "LO", "ReconstructionDescription", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9538}, "SQ", "PerProjectionAcquisitionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9601}, "SQ", "DiffusionBMatrixSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9602}, "FD", "DiffusionBValueXX", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9603}, "FD", "DiffusionBValueXY", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9604}, "FD", "DiffusionBValueXZ", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9605}, "FD", "DiffusionBValueYY", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9606}, "FD", "DiffusionBValueYZ", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9607}, "FD", "DiffusionBValueZZ", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9701}, "DT", "DecayCorrectionDateTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9715}, "FD", "StartDensityThreshold", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9716}, "FD", "StartRelativeDensityDifferenceThreshold", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9717}, "FD", "StartCardiacTriggerCountThreshold", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9718}, "FD", "StartRespiratoryTriggerCountThreshold", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9719}, "FD", "TerminationCountsThreshold", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9720}, "FD", "TerminationDensityThreshold", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9721}, "FD", "TerminationRelativeDensityThreshold", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9722}, "FD", "TerminationTimeThreshold", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9723}, "FD", "TerminationCardiacTriggerCountThreshold", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9724}, "FD", "TerminationRespiratoryTriggerCountThreshold", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9725}, "CS", "DetectorGeometry", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9726}, "FD", "TransverseDetectorSeparation", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9727}, "FD", "AxialDetectorDimension", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9729}, "US", "RadiopharmaceuticalAgentNumber", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9732}, "SQ", "PETFrameAcquisitionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9733}, "SQ", "PETDetectorMotionDetailsSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9734}, "SQ", "PETTableDynamicsSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9735}, "SQ", "PETPositionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9736}, "SQ", "PETFrameCorrectionFactorsSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9737}, "SQ", "RadiopharmaceuticalUsageSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9738}, "CS", "AttenuationCorrectionSource", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9739}, "US", "NumberOfIterations", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9740}, "US", "NumberOfSubsets", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9749}, "SQ", "PETReconstructionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9751}, "SQ", "PETFrameTypeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9755}, "CS", "TimeOfFlightInformationUsed", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9756}, "CS", "ReconstructionType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9758}, "CS", "DecayCorrected", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9759}, "CS", "AttenuationCorrected", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9760}, "CS", "ScatterCorrected", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9761}, "CS", "DeadTimeCorrected", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9762}, "CS", "GantryMotionCorrected", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9763}, "CS", "PatientMotionCorrected", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9764}, "CS", "CountLossNormalizationCorrected", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9765}, "CS", "RandomsCorrected", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9766}, "CS", "NonUniformRadialSamplingCorrected", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9767}, "CS", "SensitivityCalibrated", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9768}, "CS", "DetectorNormalizationCorrection", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9769}, "CS", "IterativeReconstructionMethod", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9770}, "CS", "AttenuationCorrectionTemporalRelationship", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9771}, "SQ", "PatientPhysiologicalStateSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9772}, "SQ", "PatientPhysiologicalStateCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9801}, "FD", "DepthsOfFocus", "1-n", "DICOM_2011"},
+	{Tag{0x0018, 0x9803}, "SQ", "ExcludedIntervalsSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9804}, "DT", "ExclusionStartDatetime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9805}, "FD", "ExclusionDuration", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9806}, "SQ", "USImageDescriptionSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9807}, "SQ", "ImageDataTypeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9808}, "CS", "DataType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x9809}, "SQ", "TransducerScanPatternCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x980B}, "CS", "AliasedDataType", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x980C}, "CS", "PositionMeasuringDeviceUsed", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x980D}, "SQ", "TransducerGeometryCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x980E}, "SQ", "TransducerBeamSteeringCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0x980F}, "SQ", "TransducerApplicationCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0xA001}, "SQ", "ContributingEquipmentSequence", "1", "DICOM_2011"},
+	{Tag{0x0018, 0xA002}, "DT", "ContributionDateTime", "1", "DICOM_2011"},
+	{Tag{0x0018, 0xA003}, "ST", "ContributionDescription", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x000D}, "UI", "StudyInstanceUID", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x000E}, "UI", "SeriesInstanceUID", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x0010}, "SH", "StudyID", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x0011}, "IS", "SeriesNumber", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x0012}, "IS", "AcquisitionNumber", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x0013}, "IS", "InstanceNumber", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x0014}, "IS", "RETIRED_IsotopeNumber", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x0015}, "IS", "RETIRED_PhaseNumber", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x0016}, "IS", "RETIRED_IntervalNumber", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x0017}, "IS", "RETIRED_TimeSlotNumber", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x0018}, "IS", "RETIRED_AngleNumber", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x0019}, "IS", "ItemNumber", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x0020}, "CS", "PatientOrientation", "2", "DICOM_2011"},
+	{Tag{0x0020, 0x0022}, "IS", "RETIRED_OverlayNumber", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x0024}, "IS", "RETIRED_CurveNumber", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x0026}, "IS", "RETIRED_LUTNumber", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x0030}, "DS", "ACR_NEMA_ImagePosition", "3", "ACR/NEMA2"},
+	{Tag{0x0020, 0x0030}, "DS", "RETIRED_ImagePosition", "3", "DICOM/retired"},
+	{Tag{0x0020, 0x0032}, "DS", "ImagePositionPatient", "3", "DICOM_2011"},
+	{Tag{0x0020, 0x0035}, "DS", "ACR_NEMA_ImageOrientation", "6", "ACR/NEMA2"},
+	{Tag{0x0020, 0x0035}, "DS", "RETIRED_ImageOrientation", "6", "DICOM/retired"},
+	{Tag{0x0020, 0x0037}, "DS", "ImageOrientationPatient", "6", "DICOM_2011"},
+	{Tag{0x0020, 0x0050}, "DS", "ACR_NEMA_Location", "1", "ACR/NEMA2"},
+	{Tag{0x0020, 0x0050}, "DS", "RETIRED_Location", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x0052}, "UI", "FrameOfReferenceUID", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x0060}, "CS", "Laterality", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x0062}, "CS", "ImageLaterality", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x0070}, "LO", "ACR_NEMA_ImageGeometryType", "1", "ACR/NEMA2"},
+	{Tag{0x0020, 0x0070}, "LO", "RETIRED_ImageGeometryType", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x0080}, "LO", "ACR_NEMA_MaskingImage", "1-n", "ACR/NEMA2"},
+	{Tag{0x0020, 0x0080}, "CS", "RETIRED_MaskingImage", "1-n", "DICOM/retired"},
+	{Tag{0x0020, 0x00AA}, "IS", "RETIRED_ReportNumber", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x0100}, "IS", "TemporalPositionIdentifier", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x0105}, "IS", "NumberOfTemporalPositions", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x0110}, "DS", "TemporalResolution", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x0200}, "UI", "SynchronizationFrameOfReferenceUID", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x0242}, "UI", "SOPInstanceUIDOfConcatenationSource", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x1000}, "IS", "RETIRED_SeriesInStudy", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x1001}, "IS", "ACR_NEMA_AcquisitionsInSeries", "1", "ACR/NEMA2"},
+	{Tag{0x0020, 0x1001}, "IS", "RETIRED_AcquisitionsInSeries", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x1002}, "IS", "ImagesInAcquisition", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x1003}, "IS", "ACR_NEMA_ImagesInSeries", "1", "ACR/NEMA2"},
+	{Tag{0x0020, 0x1003}, "IS", "RETIRED_ImagesInSeries", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x1004}, "IS", "RETIRED_AcquisitionsInStudy", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x1005}, "IS", "ACR_NEMA_ImagesInStudy", "1", "ACR/NEMA2"},
+	{Tag{0x0020, 0x1005}, "IS", "RETIRED_ImagesInStudy", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x1020}, "LO", "ACR_NEMA_Reference", "1-n", "ACR/NEMA2"},
+	{Tag{0x0020, 0x1020}, "LO", "RETIRED_Reference", "1-n", "DICOM/retired"},
+	{Tag{0x0020, 0x1040}, "LO", "PositionReferenceIndicator", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x1041}, "DS", "SliceLocation", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x1070}, "IS", "RETIRED_OtherStudyNumbers", "1-n", "DICOM/retired"},
+	{Tag{0x0020, 0x1200}, "IS", "NumberOfPatientRelatedStudies", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x1202}, "IS", "NumberOfPatientRelatedSeries", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x1204}, "IS", "NumberOfPatientRelatedInstances", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x1206}, "IS", "NumberOfStudyRelatedSeries", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x1208}, "IS", "NumberOfStudyRelatedInstances", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x1209}, "IS", "NumberOfSeriesRelatedInstances", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x3401}, "LO", "ACR_NEMA_ModifyingDeviceID", "1", "ACR/NEMA2"},
+	{Tag{0x0020, 0x3401}, "CS", "RETIRED_ModifyingDeviceID", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x3402}, "LO", "ACR_NEMA_ModifiedImageID", "1", "ACR/NEMA2"},
+	{Tag{0x0020, 0x3402}, "CS", "RETIRED_ModifiedImageID", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x3403}, "DA", "ACR_NEMA_ModifiedImageDate", "1", "ACR/NEMA2"},
+	{Tag{0x0020, 0x3403}, "DA", "RETIRED_ModifiedImageDate", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x3404}, "LO", "ACR_NEMA_ModifyingDeviceManufacturer", "1", "ACR/NEMA2"},
+	{Tag{0x0020, 0x3404}, "LO", "RETIRED_ModifyingDeviceManufacturer", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x3405}, "TM", "ACR_NEMA_ModifiedImageTime", "1", "ACR/NEMA2"},
+	{Tag{0x0020, 0x3405}, "TM", "RETIRED_ModifiedImageTime", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x3406}, "LO", "ACR_NEMA_ModifiedImageDescription", "1", "ACR/NEMA2"},
+	{Tag{0x0020, 0x3406}, "LO", "RETIRED_ModifiedImageDescription", "1", "DICOM/retired"},
+	{Tag{0x0020, 0x4000}, "LT", "ImageComments", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x5000}, "AT", "ACR_NEMA_OriginalImageIdentification", "1-n", "ACR/NEMA2"},
+	{Tag{0x0020, 0x5000}, "AT", "RETIRED_OriginalImageIdentification", "1-n", "DICOM/retired"},
+	{Tag{0x0020, 0x5002}, "LO", "ACR_NEMA_OriginalImageIdentificationNomenclature", "1-n", "ACR/NEMA2"},
+	{Tag{0x0020, 0x5002}, "LO", "RETIRED_OriginalImageIdentificationNomenclature", "1-n", "DICOM/retired"},
+	{Tag{0x0020, 0x9056}, "SH", "StackID", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9057}, "UL", "InStackPositionNumber", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9071}, "SQ", "FrameAnatomySequence", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9072}, "CS", "FrameLaterality", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9111}, "SQ", "FrameContentSequence", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9113}, "SQ", "PlanePositionSequence", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9116}, "SQ", "PlaneOrientationSequence", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9128}, "UL", "TemporalPositionIndex", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9153}, "FD", "NominalCardiacTriggerDelayTime", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9154}, "FL", "NominalCardiacTriggerTimePriorToRPeak", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9155}, "FL", "ActualCardiacTriggerTimePriorToRPeak", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9156}, "US", "FrameAcquisitionNumber", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9157}, "UL", "DimensionIndexValues", "1-n", "DICOM_2011"},
+	{Tag{0x0020, 0x9158}, "LT", "FrameComments", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9161}, "UI", "ConcatenationUID", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9162}, "US", "InConcatenationNumber", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9163}, "US", "InConcatenationTotalNumber", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9164}, "UI", "DimensionOrganizationUID", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9165}, "AT", "DimensionIndexPointer", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9167}, "AT", "FunctionalGroupPointer", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9170}, "SQ", "UnassignedSharedConvertedAttributesSequence", "1", "SUP_169"},
+	{Tag{0x0020, 0x9171}, "SQ", "UnassignedPerFrameConvertedAttributesSequence", "1", "SUP_169"},
+	{Tag{0x0020, 0x9172}, "SQ", "ConversionSourceAttributesSequence", "1", "SUP_169"},
+	{Tag{0x0020, 0x9213}, "LO", "DimensionIndexPrivateCreator", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9221}, "SQ", "DimensionOrganizationSequence", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9222}, "SQ", "DimensionIndexSequence", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9228}, "UL", "ConcatenationFrameOffsetNumber", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9238}, "LO", "FunctionalGroupPrivateCreator", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9241}, "FL", "NominalPercentageOfCardiacPhase", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9245}, "FL", "NominalPercentageOfRespiratoryPhase", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9246}, "FL", "StartingRespiratoryAmplitude", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9247}, "CS", "StartingRespiratoryPhase", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9248}, "FL", "EndingRespiratoryAmplitude", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9249}, "CS", "EndingRespiratoryPhase", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9250}, "CS", "RespiratoryTriggerType", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9251}, "FD", "RRIntervalTimeNominal", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9252}, "FD", "ActualCardiacTriggerDelayTime", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9253}, "SQ", "RespiratorySynchronizationSequence", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9254}, "FD", "RespiratoryIntervalTime", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9255}, "FD", "NominalRespiratoryTriggerDelayTime", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9256}, "FD", "RespiratoryTriggerDelayThreshold", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9257}, "FD", "ActualRespiratoryTriggerDelayTime", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9301}, "FD", "ImagePositionVolume", "3", "DICOM_2011"},
+	{Tag{0x0020, 0x9302}, "FD", "ImageOrientationVolume", "6", "DICOM_2011"},
+	{Tag{0x0020, 0x9307}, "CS", "UltrasoundAcquisitionGeometry", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9308}, "FD", "ApexPosition", "3", "DICOM_2011"},
+	{Tag{0x0020, 0x9309}, "FD", "VolumeToTransducerMappingMatrix", "16", "DICOM_2011"},
+	{Tag{0x0020, 0x930A}, "FD", "VolumeToTableMappingMatrix", "16", "DICOM_2011"},
+	{Tag{0x0020, 0x930C}, "CS", "PatientFrameOfReferenceSource", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x930D}, "FD", "TemporalPositionTimeOffset", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x930E}, "SQ", "PlanePositionVolumeSequence", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x930F}, "SQ", "PlaneOrientationVolumeSequence", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9310}, "SQ", "TemporalPositionSequence", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9311}, "CS", "DimensionOrganizationType", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9312}, "UI", "VolumeFrameOfReferenceUID", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9313}, "UI", "TableFrameOfReferenceUID", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9421}, "LO", "DimensionDescriptionLabel", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9450}, "SQ", "PatientOrientationInFrameSequence", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9453}, "LO", "FrameLabel", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9518}, "US", "AcquisitionIndex", "1-n", "DICOM_2011"},
+	{Tag{0x0020, 0x9529}, "SQ", "ContributingSOPInstancesReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x0020, 0x9536}, "US", "ReconstructionIndex", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0001}, "US", "LightPathFilterPassThroughWavelength", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0002}, "US", "LightPathFilterPassBand", "2", "DICOM_2011"},
+	{Tag{0x0022, 0x0003}, "US", "ImagePathFilterPassThroughWavelength", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0004}, "US", "ImagePathFilterPassBand", "2", "DICOM_2011"},
+	{Tag{0x0022, 0x0005}, "CS", "PatientEyeMovementCommanded", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0006}, "SQ", "PatientEyeMovementCommandCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0007}, "FL", "SphericalLensPower", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0008}, "FL", "CylinderLensPower", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0009}, "FL", "CylinderAxis", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x000A}, "FL", "EmmetropicMagnification", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x000B}, "FL", "IntraOcularPressure", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x000C}, "FL", "HorizontalFieldOfView", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x000D}, "CS", "PupilDilated", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x000E}, "FL", "DegreeOfDilation", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0010}, "FL", "StereoBaselineAngle", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0011}, "FL", "StereoBaselineDisplacement", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0012}, "FL", "StereoHorizontalPixelOffset", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0013}, "FL", "StereoVerticalPixelOffset", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0014}, "FL", "StereoRotation", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0015}, "SQ", "AcquisitionDeviceTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0016}, "SQ", "IlluminationTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0017}, "SQ", "LightPathFilterTypeStackCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0018}, "SQ", "ImagePathFilterTypeStackCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0019}, "SQ", "LensesCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x001A}, "SQ", "ChannelDescriptionCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x001B}, "SQ", "RefractiveStateSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x001C}, "SQ", "MydriaticAgentCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x001D}, "SQ", "RelativeImagePositionCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x001E}, "FL", "CameraAngleOfView", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0020}, "SQ", "StereoPairsSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0021}, "SQ", "LeftImageSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0022}, "SQ", "RightImageSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0030}, "FL", "AxialLengthOfTheEye", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0031}, "SQ", "OphthalmicFrameLocationSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0032}, "FL", "ReferenceCoordinates", "2-2n", "DICOM_2011"},
+	{Tag{0x0022, 0x0035}, "FL", "DepthSpatialResolution", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0036}, "FL", "MaximumDepthDistortion", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0037}, "FL", "AlongScanSpatialResolution", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0038}, "FL", "MaximumAlongScanDistortion", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0039}, "CS", "OphthalmicImageOrientation", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0041}, "FL", "DepthOfTransverseImage", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0042}, "SQ", "MydriaticAgentConcentrationUnitsSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0048}, "FL", "AcrossScanSpatialResolution", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0049}, "FL", "MaximumAcrossScanDistortion", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x004E}, "DS", "MydriaticAgentConcentration", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0055}, "FL", "IlluminationWaveLength", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0056}, "FL", "IlluminationPower", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0057}, "FL", "IlluminationBandwidth", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x0058}, "SQ", "MydriaticAgentSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1007}, "SQ", "OphthalmicAxialMeasurementsRightEyeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1008}, "SQ", "OphthalmicAxialMeasurementsLeftEyeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1009}, "CS", "OphthalmicAxialMeasurementsDeviceType", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1010}, "CS", "OphthalmicAxialLengthMeasurementsType", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1012}, "SQ", "OphthalmicAxialLengthSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1019}, "FL", "OphthalmicAxialLength", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1024}, "SQ", "LensStatusCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1025}, "SQ", "VitreousStatusCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1028}, "SQ", "IOLFormulaCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1029}, "LO", "IOLFormulaDetail", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1033}, "FL", "KeratometerIndex", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1035}, "SQ", "SourceOfOphthalmicAxialLengthCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1037}, "FL", "TargetRefraction", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1039}, "CS", "RefractiveProcedureOccurred", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1040}, "SQ", "RefractiveSurgeryTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1044}, "SQ", "OphthalmicUltrasoundMethodCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1050}, "SQ", "OphthalmicAxialLengthMeasurementsSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1053}, "FL", "IOLPower", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1054}, "FL", "PredictedRefractiveError", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1059}, "FL", "OphthalmicAxialLengthVelocity", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1065}, "LO", "LensStatusDescription", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1066}, "LO", "VitreousStatusDescription", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1090}, "SQ", "IOLPowerSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1092}, "SQ", "LensConstantSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1093}, "LO", "IOLManufacturer", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1094}, "LO", "LensConstantDescription", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1095}, "LO", "ImplantName", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1096}, "SQ", "KeratometryMeasurementTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1097}, "LO", "ImplantPartNumber", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1100}, "SQ", "ReferencedOphthalmicAxialMeasurementsSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1101}, "SQ", "OphthalmicAxialLengthMeasurementsSegmentNameCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1103}, "SQ", "RefractiveErrorBeforeRefractiveSurgeryCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1121}, "FL", "IOLPowerForExactEmmetropia", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1122}, "FL", "IOLPowerForExactTargetRefraction", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1125}, "SQ", "AnteriorChamberDepthDefinitionCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1127}, "SQ", "LensThicknessSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1128}, "SQ", "AnteriorChamberDepthSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1130}, "FL", "LensThickness", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1131}, "FL", "AnteriorChamberDepth", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1132}, "SQ", "SourceOfLensThicknessDataCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1133}, "SQ", "SourceOfAnteriorChamberDepthDataCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1134}, "SQ", "SourceOfRefractiveMeasurementsSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1135}, "SQ", "SourceOfRefractiveMeasurementsCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1140}, "CS", "OphthalmicAxialLengthMeasurementModified", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1150}, "SQ", "OphthalmicAxialLengthDataSourceCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1153}, "SQ", "OphthalmicAxialLengthAcquisitionMethodCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1155}, "FL", "SignalToNoiseRatio", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1159}, "LO", "OphthalmicAxialLengthDataSourceDescription", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1210}, "SQ", "OphthalmicAxialLengthMeasurementsTotalLengthSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1211}, "SQ", "OphthalmicAxialLengthMeasurementsSegmentalLengthSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1212}, "SQ", "OphthalmicAxialLengthMeasurementsLengthSummationSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1220}, "SQ", "UltrasoundOphthalmicAxialLengthMeasurementsSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1225}, "SQ", "OpticalOphthalmicAxialLengthMeasurementsSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1230}, "SQ", "UltrasoundSelectedOphthalmicAxialLengthSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1250}, "SQ", "OphthalmicAxialLengthSelectionMethodCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1255}, "SQ", "OpticalSelectedOphthalmicAxialLengthSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1257}, "SQ", "SelectedSegmentalOphthalmicAxialLengthSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1260}, "SQ", "SelectedTotalOphthalmicAxialLengthSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1262}, "SQ", "OphthalmicAxialLengthQualityMetricSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1265}, "SQ", "OphthalmicAxialLengthQualityMetricTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1273}, "LO", "OphthalmicAxialLengthQualityMetricTypeDescription", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1300}, "SQ", "IntraocularLensCalculationsRightEyeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1310}, "SQ", "IntraocularLensCalculationsLeftEyeSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1330}, "SQ", "ReferencedOphthalmicAxialLengthMeasurementQCImageSequence", "1", "DICOM_2011"},
+	{Tag{0x0022, 0x1415}, "CS", "OphthalmicMappingDeviceType", "1", "Supplement_152"},
+	{Tag{0x0022, 0x1420}, "SQ", "AcquisitionTypeMethodSequence", "1", "Supplement_152"},
+	{Tag{0x0022, 0x1423}, "SQ", "AcquisitonMethodAlgorithmSequence", "1", "Supplement_152"},
+	{Tag{0x0022, 0x1436}, "SQ", "OphthalmicThicknessMapTypeCodeSequence", "1", "Supplement_152"},
+	{Tag{0x0022, 0x1443}, "SQ", "OphthalmicThicknessMappingNormalsSequence", "1", "Supplement_152"},
+	{Tag{0x0022, 0x1445}, "SQ", "RetinalThicknessDefinitionCodeSequence", "1", "Supplement_152"},
+	{Tag{0x0022, 0x1450}, "SQ", "PixelValueMappingtoCodedConceptSequence", "1", "Supplement_152"},
+	{Tag{0x0022, 0x1452}, "XS", "MappedPixelValue", "1", "Supplement_152"},
+	{Tag{0x0022, 0x1454}, "LO", "PixelValueMappingExplanation", "1", "Supplement_152"},
+	{Tag{0x0022, 0x1458}, "SQ", "OphthalmicThicknessMapQualityThresholdSequence", "1", "Supplement_152"},
+	{Tag{0x0022, 0x1460}, "FL", "OphthalmicThicknessMapThresholdQualityRating", "1", "Supplement_152"},
+	{Tag{0x0022, 0x1463}, "FL", "AnatomicStructureReferencePoint", "2", "Supplement_152"},
+	{Tag{0x0022, 0x1465}, "SQ", "RegistrationtoLocalizerSequence", "1", "Supplement_152"},
+	{Tag{0x0022, 0x1466}, "CS", "RegisteredLocalizerUnits", "1", "Supplement_152"},
+	{Tag{0x0022, 0x1467}, "FL", "RegisteredTopLeftHandCorner", "2", "Supplement_152"},
+	{Tag{0x0022, 0x1468}, "FL", "RegisteredBottomRightHandCorner", "2", "Supplement_152"},
+	{Tag{0x0022, 0x1470}, "SQ", "OphthalmicThicknessMapQualityRatingSequence", "1", "Supplement_152"},
+	{Tag{0x0022, 0x1472}, "SQ", "RelevantOPTAttributesSequence", "1", "Supplement_152"},
+	{Tag{0x0024, 0x0010}, "FL", "VisualFieldHorizontalExtent", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0011}, "FL", "VisualFieldVerticalExtent", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0012}, "CS", "VisualFieldShape", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0016}, "SQ", "ScreeningTestModeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0018}, "FL", "MaximumStimulusLuminance", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0020}, "FL", "BackgroundLuminance", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0021}, "SQ", "StimulusColorCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0024}, "SQ", "BackgroundIlluminationColorCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0025}, "FL", "StimulusArea", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0028}, "FL", "StimulusPresentationTime", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0032}, "SQ", "FixationSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0033}, "SQ", "FixationMonitoringCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0034}, "SQ", "VisualFieldCatchTrialSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0035}, "US", "FixationCheckedQuantity", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0036}, "US", "PatientNotProperlyFixatedQuantity", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0037}, "CS", "PresentedVisualStimuliDataFlag", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0038}, "US", "NumberOfVisualStimuli", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0039}, "CS", "ExcessiveFixationLossesDataFlag", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0040}, "CS", "ExcessiveFixationLosses", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0042}, "US", "StimuliRetestingQuantity", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0044}, "LT", "CommentsOnPatientPerformanceOfVisualField", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0045}, "CS", "FalseNegativesEstimateFlag", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0046}, "FL", "FalseNegativesEstimate", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0048}, "US", "NegativeCatchTrialsQuantity", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0050}, "US", "FalseNegativesQuantity", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0051}, "CS", "ExcessiveFalseNegativesDataFlag", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0052}, "CS", "ExcessiveFalseNegatives", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0053}, "CS", "FalsePositivesEstimateFlag", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0054}, "FL", "FalsePositivesEstimate", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0055}, "CS", "CatchTrialsDataFlag", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0056}, "US", "PositiveCatchTrialsQuantity", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0057}, "CS", "TestPointNormalsDataFlag", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0058}, "SQ", "TestPointNormalsSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0059}, "CS", "GlobalDeviationProbabilityNormalsFlag", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0060}, "US", "FalsePositivesQuantity", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0061}, "CS", "ExcessiveFalsePositivesDataFlag", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0062}, "CS", "ExcessiveFalsePositives", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0063}, "CS", "VisualFieldTestNormalsFlag", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0064}, "SQ", "ResultsNormalsSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0065}, "SQ", "AgeCorrectedSensitivityDeviationAlgorithmSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0066}, "FL", "GlobalDeviationFromNormal", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0067}, "SQ", "GeneralizedDefectSensitivityDeviationAlgorithmSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0068}, "FL", "LocalizedDeviationfromNormal", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0069}, "LO", "PatientReliabilityIndicator", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0070}, "FL", "VisualFieldMeanSensitivity", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0071}, "FL", "GlobalDeviationProbability", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0072}, "CS", "LocalDeviationProbabilityNormalsFlag", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0073}, "FL", "LocalizedDeviationProbability", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0074}, "CS", "ShortTermFluctuationCalculated", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0075}, "FL", "ShortTermFluctuation", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0076}, "CS", "ShortTermFluctuationProbabilityCalculated", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0077}, "FL", "ShortTermFluctuationProbability", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0078}, "CS", "CorrectedLocalizedDeviationFromNormalCalculated", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0079}, "FL", "CorrectedLocalizedDeviationFromNormal", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0080}, "CS", "CorrectedLocalizedDeviationFromNormalProbabilityCalculated", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0081}, "FL", "CorrectedLocalizedDeviationFromNormalProbability", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0083}, "SQ", "GlobalDeviationProbabilitySequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0085}, "SQ", "LocalizedDeviationProbabilitySequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0086}, "CS", "FovealSensitivityMeasured", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0087}, "FL", "FovealSensitivity", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0088}, "FL", "VisualFieldTestDuration", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0089}, "SQ", "VisualFieldTestPointSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0090}, "FL", "VisualFieldTestPointXCoordinate", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0091}, "FL", "VisualFieldTestPointYCoordinate", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0092}, "FL", "AgeCorrectedSensitivityDeviationValue", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0093}, "CS", "StimulusResults", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0094}, "FL", "SensitivityValue", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0095}, "CS", "RetestStimulusSeen", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0096}, "FL", "RetestSensitivityValue", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0097}, "SQ", "VisualFieldTestPointNormalsSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0098}, "FL", "QuantifiedDefect", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0100}, "FL", "AgeCorrectedSensitivityDeviationProbabilityValue", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0102}, "CS", "GeneralizedDefectCorrectedSensitivityDeviationFlag", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0103}, "FL", "GeneralizedDefectCorrectedSensitivityDeviationValue", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0104}, "FL", "GeneralizedDefectCorrectedSensitivityDeviationProbabilityValue", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0105}, "FL", "MinimumSensitivityValue", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0106}, "CS", "BlindSpotLocalized", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0107}, "FL", "BlindSpotXCoordinate", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0108}, "FL", "BlindSpotYCoordinate", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0110}, "SQ", "VisualAcuityMeasurementSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0112}, "SQ", "RefractiveParametersUsedOnPatientSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0113}, "CS", "MeasurementLaterality", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0114}, "SQ", "OphthalmicPatientClinicalInformationLeftEyeSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0115}, "SQ", "OphthalmicPatientClinicalInformationRightEyeSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0117}, "CS", "FovealPointNormativeDataFlag", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0118}, "FL", "FovealPointProbabilityValue", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0120}, "CS", "ScreeningBaselineMeasured", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0122}, "SQ", "ScreeningBaselineMeasuredSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0124}, "CS", "ScreeningBaselineType", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0126}, "FL", "ScreeningBaselineValue", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0202}, "LO", "AlgorithmSource", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0306}, "LO", "DataSetName", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0307}, "LO", "DataSetVersion", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0308}, "LO", "DataSetSource", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0309}, "LO", "DataSetDescription", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0317}, "SQ", "VisualFieldTestReliabilityGlobalIndexSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0320}, "SQ", "VisualFieldGlobalResultsIndexSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0325}, "SQ", "DataObservationSequence", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0338}, "CS", "IndexNormalsFlag", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0341}, "FL", "IndexProbability", "1", "DICOM_2011"},
+	{Tag{0x0024, 0x0344}, "SQ", "IndexProbabilitySequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0002}, "US", "SamplesPerPixel", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0003}, "US", "SamplesPerPixelUsed", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0004}, "CS", "PhotometricInterpretation", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0005}, "US", "ACR_NEMA_ImageDimensions", "1", "ACR/NEMA2"},
+	{Tag{0x0028, 0x0005}, "US", "RETIRED_ImageDimensions", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0006}, "US", "PlanarConfiguration", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0008}, "IS", "NumberOfFrames", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0009}, "AT", "FrameIncrementPointer", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x000A}, "AT", "FrameDimensionPointer", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x0010}, "US", "Rows", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0011}, "US", "Columns", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0012}, "US", "RETIRED_Planes", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0014}, "US", "UltrasoundColorDataPresent", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0030}, "DS", "PixelSpacing", "2", "DICOM_2011"},
+	{Tag{0x0028, 0x0031}, "DS", "ZoomFactor", "2", "DICOM_2011"},
+	{Tag{0x0028, 0x0032}, "DS", "ZoomCenter", "2", "DICOM_2011"},
+	{Tag{0x0028, 0x0034}, "IS", "PixelAspectRatio", "2", "DICOM_2011"},
+	{Tag{0x0028, 0x0040}, "CS", "ACR_NEMA_ImageFormat", "1", "ACR/NEMA2"},
+	{Tag{0x0028, 0x0040}, "CS", "RETIRED_ImageFormat", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0050}, "LO", "ACR_NEMA_ManipulatedImage", "1-n", "ACR/NEMA2"},
+	{Tag{0x0028, 0x0050}, "LO", "RETIRED_ManipulatedImage", "1-n", "DICOM/retired"},
+	{Tag{0x0028, 0x0051}, "CS", "CorrectedImage", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x005F}, "CS", "ACR_NEMA_2C_CompressionRecognitionCode", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x005F}, "LO", "RETIRED_CompressionRecognitionCode", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0060}, "CS", "ACR_NEMA_CompressionCode", "1", "ACR/NEMA2"},
+	{Tag{0x0028, 0x0060}, "CS", "RETIRED_CompressionCode", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0061}, "SH", "ACR_NEMA_2C_CompressionOriginator", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0061}, "SH", "RETIRED_CompressionOriginator", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0062}, "SH", "ACR_NEMA_2C_CompressionLabel", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0062}, "LO", "RETIRED_CompressionLabel", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0063}, "SH", "ACR_NEMA_2C_CompressionDescription", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0063}, "SH", "RETIRED_CompressionDescription", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0065}, "CS", "ACR_NEMA_2C_CompressionSequence", "1-n", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0065}, "CS", "RETIRED_CompressionSequence", "1-n", "DICOM/retired"},
+	{Tag{0x0028, 0x0066}, "AT", "ACR_NEMA_2C_CompressionStepPointers", "1-n", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0066}, "AT", "RETIRED_CompressionStepPointers", "1-n", "DICOM/retired"},
+	{Tag{0x0028, 0x0068}, "US", "ACR_NEMA_2C_RepeatInterval", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0068}, "US", "RETIRED_RepeatInterval", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0069}, "US", "ACR_NEMA_2C_BitsGrouped", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0069}, "US", "RETIRED_BitsGrouped", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0070}, "US", "ACR_NEMA_2C_PerimeterTable", "1-n", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0070}, "US", "RETIRED_PerimeterTable", "1-n", "DICOM/retired"},
+	{Tag{0x0028, 0x0071}, "XS", "ACR_NEMA_2C_PerimeterValue", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0071}, "XS", "RETIRED_PerimeterValue", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0080}, "US", "ACR_NEMA_2C_PredictorRows", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0080}, "US", "RETIRED_PredictorRows", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0081}, "US", "ACR_NEMA_2C_PredictorColumns", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0081}, "US", "RETIRED_PredictorColumns", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0082}, "US", "ACR_NEMA_2C_PredictorConstants", "1-n", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0082}, "US", "RETIRED_PredictorConstants", "1-n", "DICOM/retired"},
+	{Tag{0x0028, 0x0090}, "CS", "ACR_NEMA_2C_BlockedPixels", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0090}, "CS", "RETIRED_BlockedPixels", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0091}, "US", "ACR_NEMA_2C_BlockRows", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0091}, "US", "RETIRED_BlockRows", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0092}, "US", "ACR_NEMA_2C_BlockColumns", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0092}, "US", "RETIRED_BlockColumns", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0093}, "US", "ACR_NEMA_2C_RowOverlap", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0093}, "US", "RETIRED_RowOverlap", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0094}, "US", "ACR_NEMA_2C_ColumnOverlap", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0094}, "US", "RETIRED_ColumnOverlap", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0100}, "US", "BitsAllocated", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0101}, "US", "BitsStored", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0102}, "US", "HighBit", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0103}, "US", "PixelRepresentation", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0104}, "XS", "ACR_NEMA_SmallestValidPixelValue", "1", "ACR/NEMA2"},
+	{Tag{0x0028, 0x0104}, "XS", "RETIRED_SmallestValidPixelValue", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0105}, "XS", "ACR_NEMA_LargestValidPixelValue", "1", "ACR/NEMA2"},
+	{Tag{0x0028, 0x0105}, "XS", "RETIRED_LargestValidPixelValue", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0106}, "XS", "SmallestImagePixelValue", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0107}, "XS", "LargestImagePixelValue", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0108}, "XS", "SmallestPixelValueInSeries", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0109}, "XS", "LargestPixelValueInSeries", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0110}, "XS", "RETIRED_SmallestImagePixelValueInPlane", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0111}, "XS", "RETIRED_LargestImagePixelValueInPlane", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0120}, "XS", "PixelPaddingValue", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0121}, "XS", "PixelPaddingRangeLimit", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0200}, "US", "ACR_NEMA_ImageLocation", "1", "ACR/NEMA2"},
+	{Tag{0x0028, 0x0200}, "US", "RETIRED_ImageLocation", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0300}, "CS", "QualityControlImage", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0301}, "CS", "BurnedInAnnotation", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0302}, "CS", "RecognizableVisualFeatures", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0303}, "CS", "LongitudinalTemporalInformationModified", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0304}, "UI", "ReferencedColorPaletteInstanceUID", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0400}, "CS", "ACR_NEMA_2C_TransformLabel", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0400}, "LO", "RETIRED_TransformLabel", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0401}, "CS", "ACR_NEMA_2C_TransformVersionNumber", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0401}, "LO", "RETIRED_TransformVersionNumber", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0402}, "US", "ACR_NEMA_2C_NumberOfTransformSteps", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0402}, "US", "RETIRED_NumberOfTransformSteps", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0403}, "CS", "ACR_NEMA_2C_SequenceOfCompressedData", "1-n", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0403}, "LO", "RETIRED_SequenceOfCompressedData", "1-n", "DICOM/retired"},
+	{Tag{0x0028, 0x0404}, "AT", "ACR_NEMA_2C_DetailsOfCoefficients", "1-n", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0404}, "AT", "RETIRED_DetailsOfCoefficients", "1-n", "DICOM/retired"},
+	{Tag{0x0028, 0x0410}, "US", "ACR_NEMA_2C_RowsForNthOrderCoefficients", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0411}, "US", "ACR_NEMA_2C_ColumnsForNthOrderCoefficients", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0412}, "CS", "ACR_NEMA_2C_CoefficientCoding", "1-n", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0413}, "AT", "ACR_NEMA_2C_CoefficientCodingPointers", "1-n", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0700}, "CS", "ACR_NEMA_2C_DCTLabel", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0700}, "LO", "RETIRED_DCTLabel", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0701}, "CS", "ACR_NEMA_2C_DataBlockDescription", "1-n", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0701}, "CS", "RETIRED_DataBlockDescription", "1-n", "DICOM/retired"},
+	{Tag{0x0028, 0x0702}, "AT", "ACR_NEMA_2C_DataBlock", "1-n", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0702}, "AT", "RETIRED_DataBlock", "1-n", "DICOM/retired"},
+	{Tag{0x0028, 0x0710}, "US", "ACR_NEMA_2C_NormalizationFactorFormat", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0710}, "US", "RETIRED_NormalizationFactorFormat", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0720}, "US", "ACR_NEMA_2C_ZonalMapNumberFormat", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0720}, "US", "RETIRED_ZonalMapNumberFormat", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0721}, "AT", "ACR_NEMA_2C_ZonalMapLocation", "1-n", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0721}, "AT", "RETIRED_ZonalMapLocation", "1-n", "DICOM/retired"},
+	{Tag{0x0028, 0x0722}, "US", "ACR_NEMA_2C_ZonalMapFormat", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0722}, "US", "RETIRED_ZonalMapFormat", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0730}, "US", "ACR_NEMA_2C_AdaptiveMapFormat", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0730}, "US", "RETIRED_AdaptiveMapFormat", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0740}, "US", "ACR_NEMA_2C_CodeNumberFormat", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0740}, "US", "RETIRED_CodeNumberFormat", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x0800}, "CS", "ACR_NEMA_2C_CodeLabel", "1-n", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0802}, "US", "ACR_NEMA_2C_NumberOfTables", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0803}, "AT", "ACR_NEMA_2C_CodeTableLocation", "1-n", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0804}, "US", "ACR_NEMA_2C_BitsForCodeWord", "1", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0808}, "AT", "ACR_NEMA_2C_ImageDataLocation", "1-n", "ACR/NEMA2C"},
+	{Tag{0x0028, 0x0A02}, "CS", "PixelSpacingCalibrationType", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x0A04}, "LO", "PixelSpacingCalibrationDescription", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1040}, "CS", "PixelIntensityRelationship", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1041}, "SS", "PixelIntensityRelationshipSign", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1050}, "DS", "WindowCenter", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x1051}, "DS", "WindowWidth", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x1052}, "DS", "RescaleIntercept", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1053}, "DS", "RescaleSlope", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1054}, "LO", "RescaleType", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1055}, "LO", "WindowCenterWidthExplanation", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x1056}, "CS", "VOILUTFunction", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1080}, "CS", "ACR_NEMA_GrayScale", "1", "ACR/NEMA2"},
+	{Tag{0x0028, 0x1080}, "CS", "RETIRED_GrayScale", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x1090}, "CS", "RecommendedViewingMode", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1100}, "XS", "ACR_NEMA_GrayLookupTableDescriptor", "3", "ACR/NEMA2"},
+	{Tag{0x0028, 0x1100}, "XS", "RETIRED_GrayLookupTableDescriptor", "3", "DICOM/retired"},
+	{Tag{0x0028, 0x1101}, "XS", "RedPaletteColorLookupTableDescriptor", "3", "DICOM_2011"},
+	{Tag{0x0028, 0x1102}, "XS", "GreenPaletteColorLookupTableDescriptor", "3", "DICOM_2011"},
+	{Tag{0x0028, 0x1103}, "XS", "BluePaletteColorLookupTableDescriptor", "3", "DICOM_2011"},
+	{Tag{0x0028, 0x1104}, "US", "AlphaPaletteColorLookupTableDescriptor", "3", "DICOM_2011"},
+	{Tag{0x0028, 0x1111}, "XS", "RETIRED_LargeRedPaletteColorLookupTableDescriptor", "4", "DICOM/retired"},
+	{Tag{0x0028, 0x1112}, "XS", "RETIRED_LargeGreenPaletteColorLookupTableDescriptor", "4", "DICOM/retired"},
+	{Tag{0x0028, 0x1113}, "XS", "RETIRED_LargeBluePaletteColorLookupTableDescriptor", "4", "DICOM/retired"},
+	{Tag{0x0028, 0x1199}, "UI", "PaletteColorLookupTableUID", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1200}, "XS", "ACR_NEMA_GrayLookupTableData", "1-n", "ACR/NEMA2"},
+	{Tag{0x0028, 0x1200}, "LT", "RETIRED_GrayLookupTableData", "1-n", "DICOM/retired"},
+	{Tag{0x0028, 0x1201}, "OW", "RedPaletteColorLookupTableData", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1202}, "OW", "GreenPaletteColorLookupTableData", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1203}, "OW", "BluePaletteColorLookupTableData", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1204}, "OW", "AlphaPaletteColorLookupTableData", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1211}, "OW", "RETIRED_LargeRedPaletteColorLookupTableData", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x1212}, "OW", "RETIRED_LargeGreenPaletteColorLookupTableData", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x1213}, "OW", "RETIRED_LargeBluePaletteColorLookupTableData", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x1214}, "UI", "RETIRED_LargePaletteColorLookupTableUID", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x1221}, "OW", "SegmentedRedPaletteColorLookupTableData", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1222}, "OW", "SegmentedGreenPaletteColorLookupTableData", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1223}, "OW", "SegmentedBluePaletteColorLookupTableData", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1300}, "CS", "BreastImplantPresent", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1350}, "CS", "PartialView", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1351}, "ST", "PartialViewDescription", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1352}, "SQ", "PartialViewCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x135A}, "CS", "SpatialLocationsPreserved", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1401}, "SQ", "DataFrameAssignmentSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1402}, "CS", "DataPathAssignment", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1403}, "US", "BitsMappedToColorLookupTable", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1404}, "SQ", "BlendingLUT1Sequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1405}, "CS", "BlendingLUT1TransferFunction", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1406}, "FD", "BlendingWeightConstant", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1407}, "US", "BlendingLookupTableDescriptor", "3", "DICOM_2011"},
+	{Tag{0x0028, 0x1408}, "OW", "BlendingLookupTableData", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x140B}, "SQ", "EnhancedPaletteColorLookupTableSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x140C}, "SQ", "BlendingLUT2Sequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x140D}, "CS", "BlendingLUT2TransferFunction", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x140E}, "CS", "DataPathID", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x140F}, "CS", "RGBLUTTransferFunction", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x1410}, "CS", "AlphaLUTTransferFunction", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x2000}, "OB", "ICCProfile", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x2110}, "CS", "LossyImageCompression", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x2112}, "DS", "LossyImageCompressionRatio", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x2114}, "CS", "LossyImageCompressionMethod", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x3000}, "SQ", "ModalityLUTSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x3002}, "XS", "LUTDescriptor", "3", "DICOM_2011"},
+	{Tag{0x0028, 0x3003}, "LO", "LUTExplanation", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x3004}, "LO", "ModalityLUTType", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x3006}, "LT", "LUTData", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x3010}, "SQ", "VOILUTSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x3110}, "SQ", "SoftcopyVOILUTSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x4000}, "LT", "ACR_NEMA_ImagePresentationComments", "1-n", "ACR/NEMA2"},
+	{Tag{0x0028, 0x4000}, "LT", "RETIRED_ImagePresentationComments", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x5000}, "SQ", "RETIRED_BiPlaneAcquisitionSequence", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x6010}, "US", "RepresentativeFrameNumber", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x6020}, "US", "FrameNumbersOfInterest", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x6022}, "LO", "FrameOfInterestDescription", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x6023}, "CS", "FrameOfInterestType", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x6030}, "US", "RETIRED_MaskPointers", "1-n", "DICOM/retired"},
+	{Tag{0x0028, 0x6040}, "US", "RWavePointer", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x6100}, "SQ", "MaskSubtractionSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x6101}, "CS", "MaskOperation", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x6102}, "US", "ApplicableFrameRange", "2-2n", "DICOM_2011"},
+	{Tag{0x0028, 0x6110}, "US", "MaskFrameNumbers", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x6112}, "US", "ContrastFrameAveraging", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x6114}, "FL", "MaskSubPixelShift", "2", "DICOM_2011"},
+	{Tag{0x0028, 0x6120}, "SS", "TIDOffset", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x6190}, "ST", "MaskOperationExplanation", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x7FE0}, "UT", "PixelDataProviderURL", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9001}, "UL", "DataPointRows", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9002}, "UL", "DataPointColumns", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9003}, "CS", "SignalDomainColumns", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9099}, "US", "RETIRED_LargestMonochromePixelValue", "1", "DICOM/retired"},
+	{Tag{0x0028, 0x9108}, "CS", "DataRepresentation", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9110}, "SQ", "PixelMeasuresSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9132}, "SQ", "FrameVOILUTSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9145}, "SQ", "PixelValueTransformationSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9235}, "CS", "SignalDomainRows", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9411}, "FL", "DisplayFilterPercentage", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9415}, "SQ", "FramePixelShiftSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9416}, "US", "SubtractionItemID", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9422}, "SQ", "PixelIntensityRelationshipLUTSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9443}, "SQ", "FramePixelDataPropertiesSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9444}, "CS", "GeometricalProperties", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9445}, "FL", "GeometricMaximumDistortion", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9446}, "CS", "ImageProcessingApplied", "1-n", "DICOM_2011"},
+	{Tag{0x0028, 0x9454}, "CS", "MaskSelectionMode", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9474}, "CS", "LUTFunction", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9478}, "FL", "MaskVisibilityPercentage", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9501}, "SQ", "PixelShiftSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9502}, "SQ", "RegionPixelShiftSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9503}, "SS", "VerticesOfTheRegion", "2-2n", "DICOM_2011"},
+	{Tag{0x0028, 0x9505}, "SQ", "MultiFramePresentationSequence", "1", "DICOM_2011"},
+	{Tag{0x0028, 0x9506}, "US", "PixelShiftFrameRange", "2-2n", "DICOM_2011"},
+	{Tag{0x0028, 0x9507}, "US", "LUTFrameRange", "2-2n", "DICOM_2011"},
+	{Tag{0x0028, 0x9520}, "DS", "ImageToEquipmentMappingMatrix", "16", "DICOM_2011"},
+	{Tag{0x0028, 0x9537}, "CS", "EquipmentCoordinateSystemIdentification", "1", "DICOM_2011"},
+	{Tag{0x0032, 0x000A}, "CS", "RETIRED_StudyStatusID", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x000C}, "CS", "RETIRED_StudyPriorityID", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x0012}, "LO", "RETIRED_StudyIDIssuer", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x0032}, "DA", "RETIRED_StudyVerifiedDate", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x0033}, "TM", "RETIRED_StudyVerifiedTime", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x0034}, "DA", "RETIRED_StudyReadDate", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x0035}, "TM", "RETIRED_StudyReadTime", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x1000}, "DA", "RETIRED_ScheduledStudyStartDate", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x1001}, "TM", "RETIRED_ScheduledStudyStartTime", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x1010}, "DA", "RETIRED_ScheduledStudyStopDate", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x1011}, "TM", "RETIRED_ScheduledStudyStopTime", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x1020}, "LO", "RETIRED_ScheduledStudyLocation", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x1021}, "AE", "RETIRED_ScheduledStudyLocationAETitle", "1-n", "DICOM/retired"},
+	{Tag{0x0032, 0x1030}, "LO", "RETIRED_ReasonForStudy", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x1031}, "SQ", "RequestingPhysicianIdentificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0032, 0x1032}, "PN", "RequestingPhysician", "1", "DICOM_2011"},
+	{Tag{0x0032, 0x1033}, "LO", "RequestingService", "1", "DICOM_2011"},
+	{Tag{0x0032, 0x1034}, "SQ", "RequestingServiceCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0032, 0x1040}, "DA", "RETIRED_StudyArrivalDate", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x1041}, "TM", "RETIRED_StudyArrivalTime", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x1050}, "DA", "RETIRED_StudyCompletionDate", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x1051}, "TM", "RETIRED_StudyCompletionTime", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x1055}, "CS", "RETIRED_StudyComponentStatusID", "1", "DICOM/retired"},
+	{Tag{0x0032, 0x1060}, "LO", "RequestedProcedureDescription", "1", "DICOM_2011"},
+	{Tag{0x0032, 0x1064}, "SQ", "RequestedProcedureCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0032, 0x1070}, "LO", "RequestedContrastAgent", "1", "DICOM_2011"},
+	{Tag{0x0032, 0x4000}, "LT", "RETIRED_StudyComments", "1", "DICOM/retired"},
+	{Tag{0x0038, 0x0004}, "SQ", "ReferencedPatientAliasSequence", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x0008}, "CS", "VisitStatusID", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x0010}, "LO", "AdmissionID", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x0011}, "LO", "RETIRED_IssuerOfAdmissionID", "1", "DICOM/retired"},
+	{Tag{0x0038, 0x0014}, "SQ", "IssuerOfAdmissionIDSequence", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x0016}, "LO", "RouteOfAdmissions", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x001A}, "DA", "RETIRED_ScheduledAdmissionDate", "1", "DICOM/retired"},
+	{Tag{0x0038, 0x001B}, "TM", "RETIRED_ScheduledAdmissionTime", "1", "DICOM/retired"},
+	{Tag{0x0038, 0x001C}, "DA", "RETIRED_ScheduledDischargeDate", "1", "DICOM/retired"},
+	{Tag{0x0038, 0x001D}, "TM", "RETIRED_ScheduledDischargeTime", "1", "DICOM/retired"},
+	{Tag{0x0038, 0x001E}, "LO", "RETIRED_ScheduledPatientInstitutionResidence", "1", "DICOM/retired"},
+	{Tag{0x0038, 0x0020}, "DA", "AdmittingDate", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x0021}, "TM", "AdmittingTime", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x0030}, "DA", "RETIRED_DischargeDate", "1", "DICOM/retired"},
+	{Tag{0x0038, 0x0032}, "TM", "RETIRED_DischargeTime", "1", "DICOM/retired"},
+	{Tag{0x0038, 0x0040}, "LO", "RETIRED_DischargeDiagnosisDescription", "1", "DICOM/retired"},
+	{Tag{0x0038, 0x0044}, "SQ", "RETIRED_DischargeDiagnosisCodeSequence", "1", "DICOM/retired"},
+	{Tag{0x0038, 0x0050}, "LO", "SpecialNeeds", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x0060}, "LO", "ServiceEpisodeID", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x0061}, "LO", "RETIRED_IssuerOfServiceEpisodeID", "1", "DICOM/retired"},
+	{Tag{0x0038, 0x0062}, "LO", "ServiceEpisodeDescription", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x0064}, "SQ", "IssuerOfServiceEpisodeIDSequence", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x0100}, "SQ", "PertinentDocumentsSequence", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x0300}, "LO", "CurrentPatientLocation", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x0400}, "LO", "PatientInstitutionResidence", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x0500}, "LO", "PatientState", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x0502}, "SQ", "PatientClinicalTrialParticipationSequence", "1", "DICOM_2011"},
+	{Tag{0x0038, 0x4000}, "LT", "VisitComments", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0004}, "CS", "WaveformOriginality", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0005}, "US", "NumberOfWaveformChannels", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0010}, "UL", "NumberOfWaveformSamples", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x001A}, "DS", "SamplingFrequency", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0020}, "SH", "MultiplexGroupLabel", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0200}, "SQ", "ChannelDefinitionSequence", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0202}, "IS", "WaveformChannelNumber", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0203}, "SH", "ChannelLabel", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0205}, "CS", "ChannelStatus", "1-n", "DICOM_2011"},
+	{Tag{0x003A, 0x0208}, "SQ", "ChannelSourceSequence", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0209}, "SQ", "ChannelSourceModifiersSequence", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x020A}, "SQ", "SourceWaveformSequence", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x020C}, "LO", "ChannelDerivationDescription", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0210}, "DS", "ChannelSensitivity", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0211}, "SQ", "ChannelSensitivityUnitsSequence", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0212}, "DS", "ChannelSensitivityCorrectionFactor", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0213}, "DS", "ChannelBaseline", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0214}, "DS", "ChannelTimeSkew", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0215}, "DS", "ChannelSampleSkew", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0218}, "DS", "ChannelOffset", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x021A}, "US", "WaveformBitsStored", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0220}, "DS", "FilterLowFrequency", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0221}, "DS", "FilterHighFrequency", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0222}, "DS", "NotchFilterFrequency", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0223}, "DS", "NotchFilterBandwidth", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0230}, "FL", "WaveformDataDisplayScale", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0231}, "US", "WaveformDisplayBackgroundCIELabValue", "3", "DICOM_2011"},
+	{Tag{0x003A, 0x0240}, "SQ", "WaveformPresentationGroupSequence", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0241}, "US", "PresentationGroupNumber", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0242}, "SQ", "ChannelDisplaySequence", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0244}, "US", "ChannelRecommendedDisplayCIELabValue", "3", "DICOM_2011"},
+	{Tag{0x003A, 0x0245}, "FL", "ChannelPosition", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0246}, "CS", "DisplayShadingFlag", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0247}, "FL", "FractionalChannelDisplayScale", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0248}, "FL", "AbsoluteChannelDisplayScale", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0300}, "SQ", "MultiplexedAudioChannelsDescriptionCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0301}, "IS", "ChannelIdentificationCode", "1", "DICOM_2011"},
+	{Tag{0x003A, 0x0302}, "CS", "ChannelMode", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0001}, "AE", "ScheduledStationAETitle", "1-n", "DICOM_2011"},
+	{Tag{0x0040, 0x0002}, "DA", "ScheduledProcedureStepStartDate", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0003}, "TM", "ScheduledProcedureStepStartTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0004}, "DA", "ScheduledProcedureStepEndDate", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0005}, "TM", "ScheduledProcedureStepEndTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0006}, "PN", "ScheduledPerformingPhysicianName", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0007}, "LO", "ScheduledProcedureStepDescription", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0008}, "SQ", "ScheduledProtocolCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0009}, "SH", "ScheduledProcedureStepID", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x000A}, "SQ", "StageCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x000B}, "SQ", "ScheduledPerformingPhysicianIdentificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0010}, "SH", "ScheduledStationName", "1-n", "DICOM_2011"},
+	{Tag{0x0040, 0x0011}, "SH", "ScheduledProcedureStepLocation", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0012}, "LO", "PreMedication", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0020}, "CS", "ScheduledProcedureStepStatus", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0026}, "SQ", "OrderPlacerIdentifierSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0027}, "SQ", "OrderFillerIdentifierSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0031}, "UT", "LocalNamespaceEntityID", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0032}, "UT", "UniversalEntityID", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0033}, "CS", "UniversalEntityIDType", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0035}, "CS", "IdentifierTypeCode", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0036}, "SQ", "AssigningFacilitySequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0039}, "SQ", "AssigningJurisdictionCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x003A}, "SQ", "AssigningAgencyOrDepartmentCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0100}, "SQ", "ScheduledProcedureStepSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0220}, "SQ", "ReferencedNonImageCompositeSOPInstanceSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0241}, "AE", "PerformedStationAETitle", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0242}, "SH", "PerformedStationName", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0243}, "SH", "PerformedLocation", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0244}, "DA", "PerformedProcedureStepStartDate", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0245}, "TM", "PerformedProcedureStepStartTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0250}, "DA", "PerformedProcedureStepEndDate", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0251}, "TM", "PerformedProcedureStepEndTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0252}, "CS", "PerformedProcedureStepStatus", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0253}, "SH", "PerformedProcedureStepID", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0254}, "LO", "PerformedProcedureStepDescription", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0255}, "LO", "PerformedProcedureTypeDescription", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0260}, "SQ", "PerformedProtocolCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0261}, "CS", "PerformedProtocolType", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0270}, "SQ", "ScheduledStepAttributesSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0275}, "SQ", "RequestAttributesSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0280}, "ST", "CommentsOnThePerformedProcedureStep", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0281}, "SQ", "PerformedProcedureStepDiscontinuationReasonCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0293}, "SQ", "QuantitySequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0294}, "DS", "Quantity", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0295}, "SQ", "MeasuringUnitsSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0296}, "SQ", "BillingItemSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0300}, "US", "TotalTimeOfFluoroscopy", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0301}, "US", "TotalNumberOfExposures", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0302}, "US", "EntranceDose", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0303}, "US", "ExposedArea", "1-2", "DICOM_2011"},
+	{Tag{0x0040, 0x0306}, "DS", "DistanceSourceToEntrance", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0307}, "DS", "RETIRED_DistanceSourceToSupport", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x030E}, "SQ", "ExposureDoseSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0310}, "ST", "CommentsOnRadiationDose", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0312}, "DS", "XRayOutput", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0314}, "DS", "HalfValueLayer", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0316}, "DS", "OrganDose", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0318}, "CS", "OrganExposed", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0320}, "SQ", "BillingProcedureStepSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0321}, "SQ", "FilmConsumptionSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0324}, "SQ", "BillingSuppliesAndDevicesSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0330}, "SQ", "RETIRED_ReferencedProcedureStepSequence", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x0340}, "SQ", "PerformedSeriesSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0400}, "LT", "CommentsOnTheScheduledProcedureStep", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0440}, "SQ", "ProtocolContextSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0441}, "SQ", "ContentItemModifierSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0500}, "SQ", "ScheduledSpecimenSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x050A}, "LO", "RETIRED_SpecimenAccessionNumber", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x0512}, "LO", "ContainerIdentifier", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0513}, "SQ", "IssuerOfTheContainerIdentifierSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0515}, "SQ", "AlternateContainerIdentifierSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0518}, "SQ", "ContainerTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x051A}, "LO", "ContainerDescription", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0520}, "SQ", "ContainerComponentSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0550}, "SQ", "RETIRED_SpecimenSequence", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x0551}, "LO", "SpecimenIdentifier", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0552}, "SQ", "RETIRED_SpecimenDescriptionSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x0553}, "ST", "RETIRED_SpecimenDescriptionTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x0554}, "UI", "SpecimenUID", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0555}, "SQ", "AcquisitionContextSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0556}, "ST", "AcquisitionContextDescription", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0560}, "SQ", "SpecimenDescriptionSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0562}, "SQ", "IssuerOfTheSpecimenIdentifierSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x059A}, "SQ", "SpecimenTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0600}, "LO", "SpecimenShortDescription", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0602}, "UT", "SpecimenDetailedDescription", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0610}, "SQ", "SpecimenPreparationSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0612}, "SQ", "SpecimenPreparationStepContentItemSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x0620}, "SQ", "SpecimenLocalizationContentItemSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x06FA}, "LO", "RETIRED_SlideIdentifier", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x071A}, "SQ", "ImageCenterPointCoordinatesSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x072A}, "DS", "XOffsetInSlideCoordinateSystem", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x073A}, "DS", "YOffsetInSlideCoordinateSystem", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x074A}, "DS", "ZOffsetInSlideCoordinateSystem", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x08D8}, "SQ", "RETIRED_PixelSpacingSequence", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x08DA}, "SQ", "RETIRED_CoordinateSystemAxisCodeSequence", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x08EA}, "SQ", "MeasurementUnitsCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x09F8}, "SQ", "RETIRED_VitalStainCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x1001}, "SH", "RequestedProcedureID", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x1002}, "LO", "ReasonForTheRequestedProcedure", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x1003}, "SH", "RequestedProcedurePriority", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x1004}, "LO", "PatientTransportArrangements", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x1005}, "LO", "RequestedProcedureLocation", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x1006}, "SH", "RETIRED_PlacerOrderNumberProcedure", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x1007}, "SH", "RETIRED_FillerOrderNumberProcedure", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x1008}, "LO", "ConfidentialityCode", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x1009}, "SH", "ReportingPriority", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x100A}, "SQ", "ReasonForRequestedProcedureCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x1010}, "PN", "NamesOfIntendedRecipientsOfResults", "1-n", "DICOM_2011"},
+	{Tag{0x0040, 0x1011}, "SQ", "IntendedRecipientsOfResultsIdentificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x1012}, "SQ", "ReasonForPerformedProcedureCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x1060}, "LO", "RETIRED_RequestedProcedureDescriptionTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x1101}, "SQ", "PersonIdentificationCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x1102}, "ST", "PersonAddress", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x1103}, "LO", "PersonTelephoneNumbers", "1-n", "DICOM_2011"},
+	{Tag{0x0040, 0x1400}, "LT", "RequestedProcedureComments", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x2001}, "LO", "RETIRED_ReasonForTheImagingServiceRequest", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x2004}, "DA", "IssueDateOfImagingServiceRequest", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x2005}, "TM", "IssueTimeOfImagingServiceRequest", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x2006}, "SH", "RETIRED_PlacerOrderNumberImagingServiceRequestRetired", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x2007}, "SH", "RETIRED_FillerOrderNumberImagingServiceRequestRetired", "1", "DICOM/retired"},
+	{Tag{0x0040, 0x2008}, "PN", "OrderEnteredBy", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x2009}, "SH", "OrderEntererLocation", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x2010}, "SH", "OrderCallbackPhoneNumber", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x2016}, "LO", "PlacerOrderNumberImagingServiceRequest", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x2017}, "LO", "FillerOrderNumberImagingServiceRequest", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x2400}, "LT", "ImagingServiceRequestComments", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x3001}, "LO", "ConfidentialityConstraintOnPatientDataDescription", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4001}, "CS", "GeneralPurposeScheduledProcedureStepStatus", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4001}, "CS", "RETIRED_GeneralPurposeScheduledProcedureStepStatus", "1", "Supplement_158/retired"},
+	{Tag{0x0040, 0x4002}, "CS", "GeneralPurposePerformedProcedureStepStatus", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4002}, "CS", "RETIRED_GeneralPurposePerformedProcedureStepStatus", "1", "Supplement_158/retired"},
+	{Tag{0x0040, 0x4003}, "CS", "GeneralPurposeScheduledProcedureStepPriority", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4003}, "CS", "RETIRED_GeneralPurposeScheduledProcedureStepPriority", "1", "Supplement_158/retired"},
+	{Tag{0x0040, 0x4004}, "SQ", "ScheduledProcessingApplicationsCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4004}, "SQ", "RETIRED_ScheduledProcessingApplicationsCodeSequence", "1", "Supplement_158/retired"},
+	{Tag{0x0040, 0x4005}, "DT", "ScheduledProcedureStepStartDateTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4006}, "CS", "MultipleCopiesFlag", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4006}, "CS", "RETIRED_MultipleCopiesFlag", "1", "Supplement_158/retired"},
+	{Tag{0x0040, 0x4007}, "SQ", "PerformedProcessingApplicationsCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4009}, "SQ", "HumanPerformerCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4010}, "DT", "ScheduledProcedureStepModificationDateTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4011}, "DT", "ExpectedCompletionDateTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4015}, "SQ", "ResultingGeneralPurposePerformedProcedureStepsSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4015}, "SQ", "RETIRED_ResultingGeneralPurposePerformedProcedureStepsSequence", "1", "Supplement_158/retired"},
+	{Tag{0x0040, 0x4016}, "SQ", "ReferencedGeneralPurposeScheduledProcedureStepSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4016}, "SQ", "RETIRED_ReferencedGeneralPurposeScheduledProcedureStepSequence", "1", "Supplement_158/retired"},
+	{Tag{0x0040, 0x4018}, "SQ", "ScheduledWorkitemCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4019}, "SQ", "PerformedWorkitemCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4020}, "CS", "InputAvailabilityFlag", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4021}, "SQ", "InputInformationSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4022}, "SQ", "RelevantInformationSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4022}, "SQ", "RETIRED_RelevantInformationSequence", "1", "Supplement_158/retired"},
+	{Tag{0x0040, 0x4023}, "UI", "ReferencedGeneralPurposeScheduledProcedureStepTransactionUID", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4023}, "UI", "RETIRED_ReferencedGeneralPurposeScheduledProcedureStepTransactionUID", "1", "Supplement_158/retired"},
+	{Tag{0x0040, 0x4025}, "SQ", "ScheduledStationNameCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4026}, "SQ", "ScheduledStationClassCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4027}, "SQ", "ScheduledStationGeographicLocationCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4028}, "SQ", "PerformedStationNameCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4029}, "SQ", "PerformedStationClassCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4030}, "SQ", "PerformedStationGeographicLocationCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4031}, "SQ", "RequestedSubsequentWorkitemCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4031}, "SQ", "RETIRED_RequestedSubsequentWorkitemCodeSequence", "1", "Supplement_158/retired"},
+	{Tag{0x0040, 0x4032}, "SQ", "NonDICOMOutputCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4032}, "SQ", "RETIRED_NonDICOMOutputCodeSequence", "1", "Supplement_158/retired"},
+	{Tag{0x0040, 0x4033}, "SQ", "OutputInformationSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4034}, "SQ", "ScheduledHumanPerformersSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4035}, "SQ", "ActualHumanPerformersSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4036}, "LO", "HumanPerformerOrganization", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4037}, "PN", "HumanPerformerName", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4040}, "CS", "RawDataHandling", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4041}, "CS", "InputReadinessState", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4050}, "DT", "PerformedProcedureStepStartDateTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4051}, "DT", "PerformedProcedureStepEndDateTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x4052}, "DT", "ProcedureStepCancellationDateTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x8302}, "DS", "EntranceDoseInmGy", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x9094}, "SQ", "ReferencedImageRealWorldValueMappingSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x9096}, "SQ", "RealWorldValueMappingSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x9098}, "SQ", "PixelValueMappingCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x9210}, "SH", "LUTLabel", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x9211}, "XS", "RealWorldValueLastValueMapped", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x9212}, "FD", "RealWorldValueLUTData", "1-n", "DICOM_2011"},
+	{Tag{0x0040, 0x9216}, "XS", "RealWorldValueFirstValueMapped", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x9224}, "FD", "RealWorldValueIntercept", "1", "DICOM_2011"},
+	{Tag{0x0040, 0x9225}, "FD", "RealWorldValueSlope", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA007}, "CS", "RETIRED_FindingsFlagTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA010}, "CS", "RelationshipType", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA020}, "SQ", "RETIRED_FindingsSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA021}, "UI", "RETIRED_FindingsGroupUIDTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA022}, "UI", "RETIRED_ReferencedFindingsGroupUIDTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA023}, "DA", "RETIRED_FindingsGroupRecordingDateTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA024}, "TM", "RETIRED_FindingsGroupRecordingTimeTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA026}, "SQ", "RETIRED_FindingsSourceCategoryCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA027}, "LO", "VerifyingOrganization", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA028}, "SQ", "RETIRED_DocumentingOrganizationIdentifierCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA030}, "DT", "VerificationDateTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA032}, "DT", "ObservationDateTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA040}, "CS", "ValueType", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA043}, "SQ", "ConceptNameCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA047}, "LO", "RETIRED_MeasurementPrecisionDescriptionTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA050}, "CS", "ContinuityOfContent", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA057}, "CS", "RETIRED_UrgencyOrPriorityAlertsTrial", "1-n", "DICOM/retired"},
+	{Tag{0x0040, 0xA060}, "LO", "RETIRED_SequencingIndicatorTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA066}, "SQ", "RETIRED_DocumentIdentifierCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA067}, "PN", "RETIRED_DocumentAuthorTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA068}, "SQ", "RETIRED_DocumentAuthorIdentifierCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA070}, "SQ", "RETIRED_IdentifierCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA073}, "SQ", "VerifyingObserverSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA074}, "OB", "RETIRED_ObjectBinaryIdentifierTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA075}, "PN", "VerifyingObserverName", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA076}, "SQ", "RETIRED_DocumentingObserverIdentifierCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA078}, "SQ", "AuthorObserverSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA07A}, "SQ", "ParticipantSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA07C}, "SQ", "CustodialOrganizationSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA080}, "CS", "ParticipationType", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA082}, "DT", "ParticipationDateTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA084}, "CS", "ObserverType", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA085}, "SQ", "RETIRED_ProcedureIdentifierCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA088}, "SQ", "VerifyingObserverIdentificationCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA089}, "OB", "RETIRED_ObjectDirectoryBinaryIdentifierTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA090}, "SQ", "RETIRED_EquivalentCDADocumentSequence", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA0B0}, "US", "ReferencedWaveformChannels", "2-2n", "DICOM_2011"},
+	{Tag{0x0040, 0xA110}, "DA", "RETIRED_DateOfDocumentOrVerbalTransactionTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA112}, "TM", "RETIRED_TimeOfDocumentCreationOrVerbalTransactionTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA120}, "DT", "DateTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA121}, "DA", "Date", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA122}, "TM", "Time", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA123}, "PN", "PersonName", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA124}, "UI", "UID", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA125}, "CS", "RETIRED_ReportStatusIDTrial", "2", "DICOM/retired"},
+	{Tag{0x0040, 0xA130}, "CS", "TemporalRangeType", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA132}, "UL", "ReferencedSamplePositions", "1-n", "DICOM_2011"},
+	{Tag{0x0040, 0xA136}, "US", "ReferencedFrameNumbers", "1-n", "DICOM_2011"},
+	{Tag{0x0040, 0xA138}, "DS", "ReferencedTimeOffsets", "1-n", "DICOM_2011"},
+	{Tag{0x0040, 0xA13A}, "DT", "ReferencedDateTime", "1-n", "DICOM_2011"},
+	{Tag{0x0040, 0xA160}, "UT", "TextValue", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA161}, "FD", "FloatingPointValue", "1-n", "CP_1064"},
+	{Tag{0x0040, 0xA162}, "SL", "RationalNumeratorValue", "1-n", "CP_1064"},
+	{Tag{0x0040, 0xA163}, "UL", "RationalDenominatorValue", "1-n", "CP_1064"},
+	{Tag{0x0040, 0xA167}, "SQ", "RETIRED_ObservationCategoryCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA168}, "SQ", "ConceptCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA16A}, "ST", "RETIRED_BibliographicCitationTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA170}, "SQ", "PurposeOfReferenceCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA171}, "UI", "ObservationUID", "1", "CP_1147"},
+	{Tag{0x0040, 0xA172}, "UI", "RETIRED_ReferencedObservationUIDTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA173}, "CS", "RETIRED_ReferencedObservationClassTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA174}, "CS", "RETIRED_ReferencedObjectObservationClassTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA180}, "US", "AnnotationGroupNumber", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA192}, "DA", "RETIRED_ObservationDateTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA193}, "TM", "RETIRED_ObservationTimeTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA194}, "CS", "RETIRED_MeasurementAutomationTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA195}, "SQ", "ModifierCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA224}, "ST", "RETIRED_IdentificationDescriptionTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA290}, "CS", "RETIRED_CoordinatesSetGeometricTypeTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA296}, "SQ", "RETIRED_AlgorithmCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA297}, "ST", "RETIRED_AlgorithmDescriptionTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA29A}, "SL", "RETIRED_PixelCoordinatesSetTrial", "2-2n", "DICOM/retired"},
+	{Tag{0x0040, 0xA300}, "SQ", "MeasuredValueSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA301}, "SQ", "NumericValueQualifierCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA307}, "PN", "RETIRED_CurrentObserverTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA30A}, "DS", "NumericValue", "1-n", "DICOM_2011"},
+	{Tag{0x0040, 0xA313}, "SQ", "RETIRED_ReferencedAccessionSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA33A}, "ST", "RETIRED_ReportStatusCommentTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA340}, "SQ", "RETIRED_ProcedureContextSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA352}, "PN", "RETIRED_VerbalSourceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA353}, "ST", "RETIRED_AddressTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA354}, "LO", "RETIRED_TelephoneNumberTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA358}, "SQ", "RETIRED_VerbalSourceIdentifierCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA360}, "SQ", "PredecessorDocumentsSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA370}, "SQ", "ReferencedRequestSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA372}, "SQ", "PerformedProcedureCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA375}, "SQ", "CurrentRequestedProcedureEvidenceSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA380}, "SQ", "RETIRED_ReportDetailSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA385}, "SQ", "PertinentOtherEvidenceSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA390}, "SQ", "HL7StructuredDocumentReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA402}, "UI", "RETIRED_ObservationSubjectUIDTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA403}, "CS", "RETIRED_ObservationSubjectClassTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA404}, "SQ", "RETIRED_ObservationSubjectTypeCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA491}, "CS", "CompletionFlag", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA492}, "LO", "CompletionFlagDescription", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA493}, "CS", "VerificationFlag", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA494}, "CS", "ArchiveRequested", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA496}, "CS", "PreliminaryFlag", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA504}, "SQ", "ContentTemplateSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA525}, "SQ", "IdenticalDocumentsSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA600}, "CS", "RETIRED_ObservationSubjectContextFlagTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA601}, "CS", "RETIRED_ObserverContextFlagTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA603}, "CS", "RETIRED_ProcedureContextFlagTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA730}, "SQ", "ContentSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xA731}, "SQ", "RETIRED_RelationshipSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA732}, "SQ", "RETIRED_RelationshipTypeCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA744}, "SQ", "RETIRED_LanguageCodeSequenceTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xA992}, "ST", "RETIRED_UniformResourceLocatorTrial", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xB020}, "SQ", "WaveformAnnotationSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xDB00}, "CS", "TemplateIdentifier", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xDB06}, "DT", "RETIRED_TemplateVersion", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xDB07}, "DT", "RETIRED_TemplateLocalVersion", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xDB0B}, "CS", "RETIRED_TemplateExtensionFlag", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xDB0C}, "UI", "RETIRED_TemplateExtensionOrganizationUID", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xDB0D}, "UI", "RETIRED_TemplateExtensionCreatorUID", "1", "DICOM/retired"},
+	{Tag{0x0040, 0xDB73}, "UL", "ReferencedContentItemIdentifier", "1-n", "DICOM_2011"},
+	{Tag{0x0040, 0xE001}, "ST", "HL7InstanceIdentifier", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xE004}, "DT", "HL7DocumentEffectiveTime", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xE006}, "SQ", "HL7DocumentTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xE008}, "SQ", "DocumentClassCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xE010}, "UT", "RetrieveURI", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xE011}, "UI", "RetrieveLocationUID", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xE020}, "CS", "TypeOfInstances", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xE021}, "SQ", "DICOMRetrievalSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xE022}, "SQ", "DICOMMediaRetrievalSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xE023}, "SQ", "WADORetrievalSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xE024}, "SQ", "XDSRetrievalSequence", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xE030}, "UI", "RepositoryUniqueID", "1", "DICOM_2011"},
+	{Tag{0x0040, 0xE031}, "UI", "HomeCommunityID", "1", "DICOM_2011"},
+	{Tag{0x0042, 0x0010}, "ST", "DocumentTitle", "1", "DICOM_2011"},
+	{Tag{0x0042, 0x0011}, "OB", "EncapsulatedDocument", "1", "DICOM_2011"},
+	{Tag{0x0042, 0x0012}, "LO", "MIMETypeOfEncapsulatedDocument", "1", "DICOM_2011"},
+	{Tag{0x0042, 0x0013}, "SQ", "SourceInstanceSequence", "1", "DICOM_2011"},
+	{Tag{0x0042, 0x0014}, "LO", "ListOfMIMETypes", "1-n", "DICOM_2011"},
+	{Tag{0x0044, 0x0001}, "ST", "ProductPackageIdentifier", "1", "DICOM_2011"},
+	{Tag{0x0044, 0x0002}, "CS", "SubstanceAdministrationApproval", "1", "DICOM_2011"},
+	{Tag{0x0044, 0x0003}, "LT", "ApprovalStatusFurtherDescription", "1", "DICOM_2011"},
+	{Tag{0x0044, 0x0004}, "DT", "ApprovalStatusDateTime", "1", "DICOM_2011"},
+	{Tag{0x0044, 0x0007}, "SQ", "ProductTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0044, 0x0008}, "LO", "ProductName", "1-n", "DICOM_2011"},
+	{Tag{0x0044, 0x0009}, "LT", "ProductDescription", "1", "DICOM_2011"},
+	{Tag{0x0044, 0x000A}, "LO", "ProductLotIdentifier", "1", "DICOM_2011"},
+	{Tag{0x0044, 0x000B}, "DT", "ProductExpirationDateTime", "1", "DICOM_2011"},
+	{Tag{0x0044, 0x0010}, "DT", "SubstanceAdministrationDateTime", "1", "DICOM_2011"},
+	{Tag{0x0044, 0x0011}, "LO", "SubstanceAdministrationNotes", "1", "DICOM_2011"},
+	{Tag{0x0044, 0x0012}, "LO", "SubstanceAdministrationDeviceID", "1", "DICOM_2011"},
+	{Tag{0x0044, 0x0013}, "SQ", "ProductParameterSequence", "1", "DICOM_2011"},
+	{Tag{0x0044, 0x0019}, "SQ", "SubstanceAdministrationParameterSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0012}, "LO", "LensDescription", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0014}, "SQ", "RightLensSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0015}, "SQ", "LeftLensSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0016}, "SQ", "UnspecifiedLateralityLensSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0018}, "SQ", "CylinderSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0028}, "SQ", "PrismSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0030}, "FD", "HorizontalPrismPower", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0032}, "CS", "HorizontalPrismBase", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0034}, "FD", "VerticalPrismPower", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0036}, "CS", "VerticalPrismBase", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0038}, "CS", "LensSegmentType", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0040}, "FD", "OpticalTransmittance", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0042}, "FD", "ChannelWidth", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0044}, "FD", "PupilSize", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0046}, "FD", "CornealSize", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0050}, "SQ", "AutorefractionRightEyeSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0052}, "SQ", "AutorefractionLeftEyeSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0060}, "FD", "DistancePupillaryDistance", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0062}, "FD", "NearPupillaryDistance", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0063}, "FD", "IntermediatePupillaryDistance", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0064}, "FD", "OtherPupillaryDistance", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0070}, "SQ", "KeratometryRightEyeSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0071}, "SQ", "KeratometryLeftEyeSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0074}, "SQ", "SteepKeratometricAxisSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0075}, "FD", "RadiusOfCurvature", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0076}, "FD", "KeratometricPower", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0077}, "FD", "KeratometricAxis", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0080}, "SQ", "FlatKeratometricAxisSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0092}, "CS", "BackgroundColor", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0094}, "CS", "Optotype", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0095}, "CS", "OptotypePresentation", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0097}, "SQ", "SubjectiveRefractionRightEyeSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0098}, "SQ", "SubjectiveRefractionLeftEyeSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0100}, "SQ", "AddNearSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0101}, "SQ", "AddIntermediateSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0102}, "SQ", "AddOtherSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0104}, "FD", "AddPower", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0106}, "FD", "ViewingDistance", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0121}, "SQ", "VisualAcuityTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0122}, "SQ", "VisualAcuityRightEyeSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0123}, "SQ", "VisualAcuityLeftEyeSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0124}, "SQ", "VisualAcuityBothEyesOpenSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0125}, "CS", "ViewingDistanceType", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0135}, "SS", "VisualAcuityModifiers", "2", "DICOM_2011"},
+	{Tag{0x0046, 0x0137}, "FD", "DecimalVisualAcuity", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0139}, "LO", "OptotypeDetailedDefinition", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0145}, "SQ", "ReferencedRefractiveMeasurementsSequence", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0146}, "FD", "SpherePower", "1", "DICOM_2011"},
+	{Tag{0x0046, 0x0147}, "FD", "CylinderPower", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0001}, "FL", "ImagedVolumeWidth", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0002}, "FL", "ImagedVolumeHeight", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0003}, "FL", "ImagedVolumeDepth", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0006}, "UL", "TotalPixelMatrixColumns", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0007}, "UL", "TotalPixelMatrixRows", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0008}, "SQ", "TotalPixelMatrixOriginSequence", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0010}, "CS", "SpecimenLabelInImage", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0011}, "CS", "FocusMethod", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0012}, "CS", "ExtendedDepthOfField", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0013}, "US", "NumberOfFocalPlanes", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0014}, "FL", "DistanceBetweenFocalPlanes", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0015}, "US", "RecommendedAbsentPixelCIELabValue", "3", "DICOM_2011"},
+	{Tag{0x0048, 0x0100}, "SQ", "IlluminatorTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0102}, "DS", "ImageOrientationSlide", "6", "DICOM_2011"},
+	{Tag{0x0048, 0x0105}, "SQ", "OpticalPathSequence", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0106}, "SH", "OpticalPathIdentifier", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0107}, "ST", "OpticalPathDescription", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0108}, "SQ", "IlluminationColorCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0110}, "SQ", "SpecimenReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0111}, "DS", "CondenserLensPower", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0112}, "DS", "ObjectiveLensPower", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0113}, "DS", "ObjectiveLensNumericalAperture", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0120}, "SQ", "PaletteColorLookupTableSequence", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0200}, "SQ", "ReferencedImageNavigationSequence", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0201}, "US", "TopLeftHandCornerOfLocalizerArea", "2", "DICOM_2011"},
+	{Tag{0x0048, 0x0202}, "US", "BottomRightHandCornerOfLocalizerArea", "2", "DICOM_2011"},
+	{Tag{0x0048, 0x0207}, "SQ", "OpticalPathIdentificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x021A}, "SQ", "PlanePositionSlideSequence", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x021E}, "SL", "ColumnPositionInTotalImagePixelMatrix", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x021F}, "SL", "RowPositionInTotalImagePixelMatrix", "1", "DICOM_2011"},
+	{Tag{0x0048, 0x0301}, "CS", "PixelOriginInterpretation", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x0004}, "CS", "CalibrationImage", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x0010}, "SQ", "DeviceSequence", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x0012}, "SQ", "ContainerComponentTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x0013}, "FD", "ContainerComponentThickness", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x0014}, "DS", "DeviceLength", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x0015}, "FD", "ContainerComponentWidth", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x0016}, "DS", "DeviceDiameter", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x0017}, "CS", "DeviceDiameterUnits", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x0018}, "DS", "DeviceVolume", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x0019}, "DS", "InterMarkerDistance", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x001A}, "CS", "ContainerComponentMaterial", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x001B}, "LO", "ContainerComponentID", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x001C}, "FD", "ContainerComponentLength", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x001D}, "FD", "ContainerComponentDiameter", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x001E}, "LO", "ContainerComponentDescription", "1", "DICOM_2011"},
+	{Tag{0x0050, 0x0020}, "LO", "DeviceDescription", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0001}, "FL", "ContrastBolusIngredientPercentByVolume", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0002}, "FD", "OCTFocalDistance", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0003}, "FD", "BeamSpotSize", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0004}, "FD", "EffectiveRefractiveIndex", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0006}, "CS", "OCTAcquisitionDomain", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0007}, "FD", "OCTOpticalCenterWavelength", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0008}, "FD", "AxialResolution", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0009}, "FD", "RangingDepth", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0011}, "FD", "ALineRate", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0012}, "US", "ALinesPerFrame", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0013}, "FD", "CatheterRotationalRate", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0014}, "FD", "ALinePixelSpacing", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0016}, "SQ", "ModeOfPercutaneousAccessSequence", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0025}, "SQ", "IntravascularOCTFrameTypeSequence", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0026}, "CS", "OCTZOffsetApplied", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0027}, "SQ", "IntravascularFrameContentSequence", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0028}, "FD", "IntravascularLongitudinalDistance", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0029}, "SQ", "IntravascularOCTFrameContentSequence", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0030}, "SS", "OCTZOffsetCorrection", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0031}, "CS", "CatheterDirectionOfRotation", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0033}, "FD", "SeamLineLocation", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0034}, "FD", "FirstALineLocation", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0036}, "US", "SeamLineIndex", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0038}, "US", "NumberOfPaddedAlines", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x0039}, "CS", "InterpolationType", "1", "DICOM_2011"},
+	{Tag{0x0052, 0x003A}, "CS", "RefractiveIndexApplied", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0010}, "US", "EnergyWindowVector", "1-n", "DICOM_2011"},
+	{Tag{0x0054, 0x0011}, "US", "NumberOfEnergyWindows", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0012}, "SQ", "EnergyWindowInformationSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0013}, "SQ", "EnergyWindowRangeSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0014}, "DS", "EnergyWindowLowerLimit", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0015}, "DS", "EnergyWindowUpperLimit", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0016}, "SQ", "RadiopharmaceuticalInformationSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0017}, "IS", "ResidualSyringeCounts", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0018}, "SH", "EnergyWindowName", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0020}, "US", "DetectorVector", "1-n", "DICOM_2011"},
+	{Tag{0x0054, 0x0021}, "US", "NumberOfDetectors", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0022}, "SQ", "DetectorInformationSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0030}, "US", "PhaseVector", "1-n", "DICOM_2011"},
+	{Tag{0x0054, 0x0031}, "US", "NumberOfPhases", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0032}, "SQ", "PhaseInformationSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0033}, "US", "NumberOfFramesInPhase", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0036}, "IS", "PhaseDelay", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0038}, "IS", "PauseBetweenFrames", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0039}, "CS", "PhaseDescription", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0050}, "US", "RotationVector", "1-n", "DICOM_2011"},
+	{Tag{0x0054, 0x0051}, "US", "NumberOfRotations", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0052}, "SQ", "RotationInformationSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0053}, "US", "NumberOfFramesInRotation", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0060}, "US", "RRIntervalVector", "1-n", "DICOM_2011"},
+	{Tag{0x0054, 0x0061}, "US", "NumberOfRRIntervals", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0062}, "SQ", "GatedInformationSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0063}, "SQ", "DataInformationSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0070}, "US", "TimeSlotVector", "1-n", "DICOM_2011"},
+	{Tag{0x0054, 0x0071}, "US", "NumberOfTimeSlots", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0072}, "SQ", "TimeSlotInformationSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0073}, "DS", "TimeSlotTime", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0080}, "US", "SliceVector", "1-n", "DICOM_2011"},
+	{Tag{0x0054, 0x0081}, "US", "NumberOfSlices", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0090}, "US", "AngularViewVector", "1-n", "DICOM_2011"},
+	{Tag{0x0054, 0x0100}, "US", "TimeSliceVector", "1-n", "DICOM_2011"},
+	{Tag{0x0054, 0x0101}, "US", "NumberOfTimeSlices", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0200}, "DS", "StartAngle", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0202}, "CS", "TypeOfDetectorMotion", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0210}, "IS", "TriggerVector", "1-n", "DICOM_2011"},
+	{Tag{0x0054, 0x0211}, "US", "NumberOfTriggersInPhase", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0220}, "SQ", "ViewCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0222}, "SQ", "ViewModifierCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0300}, "SQ", "RadionuclideCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0302}, "SQ", "AdministrationRouteCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0304}, "SQ", "RadiopharmaceuticalCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0306}, "SQ", "CalibrationDataSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0308}, "US", "EnergyWindowNumber", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0400}, "SH", "ImageID", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0410}, "SQ", "PatientOrientationCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0412}, "SQ", "PatientOrientationModifierCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0414}, "SQ", "PatientGantryRelationshipCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x0500}, "CS", "SliceProgressionDirection", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1000}, "CS", "SeriesType", "2", "DICOM_2011"},
+	{Tag{0x0054, 0x1001}, "CS", "Units", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1002}, "CS", "CountsSource", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1004}, "CS", "ReprojectionMethod", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1006}, "CS", "SUVType", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1100}, "CS", "RandomsCorrectionMethod", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1101}, "LO", "AttenuationCorrectionMethod", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1102}, "CS", "DecayCorrection", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1103}, "LO", "ReconstructionMethod", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1104}, "LO", "DetectorLinesOfResponseUsed", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1105}, "LO", "ScatterCorrectionMethod", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1200}, "DS", "AxialAcceptance", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1201}, "IS", "AxialMash", "2", "DICOM_2011"},
+	{Tag{0x0054, 0x1202}, "IS", "TransverseMash", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1203}, "DS", "DetectorElementSize", "2", "DICOM_2011"},
+	{Tag{0x0054, 0x1210}, "DS", "CoincidenceWindowWidth", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1220}, "CS", "SecondaryCountsType", "1-n", "DICOM_2011"},
+	{Tag{0x0054, 0x1300}, "DS", "FrameReferenceTime", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1310}, "IS", "PrimaryPromptsCountsAccumulated", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1311}, "IS", "SecondaryCountsAccumulated", "1-n", "DICOM_2011"},
+	{Tag{0x0054, 0x1320}, "DS", "SliceSensitivityFactor", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1321}, "DS", "DecayFactor", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1322}, "DS", "DoseCalibrationFactor", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1323}, "DS", "ScatterFractionFactor", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1324}, "DS", "DeadTimeFactor", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1330}, "US", "ImageIndex", "1", "DICOM_2011"},
+	{Tag{0x0054, 0x1400}, "CS", "RETIRED_CountsIncluded", "1-n", "DICOM/retired"},
+	{Tag{0x0054, 0x1401}, "CS", "RETIRED_DeadTimeCorrectionFlag", "1", "DICOM/retired"},
+	{Tag{0x0060, 0x3000}, "SQ", "HistogramSequence", "1", "DICOM_2011"},
+	{Tag{0x0060, 0x3002}, "US", "HistogramNumberOfBins", "1", "DICOM_2011"},
+	{Tag{0x0060, 0x3004}, "XS", "HistogramFirstBinValue", "1", "DICOM_2011"},
+	{Tag{0x0060, 0x3006}, "XS", "HistogramLastBinValue", "1", "DICOM_2011"},
+	{Tag{0x0060, 0x3008}, "US", "HistogramBinWidth", "1", "DICOM_2011"},
+	{Tag{0x0060, 0x3010}, "LO", "HistogramExplanation", "1", "DICOM_2011"},
+	{Tag{0x0060, 0x3020}, "UL", "HistogramData", "1-n", "DICOM_2011"},
+	{Tag{0x0062, 0x0001}, "CS", "SegmentationType", "1", "DICOM_2011"},
+	{Tag{0x0062, 0x0002}, "SQ", "SegmentSequence", "1", "DICOM_2011"},
+	{Tag{0x0062, 0x0003}, "SQ", "SegmentedPropertyCategoryCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0062, 0x0004}, "US", "SegmentNumber", "1", "DICOM_2011"},
+	{Tag{0x0062, 0x0005}, "LO", "SegmentLabel", "1", "DICOM_2011"},
+	{Tag{0x0062, 0x0006}, "ST", "SegmentDescription", "1", "DICOM_2011"},
+	{Tag{0x0062, 0x0008}, "CS", "SegmentAlgorithmType", "1", "DICOM_2011"},
+	{Tag{0x0062, 0x0009}, "LO", "SegmentAlgorithmName", "1", "DICOM_2011"},
+	{Tag{0x0062, 0x000A}, "SQ", "SegmentIdentificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0062, 0x000B}, "US", "ReferencedSegmentNumber", "1-n", "DICOM_2011"},
+	{Tag{0x0062, 0x000C}, "US", "RecommendedDisplayGrayscaleValue", "1", "DICOM_2011"},
+	{Tag{0x0062, 0x000D}, "US", "RecommendedDisplayCIELabValue", "3", "DICOM_2011"},
+	{Tag{0x0062, 0x000E}, "US", "MaximumFractionalValue", "1", "DICOM_2011"},
+	{Tag{0x0062, 0x000F}, "SQ", "SegmentedPropertyTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0062, 0x0010}, "CS", "SegmentationFractionalType", "1", "DICOM_2011"},
+	{Tag{0x0064, 0x0002}, "SQ", "DeformableRegistrationSequence", "1", "DICOM_2011"},
+	{Tag{0x0064, 0x0003}, "UI", "SourceFrameOfReferenceUID", "1", "DICOM_2011"},
+	{Tag{0x0064, 0x0005}, "SQ", "DeformableRegistrationGridSequence", "1", "DICOM_2011"},
+	{Tag{0x0064, 0x0007}, "UL", "GridDimensions", "3", "DICOM_2011"},
+	{Tag{0x0064, 0x0008}, "FD", "GridResolution", "3", "DICOM_2011"},
+	{Tag{0x0064, 0x0009}, "OF", "VectorGridData", "1", "DICOM_2011"},
+	{Tag{0x0064, 0x000F}, "SQ", "PreDeformationMatrixRegistrationSequence", "1", "DICOM_2011"},
+	{Tag{0x0064, 0x0010}, "SQ", "PostDeformationMatrixRegistrationSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0001}, "UL", "NumberOfSurfaces", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0002}, "SQ", "SurfaceSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0003}, "UL", "SurfaceNumber", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0004}, "LT", "SurfaceComments", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0009}, "CS", "SurfaceProcessing", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x000A}, "FL", "SurfaceProcessingRatio", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x000B}, "LO", "SurfaceProcessingDescription", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x000C}, "FL", "RecommendedPresentationOpacity", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x000D}, "CS", "RecommendedPresentationType", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x000E}, "CS", "FiniteVolume", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0010}, "CS", "Manifold", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0011}, "SQ", "SurfacePointsSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0012}, "SQ", "SurfacePointsNormalsSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0013}, "SQ", "SurfaceMeshPrimitivesSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0015}, "UL", "NumberOfSurfacePoints", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0016}, "OF", "PointCoordinatesData", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0017}, "FL", "PointPositionAccuracy", "3", "DICOM_2011"},
+	{Tag{0x0066, 0x0018}, "FL", "MeanPointDistance", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0019}, "FL", "MaximumPointDistance", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x001A}, "FL", "PointsBoundingBoxCoordinates", "6", "DICOM_2011"},
+	{Tag{0x0066, 0x001B}, "FL", "AxisOfRotation", "3", "DICOM_2011"},
+	{Tag{0x0066, 0x001C}, "FL", "CenterOfRotation", "3", "DICOM_2011"},
+	{Tag{0x0066, 0x001E}, "UL", "NumberOfVectors", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x001F}, "US", "VectorDimensionality", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0020}, "FL", "VectorAccuracy", "1-n", "DICOM_2011"},
+	{Tag{0x0066, 0x0021}, "OF", "VectorCoordinateData", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0023}, "OW", "TrianglePointIndexList", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0024}, "OW", "EdgePointIndexList", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0025}, "OW", "VertexPointIndexList", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0026}, "SQ", "TriangleStripSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0027}, "SQ", "TriangleFanSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0028}, "SQ", "LineSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0029}, "OW", "PrimitivePointIndexList", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x002A}, "UL", "SurfaceCount", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x002B}, "SQ", "ReferencedSurfaceSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x002C}, "UL", "ReferencedSurfaceNumber", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x002D}, "SQ", "SegmentSurfaceGenerationAlgorithmIdentificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x002E}, "SQ", "SegmentSurfaceSourceInstanceSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x002F}, "SQ", "AlgorithmFamilyCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0030}, "SQ", "AlgorithmNameCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0031}, "LO", "AlgorithmVersion", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0032}, "LT", "AlgorithmParameters", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0034}, "SQ", "FacetSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0035}, "SQ", "SurfaceProcessingAlgorithmIdentificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0036}, "LO", "AlgorithmName", "1", "DICOM_2011"},
+	{Tag{0x0066, 0x0037}, "FL", "RecommendedPointRadius", "1", "CP_1200"},
+	{Tag{0x0066, 0x0038}, "FL", "RecommendedLineThickness", "1", "CP_1200"},
+	{Tag{0x0066, 0x0129}, "OL", "TrackPointIndexList", "1", "SUP_181"},
+	{Tag{0x0068, 0x6210}, "LO", "ImplantSize", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6221}, "LO", "ImplantTemplateVersion", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6222}, "SQ", "ReplacedImplantTemplateSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6223}, "CS", "ImplantType", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6224}, "SQ", "DerivationImplantTemplateSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6225}, "SQ", "OriginalImplantTemplateSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6226}, "DT", "EffectiveDateTime", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6230}, "SQ", "ImplantTargetAnatomySequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6260}, "SQ", "InformationFromManufacturerSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6265}, "SQ", "NotificationFromManufacturerSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6270}, "DT", "InformationIssueDateTime", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6280}, "ST", "InformationSummary", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x62A0}, "SQ", "ImplantRegulatoryDisapprovalCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x62A5}, "FD", "OverallTemplateSpatialTolerance", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x62C0}, "SQ", "HPGLDocumentSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x62D0}, "US", "HPGLDocumentID", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x62D5}, "LO", "HPGLDocumentLabel", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x62E0}, "SQ", "ViewOrientationCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x62F0}, "FD", "ViewOrientationModifier", "9", "DICOM_2011"},
+	{Tag{0x0068, 0x62F2}, "FD", "HPGLDocumentScaling", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6300}, "OB", "HPGLDocument", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6310}, "US", "HPGLContourPenNumber", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6320}, "SQ", "HPGLPenSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6330}, "US", "HPGLPenNumber", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6340}, "LO", "HPGLPenLabel", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6345}, "ST", "HPGLPenDescription", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6346}, "FD", "RecommendedRotationPoint", "2", "DICOM_2011"},
+	{Tag{0x0068, 0x6347}, "FD", "BoundingRectangle", "4", "DICOM_2011"},
+	{Tag{0x0068, 0x6350}, "US", "ImplantTemplate3DModelSurfaceNumber", "1-n", "DICOM_2011"},
+	{Tag{0x0068, 0x6360}, "SQ", "SurfaceModelDescriptionSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6380}, "LO", "SurfaceModelLabel", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6390}, "FD", "SurfaceModelScalingFactor", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x63A0}, "SQ", "MaterialsCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x63A4}, "SQ", "CoatingMaterialsCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x63A8}, "SQ", "ImplantTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x63AC}, "SQ", "FixationMethodCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x63B0}, "SQ", "MatingFeatureSetsSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x63C0}, "US", "MatingFeatureSetID", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x63D0}, "LO", "MatingFeatureSetLabel", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x63E0}, "SQ", "MatingFeatureSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x63F0}, "US", "MatingFeatureID", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6400}, "SQ", "MatingFeatureDegreeOfFreedomSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6410}, "US", "DegreeOfFreedomID", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6420}, "CS", "DegreeOfFreedomType", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6430}, "SQ", "TwoDMatingFeatureCoordinatesSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6440}, "US", "ReferencedHPGLDocumentID", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6450}, "FD", "TwoDMatingPoint", "2", "DICOM_2011"},
+	{Tag{0x0068, 0x6460}, "FD", "TwoDMatingAxes", "4", "DICOM_2011"},
+	{Tag{0x0068, 0x6470}, "SQ", "TwoDDegreeOfFreedomSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6490}, "FD", "ThreeDDegreeOfFreedomAxis", "3", "DICOM_2011"},
+	{Tag{0x0068, 0x64A0}, "FD", "RangeOfFreedom", "2", "DICOM_2011"},
+	{Tag{0x0068, 0x64C0}, "FD", "ThreeDMatingPoint", "3", "DICOM_2011"},
+	{Tag{0x0068, 0x64D0}, "FD", "ThreeDMatingAxes", "9", "DICOM_2011"},
+	{Tag{0x0068, 0x64F0}, "FD", "TwoDDegreeOfFreedomAxis", "3", "DICOM_2011"},
+	{Tag{0x0068, 0x6500}, "SQ", "PlanningLandmarkPointSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6510}, "SQ", "PlanningLandmarkLineSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6520}, "SQ", "PlanningLandmarkPlaneSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6530}, "US", "PlanningLandmarkID", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6540}, "LO", "PlanningLandmarkDescription", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6545}, "SQ", "PlanningLandmarkIdentificationCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6550}, "SQ", "TwoDPointCoordinatesSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x6560}, "FD", "TwoDPointCoordinates", "2", "DICOM_2011"},
+	{Tag{0x0068, 0x6590}, "FD", "ThreeDPointCoordinates", "3", "DICOM_2011"},
+	{Tag{0x0068, 0x65A0}, "SQ", "TwoDLineCoordinatesSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x65B0}, "FD", "TwoDLineCoordinates", "4", "DICOM_2011"},
+	{Tag{0x0068, 0x65D0}, "FD", "ThreeDLineCoordinates", "6", "DICOM_2011"},
+	{Tag{0x0068, 0x65E0}, "SQ", "TwoDPlaneCoordinatesSequence", "1", "DICOM_2011"},
+	{Tag{0x0068, 0x65F0}, "FD", "TwoDPlaneIntersection", "4", "DICOM_2011"},
+	{Tag{0x0068, 0x6610}, "FD", "ThreeDPlaneOrigin", "3", "DICOM_2011"},
+	{Tag{0x0068, 0x6620}, "FD", "ThreeDPlaneNormal", "3", "DICOM_2011"},
+	{Tag{0x0070, 0x0001}, "SQ", "GraphicAnnotationSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0002}, "CS", "GraphicLayer", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0003}, "CS", "BoundingBoxAnnotationUnits", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0004}, "CS", "AnchorPointAnnotationUnits", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0005}, "CS", "GraphicAnnotationUnits", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0006}, "ST", "UnformattedTextValue", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0008}, "SQ", "TextObjectSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0009}, "SQ", "GraphicObjectSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0010}, "FL", "BoundingBoxTopLeftHandCorner", "2", "DICOM_2011"},
+	{Tag{0x0070, 0x0011}, "FL", "BoundingBoxBottomRightHandCorner", "2", "DICOM_2011"},
+	{Tag{0x0070, 0x0012}, "CS", "BoundingBoxTextHorizontalJustification", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0014}, "FL", "AnchorPoint", "2", "DICOM_2011"},
+	{Tag{0x0070, 0x0015}, "CS", "AnchorPointVisibility", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0020}, "US", "GraphicDimensions", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0021}, "US", "NumberOfGraphicPoints", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0022}, "FL", "GraphicData", "2-n", "DICOM_2011"},
+	{Tag{0x0070, 0x0023}, "CS", "GraphicType", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0024}, "CS", "GraphicFilled", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0040}, "IS", "RETIRED_ImageRotationRetired", "1", "DICOM/retired"},
+	{Tag{0x0070, 0x0041}, "CS", "ImageHorizontalFlip", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0042}, "US", "ImageRotation", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0050}, "US", "RETIRED_DisplayedAreaTopLeftHandCornerTrial", "2", "DICOM/retired"},
+	{Tag{0x0070, 0x0051}, "US", "RETIRED_DisplayedAreaBottomRightHandCornerTrial", "2", "DICOM/retired"},
+	{Tag{0x0070, 0x0052}, "SL", "DisplayedAreaTopLeftHandCorner", "2", "DICOM_2011"},
+	{Tag{0x0070, 0x0053}, "SL", "DisplayedAreaBottomRightHandCorner", "2", "DICOM_2011"},
+	{Tag{0x0070, 0x005A}, "SQ", "DisplayedAreaSelectionSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0060}, "SQ", "GraphicLayerSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0062}, "IS", "GraphicLayerOrder", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0066}, "US", "GraphicLayerRecommendedDisplayGrayscaleValue", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0067}, "US", "RETIRED_GraphicLayerRecommendedDisplayRGBValue", "3", "DICOM/retired"},
+	{Tag{0x0070, 0x0068}, "LO", "GraphicLayerDescription", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0080}, "CS", "ContentLabel", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0081}, "LO", "ContentDescription", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0082}, "DA", "PresentationCreationDate", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0083}, "TM", "PresentationCreationTime", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0084}, "PN", "ContentCreatorName", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0086}, "SQ", "ContentCreatorIdentificationCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0087}, "SQ", "AlternateContentDescriptionSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0100}, "CS", "PresentationSizeMode", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0101}, "DS", "PresentationPixelSpacing", "2", "DICOM_2011"},
+	{Tag{0x0070, 0x0102}, "IS", "PresentationPixelAspectRatio", "2", "DICOM_2011"},
+	{Tag{0x0070, 0x0103}, "FL", "PresentationPixelMagnificationRatio", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0207}, "LO", "GraphicGroupLabel", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0208}, "ST", "GraphicGroupDescription", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0209}, "SQ", "CompoundGraphicSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0226}, "UL", "CompoundGraphicInstanceID", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0227}, "LO", "FontName", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0228}, "CS", "FontNameType", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0229}, "LO", "CSSFontName", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0230}, "FD", "RotationAngle", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0231}, "SQ", "TextStyleSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0232}, "SQ", "LineStyleSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0233}, "SQ", "FillStyleSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0234}, "SQ", "GraphicGroupSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0241}, "US", "TextColorCIELabValue", "3", "DICOM_2011"},
+	{Tag{0x0070, 0x0242}, "CS", "HorizontalAlignment", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0243}, "CS", "VerticalAlignment", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0244}, "CS", "ShadowStyle", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0245}, "FL", "ShadowOffsetX", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0246}, "FL", "ShadowOffsetY", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0247}, "US", "ShadowColorCIELabValue", "3", "DICOM_2011"},
+	{Tag{0x0070, 0x0248}, "CS", "Underlined", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0249}, "CS", "Bold", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0250}, "CS", "Italic", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0251}, "US", "PatternOnColorCIELabValue", "3", "DICOM_2011"},
+	{Tag{0x0070, 0x0252}, "US", "PatternOffColorCIELabValue", "3", "DICOM_2011"},
+	{Tag{0x0070, 0x0253}, "FL", "LineThickness", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0254}, "CS", "LineDashingStyle", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0255}, "UL", "LinePattern", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0256}, "OB", "FillPattern", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0257}, "CS", "FillMode", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0258}, "FL", "ShadowOpacity", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0261}, "FL", "GapLength", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0262}, "FL", "DiameterOfVisibility", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0273}, "FL", "RotationPoint", "2", "DICOM_2011"},
+	{Tag{0x0070, 0x0274}, "CS", "TickAlignment", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0278}, "CS", "ShowTickLabel", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0279}, "CS", "TickLabelAlignment", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0282}, "CS", "CompoundGraphicUnits", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0284}, "FL", "PatternOnOpacity", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0285}, "FL", "PatternOffOpacity", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0287}, "SQ", "MajorTicksSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0288}, "FL", "TickPosition", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0289}, "SH", "TickLabel", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0294}, "CS", "CompoundGraphicType", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0295}, "UL", "GraphicGroupID", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0306}, "CS", "ShapeType", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0308}, "SQ", "RegistrationSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0309}, "SQ", "MatrixRegistrationSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x030A}, "SQ", "MatrixSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x030C}, "CS", "FrameOfReferenceTransformationMatrixType", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x030D}, "SQ", "RegistrationTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x030F}, "ST", "FiducialDescription", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0310}, "SH", "FiducialIdentifier", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0311}, "SQ", "FiducialIdentifierCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0312}, "FD", "ContourUncertaintyRadius", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0314}, "SQ", "UsedFiducialsSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0318}, "SQ", "GraphicCoordinatesDataSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x031A}, "UI", "FiducialUID", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x031C}, "SQ", "FiducialSetSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x031E}, "SQ", "FiducialSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0401}, "US", "GraphicLayerRecommendedDisplayCIELabValue", "3", "DICOM_2011"},
+	{Tag{0x0070, 0x0402}, "SQ", "BlendingSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0403}, "FL", "RelativeOpacity", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0404}, "SQ", "ReferencedSpatialRegistrationSequence", "1", "DICOM_2011"},
+	{Tag{0x0070, 0x0405}, "CS", "BlendingPosition", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0002}, "SH", "HangingProtocolName", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0004}, "LO", "HangingProtocolDescription", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0006}, "CS", "HangingProtocolLevel", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0008}, "LO", "HangingProtocolCreator", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x000A}, "DT", "HangingProtocolCreationDateTime", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x000C}, "SQ", "HangingProtocolDefinitionSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x000E}, "SQ", "HangingProtocolUserIdentificationCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0010}, "LO", "HangingProtocolUserGroupName", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0012}, "SQ", "SourceHangingProtocolSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0014}, "US", "NumberOfPriorsReferenced", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0020}, "SQ", "ImageSetsSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0022}, "SQ", "ImageSetSelectorSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0024}, "CS", "ImageSetSelectorUsageFlag", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0026}, "AT", "SelectorAttribute", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0028}, "US", "SelectorValueNumber", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0030}, "SQ", "TimeBasedImageSetsSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0032}, "US", "ImageSetNumber", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0034}, "CS", "ImageSetSelectorCategory", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0038}, "US", "RelativeTime", "2", "DICOM_2011"},
+	{Tag{0x0072, 0x003A}, "CS", "RelativeTimeUnits", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x003C}, "SS", "AbstractPriorValue", "2", "DICOM_2011"},
+	{Tag{0x0072, 0x003E}, "SQ", "AbstractPriorCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0040}, "LO", "ImageSetLabel", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0050}, "CS", "SelectorAttributeVR", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0052}, "AT", "SelectorSequencePointer", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x0054}, "LO", "SelectorSequencePointerPrivateCreator", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x0056}, "LO", "SelectorAttributePrivateCreator", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0060}, "AT", "SelectorATValue", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x0062}, "CS", "SelectorCSValue", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x0064}, "IS", "SelectorISValue", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x0066}, "LO", "SelectorLOValue", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x0068}, "LT", "SelectorLTValue", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x006A}, "PN", "SelectorPNValue", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x006C}, "SH", "SelectorSHValue", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x006E}, "ST", "SelectorSTValue", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0070}, "UT", "SelectorUTValue", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0072}, "DS", "SelectorDSValue", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x0074}, "FD", "SelectorFDValue", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x0076}, "FL", "SelectorFLValue", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x0078}, "UL", "SelectorULValue", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x007A}, "US", "SelectorUSValue", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x007C}, "SL", "SelectorSLValue", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x007E}, "SS", "SelectorSSValue", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x0080}, "SQ", "SelectorCodeSequenceValue", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0100}, "US", "NumberOfScreens", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0102}, "SQ", "NominalScreenDefinitionSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0104}, "US", "NumberOfVerticalPixels", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0106}, "US", "NumberOfHorizontalPixels", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0108}, "FD", "DisplayEnvironmentSpatialPosition", "4", "DICOM_2011"},
+	{Tag{0x0072, 0x010A}, "US", "ScreenMinimumGrayscaleBitDepth", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x010C}, "US", "ScreenMinimumColorBitDepth", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x010E}, "US", "ApplicationMaximumRepaintTime", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0200}, "SQ", "DisplaySetsSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0202}, "US", "DisplaySetNumber", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0203}, "LO", "DisplaySetLabel", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0204}, "US", "DisplaySetPresentationGroup", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0206}, "LO", "DisplaySetPresentationGroupDescription", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0208}, "CS", "PartialDataDisplayHandling", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0210}, "SQ", "SynchronizedScrollingSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0212}, "US", "DisplaySetScrollingGroup", "2-n", "DICOM_2011"},
+	{Tag{0x0072, 0x0214}, "SQ", "NavigationIndicatorSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0216}, "US", "NavigationDisplaySet", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0218}, "US", "ReferenceDisplaySets", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x0300}, "SQ", "ImageBoxesSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0302}, "US", "ImageBoxNumber", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0304}, "CS", "ImageBoxLayoutType", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0306}, "US", "ImageBoxTileHorizontalDimension", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0308}, "US", "ImageBoxTileVerticalDimension", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0310}, "CS", "ImageBoxScrollDirection", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0312}, "CS", "ImageBoxSmallScrollType", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0314}, "US", "ImageBoxSmallScrollAmount", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0316}, "CS", "ImageBoxLargeScrollType", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0318}, "US", "ImageBoxLargeScrollAmount", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0320}, "US", "ImageBoxOverlapPriority", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0330}, "FD", "CineRelativeToRealTime", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0400}, "SQ", "FilterOperationsSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0402}, "CS", "FilterByCategory", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0404}, "CS", "FilterByAttributePresence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0406}, "CS", "FilterByOperator", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0420}, "US", "StructuredDisplayBackgroundCIELabValue", "3", "DICOM_2011"},
+	{Tag{0x0072, 0x0421}, "US", "EmptyImageBoxCIELabValue", "3", "DICOM_2011"},
+	{Tag{0x0072, 0x0422}, "SQ", "StructuredDisplayImageBoxSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0424}, "SQ", "StructuredDisplayTextBoxSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0427}, "SQ", "ReferencedFirstFrameSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0430}, "SQ", "ImageBoxSynchronizationSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0432}, "US", "SynchronizedImageBoxList", "2-n", "DICOM_2011"},
+	{Tag{0x0072, 0x0434}, "CS", "TypeOfSynchronization", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0500}, "CS", "BlendingOperationType", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0510}, "CS", "ReformattingOperationType", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0512}, "FD", "ReformattingThickness", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0514}, "FD", "ReformattingInterval", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0516}, "CS", "ReformattingOperationInitialViewDirection", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0520}, "CS", "ThreeDRenderingType", "1-n", "DICOM_2011"},
+	{Tag{0x0072, 0x0600}, "SQ", "SortingOperationsSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0602}, "CS", "SortByCategory", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0604}, "CS", "SortingDirection", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0700}, "CS", "DisplaySetPatientOrientation", "2", "DICOM_2011"},
+	{Tag{0x0072, 0x0702}, "CS", "VOIType", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0704}, "CS", "PseudoColorType", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0705}, "SQ", "PseudoColorPaletteInstanceReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0706}, "CS", "ShowGrayscaleInverted", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0710}, "CS", "ShowImageTrueSizeFlag", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0712}, "CS", "ShowGraphicAnnotationFlag", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0714}, "CS", "ShowPatientDemographicsFlag", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0716}, "CS", "ShowAcquisitionTechniquesFlag", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0717}, "CS", "DisplaySetHorizontalJustification", "1", "DICOM_2011"},
+	{Tag{0x0072, 0x0718}, "CS", "DisplaySetVerticalJustification", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x0120}, "FD", "ContinuationStartMeterset", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x0121}, "FD", "ContinuationEndMeterset", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1000}, "CS", "ProcedureStepState", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1002}, "SQ", "ProcedureStepProgressInformationSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1004}, "DS", "ProcedureStepProgress", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1006}, "ST", "ProcedureStepProgressDescription", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1008}, "SQ", "ProcedureStepCommunicationsURISequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x100A}, "ST", "ContactURI", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x100C}, "LO", "ContactDisplayName", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x100E}, "SQ", "ProcedureStepDiscontinuationReasonCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1020}, "SQ", "BeamTaskSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1022}, "CS", "BeamTaskType", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1024}, "IS", "RETIRED_BeamOrderIndexTrial", "1", "DICOM/retired"},
+	{Tag{0x0074, 0x1026}, "FD", "TableTopVerticalAdjustedPosition", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1027}, "FD", "TableTopLongitudinalAdjustedPosition", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1028}, "FD", "TableTopLateralAdjustedPosition", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x102A}, "FD", "PatientSupportAdjustedAngle", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x102B}, "FD", "TableTopEccentricAdjustedAngle", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x102C}, "FD", "TableTopPitchAdjustedAngle", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x102D}, "FD", "TableTopRollAdjustedAngle", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1030}, "SQ", "DeliveryVerificationImageSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1032}, "CS", "VerificationImageTiming", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1034}, "CS", "DoubleExposureFlag", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1036}, "CS", "DoubleExposureOrdering", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1038}, "DS", "RETIRED_DoubleExposureMetersetTrial", "1", "DICOM/retired"},
+	{Tag{0x0074, 0x103A}, "DS", "RETIRED_DoubleExposureFieldDeltaTrial", "4", "DICOM/retired"},
+	{Tag{0x0074, 0x1040}, "SQ", "RelatedReferenceRTImageSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1042}, "SQ", "GeneralMachineVerificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1044}, "SQ", "ConventionalMachineVerificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1046}, "SQ", "IonMachineVerificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1048}, "SQ", "FailedAttributesSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x104A}, "SQ", "OverriddenAttributesSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x104C}, "SQ", "ConventionalControlPointVerificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x104E}, "SQ", "IonControlPointVerificationSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1050}, "SQ", "AttributeOccurrenceSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1052}, "AT", "AttributeOccurrencePointer", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1054}, "UL", "AttributeItemSelector", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1056}, "LO", "AttributeOccurrencePrivateCreator", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1057}, "IS", "SelectorSequencePointerItems", "1-n", "DICOM_2011"},
+	{Tag{0x0074, 0x1200}, "CS", "ScheduledProcedureStepPriority", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1202}, "LO", "WorklistLabel", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1204}, "LO", "ProcedureStepLabel", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1210}, "SQ", "ScheduledProcessingParametersSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1212}, "SQ", "PerformedProcessingParametersSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1216}, "SQ", "UnifiedProcedureStepPerformedProcedureSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1220}, "SQ", "RETIRED_RelatedProcedureStepSequence", "1", "DICOM/retired"},
+	{Tag{0x0074, 0x1222}, "LO", "RETIRED_ProcedureStepRelationshipType", "1", "DICOM/retired"},
+	{Tag{0x0074, 0x1224}, "SQ", "ReplacedProcedureStepSequence", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1230}, "LO", "DeletionLock", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1234}, "AE", "ReceivingAE", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1236}, "AE", "RequestingAE", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1238}, "LT", "ReasonForCancellation", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1242}, "CS", "SCPStatus", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1244}, "CS", "SubscriptionListStatus", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1246}, "CS", "UnifiedProcedureStepListStatus", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1324}, "UL", "BeamOrderIndex", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x1338}, "FD", "DoubleExposureMeterset", "1", "DICOM_2011"},
+	{Tag{0x0074, 0x133A}, "FD", "DoubleExposureFieldDelta", "4", "DICOM_2011"},
+	{Tag{0x0076, 0x0001}, "LO", "ImplantAssemblyTemplateName", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0003}, "LO", "ImplantAssemblyTemplateIssuer", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0006}, "LO", "ImplantAssemblyTemplateVersion", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0008}, "SQ", "ReplacedImplantAssemblyTemplateSequence", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x000A}, "CS", "ImplantAssemblyTemplateType", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x000C}, "SQ", "OriginalImplantAssemblyTemplateSequence", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x000E}, "SQ", "DerivationImplantAssemblyTemplateSequence", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0010}, "SQ", "ImplantAssemblyTemplateTargetAnatomySequence", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0020}, "SQ", "ProcedureTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0030}, "LO", "SurgicalTechnique", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0032}, "SQ", "ComponentTypesSequence", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0034}, "CS", "ComponentTypeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0036}, "CS", "ExclusiveComponentType", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0038}, "CS", "MandatoryComponentType", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0040}, "SQ", "ComponentSequence", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0055}, "US", "ComponentID", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0060}, "SQ", "ComponentAssemblySequence", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0070}, "US", "Component1ReferencedID", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0080}, "US", "Component1ReferencedMatingFeatureSetID", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x0090}, "US", "Component1ReferencedMatingFeatureID", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x00A0}, "US", "Component2ReferencedID", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x00B0}, "US", "Component2ReferencedMatingFeatureSetID", "1", "DICOM_2011"},
+	{Tag{0x0076, 0x00C0}, "US", "Component2ReferencedMatingFeatureID", "1", "DICOM_2011"},
+	{Tag{0x0078, 0x0001}, "LO", "ImplantTemplateGroupName", "1", "DICOM_2011"},
+	{Tag{0x0078, 0x0010}, "ST", "ImplantTemplateGroupDescription", "1", "DICOM_2011"},
+	{Tag{0x0078, 0x0020}, "LO", "ImplantTemplateGroupIssuer", "1", "DICOM_2011"},
+	{Tag{0x0078, 0x0024}, "LO", "ImplantTemplateGroupVersion", "1", "DICOM_2011"},
+	{Tag{0x0078, 0x0026}, "SQ", "ReplacedImplantTemplateGroupSequence", "1", "DICOM_2011"},
+	{Tag{0x0078, 0x0028}, "SQ", "ImplantTemplateGroupTargetAnatomySequence", "1", "DICOM_2011"},
+	{Tag{0x0078, 0x002A}, "SQ", "ImplantTemplateGroupMembersSequence", "1", "DICOM_2011"},
+	{Tag{0x0078, 0x002E}, "US", "ImplantTemplateGroupMemberID", "1", "DICOM_2011"},
+	{Tag{0x0078, 0x0050}, "FD", "ThreeDImplantTemplateGroupMemberMatchingPoint", "3", "DICOM_2011"},
+	{Tag{0x0078, 0x0060}, "FD", "ThreeDImplantTemplateGroupMemberMatchingAxes", "9", "DICOM_2011"},
+	{Tag{0x0078, 0x0070}, "SQ", "ImplantTemplateGroupMemberMatching2DCoordinatesSequence", "1", "DICOM_2011"},
+	{Tag{0x0078, 0x0090}, "FD", "TwoDImplantTemplateGroupMemberMatchingPoint", "2", "DICOM_2011"},
+	{Tag{0x0078, 0x00A0}, "FD", "TwoDImplantTemplateGroupMemberMatchingAxes", "4", "DICOM_2011"},
+	{Tag{0x0078, 0x00B0}, "SQ", "ImplantTemplateGroupVariationDimensionSequence", "1", "DICOM_2011"},
+	{Tag{0x0078, 0x00B2}, "LO", "ImplantTemplateGroupVariationDimensionName", "1", "DICOM_2011"},
+	{Tag{0x0078, 0x00B4}, "SQ", "ImplantTemplateGroupVariationDimensionRankSequence", "1", "DICOM_2011"},
+	{Tag{0x0078, 0x00B6}, "US", "ReferencedImplantTemplateGroupMemberID", "1", "DICOM_2011"},
+	{Tag{0x0078, 0x00B8}, "US", "ImplantTemplateGroupVariationDimensionRank", "1", "DICOM_2011"},
+	{Tag{0x0088, 0x0130}, "SH", "StorageMediaFileSetID", "1", "DICOM_2011"},
+	{Tag{0x0088, 0x0140}, "UI", "StorageMediaFileSetUID", "1", "DICOM_2011"},
+	{Tag{0x0088, 0x0200}, "SQ", "IconImageSequence", "1", "DICOM_2011"},
+	{Tag{0x0088, 0x0904}, "LO", "RETIRED_TopicTitle", "1", "DICOM/retired"},
+	{Tag{0x0088, 0x0906}, "ST", "RETIRED_TopicSubject", "1", "DICOM/retired"},
+	{Tag{0x0088, 0x0910}, "LO", "RETIRED_TopicAuthor", "1", "DICOM/retired"},
+	{Tag{0x0088, 0x0912}, "LO", "RETIRED_TopicKeywords", "1-32", "DICOM/retired"},
+	{Tag{0x0100, 0x0410}, "CS", "SOPInstanceStatus", "1", "DICOM_2011"},
+	{Tag{0x0100, 0x0420}, "DT", "SOPAuthorizationDateTime", "1", "DICOM_2011"},
+	{Tag{0x0100, 0x0424}, "LT", "SOPAuthorizationComment", "1", "DICOM_2011"},
+	{Tag{0x0100, 0x0426}, "LO", "AuthorizationEquipmentCertificationNumber", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0005}, "US", "MACIDNumber", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0010}, "UI", "MACCalculationTransferSyntaxUID", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0015}, "CS", "MACAlgorithm", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0020}, "AT", "DataElementsSigned", "1-n", "DICOM_2011"},
+	{Tag{0x0400, 0x0100}, "UI", "DigitalSignatureUID", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0105}, "DT", "DigitalSignatureDateTime", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0110}, "CS", "CertificateType", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0115}, "OB", "CertificateOfSigner", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0120}, "OB", "Signature", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0305}, "CS", "CertifiedTimestampType", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0310}, "OB", "CertifiedTimestamp", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0401}, "SQ", "DigitalSignaturePurposeCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0402}, "SQ", "ReferencedDigitalSignatureSequence", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0403}, "SQ", "ReferencedSOPInstanceMACSequence", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0404}, "OB", "MAC", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0500}, "SQ", "EncryptedAttributesSequence", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0510}, "UI", "EncryptedContentTransferSyntaxUID", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0520}, "OB", "EncryptedContent", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0550}, "SQ", "ModifiedAttributesSequence", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0561}, "SQ", "OriginalAttributesSequence", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0562}, "DT", "AttributeModificationDateTime", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0563}, "LO", "ModifyingSystem", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0564}, "LO", "SourceOfPreviousValues", "1", "DICOM_2011"},
+	{Tag{0x0400, 0x0565}, "CS", "ReasonForTheAttributeModification", "1", "DICOM_2011"},
+	{Tag{0x1000, 0x0000}, "UL", "ACR_NEMA_2C_CodeTableGroupLength", "1", "ACR/NEMA2C"},
+	{Tag{0x1000, 0x0010}, "US", "ACR_NEMA_2C_EscapeTriplet", "3", "ACR/NEMA2C"},
+	{Tag{0x1000, 0x0011}, "US", "ACR_NEMA_2C_RunLengthTriplet", "3", "ACR/NEMA2C"},
+	{Tag{0x1000, 0x0012}, "US", "ACR_NEMA_2C_HuffmanTableSize", "1", "ACR/NEMA2C"},
+	{Tag{0x1000, 0x0013}, "US", "ACR_NEMA_2C_HuffmanTableTriplet", "3", "ACR/NEMA2C"},
+	{Tag{0x1000, 0x0014}, "US", "ACR_NEMA_2C_ShiftTableSize", "1", "ACR/NEMA2C"},
+	{Tag{0x1000, 0x0015}, "US", "ACR_NEMA_2C_ShiftTableTriplet", "3", "ACR/NEMA2C"},
+	{Tag{0x1010, 0x0000}, "UL", "ACR_NEMA_2C_ZonalMapGroupLength", "1", "ACR/NEMA2C"},
+	{Tag{0x1010, 0x0004}, "US", "ACR_NEMA_2C_ZonalMap", "1-n", "ACR/NEMA2C"},
+	{Tag{0x2000, 0x0010}, "IS", "NumberOfCopies", "1", "DICOM_2011"},
+	{Tag{0x2000, 0x001E}, "SQ", "PrinterConfigurationSequence", "1", "DICOM_2011"},
+	{Tag{0x2000, 0x0020}, "CS", "PrintPriority", "1", "DICOM_2011"},
+	{Tag{0x2000, 0x0030}, "CS", "MediumType", "1", "DICOM_2011"},
+	{Tag{0x2000, 0x0040}, "CS", "FilmDestination", "1", "DICOM_2011"},
+	{Tag{0x2000, 0x0050}, "LO", "FilmSessionLabel", "1", "DICOM_2011"},
+	{Tag{0x2000, 0x0060}, "IS", "MemoryAllocation", "1", "DICOM_2011"},
+	{Tag{0x2000, 0x0061}, "IS", "MaximumMemoryAllocation", "1", "DICOM_2011"},
+	{Tag{0x2000, 0x0062}, "CS", "RETIRED_ColorImagePrintingFlag", "1", "DICOM/retired"},
+	{Tag{0x2000, 0x0063}, "CS", "RETIRED_CollationFlag", "1", "DICOM/retired"},
+	{Tag{0x2000, 0x0065}, "CS", "RETIRED_AnnotationFlag", "1", "DICOM/retired"},
+	{Tag{0x2000, 0x0067}, "CS", "RETIRED_ImageOverlayFlag", "1", "DICOM/retired"},
+	{Tag{0x2000, 0x0069}, "CS", "RETIRED_PresentationLUTFlag", "1", "DICOM/retired"},
+	{Tag{0x2000, 0x006A}, "CS", "RETIRED_ImageBoxPresentationLUTFlag", "1", "DICOM/retired"},
+	{Tag{0x2000, 0x00A0}, "US", "MemoryBitDepth", "1", "DICOM_2011"},
+	{Tag{0x2000, 0x00A1}, "US", "PrintingBitDepth", "1", "DICOM_2011"},
+	{Tag{0x2000, 0x00A2}, "SQ", "MediaInstalledSequence", "1", "DICOM_2011"},
+	{Tag{0x2000, 0x00A4}, "SQ", "OtherMediaAvailableSequence", "1", "DICOM_2011"},
+	{Tag{0x2000, 0x00A8}, "SQ", "SupportedImageDisplayFormatsSequence", "1", "DICOM_2011"},
+	{Tag{0x2000, 0x0500}, "SQ", "ReferencedFilmBoxSequence", "1", "DICOM_2011"},
+	{Tag{0x2000, 0x0510}, "SQ", "RETIRED_ReferencedStoredPrintSequence", "1", "DICOM/retired"},
+	{Tag{0x2010, 0x0010}, "ST", "ImageDisplayFormat", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0030}, "CS", "AnnotationDisplayFormatID", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0040}, "CS", "FilmOrientation", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0050}, "CS", "FilmSizeID", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0052}, "CS", "PrinterResolutionID", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0054}, "CS", "DefaultPrinterResolutionID", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0060}, "CS", "MagnificationType", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0080}, "CS", "SmoothingType", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x00A6}, "CS", "DefaultMagnificationType", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x00A7}, "CS", "OtherMagnificationTypesAvailable", "1-n", "DICOM_2011"},
+	{Tag{0x2010, 0x00A8}, "CS", "DefaultSmoothingType", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x00A9}, "CS", "OtherSmoothingTypesAvailable", "1-n", "DICOM_2011"},
+	{Tag{0x2010, 0x0100}, "CS", "BorderDensity", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0110}, "CS", "EmptyImageDensity", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0120}, "US", "MinDensity", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0130}, "US", "MaxDensity", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0140}, "CS", "Trim", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0150}, "ST", "ConfigurationInformation", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0152}, "LT", "ConfigurationInformationDescription", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0154}, "IS", "MaximumCollatedFilms", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x015E}, "US", "Illumination", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0160}, "US", "ReflectedAmbientLight", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0376}, "DS", "PrinterPixelSpacing", "2", "DICOM_2011"},
+	{Tag{0x2010, 0x0500}, "SQ", "ReferencedFilmSessionSequence", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0510}, "SQ", "ReferencedImageBoxSequence", "1", "DICOM_2011"},
+	{Tag{0x2010, 0x0520}, "SQ", "ReferencedBasicAnnotationBoxSequence", "1", "DICOM_2011"},
+	{Tag{0x2020, 0x0010}, "US", "ImageBoxPosition", "1", "DICOM_2011"},
+	{Tag{0x2020, 0x0020}, "CS", "Polarity", "1", "DICOM_2011"},
+	{Tag{0x2020, 0x0030}, "DS", "RequestedImageSize", "1", "DICOM_2011"},
+	{Tag{0x2020, 0x0040}, "CS", "RequestedDecimateCropBehavior", "1", "DICOM_2011"},
+	{Tag{0x2020, 0x0050}, "CS", "RequestedResolutionID", "1", "DICOM_2011"},
+	{Tag{0x2020, 0x00A0}, "CS", "RequestedImageSizeFlag", "1", "DICOM_2011"},
+	{Tag{0x2020, 0x00A2}, "CS", "DecimateCropResult", "1", "DICOM_2011"},
+	{Tag{0x2020, 0x0110}, "SQ", "BasicGrayscaleImageSequence", "1", "DICOM_2011"},
+	{Tag{0x2020, 0x0111}, "SQ", "BasicColorImageSequence", "1", "DICOM_2011"},
+	{Tag{0x2020, 0x0130}, "SQ", "RETIRED_ReferencedImageOverlayBoxSequence", "1", "DICOM/retired"},
+	{Tag{0x2020, 0x0140}, "SQ", "RETIRED_ReferencedVOILUTBoxSequence", "1", "DICOM/retired"},
+	{Tag{0x2030, 0x0010}, "US", "AnnotationPosition", "1", "DICOM_2011"},
+	{Tag{0x2030, 0x0020}, "LO", "TextString", "1", "DICOM_2011"},
+	{Tag{0x2040, 0x0010}, "SQ", "RETIRED_ReferencedOverlayPlaneSequence", "1", "DICOM/retired"},
+	{Tag{0x2040, 0x0011}, "US", "RETIRED_ReferencedOverlayPlaneGroups", "1-99", "DICOM/retired"},
+	{Tag{0x2040, 0x0020}, "SQ", "RETIRED_OverlayPixelDataSequence", "1", "DICOM/retired"},
+	{Tag{0x2040, 0x0060}, "CS", "RETIRED_OverlayMagnificationType", "1", "DICOM/retired"},
+	{Tag{0x2040, 0x0070}, "CS", "RETIRED_OverlaySmoothingType", "1", "DICOM/retired"},
+	{Tag{0x2040, 0x0072}, "CS", "RETIRED_OverlayOrImageMagnification", "1", "DICOM/retired"},
+	{Tag{0x2040, 0x0074}, "US", "RETIRED_MagnifyToNumberOfColumns", "1", "DICOM/retired"},
+	{Tag{0x2040, 0x0080}, "CS", "RETIRED_OverlayForegroundDensity", "1", "DICOM/retired"},
+	{Tag{0x2040, 0x0082}, "CS", "RETIRED_OverlayBackgroundDensity", "1", "DICOM/retired"},
+	{Tag{0x2040, 0x0090}, "CS", "RETIRED_OverlayMode", "1", "DICOM/retired"},
+	{Tag{0x2040, 0x0100}, "CS", "RETIRED_ThresholdDensity", "1", "DICOM/retired"},
+	{Tag{0x2040, 0x0500}, "SQ", "RETIRED_ReferencedImageBoxSequenceRetired", "1", "DICOM/retired"},
+	{Tag{0x2050, 0x0010}, "SQ", "PresentationLUTSequence", "1", "DICOM_2011"},
+	{Tag{0x2050, 0x0020}, "CS", "PresentationLUTShape", "1", "DICOM_2011"},
+	{Tag{0x2050, 0x0500}, "SQ", "ReferencedPresentationLUTSequence", "1", "DICOM_2011"},
+	{Tag{0x2100, 0x0010}, "SH", "RETIRED_PrintJobID", "1", "DICOM/retired"},
+	{Tag{0x2100, 0x0020}, "CS", "ExecutionStatus", "1", "DICOM_2011"},
+	{Tag{0x2100, 0x0030}, "CS", "ExecutionStatusInfo", "1", "DICOM_2011"},
+	{Tag{0x2100, 0x0040}, "DA", "CreationDate", "1", "DICOM_2011"},
+	{Tag{0x2100, 0x0050}, "TM", "CreationTime", "1", "DICOM_2011"},
+	{Tag{0x2100, 0x0070}, "AE", "Originator", "1", "DICOM_2011"},
+	{Tag{0x2100, 0x0140}, "AE", "RETIRED_DestinationAE", "1", "DICOM/retired"},
+	{Tag{0x2100, 0x0160}, "SH", "OwnerID", "1", "DICOM_2011"},
+	{Tag{0x2100, 0x0170}, "IS", "NumberOfFilms", "1", "DICOM_2011"},
+	{Tag{0x2100, 0x0500}, "SQ", "RETIRED_ReferencedPrintJobSequencePullStoredPrint", "1", "DICOM/retired"},
+	{Tag{0x2110, 0x0010}, "CS", "PrinterStatus", "1", "DICOM_2011"},
+	{Tag{0x2110, 0x0020}, "CS", "PrinterStatusInfo", "1", "DICOM_2011"},
+	{Tag{0x2110, 0x0030}, "LO", "PrinterName", "1", "DICOM_2011"},
+	{Tag{0x2110, 0x0099}, "SH", "RETIRED_PrintQueueID", "1", "DICOM/retired"},
+	{Tag{0x2120, 0x0010}, "CS", "RETIRED_QueueStatus", "1", "DICOM/retired"},
+	{Tag{0x2120, 0x0050}, "SQ", "RETIRED_PrintJobDescriptionSequence", "1", "DICOM/retired"},
+	{Tag{0x2120, 0x0070}, "SQ", "RETIRED_ReferencedPrintJobSequence", "1", "DICOM/retired"},
+	{Tag{0x2130, 0x0010}, "SQ", "RETIRED_PrintManagementCapabilitiesSequence", "1", "DICOM/retired"},
+	{Tag{0x2130, 0x0015}, "SQ", "RETIRED_PrinterCharacteristicsSequence", "1", "DICOM/retired"},
+	{Tag{0x2130, 0x0030}, "SQ", "RETIRED_FilmBoxContentSequence", "1", "DICOM/retired"},
+	{Tag{0x2130, 0x0040}, "SQ", "RETIRED_ImageBoxContentSequence", "1", "DICOM/retired"},
+	{Tag{0x2130, 0x0050}, "SQ", "RETIRED_AnnotationContentSequence", "1", "DICOM/retired"},
+	{Tag{0x2130, 0x0060}, "SQ", "RETIRED_ImageOverlayBoxContentSequence", "1", "DICOM/retired"},
+	{Tag{0x2130, 0x0080}, "SQ", "RETIRED_PresentationLUTContentSequence", "1", "DICOM/retired"},
+	{Tag{0x2130, 0x00A0}, "SQ", "RETIRED_ProposedStudySequence", "1", "DICOM/retired"},
+	{Tag{0x2130, 0x00C0}, "SQ", "RETIRED_OriginalImageSequence", "1", "DICOM/retired"},
+	{Tag{0x2200, 0x0001}, "CS", "LabelUsingInformationExtractedFromInstances", "1", "DICOM_2011"},
+	{Tag{0x2200, 0x0002}, "UT", "LabelText", "1", "DICOM_2011"},
+	{Tag{0x2200, 0x0003}, "CS", "LabelStyleSelection", "1", "DICOM_2011"},
+	{Tag{0x2200, 0x0004}, "LT", "MediaDisposition", "1", "DICOM_2011"},
+	{Tag{0x2200, 0x0005}, "LT", "BarcodeValue", "1", "DICOM_2011"},
+	{Tag{0x2200, 0x0006}, "CS", "BarcodeSymbology", "1", "DICOM_2011"},
+	{Tag{0x2200, 0x0007}, "CS", "AllowMediaSplitting", "1", "DICOM_2011"},
+	{Tag{0x2200, 0x0008}, "CS", "IncludeNonDICOMObjects", "1", "DICOM_2011"},
+	{Tag{0x2200, 0x0009}, "CS", "IncludeDisplayApplication", "1", "DICOM_2011"},
+	{Tag{0x2200, 0x000A}, "CS", "PreserveCompositeInstancesAfterMediaCreation", "1", "DICOM_2011"},
+	{Tag{0x2200, 0x000B}, "US", "TotalNumberOfPiecesOfMediaCreated", "1", "DICOM_2011"},
+	{Tag{0x2200, 0x000C}, "LO", "RequestedMediaApplicationProfile", "1", "DICOM_2011"},
+	{Tag{0x2200, 0x000D}, "SQ", "ReferencedStorageMediaSequence", "1", "DICOM_2011"},
+	{Tag{0x2200, 0x000E}, "AT", "FailureAttributes", "1-n", "DICOM_2011"},
+	{Tag{0x2200, 0x000F}, "CS", "AllowLossyCompression", "1", "DICOM_2011"},
+	{Tag{0x2200, 0x0020}, "CS", "RequestPriority", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0002}, "SH", "RTImageLabel", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0003}, "LO", "RTImageName", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0004}, "ST", "RTImageDescription", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x000A}, "CS", "ReportedValuesOrigin", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x000C}, "CS", "RTImagePlane", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x000D}, "DS", "XRayImageReceptorTranslation", "3", "DICOM_2011"},
+	{Tag{0x3002, 0x000E}, "DS", "XRayImageReceptorAngle", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0010}, "DS", "RTImageOrientation", "6", "DICOM_2011"},
+	{Tag{0x3002, 0x0011}, "DS", "ImagePlanePixelSpacing", "2", "DICOM_2011"},
+	{Tag{0x3002, 0x0012}, "DS", "RTImagePosition", "2", "DICOM_2011"},
+	{Tag{0x3002, 0x0020}, "SH", "RadiationMachineName", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0022}, "DS", "RadiationMachineSAD", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0024}, "DS", "RadiationMachineSSD", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0026}, "DS", "RTImageSID", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0028}, "DS", "SourceToReferenceObjectDistance", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0029}, "IS", "FractionNumber", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0030}, "SQ", "ExposureSequence", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0032}, "DS", "MetersetExposure", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0034}, "DS", "DiaphragmPosition", "4", "DICOM_2011"},
+	{Tag{0x3002, 0x0040}, "SQ", "FluenceMapSequence", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0041}, "CS", "FluenceDataSource", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0042}, "DS", "FluenceDataScale", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0050}, "SQ", "PrimaryFluenceModeSequence", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0051}, "CS", "FluenceMode", "1", "DICOM_2011"},
+	{Tag{0x3002, 0x0052}, "SH", "FluenceModeID", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0001}, "CS", "DVHType", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0002}, "CS", "DoseUnits", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0004}, "CS", "DoseType", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0006}, "LO", "DoseComment", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0008}, "DS", "NormalizationPoint", "3", "DICOM_2011"},
+	{Tag{0x3004, 0x000A}, "CS", "DoseSummationType", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x000C}, "DS", "GridFrameOffsetVector", "2-n", "DICOM_2011"},
+	{Tag{0x3004, 0x000E}, "DS", "DoseGridScaling", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0010}, "SQ", "RTDoseROISequence", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0012}, "DS", "DoseValue", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0014}, "CS", "TissueHeterogeneityCorrection", "1-3", "DICOM_2011"},
+	{Tag{0x3004, 0x0040}, "DS", "DVHNormalizationPoint", "3", "DICOM_2011"},
+	{Tag{0x3004, 0x0042}, "DS", "DVHNormalizationDoseValue", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0050}, "SQ", "DVHSequence", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0052}, "DS", "DVHDoseScaling", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0054}, "CS", "DVHVolumeUnits", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0056}, "IS", "DVHNumberOfBins", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0058}, "DS", "DVHData", "2-2n", "DICOM_2011"},
+	{Tag{0x3004, 0x0060}, "SQ", "DVHReferencedROISequence", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0062}, "CS", "DVHROIContributionType", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0070}, "DS", "DVHMinimumDose", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0072}, "DS", "DVHMaximumDose", "1", "DICOM_2011"},
+	{Tag{0x3004, 0x0074}, "DS", "DVHMeanDose", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0002}, "SH", "StructureSetLabel", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0004}, "LO", "StructureSetName", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0006}, "ST", "StructureSetDescription", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0008}, "DA", "StructureSetDate", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0009}, "TM", "StructureSetTime", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0010}, "SQ", "ReferencedFrameOfReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0012}, "SQ", "RTReferencedStudySequence", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0014}, "SQ", "RTReferencedSeriesSequence", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0016}, "SQ", "ContourImageSequence", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0018}, "SQ", "PredecessorStructureSetSequence", "1", "CP_1137"},
+	{Tag{0x3006, 0x0020}, "SQ", "StructureSetROISequence", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0022}, "IS", "ROINumber", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0024}, "UI", "ReferencedFrameOfReferenceUID", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0026}, "LO", "ROIName", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0028}, "ST", "ROIDescription", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x002A}, "IS", "ROIDisplayColor", "3", "DICOM_2011"},
+	{Tag{0x3006, 0x002C}, "DS", "ROIVolume", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0030}, "SQ", "RTRelatedROISequence", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0033}, "CS", "RTROIRelationship", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0036}, "CS", "ROIGenerationAlgorithm", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0038}, "LO", "ROIGenerationDescription", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0039}, "SQ", "ROIContourSequence", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0040}, "SQ", "ContourSequence", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0042}, "CS", "ContourGeometricType", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0044}, "DS", "ContourSlabThickness", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0045}, "DS", "ContourOffsetVector", "3", "DICOM_2011"},
+	{Tag{0x3006, 0x0046}, "IS", "NumberOfContourPoints", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0048}, "IS", "ContourNumber", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0049}, "IS", "AttachedContours", "1-n", "DICOM_2011"},
+	{Tag{0x3006, 0x0050}, "DS", "ContourData", "3-3n", "DICOM_2011"},
+	{Tag{0x3006, 0x0080}, "SQ", "RTROIObservationsSequence", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0082}, "IS", "ObservationNumber", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0084}, "IS", "ReferencedROINumber", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0085}, "SH", "ROIObservationLabel", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0086}, "SQ", "RTROIIdentificationCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x0088}, "ST", "ROIObservationDescription", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x00A0}, "SQ", "RelatedRTROIObservationsSequence", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x00A4}, "CS", "RTROIInterpretedType", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x00A6}, "PN", "ROIInterpreter", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x00B0}, "SQ", "ROIPhysicalPropertiesSequence", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x00B2}, "CS", "ROIPhysicalProperty", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x00B4}, "DS", "ROIPhysicalPropertyValue", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x00B6}, "SQ", "ROIElementalCompositionSequence", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x00B7}, "US", "ROIElementalCompositionAtomicNumber", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x00B8}, "FL", "ROIElementalCompositionAtomicMassFraction", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x00C0}, "SQ", "FrameOfReferenceRelationshipSequence", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x00C2}, "UI", "RelatedFrameOfReferenceUID", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x00C4}, "CS", "FrameOfReferenceTransformationType", "1", "DICOM_2011"},
+	{Tag{0x3006, 0x00C6}, "DS", "FrameOfReferenceTransformationMatrix", "16", "DICOM_2011"},
+	{Tag{0x3006, 0x00C8}, "LO", "FrameOfReferenceTransformationComment", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0010}, "SQ", "MeasuredDoseReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0012}, "ST", "MeasuredDoseDescription", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0014}, "CS", "MeasuredDoseType", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0016}, "DS", "MeasuredDoseValue", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0020}, "SQ", "TreatmentSessionBeamSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0021}, "SQ", "TreatmentSessionIonBeamSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0022}, "IS", "CurrentFractionNumber", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0024}, "DA", "TreatmentControlPointDate", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0025}, "TM", "TreatmentControlPointTime", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x002A}, "CS", "TreatmentTerminationStatus", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x002B}, "SH", "TreatmentTerminationCode", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x002C}, "CS", "TreatmentVerificationStatus", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0030}, "SQ", "ReferencedTreatmentRecordSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0032}, "DS", "SpecifiedPrimaryMeterset", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0033}, "DS", "SpecifiedSecondaryMeterset", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0036}, "DS", "DeliveredPrimaryMeterset", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0037}, "DS", "DeliveredSecondaryMeterset", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x003A}, "DS", "SpecifiedTreatmentTime", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x003B}, "DS", "DeliveredTreatmentTime", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0040}, "SQ", "ControlPointDeliverySequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0041}, "SQ", "IonControlPointDeliverySequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0042}, "DS", "SpecifiedMeterset", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0044}, "DS", "DeliveredMeterset", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0045}, "FL", "MetersetRateSet", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0046}, "FL", "MetersetRateDelivered", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0047}, "FL", "ScanSpotMetersetsDelivered", "1-n", "DICOM_2011"},
+	{Tag{0x3008, 0x0048}, "DS", "DoseRateDelivered", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0050}, "SQ", "TreatmentSummaryCalculatedDoseReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0052}, "DS", "CumulativeDoseToDoseReference", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0054}, "DA", "FirstTreatmentDate", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0056}, "DA", "MostRecentTreatmentDate", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x005A}, "IS", "NumberOfFractionsDelivered", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0060}, "SQ", "OverrideSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0061}, "AT", "ParameterSequencePointer", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0062}, "AT", "OverrideParameterPointer", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0063}, "IS", "ParameterItemIndex", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0064}, "IS", "MeasuredDoseReferenceNumber", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0065}, "AT", "ParameterPointer", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0066}, "ST", "OverrideReason", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0068}, "SQ", "CorrectedParameterSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x006A}, "FL", "CorrectionValue", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0070}, "SQ", "CalculatedDoseReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0072}, "IS", "CalculatedDoseReferenceNumber", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0074}, "ST", "CalculatedDoseReferenceDescription", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0076}, "DS", "CalculatedDoseReferenceDoseValue", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0078}, "DS", "StartMeterset", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x007A}, "DS", "EndMeterset", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0080}, "SQ", "ReferencedMeasuredDoseReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0082}, "IS", "ReferencedMeasuredDoseReferenceNumber", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0090}, "SQ", "ReferencedCalculatedDoseReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0092}, "IS", "ReferencedCalculatedDoseReferenceNumber", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x00A0}, "SQ", "BeamLimitingDeviceLeafPairsSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x00B0}, "SQ", "RecordedWedgeSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x00C0}, "SQ", "RecordedCompensatorSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x00D0}, "SQ", "RecordedBlockSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x00E0}, "SQ", "TreatmentSummaryMeasuredDoseReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x00F0}, "SQ", "RecordedSnoutSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x00F2}, "SQ", "RecordedRangeShifterSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x00F4}, "SQ", "RecordedLateralSpreadingDeviceSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x00F6}, "SQ", "RecordedRangeModulatorSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0100}, "SQ", "RecordedSourceSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0105}, "LO", "SourceSerialNumber", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0110}, "SQ", "TreatmentSessionApplicationSetupSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0116}, "CS", "ApplicationSetupCheck", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0120}, "SQ", "RecordedBrachyAccessoryDeviceSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0122}, "IS", "ReferencedBrachyAccessoryDeviceNumber", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0130}, "SQ", "RecordedChannelSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0132}, "DS", "SpecifiedChannelTotalTime", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0134}, "DS", "DeliveredChannelTotalTime", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0136}, "IS", "SpecifiedNumberOfPulses", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0138}, "IS", "DeliveredNumberOfPulses", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x013A}, "DS", "SpecifiedPulseRepetitionInterval", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x013C}, "DS", "DeliveredPulseRepetitionInterval", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0140}, "SQ", "RecordedSourceApplicatorSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0142}, "IS", "ReferencedSourceApplicatorNumber", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0150}, "SQ", "RecordedChannelShieldSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0152}, "IS", "ReferencedChannelShieldNumber", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0160}, "SQ", "BrachyControlPointDeliveredSequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0162}, "DA", "SafePositionExitDate", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0164}, "TM", "SafePositionExitTime", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0166}, "DA", "SafePositionReturnDate", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0168}, "TM", "SafePositionReturnTime", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0200}, "CS", "CurrentTreatmentStatus", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0202}, "ST", "TreatmentStatusComment", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0220}, "SQ", "FractionGroupSummarySequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0223}, "IS", "ReferencedFractionNumber", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0224}, "CS", "FractionGroupType", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0230}, "CS", "BeamStopperPosition", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0240}, "SQ", "FractionStatusSummarySequence", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0250}, "DA", "TreatmentDate", "1", "DICOM_2011"},
+	{Tag{0x3008, 0x0251}, "TM", "TreatmentTime", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0002}, "SH", "RTPlanLabel", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0003}, "LO", "RTPlanName", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0004}, "ST", "RTPlanDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0006}, "DA", "RTPlanDate", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0007}, "TM", "RTPlanTime", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0009}, "LO", "TreatmentProtocols", "1-n", "DICOM_2011"},
+	{Tag{0x300A, 0x000A}, "CS", "PlanIntent", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x000B}, "LO", "TreatmentSites", "1-n", "DICOM_2011"},
+	{Tag{0x300A, 0x000C}, "CS", "RTPlanGeometry", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x000E}, "ST", "PrescriptionDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0010}, "SQ", "DoseReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0012}, "IS", "DoseReferenceNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0013}, "UI", "DoseReferenceUID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0014}, "CS", "DoseReferenceStructureType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0015}, "CS", "NominalBeamEnergyUnit", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0016}, "LO", "DoseReferenceDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0018}, "DS", "DoseReferencePointCoordinates", "3", "DICOM_2011"},
+	{Tag{0x300A, 0x001A}, "DS", "NominalPriorDose", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0020}, "CS", "DoseReferenceType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0021}, "DS", "ConstraintWeight", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0022}, "DS", "DeliveryWarningDose", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0023}, "DS", "DeliveryMaximumDose", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0025}, "DS", "TargetMinimumDose", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0026}, "DS", "TargetPrescriptionDose", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0027}, "DS", "TargetMaximumDose", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0028}, "DS", "TargetUnderdoseVolumeFraction", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x002A}, "DS", "OrganAtRiskFullVolumeDose", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x002B}, "DS", "OrganAtRiskLimitDose", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x002C}, "DS", "OrganAtRiskMaximumDose", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x002D}, "DS", "OrganAtRiskOverdoseVolumeFraction", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0040}, "SQ", "ToleranceTableSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0042}, "IS", "ToleranceTableNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0043}, "SH", "ToleranceTableLabel", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0044}, "DS", "GantryAngleTolerance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0046}, "DS", "BeamLimitingDeviceAngleTolerance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0048}, "SQ", "BeamLimitingDeviceToleranceSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x004A}, "DS", "BeamLimitingDevicePositionTolerance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x004B}, "FL", "SnoutPositionTolerance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x004C}, "DS", "PatientSupportAngleTolerance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x004E}, "DS", "TableTopEccentricAngleTolerance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x004F}, "FL", "TableTopPitchAngleTolerance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0050}, "FL", "TableTopRollAngleTolerance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0051}, "DS", "TableTopVerticalPositionTolerance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0052}, "DS", "TableTopLongitudinalPositionTolerance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0053}, "DS", "TableTopLateralPositionTolerance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0055}, "CS", "RTPlanRelationship", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0070}, "SQ", "FractionGroupSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0071}, "IS", "FractionGroupNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0072}, "LO", "FractionGroupDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0078}, "IS", "NumberOfFractionsPlanned", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0079}, "IS", "NumberOfFractionPatternDigitsPerDay", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x007A}, "IS", "RepeatFractionCycleLength", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x007B}, "LT", "FractionPattern", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0080}, "IS", "NumberOfBeams", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0082}, "DS", "BeamDoseSpecificationPoint", "3", "DICOM_2011"},
+	{Tag{0x300A, 0x0084}, "DS", "BeamDose", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0086}, "DS", "BeamMeterset", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0088}, "FL", "BeamDosePointDepth", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0088}, "FL", "RETIRED_BeamDosePointDepth", "1", "CP_1138/retired"},
+	{Tag{0x300A, 0x0089}, "FL", "BeamDosePointEquivalentDepth", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0089}, "FL", "RETIRED_BeamDosePointEquivalentDepth", "1", "CP_1138/retired"},
+	{Tag{0x300A, 0x008A}, "FL", "BeamDosePointSSD", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x008A}, "FL", "RETIRED_BeamDosePointSSD", "1", "CP_1138/retired"},
+	{Tag{0x300A, 0x008C}, "SQ", "BeamDoseVerificationControlPointSequence", "1", "CP_1138"},
+	{Tag{0x300A, 0x008D}, "FL", "AverageBeamDosePointDepth", "1", "CP_1138"},
+	{Tag{0x300A, 0x008E}, "FL", "AverageBeamDosePointEquivalentDepth", "1", "CP_1138"},
+	{Tag{0x300A, 0x008F}, "FL", "AverageBeamDosePointSSD", "1", "CP_1138"},
+	{Tag{0x300A, 0x00A0}, "IS", "NumberOfBrachyApplicationSetups", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00A2}, "DS", "BrachyApplicationSetupDoseSpecificationPoint", "3", "DICOM_2011"},
+	{Tag{0x300A, 0x00A4}, "DS", "BrachyApplicationSetupDose", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00B0}, "SQ", "BeamSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00B2}, "SH", "TreatmentMachineName", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00B3}, "CS", "PrimaryDosimeterUnit", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00B4}, "DS", "SourceAxisDistance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00B6}, "SQ", "BeamLimitingDeviceSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00B8}, "CS", "RTBeamLimitingDeviceType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00BA}, "DS", "SourceToBeamLimitingDeviceDistance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00BB}, "FL", "IsocenterToBeamLimitingDeviceDistance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00BC}, "IS", "NumberOfLeafJawPairs", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00BE}, "DS", "LeafPositionBoundaries", "3-n", "DICOM_2011"},
+	{Tag{0x300A, 0x00C0}, "IS", "BeamNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00C2}, "LO", "BeamName", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00C3}, "ST", "BeamDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00C4}, "CS", "BeamType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00C6}, "CS", "RadiationType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00C7}, "CS", "HighDoseTechniqueType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00C8}, "IS", "ReferenceImageNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00CA}, "SQ", "PlannedVerificationImageSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00CC}, "LO", "ImagingDeviceSpecificAcquisitionParameters", "1-n", "DICOM_2011"},
+	{Tag{0x300A, 0x00CE}, "CS", "TreatmentDeliveryType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00D0}, "IS", "NumberOfWedges", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00D1}, "SQ", "WedgeSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00D2}, "IS", "WedgeNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00D3}, "CS", "WedgeType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00D4}, "SH", "WedgeID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00D5}, "IS", "WedgeAngle", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00D6}, "DS", "WedgeFactor", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00D7}, "FL", "TotalWedgeTrayWaterEquivalentThickness", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00D8}, "DS", "WedgeOrientation", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00D9}, "FL", "IsocenterToWedgeTrayDistance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00DA}, "DS", "SourceToWedgeTrayDistance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00DB}, "FL", "WedgeThinEdgePosition", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00DC}, "SH", "BolusID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00DD}, "ST", "BolusDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00E0}, "IS", "NumberOfCompensators", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00E1}, "SH", "MaterialID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00E2}, "DS", "TotalCompensatorTrayFactor", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00E3}, "SQ", "CompensatorSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00E4}, "IS", "CompensatorNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00E5}, "SH", "CompensatorID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00E6}, "DS", "SourceToCompensatorTrayDistance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00E7}, "IS", "CompensatorRows", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00E8}, "IS", "CompensatorColumns", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00E9}, "DS", "CompensatorPixelSpacing", "2", "DICOM_2011"},
+	{Tag{0x300A, 0x00EA}, "DS", "CompensatorPosition", "2", "DICOM_2011"},
+	{Tag{0x300A, 0x00EB}, "DS", "CompensatorTransmissionData", "1-n", "DICOM_2011"},
+	{Tag{0x300A, 0x00EC}, "DS", "CompensatorThicknessData", "1-n", "DICOM_2011"},
+	{Tag{0x300A, 0x00ED}, "IS", "NumberOfBoli", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00EE}, "CS", "CompensatorType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00EF}, "SH", "CompensatorTrayID", "1", "CP_1188"},
+	{Tag{0x300A, 0x00F0}, "IS", "NumberOfBlocks", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00F2}, "DS", "TotalBlockTrayFactor", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00F3}, "FL", "TotalBlockTrayWaterEquivalentThickness", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00F4}, "SQ", "BlockSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00F5}, "SH", "BlockTrayID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00F6}, "DS", "SourceToBlockTrayDistance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00F7}, "FL", "IsocenterToBlockTrayDistance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00F8}, "CS", "BlockType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00F9}, "LO", "AccessoryCode", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00FA}, "CS", "BlockDivergence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00FB}, "CS", "BlockMountingPosition", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00FC}, "IS", "BlockNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x00FE}, "LO", "BlockName", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0100}, "DS", "BlockThickness", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0102}, "DS", "BlockTransmission", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0104}, "IS", "BlockNumberOfPoints", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0106}, "DS", "BlockData", "2-2n", "DICOM_2011"},
+	{Tag{0x300A, 0x0107}, "SQ", "ApplicatorSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0108}, "SH", "ApplicatorID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0109}, "CS", "ApplicatorType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x010A}, "LO", "ApplicatorDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x010C}, "DS", "CumulativeDoseReferenceCoefficient", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x010E}, "DS", "FinalCumulativeMetersetWeight", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0110}, "IS", "NumberOfControlPoints", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0111}, "SQ", "ControlPointSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0112}, "IS", "ControlPointIndex", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0114}, "DS", "NominalBeamEnergy", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0115}, "DS", "DoseRateSet", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0116}, "SQ", "WedgePositionSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0118}, "CS", "WedgePosition", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x011A}, "SQ", "BeamLimitingDevicePositionSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x011C}, "DS", "LeafJawPositions", "2-2n", "DICOM_2011"},
+	{Tag{0x300A, 0x011E}, "DS", "GantryAngle", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x011F}, "CS", "GantryRotationDirection", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0120}, "DS", "BeamLimitingDeviceAngle", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0121}, "CS", "BeamLimitingDeviceRotationDirection", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0122}, "DS", "PatientSupportAngle", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0123}, "CS", "PatientSupportRotationDirection", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0124}, "DS", "TableTopEccentricAxisDistance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0125}, "DS", "TableTopEccentricAngle", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0126}, "CS", "TableTopEccentricRotationDirection", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0128}, "DS", "TableTopVerticalPosition", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0129}, "DS", "TableTopLongitudinalPosition", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x012A}, "DS", "TableTopLateralPosition", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x012C}, "DS", "IsocenterPosition", "3", "DICOM_2011"},
+	{Tag{0x300A, 0x012E}, "DS", "SurfaceEntryPoint", "3", "DICOM_2011"},
+	{Tag{0x300A, 0x0130}, "DS", "SourceToSurfaceDistance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0134}, "DS", "CumulativeMetersetWeight", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0140}, "FL", "TableTopPitchAngle", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0142}, "CS", "TableTopPitchRotationDirection", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0144}, "FL", "TableTopRollAngle", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0146}, "CS", "TableTopRollRotationDirection", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0148}, "FL", "HeadFixationAngle", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x014A}, "FL", "GantryPitchAngle", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x014C}, "CS", "GantryPitchRotationDirection", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x014E}, "FL", "GantryPitchAngleTolerance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0180}, "SQ", "PatientSetupSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0182}, "IS", "PatientSetupNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0183}, "LO", "PatientSetupLabel", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0184}, "LO", "PatientAdditionalPosition", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0190}, "SQ", "FixationDeviceSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0192}, "CS", "FixationDeviceType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0194}, "SH", "FixationDeviceLabel", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0196}, "ST", "FixationDeviceDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0198}, "SH", "FixationDevicePosition", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0199}, "FL", "FixationDevicePitchAngle", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x019A}, "FL", "FixationDeviceRollAngle", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01A0}, "SQ", "ShieldingDeviceSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01A2}, "CS", "ShieldingDeviceType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01A4}, "SH", "ShieldingDeviceLabel", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01A6}, "ST", "ShieldingDeviceDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01A8}, "SH", "ShieldingDevicePosition", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01B0}, "CS", "SetupTechnique", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01B2}, "ST", "SetupTechniqueDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01B4}, "SQ", "SetupDeviceSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01B6}, "CS", "SetupDeviceType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01B8}, "SH", "SetupDeviceLabel", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01BA}, "ST", "SetupDeviceDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01BC}, "DS", "SetupDeviceParameter", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01D0}, "ST", "SetupReferenceDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01D2}, "DS", "TableTopVerticalSetupDisplacement", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01D4}, "DS", "TableTopLongitudinalSetupDisplacement", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x01D6}, "DS", "TableTopLateralSetupDisplacement", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0200}, "CS", "BrachyTreatmentTechnique", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0202}, "CS", "BrachyTreatmentType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0206}, "SQ", "TreatmentMachineSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0210}, "SQ", "SourceSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0212}, "IS", "SourceNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0214}, "CS", "SourceType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0216}, "LO", "SourceManufacturer", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0218}, "DS", "ActiveSourceDiameter", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x021A}, "DS", "ActiveSourceLength", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x021B}, "SH", "SourceModel", "1", "CP_1204"},
+	{Tag{0x300A, 0x021C}, "LO", "SourceDescription", "1", "CP_1204"},
+	{Tag{0x300A, 0x0222}, "DS", "SourceEncapsulationNominalThickness", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0224}, "DS", "SourceEncapsulationNominalTransmission", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0226}, "LO", "SourceIsotopeName", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0228}, "DS", "SourceIsotopeHalfLife", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0229}, "CS", "SourceStrengthUnits", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x022A}, "DS", "ReferenceAirKermaRate", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x022B}, "DS", "SourceStrength", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x022C}, "DA", "SourceStrengthReferenceDate", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x022E}, "TM", "SourceStrengthReferenceTime", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0230}, "SQ", "ApplicationSetupSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0232}, "CS", "ApplicationSetupType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0234}, "IS", "ApplicationSetupNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0236}, "LO", "ApplicationSetupName", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0238}, "LO", "ApplicationSetupManufacturer", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0240}, "IS", "TemplateNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0242}, "SH", "TemplateType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0244}, "LO", "TemplateName", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0250}, "DS", "TotalReferenceAirKerma", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0260}, "SQ", "BrachyAccessoryDeviceSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0262}, "IS", "BrachyAccessoryDeviceNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0263}, "SH", "BrachyAccessoryDeviceID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0264}, "CS", "BrachyAccessoryDeviceType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0266}, "LO", "BrachyAccessoryDeviceName", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x026A}, "DS", "BrachyAccessoryDeviceNominalThickness", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x026C}, "DS", "BrachyAccessoryDeviceNominalTransmission", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0280}, "SQ", "ChannelSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0282}, "IS", "ChannelNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0284}, "DS", "ChannelLength", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0286}, "DS", "ChannelTotalTime", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0288}, "CS", "SourceMovementType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x028A}, "IS", "NumberOfPulses", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x028C}, "DS", "PulseRepetitionInterval", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0290}, "IS", "SourceApplicatorNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0291}, "SH", "SourceApplicatorID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0292}, "CS", "SourceApplicatorType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0294}, "LO", "SourceApplicatorName", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0296}, "DS", "SourceApplicatorLength", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0298}, "LO", "SourceApplicatorManufacturer", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x029C}, "DS", "SourceApplicatorWallNominalThickness", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x029E}, "DS", "SourceApplicatorWallNominalTransmission", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02A0}, "DS", "SourceApplicatorStepSize", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02A2}, "IS", "TransferTubeNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02A4}, "DS", "TransferTubeLength", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02B0}, "SQ", "ChannelShieldSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02B2}, "IS", "ChannelShieldNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02B3}, "SH", "ChannelShieldID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02B4}, "LO", "ChannelShieldName", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02B8}, "DS", "ChannelShieldNominalThickness", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02BA}, "DS", "ChannelShieldNominalTransmission", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02C8}, "DS", "FinalCumulativeTimeWeight", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02D0}, "SQ", "BrachyControlPointSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02D2}, "DS", "ControlPointRelativePosition", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02D4}, "DS", "ControlPoint3DPosition", "3", "DICOM_2011"},
+	{Tag{0x300A, 0x02D6}, "DS", "CumulativeTimeWeight", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02E0}, "CS", "CompensatorDivergence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02E1}, "CS", "CompensatorMountingPosition", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02E2}, "DS", "SourceToCompensatorDistance", "1-n", "DICOM_2011"},
+	{Tag{0x300A, 0x02E3}, "FL", "TotalCompensatorTrayWaterEquivalentThickness", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02E4}, "FL", "IsocenterToCompensatorTrayDistance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02E5}, "FL", "CompensatorColumnOffset", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02E6}, "FL", "IsocenterToCompensatorDistances", "1-n", "DICOM_2011"},
+	{Tag{0x300A, 0x02E7}, "FL", "CompensatorRelativeStoppingPowerRatio", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02E8}, "FL", "CompensatorMillingToolDiameter", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02EA}, "SQ", "IonRangeCompensatorSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x02EB}, "LT", "CompensatorDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0302}, "IS", "RadiationMassNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0304}, "IS", "RadiationAtomicNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0306}, "SS", "RadiationChargeState", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0308}, "CS", "ScanMode", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x030A}, "FL", "VirtualSourceAxisDistances", "2", "DICOM_2011"},
+	{Tag{0x300A, 0x030C}, "SQ", "SnoutSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x030D}, "FL", "SnoutPosition", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x030F}, "SH", "SnoutID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0312}, "IS", "NumberOfRangeShifters", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0314}, "SQ", "RangeShifterSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0316}, "IS", "RangeShifterNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0318}, "SH", "RangeShifterID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0320}, "CS", "RangeShifterType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0322}, "LO", "RangeShifterDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0330}, "IS", "NumberOfLateralSpreadingDevices", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0332}, "SQ", "LateralSpreadingDeviceSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0334}, "IS", "LateralSpreadingDeviceNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0336}, "SH", "LateralSpreadingDeviceID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0338}, "CS", "LateralSpreadingDeviceType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x033A}, "LO", "LateralSpreadingDeviceDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x033C}, "FL", "LateralSpreadingDeviceWaterEquivalentThickness", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0340}, "IS", "NumberOfRangeModulators", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0342}, "SQ", "RangeModulatorSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0344}, "IS", "RangeModulatorNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0346}, "SH", "RangeModulatorID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0348}, "CS", "RangeModulatorType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x034A}, "LO", "RangeModulatorDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x034C}, "SH", "BeamCurrentModulationID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0350}, "CS", "PatientSupportType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0352}, "SH", "PatientSupportID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0354}, "LO", "PatientSupportAccessoryCode", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0356}, "FL", "FixationLightAzimuthalAngle", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0358}, "FL", "FixationLightPolarAngle", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x035A}, "FL", "MetersetRate", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0360}, "SQ", "RangeShifterSettingsSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0362}, "LO", "RangeShifterSetting", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0364}, "FL", "IsocenterToRangeShifterDistance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0366}, "FL", "RangeShifterWaterEquivalentThickness", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0370}, "SQ", "LateralSpreadingDeviceSettingsSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0372}, "LO", "LateralSpreadingDeviceSetting", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0374}, "FL", "IsocenterToLateralSpreadingDeviceDistance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0380}, "SQ", "RangeModulatorSettingsSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0382}, "FL", "RangeModulatorGatingStartValue", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0384}, "FL", "RangeModulatorGatingStopValue", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0386}, "FL", "RangeModulatorGatingStartWaterEquivalentThickness", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0388}, "FL", "RangeModulatorGatingStopWaterEquivalentThickness", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x038A}, "FL", "IsocenterToRangeModulatorDistance", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0390}, "SH", "ScanSpotTuneID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0392}, "IS", "NumberOfScanSpotPositions", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0394}, "FL", "ScanSpotPositionMap", "1-n", "DICOM_2011"},
+	{Tag{0x300A, 0x0396}, "FL", "ScanSpotMetersetWeights", "1-n", "DICOM_2011"},
+	{Tag{0x300A, 0x0398}, "FL", "ScanningSpotSize", "2", "DICOM_2011"},
+	{Tag{0x300A, 0x039A}, "IS", "NumberOfPaintings", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x03A0}, "SQ", "IonToleranceTableSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x03A2}, "SQ", "IonBeamSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x03A4}, "SQ", "IonBeamLimitingDeviceSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x03A6}, "SQ", "IonBlockSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x03A8}, "SQ", "IonControlPointSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x03AA}, "SQ", "IonWedgeSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x03AC}, "SQ", "IonWedgePositionSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0401}, "SQ", "ReferencedSetupImageSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0402}, "ST", "SetupImageComment", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0410}, "SQ", "MotionSynchronizationSequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0412}, "FL", "ControlPointOrientation", "3", "DICOM_2011"},
+	{Tag{0x300A, 0x0420}, "SQ", "GeneralAccessorySequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0421}, "SH", "GeneralAccessoryID", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0422}, "ST", "GeneralAccessoryDescription", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0423}, "CS", "GeneralAccessoryType", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0424}, "IS", "GeneralAccessoryNumber", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0431}, "SQ", "ApplicatorGeometrySequence", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0432}, "CS", "ApplicatorApertureShape", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0433}, "FL", "ApplicatorOpening", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0434}, "FL", "ApplicatorOpeningX", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0435}, "FL", "ApplicatorOpeningY", "1", "DICOM_2011"},
+	{Tag{0x300A, 0x0436}, "FL", "SourceToApplicatorMountingPositionDistance", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0002}, "SQ", "ReferencedRTPlanSequence", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0004}, "SQ", "ReferencedBeamSequence", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0006}, "IS", "ReferencedBeamNumber", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0007}, "IS", "ReferencedReferenceImageNumber", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0008}, "DS", "StartCumulativeMetersetWeight", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0009}, "DS", "EndCumulativeMetersetWeight", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x000A}, "SQ", "ReferencedBrachyApplicationSetupSequence", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x000C}, "IS", "ReferencedBrachyApplicationSetupNumber", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x000E}, "IS", "ReferencedSourceNumber", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0020}, "SQ", "ReferencedFractionGroupSequence", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0022}, "IS", "ReferencedFractionGroupNumber", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0040}, "SQ", "ReferencedVerificationImageSequence", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0042}, "SQ", "ReferencedReferenceImageSequence", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0050}, "SQ", "ReferencedDoseReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0051}, "IS", "ReferencedDoseReferenceNumber", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0055}, "SQ", "BrachyReferencedDoseReferenceSequence", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0060}, "SQ", "ReferencedStructureSetSequence", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x006A}, "IS", "ReferencedPatientSetupNumber", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0080}, "SQ", "ReferencedDoseSequence", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x00A0}, "IS", "ReferencedToleranceTableNumber", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x00B0}, "SQ", "ReferencedBolusSequence", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x00C0}, "IS", "ReferencedWedgeNumber", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x00D0}, "IS", "ReferencedCompensatorNumber", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x00E0}, "IS", "ReferencedBlockNumber", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x00F0}, "IS", "ReferencedControlPointIndex", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x00F2}, "SQ", "ReferencedControlPointSequence", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x00F4}, "IS", "ReferencedStartControlPointIndex", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x00F6}, "IS", "ReferencedStopControlPointIndex", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0100}, "IS", "ReferencedRangeShifterNumber", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0102}, "IS", "ReferencedLateralSpreadingDeviceNumber", "1", "DICOM_2011"},
+	{Tag{0x300C, 0x0104}, "IS", "ReferencedRangeModulatorNumber", "1", "DICOM_2011"},
+	{Tag{0x300E, 0x0002}, "CS", "ApprovalStatus", "1", "DICOM_2011"},
+	{Tag{0x300E, 0x0004}, "DA", "ReviewDate", "1", "DICOM_2011"},
+	{Tag{0x300E, 0x0005}, "TM", "ReviewTime", "1", "DICOM_2011"},
+	{Tag{0x300E, 0x0008}, "PN", "ReviewerName", "1", "DICOM_2011"},
+	{Tag{0x4000, 0x0000}, "UL", "ACR_NEMA_TextGroupLength", "1", "ACR/NEMA2"},
+	{Tag{0x4000, 0x0010}, "LT", "ACR_NEMA_TextArbitrary", "1-n", "ACR/NEMA2"},
+	{Tag{0x4000, 0x0010}, "LT", "RETIRED_Arbitrary", "1", "DICOM/retired"},
+	{Tag{0x4000, 0x4000}, "LT", "ACR_NEMA_TextComments", "1-n", "ACR/NEMA2"},
+	{Tag{0x4000, 0x4000}, "LT", "RETIRED_TextComments", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0040}, "SH", "RETIRED_ResultsID", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0042}, "LO", "RETIRED_ResultsIDIssuer", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0050}, "SQ", "RETIRED_ReferencedInterpretationSequence", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x00FF}, "CS", "RETIRED_ReportProductionStatusTrial", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0100}, "DA", "RETIRED_InterpretationRecordedDate", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0101}, "TM", "RETIRED_InterpretationRecordedTime", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0102}, "PN", "RETIRED_InterpretationRecorder", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0103}, "LO", "RETIRED_ReferenceToRecordedSound", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0108}, "DA", "RETIRED_InterpretationTranscriptionDate", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0109}, "TM", "RETIRED_InterpretationTranscriptionTime", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x010A}, "PN", "RETIRED_InterpretationTranscriber", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x010B}, "ST", "RETIRED_InterpretationText", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x010C}, "PN", "RETIRED_InterpretationAuthor", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0111}, "SQ", "RETIRED_InterpretationApproverSequence", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0112}, "DA", "RETIRED_InterpretationApprovalDate", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0113}, "TM", "RETIRED_InterpretationApprovalTime", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0114}, "PN", "RETIRED_PhysicianApprovingInterpretation", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0115}, "LT", "RETIRED_InterpretationDiagnosisDescription", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0117}, "SQ", "RETIRED_InterpretationDiagnosisCodeSequence", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0118}, "SQ", "RETIRED_ResultsDistributionListSequence", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0119}, "PN", "RETIRED_DistributionName", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x011A}, "LO", "RETIRED_DistributionAddress", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0200}, "SH", "RETIRED_InterpretationID", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0202}, "LO", "RETIRED_InterpretationIDIssuer", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0210}, "CS", "RETIRED_InterpretationTypeID", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0212}, "CS", "RETIRED_InterpretationStatusID", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x0300}, "ST", "RETIRED_Impressions", "1", "DICOM/retired"},
+	{Tag{0x4008, 0x4000}, "ST", "RETIRED_ResultsComments", "1", "DICOM/retired"},
+	{Tag{0x4010, 0x0001}, "CS", "LowEnergyDetectors", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x0002}, "CS", "HighEnergyDetectors", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x0004}, "SQ", "DetectorGeometrySequence", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1001}, "SQ", "ThreatROIVoxelSequence", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1004}, "FL", "ThreatROIBase", "3", "DICOM_2011"},
+	{Tag{0x4010, 0x1005}, "FL", "ThreatROIExtents", "3", "DICOM_2011"},
+	{Tag{0x4010, 0x1006}, "OB", "ThreatROIBitmap", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1007}, "SH", "RouteSegmentID", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1008}, "CS", "GantryType", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1009}, "CS", "OOIOwnerType", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x100A}, "SQ", "RouteSegmentSequence", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1010}, "US", "PotentialThreatObjectID", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1011}, "SQ", "ThreatSequence", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1012}, "CS", "ThreatCategory", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1013}, "LT", "ThreatCategoryDescription", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1014}, "CS", "ATDAbilityAssessment", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1015}, "CS", "ATDAssessmentFlag", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1016}, "FL", "ATDAssessmentProbability", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1017}, "FL", "Mass", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1018}, "FL", "Density", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1019}, "FL", "ZEffective", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x101A}, "SH", "BoardingPassID", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x101B}, "FL", "CenterOfMass", "3", "DICOM_2011"},
+	{Tag{0x4010, 0x101C}, "FL", "CenterOfPTO", "3", "DICOM_2011"},
+	{Tag{0x4010, 0x101D}, "FL", "BoundingPolygon", "6-n", "DICOM_2011"},
+	{Tag{0x4010, 0x101E}, "SH", "RouteSegmentStartLocationID", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x101F}, "SH", "RouteSegmentEndLocationID", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1020}, "CS", "RouteSegmentLocationIDType", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1021}, "CS", "AbortReason", "1-n", "DICOM_2011"},
+	{Tag{0x4010, 0x1023}, "FL", "VolumeOfPTO", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1024}, "CS", "AbortFlag", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1025}, "DT", "RouteSegmentStartTime", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1026}, "DT", "RouteSegmentEndTime", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1027}, "CS", "TDRType", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1028}, "CS", "InternationalRouteSegment", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1029}, "LO", "ThreatDetectionAlgorithmandVersion", "1-n", "DICOM_2011"},
+	{Tag{0x4010, 0x102A}, "SH", "AssignedLocation", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x102B}, "DT", "AlarmDecisionTime", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1031}, "CS", "AlarmDecision", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1033}, "US", "NumberOfTotalObjects", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1034}, "US", "NumberOfAlarmObjects", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1037}, "SQ", "PTORepresentationSequence", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1038}, "SQ", "ATDAssessmentSequence", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1039}, "CS", "TIPType", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x103A}, "CS", "DICOSVersion", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1041}, "DT", "OOIOwnerCreationTime", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1042}, "CS", "OOIType", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1043}, "FL", "OOISize", "3", "DICOM_2011"},
+	{Tag{0x4010, 0x1044}, "CS", "AcquisitionStatus", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1045}, "SQ", "BasisMaterialsCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1046}, "CS", "PhantomType", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1047}, "SQ", "OOIOwnerSequence", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1048}, "CS", "ScanType", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1051}, "LO", "ItineraryID", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1052}, "SH", "ItineraryIDType", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1053}, "LO", "ItineraryIDAssigningAuthority", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1054}, "SH", "RouteID", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1055}, "SH", "RouteIDAssigningAuthority", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1056}, "CS", "InboundArrivalType", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1058}, "SH", "CarrierID", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1059}, "CS", "CarrierIDAssigningAuthority", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1060}, "FL", "SourceOrientation", "3", "DICOM_2011"},
+	{Tag{0x4010, 0x1061}, "FL", "SourcePosition", "3", "DICOM_2011"},
+	{Tag{0x4010, 0x1062}, "FL", "BeltHeight", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1064}, "SQ", "AlgorithmRoutingCodeSequence", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1067}, "CS", "TransportClassification", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1068}, "LT", "OOITypeDescriptor", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x1069}, "FL", "TotalProcessingTime", "1", "DICOM_2011"},
+	{Tag{0x4010, 0x106C}, "OB", "DetectorCalibrationData", "1", "DICOM_2011"},
+	{Tag{0x4FFE, 0x0001}, "SQ", "MACParametersSequence", "1", "DICOM_2011"},
+	{Tag{0x5200, 0x9229}, "SQ", "SharedFunctionalGroupsSequence", "1", "DICOM_2011"},
+	{Tag{0x5200, 0x9230}, "SQ", "PerFrameFunctionalGroupsSequence", "1", "DICOM_2011"},
+	{Tag{0x5400, 0x0100}, "SQ", "WaveformSequence", "1", "DICOM_2011"},
+	{Tag{0x5400, 0x0110}, "OX", "ChannelMinimumValue", "1", "DICOM_2011"},
+	{Tag{0x5400, 0x0112}, "OX", "ChannelMaximumValue", "1", "DICOM_2011"},
+	{Tag{0x5400, 0x1004}, "US", "WaveformBitsAllocated", "1", "DICOM_2011"},
+	{Tag{0x5400, 0x1006}, "CS", "WaveformSampleInterpretation", "1", "DICOM_2011"},
+	{Tag{0x5400, 0x100A}, "OX", "WaveformPaddingValue", "1", "DICOM_2011"},
+	{Tag{0x5400, 0x1010}, "OX", "WaveformData", "1", "DICOM_2011"},
+	{Tag{0x5600, 0x0010}, "OF", "FirstOrderPhaseCorrectionAngle", "1", "DICOM_2011"},
+	{Tag{0x5600, 0x0020}, "OF", "SpectroscopyData", "1", "DICOM_2011"},
+	{Tag{0x7FE0, 0x0001}, "OV", "ExtendedOffsetTable", "1", "CP_1818"},
+	{Tag{0x7FE0, 0x0002}, "OV", "ExtendedOffsetTableLengths", "1", "CP_1818"},
+	{Tag{0x7FE0, 0x0008}, "OF", "FloatPixelData", "1", "SUP_172"},
+	{Tag{0x7FE0, 0x0009}, "OD", "DoubleFloatPixelData", "1", "SUP_172"},
+	{Tag{0x7FE0, 0x0010}, "OX", "PixelData", "1", "DICOM_2011"},
+	{Tag{0x7FE0, 0x0020}, "OW", "ACR_NEMA_2C_CoefficientsSDVN", "1-n", "ACR/NEMA2C"},
+	{Tag{0x7FE0, 0x0020}, "OW", "RETIRED_CoefficientsSDVN", "1", "DICOM/retired"},
+	{Tag{0x7FE0, 0x0030}, "OW", "ACR_NEMA_2C_CoefficientsSDHN", "1-n", "ACR/NEMA2C"},
+	{Tag{0x7FE0, 0x0030}, "OW", "RETIRED_CoefficientsSDHN", "1", "DICOM/retired"},
+	{Tag{0x7FE0, 0x0040}, "OW", "ACR_NEMA_2C_CoefficientsSDDN", "1-n", "ACR/NEMA2C"},
+	{Tag{0x7FE0, 0x0040}, "OW", "RETIRED_CoefficientsSDDN", "1", "DICOM/retired"},
+	{Tag{0xFFFA, 0xFFFA}, "SQ", "DigitalSignaturesSequence", "1", "DICOM_2011"},
+	{Tag{0xFFFC, 0xFFFC}, "OB", "DataSetTrailingPadding", "1", "DICOM_2011"},
+	{Tag{0xFFFE, 0xE000}, "NA", "Item", "1", "DICOM_2011"},
+	{Tag{0xFFFE, 0xE00D}, "NA", "ItemDelimitationItem", "1", "DICOM_2011"},
+	{Tag{0xFFFE, 0xE0DD}, "NA", "SequenceDelimitationItem", "1", "DICOM_2011"},
+}
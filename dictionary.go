@@ -1,21 +1,77 @@
 package dicom
 
+//go:generate go run gendict.go
+
 import (
 	"encoding/csv"
-	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 type dictEntry struct {
-	tag     string
+	tag     Tag
 	vr      string
 	name    string
 	vm      string
 	version string
 }
 
+var (
+	stdNames     map[string]Tag
+	stdNamesOnce sync.Once
+)
+
+// Entries added to the default dictionary by RegisterTag. The registry is
+// replaced as a whole on every registration, so that lookups need no lock.
+type registry struct {
+	entries map[Tag]dictEntry
+	names   map[string]Tag
+}
+
+var (
+	registered   atomic.Pointer[registry]
+	registeredMu sync.Mutex // serializes registrations
+)
+
+// Add an entry to the default dictionary, ie. for a private tag, replacing
+// the entry of the tag if any. Parsers with a dictionary set by the
+// Dictionary option are not affected. Safe for concurrent use, including
+// with parsing.
+func RegisterTag(tag Tag, vr, name, vm string) {
+
+	registeredMu.Lock()
+	defer registeredMu.Unlock()
+
+	r := &registry{entries: map[Tag]dictEntry{}, names: map[string]Tag{}}
+	if old := registered.Load(); old != nil {
+		for t, entry := range old.entries {
+			r.entries[t] = entry
+		}
+		for n, t := range old.names {
+			r.names[n] = t
+		}
+	}
+	if old, ok := r.entries[tag]; ok {
+		delete(r.names, old.name)
+	}
+	r.entries[tag] = dictEntry{tag, strings.ToUpper(vr), name, vm, "REGISTERED"}
+	r.names[name] = tag
+
+	registered.Store(r)
+}
+
+// Return the tags of the default dictionary by name, indexed on first use
+func standardNames() map[string]Tag {
+	stdNamesOnce.Do(func() {
+		stdNames = indexNames(stdDictionary)
+	})
+	return stdNames
+}
+
 // Sets the dictionary for the Parser
 func Dictionary(r io.Reader) func(*Parser) error {
 
@@ -25,7 +81,7 @@ func Dictionary(r io.Reader) func(*Parser) error {
 		reader.Comma = '\t'  // tab separated file
 		reader.Comment = '#' // comments start with #
 
-		dictionary := make([][]*dictEntry, 0xffff+1)
+		var dictionary []dictEntry
 
 		for {
 
@@ -44,51 +100,82 @@ func Dictionary(r io.Reader) func(*Parser) error {
 				continue // we don't support groups yet
 			}
 
-			if cap(dictionary[group]) == 0 {
-				dictionary[group] = make([]*dictEntry, 0xffff+1)
-			}
-
-			dictionary[group][element] = &dictEntry{
-				row[0],
+			dictionary = append(dictionary, dictEntry{
+				Tag{uint16(group), uint16(element)},
 				strings.ToUpper(row[1]),
 				row[2],
 				row[3],
 				row[4],
-			}
+			})
 		}
 
+		// entries of the same tag keep their order, the last one is looked up
+		sort.SliceStable(dictionary, func(i, j int) bool {
+			return tagLess(dictionary[i].tag, dictionary[j].tag)
+		})
+
 		p.dictionary = dictionary
+		p.names = indexNames(dictionary)
 		return nil
 	}
 
 }
 
-func (p *Parser) getDictEntry(group, element uint16) (*dictEntry, error) {
-
-	var entry *dictEntry
+// Index the tags of dictionary entries by name
+func indexNames(dictionary []dictEntry) map[string]Tag {
+	names := make(map[string]Tag, len(dictionary))
+	for _, entry := range dictionary {
+		names[entry.name] = entry.tag
+	}
+	return names
+}
 
-	tag := fmt.Sprintf("(%s,%s)", group, element)
+func (p *Parser) getDictEntry(group, element uint16) (*dictEntry, error) {
 
-	// does the entry exist?
-	exists := p.dictionary[group] != nil && p.dictionary[group][element] != nil
+	tag := Tag{group, element}
+	dictionary := p.dictionary
+	if dictionary == nil {
+		if r := registered.Load(); r != nil {
+			if entry, ok := r.entries[tag]; ok {
+				return &entry, nil
+			}
+		}
+		dictionary = stdDictionary
+	}
 
-	if exists {
-		entry = p.dictionary[group][element]
+	// the last entry of the tag, if any, precedes the first greater tag
+	i := sort.Search(len(dictionary), func(i int) bool {
+		return tagLess(tag, dictionary[i].tag)
+	})
+	if i > 0 && dictionary[i-1].tag == tag {
+		return &dictionary[i-1], nil
 	}
 
-	if !exists {
-		// (0000-u-ffff,0000)	UL	GenericGroupLength	1	GENERIC
-		if group%2 == 0 && element == 0x0000 {
-			entry = &dictEntry{tag, "UL", "GenericGroupLength", "1", "GENERIC"}
-		}
+	// (0000-u-ffff,0000)	UL	GenericGroupLength	1	GENERIC
+	if group%2 == 0 && element == 0x0000 {
+		return &dictEntry{tag, "UL", "GenericGroupLength", "1", "GENERIC"}, nil
 	}
 
 	// nope, still nothing
-	if entry == nil {
-		return nil, ErrTagNotFound
-	}
+	return nil, ErrTagNotFound
+}
 
-	return entry, nil
+// Lookup the tag of a dictionary name, ie. PatientName
+func (p *Parser) LookupTag(name string) (Tag, error) {
+	names := p.names
+	if names == nil {
+		if r := registered.Load(); r != nil {
+			if tag, ok := r.names[name]; ok {
+				return tag, nil
+			}
+		}
+		names = standardNames()
+	}
+	tag, ok := names[name]
+	if !ok {
+		return Tag{}, ErrTagNotFound
+	}
+	return tag, nil
 }
 
 // Split a tag into a group and element, represented as a hex value
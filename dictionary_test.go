@@ -2,6 +2,8 @@ package dicom
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -70,3 +72,37 @@ func BenchmarkFindPixelDataTag(b *testing.B) {
 
 	}
 }
+
+func TestRegisterTag(t *testing.T) {
+
+	tag := Tag{0x0FF1, 0x1010}
+	custom, _ := NewParser(Dictionary(strings.NewReader("(0010,0010)\tPN\tPatientName\t1\tDICOM\n")))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			RegisterTag(Tag{0x0FF1, uint16(0x1020 + i)}, "LO", fmt.Sprintf("TestRegistered%d", i), "1")
+			parser.LookupTag("TestRegistered0")
+		}(i)
+	}
+	RegisterTag(tag, "cs", "TestCSAImageHeaderType", "1")
+	wg.Wait()
+
+	entry, err := parser.getDictEntry(tag.Group, tag.Element)
+	if err != nil || entry.vr != "CS" || entry.name != "TestCSAImageHeaderType" {
+		t.Errorf("Incorrect entry %v, %v", entry, err)
+	}
+	if found, err := parser.LookupTag("TestRegistered3"); err != nil || found != (Tag{0x0FF1, 0x1023}) {
+		t.Errorf("Incorrect tag %s, %v", found, err)
+	}
+	if _, err := custom.getDictEntry(tag.Group, tag.Element); err != ErrTagNotFound {
+		t.Errorf("Registered tag found in a custom dictionary")
+	}
+
+	RegisterTag(tag, "LO", "TestCSAImageHeaderVersion", "1")
+	if _, err := parser.LookupTag("TestCSAImageHeaderType"); err != ErrTagNotFound {
+		t.Errorf("Replaced name still registered")
+	}
+}
@@ -0,0 +1,93 @@
+package dicom
+
+import (
+	"fmt"
+)
+
+// A difference between two files: an element present in only one of them,
+// or present in both with different values
+type Difference struct {
+	Path TagPath
+	A, B *DicomElement // nil when the element is missing from that file
+}
+
+// Stringer, ie. "(0010,0020) PatientID: [7DkT2Tp] != [ANON]"
+func (d Difference) String() string {
+
+	opts := &DumpOptions{MaxValueLen: 64}
+	name := d.Path.String()
+	a, b := "<missing>", "<missing>"
+	if d.A != nil {
+		name += " " + d.A.Name
+		a = dumpValue(d.A, opts)
+	}
+	if d.B != nil {
+		if d.A == nil {
+			name += " " + d.B.Name
+		}
+		b = dumpValue(d.B, opts)
+	}
+
+	return fmt.Sprintf("%s: %s != %s", name, a, b)
+}
+
+// Return the differences between two files, in tag order, as compared by
+// Equal. The items of sequences holding as many items in both files are
+// compared element by element, other sequences are reported as a whole.
+func Diff(a, b *DicomFile, opts EqualOptions) []Difference {
+	return diffElements(nil, a.topLevel(), b.topLevel(), &opts)
+}
+
+// Compare two lists of elements in tag order
+func diffElements(parent TagPath, a, b []*DicomElement, opts *EqualOptions) []Difference {
+
+	a = filterIgnored(a, opts)
+	b = filterIgnored(b, opts)
+
+	var diffs []Difference
+	for len(a) > 0 || len(b) > 0 {
+		switch {
+		case len(b) == 0 || len(a) > 0 && tagLess(a[0].Tag(), b[0].Tag()):
+			diffs = append(diffs, Difference{diffPath(parent, a[0]), a[0], nil})
+			a = a[1:]
+		case len(a) == 0 || tagLess(b[0].Tag(), a[0].Tag()):
+			diffs = append(diffs, Difference{diffPath(parent, b[0]), nil, b[0]})
+			b = b[1:]
+		default:
+			diffs = append(diffs, diffElement(parent, a[0], b[0], opts)...)
+			a, b = a[1:], b[1:]
+		}
+	}
+
+	return diffs
+}
+
+// Compare two elements with the same tag
+func diffElement(parent TagPath, a, b *DicomElement, opts *EqualOptions) []Difference {
+
+	path := diffPath(parent, a)
+
+	seqA, okA := a.value().(Sequence)
+	seqB, okB := b.value().(Sequence)
+	if !okA || !okB || len(seqA) != len(seqB) {
+		if equalElement(a, b, opts) {
+			return nil
+		}
+		return []Difference{{path, a, b}}
+	}
+
+	var diffs []Difference
+	for i := range seqA {
+		path[len(path)-1].Item = i
+		itemPath := append(TagPath(nil), path...)
+		diffs = append(diffs, diffElements(itemPath, seqA[i].Elements, seqB[i].Elements, opts)...)
+	}
+
+	return diffs
+}
+
+func diffPath(parent TagPath, elem *DicomElement) TagPath {
+	path := make(TagPath, len(parent), len(parent)+1)
+	copy(path, parent)
+	return append(path, PathStep{elem.Tag(), 0})
+}
@@ -0,0 +1,55 @@
+package dicom
+
+import (
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+
+	p, _ := NewParser()
+	a, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := a.Clone()
+
+	if diffs := Diff(a, b, EqualOptions{}); len(diffs) != 0 {
+		t.Errorf("Unexpected differences %v", diffs)
+	}
+
+	b.Elements[b.indexOf(TagPatientID)].Value = Strings{"ANON"}
+	b.Elements = append(b.Elements[:b.indexOf(TagModality)], b.Elements[b.indexOf(TagModality)+1:]...)
+
+	diffs := Diff(a, b, EqualOptions{})
+	if len(diffs) != 2 {
+		t.Fatalf("Expected 2 differences, got %v", diffs)
+	}
+	if diffs[0].Path.Tag() != TagModality || diffs[0].A == nil || diffs[0].B != nil {
+		t.Errorf("Incorrect difference %v", diffs[0])
+	}
+	if diffs[1].String() != "(0010,0020) PatientID: [7DkT2Tp] != [ANON]" {
+		t.Errorf("Incorrect difference %v", diffs[1])
+	}
+
+	if diffs := Diff(a, b, EqualOptions{IgnoreTags: []Tag{TagPatientID, TagModality}}); len(diffs) != 0 {
+		t.Errorf("Unexpected differences %v", diffs)
+	}
+
+}
+
+func TestDiffSequence(t *testing.T) {
+
+	item := func(value string) *Item {
+		return &Item{Elements: []*DicomElement{{Group: 0x0008, Element: 0x0050, Vr: "SH", Value: Strings{value}}}}
+	}
+
+	a, b := &DicomFile{}, &DicomFile{}
+	a.appendDataElement(&DicomElement{Group: 0x0040, Element: 0x0275, Vr: "SQ", Value: Sequence{item("1"), item("2")}})
+	b.appendDataElement(&DicomElement{Group: 0x0040, Element: 0x0275, Vr: "SQ", Value: Sequence{item("1"), item("3")}})
+
+	diffs := Diff(a, b, EqualOptions{})
+	if len(diffs) != 1 || diffs[0].Path.String() != "(0040,0275)[1].(0008,0050)" {
+		t.Errorf("Incorrect differences %v", diffs)
+	}
+
+}
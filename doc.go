@@ -0,0 +1,19 @@
+// Package dicom reads and writes DICOM files (PS 3.10) and data sets, and
+// provides helpers to query, validate, compare and modify them.
+//
+// # Concurrency
+//
+// A Parser holds no state between calls once created, and may be shared by
+// goroutines. A parsed DicomFile may be read by several goroutines at once:
+// lookups, getters, Walk, Equal, Diff, DebugString, the writers and the
+// sequences left unparsed by LazySequences, which are parsed once on first
+// access without being stored in their elements, do not modify it.
+// Methods that change a file or its elements, ie. the setters, PutElement,
+// RemoveElement, Merge, Deidentify or LoadSequences, need exclusive access,
+// as does any direct change of Elements or Value.
+//
+// RegisterTag, RegisterPixelCodec, SetLogger and the metrics may be used
+// concurrently with parsing. The other package tables, ie. of SOP Classes and character sets,
+// are read-only. Store and HeaderIndex document their own guarantees: a
+// Store is safe for concurrent use, a HeaderIndex is not.
+package dicom
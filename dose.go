@@ -0,0 +1,249 @@
+package dicom
+
+import (
+	"fmt"
+	"math"
+)
+
+// The dose grid of an RT Dose instance (PS 3.3 C.8.8.3), with its geometry
+// in the patient coordinate system, in mm
+type DoseGrid struct {
+	Rows, Columns, Frames int
+
+	Origin        [3]float64 // the position of the center of the first voxel
+	RowCosines    [3]float64 // the direction of the rows, along which columns increase
+	ColumnCosines [3]float64 // the direction of the columns, along which rows increase
+	Spacing       [2]float64 // between rows and between columns
+	Offsets       []float64  // of the frames from the first, along the normal of the frames
+
+	Units string    // GY or RELATIVE
+	Type  string    // PHYSICAL, EFFECTIVE or ERROR
+	Dose  []float32 // scaled doses, frame after frame, row after row
+}
+
+// Decode the dose grid of an RT Dose instance: the pixel data scaled by the
+// Dose Grid Scaling, in the frames at the Grid Frame Offset Vector
+func (file *DicomFile) DoseGrid() (*DoseGrid, error) {
+
+	attrs, err := file.imageAttrs()
+	if err != nil {
+		return nil, err
+	}
+	if attrs.samples != 1 || attrs.bitsAllocated != 16 && attrs.bitsAllocated != 32 {
+		return nil, fmt.Errorf("%w: %d samples of %d bits", ErrUnsupportedImage, attrs.samples, attrs.bitsAllocated)
+	}
+
+	grid := &DoseGrid{Rows: attrs.rows, Columns: attrs.columns, Frames: attrs.frames}
+	if grid.Units, err = file.lookupString(TagDoseUnits); err != nil {
+		return nil, err
+	}
+	if grid.Type, err = file.lookupString(TagDoseType); err != nil {
+		return nil, err
+	}
+
+	position, err := file.lookupDecimals(TagImagePositionPatient, 3)
+	if err != nil {
+		return nil, err
+	}
+	orientation, err := file.lookupDecimals(TagImageOrientationPatient, 6)
+	if err != nil {
+		return nil, err
+	}
+	spacing, err := file.lookupDecimals(TagPixelSpacing, 2)
+	if err != nil {
+		return nil, err
+	}
+	scaling, err := file.lookupDecimals(TagDoseGridScaling, 1)
+	if err != nil {
+		return nil, err
+	}
+	copy(grid.Origin[:], position)
+	copy(grid.RowCosines[:], orientation)
+	copy(grid.ColumnCosines[:], orientation[3:])
+	copy(grid.Spacing[:], spacing)
+
+	// single frame grids may omit the Grid Frame Offset Vector
+	if grid.Frames == 1 && file.indexOf(TagGridFrameOffsetVector) < 0 {
+		grid.Offsets = []float64{0}
+	} else if grid.Offsets, err = file.lookupDecimals(TagGridFrameOffsetVector, grid.Frames); err != nil {
+		return nil, err
+	}
+	// absolute offsets are made relative to the first frame (C.8.8.3.2)
+	grid.Offsets = append([]float64(nil), grid.Offsets[:grid.Frames]...)
+	for i := len(grid.Offsets) - 1; i >= 0; i-- {
+		grid.Offsets[i] -= grid.Offsets[0]
+	}
+
+	elem, err := file.LookupElementByTag(TagPixelData)
+	if err != nil {
+		return nil, err
+	}
+	data, bo, err := file.nativePixels(elem)
+	if err != nil {
+		return nil, err
+	}
+	bytesPerSample := attrs.bitsAllocated / 8
+	count := grid.Frames * grid.Rows * grid.Columns
+	if len(data) < count*bytesPerSample {
+		return nil, ErrPixelDataTruncated
+	}
+
+	grid.Dose = make([]float32, count)
+	for i := range grid.Dose {
+		var v float64
+		switch {
+		case bytesPerSample == 2 && attrs.signed:
+			v = float64(int16(bo.Uint16(data[2*i:])))
+		case bytesPerSample == 2:
+			v = float64(bo.Uint16(data[2*i:]))
+		case attrs.signed:
+			v = float64(int32(bo.Uint32(data[4*i:])))
+		default:
+			v = float64(bo.Uint32(data[4*i:]))
+		}
+		grid.Dose[i] = float32(v * scaling[0])
+	}
+
+	return grid, nil
+}
+
+// Return the dose of a voxel
+func (grid *DoseGrid) At(frame, row, column int) float64 {
+	return float64(grid.Dose[(frame*grid.Rows+row)*grid.Columns+column])
+}
+
+// Return the position of the center of a voxel
+func (grid *DoseGrid) Position(frame, row, column int) [3]float64 {
+	normal := grid.normal()
+	var p [3]float64
+	for i := range p {
+		p[i] = grid.Origin[i] +
+			float64(column)*grid.Spacing[1]*grid.RowCosines[i] +
+			float64(row)*grid.Spacing[0]*grid.ColumnCosines[i] +
+			grid.Offsets[frame]*normal[i]
+	}
+	return p
+}
+
+// Sample the dose at a position, interpolated from the 8 voxels around it.
+// Reports false for positions outside the grid.
+func (grid *DoseGrid) Sample(p [3]float64) (float64, bool) {
+
+	var d [3]float64
+	for i := range d {
+		d[i] = p[i] - grid.Origin[i]
+	}
+	row := dot(d, grid.ColumnCosines) / grid.Spacing[0]
+	column := dot(d, grid.RowCosines) / grid.Spacing[1]
+
+	frame, t, ok := grid.frameAt(dot(d, grid.normal()))
+	if !ok || !grid.inPlane(row, column) {
+		return 0, false
+	}
+
+	v := grid.bilinear(frame, row, column)
+	if t > 0 {
+		v = (1-t)*v + t*grid.bilinear(frame+1, row, column)
+	}
+	return v, true
+}
+
+// Return the doses of the plane parallel to the frames at an offset from
+// the first frame, row after row, interpolated between the frames around
+// it. Reports false for offsets outside the grid.
+func (grid *DoseGrid) Plane(offset float64) ([]float32, bool) {
+
+	frame, t, ok := grid.frameAt(offset)
+	if !ok {
+		return nil, false
+	}
+
+	size := grid.Rows * grid.Columns
+	plane := append([]float32(nil), grid.Dose[frame*size:(frame+1)*size]...)
+	if t > 0 {
+		next := grid.Dose[(frame+1)*size : (frame+2)*size]
+		for i := range plane {
+			plane[i] = float32((1-t)*float64(plane[i]) + t*float64(next[i]))
+		}
+	}
+	return plane, true
+}
+
+// The normal of the frames, the cross product of the row and column
+// directions
+func (grid *DoseGrid) normal() [3]float64 {
+	r, c := grid.RowCosines, grid.ColumnCosines
+	return [3]float64{r[1]*c[2] - r[2]*c[1], r[2]*c[0] - r[0]*c[2], r[0]*c[1] - r[1]*c[0]}
+}
+
+// Locate an offset between two frames: the first frame and the weight of
+// the second, 0 if the offset is that of a frame
+func (grid *DoseGrid) frameAt(offset float64) (int, float64, bool) {
+
+	for i, o := range grid.Offsets {
+		if o == offset {
+			return i, 0, true
+		}
+		if i+1 == len(grid.Offsets) {
+			break
+		}
+		// offsets may decrease as well as increase
+		next := grid.Offsets[i+1]
+		if math.Min(o, next) < offset && offset < math.Max(o, next) {
+			return i, (offset - o) / (next - o), true
+		}
+	}
+
+	return 0, 0, false
+}
+
+func (grid *DoseGrid) inPlane(row, column float64) bool {
+	return row >= 0 && row <= float64(grid.Rows-1) && column >= 0 && column <= float64(grid.Columns-1)
+}
+
+// Interpolate the doses of a frame from the 4 voxels around a fractional
+// row and column
+func (grid *DoseGrid) bilinear(frame int, row, column float64) float64 {
+
+	r, c := int(row), int(column)
+	tr, tc := row-float64(r), column-float64(c)
+	r1, c1 := r, c
+	if tr > 0 {
+		r1++
+	}
+	if tc > 0 {
+		c1++
+	}
+
+	top := (1-tc)*grid.At(frame, r, c) + tc*grid.At(frame, r, c1)
+	bottom := (1-tc)*grid.At(frame, r1, c) + tc*grid.At(frame, r1, c1)
+	return (1-tr)*top + tr*bottom
+}
+
+func dot(a, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+// Return the values of a top level DS element, which must have at least n
+func (file *DicomFile) lookupDecimals(tag Tag, n int) ([]float64, error) {
+
+	elem, err := file.LookupElementByTag(tag)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, tag)
+	}
+	decimals, err := elem.GetDecimals()
+	if err != nil {
+		return nil, err
+	}
+	if len(decimals) < n {
+		return nil, fmt.Errorf("%w: %d values of %s", ErrInvalidDS, len(decimals), tag)
+	}
+
+	values := make([]float64, len(decimals))
+	for i, d := range decimals {
+		if values[i], err = d.Float64(); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
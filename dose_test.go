@@ -0,0 +1,99 @@
+package dicom
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+// An RT Dose grid of 2 frames of 2x3 voxels, with 32 bits doses of 0 to 11
+// scaled by 0.5
+func doseFile(t *testing.T) *DicomFile {
+
+	pixels := make(Bytes, 4*12)
+	for i := 0; i < 12; i++ {
+		binary.LittleEndian.PutUint32(pixels[4*i:], uint32(i))
+	}
+
+	p, _ := NewParser()
+	file := &DicomFile{}
+	for _, attr := range []struct {
+		tag   Tag
+		value Value
+	}{
+		{TagTransferSyntaxUID, Strings{explicit_vr_little_endian}},
+		{TagSOPClassUID, Strings{RTDoseStorage}},
+		{TagImagePositionPatient, Strings{"10", "20", "30"}},
+		{TagImageOrientationPatient, Strings{"1", "0", "0", "0", "1", "0"}},
+		{TagSamplesPerPixel, UInt16s{1}},
+		{TagPhotometricInterpretation, Strings{"MONOCHROME2"}},
+		{TagNumberOfFrames, Strings{"2"}},
+		{TagRows, UInt16s{2}},
+		{TagColumns, UInt16s{3}},
+		{TagPixelSpacing, Strings{"2", "3"}},
+		{TagBitsAllocated, UInt16s{32}},
+		{TagBitsStored, UInt16s{32}},
+		{TagPixelRepresentation, UInt16s{0}},
+		{TagDoseUnits, Strings{"GY"}},
+		{TagDoseType, Strings{"PHYSICAL"}},
+		{TagGridFrameOffsetVector, Strings{"30", "35"}},
+		{TagDoseGridScaling, Strings{"0.5"}},
+		{TagPixelData, pixels},
+	} {
+		elem, err := p.NewElement(attr.tag, attr.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.insertElement(elem)
+	}
+
+	return file
+}
+
+func TestDoseGrid(t *testing.T) {
+
+	grid, err := doseFile(t).DoseGrid()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if grid.Frames != 2 || grid.Rows != 2 || grid.Columns != 3 || grid.Units != "GY" {
+		t.Fatalf("Incorrect grid %+v", grid)
+	}
+	if grid.Offsets[0] != 0 || grid.Offsets[1] != 5 {
+		t.Errorf("Expected relative offsets, got %v", grid.Offsets)
+	}
+	if d := grid.At(1, 1, 2); d != 5.5 {
+		t.Errorf("Expected 5.5 Gy, got %v", d)
+	}
+	if p := grid.Position(1, 1, 2); p != [3]float64{16, 22, 35} {
+		t.Errorf("Incorrect position %v", p)
+	}
+
+	for _, test := range []struct {
+		p    [3]float64
+		dose float64
+		ok   bool
+	}{
+		{[3]float64{10, 20, 30}, 0, true},
+		{[3]float64{16, 22, 35}, 5.5, true},
+		{[3]float64{11.5, 20, 30}, 0.25, true},  // between 2 columns
+		{[3]float64{10, 21, 30}, 0.75, true},    // between 2 rows
+		{[3]float64{10, 20, 32.5}, 1.5, true},   // between 2 frames
+		{[3]float64{11.5, 21, 32.5}, 2.5, true}, // between 8 voxels
+		{[3]float64{9, 20, 30}, 0, false},
+		{[3]float64{10, 20, 36}, 0, false},
+	} {
+		dose, ok := grid.Sample(test.p)
+		if ok != test.ok || dose != test.dose {
+			t.Errorf("%v: expected %v %v, got %v %v", test.p, test.dose, test.ok, dose, ok)
+		}
+	}
+
+	plane, ok := grid.Plane(2.5)
+	if !ok || len(plane) != 6 || plane[0] != 1.5 || plane[5] != 4 {
+		t.Errorf("Incorrect plane %v %v", plane, ok)
+	}
+	if _, ok := grid.Plane(-1); ok {
+		t.Errorf("Expected no plane outside the grid")
+	}
+}
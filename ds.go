@@ -0,0 +1,109 @@
+package dicom
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidDS = errors.New("Invalid DS value")
+
+// Maximum length of a DS value (PS 3.5 6.2)
+const maxDSLength = 16
+
+// A Decimal String value. The original string is kept, so that values are
+// written back exactly as they were read.
+type DS string
+
+// Return the value as a float64
+func (d DS) Float64() (float64, error) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(string(d)), 64)
+	if err != nil {
+		return 0, ErrInvalidDS
+	}
+	return f, nil
+}
+
+// Return the exact value as a big.Rat
+func (d DS) Rat() (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(string(d)))
+	if !ok {
+		return nil, ErrInvalidDS
+	}
+	return r, nil
+}
+
+// Format a float64 as a DS of at most 16 bytes, with as many significant
+// digits as fit, using exponent notation for large and small values
+func FormatDS(f float64) (DS, error) {
+
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", ErrInvalidDS
+	}
+
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	for prec := 16; len(s) > maxDSLength; prec-- {
+		s = strconv.FormatFloat(f, 'g', prec, 64)
+	}
+
+	return DS(s), nil
+}
+
+// Return the value of a DS element with a single value
+func (e *DicomElement) GetDecimal() (DS, error) {
+	s, err := e.GetString()
+	return DS(s), err
+}
+
+// Return the values of a DS element
+func (e *DicomElement) GetDecimals() ([]DS, error) {
+	values, err := e.GetStrings()
+	if err != nil {
+		return nil, err
+	}
+	ds := make([]DS, len(values))
+	for i, s := range values {
+		ds[i] = DS(s)
+	}
+	return ds, nil
+}
+
+// Set the values of a DS element
+func (e *DicomElement) SetDecimals(v ...DS) error {
+	values := make(Strings, len(v))
+	for i, d := range v {
+		values[i] = string(d)
+	}
+	return e.SetValue(values)
+}
+
+// Reformat DS values longer than 16 bytes, leaving the other values as they
+// are
+func fitDS(values []string) ([]string, error) {
+
+	var fitted []string
+	for i, s := range values {
+		if len(s) <= maxDSLength {
+			continue
+		}
+		f, err := DS(s).Float64()
+		if err != nil {
+			return nil, err
+		}
+		d, err := FormatDS(f)
+		if err != nil {
+			return nil, err
+		}
+		if fitted == nil {
+			fitted = append([]string(nil), values...)
+		}
+		fitted[i] = string(d)
+	}
+
+	if fitted == nil {
+		return values, nil
+	}
+	return fitted, nil
+}
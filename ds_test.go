@@ -0,0 +1,65 @@
+package dicom
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+)
+
+func TestDS(t *testing.T) {
+
+	d := DS(" 0.1234567890123 ")
+
+	if f, err := d.Float64(); err != nil || f != 0.1234567890123 {
+		t.Errorf("Incorrect float %v: %v", f, err)
+	}
+
+	r, err := d.Rat()
+	if err != nil || r.Cmp(big.NewRat(1234567890123, 10000000000000)) != 0 {
+		t.Errorf("Incorrect rational %v: %v", r, err)
+	}
+
+	if _, err := DS("abc").Float64(); err != ErrInvalidDS {
+		t.Errorf("Expected ErrInvalidDS, got %v", err)
+	}
+
+}
+
+func TestFormatDS(t *testing.T) {
+
+	cases := []struct {
+		f float64
+		s DS
+	}{
+		{2.5, "2.5"},
+		{-0.1, "-0.1"},
+		{1.0 / 3, "0.33333333333333"},
+		{-1.0 / 3, "-0.3333333333333"},
+		{1e25 / 3, "3.3333333333e+24"},
+		{1.2345678901234567e-10, "1.2345678901e-10"},
+	}
+
+	for _, c := range cases {
+		s, err := FormatDS(c.f)
+		if err != nil || s != c.s || len(s) > 16 {
+			t.Errorf("Incorrect DS for %v: %s %v", c.f, s, err)
+		}
+	}
+
+}
+
+func TestWriteLongDS(t *testing.T) {
+
+	elem := &DicomElement{Group: 0x0018, Element: 0x0050, Vr: "DS", Value: Strings{"0.33333333333333333", "1.5"}}
+
+	buffer := newDicomWriter(nil, true)
+	value, err := buffer.encodeValue(elem, "DS")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(value, []byte("0.33333333333333\\1.5")) {
+		t.Errorf("Incorrect value %q", value)
+	}
+
+}
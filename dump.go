@@ -0,0 +1,101 @@
+package dicom
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Options of DebugString
+type DumpOptions struct {
+	MaskPHI     bool // replace the values of patient identifying elements
+	MaxValueLen int  // truncate longer values, 0 for no limit
+}
+
+const maskedValue = "***"
+
+// Elements identifying the patient, masked by DebugString along with every
+// PN element
+var phiTags = map[Tag]bool{
+	TagPatientID:                    true,
+	TagPatientBirthDate:             true,
+	TagPatientBirthTime:             true,
+	TagPatientAddress:               true,
+	TagPatientTelephoneNumbers:      true,
+	TagPatientComments:              true,
+	TagOtherPatientIDs:              true,
+	TagIssuerOfPatientID:            true,
+	TagMedicalRecordLocator:         true,
+	TagMilitaryRank:                 true,
+	TagOccupation:                   true,
+	TagEthnicGroup:                  true,
+	TagAdditionalPatientHistory:     true,
+	TagCountryOfResidence:           true,
+	TagRegionOfResidence:            true,
+	TagReferringPhysicianAddress:    true,
+	TagInstitutionName:              true,
+	TagInstitutionAddress:           true,
+	TagAccessionNumber:              true,
+	TagStudyID:                      true,
+	TagStationName:                  true,
+	TagDeviceSerialNumber:           true,
+	TagPatientMotherBirthName:       true,
+	TagOtherPatientNames:            true,
+	TagReferringPhysicianName:       true,
+	TagPerformingPhysicianName:      true,
+	TagOperatorsName:                true,
+	TagNameOfPhysiciansReadingStudy: true,
+	TagPhysiciansOfRecord:           true,
+}
+
+// Reports whether an element may identify the patient
+func isPHI(elem *DicomElement) bool {
+	return elem.Vr == "PN" || phiTags[elem.Tag()]
+}
+
+// Return a dump of the file, one element per line with the elements of
+// sequence items indented, ie. to log it:
+//
+//	log.Print(file.DebugString(dicom.DumpOptions{MaskPHI: true, MaxValueLen: 64}))
+func (file *DicomFile) DebugString(opts DumpOptions) string {
+
+	buf := new(bytes.Buffer)
+	file.Walk(func(path TagPath, elem *DicomElement) error {
+		indent := strings.Repeat("  ", len(path)-1)
+		fmt.Fprintf(buf, "%s%s %s %s %s\n", indent, elem.Tag(), elem.Vr, elem.Name, dumpValue(elem, &opts))
+		return nil
+	})
+
+	return buf.String()
+}
+
+// Format the value of an element for DebugString
+func dumpValue(elem *DicomElement, opts *DumpOptions) string {
+
+	var s string
+	switch v := elem.value().(type) {
+	case Sequence:
+		return fmt.Sprintf("<%d items>", len(v))
+	case *PixelData:
+		return fmt.Sprintf("<%d fragments>", len(v.Fragments))
+	}
+
+	if opts.MaskPHI && isPHI(elem) && elem.Len() > 0 {
+		return maskedValue
+	}
+
+	switch v := elem.Value.(type) {
+	case nil:
+		s = "[]"
+	case Bytes:
+		s = fmt.Sprintf("<%d bytes> % x", len(v), []byte(v))
+	default:
+		s = fmt.Sprintf("%v", v)
+	}
+
+	if opts.MaxValueLen > 0 && len(s) > opts.MaxValueLen {
+		s = s[:opts.MaxValueLen] + "(...)"
+	}
+
+	return s
+}
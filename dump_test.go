@@ -0,0 +1,32 @@
+package dicom
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDebugString(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dump := file.DebugString(DumpOptions{})
+	if !strings.Contains(dump, "TOUTATIX") {
+		t.Error("PatientName missing from the dump")
+	}
+
+	dump = file.DebugString(DumpOptions{MaskPHI: true, MaxValueLen: 16})
+	if strings.Contains(dump, "TOUTATIX") || strings.Contains(dump, "7DkT2Tp") {
+		t.Error("PHI found in the masked dump")
+	}
+
+	for _, line := range strings.Split(dump, "\n") {
+		if len(line) > 200 {
+			t.Errorf("Value not truncated: %s", line)
+		}
+	}
+
+}
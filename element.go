@@ -0,0 +1,408 @@
+package dicom
+
+import (
+	"errors"
+	"math"
+	"strconv"
+)
+
+var (
+	ErrNotSingleValue  = errors.New("Element does not have exactly one value")
+	ErrWrongValueType  = errors.New("Element value is of a different type")
+	ErrValueOutOfRange = errors.New("Value out of range for the VR of the element")
+)
+
+// Return the single value of an element as a T, ie.
+//
+//	rows, err := dicom.Get[uint16](elem)
+func Get[T any](e *DicomElement) (T, error) {
+	var zero T
+	values, err := GetAll[T](e)
+	if err != nil {
+		return zero, err
+	}
+	if len(values) != 1 {
+		return zero, ErrNotSingleValue
+	}
+	return values[0], nil
+}
+
+// Return the values of an element as a []T, ie. a []uint16 for a US or OW
+// element. The slice is shared with the element.
+func GetAll[T any](e *DicomElement) ([]T, error) {
+	if e.Value == nil {
+		return nil, nil
+	}
+	values, ok := e.Value.slice().([]T)
+	if !ok {
+		return nil, ErrWrongValueType
+	}
+	return values, nil
+}
+
+// Like Get, but panics on error
+func MustGet[T any](e *DicomElement) T {
+	v, err := Get[T](e)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Like GetAll, but panics on error
+func MustGetAll[T any](e *DicomElement) []T {
+	v, err := GetAll[T](e)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Return the value of an element with a single string value
+func (e *DicomElement) GetString() (string, error) {
+	return Get[string](e)
+}
+
+// Return the values of an element with string values
+func (e *DicomElement) GetStrings() ([]string, error) {
+	return GetAll[string](e)
+}
+
+// Like GetString, but panics on error
+func (e *DicomElement) MustGetString() string {
+	return MustGet[string](e)
+}
+
+// Like GetStrings, but panics on error
+func (e *DicomElement) MustGetStrings() []string {
+	return MustGetAll[string](e)
+}
+
+// Return the value of an element with a single uint16 value
+func (e *DicomElement) GetUInt16() (uint16, error) {
+	return Get[uint16](e)
+}
+
+// Return the values of an element with uint16 values
+func (e *DicomElement) GetUInt16s() ([]uint16, error) {
+	return GetAll[uint16](e)
+}
+
+// Like GetUInt16, but panics on error
+func (e *DicomElement) MustGetUInt16() uint16 {
+	return MustGet[uint16](e)
+}
+
+// Like GetUInt16s, but panics on error
+func (e *DicomElement) MustGetUInt16s() []uint16 {
+	return MustGetAll[uint16](e)
+}
+
+// Return the value of an element with a single int16 value
+func (e *DicomElement) GetInt16() (int16, error) {
+	return Get[int16](e)
+}
+
+// Return the values of an element with int16 values
+func (e *DicomElement) GetInt16s() ([]int16, error) {
+	return GetAll[int16](e)
+}
+
+// Like GetInt16, but panics on error
+func (e *DicomElement) MustGetInt16() int16 {
+	return MustGet[int16](e)
+}
+
+// Like GetInt16s, but panics on error
+func (e *DicomElement) MustGetInt16s() []int16 {
+	return MustGetAll[int16](e)
+}
+
+// Return the value of an element with a single uint32 value
+func (e *DicomElement) GetUInt32() (uint32, error) {
+	return Get[uint32](e)
+}
+
+// Return the values of an element with uint32 values
+func (e *DicomElement) GetUInt32s() ([]uint32, error) {
+	return GetAll[uint32](e)
+}
+
+// Like GetUInt32, but panics on error
+func (e *DicomElement) MustGetUInt32() uint32 {
+	return MustGet[uint32](e)
+}
+
+// Like GetUInt32s, but panics on error
+func (e *DicomElement) MustGetUInt32s() []uint32 {
+	return MustGetAll[uint32](e)
+}
+
+// Return the value of an element with a single int32 value
+func (e *DicomElement) GetInt32() (int32, error) {
+	return Get[int32](e)
+}
+
+// Return the values of an element with int32 values
+func (e *DicomElement) GetInt32s() ([]int32, error) {
+	return GetAll[int32](e)
+}
+
+// Like GetInt32, but panics on error
+func (e *DicomElement) MustGetInt32() int32 {
+	return MustGet[int32](e)
+}
+
+// Like GetInt32s, but panics on error
+func (e *DicomElement) MustGetInt32s() []int32 {
+	return MustGetAll[int32](e)
+}
+
+// Return the value of an element with a single uint64 value
+func (e *DicomElement) GetUInt64() (uint64, error) {
+	return Get[uint64](e)
+}
+
+// Return the values of an element with uint64 values
+func (e *DicomElement) GetUInt64s() ([]uint64, error) {
+	return GetAll[uint64](e)
+}
+
+// Like GetUInt64, but panics on error
+func (e *DicomElement) MustGetUInt64() uint64 {
+	return MustGet[uint64](e)
+}
+
+// Like GetUInt64s, but panics on error
+func (e *DicomElement) MustGetUInt64s() []uint64 {
+	return MustGetAll[uint64](e)
+}
+
+// Return the value of an element with a single int64 value
+func (e *DicomElement) GetInt64() (int64, error) {
+	return Get[int64](e)
+}
+
+// Return the values of an element with int64 values
+func (e *DicomElement) GetInt64s() ([]int64, error) {
+	return GetAll[int64](e)
+}
+
+// Like GetInt64, but panics on error
+func (e *DicomElement) MustGetInt64() int64 {
+	return MustGet[int64](e)
+}
+
+// Like GetInt64s, but panics on error
+func (e *DicomElement) MustGetInt64s() []int64 {
+	return MustGetAll[int64](e)
+}
+
+// Return the value of an element with a single float32 value
+func (e *DicomElement) GetFloat32() (float32, error) {
+	return Get[float32](e)
+}
+
+// Return the values of an element with float32 values
+func (e *DicomElement) GetFloat32s() ([]float32, error) {
+	return GetAll[float32](e)
+}
+
+// Like GetFloat32, but panics on error
+func (e *DicomElement) MustGetFloat32() float32 {
+	return MustGet[float32](e)
+}
+
+// Like GetFloat32s, but panics on error
+func (e *DicomElement) MustGetFloat32s() []float32 {
+	return MustGetAll[float32](e)
+}
+
+// Return the value of an element with a single float64 value
+func (e *DicomElement) GetFloat64() (float64, error) {
+	return Get[float64](e)
+}
+
+// Return the values of an element with float64 values
+func (e *DicomElement) GetFloat64s() ([]float64, error) {
+	return GetAll[float64](e)
+}
+
+// Like GetFloat64, but panics on error
+func (e *DicomElement) MustGetFloat64() float64 {
+	return MustGet[float64](e)
+}
+
+// Like GetFloat64s, but panics on error
+func (e *DicomElement) MustGetFloat64s() []float64 {
+	return MustGetAll[float64](e)
+}
+
+// Return the value of an element with a single Tag value
+func (e *DicomElement) GetTag() (Tag, error) {
+	return Get[Tag](e)
+}
+
+// Return the values of an element with Tag values
+func (e *DicomElement) GetTags() ([]Tag, error) {
+	return GetAll[Tag](e)
+}
+
+// Like GetTag, but panics on error
+func (e *DicomElement) MustGetTag() Tag {
+	return MustGet[Tag](e)
+}
+
+// Like GetTags, but panics on error
+func (e *DicomElement) MustGetTags() []Tag {
+	return MustGetAll[Tag](e)
+}
+
+// Return the value of an OB or UN element
+func (e *DicomElement) GetBytes() ([]byte, error) {
+	return GetAll[byte](e)
+}
+
+// Like GetBytes, but panics on error
+func (e *DicomElement) MustGetBytes() []byte {
+	return MustGetAll[byte](e)
+}
+
+// Return the encapsulated pixel data of a PixelData element
+func (e *DicomElement) GetPixelData() (*PixelData, error) {
+	pixels, ok := e.Value.(*PixelData)
+	if !ok {
+		return nil, ErrWrongValueType
+	}
+	return pixels, nil
+}
+
+// Replace the value of an element. The value is checked against the VR of
+// the element and the VM of its dictionary entry, as in NewElement, and the
+// element is left unchanged on error.
+func (e *DicomElement) SetValue(value Value) error {
+
+	elem := *e
+	elem.Value = value
+	if err := standardParser().checkValue(&elem); err != nil {
+		return err
+	}
+
+	e.Value = value
+	return nil
+}
+
+// Set the value of an element with a string VR
+func (e *DicomElement) SetString(v string) error {
+	return e.SetValue(Strings{v})
+}
+
+// Set the values of an element with a string VR
+func (e *DicomElement) SetStrings(v ...string) error {
+	return e.SetValue(Strings(v))
+}
+
+// Set the values of an IS, US, SS, UL, SL, SV, UV, OW, OL or OV element,
+// converting them to the type of the VR
+func (e *DicomElement) SetInts(v ...int) error {
+
+	switch e.Vr {
+	case "IS":
+		values := make(Strings, len(v))
+		for i, n := range v {
+			values[i] = strconv.Itoa(n)
+		}
+		return e.SetValue(values)
+	case "US", "OW", "XS":
+		values := make(UInt16s, len(v))
+		for i, n := range v {
+			if n < 0 || n > math.MaxUint16 {
+				return ErrValueOutOfRange
+			}
+			values[i] = uint16(n)
+		}
+		return e.SetValue(values)
+	case "SS":
+		values := make(Int16s, len(v))
+		for i, n := range v {
+			if n < math.MinInt16 || n > math.MaxInt16 {
+				return ErrValueOutOfRange
+			}
+			values[i] = int16(n)
+		}
+		return e.SetValue(values)
+	case "UL", "OL", "UP":
+		values := make(UInt32s, len(v))
+		for i, n := range v {
+			if n < 0 || uint64(n) > math.MaxUint32 {
+				return ErrValueOutOfRange
+			}
+			values[i] = uint32(n)
+		}
+		return e.SetValue(values)
+	case "SL":
+		values := make(Int32s, len(v))
+		for i, n := range v {
+			if n < math.MinInt32 || n > math.MaxInt32 {
+				return ErrValueOutOfRange
+			}
+			values[i] = int32(n)
+		}
+		return e.SetValue(values)
+	case "UV", "OV":
+		values := make(UInt64s, len(v))
+		for i, n := range v {
+			if n < 0 {
+				return ErrValueOutOfRange
+			}
+			values[i] = uint64(n)
+		}
+		return e.SetValue(values)
+	case "SV":
+		values := make(Int64s, len(v))
+		for i, n := range v {
+			values[i] = int64(n)
+		}
+		return e.SetValue(values)
+	}
+
+	return ErrWrongVR
+}
+
+// Set the values of a DS, FL, FD, OF or OD element, converting them to the
+// type of the VR
+func (e *DicomElement) SetFloats(v ...float64) error {
+
+	switch e.Vr {
+	case "DS":
+		values := make(Strings, len(v))
+		for i, f := range v {
+			d, err := FormatDS(f)
+			if err != nil {
+				return err
+			}
+			values[i] = string(d)
+		}
+		return e.SetValue(values)
+	case "FL", "OF":
+		values := make(Float32s, len(v))
+		for i, f := range v {
+			values[i] = float32(f)
+		}
+		return e.SetValue(values)
+	case "FD", "OD":
+		return e.SetValue(Float64s(v))
+	}
+
+	return ErrWrongVR
+}
+
+// Set the values of an AT element
+func (e *DicomElement) SetTags(v ...Tag) error {
+	return e.SetValue(Tags(v))
+}
+
+// Set the value of an OB or UN element
+func (e *DicomElement) SetBytes(v []byte) error {
+	return e.SetValue(Bytes(v))
+}
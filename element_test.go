@@ -0,0 +1,119 @@
+package dicom
+
+import (
+	"testing"
+)
+
+func TestGetters(t *testing.T) {
+
+	elem := &DicomElement{Vr: "US", Value: UInt16s{512}}
+
+	if v, err := elem.GetUInt16(); err != nil || v != 512 {
+		t.Errorf("Incorrect value %v: %v", v, err)
+	}
+
+	if _, err := elem.GetUInt32(); err != ErrWrongValueType {
+		t.Errorf("Expected ErrWrongValueType, got %v", err)
+	}
+
+	elem = &DicomElement{Vr: "DS", Value: Strings{"1", "0"}}
+
+	if _, err := elem.GetString(); err != ErrNotSingleValue {
+		t.Errorf("Expected ErrNotSingleValue, got %v", err)
+	}
+
+	if v := elem.MustGetStrings(); len(v) != 2 || v[1] != "0" {
+		t.Errorf("Incorrect values %v", v)
+	}
+
+	elem = &DicomElement{Vr: "OW", Value: UInt16s{1, 2, 3}}
+
+	if v, err := elem.GetUInt16s(); err != nil || len(v) != 3 {
+		t.Errorf("Incorrect values %v: %v", v, err)
+	}
+
+	elem = &DicomElement{Vr: "AT", Value: Tags{TagPixelData}}
+
+	if v := elem.MustGetTags(); len(v) != 1 || v[0] != TagPixelData {
+		t.Errorf("Incorrect values %v", v)
+	}
+
+}
+
+func TestMustGetPanics(t *testing.T) {
+
+	defer func() {
+		if r := recover(); r != ErrWrongValueType {
+			t.Errorf("Expected a ErrWrongValueType panic, got %v", r)
+		}
+	}()
+
+	elem := &DicomElement{Vr: "OB", Value: Strings{"not bytes"}}
+	elem.MustGetBytes()
+
+}
+
+func TestGenericGetters(t *testing.T) {
+
+	elem := &DicomElement{Vr: "FD", Value: Float64s{1.5, 2.5}}
+
+	if _, err := Get[float64](elem); err != ErrNotSingleValue {
+		t.Errorf("Expected ErrNotSingleValue, got %v", err)
+	}
+
+	v, err := GetAll[float64](elem)
+	if err != nil || len(v) != 2 || v[1] != 2.5 {
+		t.Errorf("Incorrect values %v: %v", v, err)
+	}
+
+	if _, err := GetAll[string](elem); err != ErrWrongValueType {
+		t.Errorf("Expected ErrWrongValueType, got %v", err)
+	}
+
+	elem = &DicomElement{Vr: "OB", Value: Bytes{0, 1}}
+
+	if b := MustGetAll[byte](elem); len(b) != 2 {
+		t.Errorf("Incorrect value %v", b)
+	}
+
+}
+
+func TestSetters(t *testing.T) {
+
+	p, _ := NewParser()
+
+	elem, _ := p.NewElement(TagRows, UInt16s{512})
+
+	if err := elem.SetInts(256); err != nil || elem.MustGetUInt16() != 256 {
+		t.Errorf("Incorrect value %v: %v", elem.Value, err)
+	}
+
+	if err := elem.SetInts(70000); err != ErrValueOutOfRange {
+		t.Errorf("Expected ErrValueOutOfRange, got %v", err)
+	}
+
+	if err := elem.SetString("256"); err != ErrWrongVR {
+		t.Errorf("Expected ErrWrongVR, got %v", err)
+	}
+
+	if err := elem.SetInts(1, 2); err == nil {
+		t.Error("Expected a VMError setting 2 values")
+	}
+
+	if elem.MustGetUInt16() != 256 {
+		t.Errorf("Element changed by a failed set: %v", elem.Value)
+	}
+
+	elem, _ = p.NewElement(TagSliceThickness, Strings{"1"})
+
+	if err := elem.SetFloats(2.5); err != nil || elem.MustGetString() != "2.5" {
+		t.Errorf("Incorrect value %v: %v", elem.Value, err)
+	}
+
+	elem, _ = p.NewElement(TagInstanceNumber, Strings{"1"})
+
+	if err := elem.SetInts(42); err != nil || elem.MustGetString() != "42" {
+		t.Errorf("Incorrect value %v: %v", elem.Value, err)
+	}
+
+}
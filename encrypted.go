@@ -0,0 +1,122 @@
+package dicom
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+)
+
+// Encrypt the original values of the top level elements that the
+// de-identification removed or modified, in the Modified Attributes Sequence
+// of an Encrypted Attributes Sequence for the recipients (PS 3.15 E.1.1)
+func (file *DicomFile) encryptAttributes(originals []*DicomElement, recipients []*x509.Certificate) error {
+
+	item := &Item{}
+	for _, original := range originals {
+		i := file.indexOf(original.Tag())
+		if i < 0 || !equalElement(original, &file.Elements[i], &EqualOptions{}) {
+			item.Elements = append(item.Elements, original)
+		}
+	}
+	if len(item.Elements) == 0 {
+		return nil
+	}
+
+	modified, err := standardParser().NewElement(TagModifiedAttributesSequence, Sequence{item})
+	if err != nil {
+		return err
+	}
+	buffer := new(bytes.Buffer)
+	dataSet := &DicomFile{Elements: []DicomElement{*modified}}
+	if err := dataSet.WriteDataSet(buffer, ExplicitVRLittleEndian); err != nil {
+		return err
+	}
+
+	content, err := encryptCMS(buffer.Bytes(), recipients)
+	if err != nil {
+		return err
+	}
+	encrypted, err := newItem([]itemValue{
+		{TagEncryptedContentTransferSyntaxUID, Strings{ExplicitVRLittleEndian}},
+		{TagEncryptedContent, Bytes(content)},
+	})
+	if err != nil {
+		return err
+	}
+
+	return file.setSequence(TagEncryptedAttributesSequence, append(file.sequence(TagEncryptedAttributesSequence), encrypted))
+}
+
+// Decrypt the Encrypted Attributes Sequence of a file de-identified with the
+// EncryptFor option, with the private key of one of the certificates it was
+// encrypted for. Returns the original values of the elements removed or
+// modified by the de-identification, or ErrNoRecipient.
+func (file *DicomFile) DecryptAttributes(key crypto.Decrypter, cert *x509.Certificate) ([]*DicomElement, error) {
+
+	if err := file.parseSequences(); err != nil {
+		return nil, err
+	}
+
+	for _, item := range file.sequence(TagEncryptedAttributesSequence) {
+		ts, err := item.lookupString(TagEncryptedContentTransferSyntaxUID)
+		if err != nil {
+			return nil, err
+		}
+		content, err := item.lookupBytes(TagEncryptedContent)
+		if err != nil {
+			return nil, err
+		}
+
+		decrypted, err := decryptCMS(content, key, cert)
+		if err == ErrNoRecipient {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		p, _ := NewParser()
+		dataSet, err := p.ParseDataSet(decrypted, ts)
+		if err != nil {
+			return nil, err
+		}
+
+		var elems []*DicomElement
+		for _, modified := range dataSet.sequence(TagModifiedAttributesSequence) {
+			for _, elem := range modified.Elements {
+				elem.IndentLevel = 0
+				elems = append(elems, elem)
+			}
+		}
+		return elems, nil
+	}
+
+	return nil, ErrNoRecipient
+}
+
+// Restore the elements removed or modified by the de-identification of a
+// file de-identified with the EncryptFor option, decrypting them with the
+// private key of one of the certificates they were encrypted for. The
+// elements recording the de-identification are removed.
+func (file *DicomFile) Reidentify(key crypto.Decrypter, cert *x509.Certificate) error {
+
+	originals, err := file.DecryptAttributes(key, cert)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range []Tag{TagPatientIdentityRemoved, TagDeidentificationMethod, TagDeidentificationMethodCodeSequence, TagEncryptedAttributesSequence} {
+		if i := file.indexOf(tag); i >= 0 {
+			file.Elements = append(file.Elements[:i], file.Elements[i+1:]...)
+		}
+	}
+
+	for _, original := range originals {
+		if i := file.indexOf(original.Tag()); i >= 0 {
+			file.Elements[i] = *original
+		} else {
+			file.insertElement(original)
+		}
+	}
+
+	return nil
+}
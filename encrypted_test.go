@@ -0,0 +1,57 @@
+package dicom
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"testing"
+)
+
+func TestEncryptedAttributes(t *testing.T) {
+
+	key, _ := rsa.GenerateKey(rand.Reader, 1024)
+	cert := newSigner(t, key)
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 1024)
+	other := newSigner(t, otherKey)
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+	uid, _ := file.lookupString(TagStudyInstanceUID)
+	if err := file.Deidentify(DeidentifyOptions{EncryptFor: []*x509.Certificate{cert}}); err != nil {
+		t.Fatal(err)
+	}
+
+	buffer := new(bytes.Buffer)
+	if err := file.Write(buffer); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(buffer.Bytes(), []byte("TOUTATIX")) {
+		t.Error("PatientName found in the de-identified file")
+	}
+	file, err = p.Parse(buffer.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := file.DecryptAttributes(otherKey, other); err != ErrNoRecipient {
+		t.Errorf("Incorrect error %v", err)
+	}
+
+	if err := file.Reidentify(key, cert); err != nil {
+		t.Fatal(err)
+	}
+	if name, _ := file.lookupString(TagPatientName); name != "TOUTATIX" {
+		t.Errorf("PatientName not restored: %q", name)
+	}
+	if u, _ := file.lookupString(TagStudyInstanceUID); u != uid {
+		t.Errorf("StudyInstanceUID not restored: %q", u)
+	}
+	if _, err := file.LookupElementByTag(TagPatientIdentityRemoved); err == nil {
+		t.Error("PatientIdentityRemoved not removed")
+	}
+
+}
@@ -0,0 +1,159 @@
+package dicom
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Options for Equal
+type EqualOptions struct {
+	IgnoreTags    []Tag // tags to leave out of the comparison, at any depth
+	IgnorePrivate bool  // leave out elements of odd groups
+}
+
+func (opts *EqualOptions) ignored(elem *DicomElement) bool {
+	if opts.IgnorePrivate && elem.Group%2 == 1 {
+		return true
+	}
+	for _, tag := range opts.IgnoreTags {
+		if elem.Tag() == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Reports whether two files hold the same data elements and values.
+// Encoding details are ignored: VRs, value lengths, defined or undefined
+// length sequences, and the padding of string and binary values.
+func Equal(a, b *DicomFile, opts EqualOptions) bool {
+
+	return equalElements(a.topLevel(), b.topLevel(), &opts)
+}
+
+// Compare two lists of elements, leaving out ignored elements
+func equalElements(a, b []*DicomElement, opts *EqualOptions) bool {
+
+	a = filterIgnored(a, opts)
+	b = filterIgnored(b, opts)
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !equalElement(a[i], b[i], opts) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func filterIgnored(elems []*DicomElement, opts *EqualOptions) []*DicomElement {
+	var kept []*DicomElement
+	for _, elem := range elems {
+		if !opts.ignored(elem) {
+			kept = append(kept, elem)
+		}
+	}
+	return kept
+}
+
+func equalElement(a, b *DicomElement, opts *EqualOptions) bool {
+	return a.Tag() == b.Tag() && equalValue(a.value(), b.value(), opts)
+}
+
+// Reports whether a value is empty. A single empty string is the same as no
+// value at all.
+func isEmpty(v Value) bool {
+	if v == nil || v.Len() == 0 {
+		return true
+	}
+	if s, ok := v.(Strings); ok && len(s) == 1 {
+		return trimPadding(s[0]) == ""
+	}
+	return false
+}
+
+func trimPadding(s string) string {
+	return strings.TrimRight(s, " \x00")
+}
+
+func equalValue(a, b Value, opts *EqualOptions) bool {
+
+	if isEmpty(a) || isEmpty(b) {
+		return isEmpty(a) && isEmpty(b)
+	}
+
+	switch a := a.(type) {
+	case Strings:
+		b, ok := b.(Strings)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if trimPadding(a[i]) != trimPadding(b[i]) {
+				return false
+			}
+		}
+		return true
+	case Bytes:
+		b, ok := b.(Bytes)
+		return ok && bytes.Equal(padBytes(a), padBytes(b))
+	case Sequence:
+		b, ok := b.(Sequence)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equalElements(a[i].Elements, b[i].Elements, opts) {
+				return false
+			}
+		}
+		return true
+	case *PixelData:
+		b, ok := b.(*PixelData)
+		if !ok || len(a.Fragments) != len(b.Fragments) || len(a.Offsets) != len(b.Offsets) {
+			return false
+		}
+		for i := range a.Offsets {
+			if a.Offsets[i] != b.Offsets[i] {
+				return false
+			}
+		}
+		for i := range a.Fragments {
+			if !bytes.Equal(padBytes(a.Fragments[i]), padBytes(b.Fragments[i])) {
+				return false
+			}
+		}
+		return true
+	case Float32s:
+		b, ok := b.(Float32s)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] && math.Float32bits(a[i]) != math.Float32bits(b[i]) {
+				return false
+			}
+		}
+		return true
+	case Float64s:
+		b, ok := b.(Float64s)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] && math.Float64bits(a[i]) != math.Float64bits(b[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// integers and tags
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,51 @@
+package dicom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+
+	a := &DicomFile{}
+	a.appendDataElement(&DicomElement{Group: 0x0010, Element: 0x0010, Vr: "PN", Vl: 8, Value: Strings{"TOUTATIX"}})
+	a.appendDataElement(&DicomElement{Group: 0x0010, Element: 0x0020, Vr: "LO", Vl: 8, Value: Strings{"7DkT2Tp "}})
+
+	b := &DicomFile{}
+	b.appendDataElement(&DicomElement{Group: 0x0010, Element: 0x0010, Vr: "PN", Vl: 8, Value: Strings{"TOUTATIX"}})
+	b.appendDataElement(&DicomElement{Group: 0x0010, Element: 0x0020, Vr: "LO", Vl: 7, Value: Strings{"7DkT2Tp"}})
+
+	if !Equal(a, b, EqualOptions{}) {
+		t.Error("Files with differently padded values should be equal")
+	}
+
+	b.Elements[1].Value.(Strings)[0] = "other"
+	if Equal(a, b, EqualOptions{}) {
+		t.Error("Files with different values should not be equal")
+	}
+
+	if !Equal(a, b, EqualOptions{IgnoreTags: []Tag{TagPatientID}}) {
+		t.Error("Ignored tags should not be compared")
+	}
+
+}
+
+func TestEqualFloats(t *testing.T) {
+
+	nan := math.Float32frombits(0x7fc00001)
+	for _, c := range []struct {
+		a, b  Value
+		equal bool
+	}{
+		{Float32s{1.5, nan}, Float32s{1.5, nan}, true},
+		{Float64s{math.NaN()}, Float64s{math.NaN()}, true},
+		{Float32s{nan}, Float32s{float32(math.NaN())}, false},
+		{Float64s{0.5}, Float64s{0.25}, false},
+		{Float64s{0.5}, Float32s{0.5}, false},
+	} {
+		if equalValue(c.a, c.b, &EqualOptions{}) != c.equal {
+			t.Errorf("%v and %v: expected equal to be %v", c.a, c.b, c.equal)
+		}
+	}
+
+}
@@ -0,0 +1,210 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	fp "path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The instances of a file set grouped by patient, study and series, each
+// level sorted: patients by PatientID, studies by StudyDate and StudyTime,
+// series by SeriesNumber and instances by InstanceNumber
+type FileSet struct {
+	Patients []*FileSetPatient
+}
+
+// A patient of a file set, and its studies
+type FileSetPatient struct {
+	PatientID   string
+	PatientName string
+	Studies     []*FileSetStudy
+}
+
+// A study of a file set, and its series
+type FileSetStudy struct {
+	StudyInstanceUID string
+	StudyTime        time.Time
+	StudyDescription string
+	AccessionNumber  string
+	Series           []*FileSetSeries
+}
+
+// A series of a file set, and its instances
+type FileSetSeries struct {
+	SeriesInstanceUID string
+	Modality          string
+	SeriesNumber      int
+	Instances         []FileSetFile
+}
+
+// Read the DICOM files of a folder and its subfolders with p, each with the
+// components of its path from root as File ID. Files which are not DICOM
+// files and DICOMDIRs are skipped.
+func ReadDirectory(root string, p *Parser) ([]FileSetFile, error) {
+
+	var files []FileSetFile
+	err := fp.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		file, err := readFileSetFile(p, path)
+		if err != nil || file == nil {
+			return err
+		}
+		id, err := fileID(root, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, FileSetFile{id, file})
+		return nil
+	})
+
+	return files, err
+}
+
+// Read a file of a file set. Returns nil for files which are not DICOM
+// files, and for DICOMDIRs.
+func readFileSetFile(p *Parser, path string) (*DicomFile, error) {
+
+	buff, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	file, err := p.Parse(buff)
+	if errors.Is(err, ErrBrokenFile) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if class, _ := file.lookupString(TagMediaStorageSOPClassUID); class == mediaStorageDirectoryStorage {
+		return nil, nil
+	}
+
+	return file, nil
+}
+
+// The File ID of a file: the components of its path from root
+func fileID(root, path string) ([]string, error) {
+	rel, err := fp.Rel(root, path)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(fp.ToSlash(rel), "/"), nil
+}
+
+// Group files by patient, study and series
+func NewFileSet(files []FileSetFile) (*FileSet, error) {
+
+	set := &FileSet{}
+	patients := map[string]*FileSetPatient{}
+	studies := map[string]*FileSetStudy{}
+	series := map[string]*FileSetSeries{}
+	numbers := map[*DicomFile]int{} // InstanceNumber of the files
+
+	for _, f := range files {
+		info, err := f.File.Info()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fp.Join(f.ID...), err)
+		}
+		numbers[f.File] = info.InstanceNumber
+
+		patient, ok := patients[info.PatientID]
+		if !ok {
+			patient = &FileSetPatient{PatientID: info.PatientID, PatientName: info.PatientName}
+			patients[info.PatientID] = patient
+			set.Patients = append(set.Patients, patient)
+		}
+
+		study, ok := studies[info.StudyInstanceUID]
+		if !ok {
+			study = &FileSetStudy{StudyInstanceUID: info.StudyInstanceUID, StudyTime: info.StudyTime}
+			study.StudyDescription, _ = f.File.lookupString(TagStudyDescription)
+			study.AccessionNumber, _ = f.File.lookupString(TagAccessionNumber)
+			studies[info.StudyInstanceUID] = study
+			patient.Studies = append(patient.Studies, study)
+		}
+
+		s, ok := series[info.SeriesInstanceUID]
+		if !ok {
+			number, _, err := f.File.lookupInt(TagSeriesNumber)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", fp.Join(f.ID...), err)
+			}
+			s = &FileSetSeries{SeriesInstanceUID: info.SeriesInstanceUID, Modality: info.Modality, SeriesNumber: number}
+			series[info.SeriesInstanceUID] = s
+			study.Series = append(study.Series, s)
+		}
+		s.Instances = append(s.Instances, f)
+	}
+
+	sort.SliceStable(set.Patients, func(i, j int) bool {
+		return set.Patients[i].PatientID < set.Patients[j].PatientID
+	})
+	for _, patient := range set.Patients {
+		sort.SliceStable(patient.Studies, func(i, j int) bool {
+			return patient.Studies[i].StudyTime.Before(patient.Studies[j].StudyTime)
+		})
+		for _, study := range patient.Studies {
+			sort.SliceStable(study.Series, func(i, j int) bool {
+				return study.Series[i].SeriesNumber < study.Series[j].SeriesNumber
+			})
+			for _, s := range study.Series {
+				sort.SliceStable(s.Instances, func(i, j int) bool {
+					return numbers[s.Instances[i].File] < numbers[s.Instances[j].File]
+				})
+			}
+		}
+	}
+
+	return set, nil
+}
+
+// The number of instances of the patient
+func (patient *FileSetPatient) NumberOfInstances() int {
+	n := 0
+	for _, study := range patient.Studies {
+		n += study.NumberOfInstances()
+	}
+	return n
+}
+
+// The number of instances of the study
+func (study *FileSetStudy) NumberOfInstances() int {
+	n := 0
+	for _, s := range study.Series {
+		n += len(s.Instances)
+	}
+	return n
+}
+
+// The modalities of the series of the study, in series order, each once
+func (study *FileSetStudy) Modalities() []string {
+	var modalities []string
+	seen := map[string]bool{}
+	for _, s := range study.Series {
+		if s.Modality != "" && !seen[s.Modality] {
+			seen[s.Modality] = true
+			modalities = append(modalities, s.Modality)
+		}
+	}
+	return modalities
+}
+
+// Look up a study by StudyInstanceUID
+func (set *FileSet) Study(uid string) (*FileSetStudy, bool) {
+	for _, patient := range set.Patients {
+		for _, study := range patient.Studies {
+			if study.StudyInstanceUID == uid {
+				return study, true
+			}
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,48 @@
+package dicom
+
+import (
+	"testing"
+)
+
+func TestFileSet(t *testing.T) {
+
+	p, _ := NewParser(HeaderOnly())
+	files, err := ReadDirectory("examples", p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 || len(files[0].ID) != 1 {
+		t.Fatalf("Incorrect files %v", files)
+	}
+
+	set, err := NewFileSet(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := 0
+	for _, patient := range set.Patients {
+		n += patient.NumberOfInstances()
+		for _, study := range patient.Studies {
+			if len(study.Modalities()) == 0 {
+				t.Errorf("Study %s without modalities", study.StudyInstanceUID)
+			}
+			if s, ok := set.Study(study.StudyInstanceUID); !ok || s != study {
+				t.Errorf("Study %s not found", study.StudyInstanceUID)
+			}
+			for _, series := range study.Series {
+				last := -1
+				for _, instance := range series.Instances {
+					info, _ := instance.File.Info()
+					if info.InstanceNumber < last {
+						t.Errorf("Instances of series %s not sorted", series.SeriesInstanceUID)
+					}
+					last = info.InstanceNumber
+				}
+			}
+		}
+	}
+	if n != len(files) {
+		t.Errorf("File set of %d instances, expected %d", n, len(files))
+	}
+}
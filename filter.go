@@ -0,0 +1,397 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var ErrInvalidFilter = errors.New("Invalid filter")
+
+// A compiled filter expression, ie.
+//
+//	Modality == "CT" && SliceThickness < 2.0 && StudyDate >= 20240101
+//
+// Operands are element paths as accepted by GetByPath, compared with ==, !=,
+// <, <=, > or >= to a quoted string or a number. Numbers compare numerically
+// with numeric values and with DS, IS, DA and TM strings, quoted strings
+// compare as strings. A comparison holds when any value of any element at
+// the path satisfies it. A path on its own tests for the presence of the
+// element. Comparisons are combined with &&, || and !, and grouped with
+// parentheses.
+type Filter struct {
+	expr string
+	root filterNode
+}
+
+type filterNode interface {
+	match(file *DicomFile) bool
+}
+
+type (
+	filterAnd     struct{ a, b filterNode }
+	filterOr      struct{ a, b filterNode }
+	filterNot     struct{ a filterNode }
+	filterPresent struct{ path string }
+	filterCompare struct {
+		path  string
+		op    string
+		str   string
+		num   float64
+		isNum bool
+	}
+)
+
+func (n *filterAnd) match(file *DicomFile) bool { return n.a.match(file) && n.b.match(file) }
+func (n *filterOr) match(file *DicomFile) bool  { return n.a.match(file) || n.b.match(file) }
+func (n *filterNot) match(file *DicomFile) bool { return !n.a.match(file) }
+
+func (n *filterPresent) match(file *DicomFile) bool {
+	_, err := file.GetByPath(n.path)
+	return err == nil
+}
+
+func (n *filterCompare) match(file *DicomFile) bool {
+
+	elems, err := file.GetByPath(n.path)
+	if err != nil {
+		return false
+	}
+
+	for _, elem := range elems {
+		if n.isNum {
+			for _, v := range numericValues(elem) {
+				if compare(n.op, v-n.num) {
+					return true
+				}
+			}
+		} else if values, err := elem.GetStrings(); err == nil {
+			for _, v := range values {
+				if compare(n.op, float64(strings.Compare(v, n.str))) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// Reports whether the result of a comparison, negative, zero or positive,
+// satisfies op
+func compare(op string, cmp float64) bool {
+	switch op {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+// The values of an element as numbers, parsing the strings of DS, IS, DA
+// and TM values
+func numericValues(elem *DicomElement) []float64 {
+
+	var values []float64
+	switch v := elem.Value.(type) {
+	case Strings:
+		for _, s := range v {
+			if f, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+				values = append(values, f)
+			}
+		}
+	case UInt16s:
+		for _, n := range v {
+			values = append(values, float64(n))
+		}
+	case Int16s:
+		for _, n := range v {
+			values = append(values, float64(n))
+		}
+	case UInt32s:
+		for _, n := range v {
+			values = append(values, float64(n))
+		}
+	case Int32s:
+		for _, n := range v {
+			values = append(values, float64(n))
+		}
+	case UInt64s:
+		for _, n := range v {
+			values = append(values, float64(n))
+		}
+	case Int64s:
+		for _, n := range v {
+			values = append(values, float64(n))
+		}
+	case Float32s:
+		for _, f := range v {
+			values = append(values, float64(f))
+		}
+	case Float64s:
+		values = append(values, v...)
+	}
+
+	return values
+}
+
+// Compile a filter expression
+func CompileFilter(expr string) (*Filter, error) {
+
+	tokens, err := tokenizeFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	fp := &filterParser{tokens: tokens}
+	root, err := fp.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if fp.pos < len(fp.tokens) {
+		return nil, fp.errorf("unexpected %q", fp.tokens[fp.pos].text)
+	}
+
+	return &Filter{expr, root}, nil
+}
+
+// Like CompileFilter, but panics on error
+func MustCompileFilter(expr string) *Filter {
+	f, err := CompileFilter(expr)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// Reports whether a file satisfies the filter
+func (f *Filter) Match(file *DicomFile) bool {
+	return f.root.match(file)
+}
+
+// Stringer
+func (f *Filter) String() string {
+	return f.expr
+}
+
+type filterToken struct {
+	kind byte // 'p'ath, 's'tring, 'n'umber or 'o'perator
+	text string
+	pos  int
+}
+
+func tokenizeFilter(expr string) ([]filterToken, error) {
+
+	var tokens []filterToken
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '"':
+			j := strings.IndexByte(expr[i+1:], '"')
+			if j < 0 {
+				return nil, fmt.Errorf("%w: unterminated string at %d", ErrInvalidFilter, i)
+			}
+			tokens = append(tokens, filterToken{'s', expr[i+1 : i+1+j], i})
+			i += j + 2
+		case strings.ContainsRune("=!<>&|", rune(c)):
+			op := string(c)
+			if i+1 < len(expr) && strings.ContainsRune("=&|", rune(expr[i+1])) {
+				op = expr[i : i+2]
+			}
+			switch op {
+			case "==", "!=", "<", "<=", ">", ">=", "&&", "||", "!":
+			default:
+				return nil, fmt.Errorf("%w: unknown operator %q at %d", ErrInvalidFilter, op, i)
+			}
+			tokens = append(tokens, filterToken{'o', op, i})
+			i += len(op)
+		case c == '(' && !isTagAt(expr, i) || c == ')':
+			tokens = append(tokens, filterToken{'o', string(c), i})
+			i++
+		case c == '-' || c == '+' || c == '.' || unicode.IsDigit(rune(c)):
+			j := i + 1
+			for j < len(expr) && strings.ContainsRune("0123456789.eE+-", rune(expr[j])) {
+				j++
+			}
+			tokens = append(tokens, filterToken{'n', expr[i:j], i})
+			i = j
+		default:
+			// a path, up to the next space, operator or closing parenthesis
+			// that is not part of a (gggg,eeee) tag
+			j, depth := i, 0
+			for j < len(expr) {
+				d := expr[j]
+				if d == '(' {
+					depth++
+				} else if d == ')' {
+					if depth == 0 {
+						break
+					}
+					depth--
+				} else if depth == 0 && strings.ContainsRune(" \t=!<>&|\"", rune(d)) {
+					break
+				}
+				j++
+			}
+			tokens = append(tokens, filterToken{'p', expr[i:j], i})
+			i = j
+		}
+	}
+
+	return tokens, nil
+}
+
+// Reports whether expr holds a (gggg,eeee) tag at i
+func isTagAt(expr string, i int) bool {
+	if len(expr) < i+11 || expr[i+5] != ',' || expr[i+10] != ')' {
+		return false
+	}
+	_, err := strconv.ParseUint(expr[i+1:i+5]+expr[i+6:i+10], 16, 32)
+	return err == nil
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (fp *filterParser) errorf(format string, args ...interface{}) error {
+	at := -1
+	if fp.pos < len(fp.tokens) {
+		at = fp.tokens[fp.pos].pos
+	}
+	return fmt.Errorf("%w: %s at %d", ErrInvalidFilter, fmt.Sprintf(format, args...), at)
+}
+
+// Reports whether the next token is the operator op, and consumes it
+func (fp *filterParser) accept(op string) bool {
+	if fp.pos < len(fp.tokens) && fp.tokens[fp.pos].kind == 'o' && fp.tokens[fp.pos].text == op {
+		fp.pos++
+		return true
+	}
+	return false
+}
+
+func (fp *filterParser) parseOr() (filterNode, error) {
+	a, err := fp.parseAnd()
+	for err == nil && fp.accept("||") {
+		var b filterNode
+		if b, err = fp.parseAnd(); err == nil {
+			a = &filterOr{a, b}
+		}
+	}
+	return a, err
+}
+
+func (fp *filterParser) parseAnd() (filterNode, error) {
+	a, err := fp.parseUnary()
+	for err == nil && fp.accept("&&") {
+		var b filterNode
+		if b, err = fp.parseUnary(); err == nil {
+			a = &filterAnd{a, b}
+		}
+	}
+	return a, err
+}
+
+func (fp *filterParser) parseUnary() (filterNode, error) {
+
+	if fp.accept("!") {
+		a, err := fp.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &filterNot{a}, nil
+	}
+
+	if fp.accept("(") {
+		a, err := fp.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !fp.accept(")") {
+			return nil, fp.errorf("missing )")
+		}
+		return a, nil
+	}
+
+	return fp.parseComparison()
+}
+
+func (fp *filterParser) parseComparison() (filterNode, error) {
+
+	if fp.pos >= len(fp.tokens) || fp.tokens[fp.pos].kind != 'p' {
+		return nil, fp.errorf("expected an element")
+	}
+	path := fp.tokens[fp.pos].text
+	if err := checkFilterPath(path); err != nil {
+		return nil, fp.errorf("%s", err)
+	}
+	fp.pos++
+
+	if fp.pos >= len(fp.tokens) {
+		return &filterPresent{path}, nil
+	}
+
+	op := fp.tokens[fp.pos]
+	switch op.text {
+	case "==", "!=", "<", "<=", ">", ">=":
+	default:
+		return &filterPresent{path}, nil
+	}
+	fp.pos++
+
+	if fp.pos >= len(fp.tokens) {
+		return nil, fp.errorf("expected a value")
+	}
+	value := fp.tokens[fp.pos]
+	node := &filterCompare{path: path, op: op.text}
+	switch value.kind {
+	case 's':
+		node.str = value.text
+	case 'n':
+		f, err := strconv.ParseFloat(value.text, 64)
+		if err != nil {
+			return nil, fp.errorf("invalid number %q", value.text)
+		}
+		node.num, node.isNum = f, true
+	default:
+		return nil, fp.errorf("expected a value")
+	}
+	fp.pos++
+
+	return node, nil
+}
+
+// Check the syntax of a path and that its keywords are in the dictionary
+func checkFilterPath(path string) error {
+
+	queries, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	for _, q := range queries {
+		if q.name == "" {
+			continue
+		}
+		if _, err := standardParser().LookupTag(q.name); err != nil {
+			return fmt.Errorf("unknown keyword %s", q.name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package dicom
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		expr  string
+		match bool
+	}{
+		{`Modality == "CT"`, true},
+		{`Modality != "CT"`, false},
+		{`Modality == "CT" && SliceThickness < 2.0 && StudyDate >= 20050101`, true},
+		{`StudyDate >= 20240101`, false},
+		{`Rows == 512 && Columns > 256`, true},
+		{`Modality == "MR" || (Rows == 512 && !(Columns < 512))`, true},
+		{`(0008,0060) == "CT"`, true},
+		{`RequestAttributesSequence.ScheduledProtocolCodeSequence.CodeValue == "CTCHWCACOR"`, true},
+		{`RequestAttributesSequence`, true},
+		{`!PatientBirthName`, true},
+	}
+
+	for _, c := range cases {
+		f, err := CompileFilter(c.expr)
+		if err != nil {
+			t.Errorf("Could not compile %s: %v", c.expr, err)
+			continue
+		}
+		if f.Match(file) != c.match {
+			t.Errorf("Incorrect match of %s", c.expr)
+		}
+	}
+
+	for _, expr := range []string{`Modality ==`, `Modality = "CT"`, `Modalty == "CT"`, `(Rows == 1`, `Modality == "CT`, `Rows == 1 Columns`} {
+		if _, err := CompileFilter(expr); !errors.Is(err, ErrInvalidFilter) {
+			t.Errorf("Expected ErrInvalidFilter for %s, got %v", expr, err)
+		}
+	}
+
+}
@@ -0,0 +1,161 @@
+//go:build ignore
+// +build ignore
+
+// Generates charsetdata.go, containing the tables of the GB18030 character set
+// built from the GB18030 indexes of the WHATWG Encoding Standard, as Go has no
+// such codec without golang.org/x/text. The indexes are downloaded, or read
+// from the directory given as argument.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const indexURL = "https://encoding.spec.whatwg.org/"
+
+// The two-byte codes, 190 per lead byte from 0x81 to 0xFE
+const twoByteCodes = 126 * 190
+
+// The four-byte codes of the BMP, from 81308130 to 8431A439
+const bmpCodes = 39420
+
+func main() {
+
+	twoByte := make([]uint16, twoByteCodes)
+	for _, pair := range readIndex("index-gb18030.txt") {
+		if pair[0] < twoByteCodes && pair[1] <= 0xFFFF {
+			twoByte[pair[0]] = uint16(pair[1])
+		}
+	}
+
+	var ranges [][2]int
+	for _, pair := range readIndex("index-gb18030-ranges.txt") {
+		if pair[0] < bmpCodes {
+			ranges = append(ranges, pair)
+		}
+	}
+	// GB 18030-2005 maps 8135F437 to U+E7C7, outside of the ranges
+	ranges = splitRange(ranges, 7457, 0xE7C7)
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, "// Code generated by gencharset.go; DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package dicom")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "// The characters of the two-byte codes of GB18030, 190 per lead byte from")
+	fmt.Fprintln(buf, "// 0x81, 0 for no character")
+	fmt.Fprintln(buf, "var gb18030TwoByte = [...]uint16{")
+	for i := 0; i < len(twoByte); i += 12 {
+		end := i + 12
+		if end > len(twoByte) {
+			end = len(twoByte)
+		}
+		var line []string
+		for _, c := range twoByte[i:end] {
+			line = append(line, fmt.Sprintf("0x%04x,", c))
+		}
+		fmt.Fprintf(buf, "\t%s\n", strings.Join(line, " "))
+	}
+	fmt.Fprintln(buf, "}")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "// The four-byte codes of GB18030 of the BMP, by ranges of consecutive")
+	fmt.Fprintln(buf, "// characters: the linear index of the first code of a range, from")
+	fmt.Fprintln(buf, "// 81308130, and its character")
+	fmt.Fprintln(buf, "var gb18030Ranges = [...][2]uint16{")
+	for _, r := range ranges {
+		fmt.Fprintf(buf, "\t{0x%04x, 0x%04x},\n", r[0], r[1])
+	}
+	fmt.Fprintln(buf, "}")
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	if err := ioutil.WriteFile("charsetdata.go", out, 0644); err != nil {
+		panic(err)
+	}
+}
+
+// The pointers and code points of an index, ie. lines such as
+// "7457	0xE7C7	(comment)"
+func readIndex(name string) [][2]int {
+
+	var r io.Reader
+	if len(os.Args) > 1 {
+		f, err := os.Open(filepath.Join(os.Args[1], name))
+		if err != nil {
+			panic(err)
+		}
+		defer f.Close()
+		r = f
+	} else {
+		resp, err := http.Get(indexURL + name)
+		if err != nil {
+			panic(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			panic(fmt.Sprintf("%s: %s", name, resp.Status))
+		}
+		r = resp.Body
+	}
+
+	var pairs [][2]int
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		pointer, err := strconv.Atoi(fields[0])
+		if err != nil {
+			panic(err)
+		}
+		c, err := strconv.ParseInt(fields[1], 0, 32)
+		if err != nil {
+			panic(err)
+		}
+		pairs = append(pairs, [2]int{pointer, int(c)})
+	}
+	if err := scanner.Err(); err != nil {
+		panic(err)
+	}
+
+	return pairs
+}
+
+// The ranges with the code of a pointer mapped to a character on its own
+func splitRange(ranges [][2]int, pointer, c int) [][2]int {
+
+	var split [][2]int
+	for i, r := range ranges {
+		end := bmpCodes
+		if i+1 < len(ranges) {
+			end = ranges[i+1][0]
+		}
+		if pointer < r[0] || pointer >= end {
+			split = append(split, r)
+			continue
+		}
+		if pointer > r[0] {
+			split = append(split, r)
+		}
+		split = append(split, [2]int{pointer, c})
+		if pointer+1 < end {
+			split = append(split, [2]int{pointer + 1, r[1] + pointer + 1 - r[0]})
+		}
+	}
+
+	return split
+}
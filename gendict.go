@@ -0,0 +1,93 @@
+//go:build ignore
+// +build ignore
+
+// Generates dictdata.go, containing the entries of the default dictionary in
+// dict.go as a table sorted by tag
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type entry struct {
+	group, element        uint64
+	vr, name, vm, version string
+}
+
+func main() {
+
+	src, err := ioutil.ReadFile("dict.go")
+	if err != nil {
+		panic(err)
+	}
+
+	// the dictionary is the only raw string literal in dict.go
+	start := bytes.IndexByte(src, '`')
+	end := bytes.LastIndexByte(src, '`')
+	if start < 0 || end <= start {
+		panic("could not find dictionary data in dict.go")
+	}
+
+	reader := csv.NewReader(bytes.NewReader(src[start+1 : end]))
+	reader.Comma = '\t'
+	reader.Comment = '#'
+
+	var entries []entry
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			panic(err)
+		}
+
+		parts := strings.Split(strings.Trim(row[0], "()"), ",")
+		group, err := strconv.ParseUint(parts[0], 16, 16)
+		if err != nil {
+			continue // repeating groups have no single tag
+		}
+		element, err := strconv.ParseUint(parts[1], 16, 16)
+		if err != nil {
+			continue
+		}
+
+		entries = append(entries, entry{group, element, strings.ToUpper(row[1]), row[2], row[3], row[4]})
+	}
+
+	// entries of the same tag keep their order, the last one is looked up
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		return a.group < b.group || a.group == b.group && a.element < b.element
+	})
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, "// Code generated by gendict.go; DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package dicom")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "// Entries of the default dictionary, sorted by tag")
+	fmt.Fprintln(buf, "var stdDictionary = []dictEntry{")
+
+	for _, e := range entries {
+		fmt.Fprintf(buf, "\t{Tag{0x%04X, 0x%04X}, %q, %q, %q, %q},\n", e.group, e.element, e.vr, e.name, e.vm, e.version)
+	}
+
+	fmt.Fprintln(buf, "}")
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	if err := ioutil.WriteFile("dictdata.go", out, 0644); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,105 @@
+//go:build ignore
+// +build ignore
+
+// Generates tags.go, containing a Tag variable for every entry of the
+// default dictionary in dict.go
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
+const retired = "RETIRED_"
+
+type entry struct {
+	name           string
+	group, element uint64
+	vr, vm         string
+}
+
+func main() {
+
+	src, err := ioutil.ReadFile("dict.go")
+	if err != nil {
+		panic(err)
+	}
+
+	// the dictionary is the only raw string literal in dict.go
+	start := bytes.IndexByte(src, '`')
+	end := bytes.LastIndexByte(src, '`')
+	if start < 0 || end <= start {
+		panic("could not find dictionary data in dict.go")
+	}
+
+	reader := csv.NewReader(bytes.NewReader(src[start+1 : end]))
+	reader.Comma = '\t'
+	reader.Comment = '#'
+
+	var entries []entry
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			panic(err)
+		}
+
+		parts := strings.Split(strings.Trim(row[0], "()"), ",")
+		group, err := strconv.ParseUint(parts[0], 16, 16)
+		if err != nil {
+			continue // repeating groups have no single tag
+		}
+		element, err := strconv.ParseUint(parts[1], 16, 16)
+		if err != nil {
+			continue
+		}
+
+		entries = append(entries, entry{row[2], group, element, strings.ToUpper(row[1]), row[3]})
+	}
+
+	// current entries take precedence over retired entries with the same name
+	names := make(map[string]bool)
+	for _, e := range entries {
+		if !strings.HasPrefix(e.name, retired) {
+			names[e.name] = true
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, "// Code generated by gentags.go; DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package dicom")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "// Tags of the default dictionary")
+	fmt.Fprintln(buf, "var (")
+
+	for _, e := range entries {
+		name := e.name
+		if strings.HasPrefix(name, retired) {
+			name = strings.TrimPrefix(name, retired)
+			if names[name] {
+				continue
+			}
+			names[name] = true
+		}
+		fmt.Fprintf(buf, "\tTag%s = Tag{0x%04X, 0x%04X} // %s %s\n", name, e.group, e.element, e.vr, e.vm)
+	}
+
+	fmt.Fprintln(buf, ")")
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	if err := ioutil.WriteFile("tags.go", out, 0644); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,18 @@
+package dicom
+
+// Stop parsing at the pixel data: the elements of group 7FE0 and those
+// following are not read, which saves decoding them when only the header
+// of files is needed
+func HeaderOnly() func(*Parser) error {
+	return func(p *Parser) error {
+		p.headerOnly = true
+		return nil
+	}
+}
+
+// Reports whether the next element of the buffer starts the pixel data, or
+// any later group
+func (buffer *dicomBuffer) atPixelData() bool {
+	tag, err := buffer.peekTag()
+	return err == nil && tag.Group >= 0x7fe0
+}
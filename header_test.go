@@ -0,0 +1,28 @@
+package dicom
+
+import (
+	"testing"
+)
+
+func TestParseHeaderOnly(t *testing.T) {
+
+	parser, _ := NewParser(HeaderOnly())
+	data, err := parser.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := data.LookupElementByTag(TagPixelData); err != ErrNotFound {
+		t.Errorf("PixelData should not be read, got %v", err)
+	}
+	if _, err := data.LookupElementByTag(TagPatientName); err != nil {
+		t.Error(err)
+	}
+
+	full, _ := NewParser()
+	all, _ := full.Parse(readFile())
+	if len(data.Elements) != len(all.Elements)-1 {
+		t.Errorf("Expected %d elements, got %d", len(all.Elements)-1, len(data.Elements))
+	}
+
+}
@@ -0,0 +1,295 @@
+package dicom
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	fp "path/filepath"
+	"sort"
+	"strings"
+)
+
+var ErrInvalidIndex = errors.New("Invalid header index")
+
+// The first bytes of a header index file, with its format version
+const headerIndexMagic = "DICMIDX1"
+
+// The attributes kept by default in a header index: those of FileSet, Info
+// and the DICOMDIR records
+var defaultIndexTags = []Tag{
+	TagSpecificCharacterSet, TagSOPClassUID, TagSOPInstanceUID, TagStudyDate, TagStudyTime, TagAccessionNumber,
+	TagModality, TagTimezoneOffsetFromUTC, TagStudyDescription, TagPatientName, TagPatientID, TagStudyInstanceUID,
+	TagSeriesInstanceUID, TagStudyID, TagSeriesNumber, TagInstanceNumber,
+}
+
+// A cache of the key attributes of the files of a folder, saved to a file,
+// so that scanning the folder again only parses the files which were added
+// or modified since. Files are known by path, size and modification time.
+type HeaderIndex struct {
+	path    string
+	tags    []Tag // sorted
+	entries map[string]*indexEntry
+	parsed  int // files parsed by the last Scan
+}
+
+// A file of the index
+type indexEntry struct {
+	size    int64
+	modTime int64 // in nanoseconds
+	dicom   bool
+	dataSet []byte
+}
+
+// Maximum length of the paths and data sets of an index file
+const maxIndexBytes = 1 << 26
+
+// Open the index saved at path, which may not exist yet, keeping the
+// attributes of tags, or defaultIndexTags if none are given. A saved index
+// of other attributes is discarded.
+func OpenHeaderIndex(path string, tags ...Tag) (*HeaderIndex, error) {
+
+	if len(tags) == 0 {
+		tags = defaultIndexTags
+	}
+	idx := &HeaderIndex{path: path, tags: append([]Tag(nil), tags...), entries: map[string]*indexEntry{}}
+	sort.Slice(idx.tags, func(i, j int) bool { return tagLess(idx.tags[i], idx.tags[j]) })
+
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return idx, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if err := idx.read(bufio.NewReader(f)); err != nil {
+		return nil, err
+	}
+
+	return idx, nil
+}
+
+// Read the files of a folder and its subfolders as ReadDirectory does,
+// parsing only the files which are not in the index yet or have changed.
+// The files returned hold the indexed attributes only. The index forgets the
+// files which are not in the folder anymore.
+func (idx *HeaderIndex) Scan(root string, p *Parser) ([]FileSetFile, error) {
+
+	idx.parsed = 0
+	entries := map[string]*indexEntry{}
+	var files []FileSetFile
+
+	err := fp.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		entry, ok := idx.entries[path]
+		if !ok || entry.size != info.Size() || entry.modTime != info.ModTime().UnixNano() {
+			if entry, err = idx.index(p, path, info); err != nil {
+				return err
+			}
+		}
+		entries[path] = entry
+		if !entry.dicom {
+			return nil
+		}
+
+		file, err := standardParser().ParseDataSet(entry.dataSet, ExplicitVRLittleEndian)
+		if err != nil {
+			return err
+		}
+		id, err := fileID(root, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, FileSetFile{id, file})
+		return nil
+	})
+
+	// the files of other folders are kept
+	for path, entry := range idx.entries {
+		if _, ok := entries[path]; !ok && !within(root, path) {
+			entries[path] = entry
+		}
+	}
+	idx.entries = entries
+
+	return files, err
+}
+
+// Whether path is in the folder root or its subfolders
+func within(root, path string) bool {
+	rel, err := fp.Rel(root, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(fp.Separator))
+}
+
+// Parse a file and encode its indexed attributes
+func (idx *HeaderIndex) index(p *Parser, path string, info fs.FileInfo) (*indexEntry, error) {
+
+	idx.parsed++
+	entry := &indexEntry{size: info.Size(), modTime: info.ModTime().UnixNano()}
+
+	file, err := readFileSetFile(p, path)
+	if err != nil || file == nil {
+		return entry, err
+	}
+
+	keys := &DicomFile{}
+	for _, tag := range idx.tags {
+		if elem, err := file.LookupElementByTag(tag); err == nil {
+			keys.appendDataElement(elem.Clone())
+		}
+	}
+	buff := new(bytes.Buffer)
+	if err := keys.WriteDataSet(buff, ExplicitVRLittleEndian); err != nil {
+		return nil, err
+	}
+	entry.dicom, entry.dataSet = true, buff.Bytes()
+
+	return entry, nil
+}
+
+// Save the index to its file, replacing it once written
+func (idx *HeaderIndex) Save() error {
+
+	tmp := idx.path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	err = idx.write(w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	return os.Rename(tmp, idx.path)
+}
+
+// The format of an index file: the magic, the number of tags and the tags,
+// then per file its path, size, modification time and data set in Explicit
+// VR Little Endian. The lengths of data sets are incremented by one, 0 marking
+// files which are not DICOM files. Lengths and numbers are varints.
+func (idx *HeaderIndex) write(w *bufio.Writer) error {
+
+	b := []byte(headerIndexMagic)
+	b = binary.AppendUvarint(b, uint64(len(idx.tags)))
+	for _, tag := range idx.tags {
+		b = binary.LittleEndian.AppendUint16(b, tag.Group)
+		b = binary.LittleEndian.AppendUint16(b, tag.Element)
+	}
+
+	paths := make([]string, 0, len(idx.entries))
+	for path := range idx.entries {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		entry := idx.entries[path]
+		b = binary.AppendUvarint(b, uint64(len(path)))
+		b = append(b, path...)
+		b = binary.AppendVarint(b, entry.size)
+		b = binary.AppendVarint(b, entry.modTime)
+		if entry.dicom {
+			b = binary.AppendUvarint(b, uint64(len(entry.dataSet))+1)
+			b = append(b, entry.dataSet...)
+		} else {
+			b = binary.AppendUvarint(b, 0)
+		}
+
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+		b = b[:0]
+	}
+
+	_, err := w.Write(b)
+	return err
+}
+
+func (idx *HeaderIndex) read(r *bufio.Reader) error {
+
+	magic := make([]byte, len(headerIndexMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != headerIndexMagic {
+		return ErrInvalidIndex
+	}
+
+	n, err := binary.ReadUvarint(r)
+	if err != nil || n > maxIndexBytes/4 {
+		return ErrInvalidIndex
+	}
+	tags := make([]byte, 4*n)
+	if _, err := io.ReadFull(r, tags); err != nil {
+		return ErrInvalidIndex
+	}
+	if int(n) != len(idx.tags) {
+		return nil
+	}
+	for i, tag := range idx.tags {
+		if tag != (Tag{binary.LittleEndian.Uint16(tags[4*i:]), binary.LittleEndian.Uint16(tags[4*i+2:])}) {
+			return nil
+		}
+	}
+
+	for {
+		n, err := binary.ReadUvarint(r)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return ErrInvalidIndex
+		}
+		path, err := readIndexBytes(r, n)
+		if err != nil {
+			return err
+		}
+		entry := &indexEntry{}
+		if entry.size, err = binary.ReadVarint(r); err != nil {
+			return ErrInvalidIndex
+		}
+		if entry.modTime, err = binary.ReadVarint(r); err != nil {
+			return ErrInvalidIndex
+		}
+		if n, err = binary.ReadUvarint(r); err != nil {
+			return ErrInvalidIndex
+		}
+		if n > 0 {
+			if entry.dataSet, err = readIndexBytes(r, n-1); err != nil {
+				return err
+			}
+			entry.dicom = true
+		}
+		idx.entries[string(path)] = entry
+	}
+}
+
+// Read n bytes of an index file
+func readIndexBytes(r *bufio.Reader, n uint64) ([]byte, error) {
+	if n > maxIndexBytes {
+		return nil, ErrInvalidIndex
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, ErrInvalidIndex
+	}
+	return b, nil
+}
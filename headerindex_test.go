@@ -0,0 +1,77 @@
+package dicom
+
+import (
+	"os"
+	fp "path/filepath"
+	"testing"
+	"time"
+)
+
+func TestHeaderIndex(t *testing.T) {
+
+	dir := t.TempDir()
+	for _, name := range []string{"IM-0001-0001.dcm", "IM-0001-0002.dcm"} {
+		buff, err := os.ReadFile("examples/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fp.Join(dir, name), buff, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	os.WriteFile(fp.Join(dir, "README"), []byte("not a DICOM file"), 0644)
+
+	p, _ := NewParser(HeaderOnly())
+	path := fp.Join(t.TempDir(), "index")
+	idx, err := OpenHeaderIndex(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := idx.Scan(dir, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || idx.parsed != 3 {
+		t.Fatalf("Scanned %d files, parsed %d", len(files), idx.parsed)
+	}
+	if err := idx.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	// only the modified file is parsed again
+	later := time.Now().Add(time.Minute)
+	os.Chtimes(fp.Join(dir, "IM-0001-0002.dcm"), later, later)
+	idx, err = OpenHeaderIndex(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cached, err := idx.Scan(dir, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cached) != 2 || idx.parsed != 1 {
+		t.Errorf("Scanned %d files, parsed %d", len(cached), idx.parsed)
+	}
+
+	for i := range files {
+		a, _ := files[i].File.Info()
+		b, _ := cached[i].File.Info()
+		if *a != *b {
+			t.Errorf("Cached attributes %+v, expected %+v", b, a)
+		}
+	}
+
+	// an index of other attributes is discarded
+	idx, err = OpenHeaderIndex(path, TagSOPInstanceUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(idx.entries) != 0 {
+		t.Errorf("Index of other attributes kept")
+	}
+
+	os.WriteFile(path, []byte("DICMIDX1\xff"), 0644)
+	if _, err := OpenHeaderIndex(path); err != ErrInvalidIndex {
+		t.Errorf("Incorrect error %v", err)
+	}
+}
@@ -0,0 +1,536 @@
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"math"
+	"strconv"
+)
+
+var ErrUnsupportedImage = errors.New("Unsupported image")
+
+// JPEG transfer syntaxes decoded by image/jpeg
+const (
+	jpegBaseline = "1.2.840.10008.1.2.4.50"
+	jpegExtended = "1.2.840.10008.1.2.4.51"
+)
+
+// A VOI window, mapping values from Center-Width/2 to Center+Width/2 to the
+// full range of grays (PS 3.3 C.11.2.1.2)
+type Window struct {
+	Center, Width float64
+}
+
+// Options of Images
+type RenderOptions struct {
+	// The window of monochrome images, applied to the values after the
+	// rescale slope and intercept. The zero value uses the first
+	// WindowCenter and WindowWidth of the file.
+	Window Window
+
+	// Window each frame over its range of values, ignoring the windows of
+	// the file
+	AutoWindow bool
+}
+
+// The attributes of the Image Pixel module needed to decode pixel data
+type imageAttrs struct {
+	rows, columns, frames int
+	samples               int
+	bitsAllocated         int
+	bitsStored            int
+	signed                bool
+	planar                bool
+	photometric           string
+	slope, intercept      float64
+	window                Window
+}
+
+// Decode the frames of the pixel data and render them as 8-bit images:
+// grays for monochrome images, windowed as set by opts, and RGB for color
+// images. Native pixel data, JPEG Baseline and Extended encapsulated pixel
+// data, and the transfer syntaxes of the decoders registered with
+// RegisterPixelCodec are supported.
+func (file *DicomFile) Images(opts RenderOptions) ([]image.Image, error) {
+
+	attrs, elem, err := file.renderAttrs(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if pixels, ok := elem.Value.(*PixelData); ok {
+		return attrs.decodeEncapsulated(file, pixels)
+	}
+
+	data, bo, err := file.nativePixels(elem)
+	if err != nil {
+		return nil, err
+	}
+
+	return attrs.decodeNative(data, bo)
+}
+
+// Decode the frame at index i of Images, from 0, and render it as Images
+// does, without decoding the other frames
+func (file *DicomFile) Image(i int, opts RenderOptions) (image.Image, error) {
+
+	attrs, elem, err := file.renderAttrs(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if pixels, ok := elem.Value.(*PixelData); ok {
+		ts, err := file.lookupString(TagTransferSyntaxUID)
+		if err != nil {
+			return nil, err
+		}
+		frames := file.encapsulatedFrames(pixels, attrs.frames)
+		if i < 0 || i >= len(frames) {
+			return nil, fmt.Errorf("%w: frame %d", ErrNotFound, i+1)
+		}
+		return attrs.decodeFrame(frames[i], ts)
+	}
+
+	data, bo, err := file.nativePixels(elem)
+	if err != nil {
+		return nil, err
+	}
+	if i < 0 || i >= attrs.frames {
+		return nil, fmt.Errorf("%w: frame %d", ErrNotFound, i+1)
+	}
+
+	return attrs.decodeNativeFrame(data, bo, i)
+}
+
+// The attributes of the Image Pixel module, windowed as set by opts, and the
+// pixel data
+func (file *DicomFile) renderAttrs(opts RenderOptions) (*imageAttrs, *DicomElement, error) {
+
+	attrs, err := file.imageAttrs()
+	if err != nil {
+		return nil, nil, err
+	}
+	switch {
+	case opts.AutoWindow:
+		attrs.window = Window{}
+	case opts.Window.Width > 0:
+		attrs.window = opts.Window
+	}
+
+	elem, err := file.LookupElementByTag(TagPixelData)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attrs, elem, nil
+}
+
+// Return the bytes of native pixel data and their byte order
+func (file *DicomFile) nativePixels(elem *DicomElement) ([]byte, binary.ByteOrder, error) {
+
+	bo, _, err := file.getTransferSyntax()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch v := elem.Value.(type) {
+	case Bytes:
+		return v, bo, nil
+	case UInt16s:
+		data := make([]byte, 2*len(v))
+		for i, u := range v {
+			bo.PutUint16(data[2*i:], u)
+		}
+		return data, bo, nil
+	}
+
+	return nil, nil, ErrWrongValueType
+}
+
+// Read the attributes of the Image Pixel module
+func (file *DicomFile) imageAttrs() (*imageAttrs, error) {
+
+	attrs := &imageAttrs{frames: 1, samples: 1, slope: 1}
+
+	for _, attr := range []struct {
+		tag Tag
+		dst *int
+	}{
+		{TagRows, &attrs.rows},
+		{TagColumns, &attrs.columns},
+		{TagNumberOfFrames, &attrs.frames},
+		{TagSamplesPerPixel, &attrs.samples},
+		{TagBitsAllocated, &attrs.bitsAllocated},
+		{TagBitsStored, &attrs.bitsStored},
+	} {
+		n, ok, err := file.lookupInt(attr.tag)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			*attr.dst = n
+		}
+	}
+
+	if attrs.rows <= 0 || attrs.columns <= 0 || attrs.frames <= 0 {
+		return nil, fmt.Errorf("%w: %dx%d pixels, %d frames", ErrUnsupportedImage, attrs.columns, attrs.rows, attrs.frames)
+	}
+	if attrs.bitsStored <= 0 || attrs.bitsStored > attrs.bitsAllocated {
+		attrs.bitsStored = attrs.bitsAllocated
+	}
+
+	representation, _, err := file.lookupInt(TagPixelRepresentation)
+	if err != nil {
+		return nil, err
+	}
+	attrs.signed = representation == 1
+
+	planar, _, err := file.lookupInt(TagPlanarConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	attrs.planar = planar == 1
+
+	if attrs.photometric, err = file.lookupString(TagPhotometricInterpretation); err != nil {
+		return nil, err
+	}
+
+	for _, attr := range []struct {
+		tag Tag
+		dst *float64
+	}{
+		{TagRescaleSlope, &attrs.slope},
+		{TagRescaleIntercept, &attrs.intercept},
+		{TagWindowCenter, &attrs.window.Center},
+		{TagWindowWidth, &attrs.window.Width},
+	} {
+		elem, err := file.LookupElementByTag(attr.tag)
+		if err != nil || isEmpty(elem.Value) {
+			continue
+		}
+		decimals, err := elem.GetDecimals()
+		if err != nil {
+			return nil, err
+		}
+		if *attr.dst, err = decimals[0].Float64(); err != nil {
+			return nil, err
+		}
+	}
+
+	return attrs, nil
+}
+
+// Return the single integer value of a top level US, SS or IS element, and
+// whether it is present
+func (file *DicomFile) lookupInt(tag Tag) (int, bool, error) {
+
+	elem, err := file.LookupElementByTag(tag)
+	if err != nil || isEmpty(elem.Value) {
+		return 0, false, nil
+	}
+
+	switch v := elem.Value.(type) {
+	case UInt16s:
+		return int(v[0]), true, nil
+	case Int16s:
+		return int(v[0]), true, nil
+	}
+
+	s, err := file.lookupString(tag)
+	if err != nil {
+		return 0, false, err
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil, err
+}
+
+// Decode native pixel data, frame after frame
+func (attrs *imageAttrs) decodeNative(data []byte, bo binary.ByteOrder) ([]image.Image, error) {
+
+	images := make([]image.Image, attrs.frames)
+	for f := range images {
+		img, err := attrs.decodeNativeFrame(data, bo, f)
+		if err != nil {
+			return nil, err
+		}
+		images[f] = img
+	}
+
+	return images, nil
+}
+
+// Decode frame f of native pixel data
+func (attrs *imageAttrs) decodeNativeFrame(data []byte, bo binary.ByteOrder, f int) (image.Image, error) {
+
+	if attrs.bitsAllocated != 8 && attrs.bitsAllocated != 16 {
+		return nil, fmt.Errorf("%w: %d bits allocated", ErrUnsupportedImage, attrs.bitsAllocated)
+	}
+
+	bytesPerSample := attrs.bitsAllocated / 8
+	samples := attrs.rows * attrs.columns * attrs.samples
+	frameSize := samples * bytesPerSample
+	if len(data) < frameSize*attrs.frames {
+		return nil, ErrPixelDataTruncated
+	}
+
+	frame := data[f*frameSize : (f+1)*frameSize]
+	values := make([]int, samples)
+	for i := range values {
+		var v uint32
+		if bytesPerSample == 1 {
+			v = uint32(frame[i])
+		} else {
+			v = uint32(bo.Uint16(frame[2*i:]))
+		}
+		values[i] = attrs.storedValue(v)
+	}
+
+	return attrs.render(values)
+}
+
+// Keep the stored bits of a sample, sign extended for signed images
+func (attrs *imageAttrs) storedValue(v uint32) int {
+	bits := uint(attrs.bitsStored)
+	v &= 1<<bits - 1
+	if attrs.signed && v&(1<<(bits-1)) != 0 {
+		return int(v) - 1<<bits
+	}
+	return int(v)
+}
+
+// Render the samples of a native frame
+func (attrs *imageAttrs) render(values []int) (image.Image, error) {
+
+	switch attrs.photometric {
+	case "MONOCHROME1", "MONOCHROME2":
+		if attrs.samples != 1 {
+			break
+		}
+		return attrs.renderGray(values, image.Rect(0, 0, attrs.columns, attrs.rows)), nil
+	case "RGB":
+		if attrs.samples != 3 || attrs.bitsAllocated != 8 {
+			break
+		}
+		img := image.NewRGBA(image.Rect(0, 0, attrs.columns, attrs.rows))
+		pixels := attrs.rows * attrs.columns
+		for i := 0; i < pixels; i++ {
+			var r, g, b int
+			if attrs.planar {
+				r, g, b = values[i], values[pixels+i], values[2*pixels+i]
+			} else {
+				r, g, b = values[3*i], values[3*i+1], values[3*i+2]
+			}
+			img.Pix[4*i], img.Pix[4*i+1], img.Pix[4*i+2], img.Pix[4*i+3] = uint8(r), uint8(g), uint8(b), 0xff
+		}
+		return img, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s with %d samples of %d bits", ErrUnsupportedImage, attrs.photometric, attrs.samples, attrs.bitsAllocated)
+}
+
+// Rescale and window monochrome samples into grays
+func (attrs *imageAttrs) renderGray(values []int, bounds image.Rectangle) *image.Gray {
+
+	rescaled := make([]float64, len(values))
+	min, max := math.Inf(1), math.Inf(-1)
+	for i, v := range values {
+		rescaled[i] = float64(v)*attrs.slope + attrs.intercept
+		min = math.Min(min, rescaled[i])
+		max = math.Max(max, rescaled[i])
+	}
+
+	window := attrs.window
+	if window.Width < 1 {
+		window = Window{(min + max) / 2, max - min + 1}
+	}
+
+	img := image.NewGray(bounds)
+	for i, v := range rescaled {
+		gray := window.apply(v)
+		if attrs.photometric == "MONOCHROME1" {
+			gray = 0xff - gray
+		}
+		img.Pix[i] = gray
+	}
+
+	return img
+}
+
+// Map a value to a gray with the linear VOI LUT function (PS 3.3
+// C.11.2.1.2.1)
+func (w Window) apply(v float64) uint8 {
+	c, width := w.Center-0.5, w.Width-1
+	switch {
+	case width <= 0:
+		if v <= c {
+			return 0
+		}
+		return 0xff
+	case v <= c-width/2:
+		return 0
+	case v > c+width/2:
+		return 0xff
+	}
+	return uint8(((v-c)/width + 0.5) * 0xff)
+}
+
+// Decode the frames of encapsulated pixel data
+func (attrs *imageAttrs) decodeEncapsulated(file *DicomFile, pixels *PixelData) ([]image.Image, error) {
+
+	ts, err := file.lookupString(TagTransferSyntaxUID)
+	if err != nil {
+		return nil, err
+	}
+
+	frames := file.encapsulatedFrames(pixels, attrs.frames)
+	images := make([]image.Image, len(frames))
+	for i, frame := range frames {
+		if images[i], err = attrs.decodeFrame(frame, ts); err != nil {
+			return nil, err
+		}
+	}
+
+	return images, nil
+}
+
+// Decode a frame of encapsulated pixel data, encoded with the transfer
+// syntax ts
+func (attrs *imageAttrs) decodeFrame(frame []byte, ts string) (image.Image, error) {
+
+	if ts != jpegBaseline && ts != jpegExtended {
+		return attrs.decodeWithCodec(frame, ts)
+	}
+
+	img, err := jpeg.Decode(bytes.NewReader(frame))
+	if err != nil {
+		return nil, err
+	}
+
+	// window monochrome frames like native ones
+	bounds := img.Bounds()
+	if gray, ok := img.(*image.Gray); ok {
+		values := make([]int, 0, bounds.Dx()*bounds.Dy())
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				values = append(values, int(gray.GrayAt(x, y).Y))
+			}
+		}
+		return attrs.renderGray(values, image.Rect(0, 0, bounds.Dx(), bounds.Dy())), nil
+	}
+
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, bounds.Min, draw.Src)
+	return rgba, nil
+}
+
+// Decode a frame with the decoder registered for ts, and render it as a
+// native frame
+func (attrs *imageAttrs) decodeWithCodec(frame []byte, ts string) (image.Image, error) {
+
+	codec := lookupPixelCodec(ts)
+	if codec.decoder == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedTransferSyntax, ts)
+	}
+	data, err := codec.decoder.Decode(frame, attrs.frameInfo())
+	if err != nil {
+		return nil, err
+	}
+
+	native := *attrs
+	native.frames, native.planar = 1, false
+	if native.samples == 3 {
+		native.photometric = "RGB"
+	}
+	images, err := native.decodeNative(data, binary.LittleEndian)
+	if err != nil {
+		return nil, err
+	}
+	return images[0], nil
+}
+
+// Split the fragments into the given number of frames: one fragment per
+// frame, or using the Basic Offset Table when a frame spans several
+// fragments. Without offsets, frames start at the fragments starting a JPEG
+// or JPEG 2000 codestream.
+func (pixels *PixelData) frames(count int) [][]byte {
+
+	if count == len(pixels.Fragments) {
+		return pixels.Fragments
+	}
+
+	if count > 1 && len(pixels.Offsets) == 0 {
+		if frames := pixels.framesByMarker(count); frames != nil {
+			return frames
+		}
+	}
+
+	if count <= 1 || len(pixels.Offsets) != count {
+		return [][]byte{bytes.Join(pixels.Fragments, nil)}
+	}
+
+	offsets := make([]uint64, count)
+	for i, offset := range pixels.Offsets {
+		offsets[i] = uint64(offset)
+	}
+	return pixels.framesAt(offsets)
+}
+
+// Group the fragments into frames starting at offsets, counted from the
+// first fragment item as in the Basic and Extended Offset Tables
+func (pixels *PixelData) framesAt(offsets []uint64) [][]byte {
+
+	frames := make([][]byte, len(offsets))
+	frame, pos := -1, uint64(0)
+	for _, fragment := range pixels.Fragments {
+		for frame+1 < len(offsets) && offsets[frame+1] <= pos {
+			frame++
+		}
+		if frame >= 0 {
+			frames[frame] = append(frames[frame], fragment...)
+		}
+		// as written, odd fragments padded
+		pos += 8 + uint64(len(fragment)+len(fragment)%2)
+	}
+
+	return frames
+}
+
+// Split the encapsulated pixel data of the file into frames, using the
+// Extended Offset Table when the Basic Offset Table is empty
+func (file *DicomFile) encapsulatedFrames(pixels *PixelData, count int) [][]byte {
+
+	if count > 1 && count != len(pixels.Fragments) && len(pixels.Offsets) == 0 {
+		if elem, err := file.LookupElementByTag(TagExtendedOffsetTable); err == nil {
+			if offsets, ok := elem.Value.(UInt64s); ok && len(offsets) == count {
+				return pixels.framesAt(offsets)
+			}
+		}
+	}
+
+	return pixels.frames(count)
+}
+
+// Split the fragments into frames at the start of codestreams, or return nil
+// if they do not make count frames
+func (pixels *PixelData) framesByMarker(count int) [][]byte {
+
+	var frames [][]byte
+	for _, fragment := range pixels.Fragments {
+		if bytes.HasPrefix(fragment, []byte{0xFF, 0xD8}) || bytes.HasPrefix(fragment, []byte{0xFF, 0x4F, 0xFF, 0x51}) {
+			frames = append(frames, nil)
+		} else if len(frames) == 0 {
+			return nil
+		}
+		frames[len(frames)-1] = append(frames[len(frames)-1], fragment...)
+	}
+
+	if len(frames) != count {
+		return nil
+	}
+	return frames
+}
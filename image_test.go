@@ -0,0 +1,162 @@
+package dicom
+
+import (
+	"errors"
+	"image"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+// A native 2x2 MONOCHROME2 image
+func nativeImage(t *testing.T, pixels Value) *DicomFile {
+
+	p, _ := NewParser()
+	file := &DicomFile{}
+	for _, attr := range []struct {
+		tag   Tag
+		value Value
+	}{
+		{TagTransferSyntaxUID, Strings{explicit_vr_little_endian}},
+		{TagSamplesPerPixel, UInt16s{1}},
+		{TagPhotometricInterpretation, Strings{"MONOCHROME2"}},
+		{TagRows, UInt16s{2}},
+		{TagColumns, UInt16s{2}},
+		{TagBitsAllocated, UInt16s{16}},
+		{TagBitsStored, UInt16s{12}},
+		{TagPixelRepresentation, UInt16s{0}},
+		{TagPixelData, pixels},
+	} {
+		elem, err := p.NewElement(attr.tag, attr.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.insertElement(elem)
+	}
+
+	return file
+}
+
+func TestImagesNative(t *testing.T) {
+
+	file := nativeImage(t, UInt16s{0, 100, 200, 0xf0ff})
+
+	images, err := file.Images(RenderOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gray, ok := images[0].(*image.Gray)
+	if len(images) != 1 || !ok || gray.Bounds().Dx() != 2 {
+		t.Fatalf("Incorrect images %v", images)
+	}
+	// the range of values, 0 to 255 once the unused bits are masked
+	if gray.Pix[0] != 0 || gray.Pix[3] != 0xff || gray.Pix[1] >= gray.Pix[2] {
+		t.Errorf("Incorrect pixels %v", gray.Pix)
+	}
+
+	images, _ = file.Images(RenderOptions{Window: Window{Center: 100, Width: 2}})
+	if pix := images[0].(*image.Gray).Pix; pix[0] != 0 || pix[2] != 0xff {
+		t.Errorf("Incorrect windowed pixels %v", pix)
+	}
+
+}
+
+func TestImagesJPEG(t *testing.T) {
+
+	buff, err := ioutil.ReadFile("examples/I_000000.dcm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, _ := NewParser()
+	file, err := p.Parse(buff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	images, err := file.Images(RenderOptions{AutoWindow: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 75 || images[0].Bounds().Dx() != 512 || images[0].Bounds().Dy() != 512 {
+		t.Errorf("Incorrect images: %d of %v", len(images), images[0].Bounds())
+	}
+
+	file, _ = p.Parse(readFile())
+	if _, err := file.Images(RenderOptions{}); !errors.Is(err, ErrUnsupportedTransferSyntax) {
+		t.Errorf("Expected ErrUnsupportedTransferSyntax for JPEG 2000, got %v", err)
+	}
+
+}
+
+func TestImage(t *testing.T) {
+
+	native := nativeImage(t, UInt16s{0, 100, 200, 0xf0ff})
+	buff, err := ioutil.ReadFile("examples/I_000000.dcm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, _ := NewParser()
+	jpeg, err := p.Parse(buff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := RenderOptions{AutoWindow: true}
+	for _, c := range []struct {
+		file  *DicomFile
+		frame int
+	}{
+		{native, 0},
+		{jpeg, 40},
+	} {
+		images, err := c.file.Images(opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		img, err := c.file.Image(c.frame, opts)
+		if err != nil || !reflect.DeepEqual(img, images[c.frame]) {
+			t.Errorf("Frame %d differs from Images: %v", c.frame, err)
+		}
+		if _, err := c.file.Image(len(images), opts); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected ErrNotFound past the last frame, got %v", err)
+		}
+	}
+
+}
+
+func TestWindow(t *testing.T) {
+
+	w := Window{Center: 40, Width: 400}
+	if w.apply(-200) != 0 || w.apply(300) != 0xff || w.apply(40) != 0x7f {
+		t.Errorf("Incorrect window %v %v %v", w.apply(-200), w.apply(300), w.apply(40))
+	}
+
+}
+
+func TestFrames(t *testing.T) {
+
+	pixels := &PixelData{
+		Offsets:   []uint32{0, 20},
+		Fragments: [][]byte{{1, 2}, {3, 4}, {5, 6}},
+	}
+
+	frames := pixels.frames(2)
+	if len(frames) != 2 || len(frames[0]) != 4 || frames[1][0] != 5 {
+		t.Errorf("Incorrect frames %v", frames)
+	}
+
+	if frames := pixels.frames(1); len(frames) != 1 || len(frames[0]) != 6 {
+		t.Errorf("Incorrect frame %v", frames)
+	}
+
+	// without Basic Offset Table, a JPEG frame split in two fragments
+	pixels = &PixelData{Fragments: [][]byte{{0xFF, 0xD8, 1}, {2, 0xFF, 0xD9}, {0xFF, 0xD8, 3, 0xFF, 0xD9}}}
+	frames = pixels.frames(2)
+	if len(frames) != 2 || len(frames[0]) != 6 || len(frames[1]) != 5 {
+		t.Errorf("Incorrect frames %v", frames)
+	}
+	if frames := pixels.frames(4); len(frames) != 1 {
+		t.Errorf("Incorrect frames %v", frames)
+	}
+
+}
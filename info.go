@@ -0,0 +1,140 @@
+package dicom
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidDate = errors.New("Invalid DA value")
+	ErrInvalidTime = errors.New("Invalid TM value")
+)
+
+// The attributes most commonly needed from a file. Absent attributes are
+// left to their zero value.
+type Info struct {
+	PatientID         string
+	PatientName       string
+	StudyInstanceUID  string
+	SeriesInstanceUID string
+	SOPInstanceUID    string
+	Modality          string
+	StudyTime         time.Time // StudyDate and StudyTime, in the timezone of the file
+	InstanceNumber    int
+}
+
+// Return the common attributes of the file. Returns an error if one of them
+// is present but cannot be parsed, ie. a malformed StudyDate.
+func (file *DicomFile) Info() (*Info, error) {
+
+	info := &Info{}
+
+	for _, attr := range []struct {
+		tag Tag
+		dst *string
+	}{
+		{TagPatientID, &info.PatientID},
+		{TagPatientName, &info.PatientName},
+		{TagStudyInstanceUID, &info.StudyInstanceUID},
+		{TagSeriesInstanceUID, &info.SeriesInstanceUID},
+		{TagSOPInstanceUID, &info.SOPInstanceUID},
+		{TagModality, &info.Modality},
+	} {
+		s, err := file.lookupString(attr.tag)
+		if err != nil {
+			return nil, err
+		}
+		*attr.dst = s
+	}
+
+	date, err := file.lookupString(TagStudyDate)
+	if err != nil {
+		return nil, err
+	}
+	if date != "" {
+		if info.StudyTime, err = file.LookupTime(TagStudyDate); err != nil {
+			return nil, err
+		}
+	}
+
+	number, err := file.lookupString(TagInstanceNumber)
+	if err != nil {
+		return nil, err
+	}
+	if number != "" {
+		if info.InstanceNumber, err = strconv.Atoi(number); err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+// Return the trimmed single string value of a top level element, or an empty
+// string if the element is absent or empty
+func (file *DicomFile) lookupString(tag Tag) (string, error) {
+
+	elem, err := file.LookupElementByTag(tag)
+	if err != nil || elem.Len() == 0 {
+		return "", nil
+	}
+
+	s, err := elem.GetString()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Trim(s, " \x00"), nil
+}
+
+// Parse a DA value, YYYYMMDD, or YYYY.MM.DD as found in older files
+func parseDate(s string) (time.Time, error) {
+
+	s = strings.Replace(s, ".", "", -1)
+
+	t, err := time.Parse("20060102", s)
+	if err != nil {
+		return time.Time{}, ErrInvalidDate
+	}
+
+	return t, nil
+}
+
+// Parse a TM value, HH[MM[SS[.F{1-6}]]], or HH:MM:SS as found in older
+// files, as the duration since midnight
+func parseTime(s string) (time.Duration, error) {
+
+	s = strings.Replace(s, ":", "", -1)
+
+	frac := ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s, frac = s[:i], s[i+1:]
+	}
+
+	if len(s) < 2 || len(s) > 6 || len(s)%2 != 0 || len(frac) > 6 {
+		return 0, ErrInvalidTime
+	}
+
+	var d time.Duration
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+	limits := []int{23, 59, 60} // leap second
+	for i := 0; i < len(s); i += 2 {
+		n, err := strconv.Atoi(s[i : i+2])
+		if err != nil || n < 0 || n > limits[i/2] {
+			return 0, ErrInvalidTime
+		}
+		d += time.Duration(n) * units[i/2]
+	}
+
+	if frac != "" {
+		n, err := strconv.Atoi(frac + strings.Repeat("0", 6-len(frac)))
+		if err != nil || n < 0 {
+			return 0, ErrInvalidTime
+		}
+		d += time.Duration(n) * time.Microsecond
+	}
+
+	return d, nil
+}
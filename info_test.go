@@ -0,0 +1,56 @@
+package dicom
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInfo(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := file.Info()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.PatientName != "TOUTATIX" || info.Modality != "CT" || info.InstanceNumber != 1 {
+		t.Errorf("Incorrect info %+v", info)
+	}
+
+	studyTime := time.Date(2005, 3, 29, 14, 25, 30, 125000000, time.UTC)
+	if !info.StudyTime.Equal(studyTime) {
+		t.Errorf("Incorrect study time %v", info.StudyTime)
+	}
+
+}
+
+func TestParseTime(t *testing.T) {
+
+	cases := []struct {
+		s string
+		d time.Duration
+	}{
+		{"07", 7 * time.Hour},
+		{"0730", 7*time.Hour + 30*time.Minute},
+		{"073015.5", 7*time.Hour + 30*time.Minute + 15*time.Second + 500*time.Millisecond},
+		{"07:30:15", 7*time.Hour + 30*time.Minute + 15*time.Second},
+	}
+
+	for _, c := range cases {
+		if d, err := parseTime(c.s); err != nil || d != c.d {
+			t.Errorf("Incorrect duration for %s: %v %v", c.s, d, err)
+		}
+	}
+
+	for _, s := range []string{"", "7", "2500", "073", "0730.1234567"} {
+		if _, err := parseTime(s); err != ErrInvalidTime {
+			t.Errorf("Expected ErrInvalidTime for %q, got %v", s, err)
+		}
+	}
+
+}
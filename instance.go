@@ -0,0 +1,85 @@
+package dicom
+
+import (
+	"time"
+)
+
+// The patient and study elements copied to the instances created from a
+// subject
+var subjectTags = []Tag{
+	TagSpecificCharacterSet, TagStudyDate, TagStudyTime, TagAccessionNumber, TagReferringPhysicianName,
+	TagPatientName, TagPatientID, TagPatientBirthDate, TagPatientSex, TagStudyInstanceUID, TagStudyID,
+}
+
+// Create an instance of a SOP class, alone in a new series of the study of
+// subject, whose patient and study elements are copied, or of a new study
+// when subject is nil: the file meta information, and the SOP Common,
+// Patient, General Study and General Series modules
+func newInstance(subject *DicomFile, sopClass, modality string) (*DicomFile, error) {
+
+	file := &DicomFile{}
+	if subject != nil {
+		for _, tag := range subjectTags {
+			if i := subject.indexOf(tag); i >= 0 {
+				file.insertElement(subject.Elements[i].Clone())
+			}
+		}
+	}
+
+	study, err := file.lookupString(TagStudyInstanceUID)
+	if err != nil {
+		return nil, err
+	}
+	if study == "" {
+		if err := file.setValue(TagStudyInstanceUID, Strings{newUID()}); err != nil {
+			return nil, err
+		}
+	}
+	// type 2 elements
+	for _, tag := range []Tag{TagStudyDate, TagStudyTime, TagAccessionNumber, TagReferringPhysicianName,
+		TagPatientName, TagPatientID, TagPatientBirthDate, TagPatientSex, TagStudyID, TagManufacturer} {
+		if file.indexOf(tag) < 0 {
+			if err := file.setValue(tag, Strings{}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	instance := newUID()
+	now := time.Now()
+	for _, attr := range []itemValue{
+		{TagFileMetaInformationVersion, Bytes{0x00, 0x01}},
+		{TagMediaStorageSOPClassUID, Strings{sopClass}},
+		{TagMediaStorageSOPInstanceUID, Strings{instance}},
+		{TagTransferSyntaxUID, Strings{explicit_vr_little_endian}},
+		{TagImplementationClassUID, Strings{ImplementationClassUID}},
+		{TagSOPClassUID, Strings{sopClass}},
+		{TagSOPInstanceUID, Strings{instance}},
+		{TagContentDate, Strings{now.Format("20060102")}},
+		{TagContentTime, Strings{now.Format("150405")}},
+		{TagModality, Strings{modality}},
+		{TagSeriesInstanceUID, Strings{newUID()}},
+		{TagSeriesNumber, Strings{"1"}},
+		{TagInstanceNumber, Strings{"1"}},
+	} {
+		if err := file.setValue(attr.tag, attr.value); err != nil {
+			return nil, err
+		}
+	}
+
+	return file, nil
+}
+
+// Set the value of a top level element, adding it if absent
+func (file *DicomFile) setValue(tag Tag, value Value) error {
+	elem, err := standardParser().NewElement(tag, value)
+	if err != nil {
+		return err
+	}
+	if i := file.indexOf(tag); i >= 0 {
+		file.Elements[i] = *elem
+	} else {
+		file.insertElement(elem)
+	}
+	return nil
+}
@@ -0,0 +1,558 @@
+package dicom
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidJSON = errors.New("Invalid DICOM JSON")
+	ErrBulkData    = errors.New("Value left out as bulk data")
+)
+
+// Options of WriteJSON
+type JSONOptions struct {
+	// Write a simplified object keyed by keyword, ie. {"Rows": 512}, instead
+	// of the DICOM JSON Model of PS 3.18 F.2
+	Keywords bool
+
+	// Indent nested objects with this string, ie. "  "
+	Indent string
+}
+
+// Write the data set of the file as JSON. Group lengths are left out.
+func (file *DicomFile) WriteJSON(w io.Writer, opts JSONOptions) error {
+
+	if err := file.parseSequences(); err != nil {
+		return err
+	}
+	var v interface{}
+	if opts.Keywords {
+		v = keywordObject(file.topLevel())
+	} else {
+		obj, err := dicomJSONObject(file.topLevel())
+		if err != nil {
+			return err
+		}
+		v = obj
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", opts.Indent)
+	return enc.Encode(v)
+}
+
+// A data set in the DICOM JSON Model, keyed by GGGGEEEE
+func dicomJSONObject(elems []*DicomElement) (map[string]interface{}, error) {
+
+	obj := map[string]interface{}{}
+	for _, elem := range elems {
+		if elem.Element == 0x0000 || isDelimiter(elem) {
+			continue
+		}
+		key := fmt.Sprintf("%04X%04X", elem.Group, elem.Element)
+		attr, err := dicomJSONAttribute(elem)
+		if err != nil {
+			return nil, err
+		}
+		obj[key] = attr
+	}
+
+	return obj, nil
+}
+
+func isDelimiter(elem *DicomElement) bool {
+	return elem.Group == pixeldata_group
+}
+
+// An attribute in the DICOM JSON Model: its VR and its Value or
+// InlineBinary, left out when empty. Binary values that cannot be encoded
+// are an error rather than left out.
+func dicomJSONAttribute(elem *DicomElement) (map[string]interface{}, error) {
+
+	vr := writtenVR(elem)
+	attr := map[string]interface{}{"vr": vr}
+
+	value := elem.value()
+	if isEmpty(value) {
+		return attr, nil
+	}
+
+	if seq, ok := value.(Sequence); ok {
+		items := make([]interface{}, len(seq))
+		for i, item := range seq {
+			obj, err := dicomJSONObject(item.Elements)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = obj
+		}
+		attr["Value"] = items
+	} else if isBinaryVR(vr) {
+		b, err := encodeBinary(elem, vr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", elem.Tag(), err)
+		}
+		attr["InlineBinary"] = base64.StdEncoding.EncodeToString(b)
+	} else {
+		attr["Value"] = jsonValues(elem, vr)
+	}
+
+	return attr, nil
+}
+
+// VRs written as InlineBinary
+func isBinaryVR(vr string) bool {
+	switch vr {
+	case "OB", "OD", "OF", "OL", "OV", "OW", "UN":
+		return true
+	}
+	return false
+}
+
+// Encode a binary value in little endian, as for InlineBinary
+func encodeBinary(elem *DicomElement, vr string) ([]byte, error) {
+	buffer := newDicomWriter(binary.LittleEndian, false)
+	if _, ok := elem.Value.(*PixelData); ok {
+		if err := buffer.writeElement(elem); err != nil {
+			return nil, err
+		}
+		// leave out the tag, VR and undefined length of the element
+		return buffer.Bytes()[12:], nil
+	}
+	return buffer.encodeValue(elem, vr)
+}
+
+// Rebuild the encapsulated pixel data of a data set read from the JSON or XML
+// models, whose inline binary holds the items of the fragments as written
+// by encodeBinary
+func (file *DicomFile) decodeFragments() error {
+
+	i := file.indexOf(TagPixelData)
+	if i < 0 {
+		return nil
+	}
+	elem := &file.Elements[i]
+	b, ok := elem.Value.(Bytes)
+	if ts, _ := file.lookupString(TagTransferSyntaxUID); !ok || !isEncapsulated(ts) {
+		return nil
+	}
+
+	buffer := getDicomBuffer(b)
+	defer buffer.release()
+	elem.undefLen = true
+	pixels, err := standardParser().readFragments(buffer, elem, elem.IndentLevel+1, ignoreElement)
+	if err != nil {
+		return fmt.Errorf("encapsulated pixel data: %v", err)
+	}
+	elem.Value = pixels
+
+	return nil
+}
+
+// The values of an element as JSON values: PN values as objects, IS and DS
+// values as numbers and AT values as GGGGEEEE strings
+func jsonValues(elem *DicomElement, vr string) []interface{} {
+
+	var values []interface{}
+	switch v := elem.Value.(type) {
+	case Strings:
+		for _, s := range v {
+			values = append(values, jsonString(s, vr))
+		}
+	case Tags:
+		for _, t := range v {
+			values = append(values, fmt.Sprintf("%04X%04X", t.Group, t.Element))
+		}
+	default:
+		values = append(values, sliceValues(elem.Value)...)
+	}
+
+	return values
+}
+
+func jsonString(s, vr string) interface{} {
+	switch vr {
+	case "PN":
+		if s == "" {
+			return nil
+		}
+		name := map[string]string{}
+		for i, group := range strings.SplitN(s, "=", 3) {
+			if group != "" {
+				name[[]string{"Alphabetic", "Ideographic", "Phonetic"}[i]] = group
+			}
+		}
+		return name
+	case "IS":
+		if n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64); err == nil {
+			return n
+		}
+	case "DS":
+		if f, err := DS(s).Float64(); err == nil {
+			// keep the decimal string as is when it is a valid JSON number
+			if s = strings.TrimSpace(s); json.Valid([]byte(s)) {
+				return json.Number(s)
+			}
+			return f
+		}
+	}
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// Largest integer exactly represented by a JSON number read as a double
+const maxJSONInteger = 1<<53 - 1
+
+// The values of a numeric value as a []interface{}. SV and UV values out of
+// the range of JSON numbers are decimal strings (PS 3.18 F.2.3.1).
+func sliceValues(v Value) []interface{} {
+	var values []interface{}
+	switch v := v.(type) {
+	case UInt64s:
+		for _, n := range v {
+			if n > maxJSONInteger {
+				values = append(values, strconv.FormatUint(n, 10))
+			} else {
+				values = append(values, n)
+			}
+		}
+	case Int64s:
+		for _, n := range v {
+			if n > maxJSONInteger || n < -maxJSONInteger {
+				values = append(values, strconv.FormatInt(n, 10))
+			} else {
+				values = append(values, n)
+			}
+		}
+	case UInt16s:
+		for _, n := range v {
+			values = append(values, n)
+		}
+	case Int16s:
+		for _, n := range v {
+			values = append(values, n)
+		}
+	case UInt32s:
+		for _, n := range v {
+			values = append(values, n)
+		}
+	case Int32s:
+		for _, n := range v {
+			values = append(values, n)
+		}
+	case Float32s:
+		for _, f := range v {
+			values = append(values, f)
+		}
+	case Float64s:
+		for _, f := range v {
+			values = append(values, f)
+		}
+	}
+	return values
+}
+
+// A data set as a simplified object keyed by keyword, or by (gggg,eeee) for
+// elements without one. Single values are not wrapped in an array.
+func keywordObject(elems []*DicomElement) map[string]interface{} {
+
+	obj := map[string]interface{}{}
+	for _, elem := range elems {
+		if elem.Element == 0x0000 || isDelimiter(elem) {
+			continue
+		}
+
+		key := elem.Name
+		if key == "" || key == unknown_group_name || key == private_group_name {
+			key = elem.Tag().String()
+		}
+
+		var value interface{}
+		switch v := elem.value().(type) {
+		case Sequence:
+			items := make([]interface{}, len(v))
+			for i, item := range v {
+				items[i] = keywordObject(item.Elements)
+			}
+			value = items
+		case Bytes:
+			value = base64.StdEncoding.EncodeToString(v)
+		case *PixelData:
+			fragments := make([]string, len(v.Fragments))
+			for i, fragment := range v.Fragments {
+				fragments[i] = base64.StdEncoding.EncodeToString(fragment)
+			}
+			value = fragments
+		case nil:
+		default:
+			vr := elem.Vr
+			if vr == "PN" {
+				vr = "" // plain strings
+			}
+			values := jsonValues(elem, vr)
+			if len(values) == 1 {
+				value = values[0]
+			} else {
+				value = values
+			}
+		}
+		obj[key] = value
+	}
+
+	return obj
+}
+
+// Encode the data set of the file in the DICOM JSON Model, as WriteJSON
+func (file *DicomFile) MarshalJSON() ([]byte, error) {
+	if err := file.parseSequences(); err != nil {
+		return nil, err
+	}
+	obj, err := dicomJSONObject(file.topLevel())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+// Decode a data set in the DICOM JSON Model of PS 3.18 F.2, replacing the
+// elements of the file. The values of the attributes given by a BulkDataURI
+// are left empty, with a warning wrapping ErrBulkData naming the URI. Group
+// lengths are ignored. The inline binary of encapsulated pixel data holds its
+// items, which are read back as *PixelData.
+func (file *DicomFile) UnmarshalJSON(data []byte) error {
+
+	var obj map[string]*jsonAttribute
+	if err := decodeJSON(data, &obj); err != nil {
+		return err
+	}
+
+	var warnings []Warning
+	elems, err := dicomJSONElements(obj, 0, &warnings)
+	if err != nil {
+		return err
+	}
+
+	file.Elements = make([]DicomElement, len(elems))
+	for i, elem := range elems {
+		file.Elements[i] = *elem
+	}
+	file.Warnings = warnings
+
+	if err := file.decodeFragments(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+	return nil
+}
+
+// An attribute in the DICOM JSON Model, its values left encoded
+type jsonAttribute struct {
+	VR           string            `json:"vr"`
+	Value        []json.RawMessage `json:"Value"`
+	InlineBinary string            `json:"InlineBinary"`
+	BulkDataURI  string            `json:"BulkDataURI"`
+}
+
+// Decode JSON, with numbers as json.Number
+func decodeJSON(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+	return nil
+}
+
+// The elements of a data set in the DICOM JSON Model, sorted by tag
+func dicomJSONElements(obj map[string]*jsonAttribute, level uint8, warnings *[]Warning) ([]*DicomElement, error) {
+
+	elems := make([]*DicomElement, 0, len(obj))
+	for key, attr := range obj {
+		t, err := strconv.ParseUint(key, 16, 32)
+		if err != nil || len(key) != 8 || attr == nil {
+			return nil, fmt.Errorf("%w: attribute %q", ErrInvalidJSON, key)
+		}
+		if GetVRKind(attr.VR) == VRKindUnknown {
+			return nil, fmt.Errorf("%w: attribute %q has VR %q", ErrInvalidJSON, key, attr.VR)
+		}
+		tag := Tag{uint16(t >> 16), uint16(t)}
+		if tag.Element == 0x0000 {
+			// group lengths are not part of the model, and not written
+			continue
+		}
+		elem := &DicomElement{Group: tag.Group, Element: tag.Element, Name: elementName(tag), Vr: attr.VR, IndentLevel: level}
+
+		if elem.Value, err = attr.value(level, warnings); err != nil {
+			return nil, fmt.Errorf("%s: %w", elem.Tag(), err)
+		}
+		if attr.BulkDataURI != "" {
+			*warnings = append(*warnings, Warning{Tag: elem.Tag(), Err: fmt.Errorf("%w: %s", ErrBulkData, attr.BulkDataURI)})
+		}
+		elems = append(elems, elem)
+	}
+
+	sort.Slice(elems, func(i, j int) bool {
+		return tagLess(elems[i].Tag(), elems[j].Tag())
+	})
+
+	return elems, nil
+}
+
+// The name of an element in the standard dictionary, or the name of the
+// unknown or private elements, as set by the parser
+func elementName(tag Tag) string {
+	if entry, err := standardParser().getDictEntry(tag.Group, tag.Element); err == nil {
+		return entry.name
+	}
+	if tag.Group%2 == 0 {
+		return unknown_group_name
+	}
+	return private_group_name
+}
+
+// The value of an attribute of an element at level
+func (attr *jsonAttribute) value(level uint8, warnings *[]Warning) (Value, error) {
+
+	if attr.InlineBinary != "" {
+		b, err := base64.StdEncoding.DecodeString(attr.InlineBinary)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+		}
+		return decodeValue(attr.VR, b), nil
+	}
+	if isBinaryVR(attr.VR) && len(attr.Value) > 0 {
+		return nil, fmt.Errorf("%w: %s values are inline binary", ErrInvalidJSON, attr.VR)
+	}
+
+	switch attr.VR {
+	case "SQ":
+		seq := make(Sequence, len(attr.Value))
+		for i, raw := range attr.Value {
+			var obj map[string]*jsonAttribute
+			if err := decodeJSON(raw, &obj); err != nil {
+				return nil, err
+			}
+			elems, err := dicomJSONElements(obj, level+1, warnings)
+			if err != nil {
+				return nil, err
+			}
+			seq[i] = &Item{Elements: elems}
+		}
+		return seq, nil
+	case "PN":
+		values := make(Strings, len(attr.Value))
+		for i, raw := range attr.Value {
+			var name map[string]string
+			if err := decodeJSON(raw, &name); err != nil {
+				return nil, err
+			}
+			values[i] = strings.TrimRight(name["Alphabetic"]+"="+name["Ideographic"]+"="+name["Phonetic"], "=")
+		}
+		return values, nil
+	}
+
+	values := make([]string, len(attr.Value))
+	for i, raw := range attr.Value {
+		var v interface{}
+		if err := decodeJSON(raw, &v); err != nil {
+			return nil, err
+		}
+		switch v := v.(type) {
+		case string:
+			values[i] = v
+		case json.Number:
+			values[i] = v.String()
+		case nil:
+		default:
+			return nil, fmt.Errorf("%w: value %s", ErrInvalidJSON, raw)
+		}
+	}
+
+	value, err := valueFromStrings(attr.VR, values)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJSON, err)
+	}
+	return value, nil
+}
+
+// Convert the values of an attribute read as strings, as in the DICOM JSON
+// and XML models, to the Value of vr
+func valueFromStrings(vr string, values []string) (Value, error) {
+
+	var value Value
+	switch vr {
+	case "AT":
+		value = make(Tags, len(values))
+	case "US":
+		value = make(UInt16s, len(values))
+	case "SS":
+		value = make(Int16s, len(values))
+	case "UL":
+		value = make(UInt32s, len(values))
+	case "SL":
+		value = make(Int32s, len(values))
+	case "UV":
+		value = make(UInt64s, len(values))
+	case "SV":
+		value = make(Int64s, len(values))
+	case "FL":
+		value = make(Float32s, len(values))
+	case "FD":
+		value = make(Float64s, len(values))
+	default:
+		return Strings(values), nil
+	}
+
+	for i, s := range values {
+		var err error
+		switch v := value.(type) {
+		case Tags:
+			var t uint64
+			if t, err = strconv.ParseUint(s, 16, 32); err == nil && len(s) != 8 {
+				err = strconv.ErrSyntax
+			}
+			v[i] = Tag{uint16(t >> 16), uint16(t)}
+		case UInt16s:
+			var n uint64
+			n, err = strconv.ParseUint(s, 10, 16)
+			v[i] = uint16(n)
+		case Int16s:
+			var n int64
+			n, err = strconv.ParseInt(s, 10, 16)
+			v[i] = int16(n)
+		case UInt32s:
+			var n uint64
+			n, err = strconv.ParseUint(s, 10, 32)
+			v[i] = uint32(n)
+		case Int32s:
+			var n int64
+			n, err = strconv.ParseInt(s, 10, 32)
+			v[i] = int32(n)
+		case UInt64s:
+			v[i], err = strconv.ParseUint(s, 10, 64)
+		case Int64s:
+			v[i], err = strconv.ParseInt(s, 10, 64)
+		case Float32s:
+			var f float64
+			f, err = strconv.ParseFloat(s, 32)
+			v[i] = float32(f)
+		case Float64s:
+			v[i], err = strconv.ParseFloat(s, 64)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s value %q", vr, s)
+		}
+	}
+
+	return value, nil
+}
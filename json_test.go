@@ -0,0 +1,242 @@
+package dicom
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestWriteJSON(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := file.WriteJSON(buf, JSONOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var obj map[string]struct {
+		VR           string        `json:"vr"`
+		Value        []interface{} `json:"Value"`
+		InlineBinary string        `json:"InlineBinary"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &obj); err != nil {
+		t.Fatal(err)
+	}
+
+	if pn := obj["00100010"]; pn.VR != "PN" || pn.Value[0].(map[string]interface{})["Alphabetic"] != "TOUTATIX" {
+		t.Errorf("Incorrect PatientName %+v", pn)
+	}
+
+	if rows := obj["00280010"]; rows.VR != "US" || rows.Value[0].(float64) != 512 {
+		t.Errorf("Incorrect Rows %+v", rows)
+	}
+
+	if pixels := obj["7FE00010"]; pixels.VR != "OB" || pixels.InlineBinary == "" {
+		t.Errorf("Incorrect PixelData %+v", pixels.VR)
+	}
+
+	if _, ok := obj["00020000"]; ok {
+		t.Error("Group lengths should be left out")
+	}
+
+	buf.Reset()
+	if err := file.WriteJSON(buf, JSONOptions{Keywords: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	var simple map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &simple); err != nil {
+		t.Fatal(err)
+	}
+
+	if simple["PatientName"] != "TOUTATIX" || simple["Rows"].(float64) != 512 {
+		t.Errorf("Incorrect keyword JSON %v %v", simple["PatientName"], simple["Rows"])
+	}
+
+	if seq, ok := simple["RequestAttributesSequence"].([]interface{}); !ok || len(seq) != 1 {
+		t.Errorf("Incorrect sequence %v", simple["RequestAttributesSequence"])
+	}
+
+}
+
+// A value of no type the writer knows
+type unknownValue struct{}
+
+func (unknownValue) Len() int           { return 1 }
+func (unknownValue) slice() interface{} { return nil }
+
+func TestWriteJSONInvalidBinary(t *testing.T) {
+
+	file := &DicomFile{}
+	file.appendDataElement(&DicomElement{Group: 0x0009, Element: 0x1001, Vr: "OB", Value: unknownValue{}})
+
+	if err := file.WriteJSON(new(bytes.Buffer), JSONOptions{}); !errors.Is(err, ErrWrongValueType) {
+		t.Errorf("Expected ErrWrongValueType, got %v", err)
+	}
+	if _, err := file.MarshalJSON(); !errors.Is(err, ErrWrongValueType) {
+		t.Errorf("Expected ErrWrongValueType, got %v", err)
+	}
+
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &DicomFile{}
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := EqualOptions{IgnoreTags: []Tag{TagFileMetaInformationGroupLength}}
+	if diffs := Diff(file, decoded, opts); len(diffs) != 0 {
+		t.Errorf("Incorrect data set decoded %v", diffs)
+	}
+
+	// encapsulated pixel data is read back from its items
+	pixels, err := decoded.LookupElementByTag(TagPixelData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := pixels.Value.(*PixelData); !ok {
+		t.Errorf("Pixel data read as %T", pixels.Value)
+	}
+	if data, err := decoded.ImageData(); err != nil || !data.Encapsulated || len(data.Frames) != 1 {
+		t.Errorf("Incorrect image data %v", err)
+	}
+
+	if err := decoded.Write(new(bytes.Buffer)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+
+	data := `{
+		"00080005": {"vr": "CS", "Value": ["ISO_IR 192"]},
+		"00100000": {"vr": "UL", "Value": [42]},
+		"00100010": {"vr": "PN", "Value": [{"Alphabetic": "Yamada^Tarou", "Ideographic": "山田^太郎"}]},
+		"00100020": {"vr": "LO", "Value": ["ID1"]},
+		"00181310": {"vr": "US", "Value": [0, 256, 256, 0]},
+		"00200013": {"vr": "IS", "Value": [7]},
+		"00280030": {"vr": "DS", "Value": [0.5, 0.5]},
+		"00081140": {"vr": "SQ", "Value": [{"00081155": {"vr": "UI", "Value": ["1.2.3"]}}]},
+		"00091001": {"vr": "UN", "InlineBinary": "AQI="},
+		"7FE00010": {"vr": "OW", "BulkDataURI": "http://pacs/bulk/1"}
+	}`
+
+	file := &DicomFile{}
+	if err := json.Unmarshal([]byte(data), file); err != nil {
+		t.Fatal(err)
+	}
+
+	for tag, expected := range map[Tag]string{
+		TagPatientName:       "[Yamada^Tarou=山田^太郎]",
+		TagInstanceNumber:    "[7]",
+		TagPixelSpacing:      "[0.5 0.5]",
+		TagAcquisitionMatrix: "[0 256 256 0]",
+	} {
+		elem, err := file.LookupElementByTag(tag)
+		if err != nil {
+			t.Errorf("%s: %v", tag, err)
+			continue
+		}
+		if s := fmt.Sprint(elem.Value); s != expected {
+			t.Errorf("Expected %s %s, got %s", tag, expected, s)
+		}
+	}
+	for i := 1; i < len(file.Elements); i++ {
+		if !tagLess(file.Elements[i-1].Tag(), file.Elements[i].Tag()) {
+			t.Errorf("Elements not sorted")
+		}
+	}
+	item := file.sequence(TagReferencedImageSequence)
+	if len(item) != 1 || item[0].Elements[0].IndentLevel != 1 {
+		t.Errorf("Incorrect sequence %v", item)
+	}
+	if b := file.Elements[file.indexOf(Tag{0x0009, 0x1001})].Value; !bytes.Equal(b.(Bytes), []byte{1, 2}) {
+		t.Errorf("Incorrect InlineBinary %v", b)
+	}
+	if _, err := file.LookupElementByTag(Tag{0x0010, 0x0000}); err == nil {
+		t.Errorf("Group length read")
+	}
+	if len(file.Warnings) != 1 || !errors.Is(&file.Warnings[0], ErrBulkData) || file.Warnings[0].Tag != TagPixelData {
+		t.Errorf("Incorrect warnings %v", file.Warnings)
+	}
+
+	for _, invalid := range []string{
+		`{"0010": {"vr": "LO"}}`,
+		`{"00280010": {"vr": "US", "Value": [-1]}}`,
+		`{"00280010": {"vr": "US", "Value": [{}]}}`,
+		`{"00081140": {"vr": "SQ", "Value": ["item"]}}`,
+		`{"00280010": {"Value": [1]}}`,
+		`{"00280010": {"vr": "XS", "Value": [1]}}`,
+		`{"00091001": {"vr": "OB", "Value": ["AQI="]}}`,
+	} {
+		if err := json.Unmarshal([]byte(invalid), &DicomFile{}); !errors.Is(err, ErrInvalidJSON) {
+			t.Errorf("%s: expected ErrInvalidJSON, got %v", invalid, err)
+		}
+	}
+}
+
+func FuzzUnmarshalJSON(f *testing.F) {
+
+	// the example without its pixel data, as long inputs slow the fuzzer down
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		f.Fatal(err)
+	}
+	file.RemoveElement(TagPixelData)
+	seed, err := json.Marshal(file)
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(seed)
+	f.Add([]byte(`{"00100010": {"vr": "PN", "Value": [{"Alphabetic": "Yamada^Tarou"}]}, "00081140": {"vr": "SQ", "Value": [{}]}}`))
+	f.Add([]byte(`{"00020010": {"vr": "UI", "Value": ["1.2.840.10008.1.2.4.50"]}, "7FE00010": {"vr": "OB", "InlineBinary": "/v8A4AAAAAD+/wDgAgAAAAEC/v/d4AAAAAA="}}`))
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		first := &DicomFile{}
+		if err := json.Unmarshal(b, first); err != nil {
+			return
+		}
+		// IS and DS values are written as JSON numbers, ie. "007" as 7, so
+		// the data set read back from the JSON written is the reference
+		data, err := json.Marshal(first)
+		if err != nil {
+			// values the reader accepts may not be encodable
+			return
+		}
+		decoded := &DicomFile{}
+		if err := json.Unmarshal(data, decoded); err != nil {
+			t.Fatalf("failed to read the JSON written: %s\n%s", err, data)
+		}
+
+		if data, err = json.Marshal(decoded); err != nil {
+			t.Fatalf("failed to write the JSON read: %s", err)
+		}
+		again := &DicomFile{}
+		if err := json.Unmarshal(data, again); err != nil {
+			t.Fatalf("failed to read the JSON written: %s\n%s", err, data)
+		}
+		if diffs := Diff(decoded, again, EqualOptions{}); len(diffs) > 0 {
+			t.Errorf("Data set changed after a round trip: %v\n%s", diffs, data)
+		}
+	})
+}
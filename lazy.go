@@ -0,0 +1,246 @@
+package dicom
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+)
+
+// Leave the items of sequences unparsed until they are first accessed with
+// GetSequence, which saves parsing large content trees when only a few
+// elements are needed. The nested elements are not emitted by the parser
+// pipeline. Reading a sequence parses it once, without modifying its element,
+// so a file parsed this way may be read concurrently like any other. Only
+// LoadSequences, which stores the parsed items in their elements, needs
+// exclusive access.
+func LazySequences() func(*Parser) error {
+	return func(p *Parser) error {
+		p.lazySequences = true
+		return nil
+	}
+}
+
+// The encoded items of a sequence, parsed once when first needed
+type lazySequence struct {
+	parser   *Parser
+	raw      []byte
+	bo       binary.ByteOrder
+	implicit bool
+	charset  *characterSet
+	encaps   bool
+	p        uint32 // position of raw in the file
+	sq       DicomElement
+	level    uint8
+
+	once sync.Once
+	seq  Sequence
+	err  error
+}
+
+func (lazy *lazySequence) load() (Sequence, error) {
+	lazy.once.Do(func() {
+		buffer := getDicomBuffer(lazy.raw)
+		defer buffer.release()
+		buffer.bo = lazy.bo
+		buffer.implicit = lazy.implicit
+		buffer.charset = lazy.charset
+		buffer.encapsulated = lazy.encaps
+		buffer.p = lazy.p
+		lazy.seq, lazy.err = lazy.parser.readSequence(buffer, &lazy.sq, lazy.level, ignoreElement)
+	})
+	return lazy.seq, lazy.err
+}
+
+// Skip the items of the sequence sq, keeping them to be parsed later
+func (p *Parser) skipSequence(buffer *dicomBuffer, sq *DicomElement, level uint8) error {
+
+	raw := buffer.Bytes()
+	pos := buffer.p
+	start := buffer.Len()
+
+	if sq.undefLen {
+		if err := buffer.skipItems(int(p.sequenceDepth()) - int(level)); err != nil {
+			return err
+		}
+	} else {
+		buffer.skip(sq.Vl)
+	}
+
+	sq.Value = nil
+	sq.lazy = &lazySequence{
+		parser:   p,
+		raw:      raw[:start-buffer.Len()],
+		bo:       buffer.bo,
+		implicit: buffer.implicit,
+		charset:  buffer.charset,
+		encaps:   buffer.encapsulated,
+		p:        pos,
+		sq:       DicomElement{Group: sq.Group, Element: sq.Element, Vl: sq.Vl, undefLen: sq.undefLen},
+		level:    level,
+	}
+	return nil
+}
+
+// Skip items up to and including the Sequence Delimitation Item, reading
+// only the headers of their elements. Sequences may be nested depth levels
+// deeper.
+func (buffer *dicomBuffer) skipItems(depth int) error {
+
+	for {
+		if buffer.Len() < 8 {
+			return ErrBrokenFile
+		}
+		tag := Tag{buffer.readUInt16(), buffer.readUInt16()}
+		vl := buffer.readUInt32()
+
+		switch {
+		case tag == TagSequenceDelimitationItem:
+			return nil
+		case tag != TagItem:
+			return ErrBrokenFile
+		case vl == undefinedLength:
+			if err := buffer.skipItemElements(depth); err != nil {
+				return err
+			}
+		default:
+			if err := checkLength(buffer, TagItem, vl); err != nil {
+				return err
+			}
+			buffer.skip(vl)
+		}
+	}
+}
+
+// Skip the elements of an item up to and including the Item Delimitation
+// Item
+func (buffer *dicomBuffer) skipItemElements(depth int) error {
+
+	for {
+		if buffer.Len() < 8 {
+			return ErrBrokenFile
+		}
+		tag := Tag{buffer.readUInt16(), buffer.readUInt16()}
+
+		var vl uint32
+		if buffer.implicit || tag.Group == pixeldata_group {
+			vl = buffer.readUInt32()
+		} else if vr := string(buffer.Next(2)); isLongVR(vr) {
+			buffer.p += 2
+			buffer.skip(2)
+			vl = buffer.readUInt32()
+		} else {
+			buffer.p += 2
+			vl = uint32(buffer.readUInt16())
+		}
+
+		switch {
+		case tag == TagItemDelimitationItem:
+			return nil
+		case vl == undefinedLength:
+			// a sequence, or encapsulated pixel data
+			if depth <= 0 && tag != TagPixelData {
+				return fmt.Errorf("%w: %s", ErrNestingTooDeep, tag)
+			}
+			if err := buffer.skipItems(depth - 1); err != nil {
+				return err
+			}
+		default:
+			if err := checkLength(buffer, tag, vl); err != nil {
+				return err
+			}
+			buffer.skip(vl)
+		}
+	}
+}
+
+// Parse the sequences left unparsed by the LazySequences option, at every
+// level of the file. Returns the first error met, leaving the sequences
+// that fail to parse empty.
+func (file *DicomFile) LoadSequences() error {
+
+	var first error
+	for i := range file.Elements {
+		if err := file.Elements[i].loadSequence(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// The value of an element, with the items of a sequence left unparsed by
+// LazySequences parsed but not stored, so that reading it never modifies the
+// element. A sequence that fails to parse is empty.
+func (e *DicomElement) value() Value {
+	if e.Value == nil && e.lazy != nil {
+		seq, err := e.lazy.load()
+		if err != nil {
+			return Sequence{}
+		}
+		return seq
+	}
+	return e.Value
+}
+
+// Parse the sequences left unparsed by LazySequences, at every level of the
+// file, without storing them in their elements as LoadSequences does.
+// Returns the first error met.
+func (file *DicomFile) parseSequences() error {
+
+	var first error
+	for i := range file.Elements {
+		if err := file.Elements[i].parseSequence(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func (e *DicomElement) parseSequence() error {
+
+	var first error
+	if e.Value == nil && e.lazy != nil {
+		if _, err := e.lazy.load(); err != nil {
+			first = err
+		}
+	}
+
+	seq, ok := e.value().(Sequence)
+	if !ok {
+		return first
+	}
+	for _, item := range seq {
+		for _, child := range item.Elements {
+			if err := child.parseSequence(); err != nil && first == nil {
+				first = err
+			}
+		}
+	}
+	return first
+}
+
+func (e *DicomElement) loadSequence() error {
+
+	var first error
+	if e.lazy != nil {
+		seq, err := e.lazy.load()
+		if err != nil {
+			seq = Sequence{}
+			first = err
+		}
+		e.Value = seq
+		e.lazy = nil
+	}
+
+	seq, ok := e.Value.(Sequence)
+	if !ok {
+		return first
+	}
+	for _, item := range seq {
+		for _, child := range item.Elements {
+			if err := child.loadSequence(); err != nil && first == nil {
+				first = err
+			}
+		}
+	}
+	return first
+}
@@ -0,0 +1,169 @@
+package dicom
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLazySequences(t *testing.T) {
+
+	eager, _ := NewParser()
+	want, err := eager.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// written back with undefined lengths
+	buf := new(bytes.Buffer)
+	if err := want.Write(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, buff := range [][]byte{readFile(), buf.Bytes()} {
+
+		parser, _ := NewParser(LazySequences())
+		data, err := parser.Parse(buff)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		elem, err := data.LookupElementByTag(TagReferencedStudySequence)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if elem.Value != nil {
+			t.Error("Sequence should not be parsed yet")
+		}
+
+		elems, err := data.GetByPath("ReferencedStudySequence[0].ReferencedSOPInstanceUID")
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantElems, _ := want.GetByPath("ReferencedStudySequence[0].ReferencedSOPInstanceUID")
+		if elems[0].MustGetString() != wantElems[0].MustGetString() {
+			t.Errorf("Incorrect nested value %q", elems[0].MustGetString())
+		}
+
+		out := new(bytes.Buffer)
+		if err := data.Write(out); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out.Bytes(), buf.Bytes()) {
+			t.Error("A file with lazy sequences should be written as the eagerly parsed one")
+		}
+
+		if !Equal(data, want, EqualOptions{}) {
+			t.Error("A file with lazy sequences should equal the eagerly parsed one")
+		}
+	}
+
+}
+
+func TestLazySequencesTruncated(t *testing.T) {
+
+	eager, _ := NewParser()
+	data, _ := eager.Parse(readFile())
+	buf := new(bytes.Buffer)
+	data.Write(buf)
+
+	// cut within the undefined length ReferencedStudySequence
+	full := buf.Bytes()
+	i := bytes.Index(full, []byte{0x08, 0x00, 0x10, 0x11, 'S', 'Q'})
+	if i < 0 {
+		t.Fatal("ReferencedStudySequence not found")
+	}
+
+	parser, _ := NewParser(LazySequences())
+	if _, err := parser.Parse(full[:i+20]); err == nil {
+		t.Error("A truncated sequence should fail to parse")
+	}
+
+}
+
+func TestLazySequencesConcurrentReaders(t *testing.T) {
+
+	p, _ := NewParser(LazySequences())
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counts := make(chan int)
+	for i := 0; i < 4; i++ {
+		go func() {
+			n := 0
+			file.Walk(func(path TagPath, elem *DicomElement) error {
+				n++
+				return nil
+			})
+			counts <- n
+		}()
+	}
+	first := <-counts
+	for i := 1; i < 4; i++ {
+		if n := <-counts; n != first {
+			t.Errorf("Walked %d elements, %d in another goroutine", n, first)
+		}
+	}
+}
+
+func TestLazySequencesConcurrentFileReaders(t *testing.T) {
+
+	p, _ := NewParser(LazySequences())
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	readers := []func() error{
+		func() error {
+			if !Equal(file, file, EqualOptions{}) {
+				t.Error("File not equal to itself")
+			}
+			return nil
+		},
+		func() error {
+			if diffs := Diff(file, file, EqualOptions{}); len(diffs) != 0 {
+				t.Errorf("Differences in the same file: %v", diffs)
+			}
+			return nil
+		},
+		func() error {
+			file.DebugString(DumpOptions{})
+			return nil
+		},
+		func() error {
+			return file.WriteDcmdump(new(bytes.Buffer))
+		},
+		func() error {
+			_, err := file.MarshalJSON()
+			return err
+		},
+		func() error {
+			return file.WriteXML(new(bytes.Buffer))
+		},
+		func() error {
+			return file.Write(new(bytes.Buffer))
+		},
+	}
+
+	errs := make(chan error)
+	for i := 0; i < 2; i++ {
+		for _, read := range readers {
+			go func(read func() error) {
+				errs <- read()
+			}(read)
+		}
+	}
+	for i := 0; i < 2*len(readers); i++ {
+		if err := <-errs; err != nil {
+			t.Error(err)
+		}
+	}
+
+	// the sequences were parsed, but not stored
+	elem, _ := file.LookupElementByTag(TagReferencedStudySequence)
+	if elem.Value != nil || elem.lazy == nil {
+		t.Error("Sequence stored in its element by a reader")
+	}
+}
@@ -0,0 +1,92 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrElementTooLong  = errors.New("Element longer than the maximum length")
+	ErrDataSetTooLong  = errors.New("Data set longer than the maximum length")
+	ErrNestingTooDeep  = errors.New("Sequences nested deeper than the maximum depth")
+	ErrTooManyElements = errors.New("Data set with more elements than the maximum")
+)
+
+// The depth of sequence nesting the parser accepts unless set with
+// MaxSequenceDepth
+const DefaultMaxSequenceDepth = 64
+
+// Fail parsing with ErrElementTooLong on an element whose value is longer
+// than n bytes, rather than allocating its values. Sequences and pixel data
+// fragments, which are not copied, are not limited.
+func MaxElementLength(n uint32) func(*Parser) error {
+	return func(p *Parser) error {
+		p.maxElementLength = n
+		return nil
+	}
+}
+
+// Fail parsing with ErrDataSetTooLong on files longer than n bytes
+func MaxDataSetLength(n int) func(*Parser) error {
+	return func(p *Parser) error {
+		p.maxDataSetLength = n
+		return nil
+	}
+}
+
+// Fail parsing with ErrNestingTooDeep on sequences nested more than n levels
+// deep, rather than recursing without bound. Zero restores the default of
+// DefaultMaxSequenceDepth.
+func MaxSequenceDepth(n uint8) func(*Parser) error {
+	return func(p *Parser) error {
+		p.maxSequenceDepth = n
+		return nil
+	}
+}
+
+// Fail parsing with ErrTooManyElements on data sets of more than n elements,
+// counting the nested elements, items and delimiters. The elements of lazy
+// sequences are counted apart, when the sequence is loaded.
+func MaxElements(n int) func(*Parser) error {
+	return func(p *Parser) error {
+		p.maxElements = n
+		return nil
+	}
+}
+
+func (p *Parser) sequenceDepth() uint8 {
+	if p.maxSequenceDepth == 0 {
+		return DefaultMaxSequenceDepth
+	}
+	return p.maxSequenceDepth
+}
+
+// Check that the items of the sequence sq, at level, may be read
+func (p *Parser) checkSequenceDepth(sq *DicomElement, level uint8) error {
+	if level >= p.sequenceDepth() {
+		return fmt.Errorf("%w: %s at depth %d", ErrNestingTooDeep, sq.Tag(), level+1)
+	}
+	return nil
+}
+
+func (p *Parser) checkElements(buffer *dicomBuffer) error {
+	buffer.elements++
+	if p.maxElements > 0 && buffer.elements > p.maxElements {
+		return fmt.Errorf("%w: %d elements", ErrTooManyElements, buffer.elements)
+	}
+	return nil
+}
+
+func (p *Parser) checkDataSetLength(buff []byte) error {
+	if p.maxDataSetLength > 0 && len(buff) > p.maxDataSetLength {
+		return fmt.Errorf("%w: %d bytes", ErrDataSetTooLong, len(buff))
+	}
+	return nil
+}
+
+func (p *Parser) checkElementLength(elem *DicomElement, vl uint32) error {
+	if p.maxElementLength > 0 && vl > p.maxElementLength && elem.Vr != "SQ" && elem.Group != pixeldata_group {
+		return fmt.Errorf("%w: %s Value Length %d", ErrElementTooLong, elem.Tag(), vl)
+	}
+	return nil
+}
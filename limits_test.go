@@ -0,0 +1,114 @@
+package dicom
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMaxElementLength(t *testing.T) {
+
+	// the elements of the example, but pixel data, are at most 64 bytes long
+	parser, _ := NewParser(MaxElementLength(64))
+	if _, err := parser.Parse(readFile()); err != nil {
+		t.Error(err)
+	}
+
+	parser, _ = NewParser(MaxElementLength(16))
+	if _, err := parser.Parse(readFile()); !errors.Is(err, ErrElementTooLong) {
+		t.Errorf("Incorrect error %v", err)
+	}
+
+}
+
+func TestMaxDataSetLength(t *testing.T) {
+
+	file := readFile()
+
+	parser, _ := NewParser(MaxDataSetLength(len(file)))
+	if _, err := parser.Parse(file); err != nil {
+		t.Error(err)
+	}
+
+	parser, _ = NewParser(MaxDataSetLength(len(file) - 1))
+	if _, err := parser.Parse(file); !errors.Is(err, ErrDataSetTooLong) {
+		t.Errorf("Incorrect error %v", err)
+	}
+
+}
+
+// An implicit VR data set of depth sequences of undefined length, nested in
+// one another
+func nestedSequences(depth int) []byte {
+	var head, tail []byte
+	for i := 0; i < depth; i++ {
+		head = append(head, 0x08, 0x00, 0x15, 0x11, 0xFF, 0xFF, 0xFF, 0xFF) // ReferencedSeriesSequence
+		head = append(head, 0xFE, 0xFF, 0x00, 0xE0, 0xFF, 0xFF, 0xFF, 0xFF) // Item
+		tail = append(tail, 0xFE, 0xFF, 0x0D, 0xE0, 0, 0, 0, 0)             // Item Delimitation Item
+		tail = append(tail, 0xFE, 0xFF, 0xDD, 0xE0, 0, 0, 0, 0)             // Sequence Delimitation Item
+	}
+	return append(head, tail...)
+}
+
+func TestMaxSequenceDepth(t *testing.T) {
+
+	for _, lazy := range []bool{false, true} {
+		options := []func(*Parser) error{MaxSequenceDepth(3)}
+		if lazy {
+			options = append(options, LazySequences())
+		}
+		parser, _ := NewParser(options...)
+
+		file, err := parser.ParseDataSet(nestedSequences(3), ImplicitVRLittleEndian)
+		if err == nil {
+			err = file.LoadSequences()
+		}
+		if err != nil {
+			t.Errorf("lazy %v: %v", lazy, err)
+		}
+
+		file, err = parser.ParseDataSet(nestedSequences(4), ImplicitVRLittleEndian)
+		if err == nil {
+			err = file.LoadSequences()
+		}
+		if !errors.Is(err, ErrNestingTooDeep) {
+			t.Errorf("lazy %v: incorrect error %v", lazy, err)
+		}
+	}
+
+	// deep enough to exhaust the stack without a limit
+	parser, _ := NewParser()
+	if _, err := parser.ParseDataSet(nestedSequences(1<<16), ImplicitVRLittleEndian); !errors.Is(err, ErrNestingTooDeep) {
+		t.Errorf("Incorrect error %v", err)
+	}
+
+}
+
+func TestMaxElements(t *testing.T) {
+
+	// a sequence, an item and their delimiters
+	parser, _ := NewParser(MaxElements(4))
+	if _, err := parser.ParseDataSet(nestedSequences(1), ImplicitVRLittleEndian); err != nil {
+		t.Error(err)
+	}
+
+	parser, _ = NewParser(MaxElements(3))
+	if _, err := parser.ParseDataSet(nestedSequences(1), ImplicitVRLittleEndian); !errors.Is(err, ErrTooManyElements) {
+		t.Errorf("Incorrect error %v", err)
+	}
+
+}
+
+// Parse arbitrary input within limits, which must not panic
+func FuzzParse(f *testing.F) {
+
+	// the header of the example, as long inputs slow the fuzzer down
+	f.Add(readFile()[:2048])
+	parser, _ := NewParser(MaxElementLength(1<<20), MaxDataSetLength(1<<24), MaxElements(1<<16))
+	lazy, _ := NewParser(MaxElementLength(1<<20), MaxDataSetLength(1<<24), MaxElements(1<<16), LazySequences())
+	f.Fuzz(func(t *testing.T, data []byte) {
+		parser.Parse(data)
+		if file, err := lazy.Parse(data); err == nil {
+			file.LoadSequences()
+		}
+	})
+}
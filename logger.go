@@ -0,0 +1,49 @@
+package dicom
+
+import (
+	"sync"
+)
+
+// Logger receives the messages of the package, ie. the warnings found while
+// parsing. *log.Logger implements it.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Printf(format string, v ...interface{}) {}
+
+var (
+	loggerMu      sync.RWMutex
+	defaultLogger Logger = nopLogger{}
+)
+
+// Set the logger used by parsers without a Logging option. Messages are
+// discarded by default.
+func SetLogger(l Logger) {
+	if l == nil {
+		l = nopLogger{}
+	}
+	loggerMu.Lock()
+	defaultLogger = l
+	loggerMu.Unlock()
+}
+
+// Log the messages of the parser to l instead of the package logger
+func Logging(l Logger) func(*Parser) error {
+	return func(p *Parser) error {
+		p.logger = l
+		return nil
+	}
+}
+
+// Return the logger of the parser
+func (p *Parser) log() Logger {
+	if p.logger != nil {
+		return p.logger
+	}
+	loggerMu.RLock()
+	defer loggerMu.RUnlock()
+	return defaultLogger
+}
@@ -0,0 +1,36 @@
+package dicom
+
+import (
+	"fmt"
+	"testing"
+)
+
+type testLogger []string
+
+func (l *testLogger) Printf(format string, v ...interface{}) {
+	*l = append(*l, fmt.Sprintf(format, v...))
+}
+
+func TestLogging(t *testing.T) {
+
+	l := &testLogger{}
+	p, _ := NewParser(Logging(l))
+
+	buffer := newDicomBuffer(nil)
+	p.warn(buffer, &DicomElement{Group: 0x0020, Element: 0x0037}, ErrTrailingBytes)
+
+	if len(*l) != 1 || len(buffer.warnings) != 1 {
+		t.Errorf("Incorrect log %v", *l)
+	}
+
+	SetLogger(l)
+	defer SetLogger(nil)
+
+	p, _ = NewParser()
+	p.warn(buffer, &DicomElement{Group: 0x0020, Element: 0x0037}, ErrTrailingBytes)
+
+	if len(*l) != 2 {
+		t.Errorf("Incorrect log %v", *l)
+	}
+
+}
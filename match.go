@@ -0,0 +1,213 @@
+package dicom
+
+import (
+	"strings"
+)
+
+// Options of the matching of query keys against values
+type MatchOptions struct {
+	// Compare person names ignoring case, accents, and empty trailing
+	// components, as with the Fuzzy Semantic Matching of Person Names
+	// extended negotiation (PS 3.4 C.2.2.2.1)
+	FuzzyPersonNames bool
+}
+
+// Reports whether a PN value matches a key, which may hold the * and ?
+// wildcards (PS 3.4 C.2.2.2.4). An empty key matches any name.
+func MatchPersonName(key, name string, opts MatchOptions) bool {
+
+	if key == "" || key == "*" {
+		return true
+	}
+
+	if opts.FuzzyPersonNames {
+		key = NormalizePersonName(key)
+		name = NormalizePersonName(name)
+	}
+
+	return matchWildcard([]rune(key), []rune(name))
+}
+
+// Accented latin letters and the letter they are folded to
+var accents = map[rune]rune{}
+
+func init() {
+	for base, letters := range map[rune]string{
+		'A': "ÀÁÂÃÄÅĀĂĄ", 'a': "àáâãäåāăą",
+		'C': "ÇĆĈĊČ", 'c': "çćĉċč",
+		'D': "ĎĐ", 'd': "ďđ",
+		'E': "ÈÉÊËĒĔĖĘĚ", 'e': "èéêëēĕėęě",
+		'G': "ĜĞĠĢ", 'g': "ĝğġģ",
+		'H': "ĤĦ", 'h': "ĥħ",
+		'I': "ÌÍÎÏĨĪĬĮİ", 'i': "ìíîïĩīĭįı",
+		'J': "Ĵ", 'j': "ĵ",
+		'K': "Ķ", 'k': "ķ",
+		'L': "ĹĻĽĿŁ", 'l': "ĺļľŀł",
+		'N': "ÑŃŅŇ", 'n': "ñńņň",
+		'O': "ÒÓÔÕÖØŌŎŐ", 'o': "òóôõöøōŏő",
+		'R': "ŔŖŘ", 'r': "ŕŗř",
+		'S': "ŚŜŞŠ", 's': "śŝşš",
+		'T': "ŢŤŦ", 't': "ţťŧ",
+		'U': "ÙÚÛÜŨŪŬŮŰŲ", 'u': "ùúûüũūŭůűų",
+		'W': "Ŵ", 'w': "ŵ",
+		'Y': "ÝŶŸ", 'y': "ýÿŷ",
+		'Z': "ŹŻŽ", 'z': "źżž",
+	} {
+		for _, r := range letters {
+			accents[r] = base
+		}
+	}
+}
+
+func foldAccent(r rune) rune {
+	if base, ok := accents[r]; ok {
+		return base
+	}
+	return r
+}
+
+// Match a value against a key where * matches any sequence of characters
+// and ? any single character. On a mismatch the last * is made to match one
+// more character, so that the time is bounded by len(key)*len(value).
+func matchWildcard(key, value []rune) bool {
+
+	k, v := 0, 0
+	star, next := -1, 0
+	for v < len(value) {
+		switch {
+		case k < len(key) && (key[k] == '?' || key[k] == value[v]) && key[k] != '*':
+			k, v = k+1, v+1
+		case k < len(key) && key[k] == '*':
+			star, next = k, v
+			k++
+		case star >= 0:
+			next++
+			k, v = star+1, next
+		default:
+			return false
+		}
+	}
+	for k < len(key) && key[k] == '*' {
+		k++
+	}
+
+	return k == len(key)
+}
+
+// Reports whether an element matches a query key (PS 3.4 C.2.2.2). Empty
+// keys match any value. Keys of UIs are lists of UIDs, keys of DA, TM and DT
+// may be ranges, ie. "20050101-20051231", and keys of other string VRs may
+// hold wildcards. Sequence keys match if an item matches all the keys of
+// their item.
+func MatchKey(key, elem *DicomElement, opts MatchOptions) bool {
+
+	if isEmpty(key.Value) {
+		return true
+	}
+	if elem == nil || isEmpty(elem.value()) {
+		return false
+	}
+
+	if seq, ok := key.Value.(Sequence); ok {
+		return matchSequence(seq[0].Elements, elem, opts)
+	}
+
+	keys, ok := key.Value.(Strings)
+	if !ok {
+		return equalValue(key.Value, elem.Value, &EqualOptions{})
+	}
+	values, ok := elem.Value.(Strings)
+	if !ok {
+		return false
+	}
+
+	switch key.Vr {
+	case "UI":
+		for _, k := range keys {
+			for _, v := range values {
+				if NormalizeUID(k) == NormalizeUID(v) {
+					return true
+				}
+			}
+		}
+		return false
+	case "DA", "TM", "DT":
+		if len(keys) == 1 && strings.Contains(keys[0], "-") {
+			return matchRange(key.Vr, keys[0], values[0])
+		}
+	}
+
+	// single values without wildcards compare in their canonical form
+	if len(keys) == 1 && !strings.ContainsAny(keys[0], "*?") {
+		switch key.Vr {
+		case "DA", "TM", "DS":
+			k := NormalizeValue(key.Vr, keys[0])
+			for _, v := range values {
+				if NormalizeValue(key.Vr, v) == k {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	k := strings.Join(keys, "\\")
+	for _, v := range values {
+		if key.Vr == "PN" {
+			if MatchPersonName(k, v, opts) {
+				return true
+			}
+		} else if matchWildcard([]rune(k), []rune(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Reports whether an item of a sequence matches all the keys
+func matchSequence(keys []*DicomElement, elem *DicomElement, opts MatchOptions) bool {
+
+	seq, err := elem.GetSequence()
+	if err != nil {
+		return false
+	}
+
+	for _, item := range seq {
+		if matchElements(keys, item.Elements, opts) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Reports whether the elements match all the keys
+func matchElements(keys, elems []*DicomElement, opts MatchOptions) bool {
+	for _, key := range keys {
+		var match *DicomElement
+		for _, elem := range elems {
+			if elem.Tag() == key.Tag() {
+				match = elem
+				break
+			}
+		}
+		if !MatchKey(key, match, opts) {
+			return false
+		}
+	}
+	return true
+}
+
+// Match a date or time against a range, ie. "0800-1200", "-1200" or "0800-".
+// Values of the same VR compare as strings, in their canonical form.
+func matchRange(vr, key, value string) bool {
+	bounds := strings.SplitN(key, "-", 2)
+	for i := range bounds {
+		if bounds[i] != "" {
+			bounds[i] = NormalizeValue(vr, bounds[i])
+		}
+	}
+	value = NormalizeValue(vr, value)
+	return (bounds[0] == "" || value >= bounds[0]) && (bounds[1] == "" || value <= bounds[1])
+}
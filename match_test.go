@@ -0,0 +1,75 @@
+package dicom
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMatchPersonName(t *testing.T) {
+
+	fuzzy := MatchOptions{FuzzyPersonNames: true}
+
+	cases := []struct {
+		key, name string
+		opts      MatchOptions
+		match     bool
+	}{
+		{"", "Doe^John", MatchOptions{}, true},
+		{"Doe^John", "Doe^John", MatchOptions{}, true},
+		{"Doe^John", "DOE^JOHN ", MatchOptions{}, false},
+		{"Doe^John", "DOE^JOHN ", fuzzy, true},
+		{"Doe^John", "DOE^JOHN^^^", fuzzy, true},
+		{"Müller^Zoë", "MULLER^ZOE", fuzzy, true},
+		{"Doe*", "Doe^John", MatchOptions{}, true},
+		{"D?e^J*", "doe^jane", fuzzy, true},
+		{"Doe^J?", "Doe^John", MatchOptions{}, false},
+		{"Doe^Jane", "Doe^John", fuzzy, false},
+	}
+
+	for _, c := range cases {
+		if MatchPersonName(c.key, c.name, c.opts) != c.match {
+			t.Errorf("Incorrect match of %q against %q with %+v", c.key, c.name, c.opts)
+		}
+	}
+
+}
+
+func TestMatchWildcard(t *testing.T) {
+
+	cases := []struct {
+		key, value string
+		match      bool
+	}{
+		{"*", "", true},
+		{"", "", true},
+		{"", "A", false},
+		{"A*", "ABC", true},
+		{"*C", "ABC", true},
+		{"A*C", "AC", true},
+		{"A?C", "ABC", true},
+		{"A?C", "AC", false},
+		{"*B*", "ABC", true},
+		{"A*B*C", "AXBXBXC", true},
+		{"A*B*C", "AXBXBX", false},
+		{"**", "ABC", true},
+		{"?*", "", false},
+	}
+
+	for _, c := range cases {
+		if matchWildcard([]rune(c.key), []rune(c.value)) != c.match {
+			t.Errorf("Incorrect match of %q against %q", c.key, c.value)
+		}
+	}
+
+	// a key of many stars is matched in bounded time
+	key := []rune(strings.Repeat("*A", 32) + "*B")
+	value := []rune(strings.Repeat("A", 1024))
+	start := time.Now()
+	if matchWildcard(key, value) {
+		t.Errorf("Incorrect match of %q", string(key))
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Match took %v", elapsed)
+	}
+}
@@ -0,0 +1,87 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrMergeConflict = errors.New("Conflicting values")
+
+// How Merge resolves elements present in both files with different values
+type MergePolicy int
+
+const (
+	MergePreferExisting  MergePolicy = iota // keep the value of the file
+	MergePreferOther                        // take the value of the other file
+	MergeErrorOnConflict                    // fail without changing the file
+)
+
+// Merge the top level elements of other into the file. Elements missing from
+// the file are inserted in tag order, conflicting elements are resolved
+// according to policy. Group length elements of other are ignored. Values
+// are copied, so the files don't share any state afterwards. Private blocks
+// reserved by different creators in the two files must be moved apart first
+// with RelocatePrivateBlocks.
+func (file *DicomFile) Merge(other *DicomFile, policy MergePolicy) error {
+
+	opts := &EqualOptions{}
+
+	if policy == MergeErrorOnConflict {
+		for i := range other.Elements {
+			elem := &other.Elements[i]
+			if elem.Element == 0x0000 {
+				continue
+			}
+			if existing := file.indexOf(elem.Tag()); existing >= 0 && !equalElement(&file.Elements[existing], elem, opts) {
+				return fmt.Errorf("%w: %s", ErrMergeConflict, elem.Tag())
+			}
+		}
+	}
+
+	for i := range other.Elements {
+		elem := &other.Elements[i]
+		if elem.Element == 0x0000 {
+			continue
+		}
+
+		existing := file.indexOf(elem.Tag())
+		switch {
+		case existing < 0:
+			file.insertElement(elem.Clone())
+		case policy == MergePreferOther && !equalElement(&file.Elements[existing], elem, opts):
+			file.Elements[existing] = *elem.Clone()
+		}
+	}
+
+	return nil
+}
+
+// Return the index of the top level element with the given tag, or -1
+func (file *DicomFile) indexOf(tag Tag) int {
+	for i := range file.Elements {
+		if file.Elements[i].Tag() == tag {
+			return i
+		}
+	}
+	return -1
+}
+
+// Insert an element before the first top level element with a higher tag
+func (file *DicomFile) insertElement(elem *DicomElement) {
+
+	i := 0
+	for i < len(file.Elements) && tagLess(file.Elements[i].Tag(), elem.Tag()) {
+		i++
+	}
+
+	file.Elements = append(file.Elements, DicomElement{})
+	copy(file.Elements[i+1:], file.Elements[i:])
+	file.Elements[i] = *elem
+}
+
+func tagLess(a, b Tag) bool {
+	if a.Group != b.Group {
+		return a.Group < b.Group
+	}
+	return a.Element < b.Element
+}
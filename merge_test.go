@@ -0,0 +1,89 @@
+package dicom
+
+import (
+	"errors"
+	"testing"
+)
+
+func mergeFiles() (*DicomFile, *DicomFile) {
+
+	file := &DicomFile{}
+	file.appendDataElement(&DicomElement{Group: 0x0008, Element: 0x0050, Name: "AccessionNumber", Vr: "SH", Value: Strings{"2386679"}})
+	file.appendDataElement(&DicomElement{Group: 0x0010, Element: 0x0020, Name: "PatientID", Vr: "LO", Value: Strings{"7DkT2Tp"}})
+
+	other := &DicomFile{}
+	other.appendDataElement(&DicomElement{Group: 0x0010, Element: 0x0010, Name: "PatientName", Vr: "PN", Value: Strings{"TOUTATIX"}})
+	other.appendDataElement(&DicomElement{Group: 0x0010, Element: 0x0020, Name: "PatientID", Vr: "LO", Value: Strings{"ANON"}})
+
+	return file, other
+}
+
+func TestMergePreferExisting(t *testing.T) {
+
+	file, other := mergeFiles()
+	if err := file.Merge(other, MergePreferExisting); err != nil {
+		t.Fatal(err)
+	}
+
+	if l := len(file.Elements); l != 3 {
+		t.Fatalf("Incorrect number of elements: %d", l)
+	}
+
+	// inserted in tag order
+	if file.Elements[1].Tag() != TagPatientName {
+		t.Errorf("PatientName inserted at the wrong position: %v", file.Elements)
+	}
+
+	if id := file.Elements[2].MustGetString(); id != "7DkT2Tp" {
+		t.Errorf("Incorrect PatientID %s", id)
+	}
+
+	// values are copied
+	other.Elements[0].Value.(Strings)[0] = "changed"
+	if name := file.Elements[1].MustGetString(); name != "TOUTATIX" {
+		t.Errorf("Merged value changed with the other file: %s", name)
+	}
+
+}
+
+func TestMergePreferOther(t *testing.T) {
+
+	file, other := mergeFiles()
+	if err := file.Merge(other, MergePreferOther); err != nil {
+		t.Fatal(err)
+	}
+
+	if id := file.Elements[2].MustGetString(); id != "ANON" {
+		t.Errorf("Incorrect PatientID %s", id)
+	}
+
+}
+
+func TestMergeErrorOnConflict(t *testing.T) {
+
+	file, other := mergeFiles()
+	if err := file.Merge(other, MergeErrorOnConflict); !errors.Is(err, ErrMergeConflict) {
+		t.Errorf("Expected ErrMergeConflict, got %v", err)
+	}
+
+	if l := len(file.Elements); l != 2 {
+		t.Errorf("File changed by a failed merge: %d elements", l)
+	}
+
+}
+
+func TestMergeGroupLengths(t *testing.T) {
+
+	file, other := mergeFiles()
+	other.Elements[1].Value = Strings{"7DkT2Tp"}
+	file.insertElement(&DicomElement{Group: 0x0010, Element: 0x0000, Name: "GenericGroupLength", Vr: "UL", Value: UInt32s{10}})
+	other.insertElement(&DicomElement{Group: 0x0010, Element: 0x0000, Name: "GenericGroupLength", Vr: "UL", Value: UInt32s{20}})
+
+	if err := file.Merge(other, MergeErrorOnConflict); err != nil {
+		t.Fatal(err)
+	}
+	if length := file.Elements[1].Value.(UInt32s)[0]; length != 10 {
+		t.Errorf("Group length merged: %d", length)
+	}
+
+}
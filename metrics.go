@@ -0,0 +1,75 @@
+package dicom
+
+import (
+	"sync"
+	"time"
+)
+
+// Names of the metrics recorded by this package and by dicomnet
+const (
+	MetricFilesParsed         = "dicom_files_parsed_total"         // counter, by result, ok or error
+	MetricParseDuration       = "dicom_parse_duration_seconds"     // histogram
+	MetricBytesParsed         = "dicom_parsed_bytes_total"         // counter
+	MetricDIMSEOperations     = "dicom_dimse_operations_total"     // counter, by command, role and status
+	MetricDIMSEDuration       = "dicom_dimse_duration_seconds"     // histogram, by command and role
+	MetricAssociationFailures = "dicom_association_failures_total" // counter, by role and reason
+)
+
+// The labels of a measure, by name
+type Labels map[string]string
+
+// Metrics receives the measures of the package, ie. to export them to a
+// monitoring system. Implementations must be safe for concurrent use.
+// PrometheusMetrics implements it.
+type Metrics interface {
+	// Add delta to a counter
+	Count(name string, labels Labels, delta float64)
+	// Add an observation, ie. a duration in seconds, to a histogram
+	Observe(name string, labels Labels, value float64)
+}
+
+type nopMetrics struct{}
+
+func (nopMetrics) Count(name string, labels Labels, delta float64)   {}
+func (nopMetrics) Observe(name string, labels Labels, value float64) {}
+
+var (
+	metricsMu      sync.RWMutex
+	defaultMetrics Metrics = nopMetrics{}
+)
+
+// Set the metrics recording the files parsed and the DIMSE operations of
+// dicomnet. Measures are discarded by default.
+func SetMetrics(m Metrics) {
+	if m == nil {
+		m = nopMetrics{}
+	}
+	metricsMu.Lock()
+	defaultMetrics = m
+	metricsMu.Unlock()
+}
+
+// Return the metrics set with SetMetrics
+func GetMetrics() Metrics {
+	metricsMu.RLock()
+	defer metricsMu.RUnlock()
+	return defaultMetrics
+}
+
+// Run fn, the parsing of n bytes, recording its result and duration
+func measureParse(n int, fn func() error) error {
+
+	start := time.Now()
+	err := fn()
+
+	m := GetMetrics()
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	m.Count(MetricFilesParsed, Labels{"result": result}, 1)
+	m.Count(MetricBytesParsed, nil, float64(n))
+	m.Observe(MetricParseDuration, nil, time.Since(start).Seconds())
+
+	return err
+}
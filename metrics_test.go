@@ -0,0 +1,38 @@
+package dicom
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestParseMetrics(t *testing.T) {
+
+	metrics := NewPrometheusMetrics(DefaultBuckets)
+	SetMetrics(metrics)
+	defer SetMetrics(nil)
+
+	p, _ := NewParser()
+	buff := readFile()
+	if _, err := p.Parse(buff); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Parse(buff[:200]); err == nil {
+		t.Fatal("Expected an error parsing a truncated file")
+	}
+
+	out := new(bytes.Buffer)
+	metrics.WriteTo(out)
+	for _, expected := range []string{
+		`dicom_files_parsed_total{result="ok"} 1`,
+		`dicom_files_parsed_total{result="error"} 1`,
+		`dicom_parse_duration_seconds_count 2`,
+	} {
+		if !strings.Contains(out.String(), expected+"\n") {
+			t.Errorf("Expected %s in\n%s", expected, out)
+		}
+	}
+	if !strings.Contains(out.String(), "dicom_parsed_bytes_total "+formatFloat(float64(len(buff)+200))+"\n") {
+		t.Errorf("Incorrect bytes parsed in\n%s", out)
+	}
+}
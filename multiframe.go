@@ -0,0 +1,463 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var ErrInconsistentFrames = errors.New("Images cannot be frames of one image")
+
+// The single frame SOP classes of the enhanced multi-frame ones
+var singleFrameClasses = map[string]string{
+	EnhancedCTImageStorage:                 CTImageStorage,
+	LegacyConvertedEnhancedCTImageStorage:  CTImageStorage,
+	EnhancedMRImageStorage:                 MRImageStorage,
+	LegacyConvertedEnhancedMRImageStorage:  MRImageStorage,
+	EnhancedPETImageStorage:                PositronEmissionTomographyImageStorage,
+	LegacyConvertedEnhancedPETImageStorage: PositronEmissionTomographyImageStorage,
+}
+
+// The Legacy Converted Enhanced SOP classes of the single frame ones
+var legacyConvertedClasses = map[string]string{
+	CTImageStorage:                         LegacyConvertedEnhancedCTImageStorage,
+	MRImageStorage:                         LegacyConvertedEnhancedMRImageStorage,
+	PositronEmissionTomographyImageStorage: LegacyConvertedEnhancedPETImageStorage,
+}
+
+// The functional groups of the attributes of single frame images (PS 3.3
+// C.7.6.16.2)
+var frameGroups = []struct {
+	tag  Tag
+	tags []Tag
+}{
+	{TagPixelMeasuresSequence, []Tag{TagSliceThickness, TagPixelSpacing}},
+	{TagPlanePositionSequence, []Tag{TagImagePositionPatient}},
+	{TagPlaneOrientationSequence, []Tag{TagImageOrientationPatient}},
+	{TagFrameVOILUTSequence, []Tag{TagWindowCenter, TagWindowWidth, TagWindowCenterWidthExplanation}},
+	{TagPixelValueTransformationSequence, []Tag{TagRescaleIntercept, TagRescaleSlope, TagRescaleType}},
+}
+
+// Functional groups copied as sequences to single frame images, rather than
+// their attributes
+var frameSequences = map[Tag]bool{
+	TagReferencedImageSequence: true,
+	TagDerivationImageSequence: true,
+}
+
+// Attributes of the functional groups that single frame images do not have,
+// and those renamed
+var (
+	multiFrameOnly = map[Tag]bool{
+		TagDimensionIndexValues:                        true,
+		TagStackID:                                     true,
+		TagInStackPositionNumber:                       true,
+		TagTemporalPositionIndex:                       true,
+		TagConversionSourceAttributesSequence:          true,
+		TagDimensionOrganizationSequence:               true,
+		TagDimensionIndexSequence:                      true,
+		TagSharedFunctionalGroupsSequence:              true,
+		TagPerFrameFunctionalGroupsSequence:            true,
+		TagNumberOfFrames:                              true,
+		TagUnassignedSharedConvertedAttributesSequence: true,
+	}
+	frameRenames = map[Tag]Tag{
+		TagFrameType:                TagImageType,
+		TagFrameAcquisitionDateTime: TagAcquisitionDateTime,
+	}
+)
+
+// The attributes identifying an image, set by the conversions
+var imageIdentity = map[Tag]bool{
+	TagMediaStorageSOPClassUID:    true,
+	TagMediaStorageSOPInstanceUID: true,
+	TagSOPClassUID:                true,
+	TagSOPInstanceUID:             true,
+	TagSeriesInstanceUID:          true,
+	TagInstanceNumber:             true,
+	TagPixelData:                  true,
+}
+
+// Split an enhanced or legacy converted enhanced CT, MR or PET image into
+// single frame images of a new series, one per frame. The attributes of the
+// shared and per-frame functional groups of each frame are set at the top
+// level of its image, and the other attributes copied. The images are in
+// the study and frame of reference of the multi-frame image, and keep its
+// references to other instances.
+func SplitFrames(file *DicomFile) ([]*DicomFile, error) {
+
+	class, err := file.lookupString(TagSOPClassUID)
+	if err != nil {
+		return nil, err
+	}
+	singleFrame, ok := singleFrameClasses[class]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is not an enhanced image", ErrUnsupportedImage, class)
+	}
+
+	count, _, err := file.lookupInt(TagNumberOfFrames)
+	if err != nil {
+		return nil, err
+	}
+	perFrame := file.sequence(TagPerFrameFunctionalGroupsSequence)
+	if len(perFrame) != count {
+		return nil, fmt.Errorf("%w: %d per-frame functional groups for %d frames", ErrInconsistentFrames, len(perFrame), count)
+	}
+	frames, err := file.pixelFrames(count)
+	if err != nil {
+		return nil, err
+	}
+
+	var shared *Item
+	if seq := file.sequence(TagSharedFunctionalGroupsSequence); len(seq) > 0 {
+		shared = seq[0]
+	}
+	series := newUID()
+
+	images := make([]*DicomFile, count)
+	for n := range images {
+		image := &DicomFile{}
+		for i := range file.Elements {
+			elem := &file.Elements[i]
+			if !multiFrameOnly[elem.Tag()] && !imageIdentity[elem.Tag()] {
+				image.insertElement(elem.Clone())
+			}
+		}
+		for _, seq := range file.sequence(TagUnassignedSharedConvertedAttributesSequence) {
+			image.setItemElements(seq)
+		}
+		for _, groups := range []*Item{shared, perFrame[n]} {
+			if groups == nil {
+				continue
+			}
+			for _, group := range groups.Elements {
+				image.setFrameGroup(group)
+			}
+		}
+
+		pixels := file.Elements[file.indexOf(TagPixelData)].Clone()
+		if _, ok := pixels.Value.(*PixelData); ok {
+			pixels.Value = &PixelData{Fragments: [][]byte{frames[n]}}
+		} else {
+			pixels.Value = Bytes(frames[n])
+		}
+		image.insertElement(pixels)
+
+		instance := newUID()
+		for _, attr := range []itemValue{
+			{TagMediaStorageSOPClassUID, Strings{singleFrame}},
+			{TagMediaStorageSOPInstanceUID, Strings{instance}},
+			{TagSOPClassUID, Strings{singleFrame}},
+			{TagSOPInstanceUID, Strings{instance}},
+			{TagSeriesInstanceUID, Strings{series}},
+			{TagInstanceNumber, Strings{fmt.Sprint(n + 1)}},
+		} {
+			if attr.tag.Group == 0x0002 && file.indexOf(TagTransferSyntaxUID) < 0 {
+				continue
+			}
+			if err := image.setValue(attr.tag, attr.value); err != nil {
+				return nil, err
+			}
+		}
+		images[n] = image
+	}
+
+	return images, nil
+}
+
+// Set the attributes of a functional group of a frame at the top level
+func (file *DicomFile) setFrameGroup(group *DicomElement) {
+
+	if frameSequences[group.Tag()] {
+		file.setElement(group.Clone())
+		return
+	}
+	if multiFrameOnly[group.Tag()] {
+		return
+	}
+
+	seq, err := group.GetSequence()
+	if err != nil {
+		return
+	}
+	for _, item := range seq {
+		file.setItemElements(item)
+	}
+}
+
+// Set the elements of an item at the top level, but those of multi-frame
+// images only
+func (file *DicomFile) setItemElements(item *Item) {
+	for _, elem := range item.Elements {
+		if multiFrameOnly[elem.Tag()] {
+			continue
+		}
+		clone := elem.Clone()
+		if tag, ok := frameRenames[elem.Tag()]; ok {
+			clone.Group, clone.Element = tag.Group, tag.Element
+			if entry, err := standardParser().getDictEntry(tag.Group, tag.Element); err == nil {
+				clone.Name = entry.name
+			}
+		}
+		file.setElement(clone)
+	}
+}
+
+// Return the pixel data of each frame, native or encapsulated
+func (file *DicomFile) pixelFrames(count int) ([][]byte, error) {
+
+	elem, err := file.LookupElementByTag(TagPixelData)
+	if err != nil {
+		return nil, err
+	}
+
+	if pixels, ok := elem.Value.(*PixelData); ok {
+		frames := file.encapsulatedFrames(pixels, count)
+		if len(frames) != count {
+			return nil, fmt.Errorf("%w: %d fragments for %d frames", ErrInconsistentFrames, len(pixels.Fragments), count)
+		}
+		return frames, nil
+	}
+
+	attrs, err := file.imageAttrs()
+	if err != nil {
+		return nil, err
+	}
+	if attrs.bitsAllocated%8 != 0 {
+		return nil, fmt.Errorf("%w: %d bits allocated", ErrUnsupportedImage, attrs.bitsAllocated)
+	}
+	data, _, err := file.nativePixels(elem)
+	if err != nil {
+		return nil, err
+	}
+	size := attrs.rows * attrs.columns * attrs.samples * attrs.bitsAllocated / 8
+	if len(data) < size*count {
+		return nil, fmt.Errorf("%w: %d bytes for %d frames of %d bytes", ErrInconsistentFrames, len(data), count, size)
+	}
+
+	frames := make([][]byte, count)
+	for i := range frames {
+		frames[i] = data[i*size : (i+1)*size]
+	}
+	return frames, nil
+}
+
+// The attributes which must be the same for every frame of an image
+var framePixelTags = []Tag{
+	TagTransferSyntaxUID, TagSOPClassUID, TagSeriesInstanceUID, TagRows, TagColumns, TagSamplesPerPixel,
+	TagPhotometricInterpretation, TagBitsAllocated, TagBitsStored, TagHighBit, TagPixelRepresentation,
+	TagPlanarConfiguration,
+}
+
+// Merge the single frame CT, MR or PET images of a series into a Legacy
+// Converted Enhanced image of a new series, with the frames sorted by
+// InstanceNumber (PS 3.3 A.70). The attributes of the functional groups are
+// shared when the images agree on them, and per-frame otherwise. The other
+// attributes varying between images are kept per frame in the Unassigned
+// Per-Frame Converted Attributes Sequence, and every frame references its
+// image in the Conversion Source Attributes Sequence.
+func MergeFrames(images []*DicomFile) (*DicomFile, error) {
+
+	if len(images) == 0 {
+		return nil, fmt.Errorf("%w: no images", ErrInconsistentFrames)
+	}
+
+	images = append([]*DicomFile(nil), images...)
+	numbers := make(map[*DicomFile]int, len(images))
+	for _, image := range images {
+		n, _, err := image.lookupInt(TagInstanceNumber)
+		if err != nil {
+			return nil, err
+		}
+		numbers[image] = n
+	}
+	sort.SliceStable(images, func(i, j int) bool { return numbers[images[i]] < numbers[images[j]] })
+
+	first := images[0]
+	class, err := first.lookupString(TagSOPClassUID)
+	if err != nil {
+		return nil, err
+	}
+	enhanced, ok := legacyConvertedClasses[class]
+	if !ok {
+		return nil, fmt.Errorf("%w: no legacy converted enhanced image of %s", ErrUnsupportedImage, class)
+	}
+	for _, image := range images[1:] {
+		for _, tag := range framePixelTags {
+			a, _ := first.LookupElementByTag(tag)
+			b, _ := image.LookupElementByTag(tag)
+			if (a == nil) != (b == nil) || a != nil && !equalValue(a.Value, b.Value, &EqualOptions{}) {
+				return nil, fmt.Errorf("%w: different %s", ErrInconsistentFrames, tag)
+			}
+		}
+	}
+
+	// the attributes of the functional groups
+	grouped := map[Tag]bool{}
+	shared, perFrame := &Item{}, make(Sequence, len(images))
+	for i := range perFrame {
+		perFrame[i] = &Item{}
+	}
+	for _, group := range frameGroups {
+		for _, tag := range group.tags {
+			grouped[tag] = true
+		}
+		items := make([]*Item, len(images))
+		for i, image := range images {
+			items[i] = copyItem(image, group.tags, 2)
+		}
+		if len(items[0].Elements) == 0 && sameItems(items) {
+			continue
+		}
+		if sameItems(items) {
+			shared.Elements = append(shared.Elements, frameGroup(group.tag, items[0]))
+			continue
+		}
+		for i, item := range items {
+			perFrame[i].Elements = append(perFrame[i].Elements, frameGroup(group.tag, item))
+		}
+	}
+
+	// the other attributes, varying or not
+	file := &DicomFile{}
+	var varying []Tag
+	for _, tag := range attributeTags(images) {
+		if grouped[tag] || imageIdentity[tag] {
+			continue
+		}
+		items := make([]*Item, len(images))
+		for i, image := range images {
+			items[i] = copyItem(image, []Tag{tag}, 0)
+		}
+		// the file meta information of the first image
+		if tag.Group == 0x0002 && len(items[0].Elements) > 0 || sameItems(items) {
+			file.insertElement(items[0].Elements[0])
+		} else {
+			varying = append(varying, tag)
+		}
+	}
+
+	for i, image := range images {
+		ref, err := ReferenceTo(image)
+		if err != nil {
+			return nil, err
+		}
+		source, err := newLevelItem([]itemValue{
+			{TagReferencedSOPClassUID, Strings{ref.SOPClassUID}},
+			{TagReferencedSOPInstanceUID, Strings{ref.SOPInstanceUID}},
+		}, 2)
+		if err != nil {
+			return nil, err
+		}
+		perFrame[i].Elements = append(perFrame[i].Elements, frameGroup(TagConversionSourceAttributesSequence, source))
+		if len(varying) > 0 {
+			perFrame[i].Elements = append(perFrame[i].Elements, frameGroup(TagUnassignedPerFrameConvertedAttributesSequence, copyItem(image, varying, 2)))
+		}
+		sort.SliceStable(perFrame[i].Elements, func(a, b int) bool {
+			return tagLess(perFrame[i].Elements[a].Tag(), perFrame[i].Elements[b].Tag())
+		})
+	}
+
+	pixels, err := mergePixels(images)
+	if err != nil {
+		return nil, err
+	}
+	file.insertElement(pixels)
+
+	instance := newUID()
+	for _, attr := range []itemValue{
+		{TagMediaStorageSOPClassUID, Strings{enhanced}},
+		{TagMediaStorageSOPInstanceUID, Strings{instance}},
+		{TagSOPClassUID, Strings{enhanced}},
+		{TagSOPInstanceUID, Strings{instance}},
+		{TagSeriesInstanceUID, Strings{newUID()}},
+		{TagInstanceNumber, Strings{"1"}},
+		{TagNumberOfFrames, Strings{fmt.Sprint(len(images))}},
+		{TagSharedFunctionalGroupsSequence, Sequence{shared}},
+		{TagPerFrameFunctionalGroupsSequence, perFrame},
+	} {
+		if attr.tag.Group == 0x0002 && file.indexOf(TagTransferSyntaxUID) < 0 {
+			continue
+		}
+		if err := file.setValue(attr.tag, attr.value); err != nil {
+			return nil, err
+		}
+	}
+	for _, tag := range []Tag{TagSharedFunctionalGroupsSequence, TagPerFrameFunctionalGroupsSequence} {
+		setIndentLevel(&file.Elements[file.indexOf(tag)], 0)
+	}
+
+	return file, nil
+}
+
+// Create the element of a functional group holding an item
+func frameGroup(tag Tag, item *Item) *DicomElement {
+	elem, _ := standardParser().NewElement(tag, Sequence{item})
+	elem.IndentLevel = 1
+	return elem
+}
+
+// Reports whether the items hold the same elements and values
+func sameItems(items []*Item) bool {
+	for _, item := range items[1:] {
+		if !equalElements(items[0].Elements, item.Elements, &EqualOptions{}) {
+			return false
+		}
+	}
+	return true
+}
+
+// The tags of the top level elements of the images, sorted
+func attributeTags(images []*DicomFile) []Tag {
+	seen := map[Tag]bool{}
+	var tags []Tag
+	for _, image := range images {
+		for i := range image.Elements {
+			if tag := image.Elements[i].Tag(); !seen[tag] {
+				seen[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+	sort.Slice(tags, func(i, j int) bool { return tagLess(tags[i], tags[j]) })
+	return tags
+}
+
+// Concatenate the native pixel data of images, or gather their encapsulated
+// frames, in the transfer syntax they share
+func mergePixels(images []*DicomFile) (*DicomElement, error) {
+
+	var merged *DicomElement
+	var data Bytes
+	encapsulated := &PixelData{Offsets: []uint32{}}
+	offset := uint32(0)
+
+	for _, image := range images {
+		elem, err := image.LookupElementByTag(TagPixelData)
+		if err != nil {
+			return nil, err
+		}
+		if merged == nil {
+			merged = elem.Clone()
+		}
+
+		frames, err := image.pixelFrames(1)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := elem.Value.(*PixelData); ok {
+			encapsulated.Offsets = append(encapsulated.Offsets, offset)
+			encapsulated.Fragments = append(encapsulated.Fragments, frames[0])
+			offset += 8 + uint32(len(frames[0])+len(frames[0])%2)
+		} else {
+			data = append(data, frames[0]...)
+		}
+	}
+
+	if _, ok := merged.Value.(*PixelData); ok {
+		merged.Value = encapsulated
+	} else {
+		merged.Value = data
+	}
+	return merged, nil
+}
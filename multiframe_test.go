@@ -0,0 +1,152 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// A series of n 2x3 CT images with 8 bit pixels, each filled with its
+// instance number
+func frameSeries(t *testing.T, n int) []*DicomFile {
+	files := sourceSeries(t, n)
+	for i, file := range files {
+		for _, attr := range []itemValue{
+			{TagMediaStorageSOPClassUID, Strings{CTImageStorage}},
+			{TagMediaStorageSOPInstanceUID, Strings{file.Elements[file.indexOf(TagSOPInstanceUID)].MustGetString()}},
+			{TagInstanceNumber, Strings{fmt.Sprint(i + 1)}},
+			{TagSliceLocation, Strings{fmt.Sprint(2 * i)}},
+			{TagSamplesPerPixel, UInt16s{1}},
+			{TagPhotometricInterpretation, Strings{"MONOCHROME2"}},
+			{TagBitsAllocated, UInt16s{8}},
+			{TagBitsStored, UInt16s{8}},
+			{TagHighBit, UInt16s{7}},
+			{TagPixelRepresentation, UInt16s{0}},
+			{TagPixelData, Bytes(bytes.Repeat([]byte{byte(i + 1)}, 6))},
+		} {
+			if err := file.setValue(attr.tag, attr.value); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return files
+}
+
+func TestMergeAndSplitFrames(t *testing.T) {
+
+	images := frameSeries(t, 3)
+	images[0], images[2] = images[2], images[0]
+
+	merged, err := MergeFrames(images)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buffer := new(bytes.Buffer)
+	if err := merged.Write(buffer); err != nil {
+		t.Fatal(err)
+	}
+	p, _ := NewParser()
+	parsed, err := p.Parse(buffer.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for tag, expected := range map[Tag]string{
+		TagSOPClassUID:         LegacyConvertedEnhancedCTImageStorage,
+		TagNumberOfFrames:      "3",
+		TagPatientID:           "SEG1",
+		TagStudyInstanceUID:    images[0].Elements[images[0].indexOf(TagStudyInstanceUID)].MustGetString(),
+		TagFrameOfReferenceUID: images[0].Elements[images[0].indexOf(TagFrameOfReferenceUID)].MustGetString(),
+	} {
+		if s, _ := parsed.lookupString(tag); s != expected {
+			t.Errorf("Expected %s %s, got %s", tag, expected, s)
+		}
+	}
+	for _, tag := range []Tag{TagImagePositionPatient, TagPixelSpacing, TagSliceLocation} {
+		if parsed.indexOf(tag) >= 0 {
+			t.Errorf("%s not moved to the functional groups", tag)
+		}
+	}
+	shared := parsed.sequence(TagSharedFunctionalGroupsSequence)
+	if len(shared) != 1 || shared[0].lookup(TagPixelMeasuresSequence) == nil || shared[0].lookup(TagPlanePositionSequence) != nil {
+		t.Errorf("Incorrect shared functional groups")
+	}
+	frames := parsed.sequence(TagPerFrameFunctionalGroupsSequence)
+	if len(frames) != 3 {
+		t.Fatalf("Expected 3 frames, got %d", len(frames))
+	}
+	// frames in the order of the instance numbers
+	for i, frame := range frames {
+		source := frame.lookup(TagConversionSourceAttributesSequence).MustGetSequence()[0]
+		uid, _ := source.lookupString(TagReferencedSOPInstanceUID)
+		if expected, _ := images[2-i].lookupString(TagSOPInstanceUID); uid != expected {
+			t.Errorf("Frame %d references %s", i+1, uid)
+		}
+	}
+	pixels := parsed.FindAllByTag(TagPixelData)[0].MustGetBytes()
+	if !bytes.Equal(pixels, []byte{1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 2, 2, 3, 3, 3, 3, 3, 3}) {
+		t.Errorf("Incorrect pixels %v", pixels)
+	}
+
+	split, err := SplitFrames(parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(split) != 3 {
+		t.Fatalf("Expected 3 images, got %d", len(split))
+	}
+	series, _ := split[0].lookupString(TagSeriesInstanceUID)
+	for i, image := range split {
+		for tag, expected := range map[Tag]string{
+			TagSOPClassUID:          CTImageStorage,
+			TagInstanceNumber:       fmt.Sprint(i + 1),
+			TagImagePositionPatient: fmt.Sprintf(`0\0\%d`, 2*i),
+			TagSliceLocation:        fmt.Sprint(2 * i),
+			TagPixelSpacing:         `0.5\0.5`,
+			TagSeriesInstanceUID:    series,
+		} {
+			elem, err := image.LookupElementByTag(tag)
+			if err != nil {
+				t.Errorf("Image %d: %v", i+1, err)
+				continue
+			}
+			if s := strings.Join(elem.MustGetStrings(), `\`); s != expected {
+				t.Errorf("Image %d: expected %s %s, got %s", i+1, tag, expected, s)
+			}
+		}
+		for _, tag := range []Tag{TagPerFrameFunctionalGroupsSequence, TagNumberOfFrames, TagConversionSourceAttributesSequence} {
+			if _, err := image.LookupElementByTag(tag); err == nil {
+				t.Errorf("Image %d has %s", i+1, tag)
+			}
+		}
+		if pixels := image.Elements[image.indexOf(TagPixelData)].MustGetBytes(); !bytes.Equal(pixels, bytes.Repeat([]byte{byte(i + 1)}, 6)) {
+			t.Errorf("Image %d: incorrect pixels %v", i+1, pixels)
+		}
+		if err := image.Write(new(bytes.Buffer)); err != nil {
+			t.Errorf("Image %d: %v", i+1, err)
+		}
+	}
+	if series == images[0].Elements[images[0].indexOf(TagSeriesInstanceUID)].MustGetString() {
+		t.Errorf("Images split in the series of the source")
+	}
+}
+
+func TestMergeFramesErrors(t *testing.T) {
+
+	images := frameSeries(t, 2)
+	images[1].setValue(TagRows, UInt16s{3})
+	if _, err := MergeFrames(images); !errors.Is(err, ErrInconsistentFrames) {
+		t.Errorf("Expected ErrInconsistentFrames, got %v", err)
+	}
+
+	images = frameSeries(t, 1)
+	images[0].setValue(TagSOPClassUID, Strings{SegmentationStorage})
+	if _, err := MergeFrames(images); !errors.Is(err, ErrUnsupportedImage) {
+		t.Errorf("Expected ErrUnsupportedImage, got %v", err)
+	}
+	if _, err := SplitFrames(images[0]); !errors.Is(err, ErrUnsupportedImage) {
+		t.Errorf("Expected ErrUnsupportedImage, got %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+package dicom
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Add a top level element, or replace the element with the same tag,
+// keeping the elements sorted by tag. The elements of the items of a
+// sequence are nested below it. The group length of the group of the
+// element, if any, is updated.
+func (file *DicomFile) PutElement(elem *DicomElement) error {
+	file.setElement(elem)
+	return file.updateGroupLength(elem.Group)
+}
+
+// Remove the top level element with a tag, updating the group length of its
+// group. Returns whether the element was found.
+func (file *DicomFile) RemoveElement(tag Tag) (bool, error) {
+
+	i := file.indexOf(tag)
+	if i < 0 {
+		return false, nil
+	}
+	file.Elements = append(file.Elements[:i], file.Elements[i+1:]...)
+
+	return true, file.updateGroupLength(tag.Group)
+}
+
+// Set the values of a top level element, converted to its VR as by
+// SetByPath, creating the element from the standard dictionary if missing
+func (file *DicomFile) ReplaceString(tag Tag, values ...string) error {
+
+	if i := file.indexOf(tag); i >= 0 {
+		if err := setFromStrings(&file.Elements[i], values); err != nil {
+			return err
+		}
+		return file.updateGroupLength(tag.Group)
+	}
+
+	elem, err := newElementFromStrings(tag, values)
+	if err != nil {
+		return err
+	}
+	return file.PutElement(elem)
+}
+
+// Add an element to the item of index of a sequence, or replace the element
+// of the item with the same tag, keeping the elements sorted by tag
+func (sq *DicomElement) PutItemElement(index int, elem *DicomElement) error {
+
+	item, err := sq.item(index)
+	if err != nil {
+		return err
+	}
+
+	setIndentLevel(elem, sq.IndentLevel+1)
+	for i, e := range item.Elements {
+		if e.Tag() == elem.Tag() {
+			item.Elements[i] = elem
+			return nil
+		}
+	}
+	item.insertElement(elem)
+
+	return nil
+}
+
+// Remove the element with a tag from the item of index of a sequence.
+// Returns whether the element was found.
+func (sq *DicomElement) RemoveItemElement(index int, tag Tag) (bool, error) {
+
+	item, err := sq.item(index)
+	if err != nil {
+		return false, err
+	}
+
+	for i, e := range item.Elements {
+		if e.Tag() == tag {
+			item.Elements = append(item.Elements[:i], item.Elements[i+1:]...)
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (sq *DicomElement) item(index int) (*Item, error) {
+	seq, err := sq.GetSequence()
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= len(seq) {
+		return nil, fmt.Errorf("%w: item %d of %s", ErrNotFound, index, sq.Tag())
+	}
+	return seq[index], nil
+}
+
+// Replace the top level element with the tag of elem, or add elem
+func (file *DicomFile) setElement(elem *DicomElement) {
+	setIndentLevel(elem, 0)
+	if i := file.indexOf(elem.Tag()); i >= 0 {
+		file.Elements[i] = *elem
+	} else {
+		file.insertElement(elem)
+	}
+}
+
+// Set the level of an element, and of the elements of its items below it
+func setIndentLevel(elem *DicomElement, level uint8) {
+	elem.IndentLevel = level
+	if seq, ok := elem.Value.(Sequence); ok {
+		for _, item := range seq {
+			for _, child := range item.Elements {
+				setIndentLevel(child, level+1)
+			}
+		}
+	}
+}
+
+// Set the group length element of a group, if the file has one, to the
+// length of the other elements of the group, as encoded with the transfer
+// syntax of the file. The file meta information is explicit VR little
+// endian.
+func (file *DicomFile) updateGroupLength(group uint16) error {
+
+	i := file.indexOf(Tag{group, 0x0000})
+	if i < 0 {
+		return nil
+	}
+
+	bo, implicit := binary.ByteOrder(binary.LittleEndian), false
+	if group != 0x0002 {
+		var err error
+		if bo, implicit, err = file.getTransferSyntax(); err != nil {
+			bo, implicit = binary.LittleEndian, false // data sets without file meta information
+		}
+	}
+
+	buffer := newDicomWriter(bo, implicit)
+	for j := range file.Elements {
+		if elem := &file.Elements[j]; elem.Group == group && elem.Element != 0x0000 {
+			if err := buffer.writeElement(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	file.Elements[i].Value = UInt32s{uint32(buffer.Len())}
+	return nil
+}
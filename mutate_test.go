@@ -0,0 +1,135 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func groupLength(file *DicomFile) uint32 {
+	elem, err := file.LookupElementByTag(TagFileMetaInformationGroupLength)
+	if err != nil {
+		return 0
+	}
+	return elem.Value.(UInt32s)[0]
+}
+
+func TestPutAndRemoveElement(t *testing.T) {
+
+	p, _ := NewParser()
+	file := &DicomFile{}
+	for _, attr := range []itemValue{
+		{TagFileMetaInformationGroupLength, UInt32s{0}},
+		{TagTransferSyntaxUID, Strings{explicit_vr_little_endian}},
+		{TagPatientID, Strings{"MUT1"}},
+	} {
+		elem, err := p.NewElement(attr.tag, attr.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := file.PutElement(elem); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// (0002,0010) UI of 20 bytes
+	if length := groupLength(file); length != 28 {
+		t.Errorf("Expected a group length of 28, got %d", length)
+	}
+
+	elem, _ := p.NewElement(TagMediaStorageSOPClassUID, Strings{CTImageStorage})
+	if err := file.PutElement(elem); err != nil {
+		t.Fatal(err)
+	}
+	if length := groupLength(file); length != 62 {
+		t.Errorf("Expected a group length of 62, got %d", length)
+	}
+	for i := 1; i < len(file.Elements); i++ {
+		if !tagLess(file.Elements[i-1].Tag(), file.Elements[i].Tag()) {
+			t.Errorf("Elements not sorted: %s before %s", file.Elements[i-1].Tag(), file.Elements[i].Tag())
+		}
+	}
+
+	elem, _ = p.NewElement(TagPatientID, Strings{"MUT2"})
+	if err := file.PutElement(elem); err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := file.lookupString(TagPatientID); id != "MUT2" || len(file.Elements) != 4 {
+		t.Errorf("Patient ID not replaced: %s", id)
+	}
+
+	if found, err := file.RemoveElement(TagMediaStorageSOPClassUID); !found || err != nil {
+		t.Errorf("Element not removed: %v", err)
+	}
+	if length := groupLength(file); length != 28 {
+		t.Errorf("Expected a group length of 28, got %d", length)
+	}
+	if found, _ := file.RemoveElement(TagMediaStorageSOPClassUID); found {
+		t.Errorf("Removed a missing element")
+	}
+
+	// the group length written is the one computed
+	buffer := new(bytes.Buffer)
+	if err := file.Write(buffer); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := p.Parse(buffer.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := parsed.lookupString(TagPatientID); id != "MUT2" {
+		t.Errorf("Expected patient ID MUT2, got %s", id)
+	}
+}
+
+func TestReplaceString(t *testing.T) {
+
+	file := &DicomFile{}
+	if err := file.ReplaceString(TagRows, "512"); err != nil {
+		t.Fatal(err)
+	}
+	if rows, _, _ := file.lookupInt(TagRows); rows != 512 {
+		t.Errorf("Expected 512 rows, got %d", rows)
+	}
+	if err := file.ReplaceString(TagRows, "256"); err != nil {
+		t.Fatal(err)
+	}
+	if rows, _, _ := file.lookupInt(TagRows); rows != 256 || len(file.Elements) != 1 {
+		t.Errorf("Expected 256 rows, got %d", rows)
+	}
+	if err := file.ReplaceString(TagRows, "many"); err == nil {
+		t.Errorf("Set an invalid number of rows")
+	}
+}
+
+func TestPutItemElement(t *testing.T) {
+
+	p, _ := NewParser()
+	series, err := newItem([]itemValue{{TagSeriesInstanceUID, Strings{"1.2.3"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sq, err := p.NewElement(TagReferencedSeriesSequence, Sequence{series})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	elem, _ := p.NewElement(TagReferencedSOPInstanceUID, Strings{"1.2.3.4"})
+	if err := sq.PutItemElement(0, elem); err != nil {
+		t.Fatal(err)
+	}
+	item := sq.MustGetSequence()[0]
+	if len(item.Elements) != 2 || item.Elements[0] != elem || elem.IndentLevel != 1 {
+		t.Errorf("Element not inserted in order")
+	}
+
+	if err := sq.PutItemElement(1, elem); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+	if found, err := sq.RemoveItemElement(0, TagReferencedSOPInstanceUID); !found || err != nil {
+		t.Errorf("Element not removed: %v", err)
+	}
+	if uid, _ := item.lookupString(TagSeriesInstanceUID); len(item.Elements) != 1 || uid != "1.2.3" {
+		t.Errorf("Incorrect item elements after removal")
+	}
+}
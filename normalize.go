@@ -0,0 +1,106 @@
+package dicom
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The canonical forms of values, so that values which only differ in their
+// encoding compare equal. They are used by MatchKey and the indexes of
+// Store, so that applications comparing or indexing values the same way
+// agree with the library.
+
+// Fold the case and accents of a person name, and remove the spaces around
+// its components and its empty trailing components, ie. " Doe ^John^^" is
+// "doe^john"
+func NormalizePersonName(name string) string {
+
+	groups := strings.Split(strings.Trim(name, " \x00"), "=")
+	for i, group := range groups {
+		components := strings.Split(group, "^")
+		for j, c := range components {
+			components[j] = strings.Join(strings.Fields(c), " ")
+		}
+		for len(components) > 0 && components[len(components)-1] == "" {
+			components = components[:len(components)-1]
+		}
+		groups[i] = strings.Join(components, "^")
+	}
+	for len(groups) > 0 && groups[len(groups)-1] == "" {
+		groups = groups[:len(groups)-1]
+	}
+
+	return strings.Map(func(r rune) rune {
+		return unicode.ToLower(foldAccent(r))
+	}, strings.Join(groups, "="))
+}
+
+// Return a DA value as YYYYMMDD, ie. "2005.01.31" is "20050131"
+func NormalizeDate(s string) (string, error) {
+	s = strings.Trim(s, " \x00")
+	t, err := parseDate(s)
+	if err != nil {
+		return s, err
+	}
+	return t.Format("20060102"), nil
+}
+
+// Return a TM value with all its components, HHMMSS.FFFFFF, ie. "14:30" and
+// "1430.5" are "143000.000000" and "143000.500000"
+func NormalizeTime(s string) (string, error) {
+
+	s = strings.Trim(s, " \x00")
+	if _, err := parseTime(s); err != nil {
+		return s, err
+	}
+
+	s = strings.Replace(s, ":", "", -1)
+	frac := ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		s, frac = s[:i], s[i+1:]
+	}
+
+	return s + "0000"[len(s)-2:] + "." + frac + strings.Repeat("0", 6-len(frac)), nil
+}
+
+// Return a DS value in the shortest form of its number, ie. "+1.50" and
+// "1.5E0" are "1.5"
+func NormalizeDecimal(s string) (string, error) {
+	s = strings.Trim(s, " \x00")
+	f, err := DS(s).Float64()
+	if err != nil {
+		return s, err
+	}
+	if f == 0 {
+		f = 0 // no negative zero
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64), nil
+}
+
+// Remove the padding of a UI value, and the spaces some implementations add
+func NormalizeUID(uid string) string {
+	return strings.Trim(uid, " \x00")
+}
+
+// Return the canonical form of a value of a VR: PN, DA, TM, DS and UI
+// values as by the functions above, and other values without their padding.
+// Invalid values are only trimmed.
+func NormalizeValue(vr, value string) string {
+
+	switch vr {
+	case "PN":
+		return NormalizePersonName(value)
+	case "DA":
+		s, _ := NormalizeDate(value)
+		return s
+	case "TM":
+		s, _ := NormalizeTime(value)
+		return s
+	case "DS":
+		s, _ := NormalizeDecimal(value)
+		return s
+	}
+
+	return strings.Trim(value, " \x00")
+}
@@ -0,0 +1,64 @@
+package dicom
+
+import (
+	"testing"
+)
+
+func TestNormalizeValue(t *testing.T) {
+
+	for _, v := range []struct {
+		vr, value, expected string
+	}{
+		{"PN", " Doe ^John  Paul^^ ", "doe^john paul"},
+		{"PN", "Müller^Zoë=", "muller^zoe"},
+		{"DA", "20050131 ", "20050131"},
+		{"DA", "2005.01.31", "20050131"},
+		{"DA", "2005013", "2005013"},
+		{"TM", "14", "140000.000000"},
+		{"TM", "14:30:05", "143005.000000"},
+		{"TM", "1430.5", "143000.500000"},
+		{"TM", "143005.5", "143005.500000"},
+		{"DS", " +1.50", "1.5"},
+		{"DS", "1.5E2 ", "150"},
+		{"DS", "-0.0", "0"},
+		{"DS", "abc", "abc"},
+		{"UI", "1.2.3\x00", "1.2.3"},
+		{"LO", " ABC ", "ABC"},
+	} {
+		if s := NormalizeValue(v.vr, v.value); s != v.expected {
+			t.Errorf("%s %q: expected %q, got %q", v.vr, v.value, v.expected, s)
+		}
+	}
+
+	if _, err := NormalizeDate("20051301"); err != ErrInvalidDate {
+		t.Errorf("Expected ErrInvalidDate, got %v", err)
+	}
+	if _, err := NormalizeTime("2561"); err != ErrInvalidTime {
+		t.Errorf("Expected ErrInvalidTime, got %v", err)
+	}
+	if _, err := NormalizeDecimal("1,5"); err != ErrInvalidDS {
+		t.Errorf("Expected ErrInvalidDS, got %v", err)
+	}
+}
+
+func TestMatchKeyNormalized(t *testing.T) {
+
+	for _, v := range []struct {
+		vr, key, value string
+		match          bool
+	}{
+		{"DA", "20050131", "2005.01.31", true},
+		{"DA", "20050101-20051231", "2005.06.01", true},
+		{"TM", "1430", "14:30:00", true},
+		{"TM", "1430", "143001", false},
+		{"DS", "1.50", "1.5", true},
+		{"DS", "1.5*", "1.50", true},
+		{"UI", "1.2.3", "1.2.3\x00", true},
+	} {
+		key := &DicomElement{Vr: v.vr, Value: Strings{v.key}}
+		elem := &DicomElement{Vr: v.vr, Value: Strings{v.value}}
+		if MatchKey(key, elem, MatchOptions{}) != v.match {
+			t.Errorf("%s %q against %q: expected %v", v.vr, v.key, v.value, v.match)
+		}
+	}
+}
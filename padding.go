@@ -0,0 +1,37 @@
+package dicom
+
+import (
+	"strings"
+)
+
+// Keep string values exactly as they are read, padding and insignificant
+// spaces included, so that they are written back unchanged
+func KeepPadding() func(*Parser) error {
+	return func(p *Parser) error {
+		p.keepPadding = true
+		return nil
+	}
+}
+
+// Remove the padding and the insignificant spaces of a string value
+// (PS 3.5 6.2). Leading spaces are significant in texts, and UIs are padded
+// with a NULL byte rather than a space.
+func trimString(s string, vr string) string {
+
+	// some implementations pad every VR with NULL bytes, or with a zero-width
+	// space, on either side
+	s = strings.Trim(s, "\x00\u200B")
+
+	switch vr {
+	case "AE", "AS", "CS", "DA", "DS", "DT", "IS", "LO", "SH", "TM":
+		return strings.Trim(s, " ")
+	}
+
+	return strings.TrimRight(s, " ")
+}
+
+// Reports whether a string VR holds a single value, in which backslashes are
+// not delimiters
+func isTextVR(vr string) bool {
+	return GetVRKind(vr) == VRKindText
+}
@@ -1,9 +1,9 @@
 package dicom
 
 import (
-	"bytes"
 	"fmt"
 	"strings"
+	"sync"
 )
 
 // Constants
@@ -13,14 +13,6 @@ const (
 	private_group_name = "Private Data"
 )
 
-// Value Multiplicity PS 3.5 6.4
-type dcmVM struct {
-	s   string
-	Min uint8
-	Max uint8
-	N   bool
-}
-
 // A DICOM element
 type DicomElement struct {
 	Group       uint16
@@ -28,21 +20,50 @@ type DicomElement struct {
 	Name        string
 	Vr          string
 	Vl          uint32
-	Value       []interface{} // Value Multiplicity PS 3.5 6.4
+	Value       Value // Value Multiplicity PS 3.5 6.4
 	IndentLevel uint8
-	elemLen     uint32
+	elemLen     uint32 // length of the encoded element, items included
+	headerLen   uint32
 	undefLen    bool
-	P           uint32
+	lazy        *lazySequence // the items of SQ elements, until parsed
+	P           uint32        // position of the element in the data read
 }
 
 type Parser struct {
-	dictionary [][]*dictEntry
+	dictionary    []dictEntry    // sorted by tag, the default dictionary if nil
+	names         map[string]Tag // the default dictionary's if nil
+	strict        bool
+	keepPadding   bool
+	headerOnly    bool
+	lazySequences bool
+
+	maxElementLength uint32
+	maxDataSetLength int
+	maxSequenceDepth uint8
+	maxElements      int
+	logger           Logger
+}
+
+var (
+	stdParser     *Parser
+	stdParserOnce sync.Once
+)
+
+// Return a parser with the standard dictionary, shared by the element setters
+func standardParser() *Parser {
+	stdParserOnce.Do(func() {
+		stdParser, _ = NewParser()
+	})
+	return stdParser
 }
 
 // Stringer
 func (e *DicomElement) String() string {
 	s := strings.Repeat(" ", int(e.IndentLevel)*2)
-	sv := fmt.Sprintf("%v", e.Value)
+	sv := "[]"
+	if e.Value != nil {
+		sv = fmt.Sprintf("%v", e.Value)
+	}
 	if len(sv) > 50 {
 		sv = sv[1:50] + "(...)"
 	}
@@ -54,9 +75,26 @@ func (e *DicomElement) String() string {
 	return fmt.Sprintf("%08d %s (%04X, %04X) %s %s %d %s %s", e.P, s, e.Group, e.Element, e.Vr, sVl, e.elemLen, e.Name, sv)
 }
 
+// Number of bytes of the element in the data it was read from, from its tag
+// to the end of its value, items and delimitation items included. 0 for
+// elements which were not parsed.
+func (e *DicomElement) EncodedLength() uint32 {
+	return e.elemLen
+}
+
+// Position and number of bytes of the value of the element in the data it
+// was read from, ie. to reference it as bulk data. Values of undefined length
+// include their items and Sequence Delimitation Item.
+func (e *DicomElement) ValueRange() (offset, length uint32) {
+	if e.elemLen == 0 {
+		return 0, 0
+	}
+	return e.P + e.headerLen, e.elemLen - e.headerLen
+}
+
 // Return the tag as a string to use in the Dicom dictionary
 func (e *DicomElement) getTag() string {
-	return fmt.Sprintf("(%04X,%04X)", e.Group, e.Element)
+	return e.Tag().String()
 }
 
 // Create a new parser, with functional options for configuration
@@ -65,14 +103,6 @@ func NewParser(options ...func(*Parser) error) (*Parser, error) {
 
 	p := Parser{}
 
-	// apply defaults
-	dict := bytes.NewReader([]byte(dicomDictData))
-	err := Dictionary(dict)(&p)
-
-	if err != nil {
-		panic(err)
-	}
-
 	// override defaults
 	for _, option := range options {
 		err := option(&p)
@@ -84,15 +114,47 @@ func NewParser(options ...func(*Parser) error) (*Parser, error) {
 	return &p, nil
 }
 
+// A TruncatedError reports an element whose header or value extends past the
+// end of the data. It matches ErrBrokenFile with errors.Is, and
+// ErrPixelDataTruncated for pixel data. Values are never allocated past the
+// end of the data, whatever their Value Length.
+type TruncatedError struct {
+	Tag    Tag
+	P      uint32 // position in the file of the truncated header or value
+	Length uint32 // of the header or value
+	Left   int    // bytes left in the data
+}
+
+func (e *TruncatedError) Error() string {
+	if e.Tag == TagPixelData {
+		return fmt.Sprintf("%s: %d of %d bytes", ErrPixelDataTruncated, e.Left, e.Length)
+	}
+	return fmt.Sprintf("%08d %s: %d bytes exceed the end of the file, %d bytes left", e.P, e.Tag, e.Length, e.Left)
+}
+
+func (e *TruncatedError) Is(target error) bool {
+	return target == ErrBrokenFile || target == ErrPixelDataTruncated && e.Tag == TagPixelData
+}
+
+// Check that a Value Length does not exceed the end of the file
+func checkLength(buffer *dicomBuffer, tag Tag, vl uint32) error {
+	if vl <= uint32(buffer.Len()) {
+		return nil
+	}
+	return &TruncatedError{tag, buffer.p, vl, buffer.Len()}
+}
+
 // Read a DICOM data element
-func (buffer *dicomBuffer) readDataElement(p *Parser) *DicomElement {
+func (buffer *dicomBuffer) readDataElement(p *Parser) (*DicomElement, error) {
 
 	implicit := buffer.implicit
 	inip := buffer.p
+	left := buffer.Len()
 	elem := buffer.readTag(p)
 
 	var vr string     // Value Representation
 	var vl uint32 = 0 // Value Length
+	var err error
 
 	// The elements for group 0xFFFE should be Encoded as Implicit VR.
 	// DICOM Standard 09. PS 3.6 - Section 7.5: "Nesting of Data Sets"
@@ -101,70 +163,66 @@ func (buffer *dicomBuffer) readDataElement(p *Parser) *DicomElement {
 	}
 
 	if implicit {
-		vr, vl = buffer.readImplicit(elem, p)
+		vr, vl, err = buffer.readImplicit(elem, p)
 	} else {
-		vr, vl = buffer.readExplicit(elem)
+		vr, vl, err = buffer.readExplicit(elem)
+	}
+	if header := buffer.p - inip; int(header) > left {
+		return nil, &TruncatedError{elem.Tag(), inip, header, left}
+	}
+	// some implementations write odd length fragments, read as they are
+	oddItem := err == ErrOddLength && elem.Tag() == TagItem && !p.strict
+	if err != nil && !oddItem {
+		return nil, &ConformanceError{elem.Tag(), inip, err}
 	}
 
 	elem.Vr = vr
 	elem.Vl = vl
+	elem.headerLen = buffer.p - inip
 
-	// data
-	var data []interface{}
-	uvl := vl
-	valLen := uint32(vl)
-
-	for uvl > 0 {
-		switch vr {
-		case "AT":
-			valLen = 2
-			data = append(data, buffer.readHex())
-		case "UL":
-			valLen = 4
-			data = append(data, buffer.readUInt32())
-		case "SL":
-			valLen = 4
-			data = append(data, buffer.readInt32())
-		case "US":
-			valLen = 2
-			data = append(data, buffer.readUInt16())
-		case "SS":
-			valLen = 2
-			data = append(data, buffer.readInt16())
-		case "FL":
-			valLen = 4
-			data = append(data, buffer.readFloat())
-		case "FD":
-			valLen = 8
-			data = append(data, buffer.readFloat64())
-		case "OW":
-			valLen = vl
-			data = append(data, buffer.readUInt16Array(vl))
-		case "OB":
-			valLen = vl
-			data = append(data, buffer.readUInt8Array(vl))
-		case "NA":
-			valLen = vl
-		//case "XS": ??
-
-		case "SQ":
-			valLen = vl
-			data = append(data, "")
-		default:
-			valLen = vl
-			str := strings.TrimRight(buffer.readString(vl), " ")
-			strs := strings.Split(str, "\\")
-			for _, s := range strs {
-				data = append(data, s)
-			}
+	if err := p.checkElements(buffer); err != nil {
+		return nil, err
+	}
+	if err := p.checkElementLength(elem, vl); err != nil {
+		return nil, err
+	}
 
+	// the content of items and sequences of undefined length is checked by
+	// the parser
+	if vr != "NA" && !(vr == "SQ" && elem.undefLen) {
+		if err := checkLength(buffer, elem.Tag(), vl); err != nil {
+			return nil, err
 		}
-		uvl -= valLen
 	}
 
+	// the items of encapsulated pixel data are read by the parser
+	if !buffer.definedLengthFragments(elem) {
+		elem.Value = buffer.readValue(vr, vl)
+	}
+	if values, ok := elem.Value.(Strings); ok && !p.keepPadding {
+		for i := range values {
+			values[i] = trimString(values[i], vr)
+		}
+	}
 	elem.P = inip
-	elem.Value = data
 	elem.elemLen = buffer.p - inip
+	if oddItem {
+		p.warn(buffer, elem, ErrOddLength)
+	}
+
+	if vl%valueSize(vr) != 0 {
+		if p.strict {
+			return nil, &ConformanceError{elem.Tag(), inip, ErrTrailingBytes}
+		}
+		p.warn(buffer, elem, ErrTrailingBytes)
+	}
+
+	if err := p.validate(elem); err != nil {
+		if p.strict {
+			return nil, &ConformanceError{elem.Tag(), inip, err}
+		}
+		p.warn(buffer, elem, err)
+	}
 
-	return elem
+	return elem, nil
 }
@@ -2,6 +2,9 @@ package dicom
 
 import (
 	"bytes"
+	"errors"
+	"os"
+	"strings"
 	"testing"
 )
 
@@ -11,8 +14,8 @@ func TestConstructor(t *testing.T) {
 		t.Error(err)
 	}
 
-	if len(p.dictionary) == 0 {
-		t.Error("Error constructing parser. Dictionary can not be of 0 length")
+	if _, err := p.getDictEntry(0x7FE0, 0x0010); err != nil {
+		t.Errorf("Error constructing parser. Default dictionary lookup failed: %v", err)
 	}
 
 }
@@ -27,10 +30,37 @@ func TestDictionaryOption(t *testing.T) {
 	if len(p.dictionary) == 0 {
 		t.Error("Error constructing parser. Dictionary can not be of 0 length")
 	}
+
+	// the same entries as the generated default dictionary
+	if len(p.dictionary) != len(stdDictionary) {
+		t.Errorf("%d entries, the default dictionary has %d", len(p.dictionary), len(stdDictionary))
+	}
+	for i := range stdDictionary {
+		if p.dictionary[i] != stdDictionary[i] {
+			t.Errorf("entry %d is %v, expected %v; run go generate", i, p.dictionary[i], stdDictionary[i])
+			break
+		}
+	}
+}
+
+func TestCustomDictionary(t *testing.T) {
+	dict := "(0009,0010)\tLO\tFirstCreator\t1\tPRIVATE\n(0009,0010)\tSH\tCreator\t1\tPRIVATE\n"
+	p, _ := NewParser(Dictionary(strings.NewReader(dict)))
+
+	entry, err := p.getDictEntry(0x0009, 0x0010)
+	if err != nil || entry.name != "Creator" || entry.vr != "SH" {
+		t.Errorf("got %v, %v, expected the last entry of the tag", entry, err)
+	}
+	if _, err := p.getDictEntry(0x0010, 0x0010); err != ErrTagNotFound {
+		t.Errorf("PatientName is not in the dictionary, got %v", err)
+	}
+	if tag, err := p.LookupTag("FirstCreator"); err != nil || tag != (Tag{0x0009, 0x0010}) {
+		t.Errorf("LookupTag(FirstCreator) = %v, %v", tag, err)
+	}
 }
 
 func TestGetTag(t *testing.T) {
-	elem := &DicomElement{0x7FE0, 0x0010, "PixelData", "ox", 1, nil}
+	elem := &DicomElement{Group: 0x7FE0, Element: 0x0010, Name: "PixelData", Vr: "ox", Vl: 1}
 
 	if tag := elem.getTag(); tag != "(7FE0,0010)" {
 		t.Errorf("Error creating tag. Incorrect value %s", tag)
@@ -45,3 +75,91 @@ func TestReadTag(t *testing.T) {
 func TestReadDataElement(t *testing.T) {
 
 }
+
+func TestTruncated(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		data   []byte
+		tag    Tag
+		length uint32
+	}{
+		{"header", []byte{0x10, 0x00, 0x10, 0x00, 0x04, 0x00}, TagPatientName, 8},
+		{"value", []byte{0x10, 0x00, 0x10, 0x00, 0xF0, 0xFF, 0xFF, 0xFF, 'D', 'O', 'E', '^'}, TagPatientName, 0xFFFFFFF0},
+		{"sequence", []byte{0x08, 0x00, 0x15, 0x11, 0x64, 0x00, 0x00, 0x00, 0xFE, 0xFF, 0x00, 0xE0, 0x00, 0x00, 0x00, 0x00}, TagReferencedSeriesSequence, 100},
+	}
+
+	p, _ := NewParser()
+	for _, test := range tests {
+		_, err := p.ParseDataSet(test.data, ImplicitVRLittleEndian)
+		var truncated *TruncatedError
+		if !errors.As(err, &truncated) || !errors.Is(err, ErrBrokenFile) {
+			t.Errorf("%s: expected a TruncatedError, got %v", test.name, err)
+			continue
+		}
+		if truncated.Tag != test.tag || truncated.Length != test.length {
+			t.Errorf("%s: incorrect error %v", test.name, err)
+		}
+	}
+
+}
+
+func TestEncodedLength(t *testing.T) {
+
+	for _, lazy := range []bool{false, true} {
+		var options []func(*Parser) error
+		if lazy {
+			options = append(options, LazySequences())
+		}
+		p, _ := NewParser(options...)
+
+		for _, name := range []string{"examples/IM-0001-0001.dcm", "examples/I_000000.dcm"} {
+			buff, err := os.ReadFile(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			file, err := p.Parse(buff)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the top level elements follow each other up to the end of the file
+			end := file.Elements[0].P
+			for _, elem := range file.Elements {
+				if elem.P != end {
+					t.Errorf("%s: %s at %d, expected %d", name, elem.Tag(), elem.P, end)
+				}
+				end = elem.P + elem.EncodedLength()
+			}
+			if end != uint32(len(buff)) {
+				t.Errorf("%s: elements end at %d, file length %d", name, end, len(buff))
+			}
+		}
+	}
+
+	// a sequence of undefined length, with an item of undefined length
+	data := []byte{
+		0x08, 0x00, 0x15, 0x11, 0xFF, 0xFF, 0xFF, 0xFF,
+		0xFE, 0xFF, 0x00, 0xE0, 0xFF, 0xFF, 0xFF, 0xFF,
+		0x20, 0x00, 0x0E, 0x00, 0x02, 0x00, 0x00, 0x00, '1', 0x00,
+		0xFE, 0xFF, 0x0D, 0xE0, 0x00, 0x00, 0x00, 0x00,
+		0xFE, 0xFF, 0xDD, 0xE0, 0x00, 0x00, 0x00, 0x00,
+		0x10, 0x00, 0x10, 0x00, 0x04, 0x00, 0x00, 0x00, 'D', 'O', 'E', '^',
+	}
+	p, _ := NewParser()
+	file, err := p.ParseDataSet(data, ImplicitVRLittleEndian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sq, _ := file.LookupElementByTag(TagReferencedSeriesSequence)
+	if offset, length := sq.ValueRange(); sq.EncodedLength() != 42 || offset != 8 || length != 34 {
+		t.Errorf("Incorrect sequence length %d, value range %d %d", sq.EncodedLength(), offset, length)
+	}
+	name, _ := file.LookupElementByTag(TagPatientName)
+	if offset, length := name.ValueRange(); name.P != 42 || offset != 50 || length != 4 {
+		t.Errorf("Incorrect patient name position %d, value range %d %d", name.P, offset, length)
+	}
+	if offset, length := (&DicomElement{}).ValueRange(); offset != 0 || length != 0 {
+		t.Errorf("Incorrect value range %d %d of an element not parsed", offset, length)
+	}
+}
@@ -0,0 +1,325 @@
+package dicom
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidPath = errors.New("Invalid element path")
+
+// any item of a sequence
+const anyItem = -1
+
+type pathQuery struct {
+	name string
+	tag  Tag
+	item int
+}
+
+func (q *pathQuery) matches(elem *DicomElement) bool {
+	if q.name != "" {
+		return elem.Name == q.name
+	}
+	return elem.Tag() == q.tag
+}
+
+// Return the elements found at path, ie.
+//
+//	RequestAttributesSequence[0].AccessionNumber
+//	(0040,0275)[*].(0008,0050)
+//
+// Every step is a keyword or a (gggg,eeee) tag. Steps into a sequence take
+// an item index, or * for any item; leaving out the index also matches any
+// item.
+func (file *DicomFile) GetByPath(path string) ([]*DicomElement, error) {
+
+	queries, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	elems := file.findPath(queries)
+	if len(elems) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return elems, nil
+}
+
+func (file *DicomFile) findPath(queries []pathQuery) []*DicomElement {
+	var elems []*DicomElement
+	for i := range file.Elements {
+		elems = append(elems, findPath(&file.Elements[i], queries)...)
+	}
+	return elems
+}
+
+func findPath(elem *DicomElement, queries []pathQuery) []*DicomElement {
+
+	q := queries[0]
+	if !q.matches(elem) {
+		return nil
+	}
+
+	if len(queries) == 1 {
+		return []*DicomElement{elem}
+	}
+
+	seq, err := elem.GetSequence()
+	if err != nil {
+		return nil
+	}
+
+	var found []*DicomElement
+	for i, item := range seq {
+		if q.item != anyItem && q.item != i {
+			continue
+		}
+		for _, child := range item.Elements {
+			found = append(found, findPath(child, queries[1:])...)
+		}
+	}
+
+	return found
+}
+
+func parsePath(path string) ([]pathQuery, error) {
+
+	if path == "" {
+		return nil, ErrInvalidPath
+	}
+
+	steps := strings.Split(path, ".")
+	queries := make([]pathQuery, len(steps))
+
+	for i, step := range steps {
+		q := &queries[i]
+		q.item = anyItem
+
+		if idx := strings.IndexByte(step, '['); idx >= 0 {
+			if i == len(steps)-1 || !strings.HasSuffix(step, "]") {
+				return nil, ErrInvalidPath
+			}
+			if index := step[idx+1 : len(step)-1]; index != "*" {
+				n, err := strconv.Atoi(index)
+				if err != nil || n < 0 {
+					return nil, ErrInvalidPath
+				}
+				q.item = n
+			}
+			step = step[:idx]
+		}
+
+		if strings.HasPrefix(step, "(") {
+			if !strings.HasSuffix(step, ")") || strings.Count(step, ",") != 1 {
+				return nil, ErrInvalidPath
+			}
+			group, element, err := splitTag(step)
+			if err != nil || group < 0 || group > 0xffff || element < 0 || element > 0xffff {
+				return nil, ErrInvalidPath
+			}
+			q.tag = Tag{uint16(group), uint16(element)}
+		} else if step != "" {
+			q.name = step
+		} else {
+			return nil, ErrInvalidPath
+		}
+	}
+
+	return queries, nil
+}
+
+// Set the values of the elements at path, converting the strings to the VR
+// of each element. An element missing from the top level, or from the items
+// the path leads to, is created from the standard dictionary. Items are not
+// created: returns ErrNotFound if the path leads to none.
+func (file *DicomFile) SetByPath(path string, values ...string) error {
+
+	queries, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+
+	last := queries[len(queries)-1]
+	tag := last.tag
+	if last.name != "" {
+		if tag, err = standardParser().LookupTag(last.name); err != nil {
+			return err
+		}
+	}
+
+	if len(queries) == 1 {
+		if i := file.indexOf(tag); i >= 0 {
+			return setFromStrings(&file.Elements[i], values)
+		}
+		elem, err := newElementFromStrings(tag, values)
+		if err != nil {
+			return err
+		}
+		file.insertElement(elem)
+		return nil
+	}
+
+	found := false
+	parent := queries[len(queries)-2]
+	for _, sq := range file.findPath(queries[:len(queries)-1]) {
+		seq, err := sq.GetSequence()
+		if err != nil {
+			return err
+		}
+		for i, item := range seq {
+			if parent.item != anyItem && parent.item != i {
+				continue
+			}
+			found = true
+			if err := item.set(tag, values, sq.IndentLevel+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !found {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Set or create an element of the item
+func (item *Item) set(tag Tag, values []string, level uint8) error {
+
+	for _, elem := range item.Elements {
+		if elem.Tag() == tag {
+			return setFromStrings(elem, values)
+		}
+	}
+
+	elem, err := newElementFromStrings(tag, values)
+	if err != nil {
+		return err
+	}
+	elem.IndentLevel = level
+	item.insertElement(elem)
+
+	return nil
+}
+
+// Insert an element before the first element of the item with a higher tag
+func (item *Item) insertElement(elem *DicomElement) {
+
+	i := 0
+	for i < len(item.Elements) && tagLess(item.Elements[i].Tag(), elem.Tag()) {
+		i++
+	}
+
+	item.Elements = append(item.Elements, nil)
+	copy(item.Elements[i+1:], item.Elements[i:])
+	item.Elements[i] = elem
+}
+
+// Create an element of the standard dictionary with values converted from
+// strings
+func newElementFromStrings(tag Tag, values []string) (*DicomElement, error) {
+
+	entry, err := standardParser().getDictEntry(tag.Group, tag.Element)
+	if err != nil {
+		return nil, err
+	}
+
+	elem := &DicomElement{Group: tag.Group, Element: tag.Element, Name: entry.name, Vr: entry.vr}
+	if err := setFromStrings(elem, values); err != nil {
+		return nil, err
+	}
+
+	return elem, nil
+}
+
+// Set the values of an element from strings, parsed as numbers or tags for
+// binary VRs
+func setFromStrings(elem *DicomElement, values []string) error {
+
+	switch elem.Vr {
+	case "US", "SS", "UL", "SL", "SV", "UV", "OW", "OL", "OV", "UP", "XS":
+		ints := make([]int, len(values))
+		for i, v := range values {
+			n, err := strconv.Atoi(strings.TrimSpace(v))
+			if err != nil {
+				return err
+			}
+			ints[i] = n
+		}
+		return elem.SetInts(ints...)
+	case "FL", "FD", "OF", "OD":
+		floats := make([]float64, len(values))
+		for i, v := range values {
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return err
+			}
+			floats[i] = f
+		}
+		return elem.SetFloats(floats...)
+	case "AT":
+		tags := make([]Tag, len(values))
+		for i, v := range values {
+			tag, err := ParseTag(strings.TrimSpace(v))
+			if err != nil {
+				return err
+			}
+			tags[i] = tag
+		}
+		return elem.SetTags(tags...)
+	}
+
+	if !isStringVR(elem.Vr) {
+		return ErrWrongVR
+	}
+
+	return elem.SetStrings(values...)
+}
+
+// Remove the elements at path, at the top level or from the items of their
+// sequence, and return how many were removed
+func (file *DicomFile) DeleteByPath(path string) (int, error) {
+
+	queries, err := parsePath(path)
+	if err != nil {
+		return 0, err
+	}
+
+	matches := map[*DicomElement]bool{}
+	for _, elem := range file.findPath(queries) {
+		matches[elem] = true
+	}
+
+	removed := 0
+	kept := file.Elements[:0]
+	for i := range file.Elements {
+		if matches[&file.Elements[i]] {
+			removed++
+		} else {
+			kept = append(kept, file.Elements[i])
+		}
+	}
+	file.Elements = kept
+
+	if len(queries) > 1 {
+		for _, sq := range file.findPath(queries[:len(queries)-1]) {
+			seq, _ := sq.GetSequence()
+			for _, item := range seq {
+				elems := item.Elements[:0]
+				for _, elem := range item.Elements {
+					if matches[elem] {
+						removed++
+					} else {
+						elems = append(elems, elem)
+					}
+				}
+				item.Elements = elems
+			}
+		}
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,117 @@
+package dicom
+
+import (
+	"testing"
+)
+
+func TestGetByPath(t *testing.T) {
+
+	parser, _ := NewParser()
+	data, err := parser.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	elems, err := data.GetByPath("RequestAttributesSequence[0].ScheduledProtocolCodeSequence[0].CodeValue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(elems) != 1 || elems[0].Value.(Strings)[0] != "CTCHWCACOR" {
+		t.Errorf("Incorrect elements %v", elems)
+	}
+
+	elems, err = data.GetByPath("(0008,1140)[*].(0008,1150)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(elems) != 1 || elems[0].Value.(Strings)[0] != "1.2.840.10008.5.1.4.1.1.2" {
+		t.Errorf("Incorrect elements %v", elems)
+	}
+
+	elems, err = data.GetByPath("PatientName")
+	if err != nil || len(elems) != 1 {
+		t.Errorf("Incorrect elements %v: %v", elems, err)
+	}
+
+	if _, err := data.GetByPath("RequestAttributesSequence[1].ScheduledProcedureStepDescription"); err != ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+}
+
+func TestParsePathErrors(t *testing.T) {
+
+	for _, path := range []string{"", "a..b", "Sequence[x].b", "PatientName[0]", "(0010,0010", "(0010)", "(ZZZZ,0010)"} {
+		if _, err := parsePath(path); err != ErrInvalidPath {
+			t.Errorf("Expected ErrInvalidPath for %q, got %v", path, err)
+		}
+	}
+
+}
+
+func TestSetByPath(t *testing.T) {
+
+	parser, _ := NewParser()
+	data, err := parser.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := data.SetByPath("PatientID", "ANON123"); err != nil {
+		t.Fatal(err)
+	}
+	if id, _ := data.lookupString(TagPatientID); id != "ANON123" {
+		t.Errorf("Incorrect PatientID %q", id)
+	}
+
+	// created in tag order
+	if err := data.SetByPath("(0010,21C0)", "4"); err != nil {
+		t.Fatal(err)
+	}
+	if i := data.indexOf(TagPregnancyStatus); i < 0 || data.Elements[i].Value.(UInt16s)[0] != 4 || !tagLess(data.Elements[i-1].Tag(), TagPregnancyStatus) {
+		t.Errorf("PregnancyStatus not inserted")
+	}
+
+	if err := data.SetByPath("RequestAttributesSequence[0].ScheduledProtocolCodeSequence[0].CodeMeaning", "Chest"); err != nil {
+		t.Fatal(err)
+	}
+	if err := data.SetByPath("RequestAttributesSequence[*].AccessionNumber", "A1"); err != nil {
+		t.Fatal(err)
+	}
+	if elems, err := data.GetByPath("RequestAttributesSequence[0].AccessionNumber"); err != nil || elems[0].MustGetString() != "A1" {
+		t.Errorf("Incorrect AccessionNumber %v %v", elems, err)
+	}
+
+	if err := data.SetByPath("RequestAttributesSequence[3].AccessionNumber", "A1"); err != ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	if err := data.SetByPath("Rows", "large"); err == nil {
+		t.Error("Expected an error for a non numeric Rows")
+	}
+
+}
+
+func TestDeleteByPath(t *testing.T) {
+
+	parser, _ := NewParser()
+	data, err := parser.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n, err := data.DeleteByPath("AccessionNumber"); err != nil || n != 1 || data.indexOf(TagAccessionNumber) >= 0 {
+		t.Errorf("AccessionNumber not deleted: %d %v", n, err)
+	}
+
+	if n, err := data.DeleteByPath("(0040,0275)[*].(0040,1001)"); err != nil || n != 1 {
+		t.Errorf("Nested RequestedProcedureID not deleted: %d %v", n, err)
+	}
+	if _, err := data.GetByPath("(0040,0275)[*].(0040,1001)"); err != ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+	if n, _ := data.DeleteByPath("AccessionNumber"); n != 0 {
+		t.Errorf("Deleted %d missing elements", n)
+	}
+
+}
@@ -0,0 +1,189 @@
+package dicom
+
+import (
+	"context"
+	"sync"
+)
+
+// A stage of a Pipeline. Process reads the elements of in, and sends the
+// elements to pass on to the channel it returns, which it closes once in is
+// closed. Stages must keep reading in until it is closed or ctx is done,
+// send with SendElement so that they stop when ctx is done, and report
+// errors with AbortPipeline.
+type Stage interface {
+	Process(ctx context.Context, in <-chan *DicomElement) <-chan *DicomElement
+}
+
+// A Stage processing elements one at a time, returning the element to pass
+// on, or nil to drop it
+type ElementFunc func(elem *DicomElement) (*DicomElement, error)
+
+func (fn ElementFunc) Process(ctx context.Context, in <-chan *DicomElement) <-chan *DicomElement {
+
+	out := make(chan *DicomElement)
+	go func() {
+		defer close(out)
+		for elem := range in {
+			elem, err := fn(elem)
+			if err != nil {
+				AbortPipeline(ctx, err)
+				return
+			}
+			if elem != nil && !SendElement(ctx, out, elem) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Return a Stage sending every element to each of branches, ie. to export
+// them, before passing it on. The elements out of the branches are
+// discarded. Elements are passed on once every branch has accepted them, so
+// that the slowest branch sets the pace of the pipeline.
+func FanOut(branches ...Stage) Stage {
+	return fanOut(branches)
+}
+
+type fanOut []Stage
+
+func (branches fanOut) Process(ctx context.Context, in <-chan *DicomElement) <-chan *DicomElement {
+
+	out := make(chan *DicomElement)
+	ins := make([]chan *DicomElement, len(branches))
+	var drained sync.WaitGroup
+	for i, branch := range branches {
+		ins[i] = make(chan *DicomElement)
+		drained.Add(1)
+		go func(c <-chan *DicomElement) {
+			for range c {
+			}
+			drained.Done()
+		}(branch.Process(ctx, ins[i]))
+	}
+
+	go func() {
+		defer close(out)
+		defer drained.Wait()
+		defer func() {
+			for _, c := range ins {
+				close(c)
+			}
+		}()
+		for elem := range in {
+			for _, c := range ins {
+				if !SendElement(ctx, c, elem) {
+					return
+				}
+			}
+			if !SendElement(ctx, out, elem) {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// A source of the elements of a Pipeline, sending them to out until done or
+// ctx is done
+type Source func(ctx context.Context, out chan<- *DicomElement) error
+
+// Return a Source of the elements parsed from buff, including the items and
+// delimiters, as they are emitted by the parser. Parsing stops when ctx is
+// done.
+func ParseSource(p *Parser, buff []byte) Source {
+	return func(ctx context.Context, out chan<- *DicomElement) error {
+		_, err := p.parse(buff, &DicomFile{}, func(elem *DicomElement) error {
+			if !SendElement(ctx, out, elem) {
+				return ctx.Err()
+			}
+			return nil
+		})
+		return err
+	}
+}
+
+// Return a Source of the elements of a file, in the order of Walk. The
+// elements are those of the file, which stages may modify in place.
+func FileSource(file *DicomFile) Source {
+	return func(ctx context.Context, out chan<- *DicomElement) error {
+		return file.Walk(func(_ TagPath, elem *DicomElement) error {
+			if !SendElement(ctx, out, elem) {
+				return ctx.Err()
+			}
+			return nil
+		})
+	}
+}
+
+// A chain of stages, each processing the elements passed on by the previous
+// one. Channels are unbuffered, so that a slow stage holds back the stages
+// before it and the source.
+type Pipeline struct {
+	stages []Stage
+}
+
+func NewPipeline(stages ...Stage) *Pipeline {
+	return &Pipeline{stages}
+}
+
+type pipelineKey struct{}
+
+// Run the elements of source through the stages, calling sink with every
+// element out of the last stage. The pipeline stops at the first error of
+// the source, a stage or sink, or when ctx is done, and Run returns that
+// error once the source has returned and the last stage has closed its
+// output.
+func (pl *Pipeline) Run(ctx context.Context, source Source, sink func(*DicomElement) error) error {
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	ctx = context.WithValue(ctx, pipelineKey{}, cancel)
+
+	in := make(chan *DicomElement)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer close(in)
+		if err := source(ctx, in); err != nil {
+			cancel(err)
+		}
+	}()
+
+	var out <-chan *DicomElement = in
+	for _, stage := range pl.stages {
+		out = stage.Process(ctx, out)
+	}
+
+	for elem := range out {
+		if ctx.Err() != nil {
+			continue
+		}
+		if err := sink(elem); err != nil {
+			cancel(err)
+		}
+	}
+	<-done
+
+	return context.Cause(ctx)
+}
+
+// Stop the pipeline running with ctx, which then returns err, unless it has
+// already stopped
+func AbortPipeline(ctx context.Context, err error) {
+	if cancel, ok := ctx.Value(pipelineKey{}).(context.CancelCauseFunc); ok {
+		cancel(err)
+	}
+}
+
+// Send elem to out, unless ctx is done first. Returns whether elem was sent.
+func SendElement(ctx context.Context, out chan<- *DicomElement, elem *DicomElement) bool {
+	select {
+	case out <- elem:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,96 @@
+package dicom
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestPipeline(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// count the elements of group 0x0010, and keep the others
+	patient := 0
+	count := ElementFunc(func(elem *DicomElement) (*DicomElement, error) {
+		if elem.Group == 0x0010 {
+			patient++
+		}
+		return elem, nil
+	})
+	dropPatient := ElementFunc(func(elem *DicomElement) (*DicomElement, error) {
+		if elem.Group == 0x0010 {
+			return nil, nil
+		}
+		return elem, nil
+	})
+
+	var kept []*DicomElement
+	pl := NewPipeline(FanOut(count), dropPatient)
+	err = pl.Run(context.Background(), FileSource(file), func(elem *DicomElement) error {
+		kept = append(kept, elem)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	walked := 0
+	file.Walk(func(TagPath, *DicomElement) error {
+		walked++
+		return nil
+	})
+	if patient == 0 || len(kept) != walked-patient {
+		t.Errorf("Expected %d patient elements out of %d, got %d kept", patient, walked, len(kept))
+	}
+	for _, elem := range kept {
+		if elem.Group == 0x0010 {
+			t.Errorf("Unexpected %s", elem.Tag())
+		}
+	}
+}
+
+func TestPipelineErrors(t *testing.T) {
+
+	errStage := errors.New("stage")
+	failing := ElementFunc(func(elem *DicomElement) (*DicomElement, error) {
+		if elem.Tag() == TagModality {
+			return nil, errStage
+		}
+		return elem, nil
+	})
+	pl := NewPipeline(failing, FanOut(ElementFunc(func(elem *DicomElement) (*DicomElement, error) {
+		return elem, nil
+	})))
+
+	p, _ := NewParser()
+	sinkErr := errors.New("sink")
+	for name, test := range map[string]struct {
+		source   Source
+		sink     func(*DicomElement) error
+		expected error
+	}{
+		"stage":  {ParseSource(p, readFile()), func(*DicomElement) error { return nil }, errStage},
+		"sink":   {ParseSource(p, readFile()), func(*DicomElement) error { return sinkErr }, sinkErr},
+		"source": {ParseSource(p, readFile()[:200]), func(*DicomElement) error { return nil }, ErrBrokenFile},
+	} {
+		if err := pl.Run(context.Background(), test.source, test.sink); !errors.Is(err, test.expected) {
+			t.Errorf("%s: expected %v, got %v", name, test.expected, err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := NewPipeline().Run(ctx, ParseSource(p, readFile()), func(*DicomElement) error { return nil }); err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+
+	// the parse stops at the first element not sent
+	if err := ParseSource(p, readFile())(ctx, make(chan *DicomElement)); err != context.Canceled {
+		t.Errorf("Expected the parse to stop with context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ErrInvalidPrivateBlock = errors.New("Invalid private block")
+	ErrPrivateBlockInUse   = errors.New("Private block in use")
+	ErrNoFreePrivateBlock  = errors.New("No free private block")
+)
+
+// Range of the private blocks of a group (PS 3.5 7.8.1): the block bb holds
+// the elements (gggg,bbxx) reserved by the Private Creator (gggg,00bb)
+const (
+	firstPrivateBlock = 0x10
+	lastPrivateBlock  = 0xFF
+)
+
+// Reports whether a tag is the one of a Private Creator element
+func isPrivateCreator(tag Tag) bool {
+	return isPrivateGroup(tag.Group) && tag.Element >= firstPrivateBlock && tag.Element <= lastPrivateBlock
+}
+
+// Odd groups other than 0001, 0003, 0005, 0007 and FFFF are private
+func isPrivateGroup(group uint16) bool {
+	return group%2 == 1 && group > 0x0008 && group != 0xFFFF
+}
+
+// The Private Creators of the top level blocks of a group, by block
+func (file *DicomFile) privateCreators(group uint16) map[uint8]string {
+	creators := map[uint8]string{}
+	for i := range file.Elements {
+		elem := &file.Elements[i]
+		if elem.Group == group && isPrivateCreator(elem.Tag()) {
+			creators[uint8(elem.Element)], _ = elem.GetString()
+		}
+	}
+	return creators
+}
+
+// Look up the top level private block of a group reserved by creator
+func (file *DicomFile) PrivateBlock(group uint16, creator string) (uint8, bool) {
+	for block, c := range file.privateCreators(group) {
+		if c == creator {
+			return block, true
+		}
+	}
+	return 0, false
+}
+
+// Move the top level private block from of a group to the block to, ie.
+// (0029,10xx) to (0029,11xx), along with its Private Creator. Values are
+// kept as they are. Returns ErrPrivateBlockInUse if the block to holds a
+// Private Creator or elements.
+func (file *DicomFile) MovePrivateBlock(group uint16, from, to uint8) error {
+
+	if !isPrivateGroup(group) || from < firstPrivateBlock || to < firstPrivateBlock {
+		return fmt.Errorf("%w: (%04X,%02X00) to (%04X,%02X00)", ErrInvalidPrivateBlock, group, from, group, to)
+	}
+	if from == to {
+		return nil
+	}
+
+	for i := range file.Elements {
+		elem := &file.Elements[i]
+		if elem.Group == group && (elem.Element == uint16(to) || elem.Element>>8 == uint16(to)) {
+			return fmt.Errorf("%w: (%04X,%02X00)", ErrPrivateBlockInUse, group, to)
+		}
+	}
+
+	file.remapPrivateBlocks(group, map[uint8]uint8{from: to})
+	return nil
+}
+
+// Move the top level private blocks of other that conflict with those of the
+// file, so that other can be merged into the file without mixing up the
+// elements of different Private Creators. The blocks of other are moved to
+// the block of the same Private Creator in the file, or else to a block free
+// in both files. Call before Merge.
+func (file *DicomFile) RelocatePrivateBlocks(other *DicomFile) error {
+
+	groups := map[uint16]bool{}
+	for i := range other.Elements {
+		if tag := other.Elements[i].Tag(); isPrivateCreator(tag) {
+			groups[tag.Group] = true
+		}
+	}
+
+	for group := range groups {
+		ours, theirs := file.privateCreators(group), other.privateCreators(group)
+
+		blocks := make([]int, 0, len(theirs))
+		for block := range theirs {
+			blocks = append(blocks, int(block))
+		}
+		sort.Ints(blocks)
+
+		// blocks of the file, and blocks of other which are not moved
+		used := map[uint8]bool{}
+		for block := range ours {
+			used[block] = true
+		}
+		mapping := map[uint8]uint8{}
+		for _, b := range blocks {
+			block := uint8(b)
+			if ours[block] == theirs[block] {
+				mapping[block] = block
+			} else if _, ok := ours[block]; !ok {
+				mapping[block] = block
+				used[block] = true
+			}
+		}
+
+		for _, b := range blocks {
+			block := uint8(b)
+			if _, ok := mapping[block]; ok {
+				continue
+			}
+			if target, ok := file.PrivateBlock(group, theirs[block]); ok {
+				mapping[block] = target
+				continue
+			}
+			target, ok := freePrivateBlock(used, theirs, mapping)
+			if !ok {
+				return fmt.Errorf("%w: group %04X", ErrNoFreePrivateBlock, group)
+			}
+			mapping[block] = target
+			used[target] = true
+		}
+
+		other.remapPrivateBlocks(group, mapping)
+	}
+
+	return nil
+}
+
+// The first block neither used, nor reserved in other by a block that is not
+// moved away
+func freePrivateBlock(used map[uint8]bool, theirs map[uint8]string, mapping map[uint8]uint8) (uint8, bool) {
+	for b := firstPrivateBlock; b <= lastPrivateBlock; b++ {
+		block := uint8(b)
+		if used[block] {
+			continue
+		}
+		if _, ok := theirs[block]; ok && mapping[block] == block {
+			continue
+		}
+		return block, true
+	}
+	return 0, false
+}
+
+// Change the blocks of the top level elements of a group, keeping the
+// elements sorted by tag
+func (file *DicomFile) remapPrivateBlocks(group uint16, mapping map[uint8]uint8) {
+
+	for i := range file.Elements {
+		elem := &file.Elements[i]
+		if elem.Group != group {
+			continue
+		}
+		if isPrivateCreator(elem.Tag()) {
+			if to, ok := mapping[uint8(elem.Element)]; ok {
+				elem.Element = uint16(to)
+			}
+		} else if to, ok := mapping[uint8(elem.Element>>8)]; ok && elem.Element>>8 >= firstPrivateBlock {
+			elem.Element = uint16(to)<<8 | elem.Element&0xFF
+		}
+	}
+
+	sort.SliceStable(file.Elements, func(i, j int) bool {
+		return tagLess(file.Elements[i].Tag(), file.Elements[j].Tag())
+	})
+}
@@ -0,0 +1,99 @@
+package dicom
+
+import (
+	"errors"
+	"testing"
+)
+
+func privateFile(elems ...DicomElement) *DicomFile {
+	return &DicomFile{Elements: elems}
+}
+
+func creator(group, element uint16, name string) DicomElement {
+	return DicomElement{Group: group, Element: element, Vr: "LO", Value: Strings{name}}
+}
+
+func privateElement(group, element uint16, value string) DicomElement {
+	return DicomElement{Group: group, Element: element, Vr: "LO", Value: Strings{value}}
+}
+
+func TestMovePrivateBlock(t *testing.T) {
+
+	file := privateFile(
+		creator(0x0029, 0x0010, "SIEMENS CSA HEADER"),
+		creator(0x0029, 0x0012, "OTHER"),
+		privateElement(0x0029, 0x1008, "IMAGE NUM 4"),
+		privateElement(0x0029, 0x1201, "other"),
+	)
+
+	if err := file.MovePrivateBlock(0x0029, 0x10, 0x12); !errors.Is(err, ErrPrivateBlockInUse) {
+		t.Errorf("Incorrect error %v", err)
+	}
+	if err := file.MovePrivateBlock(0x0028, 0x10, 0x11); !errors.Is(err, ErrInvalidPrivateBlock) {
+		t.Errorf("Incorrect error %v", err)
+	}
+
+	if err := file.MovePrivateBlock(0x0029, 0x10, 0x11); err != nil {
+		t.Fatal(err)
+	}
+	if block, ok := file.PrivateBlock(0x0029, "SIEMENS CSA HEADER"); !ok || block != 0x11 {
+		t.Errorf("Incorrect block %02X", block)
+	}
+	elem, err := file.LookupElementByTag(Tag{0x0029, 0x1108})
+	if err != nil || elem.MustGetString() != "IMAGE NUM 4" {
+		t.Errorf("Element not moved: %v", err)
+	}
+	for i := 1; i < len(file.Elements); i++ {
+		if !tagLess(file.Elements[i-1].Tag(), file.Elements[i].Tag()) {
+			t.Errorf("Elements not sorted: %s after %s", file.Elements[i].Tag(), file.Elements[i-1].Tag())
+		}
+	}
+}
+
+func TestRelocatePrivateBlocks(t *testing.T) {
+
+	file := privateFile(
+		creator(0x0029, 0x0010, "SIEMENS CSA HEADER"),
+		creator(0x0029, 0x0011, "ACME"),
+		privateElement(0x0029, 0x1008, "siemens"),
+		privateElement(0x0029, 0x1101, "acme"),
+	)
+	other := privateFile(
+		creator(0x0029, 0x0010, "ACME"),
+		creator(0x0029, 0x0011, "GEMS"),
+		creator(0x0029, 0x0012, "PHILIPS"),
+		privateElement(0x0029, 0x1002, "acme 2"),
+		privateElement(0x0029, 0x1101, "gems"),
+		privateElement(0x0029, 0x1201, "philips"),
+	)
+
+	if err := file.RelocatePrivateBlocks(other); err != nil {
+		t.Fatal(err)
+	}
+	if err := file.Merge(other, MergeErrorOnConflict); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []struct {
+		tag   Tag
+		value string
+	}{
+		{Tag{0x0029, 0x0010}, "SIEMENS CSA HEADER"},
+		{Tag{0x0029, 0x0011}, "ACME"},
+		{Tag{0x0029, 0x0012}, "PHILIPS"},
+		{Tag{0x0029, 0x0013}, "GEMS"},
+		{Tag{0x0029, 0x1008}, "siemens"},
+		{Tag{0x0029, 0x1101}, "acme"},
+		{Tag{0x0029, 0x1102}, "acme 2"},
+		{Tag{0x0029, 0x1201}, "philips"},
+		{Tag{0x0029, 0x1301}, "gems"},
+	} {
+		elem, err := file.LookupElementByTag(v.tag)
+		if err != nil || elem.MustGetString() != v.value {
+			t.Errorf("Incorrect %s: %v", v.tag, err)
+		}
+	}
+	if len(file.Elements) != 9 {
+		t.Errorf("Incorrect number of elements %d", len(file.Elements))
+	}
+}
@@ -0,0 +1,34 @@
+package dicom
+
+import (
+	"context"
+	"runtime/pprof"
+	"sync/atomic"
+)
+
+var profileLabels int32
+
+// Label the samples of CPU profiles taken while files are parsed or written
+// with dicom=parse or dicom=write, to tell them apart from the rest of a
+// program. Disabled by default.
+func SetProfileLabels(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&profileLabels, v)
+}
+
+// Run fn, with the profiler label dicom=op if enabled
+func profile(op string, fn func(ctx context.Context) error) error {
+
+	if atomic.LoadInt32(&profileLabels) == 0 {
+		return fn(context.Background())
+	}
+
+	var err error
+	pprof.Do(context.Background(), pprof.Labels("dicom", op), func(ctx context.Context) {
+		err = fn(ctx)
+	})
+	return err
+}
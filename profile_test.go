@@ -0,0 +1,30 @@
+package dicom
+
+import (
+	"context"
+	"runtime/pprof"
+	"testing"
+)
+
+func TestProfileLabels(t *testing.T) {
+
+	var label string
+	fn := func(ctx context.Context) error {
+		label, _ = pprof.Label(ctx, "dicom")
+		return nil
+	}
+
+	profile("parse", fn)
+	if label != "" {
+		t.Errorf("Labels should be disabled by default, got %q", label)
+	}
+
+	SetProfileLabels(true)
+	defer SetProfileLabels(false)
+
+	profile("parse", fn)
+	if label != "parse" {
+		t.Errorf("Incorrect label %q", label)
+	}
+
+}
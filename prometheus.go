@@ -0,0 +1,161 @@
+package dicom
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The upper bounds of the histogram buckets of PrometheusMetrics, in seconds
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Metrics kept in memory and exposed in the Prometheus text format, ie.
+//
+//	metrics := dicom.NewPrometheusMetrics(dicom.DefaultBuckets)
+//	dicom.SetMetrics(metrics)
+//	http.Handle("/metrics", metrics)
+type PrometheusMetrics struct {
+	buckets    []float64
+	mu         sync.Mutex
+	counters   map[string]map[string]float64 // by name and labels
+	histograms map[string]map[string]*histogram
+}
+
+type histogram struct {
+	counts []uint64 // by bucket, not cumulated
+	count  uint64
+	sum    float64
+}
+
+// Create metrics whose histograms have buckets with the upper bounds given
+func NewPrometheusMetrics(buckets []float64) *PrometheusMetrics {
+	bounds := append([]float64(nil), buckets...)
+	sort.Float64s(bounds)
+	return &PrometheusMetrics{
+		buckets:    bounds,
+		counters:   map[string]map[string]float64{},
+		histograms: map[string]map[string]*histogram{},
+	}
+}
+
+func (m *PrometheusMetrics) Count(name string, labels Labels, delta float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.counters[name] == nil {
+		m.counters[name] = map[string]float64{}
+	}
+	m.counters[name][formatLabels(labels)] += delta
+}
+
+func (m *PrometheusMetrics) Observe(name string, labels Labels, value float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.histograms[name] == nil {
+		m.histograms[name] = map[string]*histogram{}
+	}
+	key := formatLabels(labels)
+	h := m.histograms[name][key]
+	if h == nil {
+		h = &histogram{counts: make([]uint64, len(m.buckets))}
+		m.histograms[name][key] = h
+	}
+	if i := sort.SearchFloat64s(m.buckets, value); i < len(m.buckets) {
+		h.counts[i]++
+	}
+	h.count++
+	h.sum += value
+}
+
+// Write the metrics in the Prometheus text format
+func (m *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cw := &countWriter{w: w}
+	bw := bufio.NewWriter(cw)
+
+	for _, name := range sortedKeys(m.counters) {
+		fmt.Fprintf(bw, "# TYPE %s counter\n", name)
+		for _, labels := range sortedKeys(m.counters[name]) {
+			fmt.Fprintf(bw, "%s%s %s\n", name, braces(labels), formatFloat(m.counters[name][labels]))
+		}
+	}
+
+	for _, name := range sortedKeys(m.histograms) {
+		fmt.Fprintf(bw, "# TYPE %s histogram\n", name)
+		for _, labels := range sortedKeys(m.histograms[name]) {
+			h := m.histograms[name][labels]
+			var cumulated uint64
+			for i, bound := range m.buckets {
+				cumulated += h.counts[i]
+				fmt.Fprintf(bw, "%s_bucket%s %d\n", name, braces(joinLabels(labels, `le="`+formatFloat(bound)+`"`)), cumulated)
+			}
+			fmt.Fprintf(bw, "%s_bucket%s %d\n", name, braces(joinLabels(labels, `le="+Inf"`)), h.count)
+			fmt.Fprintf(bw, "%s_sum%s %s\n", name, braces(labels), formatFloat(h.sum))
+			fmt.Fprintf(bw, "%s_count%s %d\n", name, braces(labels), h.count)
+		}
+	}
+
+	err := bw.Flush()
+	return cw.n, err
+}
+
+// Serve the metrics in the Prometheus text format
+func (m *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	m.WriteTo(w)
+}
+
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countWriter) Write(b []byte) (int, error) {
+	n, err := cw.w.Write(b)
+	cw.n += int64(n)
+	return n, err
+}
+
+// Format labels as name="value" pairs separated by commas, sorted by name
+func formatLabels(labels Labels) string {
+	pairs := make([]string, 0, len(labels))
+	for name, value := range labels {
+		pairs = append(pairs, name+"="+strconv.Quote(value))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func joinLabels(labels, label string) string {
+	if labels == "" {
+		return label
+	}
+	return labels + "," + label
+}
+
+func braces(labels string) string {
+	if labels == "" {
+		return ""
+	}
+	return "{" + labels + "}"
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
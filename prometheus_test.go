@@ -0,0 +1,37 @@
+package dicom
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPrometheusMetrics(t *testing.T) {
+
+	m := NewPrometheusMetrics([]float64{1, 0.1})
+	m.Count("requests_total", Labels{"status": "ok", "method": "GET"}, 1)
+	m.Count("requests_total", Labels{"method": "GET", "status": "ok"}, 2)
+	m.Count("requests_total", Labels{"method": "GET", "status": `a "b"`}, 1)
+	m.Observe("duration_seconds", nil, 0.05)
+	m.Observe("duration_seconds", nil, 0.5)
+	m.Observe("duration_seconds", nil, 5)
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	expected := `# TYPE requests_total counter
+requests_total{method="GET",status="a \"b\""} 1
+requests_total{method="GET",status="ok"} 3
+# TYPE duration_seconds histogram
+duration_seconds_bucket{le="0.1"} 1
+duration_seconds_bucket{le="1"} 2
+duration_seconds_bucket{le="+Inf"} 3
+duration_seconds_sum 5.55
+duration_seconds_count 3
+`
+	if body := rec.Body.String(); body != expected {
+		t.Errorf("Expected\n%s\ngot\n%s", expected, body)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "text/plain; version=0.0.4" {
+		t.Errorf("Incorrect content type %s", ct)
+	}
+}
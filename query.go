@@ -0,0 +1,86 @@
+package dicom
+
+// Build the identifier of a C-FIND response to query from a matching file
+// (PS 3.4 C.4.1.1.3.2). Every key of the query is returned, with the value
+// of the file, or empty when the file does not have it. Sequence keys with
+// an item return the keys of that item from every item of the sequence, and
+// without one the whole sequence. QueryRetrieveLevel is copied from the
+// query, and SpecificCharacterSet from the file.
+func BuildResponse(file, query *DicomFile) *DicomFile {
+
+	resp := &DicomFile{}
+
+	for i := range query.Elements {
+		key := &query.Elements[i]
+		switch key.Tag() {
+		case TagQueryRetrieveLevel, TagSpecificCharacterSet:
+			continue
+		}
+		if key.Group == 0x0002 {
+			continue
+		}
+
+		var match *DicomElement
+		if j := file.indexOf(key.Tag()); j >= 0 {
+			match = &file.Elements[j]
+		}
+		resp.insertElement(responseElement(key, match))
+	}
+
+	if i := query.indexOf(TagQueryRetrieveLevel); i >= 0 {
+		resp.insertElement(query.Elements[i].Clone())
+	}
+
+	if i := file.indexOf(TagSpecificCharacterSet); i >= 0 {
+		resp.insertElement(file.Elements[i].Clone())
+	}
+
+	return resp
+}
+
+// Return the element answering a key, from the matching element or empty
+// when there is none
+func responseElement(key, match *DicomElement) *DicomElement {
+
+	if match == nil {
+		elem := &DicomElement{
+			Group:   key.Group,
+			Element: key.Element,
+			Name:    key.Name,
+			Vr:      key.Vr,
+		}
+		if key.Vr == "SQ" {
+			elem.Value = Sequence{}
+		}
+		return elem
+	}
+
+	keys, err := key.GetSequence()
+	if err != nil || len(keys) == 0 || len(keys[0].Elements) == 0 {
+		return match.Clone()
+	}
+
+	seq, err := match.GetSequence()
+	if err != nil {
+		return match.Clone()
+	}
+
+	resp := *match
+	items := make(Sequence, len(seq))
+	for i, item := range seq {
+		items[i] = &Item{}
+		for _, k := range keys[0].Elements {
+			var m *DicomElement
+			for _, elem := range item.Elements {
+				if elem.Tag() == k.Tag() {
+					m = elem
+					break
+				}
+			}
+			items[i].Elements = append(items[i].Elements, responseElement(k, m))
+		}
+	}
+	resp.Value = items
+
+	return &resp
+}
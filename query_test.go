@@ -0,0 +1,56 @@
+package dicom
+
+import (
+	"testing"
+)
+
+func TestBuildResponse(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newKey := func(tag Tag, value Value) DicomElement {
+		elem, err := p.NewElement(tag, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return *elem
+	}
+
+	codeValue := newKey(TagCodeValue, nil)
+	request := newKey(TagRequestAttributesSequence, Sequence{
+		&Item{[]*DicomElement{&codeValue}},
+	})
+
+	query := &DicomFile{Elements: []DicomElement{
+		newKey(TagQueryRetrieveLevel, Strings{"IMAGE"}),
+		newKey(TagPatientName, nil),
+		newKey(TagPatientBirthName, nil),
+		newKey(TagReferencedImageSequence, Sequence{}),
+		request,
+	}}
+
+	resp := BuildResponse(file, query)
+
+	if len(resp.Elements) != 5 {
+		t.Errorf("Incorrect number of elements %d", len(resp.Elements))
+	}
+
+	for _, path := range []string{"QueryRetrieveLevel", "PatientName", "ReferencedImageSequence[0].ReferencedSOPClassUID", "RequestAttributesSequence[0].CodeValue"} {
+		if _, err := resp.GetByPath(path); err != nil {
+			t.Errorf("%s missing from the response", path)
+		}
+	}
+
+	if elems, _ := resp.GetByPath("RequestAttributesSequence"); len(elems) != 1 || len(elems[0].MustGetSequence()[0].Elements) != 1 {
+		t.Errorf("Only the requested keys of a sequence should be returned")
+	}
+
+	if elem, err := resp.LookupElementByTag(TagPatientBirthName); err != nil || elem.Len() != 0 {
+		t.Errorf("Expected an empty PatientBirthName, got %v", elem)
+	}
+
+}
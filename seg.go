@@ -0,0 +1,328 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidSegmentation = errors.New("Invalid segmentation")
+
+// A segment of a segmentation (PS 3.3 C.8.20.2)
+type Segment struct {
+	Label     string
+	Category  Code   // the segmented property category, ie. {"123037004", "SCT", "Anatomical Structure"}
+	Type      Code   // the segmented property type, ie. {"10200004", "SCT", "Liver"}
+	Algorithm string // the name of the algorithm, empty for manual segments
+}
+
+// A labeled mask of a series of single frame images, written as a BINARY
+// Segmentation. Labels holds the segment numbers of the pixels of each
+// image, row after row: 1 for the first of Segments, and 0 for none.
+type Segmentation struct {
+	Segments    []Segment
+	Labels      [][]uint8
+	Description string
+
+	// The equipment creating the segmentation, ie. a model
+	Manufacturer     string
+	ModelName        string
+	SerialNumber     string
+	SoftwareVersions string
+}
+
+// Build the Segmentation of the source images, which must be the single
+// frame images of a series labeled by seg.Labels, in a new series of their
+// study. Every segment has a frame for each source image it is found in,
+// referencing the source image in its Derivation Image Sequence, and
+// indexed by segment number and position.
+func (seg *Segmentation) Build(sources []*DicomFile) (*DicomFile, error) {
+
+	if len(sources) == 0 || len(seg.Labels) != len(sources) {
+		return nil, fmt.Errorf("%w: %d labels for %d images", ErrInvalidSegmentation, len(seg.Labels), len(sources))
+	}
+	if len(seg.Segments) == 0 || len(seg.Segments) > 255 {
+		return nil, fmt.Errorf("%w: %d segments", ErrInvalidSegmentation, len(seg.Segments))
+	}
+
+	rows, _, err := sources[0].lookupInt(TagRows)
+	if err != nil {
+		return nil, err
+	}
+	columns, _, err := sources[0].lookupInt(TagColumns)
+	if err != nil {
+		return nil, err
+	}
+	series, err := sources[0].lookupString(TagSeriesInstanceUID)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]Reference, len(sources))
+	for i, source := range sources {
+		if refs[i], err = ReferenceTo(source); err != nil {
+			return nil, err
+		}
+		if refs[i].SeriesInstanceUID != series {
+			return nil, fmt.Errorf("%w: images of several series", ErrInvalidSegmentation)
+		}
+		r, _, _ := source.lookupInt(TagRows)
+		c, _, _ := source.lookupInt(TagColumns)
+		if r != rows || c != columns || len(seg.Labels[i]) != rows*columns {
+			return nil, fmt.Errorf("%w: %d labels of a %dx%d image", ErrInvalidSegmentation, len(seg.Labels[i]), c, r)
+		}
+		for _, label := range seg.Labels[i] {
+			if int(label) > len(seg.Segments) {
+				return nil, fmt.Errorf("%w: no segment %d", ErrInvalidSegmentation, label)
+			}
+		}
+	}
+
+	file, err := newInstance(sources[0], SegmentationStorage, "SEG")
+	if err != nil {
+		return nil, err
+	}
+
+	// the frames of each segment, packed bit after bit across frames
+	size := rows * columns
+	var frames Sequence
+	var pixels Bytes
+	for n := range seg.Segments {
+		for i, labels := range seg.Labels {
+			found := false
+			for _, label := range labels {
+				found = found || int(label) == n+1
+			}
+			if !found {
+				continue
+			}
+
+			first := len(frames) * size
+			if need := (first + size + 7) / 8; need > len(pixels) {
+				pixels = append(pixels, make(Bytes, need-len(pixels))...)
+			}
+			for p, label := range labels {
+				if int(label) == n+1 {
+					pixels[(first+p)/8] |= 1 << uint((first+p)%8)
+				}
+			}
+
+			frame, err := newFrameGroups(sources[i], refs[i], n+1, i+1)
+			if err != nil {
+				return nil, err
+			}
+			frames = append(frames, frame)
+		}
+	}
+	if len(frames) == 0 {
+		return nil, fmt.Errorf("%w: no labeled pixels", ErrInvalidSegmentation)
+	}
+	if len(pixels)%2 != 0 {
+		pixels = append(pixels, 0)
+	}
+
+	segments := Sequence{}
+	for n, segment := range seg.Segments {
+		item, err := newSegmentItem(segment, n+1)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, item)
+	}
+
+	referenced := Sequence{}
+	for _, ref := range refs {
+		item, err := newLevelItem([]itemValue{
+			{TagReferencedSOPClassUID, Strings{ref.SOPClassUID}},
+			{TagReferencedSOPInstanceUID, Strings{ref.SOPInstanceUID}},
+		}, 2)
+		if err != nil {
+			return nil, err
+		}
+		referenced = append(referenced, item)
+	}
+	seriesItem, err := newItem([]itemValue{
+		{TagReferencedInstanceSequence, referenced},
+		{TagSeriesInstanceUID, Strings{series}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	organization := newUID()
+	organizationItem, err := newItem([]itemValue{{TagDimensionOrganizationUID, Strings{organization}}})
+	if err != nil {
+		return nil, err
+	}
+	dimensions := Sequence{}
+	for _, dim := range []struct {
+		index, group Tag
+		label        string
+	}{
+		{TagReferencedSegmentNumber, TagSegmentIdentificationSequence, "Segment"},
+		{TagImagePositionPatient, TagPlanePositionSequence, "Position"},
+	} {
+		item, err := newItem([]itemValue{
+			{TagDimensionOrganizationUID, Strings{organization}},
+			{TagDimensionIndexPointer, Tags{dim.index}},
+			{TagFunctionalGroupPointer, Tags{dim.group}},
+			{TagDimensionDescriptionLabel, Strings{dim.label}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		dimensions = append(dimensions, item)
+	}
+
+	// the geometry of the source images, but their positions
+	shared := &Item{}
+	for _, group := range []struct {
+		tag  Tag
+		tags []Tag
+	}{
+		{TagPlaneOrientationSequence, []Tag{TagImageOrientationPatient}},
+		{TagPixelMeasuresSequence, []Tag{TagSliceThickness, TagPixelSpacing}},
+	} {
+		elem, err := standardParser().NewElement(group.tag, Sequence{copyItem(sources[0], group.tags, 2)})
+		if err != nil {
+			return nil, err
+		}
+		elem.IndentLevel = 1
+		shared.Elements = append(shared.Elements, elem)
+	}
+
+	frameOfReference := copyItem(sources[0], []Tag{TagFrameOfReferenceUID, TagPositionReferenceIndicator}, 0)
+	for _, elem := range frameOfReference.Elements {
+		file.insertElement(elem)
+	}
+	if file.indexOf(TagPositionReferenceIndicator) < 0 {
+		if err := file.setValue(TagPositionReferenceIndicator, Strings{}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, attr := range []itemValue{
+		{TagImageType, Strings{"DERIVED", "PRIMARY"}},
+		{TagManufacturer, Strings{seg.Manufacturer}},
+		{TagManufacturerModelName, Strings{seg.ModelName}},
+		{TagDeviceSerialNumber, Strings{seg.SerialNumber}},
+		{TagSoftwareVersions, Strings{seg.SoftwareVersions}},
+		{TagReferencedSeriesSequence, Sequence{seriesItem}},
+		{TagDimensionOrganizationSequence, Sequence{organizationItem}},
+		{TagDimensionIndexSequence, dimensions},
+		{TagSamplesPerPixel, UInt16s{1}},
+		{TagPhotometricInterpretation, Strings{"MONOCHROME2"}},
+		{TagNumberOfFrames, Strings{fmt.Sprint(len(frames))}},
+		{TagRows, UInt16s{uint16(rows)}},
+		{TagColumns, UInt16s{uint16(columns)}},
+		{TagBitsAllocated, UInt16s{1}},
+		{TagBitsStored, UInt16s{1}},
+		{TagHighBit, UInt16s{0}},
+		{TagPixelRepresentation, UInt16s{0}},
+		{TagLossyImageCompression, Strings{"00"}},
+		{TagSegmentationType, Strings{"BINARY"}},
+		{TagSegmentSequence, segments},
+		{TagContentLabel, Strings{"SEGMENTATION"}},
+		{TagContentDescription, Strings{seg.Description}},
+		{TagContentCreatorName, Strings{}},
+		{TagSharedFunctionalGroupsSequence, Sequence{shared}},
+		{TagPerFrameFunctionalGroupsSequence, frames},
+		{TagPixelData, pixels},
+	} {
+		if err := file.setValue(attr.tag, attr.value); err != nil {
+			return nil, err
+		}
+	}
+
+	return file, nil
+}
+
+// Create the item of a segment of the Segment Sequence
+func newSegmentItem(segment Segment, number int) (*Item, error) {
+
+	category, err := newCodeItem(segment.Category, 2)
+	if err != nil {
+		return nil, err
+	}
+	propertyType, err := newCodeItem(segment.Type, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	values := []itemValue{
+		{TagSegmentedPropertyCategoryCodeSequence, Sequence{category}},
+		{TagSegmentNumber, UInt16s{uint16(number)}},
+		{TagSegmentLabel, Strings{segment.Label}},
+	}
+	if segment.Algorithm == "" {
+		values = append(values, itemValue{TagSegmentAlgorithmType, Strings{"MANUAL"}})
+	} else {
+		values = append(values,
+			itemValue{TagSegmentAlgorithmType, Strings{"AUTOMATIC"}},
+			itemValue{TagSegmentAlgorithmName, Strings{segment.Algorithm}})
+	}
+	values = append(values, itemValue{TagSegmentedPropertyTypeCodeSequence, Sequence{propertyType}})
+
+	return newItem(values)
+}
+
+// Create the per frame functional groups of the frame of a segment in a
+// source image, the position-th of the series
+func newFrameGroups(source *DicomFile, ref Reference, segment, position int) (*Item, error) {
+
+	purpose, err := newCodeItem(Code{"121322", "DCM", "Source image for image processing operation"}, 4)
+	if err != nil {
+		return nil, err
+	}
+	sourceItem, err := newLevelItem([]itemValue{
+		{TagReferencedSOPClassUID, Strings{ref.SOPClassUID}},
+		{TagReferencedSOPInstanceUID, Strings{ref.SOPInstanceUID}},
+		{TagPurposeOfReferenceCodeSequence, Sequence{purpose}},
+	}, 3)
+	if err != nil {
+		return nil, err
+	}
+	derivation, err := newCodeItem(Code{"113076", "DCM", "Segmentation"}, 3)
+	if err != nil {
+		return nil, err
+	}
+	derivationItem, err := newLevelItem([]itemValue{
+		{TagSourceImageSequence, Sequence{sourceItem}},
+		{TagDerivationCodeSequence, Sequence{derivation}},
+	}, 2)
+	if err != nil {
+		return nil, err
+	}
+	content, err := newLevelItem([]itemValue{
+		{TagDimensionIndexValues, UInt32s{uint32(segment), uint32(position)}},
+	}, 2)
+	if err != nil {
+		return nil, err
+	}
+	identification, err := newLevelItem([]itemValue{
+		{TagReferencedSegmentNumber, UInt16s{uint16(segment)}},
+	}, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	return newItem([]itemValue{
+		{TagDerivationImageSequence, Sequence{derivationItem}},
+		{TagFrameContentSequence, Sequence{content}},
+		{TagPlanePositionSequence, Sequence{copyItem(source, []Tag{TagImagePositionPatient}, 2)}},
+		{TagSegmentIdentificationSequence, Sequence{identification}},
+	})
+}
+
+// Create an item nested at level with copies of the top level elements of
+// a file, skipping the absent ones
+func copyItem(file *DicomFile, tags []Tag, level uint8) *Item {
+	item := &Item{}
+	for _, tag := range tags {
+		if i := file.indexOf(tag); i >= 0 {
+			elem := file.Elements[i].Clone()
+			elem.IndentLevel = level
+			item.Elements = append(item.Elements, elem)
+		}
+	}
+	return item
+}
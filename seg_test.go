@@ -0,0 +1,150 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// A series of n 2x3 images
+func sourceSeries(t *testing.T, n int) []*DicomFile {
+
+	p, _ := NewParser()
+	study, series, frameOfReference := newUID(), newUID(), newUID()
+	var files []*DicomFile
+	for i := 0; i < n; i++ {
+		file := &DicomFile{}
+		for _, attr := range []struct {
+			tag   Tag
+			value Value
+		}{
+			{TagTransferSyntaxUID, Strings{explicit_vr_little_endian}},
+			{TagSOPClassUID, Strings{CTImageStorage}},
+			{TagSOPInstanceUID, Strings{newUID()}},
+			{TagPatientID, Strings{"SEG1"}},
+			{TagStudyInstanceUID, Strings{study}},
+			{TagSeriesInstanceUID, Strings{series}},
+			{TagImagePositionPatient, Strings{"0", "0", fmt.Sprint(2 * i)}},
+			{TagImageOrientationPatient, Strings{"1", "0", "0", "0", "1", "0"}},
+			{TagFrameOfReferenceUID, Strings{frameOfReference}},
+			{TagRows, UInt16s{2}},
+			{TagColumns, UInt16s{3}},
+			{TagPixelSpacing, Strings{"0.5", "0.5"}},
+			{TagSliceThickness, Strings{"2"}},
+		} {
+			elem, err := p.NewElement(attr.tag, attr.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			file.insertElement(elem)
+		}
+		files = append(files, file)
+	}
+
+	return files
+}
+
+func TestBuildSegmentation(t *testing.T) {
+
+	sources := sourceSeries(t, 3)
+	seg := &Segmentation{
+		Segments: []Segment{
+			{"Liver", Code{"123037004", "SCT", "Anatomical Structure"}, Code{"10200004", "SCT", "Liver"}, "model"},
+			{"Lesion", Code{"49755003", "SCT", "Morphologically Altered Structure"}, Code{"4147007", "SCT", "Mass"}, ""},
+		},
+		Labels: [][]uint8{
+			{1, 1, 0, 0, 0, 0},
+			{0, 0, 0, 0, 0, 0},
+			{1, 0, 2, 0, 2, 1},
+		},
+		Manufacturer: "ACME",
+	}
+
+	lookup := func(file *DicomFile, tag Tag) string {
+		s, _ := file.lookupString(tag)
+		return s
+	}
+
+	file, err := seg.Build(sources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buffer := new(bytes.Buffer)
+	if err := file.Write(buffer); err != nil {
+		t.Fatal(err)
+	}
+	p, _ := NewParser()
+	parsed, err := p.Parse(buffer.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for tag, expected := range map[Tag]string{
+		TagSOPClassUID:         SegmentationStorage,
+		TagModality:            "SEG",
+		TagPatientID:           "SEG1",
+		TagSegmentationType:    "BINARY",
+		TagNumberOfFrames:      "3",
+		TagStudyInstanceUID:    lookup(sources[0], TagStudyInstanceUID),
+		TagFrameOfReferenceUID: lookup(sources[0], TagFrameOfReferenceUID),
+	} {
+		if s, _ := parsed.lookupString(tag); s != expected {
+			t.Errorf("Expected %s %s, got %s", tag, expected, s)
+		}
+	}
+
+	// liver in the first and last images, lesion in the last, 6 bits each
+	pixels := parsed.FindAllByTag(TagPixelData)[0].MustGetBytes()
+	if !bytes.Equal(pixels, []byte{0x43, 0x48, 0x01, 0x00}) {
+		t.Errorf("Incorrect pixels %x", pixels)
+	}
+
+	frames := parsed.sequence(TagPerFrameFunctionalGroupsSequence)
+	if len(frames) != 3 {
+		t.Fatalf("Expected 3 frames, got %d", len(frames))
+	}
+	for i, expected := range []struct {
+		segment, position uint32
+	}{{1, 1}, {1, 3}, {2, 3}} {
+		content := frames[i].lookup(TagFrameContentSequence).MustGetSequence()[0]
+		values := content.lookup(TagDimensionIndexValues).Value.(UInt32s)
+		if len(values) != 2 || values[0] != expected.segment || values[1] != expected.position {
+			t.Errorf("Frame %d: incorrect dimension index %v", i, values)
+		}
+		derivation := frames[i].lookup(TagDerivationImageSequence).MustGetSequence()[0]
+		source := derivation.lookup(TagSourceImageSequence).MustGetSequence()[0]
+		uid, _ := source.lookupString(TagReferencedSOPInstanceUID)
+		if expected := lookup(sources[expected.position-1], TagSOPInstanceUID); uid != expected {
+			t.Errorf("Frame %d: expected source %s, got %s", i, expected, uid)
+		}
+	}
+
+	segments := parsed.sequence(TagSegmentSequence)
+	if len(segments) != 2 {
+		t.Fatalf("Expected 2 segments, got %d", len(segments))
+	}
+	if s, _ := segments[1].lookupString(TagSegmentAlgorithmType); s != "MANUAL" {
+		t.Errorf("Expected a manual segment, got %s", s)
+	}
+	referenced := parsed.sequence(TagReferencedSeriesSequence)[0].lookup(TagReferencedInstanceSequence).MustGetSequence()
+	if len(referenced) != 3 {
+		t.Errorf("Expected 3 referenced instances, got %d", len(referenced))
+	}
+}
+
+func TestBuildInvalidSegmentation(t *testing.T) {
+
+	sources := sourceSeries(t, 2)
+	segments := []Segment{{Label: "Liver"}}
+	for name, seg := range map[string]*Segmentation{
+		"labels":  {Segments: segments, Labels: [][]uint8{{1, 0, 0, 0, 0, 0}}},
+		"size":    {Segments: segments, Labels: [][]uint8{{1}, {1}}},
+		"segment": {Segments: segments, Labels: [][]uint8{{2, 0, 0, 0, 0, 0}, make([]uint8, 6)}},
+		"empty":   {Segments: segments, Labels: [][]uint8{make([]uint8, 6), make([]uint8, 6)}},
+	} {
+		if _, err := seg.Build(sources); !errors.Is(err, ErrInvalidSegmentation) {
+			t.Errorf("%s: expected ErrInvalidSegmentation, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package dicom
+
+// The value of an SQ element
+type Sequence []*Item
+
+// An item of a sequence, holding a nested data set
+type Item struct {
+	Elements []*DicomElement
+}
+
+// Return the sequence of an SQ element, parsing its items first if they
+// were left unparsed by LazySequences
+func (e *DicomElement) GetSequence() (Sequence, error) {
+	if e.Value == nil && e.lazy != nil {
+		return e.lazy.load()
+	}
+	seq, ok := e.Value.(Sequence)
+	if !ok {
+		return nil, ErrWrongValueType
+	}
+	return seq, nil
+}
+
+// Like GetSequence, but panics on error
+func (e *DicomElement) MustGetSequence() Sequence {
+	seq, err := e.GetSequence()
+	if err != nil {
+		panic(err)
+	}
+	return seq
+}
+
+// Return a deep copy of the item
+func (item *Item) Clone() *Item {
+	clone := &Item{}
+	if item.Elements != nil {
+		clone.Elements = make([]*DicomElement, len(item.Elements))
+		for i, elem := range item.Elements {
+			clone.Elements[i] = elem.Clone()
+		}
+	}
+	return clone
+}
+
+// Return a deep copy of the sequence
+func (seq Sequence) Clone() Sequence {
+	if seq == nil {
+		return nil
+	}
+	clone := make(Sequence, len(seq))
+	for i, item := range seq {
+		clone[i] = item.Clone()
+	}
+	return clone
+}
@@ -0,0 +1,378 @@
+package dicom
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var (
+	ErrInvalidSignature     = errors.New("Invalid digital signature")
+	ErrUnsupportedSignature = errors.New("Unsupported digital signature")
+)
+
+const certificateType = "X509_1993_SIG"
+
+// The MAC algorithms of PS 3.15 C.1 supported
+var macAlgorithms = map[string]crypto.Hash{
+	"SHA1":   crypto.SHA1,
+	"SHA256": crypto.SHA256,
+	"SHA384": crypto.SHA384,
+	"SHA512": crypto.SHA512,
+}
+
+// A verified digital signature of a data set (PS 3.15 C)
+type Signature struct {
+	UID         string
+	DateTime    string
+	Certificate *x509.Certificate // of the signer
+	Tags        []Tag             // of the elements signed
+}
+
+// Sign the top level elements of tags with the private key of the
+// certificate, adding the Digital Signatures Macro (PS 3.3 C.12.1.1.3): a
+// SHA256 MAC of the elements is encrypted with key, an RSA or ECDSA key.
+// Every element of the data set, but the group lengths and the signatures,
+// is signed if no tags are given. Signatures already present are kept.
+func (file *DicomFile) Sign(key crypto.Signer, cert *x509.Certificate, tags ...Tag) error {
+
+	if err := file.LoadSequences(); err != nil {
+		return err
+	}
+
+	if len(tags) == 0 {
+		for i := range file.Elements {
+			if tag := file.Elements[i].Tag(); isSignable(tag) {
+				tags = append(tags, tag)
+			}
+		}
+	} else {
+		tags = append([]Tag(nil), tags...)
+		sort.Slice(tags, func(i, j int) bool { return tagLess(tags[i], tags[j]) })
+	}
+
+	params := file.sequence(TagMACParametersSequence)
+	signatures := file.sequence(TagDigitalSignaturesSequence)
+
+	// MAC ID numbers identify the parameters of every signature
+	id := uint16(0)
+	for _, item := range params {
+		if elem := item.lookup(TagMACIDNumber); elem != nil {
+			if n, err := elem.GetUInt16(); err == nil && n >= id {
+				id = n + 1
+			}
+		}
+	}
+
+	mac, err := file.mac(tags, ExplicitVRLittleEndian, crypto.SHA256)
+	if err != nil {
+		return err
+	}
+	signature, err := key.Sign(rand.Reader, mac, crypto.SHA256)
+	if err != nil {
+		return err
+	}
+
+	param, err := newItem([]itemValue{
+		{TagMACIDNumber, UInt16s{id}},
+		{TagMACCalculationTransferSyntaxUID, Strings{ExplicitVRLittleEndian}},
+		{TagMACAlgorithm, Strings{"SHA256"}},
+		{TagDataElementsSigned, Tags(tags)},
+	})
+	if err != nil {
+		return err
+	}
+	item, err := newItem([]itemValue{
+		{TagMACIDNumber, UInt16s{id}},
+		{TagDigitalSignatureUID, Strings{newUID()}},
+		{TagDigitalSignatureDateTime, Strings{time.Now().Format("20060102150405.000000-0700")}},
+		{TagCertificateType, Strings{certificateType}},
+		{TagCertificateOfSigner, Bytes(cert.Raw)},
+		{TagSignature, Bytes(signature)},
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := file.setSequence(TagMACParametersSequence, append(params, param)); err != nil {
+		return err
+	}
+	return file.setSequence(TagDigitalSignaturesSequence, append(signatures, item))
+}
+
+// Verify the digital signatures of the top level of the file, returning them.
+// The certificates of the signers are verified against opts, when not nil.
+// Returns ErrInvalidSignature if a signature does not match the elements it
+// signs, or if its certificate does not verify.
+func (file *DicomFile) VerifySignatures(opts *x509.VerifyOptions) ([]Signature, error) {
+
+	if err := file.parseSequences(); err != nil {
+		return nil, err
+	}
+
+	params := map[uint16]*Item{}
+	for _, item := range file.sequence(TagMACParametersSequence) {
+		if elem := item.lookup(TagMACIDNumber); elem != nil {
+			if id, err := elem.GetUInt16(); err == nil {
+				params[id] = item
+			}
+		}
+	}
+
+	var signatures []Signature
+	for _, item := range file.sequence(TagDigitalSignaturesSequence) {
+		signature, err := file.verifySignature(item, params, opts)
+		if err != nil {
+			return signatures, err
+		}
+		signatures = append(signatures, *signature)
+	}
+
+	return signatures, nil
+}
+
+// Verify an item of the Digital Signatures Sequence
+func (file *DicomFile) verifySignature(item *Item, params map[uint16]*Item, opts *x509.VerifyOptions) (*Signature, error) {
+
+	id, err := item.lookupUInt16(TagMACIDNumber)
+	if err != nil {
+		return nil, err
+	}
+	param, ok := params[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: no MAC parameters of MAC ID %d", ErrInvalidSignature, id)
+	}
+
+	ts, err := param.lookupString(TagMACCalculationTransferSyntaxUID)
+	if err != nil {
+		return nil, err
+	}
+	algorithm, err := param.lookupString(TagMACAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	hash, ok := macAlgorithms[algorithm]
+	if !ok {
+		return nil, fmt.Errorf("%w: MAC algorithm %s", ErrUnsupportedSignature, algorithm)
+	}
+	elem := param.lookup(TagDataElementsSigned)
+	if elem == nil {
+		return nil, fmt.Errorf("%w: no DataElementsSigned", ErrInvalidSignature)
+	}
+	tags, err := elem.GetTags()
+	if err != nil {
+		return nil, err
+	}
+
+	if kind, err := item.lookupString(TagCertificateType); err != nil || kind != certificateType {
+		return nil, fmt.Errorf("%w: certificate type %s", ErrUnsupportedSignature, kind)
+	}
+	der, err := item.lookupBytes(TagCertificateOfSigner)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(trimDER(der))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	sig, err := item.lookupBytes(TagSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	mac, err := file.mac(tags, ts, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	switch pub := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		err = rsa.VerifyPKCS1v15(pub, hash, mac, sig)
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(pub, mac, trimDER(sig)) {
+			err = errors.New("ECDSA verification failure")
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s key", ErrUnsupportedSignature, cert.PublicKeyAlgorithm)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+
+	if opts != nil {
+		if _, err := cert.Verify(*opts); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+		}
+	}
+
+	uid, _ := item.lookupString(TagDigitalSignatureUID)
+	dateTime, _ := item.lookupString(TagDigitalSignatureDateTime)
+	return &Signature{uid, dateTime, cert, tags}, nil
+}
+
+// Compute the MAC of the top level elements of tags, encoded with the
+// transfer syntax ts. The tags must be sorted.
+func (file *DicomFile) mac(tags []Tag, ts string, hash crypto.Hash) ([]byte, error) {
+
+	bo, implicit, err := transferSyntax(ts)
+	if err != nil {
+		return nil, err
+	}
+	if !hash.Available() {
+		return nil, fmt.Errorf("%w: hash %v", ErrUnsupportedSignature, hash)
+	}
+
+	buffer := newDicomWriter(bo, implicit)
+	buffer.charset = file.characterSet()
+	for _, tag := range tags {
+		i := file.indexOf(tag)
+		if i < 0 {
+			return nil, fmt.Errorf("%w: signed element %s not found", ErrInvalidSignature, tag)
+		}
+		if err := buffer.writeSignedElement(&file.Elements[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	h := hash.New()
+	h.Write(buffer.Bytes())
+	return h.Sum(nil), nil
+}
+
+// Write an element as its MAC is computed (PS 3.15 C.1): as in the data set,
+// but without the lengths of sequences, items and delimiters
+func (buffer *dicomWriter) writeSignedElement(elem *DicomElement) error {
+
+	vr := writtenVR(elem)
+	if vr != "SQ" {
+		return buffer.writeElement(elem)
+	}
+
+	seq, err := elem.GetSequence()
+	if err != nil {
+		return err
+	}
+
+	buffer.writeTagAndVR(elem.Tag(), vr)
+	for _, item := range seq {
+		buffer.writeTagAndVR(TagItem, "")
+		for _, child := range item.Elements {
+			if err := buffer.writeSignedElement(child); err != nil {
+				return err
+			}
+		}
+		buffer.writeTagAndVR(TagItemDelimitationItem, "")
+	}
+	buffer.writeTagAndVR(TagSequenceDelimitationItem, "")
+
+	return nil
+}
+
+// Write a tag and, in explicit VR, a VR and its reserved bytes
+func (buffer *dicomWriter) writeTagAndVR(tag Tag, vr string) {
+
+	buffer.writeUInt16(tag.Group)
+	buffer.writeUInt16(tag.Element)
+
+	if !buffer.implicit && tag.Group != pixeldata_group {
+		buffer.WriteString(vr)
+		if isLongVR(vr) {
+			buffer.Write([]byte{0, 0})
+		}
+	}
+}
+
+// Reports whether an element is signed when signing every element
+func isSignable(tag Tag) bool {
+	switch {
+	case tag.Group == 0x0002, tag.Element == 0x0000:
+		return false
+	case tag == TagMACParametersSequence, tag == TagDigitalSignaturesSequence, tag == TagDataSetTrailingPadding:
+		return false
+	}
+	return true
+}
+
+// Strip the padding of a DER encoded value to an even length
+func trimDER(b []byte) []byte {
+	var raw asn1.RawValue
+	if _, err := asn1.Unmarshal(b, &raw); err != nil {
+		return b
+	}
+	return raw.FullBytes
+}
+
+// The items of a top level sequence, if any
+func (file *DicomFile) sequence(tag Tag) Sequence {
+	if i := file.indexOf(tag); i >= 0 {
+		seq, _ := file.Elements[i].GetSequence()
+		return seq
+	}
+	return nil
+}
+
+// Set or add a top level sequence
+func (file *DicomFile) setSequence(tag Tag, seq Sequence) error {
+	return file.setValue(tag, seq)
+}
+
+// The value of an element of a new item
+type itemValue struct {
+	tag   Tag
+	value Value
+}
+
+// Create an item of a top level sequence
+func newItem(values []itemValue) (*Item, error) {
+	item := &Item{}
+	for _, v := range values {
+		elem, err := standardParser().NewElement(v.tag, v.value)
+		if err != nil {
+			return nil, err
+		}
+		elem.IndentLevel = 1
+		item.Elements = append(item.Elements, elem)
+	}
+	return item, nil
+}
+
+// Return the element of the item with tag, or nil
+func (item *Item) lookup(tag Tag) *DicomElement {
+	for _, elem := range item.Elements {
+		if elem.Tag() == tag {
+			return elem
+		}
+	}
+	return nil
+}
+
+func (item *Item) lookupString(tag Tag) (string, error) {
+	elem := item.lookup(tag)
+	if elem == nil {
+		return "", fmt.Errorf("%w: %s", ErrNotFound, tag)
+	}
+	return elem.GetString()
+}
+
+func (item *Item) lookupUInt16(tag Tag) (uint16, error) {
+	elem := item.lookup(tag)
+	if elem == nil {
+		return 0, fmt.Errorf("%w: %s", ErrNotFound, tag)
+	}
+	return elem.GetUInt16()
+}
+
+func (item *Item) lookupBytes(tag Tag) ([]byte, error) {
+	elem := item.lookup(tag)
+	if elem == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, tag)
+	}
+	return elem.GetBytes()
+}
@@ -0,0 +1,93 @@
+package dicom
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// A self-signed certificate of a key, with a random serial number
+func newSigner(t *testing.T, key crypto.Signer) *x509.Certificate {
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "signer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestSign(t *testing.T) {
+
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 1024)
+
+	for _, key := range []crypto.Signer{ecKey, rsaKey} {
+		cert := newSigner(t, key)
+
+		p, _ := NewParser()
+		file, err := p.Parse(readFile())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := file.Sign(key, cert); err != nil {
+			t.Fatal(err)
+		}
+		if err := file.Sign(key, cert, TagPatientName, TagPatientID); err != nil {
+			t.Fatal(err)
+		}
+
+		// the signatures survive writing the file
+		buffer := new(bytes.Buffer)
+		if err := file.Write(buffer); err != nil {
+			t.Fatal(err)
+		}
+		signed, err := p.Parse(buffer.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		roots := x509.NewCertPool()
+		roots.AddCert(cert)
+		signatures, err := signed.VerifySignatures(&x509.VerifyOptions{Roots: roots})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(signatures) != 2 || len(signatures[1].Tags) != 2 || signatures[0].Certificate.Subject.CommonName != "signer" {
+			t.Errorf("Incorrect signatures %v", signatures)
+		}
+
+		// with an untrusted certificate
+		if _, err := signed.VerifySignatures(&x509.VerifyOptions{Roots: x509.NewCertPool()}); !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("Incorrect error %v", err)
+		}
+
+		// with a modified element
+		signed.SetByPath("PatientID", "OTHER")
+		if _, err := signed.VerifySignatures(nil); !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("Incorrect error %v", err)
+		}
+	}
+
+}
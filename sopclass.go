@@ -0,0 +1,197 @@
+package dicom
+
+import (
+	"errors"
+)
+
+var ErrUnknownSOPClass = errors.New("Unknown SOP Class UID")
+
+// Describes the Information Object Definition of a SOP Class
+type IOD struct {
+	UID        string
+	Name       string
+	Modality   string // modality family, ie. "CT"
+	MultiFrame bool
+}
+
+// Storage SOP Class UIDs, PS 3.4 B.5
+const (
+	ComputedRadiographyImageStorage                     = "1.2.840.10008.5.1.4.1.1.1"
+	DigitalXRayImageStorageForPresentation              = "1.2.840.10008.5.1.4.1.1.1.1"
+	DigitalXRayImageStorageForProcessing                = "1.2.840.10008.5.1.4.1.1.1.1.1"
+	DigitalMammographyXRayImageStorageForPresentation   = "1.2.840.10008.5.1.4.1.1.1.2"
+	DigitalMammographyXRayImageStorageForProcessing     = "1.2.840.10008.5.1.4.1.1.1.2.1"
+	DigitalIntraOralXRayImageStorageForPresentation     = "1.2.840.10008.5.1.4.1.1.1.3"
+	DigitalIntraOralXRayImageStorageForProcessing       = "1.2.840.10008.5.1.4.1.1.1.3.1"
+	CTImageStorage                                      = "1.2.840.10008.5.1.4.1.1.2"
+	EnhancedCTImageStorage                              = "1.2.840.10008.5.1.4.1.1.2.1"
+	LegacyConvertedEnhancedCTImageStorage               = "1.2.840.10008.5.1.4.1.1.2.2"
+	UltrasoundMultiFrameImageStorage                    = "1.2.840.10008.5.1.4.1.1.3.1"
+	MRImageStorage                                      = "1.2.840.10008.5.1.4.1.1.4"
+	EnhancedMRImageStorage                              = "1.2.840.10008.5.1.4.1.1.4.1"
+	MRSpectroscopyStorage                               = "1.2.840.10008.5.1.4.1.1.4.2"
+	EnhancedMRColorImageStorage                         = "1.2.840.10008.5.1.4.1.1.4.3"
+	LegacyConvertedEnhancedMRImageStorage               = "1.2.840.10008.5.1.4.1.1.4.4"
+	UltrasoundImageStorage                              = "1.2.840.10008.5.1.4.1.1.6.1"
+	EnhancedUSVolumeStorage                             = "1.2.840.10008.5.1.4.1.1.6.2"
+	SecondaryCaptureImageStorage                        = "1.2.840.10008.5.1.4.1.1.7"
+	MultiFrameSingleBitSecondaryCaptureImageStorage     = "1.2.840.10008.5.1.4.1.1.7.1"
+	MultiFrameGrayscaleByteSecondaryCaptureImageStorage = "1.2.840.10008.5.1.4.1.1.7.2"
+	MultiFrameGrayscaleWordSecondaryCaptureImageStorage = "1.2.840.10008.5.1.4.1.1.7.3"
+	MultiFrameTrueColorSecondaryCaptureImageStorage     = "1.2.840.10008.5.1.4.1.1.7.4"
+	TwelveLeadECGWaveformStorage                        = "1.2.840.10008.5.1.4.1.1.9.1.1"
+	GeneralECGWaveformStorage                           = "1.2.840.10008.5.1.4.1.1.9.1.2"
+	AmbulatoryECGWaveformStorage                        = "1.2.840.10008.5.1.4.1.1.9.1.3"
+	HemodynamicWaveformStorage                          = "1.2.840.10008.5.1.4.1.1.9.2.1"
+	CardiacElectrophysiologyWaveformStorage             = "1.2.840.10008.5.1.4.1.1.9.3.1"
+	BasicVoiceAudioWaveformStorage                      = "1.2.840.10008.5.1.4.1.1.9.4.1"
+	GrayscaleSoftcopyPresentationStateStorage           = "1.2.840.10008.5.1.4.1.1.11.1"
+	ColorSoftcopyPresentationStateStorage               = "1.2.840.10008.5.1.4.1.1.11.2"
+	XRayAngiographicImageStorage                        = "1.2.840.10008.5.1.4.1.1.12.1"
+	EnhancedXAImageStorage                              = "1.2.840.10008.5.1.4.1.1.12.1.1"
+	XRayRadiofluoroscopicImageStorage                   = "1.2.840.10008.5.1.4.1.1.12.2"
+	EnhancedXRFImageStorage                             = "1.2.840.10008.5.1.4.1.1.12.2.1"
+	XRay3DAngiographicImageStorage                      = "1.2.840.10008.5.1.4.1.1.13.1.1"
+	BreastTomosynthesisImageStorage                     = "1.2.840.10008.5.1.4.1.1.13.1.3"
+	NuclearMedicineImageStorage                         = "1.2.840.10008.5.1.4.1.1.20"
+	RawDataStorage                                      = "1.2.840.10008.5.1.4.1.1.66"
+	SpatialRegistrationStorage                          = "1.2.840.10008.5.1.4.1.1.66.1"
+	SpatialFiducialsStorage                             = "1.2.840.10008.5.1.4.1.1.66.2"
+	SegmentationStorage                                 = "1.2.840.10008.5.1.4.1.1.66.4"
+	SurfaceSegmentationStorage                          = "1.2.840.10008.5.1.4.1.1.66.5"
+	RealWorldValueMappingStorage                        = "1.2.840.10008.5.1.4.1.1.67"
+	VLEndoscopicImageStorage                            = "1.2.840.10008.5.1.4.1.1.77.1.1"
+	VideoEndoscopicImageStorage                         = "1.2.840.10008.5.1.4.1.1.77.1.1.1"
+	VLMicroscopicImageStorage                           = "1.2.840.10008.5.1.4.1.1.77.1.2"
+	VLPhotographicImageStorage                          = "1.2.840.10008.5.1.4.1.1.77.1.4"
+	OphthalmicPhotography8BitImageStorage               = "1.2.840.10008.5.1.4.1.1.77.1.5.1"
+	OphthalmicPhotography16BitImageStorage              = "1.2.840.10008.5.1.4.1.1.77.1.5.2"
+	OphthalmicTomographyImageStorage                    = "1.2.840.10008.5.1.4.1.1.77.1.5.4"
+	VLWholeSlideMicroscopyImageStorage                  = "1.2.840.10008.5.1.4.1.1.77.1.6"
+	BasicTextSRStorage                                  = "1.2.840.10008.5.1.4.1.1.88.11"
+	EnhancedSRStorage                                   = "1.2.840.10008.5.1.4.1.1.88.22"
+	ComprehensiveSRStorage                              = "1.2.840.10008.5.1.4.1.1.88.33"
+	Comprehensive3DSRStorage                            = "1.2.840.10008.5.1.4.1.1.88.34"
+	MammographyCADSRStorage                             = "1.2.840.10008.5.1.4.1.1.88.50"
+	KeyObjectSelectionDocumentStorage                   = "1.2.840.10008.5.1.4.1.1.88.59"
+	XRayRadiationDoseSRStorage                          = "1.2.840.10008.5.1.4.1.1.88.67"
+	EncapsulatedPDFStorage                              = "1.2.840.10008.5.1.4.1.1.104.1"
+	EncapsulatedCDAStorage                              = "1.2.840.10008.5.1.4.1.1.104.2"
+	PositronEmissionTomographyImageStorage              = "1.2.840.10008.5.1.4.1.1.128"
+	LegacyConvertedEnhancedPETImageStorage              = "1.2.840.10008.5.1.4.1.1.128.1"
+	EnhancedPETImageStorage                             = "1.2.840.10008.5.1.4.1.1.130"
+	RTImageStorage                                      = "1.2.840.10008.5.1.4.1.1.481.1"
+	RTDoseStorage                                       = "1.2.840.10008.5.1.4.1.1.481.2"
+	RTStructureSetStorage                               = "1.2.840.10008.5.1.4.1.1.481.3"
+	RTBeamsTreatmentRecordStorage                       = "1.2.840.10008.5.1.4.1.1.481.4"
+	RTPlanStorage                                       = "1.2.840.10008.5.1.4.1.1.481.5"
+	RTBrachyTreatmentRecordStorage                      = "1.2.840.10008.5.1.4.1.1.481.6"
+	RTTreatmentSummaryRecordStorage                     = "1.2.840.10008.5.1.4.1.1.481.7"
+	RTIonPlanStorage                                    = "1.2.840.10008.5.1.4.1.1.481.8"
+	RTIonBeamsTreatmentRecordStorage                    = "1.2.840.10008.5.1.4.1.1.481.9"
+)
+
+var sopClasses = map[string]*IOD{
+	ComputedRadiographyImageStorage:                   {ComputedRadiographyImageStorage, "Computed Radiography Image Storage", "CR", false},
+	DigitalXRayImageStorageForPresentation:            {DigitalXRayImageStorageForPresentation, "Digital X-Ray Image Storage - For Presentation", "DX", false},
+	DigitalXRayImageStorageForProcessing:              {DigitalXRayImageStorageForProcessing, "Digital X-Ray Image Storage - For Processing", "DX", false},
+	DigitalMammographyXRayImageStorageForPresentation: {DigitalMammographyXRayImageStorageForPresentation, "Digital Mammography X-Ray Image Storage - For Presentation", "MG", false},
+	DigitalMammographyXRayImageStorageForProcessing:   {DigitalMammographyXRayImageStorageForProcessing, "Digital Mammography X-Ray Image Storage - For Processing", "MG", false},
+	DigitalIntraOralXRayImageStorageForPresentation:   {DigitalIntraOralXRayImageStorageForPresentation, "Digital Intra-Oral X-Ray Image Storage - For Presentation", "IO", false},
+	DigitalIntraOralXRayImageStorageForProcessing:     {DigitalIntraOralXRayImageStorageForProcessing, "Digital Intra-Oral X-Ray Image Storage - For Processing", "IO", false},
+	CTImageStorage:                                      {CTImageStorage, "CT Image Storage", "CT", false},
+	EnhancedCTImageStorage:                              {EnhancedCTImageStorage, "Enhanced CT Image Storage", "CT", true},
+	LegacyConvertedEnhancedCTImageStorage:               {LegacyConvertedEnhancedCTImageStorage, "Legacy Converted Enhanced CT Image Storage", "CT", true},
+	UltrasoundMultiFrameImageStorage:                    {UltrasoundMultiFrameImageStorage, "Ultrasound Multi-frame Image Storage", "US", true},
+	MRImageStorage:                                      {MRImageStorage, "MR Image Storage", "MR", false},
+	EnhancedMRImageStorage:                              {EnhancedMRImageStorage, "Enhanced MR Image Storage", "MR", true},
+	MRSpectroscopyStorage:                               {MRSpectroscopyStorage, "MR Spectroscopy Storage", "MR", true},
+	EnhancedMRColorImageStorage:                         {EnhancedMRColorImageStorage, "Enhanced MR Color Image Storage", "MR", true},
+	LegacyConvertedEnhancedMRImageStorage:               {LegacyConvertedEnhancedMRImageStorage, "Legacy Converted Enhanced MR Image Storage", "MR", true},
+	UltrasoundImageStorage:                              {UltrasoundImageStorage, "Ultrasound Image Storage", "US", false},
+	EnhancedUSVolumeStorage:                             {EnhancedUSVolumeStorage, "Enhanced US Volume Storage", "US", true},
+	SecondaryCaptureImageStorage:                        {SecondaryCaptureImageStorage, "Secondary Capture Image Storage", "OT", false},
+	MultiFrameSingleBitSecondaryCaptureImageStorage:     {MultiFrameSingleBitSecondaryCaptureImageStorage, "Multi-frame Single Bit Secondary Capture Image Storage", "OT", true},
+	MultiFrameGrayscaleByteSecondaryCaptureImageStorage: {MultiFrameGrayscaleByteSecondaryCaptureImageStorage, "Multi-frame Grayscale Byte Secondary Capture Image Storage", "OT", true},
+	MultiFrameGrayscaleWordSecondaryCaptureImageStorage: {MultiFrameGrayscaleWordSecondaryCaptureImageStorage, "Multi-frame Grayscale Word Secondary Capture Image Storage", "OT", true},
+	MultiFrameTrueColorSecondaryCaptureImageStorage:     {MultiFrameTrueColorSecondaryCaptureImageStorage, "Multi-frame True Color Secondary Capture Image Storage", "OT", true},
+	TwelveLeadECGWaveformStorage:                        {TwelveLeadECGWaveformStorage, "12-lead ECG Waveform Storage", "ECG", false},
+	GeneralECGWaveformStorage:                           {GeneralECGWaveformStorage, "General ECG Waveform Storage", "ECG", false},
+	AmbulatoryECGWaveformStorage:                        {AmbulatoryECGWaveformStorage, "Ambulatory ECG Waveform Storage", "ECG", false},
+	HemodynamicWaveformStorage:                          {HemodynamicWaveformStorage, "Hemodynamic Waveform Storage", "HD", false},
+	CardiacElectrophysiologyWaveformStorage:             {CardiacElectrophysiologyWaveformStorage, "Cardiac Electrophysiology Waveform Storage", "EPS", false},
+	BasicVoiceAudioWaveformStorage:                      {BasicVoiceAudioWaveformStorage, "Basic Voice Audio Waveform Storage", "AU", false},
+	GrayscaleSoftcopyPresentationStateStorage:           {GrayscaleSoftcopyPresentationStateStorage, "Grayscale Softcopy Presentation State Storage", "PR", false},
+	ColorSoftcopyPresentationStateStorage:               {ColorSoftcopyPresentationStateStorage, "Color Softcopy Presentation State Storage", "PR", false},
+	XRayAngiographicImageStorage:                        {XRayAngiographicImageStorage, "X-Ray Angiographic Image Storage", "XA", true},
+	EnhancedXAImageStorage:                              {EnhancedXAImageStorage, "Enhanced XA Image Storage", "XA", true},
+	XRayRadiofluoroscopicImageStorage:                   {XRayRadiofluoroscopicImageStorage, "X-Ray Radiofluoroscopic Image Storage", "RF", true},
+	EnhancedXRFImageStorage:                             {EnhancedXRFImageStorage, "Enhanced XRF Image Storage", "RF", true},
+	XRay3DAngiographicImageStorage:                      {XRay3DAngiographicImageStorage, "X-Ray 3D Angiographic Image Storage", "XA", true},
+	BreastTomosynthesisImageStorage:                     {BreastTomosynthesisImageStorage, "Breast Tomosynthesis Image Storage", "MG", true},
+	NuclearMedicineImageStorage:                         {NuclearMedicineImageStorage, "Nuclear Medicine Image Storage", "NM", true},
+	RawDataStorage:                                      {RawDataStorage, "Raw Data Storage", "", false},
+	SpatialRegistrationStorage:                          {SpatialRegistrationStorage, "Spatial Registration Storage", "REG", false},
+	SpatialFiducialsStorage:                             {SpatialFiducialsStorage, "Spatial Fiducials Storage", "FID", false},
+	SegmentationStorage:                                 {SegmentationStorage, "Segmentation Storage", "SEG", true},
+	SurfaceSegmentationStorage:                          {SurfaceSegmentationStorage, "Surface Segmentation Storage", "SEG", false},
+	RealWorldValueMappingStorage:                        {RealWorldValueMappingStorage, "Real World Value Mapping Storage", "RWV", false},
+	VLEndoscopicImageStorage:                            {VLEndoscopicImageStorage, "VL Endoscopic Image Storage", "ES", false},
+	VideoEndoscopicImageStorage:                         {VideoEndoscopicImageStorage, "Video Endoscopic Image Storage", "ES", true},
+	VLMicroscopicImageStorage:                           {VLMicroscopicImageStorage, "VL Microscopic Image Storage", "GM", false},
+	VLPhotographicImageStorage:                          {VLPhotographicImageStorage, "VL Photographic Image Storage", "XC", false},
+	OphthalmicPhotography8BitImageStorage:               {OphthalmicPhotography8BitImageStorage, "Ophthalmic Photography 8 Bit Image Storage", "OP", true},
+	OphthalmicPhotography16BitImageStorage:              {OphthalmicPhotography16BitImageStorage, "Ophthalmic Photography 16 Bit Image Storage", "OP", true},
+	OphthalmicTomographyImageStorage:                    {OphthalmicTomographyImageStorage, "Ophthalmic Tomography Image Storage", "OPT", true},
+	VLWholeSlideMicroscopyImageStorage:                  {VLWholeSlideMicroscopyImageStorage, "VL Whole Slide Microscopy Image Storage", "SM", true},
+	BasicTextSRStorage:                                  {BasicTextSRStorage, "Basic Text SR Storage", "SR", false},
+	EnhancedSRStorage:                                   {EnhancedSRStorage, "Enhanced SR Storage", "SR", false},
+	ComprehensiveSRStorage:                              {ComprehensiveSRStorage, "Comprehensive SR Storage", "SR", false},
+	Comprehensive3DSRStorage:                            {Comprehensive3DSRStorage, "Comprehensive 3D SR Storage", "SR", false},
+	MammographyCADSRStorage:                             {MammographyCADSRStorage, "Mammography CAD SR Storage", "SR", false},
+	KeyObjectSelectionDocumentStorage:                   {KeyObjectSelectionDocumentStorage, "Key Object Selection Document Storage", "KO", false},
+	XRayRadiationDoseSRStorage:                          {XRayRadiationDoseSRStorage, "X-Ray Radiation Dose SR Storage", "SR", false},
+	EncapsulatedPDFStorage:                              {EncapsulatedPDFStorage, "Encapsulated PDF Storage", "DOC", false},
+	EncapsulatedCDAStorage:                              {EncapsulatedCDAStorage, "Encapsulated CDA Storage", "DOC", false},
+	PositronEmissionTomographyImageStorage:              {PositronEmissionTomographyImageStorage, "Positron Emission Tomography Image Storage", "PT", false},
+	LegacyConvertedEnhancedPETImageStorage:              {LegacyConvertedEnhancedPETImageStorage, "Legacy Converted Enhanced PET Image Storage", "PT", true},
+	EnhancedPETImageStorage:                             {EnhancedPETImageStorage, "Enhanced PET Image Storage", "PT", true},
+	RTImageStorage:                                      {RTImageStorage, "RT Image Storage", "RTIMAGE", false},
+	RTDoseStorage:                                       {RTDoseStorage, "RT Dose Storage", "RTDOSE", true},
+	RTStructureSetStorage:                               {RTStructureSetStorage, "RT Structure Set Storage", "RTSTRUCT", false},
+	RTBeamsTreatmentRecordStorage:                       {RTBeamsTreatmentRecordStorage, "RT Beams Treatment Record Storage", "RTRECORD", false},
+	RTPlanStorage:                                       {RTPlanStorage, "RT Plan Storage", "RTPLAN", false},
+	RTBrachyTreatmentRecordStorage:                      {RTBrachyTreatmentRecordStorage, "RT Brachy Treatment Record Storage", "RTRECORD", false},
+	RTTreatmentSummaryRecordStorage:                     {RTTreatmentSummaryRecordStorage, "RT Treatment Summary Record Storage", "RTRECORD", false},
+	RTIonPlanStorage:                                    {RTIonPlanStorage, "RT Ion Plan Storage", "RTPLAN", false},
+	RTIonBeamsTreatmentRecordStorage:                    {RTIonBeamsTreatmentRecordStorage, "RT Ion Beams Treatment Record Storage", "RTRECORD", false},
+}
+
+// Lookup the IOD of a SOP Class UID
+func LookupIOD(sopClassUID string) (*IOD, error) {
+	iod, ok := sopClasses[sopClassUID]
+	if !ok {
+		return nil, ErrUnknownSOPClass
+	}
+	return iod, nil
+}
+
+// Return the IOD of the file, based on its SOPClassUID or, when absent,
+// its MediaStorageSOPClassUID
+func (file *DicomFile) IOD() (*IOD, error) {
+
+	elem, err := file.LookupElementByTag(TagSOPClassUID)
+	if err != nil {
+		elem, err = file.LookupElementByTag(TagMediaStorageSOPClassUID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	uid, err := elem.GetString()
+	if err != nil {
+		return nil, ErrUnknownSOPClass
+	}
+
+	return LookupIOD(uid)
+}
@@ -0,0 +1,38 @@
+package dicom
+
+import (
+	"testing"
+)
+
+func TestLookupIOD(t *testing.T) {
+
+	iod, err := LookupIOD("1.2.840.10008.5.1.4.1.1.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if iod.UID != CTImageStorage || iod.Name != "CT Image Storage" || iod.Modality != "CT" || iod.MultiFrame {
+		t.Errorf("Incorrect IOD %+v", iod)
+	}
+
+	if _, err := LookupIOD("1.2.3"); err != ErrUnknownSOPClass {
+		t.Errorf("Expected ErrUnknownSOPClass, got %v", err)
+	}
+
+}
+
+func TestFileIOD(t *testing.T) {
+
+	file := &DicomFile{}
+	file.Elements = append(file.Elements, DicomElement{Group: 0x0002, Element: 0x0002, Name: "MediaStorageSOPClassUID", Vr: "UI", Value: Strings{EnhancedMRImageStorage}})
+
+	iod, err := file.IOD()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if iod.Modality != "MR" || !iod.MultiFrame {
+		t.Errorf("Incorrect IOD %+v", iod)
+	}
+
+}
@@ -0,0 +1,458 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var ErrInvalidReport = errors.New("Invalid structured report")
+
+// Relationship types of a content item with its parent (PS 3.3 C.17.3.2.4)
+const (
+	RelationshipContains      = "CONTAINS"
+	RelationshipHasProperties = "HAS PROPERTIES"
+	RelationshipHasObsContext = "HAS OBS CONTEXT"
+	RelationshipHasAcqContext = "HAS ACQ CONTEXT"
+	RelationshipHasConceptMod = "HAS CONCEPT MOD"
+	RelationshipInferredFrom  = "INFERRED FROM"
+	RelationshipSelectedFrom  = "SELECTED FROM"
+)
+
+// Value types of content items (PS 3.3 C.17.3.2.1)
+const (
+	ValueTypeContainer = "CONTAINER"
+	ValueTypeText      = "TEXT"
+	ValueTypeCode      = "CODE"
+	ValueTypeNum       = "NUM"
+	ValueTypeDate      = "DATE"
+	ValueTypeTime      = "TIME"
+	ValueTypeDateTime  = "DATETIME"
+	ValueTypePName     = "PNAME"
+	ValueTypeUIDRef    = "UIDREF"
+	ValueTypeImage     = "IMAGE"
+	ValueTypeComposite = "COMPOSITE"
+)
+
+// A coded entry (PS 3.3 8.8), ie. {"126000", "DCM", "Imaging Measurement Report"}
+type Code struct {
+	Value   string
+	Scheme  string // the Coding Scheme Designator
+	Meaning string
+}
+
+// A numeric measurement and its units, the value of a NUM content item
+type Measurement struct {
+	Value float64
+	Units Code // ie. {"mm", "UCUM", "mm"}
+}
+
+// A reference to a SOP instance, the value of IMAGE and COMPOSITE content
+// items
+type Reference struct {
+	StudyInstanceUID  string
+	SeriesInstanceUID string
+	SOPClassUID       string
+	SOPInstanceUID    string
+}
+
+// A content item of a structured report: a named value, and the content
+// items it has a relationship with. Value holds a string for TEXT, DATE,
+// TIME, DATETIME, PNAME and UIDREF items, a Code for CODE items, a
+// Measurement for NUM items, a Reference for IMAGE and COMPOSITE items, and
+// nil for CONTAINER items.
+type ContentItem struct {
+	Relationship string // with the parent, empty for the root
+	ValueType    string
+	Concept      Code
+	Value        interface{}
+	Children     []*ContentItem
+}
+
+func NewContainer(concept Code) *ContentItem {
+	return &ContentItem{ValueType: ValueTypeContainer, Concept: concept}
+}
+
+func NewText(concept Code, text string) *ContentItem {
+	return &ContentItem{ValueType: ValueTypeText, Concept: concept, Value: text}
+}
+
+func NewCode(concept Code, value Code) *ContentItem {
+	return &ContentItem{ValueType: ValueTypeCode, Concept: concept, Value: value}
+}
+
+func NewNum(concept Code, value float64, units Code) *ContentItem {
+	return &ContentItem{ValueType: ValueTypeNum, Concept: concept, Value: Measurement{value, units}}
+}
+
+func NewImage(concept Code, ref Reference) *ContentItem {
+	return &ContentItem{ValueType: ValueTypeImage, Concept: concept, Value: ref}
+}
+
+func NewComposite(concept Code, ref Reference) *ContentItem {
+	return &ContentItem{ValueType: ValueTypeComposite, Concept: concept, Value: ref}
+}
+
+// Add child to the content items of item with a relationship, returning
+// child so that its own content items can be added
+func (item *ContentItem) Add(relationship string, child *ContentItem) *ContentItem {
+	child.Relationship = relationship
+	item.Children = append(item.Children, child)
+	return child
+}
+
+// Return the reference to the SOP instance of a file
+func ReferenceTo(file *DicomFile) (Reference, error) {
+
+	var ref Reference
+	for _, v := range []struct {
+		tag   Tag
+		value *string
+	}{
+		{TagStudyInstanceUID, &ref.StudyInstanceUID},
+		{TagSeriesInstanceUID, &ref.SeriesInstanceUID},
+		{TagSOPClassUID, &ref.SOPClassUID},
+		{TagSOPInstanceUID, &ref.SOPInstanceUID},
+	} {
+		s, err := file.lookupString(v.tag)
+		if err != nil {
+			return ref, err
+		}
+		if s == "" {
+			return ref, fmt.Errorf("%w: %s", ErrNotFound, v.tag)
+		}
+		*v.value = s
+	}
+
+	return ref, nil
+}
+
+// A Comprehensive SR document: a CONTAINER of the document title, the root
+// of the content tree
+type Report struct {
+	Root     *ContentItem
+	Template string // the TID of the root, ie. "1500", if any
+	Complete bool   // the content is complete, rather than partial
+}
+
+// Create a report titled title
+func NewReport(title Code) *Report {
+	return &Report{Root: NewContainer(title)}
+}
+
+// Build the Comprehensive SR document of the report, in a new series of the
+// study of subject, whose patient and study elements are copied. A new study
+// is created when subject is nil. The SOP instances of the IMAGE and
+// COMPOSITE items are listed in the Current Requested Procedure Evidence
+// Sequence when they belong to the study, and in the Pertinent Other
+// Evidence Sequence otherwise.
+func (r *Report) Build(subject *DicomFile) (*DicomFile, error) {
+
+	if r.Root == nil || r.Root.ValueType != ValueTypeContainer || r.Root.Relationship != "" {
+		return nil, fmt.Errorf("%w: the root must be a CONTAINER without relationship", ErrInvalidReport)
+	}
+	if err := checkContent(r.Root, map[*ContentItem]bool{}); err != nil {
+		return nil, err
+	}
+
+	file, err := newInstance(subject, ComprehensiveSRStorage, "SR")
+	if err != nil {
+		return nil, err
+	}
+	study, err := file.lookupString(TagStudyInstanceUID)
+	if err != nil {
+		return nil, err
+	}
+
+	completion := "PARTIAL"
+	if r.Complete {
+		completion = "COMPLETE"
+	}
+	for _, attr := range []itemValue{
+		{TagReferencedPerformedProcedureStepSequence, Sequence{}},
+		{TagCompletionFlag, Strings{completion}},
+		{TagVerificationFlag, Strings{"UNVERIFIED"}},
+	} {
+		if err := file.setValue(attr.tag, attr.value); err != nil {
+			return nil, err
+		}
+	}
+
+	// the document content, the root content item without relationship
+	root, err := newContentItem(r.Root, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, elem := range root.Elements {
+		file.insertElement(elem)
+	}
+
+	if r.Template != "" {
+		item, err := newItem([]itemValue{
+			{TagMappingResource, Strings{"DCMR"}},
+			{TagTemplateIdentifier, Strings{r.Template}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := file.setValue(TagContentTemplateSequence, Sequence{item}); err != nil {
+			return nil, err
+		}
+	}
+
+	var current, other []Reference
+	for _, ref := range references(r.Root, nil) {
+		if ref.StudyInstanceUID == study {
+			current = append(current, ref)
+		} else {
+			other = append(other, ref)
+		}
+	}
+	for _, evidence := range []struct {
+		tag  Tag
+		refs []Reference
+	}{
+		{TagCurrentRequestedProcedureEvidenceSequence, current},
+		{TagPertinentOtherEvidenceSequence, other},
+	} {
+		if len(evidence.refs) == 0 {
+			continue
+		}
+		seq, err := evidenceSequence(evidence.refs)
+		if err != nil {
+			return nil, err
+		}
+		if err := file.setValue(evidence.tag, seq); err != nil {
+			return nil, err
+		}
+	}
+
+	return file, nil
+}
+
+// Check the relationships and values of a content item and its children,
+// with the constraints of the Comprehensive SR IOD (PS 3.3 A.35.3.3.1)
+func checkContent(item *ContentItem, seen map[*ContentItem]bool) error {
+
+	if seen[item] {
+		return fmt.Errorf("%w: content item %q added twice", ErrInvalidReport, item.Concept.Meaning)
+	}
+	seen[item] = true
+
+	var ok bool
+	switch item.ValueType {
+	case ValueTypeContainer:
+		ok = item.Value == nil
+	case ValueTypeText, ValueTypeDate, ValueTypeTime, ValueTypeDateTime, ValueTypePName, ValueTypeUIDRef:
+		_, ok = item.Value.(string)
+	case ValueTypeCode:
+		_, ok = item.Value.(Code)
+	case ValueTypeNum:
+		_, ok = item.Value.(Measurement)
+	case ValueTypeImage, ValueTypeComposite:
+		_, ok = item.Value.(Reference)
+	default:
+		return fmt.Errorf("%w: value type %q", ErrInvalidReport, item.ValueType)
+	}
+	if !ok {
+		return fmt.Errorf("%w: %T value of a %s content item", ErrInvalidReport, item.Value, item.ValueType)
+	}
+
+	for _, child := range item.Children {
+		switch child.Relationship {
+		case RelationshipContains:
+			ok = item.ValueType == ValueTypeContainer
+		case RelationshipHasObsContext:
+			ok = item.ValueType == ValueTypeContainer || item.ValueType == ValueTypeText ||
+				item.ValueType == ValueTypeCode || item.ValueType == ValueTypeNum
+		case RelationshipHasAcqContext:
+			ok = item.ValueType == ValueTypeContainer || item.ValueType == ValueTypeImage ||
+				item.ValueType == ValueTypeComposite
+		case RelationshipHasProperties, RelationshipInferredFrom:
+			ok = item.ValueType != ValueTypeContainer
+		case RelationshipHasConceptMod:
+			ok = true
+		case RelationshipSelectedFrom:
+			ok = false // from SCOORD and TCOORD items only
+		default:
+			return fmt.Errorf("%w: relationship %q", ErrInvalidReport, child.Relationship)
+		}
+		if !ok {
+			return fmt.Errorf("%w: %s relationship from a %s content item", ErrInvalidReport, child.Relationship, item.ValueType)
+		}
+		if err := checkContent(child, seen); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Create the item of a content item and its children, whose elements are
+// nested at level
+func newContentItem(item *ContentItem, level uint8) (*Item, error) {
+
+	concept, err := newCodeItem(item.Concept, level+1)
+	if err != nil {
+		return nil, err
+	}
+	values := []itemValue{
+		{TagValueType, Strings{item.ValueType}},
+		{TagConceptNameCodeSequence, Sequence{concept}},
+	}
+	if item.Relationship != "" {
+		values = append(values, itemValue{TagRelationshipType, Strings{item.Relationship}})
+	}
+
+	switch v := item.Value.(type) {
+	case string:
+		tag := map[string]Tag{
+			ValueTypeText:     TagTextValue,
+			ValueTypeDate:     TagDate,
+			ValueTypeTime:     TagTime,
+			ValueTypeDateTime: TagDateTime,
+			ValueTypePName:    TagPersonName,
+			ValueTypeUIDRef:   TagUID,
+		}[item.ValueType]
+		values = append(values, itemValue{tag, Strings{v}})
+	case Code:
+		code, err := newCodeItem(v, level+1)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, itemValue{TagConceptCodeSequence, Sequence{code}})
+	case Measurement:
+		ds, err := FormatDS(v.Value)
+		if err != nil {
+			return nil, err
+		}
+		units, err := newCodeItem(v.Units, level+2)
+		if err != nil {
+			return nil, err
+		}
+		measured, err := newLevelItem([]itemValue{
+			{TagMeasurementUnitsCodeSequence, Sequence{units}},
+			{TagNumericValue, Strings{string(ds)}},
+		}, level+1)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, itemValue{TagMeasuredValueSequence, Sequence{measured}})
+	case Reference:
+		ref, err := newLevelItem([]itemValue{
+			{TagReferencedSOPClassUID, Strings{v.SOPClassUID}},
+			{TagReferencedSOPInstanceUID, Strings{v.SOPInstanceUID}},
+		}, level+1)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, itemValue{TagReferencedSOPSequence, Sequence{ref}})
+	}
+
+	if item.ValueType == ValueTypeContainer {
+		values = append(values, itemValue{TagContinuityOfContent, Strings{"SEPARATE"}})
+	}
+	if len(item.Children) > 0 {
+		var content Sequence
+		for _, child := range item.Children {
+			childItem, err := newContentItem(child, level+1)
+			if err != nil {
+				return nil, err
+			}
+			content = append(content, childItem)
+		}
+		values = append(values, itemValue{TagContentSequence, content})
+	}
+
+	sort.Slice(values, func(i, j int) bool { return tagLess(values[i].tag, values[j].tag) })
+	return newLevelItem(values, level)
+}
+
+// Create the item of a code, whose elements are nested at level
+func newCodeItem(code Code, level uint8) (*Item, error) {
+	return newLevelItem([]itemValue{
+		{TagCodeValue, Strings{code.Value}},
+		{TagCodingSchemeDesignator, Strings{code.Scheme}},
+		{TagCodeMeaning, Strings{code.Meaning}},
+	}, level)
+}
+
+// Create an item whose elements are nested at level
+func newLevelItem(values []itemValue, level uint8) (*Item, error) {
+	item, err := newItem(values)
+	if err != nil {
+		return nil, err
+	}
+	for _, elem := range item.Elements {
+		elem.IndentLevel = level
+	}
+	return item, nil
+}
+
+// Append the references of the IMAGE and COMPOSITE items of a content tree
+// to refs
+func references(item *ContentItem, refs []Reference) []Reference {
+	if ref, ok := item.Value.(Reference); ok {
+		refs = append(refs, ref)
+	}
+	for _, child := range item.Children {
+		refs = references(child, refs)
+	}
+	return refs
+}
+
+// Create a Hierarchical SOP Instance Reference sequence (PS 3.3 C.17.2.1)
+// of refs: an item per study, with an item per series of the study
+func evidenceSequence(refs []Reference) (Sequence, error) {
+
+	var studies []string
+	series := map[string][]string{}
+	instances := map[string]Sequence{}
+	seen := map[string]bool{}
+	for _, ref := range refs {
+		if seen[ref.SOPInstanceUID] {
+			continue
+		}
+		seen[ref.SOPInstanceUID] = true
+
+		if _, ok := series[ref.StudyInstanceUID]; !ok {
+			studies = append(studies, ref.StudyInstanceUID)
+		}
+		if _, ok := instances[ref.SeriesInstanceUID]; !ok {
+			series[ref.StudyInstanceUID] = append(series[ref.StudyInstanceUID], ref.SeriesInstanceUID)
+		}
+		item, err := newLevelItem([]itemValue{
+			{TagReferencedSOPClassUID, Strings{ref.SOPClassUID}},
+			{TagReferencedSOPInstanceUID, Strings{ref.SOPInstanceUID}},
+		}, 3)
+		if err != nil {
+			return nil, err
+		}
+		instances[ref.SeriesInstanceUID] = append(instances[ref.SeriesInstanceUID], item)
+	}
+
+	var seq Sequence
+	for _, study := range studies {
+		var seriesSeq Sequence
+		for _, uid := range series[study] {
+			item, err := newLevelItem([]itemValue{
+				{TagReferencedSOPSequence, instances[uid]},
+				{TagSeriesInstanceUID, Strings{uid}},
+			}, 2)
+			if err != nil {
+				return nil, err
+			}
+			seriesSeq = append(seriesSeq, item)
+		}
+		item, err := newItem([]itemValue{
+			{TagReferencedSeriesSequence, seriesSeq},
+			{TagStudyInstanceUID, Strings{study}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		seq = append(seq, item)
+	}
+
+	return seq, nil
+}
@@ -0,0 +1,165 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func measurementReport(t *testing.T) (*Report, *DicomFile) {
+
+	p, _ := NewParser()
+	image, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref, err := ReferenceTo(image)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := NewReport(Code{"126000", "DCM", "Imaging Measurement Report"})
+	report.Template = "1500"
+	report.Complete = true
+	report.Root.Add(RelationshipHasConceptMod, NewCode(Code{"121049", "DCM", "Language of Content Item and Descendants"}, Code{"en-US", "RFC5646", "English (United States)"}))
+	report.Root.Add(RelationshipHasObsContext, &ContentItem{ValueType: ValueTypePName, Concept: Code{"121008", "DCM", "Person Observer Name"}, Value: "DOE^JANE"})
+
+	measurements := report.Root.Add(RelationshipContains, NewContainer(Code{"126010", "DCM", "Imaging Measurements"}))
+	group := measurements.Add(RelationshipContains, NewContainer(Code{"125007", "DCM", "Measurement Group"}))
+	group.Add(RelationshipContains, NewText(Code{"112039", "DCM", "Tracking Identifier"}, "Lesion 1"))
+	diameter := group.Add(RelationshipContains, NewNum(Code{"103339001", "SCT", "Long axis"}, 12.5, Code{"mm", "UCUM", "mm"}))
+	diameter.Add(RelationshipInferredFrom, NewImage(Code{"121112", "DCM", "Source of Measurement"}, ref))
+
+	return report, image
+}
+
+func TestBuildReport(t *testing.T) {
+
+	report, image := measurementReport(t)
+	sr, err := report.Build(image)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buffer := new(bytes.Buffer)
+	if err := sr.Write(buffer); err != nil {
+		t.Fatal(err)
+	}
+	p, _ := NewParser()
+	parsed, err := p.Parse(buffer.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for tag, expected := range map[Tag]string{
+		TagSOPClassUID:         ComprehensiveSRStorage,
+		TagModality:            "SR",
+		TagValueType:           "CONTAINER",
+		TagContinuityOfContent: "SEPARATE",
+		TagCompletionFlag:      "COMPLETE",
+		TagVerificationFlag:    "UNVERIFIED",
+	} {
+		if s, err := parsed.lookupString(tag); err != nil || s != expected {
+			t.Errorf("Expected %s %s, got %q %v", tag, expected, s, err)
+		}
+	}
+	for _, tag := range []Tag{TagPatientID, TagStudyInstanceUID} {
+		s, _ := parsed.lookupString(tag)
+		if expected, _ := image.lookupString(tag); s != expected {
+			t.Errorf("Expected %s %s, got %s", tag, expected, s)
+		}
+	}
+	series, _ := image.lookupString(TagSeriesInstanceUID)
+	if s, _ := parsed.lookupString(TagSeriesInstanceUID); s == "" || s == series {
+		t.Errorf("Expected a new series, got %q", s)
+	}
+
+	template := parsed.sequence(TagContentTemplateSequence)
+	if len(template) != 1 {
+		t.Fatalf("Expected a template item, got %d", len(template))
+	}
+	if s, _ := template[0].lookupString(TagTemplateIdentifier); s != "1500" {
+		t.Errorf("Expected TID 1500, got %s", s)
+	}
+
+	// Imaging Measurements > Measurement Group > Long axis
+	content := parsed.sequence(TagContentSequence)
+	if len(content) != 3 {
+		t.Fatalf("Expected 3 content items, got %d", len(content))
+	}
+	if s, _ := content[0].lookupString(TagRelationshipType); s != RelationshipHasConceptMod {
+		t.Errorf("Expected %s, got %s", RelationshipHasConceptMod, s)
+	}
+	group := content[2].lookup(TagContentSequence).MustGetSequence()[0]
+	num := group.lookup(TagContentSequence).MustGetSequence()[1]
+	if s, _ := num.lookupString(TagValueType); s != ValueTypeNum {
+		t.Errorf("Expected NUM, got %s", s)
+	}
+	measured := num.lookup(TagMeasuredValueSequence).MustGetSequence()[0]
+	if s, _ := measured.lookupString(TagNumericValue); s != "12.5" {
+		t.Errorf("Expected 12.5, got %s", s)
+	}
+	units := measured.lookup(TagMeasurementUnitsCodeSequence).MustGetSequence()[0]
+	if s, _ := units.lookupString(TagCodeValue); s != "mm" {
+		t.Errorf("Expected mm, got %s", s)
+	}
+
+	// the measured image is evidence of the requested procedure
+	evidence := parsed.sequence(TagCurrentRequestedProcedureEvidenceSequence)
+	if len(evidence) != 1 {
+		t.Fatalf("Expected a study of evidence, got %d", len(evidence))
+	}
+	referenced := evidence[0].lookup(TagReferencedSeriesSequence).MustGetSequence()[0]
+	instance := referenced.lookup(TagReferencedSOPSequence).MustGetSequence()[0]
+	uid, _ := image.lookupString(TagSOPInstanceUID)
+	if s, _ := instance.lookupString(TagReferencedSOPInstanceUID); s != uid {
+		t.Errorf("Expected the image instance, got %s", s)
+	}
+	if len(parsed.sequence(TagPertinentOtherEvidenceSequence)) != 0 {
+		t.Errorf("Expected no other evidence")
+	}
+}
+
+func TestBuildReportNewStudy(t *testing.T) {
+
+	report, _ := measurementReport(t)
+	sr, err := report.Build(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s, _ := sr.lookupString(TagStudyInstanceUID); s == "" {
+		t.Errorf("Expected a new study")
+	}
+	if i := sr.indexOf(TagPatientName); i < 0 {
+		t.Errorf("Expected an empty PatientName")
+	}
+	if len(sr.sequence(TagPertinentOtherEvidenceSequence)) != 1 {
+		t.Errorf("Expected the image as other evidence")
+	}
+}
+
+func TestBuildInvalidReport(t *testing.T) {
+
+	concept := Code{"1", "99TEST", "Test"}
+	for name, build := range map[string]func(*Report){
+		"contains from NUM": func(r *Report) {
+			r.Root.Add(RelationshipContains, NewNum(concept, 1, concept)).Add(RelationshipContains, NewText(concept, "x"))
+		},
+		"value type": func(r *Report) {
+			r.Root.Add(RelationshipContains, &ContentItem{ValueType: ValueTypeText, Concept: concept, Value: 1})
+		},
+		"relationship": func(r *Report) {
+			r.Root.Children = append(r.Root.Children, NewText(concept, "x"))
+		},
+		"cycle": func(r *Report) {
+			r.Root.Add(RelationshipContains, r.Root)
+		},
+	} {
+		report := NewReport(concept)
+		build(report)
+		if _, err := report.Build(nil); !errors.Is(err, ErrInvalidReport) {
+			t.Errorf("%s: expected ErrInvalidReport, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package dicom
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var ErrNoSOPInstanceUID = errors.New("File has no SOPInstanceUID")
+
+// The tags indexed by NewStore when none are given
+var DefaultIndexedTags = []Tag{
+	TagPatientID,
+	TagStudyInstanceUID,
+	TagSeriesInstanceUID,
+	TagSOPInstanceUID,
+	TagAccessionNumber,
+	TagStudyDate,
+	TagModality,
+}
+
+// An in-memory store of files, keyed by SOPInstanceUID, with an index of the
+// values of some top level elements for fast lookups and queries. A Store is
+// safe for concurrent use.
+type Store struct {
+	mu      sync.RWMutex
+	files   []*DicomFile
+	bySOP   map[string]int
+	indexes map[Tag]map[string][]int // value to positions in files
+}
+
+// Create a store indexing the values of tags, or DefaultIndexedTags
+func NewStore(tags ...Tag) *Store {
+
+	if len(tags) == 0 {
+		tags = DefaultIndexedTags
+	}
+
+	store := &Store{
+		bySOP:   map[string]int{},
+		indexes: map[Tag]map[string][]int{},
+	}
+	for _, tag := range tags {
+		store.indexes[tag] = map[string][]int{}
+	}
+
+	return store
+}
+
+// Add a file to the store, replacing the file with the same SOPInstanceUID
+func (store *Store) Add(file *DicomFile) error {
+
+	uid, err := file.lookupString(TagSOPInstanceUID)
+	if err != nil || uid == "" {
+		return ErrNoSOPInstanceUID
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	pos, ok := store.bySOP[uid]
+	if ok {
+		store.unindex(pos)
+		store.files[pos] = file
+	} else {
+		pos = len(store.files)
+		store.files = append(store.files, file)
+		store.bySOP[uid] = pos
+	}
+
+	for tag, index := range store.indexes {
+		for _, v := range indexValues(file, tag) {
+			index[v] = append(index[v], pos)
+		}
+	}
+
+	return nil
+}
+
+// Remove the positions of a file from the indexes
+func (store *Store) unindex(pos int) {
+	file := store.files[pos]
+	for tag, index := range store.indexes {
+		for _, v := range indexValues(file, tag) {
+			positions := index[v]
+			for i, p := range positions {
+				if p == pos {
+					index[v] = append(positions[:i], positions[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+}
+
+// The string values of a top level element, in their canonical form
+func indexValues(file *DicomFile, tag Tag) []string {
+	i := file.indexOf(tag)
+	if i < 0 {
+		return nil
+	}
+	elem := &file.Elements[i]
+	values, err := elem.GetStrings()
+	if err != nil {
+		return nil
+	}
+	normalized := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = NormalizeValue(elem.Vr, v); v != "" {
+			normalized = append(normalized, v)
+		}
+	}
+	return normalized
+}
+
+// Number of files in the store
+func (store *Store) Len() int {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	return len(store.files)
+}
+
+// Return the file with a SOPInstanceUID
+func (store *Store) Get(sopInstanceUID string) (*DicomFile, bool) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	pos, ok := store.bySOP[sopInstanceUID]
+	if !ok {
+		return nil, false
+	}
+	return store.files[pos], true
+}
+
+// Return the files, in the order they were first added
+func (store *Store) Files() []*DicomFile {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	return append([]*DicomFile(nil), store.files...)
+}
+
+// Return the files with a value for an indexed tag, compared in their
+// canonical form as by NormalizeValue, or nil for a tag that is not indexed
+func (store *Store) Lookup(tag Tag, value string) []*DicomFile {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	index, ok := store.indexes[tag]
+	if !ok {
+		return nil
+	}
+	vr := ""
+	if entry, err := standardParser().getDictEntry(tag.Group, tag.Element); err == nil {
+		vr = entry.vr
+	}
+	return store.filesAt(index[NormalizeValue(vr, value)])
+}
+
+// Return the files whose top level elements match every key of query, as
+// with MatchKey. The indexes narrow down the files to match when the query
+// has single value or UID list keys on indexed tags.
+func (store *Store) Query(query *DicomFile, opts MatchOptions) []*DicomFile {
+
+	keys := query.topLevel()
+
+	var matches []*DicomFile
+	for _, file := range store.candidates(keys) {
+		if matchElements(keys, file.topLevel(), opts) {
+			matches = append(matches, file)
+		}
+	}
+
+	return matches
+}
+
+// Return the files that may match the keys, using the indexes
+func (store *Store) candidates(keys []*DicomElement) []*DicomFile {
+
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	var positions map[int]bool
+	for _, key := range keys {
+		index, ok := store.indexes[key.Tag()]
+		if !ok || !isIndexableKey(key) {
+			continue
+		}
+
+		found := map[int]bool{}
+		for _, v := range key.MustGetStrings() {
+			for _, pos := range index[NormalizeValue(key.Vr, v)] {
+				if positions == nil || positions[pos] {
+					found[pos] = true
+				}
+			}
+		}
+		positions = found
+	}
+
+	if positions == nil {
+		return append([]*DicomFile(nil), store.files...)
+	}
+
+	sorted := make([]int, 0, len(positions))
+	for pos := range positions {
+		sorted = append(sorted, pos)
+	}
+	sort.Ints(sorted)
+
+	return store.filesAt(sorted)
+}
+
+// Reports whether a key is matched by the exact values of the index: a
+// single value without wildcards or ranges, or a list of UIDs
+func isIndexableKey(key *DicomElement) bool {
+
+	values, ok := key.Value.(Strings)
+	if !ok || isEmpty(key.Value) || key.Vr == "PN" {
+		return false
+	}
+	if len(values) > 1 && key.Vr != "UI" {
+		return false
+	}
+
+	for _, v := range values {
+		if strings.ContainsAny(v, "*?") {
+			return false
+		}
+		if strings.Contains(v, "-") && (key.Vr == "DA" || key.Vr == "TM" || key.Vr == "DT") {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (store *Store) filesAt(positions []int) []*DicomFile {
+	files := make([]*DicomFile, len(positions))
+	for i, pos := range positions {
+		files[i] = store.files[pos]
+	}
+	return files
+}
+
+// Return the files satisfying a filter, in the order they were first added
+func (store *Store) Filter(f *Filter) []*DicomFile {
+
+	var matches []*DicomFile
+	for _, file := range store.Files() {
+		if f.Match(file) {
+			matches = append(matches, file)
+		}
+	}
+
+	return matches
+}
@@ -0,0 +1,93 @@
+package dicom
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestStore(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newFile := func(instance, modality string) *DicomFile {
+		f := file.Clone()
+		f.Elements[f.indexOf(TagSOPInstanceUID)].Value = Strings{instance}
+		f.Elements[f.indexOf(TagModality)].Value = Strings{modality}
+		return f
+	}
+
+	store := NewStore()
+	store.Add(newFile("1.1", "CT"))
+	store.Add(newFile("1.2", "MR"))
+	store.Add(newFile("1.3", "CT"))
+	store.Add(newFile("1.2", "CT")) // replaces 1.2
+
+	if err := store.Add(&DicomFile{}); err != ErrNoSOPInstanceUID {
+		t.Errorf("Expected ErrNoSOPInstanceUID, got %v", err)
+	}
+
+	if store.Len() != 3 {
+		t.Errorf("Expected 3 files, got %d", store.Len())
+	}
+
+	if files := store.Lookup(TagModality, "CT"); len(files) != 3 {
+		t.Errorf("Expected 3 CT files, got %d", len(files))
+	}
+
+	if files := store.Lookup(TagModality, " CT "); len(files) != 3 {
+		t.Errorf("Expected 3 CT files, got %d", len(files))
+	}
+	if files := store.Lookup(TagSOPInstanceUID, "1.3\x00"); len(files) != 1 {
+		t.Errorf("Expected 1 file, got %d", len(files))
+	}
+
+	if files := store.Lookup(TagModality, "MR"); len(files) != 0 {
+		t.Errorf("Expected no MR files, got %d", len(files))
+	}
+
+	if f, ok := store.Get("1.3"); !ok || f.Elements[f.indexOf(TagSOPInstanceUID)].MustGetString() != "1.3" {
+		t.Error("Could not get file 1.3")
+	}
+
+	query := &DicomFile{}
+	for _, key := range []struct {
+		tag   Tag
+		value Value
+	}{
+		{TagModality, Strings{"CT"}},
+		{TagSOPInstanceUID, Strings{"1.1", "1.3"}},
+		{TagPatientName, Strings{"TOUT*"}},
+	} {
+		elem, _ := p.NewElement(key.tag, nil)
+		elem.Value = key.value
+		query.insertElement(elem)
+	}
+
+	if files := store.Query(query, MatchOptions{}); len(files) != 2 {
+		t.Errorf("Expected 2 matching files, got %d", len(files))
+	}
+
+}
+
+func TestStoreFilter(t *testing.T) {
+
+	p, _ := NewParser()
+	store := NewStore()
+	for _, name := range []string{"examples/IM-0001-0001.dcm", "examples/I_000000.dcm"} {
+		buff, _ := ioutil.ReadFile(name)
+		file, err := p.Parse(buff)
+		if err != nil {
+			t.Fatal(err)
+		}
+		store.Add(file)
+	}
+
+	if files := store.Filter(MustCompileFilter(`Modality == "CT" && PatientName == "TOUTATIX"`)); len(files) != 1 {
+		t.Errorf("Expected 1 file, got %d", len(files))
+	}
+
+}
@@ -0,0 +1,74 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidTag = errors.New("Invalid tag")
+
+//go:generate go run gentags.go
+
+// A DICOM tag, ie. (0010,0010). Constants for every standard tag in the
+// dictionary are generated in tags.go, ie. TagPatientName.
+type Tag struct {
+	Group   uint16
+	Element uint16
+}
+
+// Stringer
+func (t Tag) String() string {
+	return fmt.Sprintf("(%04X,%04X)", t.Group, t.Element)
+}
+
+// Parse a keyword of the standard dictionary, ie. PatientName, or a tag,
+// ie. (0010,0010)
+func ParseTag(s string) (Tag, error) {
+
+	if !strings.HasPrefix(s, "(") {
+		return standardParser().LookupTag(s)
+	}
+
+	if len(s) != 11 || s[5] != ',' || s[10] != ')' {
+		return Tag{}, fmt.Errorf("%w: %s", ErrInvalidTag, s)
+	}
+	group, element, err := splitTag(s)
+	if err != nil || group < 0 || element < 0 {
+		return Tag{}, fmt.Errorf("%w: %s", ErrInvalidTag, s)
+	}
+
+	return Tag{uint16(group), uint16(element)}, nil
+}
+
+// Return the tag of the element
+func (e *DicomElement) Tag() Tag {
+	return Tag{e.Group, e.Element}
+}
+
+// Lookup an element by tag
+func (file *DicomFile) LookupElementByTag(tag Tag) (*DicomElement, error) {
+
+	for _, elem := range file.Elements {
+		if elem.Group == tag.Group && elem.Element == tag.Element {
+			return &elem, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// Return every element with the given tag, at the top level as well as
+// nested in sequence items, in file order
+func (file *DicomFile) FindAllByTag(tag Tag) []*DicomElement {
+
+	var elems []*DicomElement
+	file.Walk(func(path TagPath, elem *DicomElement) error {
+		if elem.Tag() == tag {
+			elems = append(elems, elem)
+		}
+		return nil
+	})
+
+	return elems
+}
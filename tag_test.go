@@ -0,0 +1,88 @@
+package dicom
+
+import (
+	"testing"
+)
+
+func TestTagString(t *testing.T) {
+	if s := TagPixelData.String(); s != "(7FE0,0010)" {
+		t.Errorf("Incorrect tag string %s", s)
+	}
+}
+
+func TestGeneratedTags(t *testing.T) {
+
+	if TagPatientName != (Tag{0x0010, 0x0010}) {
+		t.Errorf("Incorrect PatientName tag %s", TagPatientName)
+	}
+
+	if TagStudyInstanceUID != (Tag{0x0020, 0x000D}) {
+		t.Errorf("Incorrect StudyInstanceUID tag %s", TagStudyInstanceUID)
+	}
+
+	// retired entries are generated without their prefix
+	if TagCoefficientsSDDN != (Tag{0x7FE0, 0x0040}) {
+		t.Errorf("Incorrect CoefficientsSDDN tag %s", TagCoefficientsSDDN)
+	}
+
+}
+
+func TestLookupElementByTag(t *testing.T) {
+
+	file := &DicomFile{}
+	file.Elements = append(file.Elements, DicomElement{Group: 0x0010, Element: 0x0010, Name: "PatientName", Vr: "PN", Value: Strings{"TOUTATIX"}})
+
+	elem, err := file.LookupElementByTag(TagPatientName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if elem.Value.(Strings)[0] != "TOUTATIX" {
+		t.Errorf("Incorrect value %v", elem.Value)
+	}
+
+	if _, err := file.LookupElementByTag(TagPatientID); err != ErrNotFound {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+}
+
+func TestFindAllByTag(t *testing.T) {
+
+	parser, _ := NewParser()
+	data, err := parser.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	elems := data.FindAllByTag(TagReferencedSOPInstanceUID)
+	if l := len(elems); l != 4 {
+		t.Errorf("Incorrect number of ReferencedSOPInstanceUIDs: %d", l)
+	}
+
+	if elems := data.FindAllByTag(TagPatientName); len(elems) != 1 {
+		t.Errorf("Incorrect number of PatientNames: %d", len(elems))
+	}
+
+}
+
+func TestParseTag(t *testing.T) {
+
+	for s, expected := range map[string]Tag{
+		"PatientName": TagPatientName,
+		"(0010,0010)": TagPatientName,
+		"(7fe0,0010)": TagPixelData,
+		"(0009,1001)": {0x0009, 0x1001},
+	} {
+		if tag, err := ParseTag(s); err != nil || tag != expected {
+			t.Errorf("Incorrect tag for %s: %s %v", s, tag, err)
+		}
+	}
+
+	for _, s := range []string{"NoSuchKeyword", "(0010,0010", "(10,10)", "(001g,0010)", "(-010,0010)"} {
+		if _, err := ParseTag(s); err == nil {
+			t.Errorf("Expected an error for %s", s)
+		}
+	}
+
+}
@@ -0,0 +1,3731 @@
+// Code generated by gentags.go; DO NOT EDIT.
+
+package dicom
+
+// Tags of the default dictionary
+var (
+	TagCommandGroupLength                                             = Tag{0x0000, 0x0000} // UL 1
+	TagAffectedSOPClassUID                                            = Tag{0x0000, 0x0002} // UI 1
+	TagRequestedSOPClassUID                                           = Tag{0x0000, 0x0003} // UI 1
+	TagCommandField                                                   = Tag{0x0000, 0x0100} // US 1
+	TagMessageID                                                      = Tag{0x0000, 0x0110} // US 1
+	TagMessageIDBeingRespondedTo                                      = Tag{0x0000, 0x0120} // US 1
+	TagMoveDestination                                                = Tag{0x0000, 0x0600} // AE 1
+	TagPriority                                                       = Tag{0x0000, 0x0700} // US 1
+	TagCommandDataSetType                                             = Tag{0x0000, 0x0800} // US 1
+	TagStatus                                                         = Tag{0x0000, 0x0900} // US 1
+	TagOffendingElement                                               = Tag{0x0000, 0x0901} // AT 1-n
+	TagErrorComment                                                   = Tag{0x0000, 0x0902} // LO 1
+	TagErrorID                                                        = Tag{0x0000, 0x0903} // US 1
+	TagAffectedSOPInstanceUID                                         = Tag{0x0000, 0x1000} // UI 1
+	TagRequestedSOPInstanceUID                                        = Tag{0x0000, 0x1001} // UI 1
+	TagEventTypeID                                                    = Tag{0x0000, 0x1002} // US 1
+	TagAttributeIdentifierList                                        = Tag{0x0000, 0x1005} // AT 1-n
+	TagActionTypeID                                                   = Tag{0x0000, 0x1008} // US 1
+	TagNumberOfRemainingSuboperations                                 = Tag{0x0000, 0x1020} // US 1
+	TagNumberOfCompletedSuboperations                                 = Tag{0x0000, 0x1021} // US 1
+	TagNumberOfFailedSuboperations                                    = Tag{0x0000, 0x1022} // US 1
+	TagNumberOfWarningSuboperations                                   = Tag{0x0000, 0x1023} // US 1
+	TagMoveOriginatorApplicationEntityTitle                           = Tag{0x0000, 0x1030} // AE 1
+	TagMoveOriginatorMessageID                                        = Tag{0x0000, 0x1031} // US 1
+	TagFileMetaInformationGroupLength                                 = Tag{0x0002, 0x0000} // UL 1
+	TagFileMetaInformationVersion                                     = Tag{0x0002, 0x0001} // OB 1
+	TagMediaStorageSOPClassUID                                        = Tag{0x0002, 0x0002} // UI 1
+	TagMediaStorageSOPInstanceUID                                     = Tag{0x0002, 0x0003} // UI 1
+	TagTransferSyntaxUID                                              = Tag{0x0002, 0x0010} // UI 1
+	TagImplementationClassUID                                         = Tag{0x0002, 0x0012} // UI 1
+	TagImplementationVersionName                                      = Tag{0x0002, 0x0013} // SH 1
+	TagSourceApplicationEntityTitle                                   = Tag{0x0002, 0x0016} // AE 1
+	TagPrivateInformationCreatorUID                                   = Tag{0x0002, 0x0100} // UI 1
+	TagPrivateInformation                                             = Tag{0x0002, 0x0102} // OB 1
+	TagFileSetID                                                      = Tag{0x0004, 0x1130} // CS 1
+	TagFileSetDescriptorFileID                                        = Tag{0x0004, 0x1141} // CS 1-8
+	TagSpecificCharacterSetOfFileSetDescriptorFile                    = Tag{0x0004, 0x1142} // CS 1
+	TagOffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity        = Tag{0x0004, 0x1200} // UP 1
+	TagOffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity         = Tag{0x0004, 0x1202} // UP 1
+	TagFileSetConsistencyFlag                                         = Tag{0x0004, 0x1212} // US 1
+	TagDirectoryRecordSequence                                        = Tag{0x0004, 0x1220} // SQ 1
+	TagOffsetOfTheNextDirectoryRecord                                 = Tag{0x0004, 0x1400} // UP 1
+	TagRecordInUseFlag                                                = Tag{0x0004, 0x1410} // US 1
+	TagOffsetOfReferencedLowerLevelDirectoryEntity                    = Tag{0x0004, 0x1420} // UP 1
+	TagDirectoryRecordType                                            = Tag{0x0004, 0x1430} // CS 1
+	TagPrivateRecordUID                                               = Tag{0x0004, 0x1432} // UI 1
+	TagReferencedFileID                                               = Tag{0x0004, 0x1500} // CS 1-8
+	TagReferencedSOPClassUIDInFile                                    = Tag{0x0004, 0x1510} // UI 1
+	TagReferencedSOPInstanceUIDInFile                                 = Tag{0x0004, 0x1511} // UI 1
+	TagReferencedTransferSyntaxUIDInFile                              = Tag{0x0004, 0x1512} // UI 1
+	TagReferencedRelatedGeneralSOPClassUIDInFile                      = Tag{0x0004, 0x151A} // UI 1-n
+	TagSpecificCharacterSet                                           = Tag{0x0008, 0x0005} // CS 1-n
+	TagLanguageCodeSequence                                           = Tag{0x0008, 0x0006} // SQ 1
+	TagImageType                                                      = Tag{0x0008, 0x0008} // CS 2-n
+	TagInstanceCreationDate                                           = Tag{0x0008, 0x0012} // DA 1
+	TagInstanceCreationTime                                           = Tag{0x0008, 0x0013} // TM 1
+	TagInstanceCreatorUID                                             = Tag{0x0008, 0x0014} // UI 1
+	TagSOPClassUID                                                    = Tag{0x0008, 0x0016} // UI 1
+	TagSOPInstanceUID                                                 = Tag{0x0008, 0x0018} // UI 1
+	TagRelatedGeneralSOPClassUID                                      = Tag{0x0008, 0x001A} // UI 1-n
+	TagOriginalSpecializedSOPClassUID                                 = Tag{0x0008, 0x001B} // UI 1
+	TagStudyDate                                                      = Tag{0x0008, 0x0020} // DA 1
+	TagSeriesDate                                                     = Tag{0x0008, 0x0021} // DA 1
+	TagAcquisitionDate                                                = Tag{0x0008, 0x0022} // DA 1
+	TagContentDate                                                    = Tag{0x0008, 0x0023} // DA 1
+	TagAcquisitionDateTime                                            = Tag{0x0008, 0x002A} // DT 1
+	TagStudyTime                                                      = Tag{0x0008, 0x0030} // TM 1
+	TagSeriesTime                                                     = Tag{0x0008, 0x0031} // TM 1
+	TagAcquisitionTime                                                = Tag{0x0008, 0x0032} // TM 1
+	TagContentTime                                                    = Tag{0x0008, 0x0033} // TM 1
+	TagAccessionNumber                                                = Tag{0x0008, 0x0050} // SH 1
+	TagIssuerOfAccessionNumberSequence                                = Tag{0x0008, 0x0051} // SQ 1
+	TagQueryRetrieveLevel                                             = Tag{0x0008, 0x0052} // CS 1
+	TagRetrieveAETitle                                                = Tag{0x0008, 0x0054} // AE 1-n
+	TagInstanceAvailability                                           = Tag{0x0008, 0x0056} // CS 1
+	TagFailedSOPInstanceUIDList                                       = Tag{0x0008, 0x0058} // UI 1-n
+	TagModality                                                       = Tag{0x0008, 0x0060} // CS 1
+	TagModalitiesInStudy                                              = Tag{0x0008, 0x0061} // CS 1-n
+	TagSOPClassesInStudy                                              = Tag{0x0008, 0x0062} // UI 1-n
+	TagConversionType                                                 = Tag{0x0008, 0x0064} // CS 1
+	TagPresentationIntentType                                         = Tag{0x0008, 0x0068} // CS 1
+	TagManufacturer                                                   = Tag{0x0008, 0x0070} // LO 1
+	TagInstitutionName                                                = Tag{0x0008, 0x0080} // LO 1
+	TagInstitutionAddress                                             = Tag{0x0008, 0x0081} // ST 1
+	TagInstitutionCodeSequence                                        = Tag{0x0008, 0x0082} // SQ 1
+	TagReferringPhysicianName                                         = Tag{0x0008, 0x0090} // PN 1
+	TagReferringPhysicianAddress                                      = Tag{0x0008, 0x0092} // ST 1
+	TagReferringPhysicianTelephoneNumbers                             = Tag{0x0008, 0x0094} // SH 1-n
+	TagReferringPhysicianIdentificationSequence                       = Tag{0x0008, 0x0096} // SQ 1
+	TagCodeValue                                                      = Tag{0x0008, 0x0100} // SH 1
+	TagCodingSchemeDesignator                                         = Tag{0x0008, 0x0102} // SH 1
+	TagCodingSchemeVersion                                            = Tag{0x0008, 0x0103} // SH 1
+	TagCodeMeaning                                                    = Tag{0x0008, 0x0104} // LO 1
+	TagMappingResource                                                = Tag{0x0008, 0x0105} // CS 1
+	TagContextGroupVersion                                            = Tag{0x0008, 0x0106} // DT 1
+	TagContextGroupLocalVersion                                       = Tag{0x0008, 0x0107} // DT 1
+	TagContextGroupExtensionFlag                                      = Tag{0x0008, 0x010B} // CS 1
+	TagCodingSchemeUID                                                = Tag{0x0008, 0x010C} // UI 1
+	TagContextGroupExtensionCreatorUID                                = Tag{0x0008, 0x010D} // UI 1
+	TagContextIdentifier                                              = Tag{0x0008, 0x010F} // CS 1
+	TagCodingSchemeIdentificationSequence                             = Tag{0x0008, 0x0110} // SQ 1
+	TagCodingSchemeRegistry                                           = Tag{0x0008, 0x0112} // LO 1
+	TagCodingSchemeExternalID                                         = Tag{0x0008, 0x0114} // ST 1
+	TagCodingSchemeName                                               = Tag{0x0008, 0x0115} // ST 1
+	TagCodingSchemeResponsibleOrganization                            = Tag{0x0008, 0x0116} // ST 1
+	TagContextUID                                                     = Tag{0x0008, 0x0117} // UI 1
+	TagTimezoneOffsetFromUTC                                          = Tag{0x0008, 0x0201} // SH 1
+	TagStationName                                                    = Tag{0x0008, 0x1010} // SH 1
+	TagStudyDescription                                               = Tag{0x0008, 0x1030} // LO 1
+	TagProcedureCodeSequence                                          = Tag{0x0008, 0x1032} // SQ 1
+	TagSeriesDescription                                              = Tag{0x0008, 0x103E} // LO 1
+	TagSeriesDescriptionCodeSequence                                  = Tag{0x0008, 0x103F} // SQ 1
+	TagInstitutionalDepartmentName                                    = Tag{0x0008, 0x1040} // LO 1
+	TagPhysiciansOfRecord                                             = Tag{0x0008, 0x1048} // PN 1-n
+	TagPhysiciansOfRecordIdentificationSequence                       = Tag{0x0008, 0x1049} // SQ 1
+	TagPerformingPhysicianName                                        = Tag{0x0008, 0x1050} // PN 1-n
+	TagPerformingPhysicianIdentificationSequence                      = Tag{0x0008, 0x1052} // SQ 1
+	TagNameOfPhysiciansReadingStudy                                   = Tag{0x0008, 0x1060} // PN 1-n
+	TagPhysiciansReadingStudyIdentificationSequence                   = Tag{0x0008, 0x1062} // SQ 1
+	TagOperatorsName                                                  = Tag{0x0008, 0x1070} // PN 1-n
+	TagOperatorIdentificationSequence                                 = Tag{0x0008, 0x1072} // SQ 1
+	TagAdmittingDiagnosesDescription                                  = Tag{0x0008, 0x1080} // LO 1-n
+	TagAdmittingDiagnosesCodeSequence                                 = Tag{0x0008, 0x1084} // SQ 1
+	TagManufacturerModelName                                          = Tag{0x0008, 0x1090} // LO 1
+	TagReferencedStudySequence                                        = Tag{0x0008, 0x1110} // SQ 1
+	TagReferencedPerformedProcedureStepSequence                       = Tag{0x0008, 0x1111} // SQ 1
+	TagReferencedSeriesSequence                                       = Tag{0x0008, 0x1115} // SQ 1
+	TagReferencedPatientSequence                                      = Tag{0x0008, 0x1120} // SQ 1
+	TagReferencedVisitSequence                                        = Tag{0x0008, 0x1125} // SQ 1
+	TagReferencedStereometricInstanceSequence                         = Tag{0x0008, 0x1134} // SQ 1
+	TagReferencedWaveformSequence                                     = Tag{0x0008, 0x113A} // SQ 1
+	TagReferencedImageSequence                                        = Tag{0x0008, 0x1140} // SQ 1
+	TagReferencedInstanceSequence                                     = Tag{0x0008, 0x114A} // SQ 1
+	TagReferencedRealWorldValueMappingInstanceSequence                = Tag{0x0008, 0x114B} // SQ 1
+	TagReferencedSOPClassUID                                          = Tag{0x0008, 0x1150} // UI 1
+	TagReferencedSOPInstanceUID                                       = Tag{0x0008, 0x1155} // UI 1
+	TagSOPClassesSupported                                            = Tag{0x0008, 0x115A} // UI 1-n
+	TagReferencedFrameNumber                                          = Tag{0x0008, 0x1160} // IS 1-n
+	TagSimpleFrameList                                                = Tag{0x0008, 0x1161} // UL 1-n
+	TagCalculatedFrameList                                            = Tag{0x0008, 0x1162} // UL 3-3n
+	TagTimeRange                                                      = Tag{0x0008, 0x1163} // FD 2
+	TagFrameExtractionSequence                                        = Tag{0x0008, 0x1164} // SQ 1
+	TagMultiFrameSourceSOPInstanceUID                                 = Tag{0x0008, 0x1167} // UI 1
+	TagTransactionUID                                                 = Tag{0x0008, 0x1195} // UI 1
+	TagFailureReason                                                  = Tag{0x0008, 0x1197} // US 1
+	TagFailedSOPSequence                                              = Tag{0x0008, 0x1198} // SQ 1
+	TagReferencedSOPSequence                                          = Tag{0x0008, 0x1199} // SQ 1
+	TagStudiesContainingOtherReferencedInstancesSequence              = Tag{0x0008, 0x1200} // SQ 1
+	TagRelatedSeriesSequence                                          = Tag{0x0008, 0x1250} // SQ 1
+	TagDerivationDescription                                          = Tag{0x0008, 0x2111} // ST 1
+	TagSourceImageSequence                                            = Tag{0x0008, 0x2112} // SQ 1
+	TagStageName                                                      = Tag{0x0008, 0x2120} // SH 1
+	TagStageNumber                                                    = Tag{0x0008, 0x2122} // IS 1
+	TagNumberOfStages                                                 = Tag{0x0008, 0x2124} // IS 1
+	TagViewName                                                       = Tag{0x0008, 0x2127} // SH 1
+	TagViewNumber                                                     = Tag{0x0008, 0x2128} // IS 1
+	TagNumberOfEventTimers                                            = Tag{0x0008, 0x2129} // IS 1
+	TagNumberOfViewsInStage                                           = Tag{0x0008, 0x212A} // IS 1
+	TagEventElapsedTimes                                              = Tag{0x0008, 0x2130} // DS 1-n
+	TagEventTimerNames                                                = Tag{0x0008, 0x2132} // LO 1-n
+	TagEventTimerSequence                                             = Tag{0x0008, 0x2133} // SQ 1
+	TagEventTimeOffset                                                = Tag{0x0008, 0x2134} // FD 1
+	TagEventCodeSequence                                              = Tag{0x0008, 0x2135} // SQ 1
+	TagStartTrim                                                      = Tag{0x0008, 0x2142} // IS 1
+	TagStopTrim                                                       = Tag{0x0008, 0x2143} // IS 1
+	TagRecommendedDisplayFrameRate                                    = Tag{0x0008, 0x2144} // IS 1
+	TagAnatomicRegionSequence                                         = Tag{0x0008, 0x2218} // SQ 1
+	TagAnatomicRegionModifierSequence                                 = Tag{0x0008, 0x2220} // SQ 1
+	TagPrimaryAnatomicStructureSequence                               = Tag{0x0008, 0x2228} // SQ 1
+	TagAnatomicStructureSpaceOrRegionSequence                         = Tag{0x0008, 0x2229} // SQ 1
+	TagPrimaryAnatomicStructureModifierSequence                       = Tag{0x0008, 0x2230} // SQ 1
+	TagAlternateRepresentationSequence                                = Tag{0x0008, 0x3001} // SQ 1
+	TagIrradiationEventUID                                            = Tag{0x0008, 0x3010} // UI 1
+	TagFrameType                                                      = Tag{0x0008, 0x9007} // CS 4
+	TagReferencedImageEvidenceSequence                                = Tag{0x0008, 0x9092} // SQ 1
+	TagReferencedRawDataSequence                                      = Tag{0x0008, 0x9121} // SQ 1
+	TagCreatorVersionUID                                              = Tag{0x0008, 0x9123} // UI 1
+	TagDerivationImageSequence                                        = Tag{0x0008, 0x9124} // SQ 1
+	TagSourceImageEvidenceSequence                                    = Tag{0x0008, 0x9154} // SQ 1
+	TagPixelPresentation                                              = Tag{0x0008, 0x9205} // CS 1
+	TagVolumetricProperties                                           = Tag{0x0008, 0x9206} // CS 1
+	TagVolumeBasedCalculationTechnique                                = Tag{0x0008, 0x9207} // CS 1
+	TagComplexImageComponent                                          = Tag{0x0008, 0x9208} // CS 1
+	TagAcquisitionContrast                                            = Tag{0x0008, 0x9209} // CS 1
+	TagDerivationCodeSequence                                         = Tag{0x0008, 0x9215} // SQ 1
+	TagReferencedPresentationStateSequence                            = Tag{0x0008, 0x9237} // SQ 1
+	TagReferencedOtherPlaneSequence                                   = Tag{0x0008, 0x9410} // SQ 1
+	TagFrameDisplaySequence                                           = Tag{0x0008, 0x9458} // SQ 1
+	TagRecommendedDisplayFrameRateInFloat                             = Tag{0x0008, 0x9459} // FL 1
+	TagSkipFrameRangeFlag                                             = Tag{0x0008, 0x9460} // CS 1
+	TagPatientName                                                    = Tag{0x0010, 0x0010} // PN 1
+	TagPatientID                                                      = Tag{0x0010, 0x0020} // LO 1
+	TagIssuerOfPatientID                                              = Tag{0x0010, 0x0021} // LO 1
+	TagTypeOfPatientID                                                = Tag{0x0010, 0x0022} // CS 1
+	TagIssuerOfPatientIDQualifiersSequence                            = Tag{0x0010, 0x0024} // SQ 1
+	TagPatientBirthDate                                               = Tag{0x0010, 0x0030} // DA 1
+	TagPatientBirthTime                                               = Tag{0x0010, 0x0032} // TM 1
+	TagPatientSex                                                     = Tag{0x0010, 0x0040} // CS 1
+	TagPatientInsurancePlanCodeSequence                               = Tag{0x0010, 0x0050} // SQ 1
+	TagPatientPrimaryLanguageCodeSequence                             = Tag{0x0010, 0x0101} // SQ 1
+	TagPatientPrimaryLanguageModifierCodeSequence                     = Tag{0x0010, 0x0102} // SQ 1
+	TagOtherPatientIDs                                                = Tag{0x0010, 0x1000} // LO 1-n
+	TagOtherPatientNames                                              = Tag{0x0010, 0x1001} // PN 1-n
+	TagOtherPatientIDsSequence                                        = Tag{0x0010, 0x1002} // SQ 1
+	TagPatientBirthName                                               = Tag{0x0010, 0x1005} // PN 1
+	TagPatientAge                                                     = Tag{0x0010, 0x1010} // AS 1
+	TagPatientSize                                                    = Tag{0x0010, 0x1020} // DS 1
+	TagPatientSizeCodeSequence                                        = Tag{0x0010, 0x1021} // SQ 1
+	TagPatientWeight                                                  = Tag{0x0010, 0x1030} // DS 1
+	TagPatientAddress                                                 = Tag{0x0010, 0x1040} // LO 1
+	TagPatientMotherBirthName                                         = Tag{0x0010, 0x1060} // PN 1
+	TagMilitaryRank                                                   = Tag{0x0010, 0x1080} // LO 1
+	TagBranchOfService                                                = Tag{0x0010, 0x1081} // LO 1
+	TagMedicalRecordLocator                                           = Tag{0x0010, 0x1090} // LO 1
+	TagMedicalAlerts                                                  = Tag{0x0010, 0x2000} // LO 1-n
+	TagAllergies                                                      = Tag{0x0010, 0x2110} // LO 1-n
+	TagCountryOfResidence                                             = Tag{0x0010, 0x2150} // LO 1
+	TagRegionOfResidence                                              = Tag{0x0010, 0x2152} // LO 1
+	TagPatientTelephoneNumbers                                        = Tag{0x0010, 0x2154} // SH 1-n
+	TagEthnicGroup                                                    = Tag{0x0010, 0x2160} // SH 1
+	TagOccupation                                                     = Tag{0x0010, 0x2180} // SH 1
+	TagSmokingStatus                                                  = Tag{0x0010, 0x21A0} // CS 1
+	TagAdditionalPatientHistory                                       = Tag{0x0010, 0x21B0} // LT 1
+	TagPregnancyStatus                                                = Tag{0x0010, 0x21C0} // US 1
+	TagLastMenstrualDate                                              = Tag{0x0010, 0x21D0} // DA 1
+	TagPatientReligiousPreference                                     = Tag{0x0010, 0x21F0} // LO 1
+	TagPatientSpeciesDescription                                      = Tag{0x0010, 0x2201} // LO 1
+	TagPatientSpeciesCodeSequence                                     = Tag{0x0010, 0x2202} // SQ 1
+	TagPatientSexNeutered                                             = Tag{0x0010, 0x2203} // CS 1
+	TagAnatomicalOrientationType                                      = Tag{0x0010, 0x2210} // CS 1
+	TagPatientBreedDescription                                        = Tag{0x0010, 0x2292} // LO 1
+	TagPatientBreedCodeSequence                                       = Tag{0x0010, 0x2293} // SQ 1
+	TagBreedRegistrationSequence                                      = Tag{0x0010, 0x2294} // SQ 1
+	TagBreedRegistrationNumber                                        = Tag{0x0010, 0x2295} // LO 1
+	TagBreedRegistryCodeSequence                                      = Tag{0x0010, 0x2296} // SQ 1
+	TagResponsiblePerson                                              = Tag{0x0010, 0x2297} // PN 1
+	TagResponsiblePersonRole                                          = Tag{0x0010, 0x2298} // CS 1
+	TagResponsibleOrganization                                        = Tag{0x0010, 0x2299} // LO 1
+	TagPatientComments                                                = Tag{0x0010, 0x4000} // LT 1
+	TagExaminedBodyThickness                                          = Tag{0x0010, 0x9431} // FL 1
+	TagClinicalTrialSponsorName                                       = Tag{0x0012, 0x0010} // LO 1
+	TagClinicalTrialProtocolID                                        = Tag{0x0012, 0x0020} // LO 1
+	TagClinicalTrialProtocolName                                      = Tag{0x0012, 0x0021} // LO 1
+	TagClinicalTrialSiteID                                            = Tag{0x0012, 0x0030} // LO 1
+	TagClinicalTrialSiteName                                          = Tag{0x0012, 0x0031} // LO 1
+	TagClinicalTrialSubjectID                                         = Tag{0x0012, 0x0040} // LO 1
+	TagClinicalTrialSubjectReadingID                                  = Tag{0x0012, 0x0042} // LO 1
+	TagClinicalTrialTimePointID                                       = Tag{0x0012, 0x0050} // LO 1
+	TagClinicalTrialTimePointDescription                              = Tag{0x0012, 0x0051} // ST 1
+	TagClinicalTrialCoordinatingCenterName                            = Tag{0x0012, 0x0060} // LO 1
+	TagPatientIdentityRemoved                                         = Tag{0x0012, 0x0062} // CS 1
+	TagDeidentificationMethod                                         = Tag{0x0012, 0x0063} // LO 1-n
+	TagDeidentificationMethodCodeSequence                             = Tag{0x0012, 0x0064} // SQ 1
+	TagClinicalTrialSeriesID                                          = Tag{0x0012, 0x0071} // LO 1
+	TagClinicalTrialSeriesDescription                                 = Tag{0x0012, 0x0072} // LO 1
+	TagClinicalTrialProtocolEthicsCommitteeName                       = Tag{0x0012, 0x0081} // LO 1
+	TagClinicalTrialProtocolEthicsCommitteeApprovalNumber             = Tag{0x0012, 0x0082} // LO 1
+	TagConsentForClinicalTrialUseSequence                             = Tag{0x0012, 0x0083} // SQ 1
+	TagDistributionType                                               = Tag{0x0012, 0x0084} // CS 1
+	TagConsentForDistributionFlag                                     = Tag{0x0012, 0x0085} // CS 1
+	TagCADFileFormat                                                  = Tag{0x0014, 0x0023} // ST 1-n
+	TagComponentReferenceSystem                                       = Tag{0x0014, 0x0024} // ST 1-n
+	TagComponentManufacturingProcedure                                = Tag{0x0014, 0x0025} // ST 1-n
+	TagComponentManufacturer                                          = Tag{0x0014, 0x0028} // ST 1-n
+	TagMaterialThickness                                              = Tag{0x0014, 0x0030} // DS 1-n
+	TagMaterialPipeDiameter                                           = Tag{0x0014, 0x0032} // DS 1-n
+	TagMaterialIsolationDiameter                                      = Tag{0x0014, 0x0034} // DS 1-n
+	TagMaterialGrade                                                  = Tag{0x0014, 0x0042} // ST 1-n
+	TagMaterialPropertiesFileID                                       = Tag{0x0014, 0x0044} // ST 1-n
+	TagMaterialPropertiesFileFormat                                   = Tag{0x0014, 0x0045} // ST 1-n
+	TagMaterialNotes                                                  = Tag{0x0014, 0x0046} // LT 1
+	TagComponentShape                                                 = Tag{0x0014, 0x0050} // CS 1
+	TagCurvatureType                                                  = Tag{0x0014, 0x0052} // CS 1
+	TagOuterDiameter                                                  = Tag{0x0014, 0x0054} // DS 1
+	TagInnerDiameter                                                  = Tag{0x0014, 0x0056} // DS 1
+	TagActualEnvironmentalConditions                                  = Tag{0x0014, 0x1010} // ST 1
+	TagExpiryDate                                                     = Tag{0x0014, 0x1020} // DA 1
+	TagEnvironmentalConditions                                        = Tag{0x0014, 0x1040} // ST 1
+	TagEvaluatorSequence                                              = Tag{0x0014, 0x2002} // SQ 1
+	TagEvaluatorNumber                                                = Tag{0x0014, 0x2004} // IS 1
+	TagEvaluatorName                                                  = Tag{0x0014, 0x2006} // PN 1
+	TagEvaluationAttempt                                              = Tag{0x0014, 0x2008} // IS 1
+	TagIndicationSequence                                             = Tag{0x0014, 0x2012} // SQ 1
+	TagIndicationNumber                                               = Tag{0x0014, 0x2014} // IS 1
+	TagIndicationLabel                                                = Tag{0x0014, 0x2016} // SH 1
+	TagIndicationDescription                                          = Tag{0x0014, 0x2018} // ST 1
+	TagIndicationType                                                 = Tag{0x0014, 0x201A} // CS 1-n
+	TagIndicationDisposition                                          = Tag{0x0014, 0x201C} // CS 1
+	TagIndicationROISequence                                          = Tag{0x0014, 0x201E} // SQ 1
+	TagIndicationPhysicalPropertySequence                             = Tag{0x0014, 0x2030} // SQ 1
+	TagPropertyLabel                                                  = Tag{0x0014, 0x2032} // SH 1
+	TagCoordinateSystemNumberOfAxes                                   = Tag{0x0014, 0x2202} // IS 1
+	TagCoordinateSystemAxesSequence                                   = Tag{0x0014, 0x2204} // SQ 1
+	TagCoordinateSystemAxisDescription                                = Tag{0x0014, 0x2206} // ST 1
+	TagCoordinateSystemDataSetMapping                                 = Tag{0x0014, 0x2208} // CS 1
+	TagCoordinateSystemAxisNumber                                     = Tag{0x0014, 0x220A} // IS 1
+	TagCoordinateSystemAxisType                                       = Tag{0x0014, 0x220C} // CS 1
+	TagCoordinateSystemAxisUnits                                      = Tag{0x0014, 0x220E} // CS 1
+	TagCoordinateSystemAxisValues                                     = Tag{0x0014, 0x2210} // OB 1
+	TagCoordinateSystemTransformSequence                              = Tag{0x0014, 0x2220} // SQ 1
+	TagTransformDescription                                           = Tag{0x0014, 0x2222} // ST 1
+	TagTransformNumberOfAxes                                          = Tag{0x0014, 0x2224} // IS 1
+	TagTransformOrderOfAxes                                           = Tag{0x0014, 0x2226} // IS 1-n
+	TagTransformedAxisUnits                                           = Tag{0x0014, 0x2228} // CS 1
+	TagCoordinateSystemTransformRotationAndScaleMatrix                = Tag{0x0014, 0x222A} // DS 1-n
+	TagCoordinateSystemTransformTranslationMatrix                     = Tag{0x0014, 0x222C} // DS 1-n
+	TagInternalDetectorFrameTime                                      = Tag{0x0014, 0x3011} // DS 1
+	TagNumberOfFramesIntegrated                                       = Tag{0x0014, 0x3012} // DS 1
+	TagDetectorTemperatureSequence                                    = Tag{0x0014, 0x3020} // SQ 1
+	TagSensorName                                                     = Tag{0x0014, 0x3022} // ST 1
+	TagHorizontalOffsetOfSensor                                       = Tag{0x0014, 0x3024} // DS 1
+	TagVerticalOffsetOfSensor                                         = Tag{0x0014, 0x3026} // DS 1
+	TagSensorTemperature                                              = Tag{0x0014, 0x3028} // DS 1
+	TagDarkCurrentSequence                                            = Tag{0x0014, 0x3040} // SQ 1
+	TagDarkCurrentCounts                                              = Tag{0x0014, 0x3050} // OX 1
+	TagGainCorrectionReferenceSequence                                = Tag{0x0014, 0x3060} // SQ 1
+	TagAirCounts                                                      = Tag{0x0014, 0x3070} // OX 1
+	TagKVUsedInGainCalibration                                        = Tag{0x0014, 0x3071} // DS 1
+	TagMAUsedInGainCalibration                                        = Tag{0x0014, 0x3072} // DS 1
+	TagNumberOfFramesUsedForIntegration                               = Tag{0x0014, 0x3073} // DS 1
+	TagFilterMaterialUsedInGainCalibration                            = Tag{0x0014, 0x3074} // LO 1
+	TagFilterThicknessUsedInGainCalibration                           = Tag{0x0014, 0x3075} // DS 1
+	TagDateOfGainCalibration                                          = Tag{0x0014, 0x3076} // DA 1
+	TagTimeOfGainCalibration                                          = Tag{0x0014, 0x3077} // TM 1
+	TagBadPixelImage                                                  = Tag{0x0014, 0x3080} // OB 1
+	TagCalibrationNotes                                               = Tag{0x0014, 0x3099} // LT 1
+	TagPulserEquipmentSequence                                        = Tag{0x0014, 0x4002} // SQ 1
+	TagPulserType                                                     = Tag{0x0014, 0x4004} // CS 1
+	TagPulserNotes                                                    = Tag{0x0014, 0x4006} // LT 1
+	TagReceiverEquipmentSequence                                      = Tag{0x0014, 0x4008} // SQ 1
+	TagAmplifierType                                                  = Tag{0x0014, 0x400A} // CS 1
+	TagReceiverNotes                                                  = Tag{0x0014, 0x400C} // LT 1
+	TagPreAmplifierEquipmentSequence                                  = Tag{0x0014, 0x400E} // SQ 1
+	TagPreAmplifierNotes                                              = Tag{0x0014, 0x400F} // LT 1
+	TagTransmitTransducerSequence                                     = Tag{0x0014, 0x4010} // SQ 1
+	TagReceiveTransducerSequence                                      = Tag{0x0014, 0x4011} // SQ 1
+	TagNumberOfElements                                               = Tag{0x0014, 0x4012} // US 1
+	TagElementShape                                                   = Tag{0x0014, 0x4013} // CS 1
+	TagElementDimensionA                                              = Tag{0x0014, 0x4014} // DS 1
+	TagElementDimensionB                                              = Tag{0x0014, 0x4015} // DS 1
+	TagElementPitch                                                   = Tag{0x0014, 0x4016} // DS 1
+	TagMeasuredBeamDimensionA                                         = Tag{0x0014, 0x4017} // DS 1
+	TagMeasuredBeamDimensionB                                         = Tag{0x0014, 0x4018} // DS 1
+	TagLocationOfMeasuredBeamDiameter                                 = Tag{0x0014, 0x4019} // DS 1
+	TagNominalFrequency                                               = Tag{0x0014, 0x401A} // DS 1
+	TagMeasuredCenterFrequency                                        = Tag{0x0014, 0x401B} // DS 1
+	TagMeasuredBandwidth                                              = Tag{0x0014, 0x401C} // DS 1
+	TagPulserSettingsSequence                                         = Tag{0x0014, 0x4020} // SQ 1
+	TagPulseWidth                                                     = Tag{0x0014, 0x4022} // DS 1
+	TagExcitationFrequency                                            = Tag{0x0014, 0x4024} // DS 1
+	TagModulationType                                                 = Tag{0x0014, 0x4026} // CS 1
+	TagDamping                                                        = Tag{0x0014, 0x4028} // DS 1
+	TagReceiverSettingsSequence                                       = Tag{0x0014, 0x4030} // SQ 1
+	TagAcquiredSoundpathLength                                        = Tag{0x0014, 0x4031} // DS 1
+	TagAcquisitionCompressionType                                     = Tag{0x0014, 0x4032} // CS 1
+	TagAcquisitionSampleSize                                          = Tag{0x0014, 0x4033} // IS 1
+	TagRectifierSmoothing                                             = Tag{0x0014, 0x4034} // DS 1
+	TagDACSequence                                                    = Tag{0x0014, 0x4035} // SQ 1
+	TagDACType                                                        = Tag{0x0014, 0x4036} // CS 1
+	TagDACGainPoints                                                  = Tag{0x0014, 0x4038} // DS 1-n
+	TagDACTimePoints                                                  = Tag{0x0014, 0x403A} // DS 1-n
+	TagDACAmplitude                                                   = Tag{0x0014, 0x403C} // DS 1-n
+	TagPreAmplifierSettingsSequence                                   = Tag{0x0014, 0x4040} // SQ 1
+	TagTransmitTransducerSettingsSequence                             = Tag{0x0014, 0x4050} // SQ 1
+	TagReceiveTransducerSettingsSequence                              = Tag{0x0014, 0x4051} // SQ 1
+	TagIncidentAngle                                                  = Tag{0x0014, 0x4052} // DS 1
+	TagCouplingTechnique                                              = Tag{0x0014, 0x4054} // ST 1
+	TagCouplingMedium                                                 = Tag{0x0014, 0x4056} // ST 1
+	TagCouplingVelocity                                               = Tag{0x0014, 0x4057} // DS 1
+	TagCrystalCenterLocationX                                         = Tag{0x0014, 0x4058} // DS 1
+	TagCrystalCenterLocationZ                                         = Tag{0x0014, 0x4059} // DS 1
+	TagSoundPathLength                                                = Tag{0x0014, 0x405A} // DS 1
+	TagDelayLawIdentifier                                             = Tag{0x0014, 0x405C} // ST 1
+	TagGateSettingsSequence                                           = Tag{0x0014, 0x4060} // SQ 1
+	TagGateThreshold                                                  = Tag{0x0014, 0x4062} // DS 1
+	TagVelocityOfSound                                                = Tag{0x0014, 0x4064} // DS 1
+	TagCalibrationSettingsSequence                                    = Tag{0x0014, 0x4070} // SQ 1
+	TagCalibrationProcedure                                           = Tag{0x0014, 0x4072} // ST 1
+	TagProcedureVersion                                               = Tag{0x0014, 0x4074} // SH 1
+	TagProcedureCreationDate                                          = Tag{0x0014, 0x4076} // DA 1
+	TagProcedureExpirationDate                                        = Tag{0x0014, 0x4078} // DA 1
+	TagProcedureLastModifiedDate                                      = Tag{0x0014, 0x407A} // DA 1
+	TagCalibrationTime                                                = Tag{0x0014, 0x407C} // TM 1-n
+	TagCalibrationDate                                                = Tag{0x0014, 0x407E} // DA 1-n
+	TagLINACEnergy                                                    = Tag{0x0014, 0x5002} // IS 1
+	TagLINACOutput                                                    = Tag{0x0014, 0x5004} // IS 1
+	TagContrastBolusAgent                                             = Tag{0x0018, 0x0010} // LO 1
+	TagContrastBolusAgentSequence                                     = Tag{0x0018, 0x0012} // SQ 1
+	TagContrastBolusAdministrationRouteSequence                       = Tag{0x0018, 0x0014} // SQ 1
+	TagBodyPartExamined                                               = Tag{0x0018, 0x0015} // CS 1
+	TagScanningSequence                                               = Tag{0x0018, 0x0020} // CS 1-n
+	TagSequenceVariant                                                = Tag{0x0018, 0x0021} // CS 1-n
+	TagScanOptions                                                    = Tag{0x0018, 0x0022} // CS 1-n
+	TagMRAcquisitionType                                              = Tag{0x0018, 0x0023} // CS 1
+	TagSequenceName                                                   = Tag{0x0018, 0x0024} // SH 1
+	TagAngioFlag                                                      = Tag{0x0018, 0x0025} // CS 1
+	TagInterventionDrugInformationSequence                            = Tag{0x0018, 0x0026} // SQ 1
+	TagInterventionDrugStopTime                                       = Tag{0x0018, 0x0027} // TM 1
+	TagInterventionDrugDose                                           = Tag{0x0018, 0x0028} // DS 1
+	TagInterventionDrugCodeSequence                                   = Tag{0x0018, 0x0029} // SQ 1
+	TagAdditionalDrugSequence                                         = Tag{0x0018, 0x002A} // SQ 1
+	TagRadiopharmaceutical                                            = Tag{0x0018, 0x0031} // LO 1
+	TagInterventionDrugName                                           = Tag{0x0018, 0x0034} // LO 1
+	TagInterventionDrugStartTime                                      = Tag{0x0018, 0x0035} // TM 1
+	TagInterventionSequence                                           = Tag{0x0018, 0x0036} // SQ 1
+	TagInterventionStatus                                             = Tag{0x0018, 0x0038} // CS 1
+	TagInterventionDescription                                        = Tag{0x0018, 0x003A} // ST 1
+	TagCineRate                                                       = Tag{0x0018, 0x0040} // IS 1
+	TagInitialCineRunState                                            = Tag{0x0018, 0x0042} // CS 1
+	TagSliceThickness                                                 = Tag{0x0018, 0x0050} // DS 1
+	TagKVP                                                            = Tag{0x0018, 0x0060} // DS 1
+	TagCountsAccumulated                                              = Tag{0x0018, 0x0070} // IS 1
+	TagAcquisitionTerminationCondition                                = Tag{0x0018, 0x0071} // CS 1
+	TagEffectiveDuration                                              = Tag{0x0018, 0x0072} // DS 1
+	TagAcquisitionStartCondition                                      = Tag{0x0018, 0x0073} // CS 1
+	TagAcquisitionStartConditionData                                  = Tag{0x0018, 0x0074} // IS 1
+	TagAcquisitionTerminationConditionData                            = Tag{0x0018, 0x0075} // IS 1
+	TagRepetitionTime                                                 = Tag{0x0018, 0x0080} // DS 1
+	TagEchoTime                                                       = Tag{0x0018, 0x0081} // DS 1
+	TagInversionTime                                                  = Tag{0x0018, 0x0082} // DS 1
+	TagNumberOfAverages                                               = Tag{0x0018, 0x0083} // DS 1
+	TagImagingFrequency                                               = Tag{0x0018, 0x0084} // DS 1
+	TagImagedNucleus                                                  = Tag{0x0018, 0x0085} // SH 1
+	TagEchoNumbers                                                    = Tag{0x0018, 0x0086} // IS 1-n
+	TagMagneticFieldStrength                                          = Tag{0x0018, 0x0087} // DS 1
+	TagSpacingBetweenSlices                                           = Tag{0x0018, 0x0088} // DS 1
+	TagNumberOfPhaseEncodingSteps                                     = Tag{0x0018, 0x0089} // IS 1
+	TagDataCollectionDiameter                                         = Tag{0x0018, 0x0090} // DS 1
+	TagEchoTrainLength                                                = Tag{0x0018, 0x0091} // IS 1
+	TagPercentSampling                                                = Tag{0x0018, 0x0093} // DS 1
+	TagPercentPhaseFieldOfView                                        = Tag{0x0018, 0x0094} // DS 1
+	TagPixelBandwidth                                                 = Tag{0x0018, 0x0095} // DS 1
+	TagDeviceSerialNumber                                             = Tag{0x0018, 0x1000} // LO 1
+	TagDeviceUID                                                      = Tag{0x0018, 0x1002} // UI 1
+	TagDeviceID                                                       = Tag{0x0018, 0x1003} // LO 1
+	TagPlateID                                                        = Tag{0x0018, 0x1004} // LO 1
+	TagGeneratorID                                                    = Tag{0x0018, 0x1005} // LO 1
+	TagGridID                                                         = Tag{0x0018, 0x1006} // LO 1
+	TagCassetteID                                                     = Tag{0x0018, 0x1007} // LO 1
+	TagGantryID                                                       = Tag{0x0018, 0x1008} // LO 1
+	TagSecondaryCaptureDeviceID                                       = Tag{0x0018, 0x1010} // LO 1
+	TagDateOfSecondaryCapture                                         = Tag{0x0018, 0x1012} // DA 1
+	TagTimeOfSecondaryCapture                                         = Tag{0x0018, 0x1014} // TM 1
+	TagSecondaryCaptureDeviceManufacturer                             = Tag{0x0018, 0x1016} // LO 1
+	TagSecondaryCaptureDeviceManufacturerModelName                    = Tag{0x0018, 0x1018} // LO 1
+	TagSecondaryCaptureDeviceSoftwareVersions                         = Tag{0x0018, 0x1019} // LO 1-n
+	TagSoftwareVersions                                               = Tag{0x0018, 0x1020} // LO 1-n
+	TagVideoImageFormatAcquired                                       = Tag{0x0018, 0x1022} // SH 1
+	TagDigitalImageFormatAcquired                                     = Tag{0x0018, 0x1023} // LO 1
+	TagProtocolName                                                   = Tag{0x0018, 0x1030} // LO 1
+	TagContrastBolusRoute                                             = Tag{0x0018, 0x1040} // LO 1
+	TagContrastBolusVolume                                            = Tag{0x0018, 0x1041} // DS 1
+	TagContrastBolusStartTime                                         = Tag{0x0018, 0x1042} // TM 1
+	TagContrastBolusStopTime                                          = Tag{0x0018, 0x1043} // TM 1
+	TagContrastBolusTotalDose                                         = Tag{0x0018, 0x1044} // DS 1
+	TagSyringeCounts                                                  = Tag{0x0018, 0x1045} // IS 1
+	TagContrastFlowRate                                               = Tag{0x0018, 0x1046} // DS 1-n
+	TagContrastFlowDuration                                           = Tag{0x0018, 0x1047} // DS 1-n
+	TagContrastBolusIngredient                                        = Tag{0x0018, 0x1048} // CS 1
+	TagContrastBolusIngredientConcentration                           = Tag{0x0018, 0x1049} // DS 1
+	TagSpatialResolution                                              = Tag{0x0018, 0x1050} // DS 1
+	TagTriggerTime                                                    = Tag{0x0018, 0x1060} // DS 1
+	TagTriggerSourceOrType                                            = Tag{0x0018, 0x1061} // LO 1
+	TagNominalInterval                                                = Tag{0x0018, 0x1062} // IS 1
+	TagFrameTime                                                      = Tag{0x0018, 0x1063} // DS 1
+	TagCardiacFramingType                                             = Tag{0x0018, 0x1064} // LO 1
+	TagFrameTimeVector                                                = Tag{0x0018, 0x1065} // DS 1-n
+	TagFrameDelay                                                     = Tag{0x0018, 0x1066} // DS 1
+	TagImageTriggerDelay                                              = Tag{0x0018, 0x1067} // DS 1
+	TagMultiplexGroupTimeOffset                                       = Tag{0x0018, 0x1068} // DS 1
+	TagTriggerTimeOffset                                              = Tag{0x0018, 0x1069} // DS 1
+	TagSynchronizationTrigger                                         = Tag{0x0018, 0x106A} // CS 1
+	TagSynchronizationChannel                                         = Tag{0x0018, 0x106C} // US 2
+	TagTriggerSamplePosition                                          = Tag{0x0018, 0x106E} // UL 1
+	TagRadiopharmaceuticalRoute                                       = Tag{0x0018, 0x1070} // LO 1
+	TagRadiopharmaceuticalVolume                                      = Tag{0x0018, 0x1071} // DS 1
+	TagRadiopharmaceuticalStartTime                                   = Tag{0x0018, 0x1072} // TM 1
+	TagRadiopharmaceuticalStopTime                                    = Tag{0x0018, 0x1073} // TM 1
+	TagRadionuclideTotalDose                                          = Tag{0x0018, 0x1074} // DS 1
+	TagRadionuclideHalfLife                                           = Tag{0x0018, 0x1075} // DS 1
+	TagRadionuclidePositronFraction                                   = Tag{0x0018, 0x1076} // DS 1
+	TagRadiopharmaceuticalSpecificActivity                            = Tag{0x0018, 0x1077} // DS 1
+	TagRadiopharmaceuticalStartDateTime                               = Tag{0x0018, 0x1078} // DT 1
+	TagRadiopharmaceuticalStopDateTime                                = Tag{0x0018, 0x1079} // DT 1
+	TagBeatRejectionFlag                                              = Tag{0x0018, 0x1080} // CS 1
+	TagLowRRValue                                                     = Tag{0x0018, 0x1081} // IS 1
+	TagHighRRValue                                                    = Tag{0x0018, 0x1082} // IS 1
+	TagIntervalsAcquired                                              = Tag{0x0018, 0x1083} // IS 1
+	TagIntervalsRejected                                              = Tag{0x0018, 0x1084} // IS 1
+	TagPVCRejection                                                   = Tag{0x0018, 0x1085} // LO 1
+	TagSkipBeats                                                      = Tag{0x0018, 0x1086} // IS 1
+	TagHeartRate                                                      = Tag{0x0018, 0x1088} // IS 1
+	TagCardiacNumberOfImages                                          = Tag{0x0018, 0x1090} // IS 1
+	TagTriggerWindow                                                  = Tag{0x0018, 0x1094} // IS 1
+	TagReconstructionDiameter                                         = Tag{0x0018, 0x1100} // DS 1
+	TagDistanceSourceToDetector                                       = Tag{0x0018, 0x1110} // DS 1
+	TagDistanceSourceToPatient                                        = Tag{0x0018, 0x1111} // DS 1
+	TagEstimatedRadiographicMagnificationFactor                       = Tag{0x0018, 0x1114} // DS 1
+	TagGantryDetectorTilt                                             = Tag{0x0018, 0x1120} // DS 1
+	TagGantryDetectorSlew                                             = Tag{0x0018, 0x1121} // DS 1
+	TagTableHeight                                                    = Tag{0x0018, 0x1130} // DS 1
+	TagTableTraverse                                                  = Tag{0x0018, 0x1131} // DS 1
+	TagTableMotion                                                    = Tag{0x0018, 0x1134} // CS 1
+	TagTableVerticalIncrement                                         = Tag{0x0018, 0x1135} // DS 1-n
+	TagTableLateralIncrement                                          = Tag{0x0018, 0x1136} // DS 1-n
+	TagTableLongitudinalIncrement                                     = Tag{0x0018, 0x1137} // DS 1-n
+	TagTableAngle                                                     = Tag{0x0018, 0x1138} // DS 1
+	TagTableType                                                      = Tag{0x0018, 0x113A} // CS 1
+	TagRotationDirection                                              = Tag{0x0018, 0x1140} // CS 1
+	TagRadialPosition                                                 = Tag{0x0018, 0x1142} // DS 1-n
+	TagScanArc                                                        = Tag{0x0018, 0x1143} // DS 1
+	TagAngularStep                                                    = Tag{0x0018, 0x1144} // DS 1
+	TagCenterOfRotationOffset                                         = Tag{0x0018, 0x1145} // DS 1
+	TagFieldOfViewShape                                               = Tag{0x0018, 0x1147} // CS 1
+	TagFieldOfViewDimensions                                          = Tag{0x0018, 0x1149} // IS 1-2
+	TagExposureTime                                                   = Tag{0x0018, 0x1150} // IS 1
+	TagXRayTubeCurrent                                                = Tag{0x0018, 0x1151} // IS 1
+	TagExposure                                                       = Tag{0x0018, 0x1152} // IS 1
+	TagExposureInuAs                                                  = Tag{0x0018, 0x1153} // IS 1
+	TagAveragePulseWidth                                              = Tag{0x0018, 0x1154} // DS 1
+	TagRadiationSetting                                               = Tag{0x0018, 0x1155} // CS 1
+	TagRectificationType                                              = Tag{0x0018, 0x1156} // CS 1
+	TagRadiationMode                                                  = Tag{0x0018, 0x115A} // CS 1
+	TagImageAndFluoroscopyAreaDoseProduct                             = Tag{0x0018, 0x115E} // DS 1
+	TagFilterType                                                     = Tag{0x0018, 0x1160} // SH 1
+	TagTypeOfFilters                                                  = Tag{0x0018, 0x1161} // LO 1-n
+	TagIntensifierSize                                                = Tag{0x0018, 0x1162} // DS 1
+	TagImagerPixelSpacing                                             = Tag{0x0018, 0x1164} // DS 2
+	TagGrid                                                           = Tag{0x0018, 0x1166} // CS 1-n
+	TagGeneratorPower                                                 = Tag{0x0018, 0x1170} // IS 1
+	TagCollimatorGridName                                             = Tag{0x0018, 0x1180} // SH 1
+	TagCollimatorType                                                 = Tag{0x0018, 0x1181} // CS 1
+	TagFocalDistance                                                  = Tag{0x0018, 0x1182} // IS 1-2
+	TagXFocusCenter                                                   = Tag{0x0018, 0x1183} // DS 1-2
+	TagYFocusCenter                                                   = Tag{0x0018, 0x1184} // DS 1-2
+	TagFocalSpots                                                     = Tag{0x0018, 0x1190} // DS 1-n
+	TagAnodeTargetMaterial                                            = Tag{0x0018, 0x1191} // CS 1
+	TagBodyPartThickness                                              = Tag{0x0018, 0x11A0} // DS 1
+	TagCompressionForce                                               = Tag{0x0018, 0x11A2} // DS 1
+	TagDateOfLastCalibration                                          = Tag{0x0018, 0x1200} // DA 1-n
+	TagTimeOfLastCalibration                                          = Tag{0x0018, 0x1201} // TM 1-n
+	TagConvolutionKernel                                              = Tag{0x0018, 0x1210} // SH 1-n
+	TagActualFrameDuration                                            = Tag{0x0018, 0x1242} // IS 1
+	TagCountRate                                                      = Tag{0x0018, 0x1243} // IS 1
+	TagPreferredPlaybackSequencing                                    = Tag{0x0018, 0x1244} // US 1
+	TagReceiveCoilName                                                = Tag{0x0018, 0x1250} // SH 1
+	TagTransmitCoilName                                               = Tag{0x0018, 0x1251} // SH 1
+	TagPlateType                                                      = Tag{0x0018, 0x1260} // SH 1
+	TagPhosphorType                                                   = Tag{0x0018, 0x1261} // LO 1
+	TagScanVelocity                                                   = Tag{0x0018, 0x1300} // DS 1
+	TagWholeBodyTechnique                                             = Tag{0x0018, 0x1301} // CS 1-n
+	TagScanLength                                                     = Tag{0x0018, 0x1302} // IS 1
+	TagAcquisitionMatrix                                              = Tag{0x0018, 0x1310} // US 4
+	TagInPlanePhaseEncodingDirection                                  = Tag{0x0018, 0x1312} // CS 1
+	TagFlipAngle                                                      = Tag{0x0018, 0x1314} // DS 1
+	TagVariableFlipAngleFlag                                          = Tag{0x0018, 0x1315} // CS 1
+	TagSAR                                                            = Tag{0x0018, 0x1316} // DS 1
+	TagdBdt                                                           = Tag{0x0018, 0x1318} // DS 1
+	TagAcquisitionDeviceProcessingDescription                         = Tag{0x0018, 0x1400} // LO 1
+	TagAcquisitionDeviceProcessingCode                                = Tag{0x0018, 0x1401} // LO 1
+	TagCassetteOrientation                                            = Tag{0x0018, 0x1402} // CS 1
+	TagCassetteSize                                                   = Tag{0x0018, 0x1403} // CS 1
+	TagExposuresOnPlate                                               = Tag{0x0018, 0x1404} // US 1
+	TagRelativeXRayExposure                                           = Tag{0x0018, 0x1405} // IS 1
+	TagExposureIndex                                                  = Tag{0x0018, 0x1411} // DS 1
+	TagTargetExposureIndex                                            = Tag{0x0018, 0x1412} // DS 1
+	TagDeviationIndex                                                 = Tag{0x0018, 0x1413} // DS 1
+	TagColumnAngulation                                               = Tag{0x0018, 0x1450} // DS 1
+	TagTomoLayerHeight                                                = Tag{0x0018, 0x1460} // DS 1
+	TagTomoAngle                                                      = Tag{0x0018, 0x1470} // DS 1
+	TagTomoTime                                                       = Tag{0x0018, 0x1480} // DS 1
+	TagTomoType                                                       = Tag{0x0018, 0x1490} // CS 1
+	TagTomoClass                                                      = Tag{0x0018, 0x1491} // CS 1
+	TagNumberOfTomosynthesisSourceImages                              = Tag{0x0018, 0x1495} // IS 1
+	TagPositionerMotion                                               = Tag{0x0018, 0x1500} // CS 1
+	TagPositionerType                                                 = Tag{0x0018, 0x1508} // CS 1
+	TagPositionerPrimaryAngle                                         = Tag{0x0018, 0x1510} // DS 1
+	TagPositionerSecondaryAngle                                       = Tag{0x0018, 0x1511} // DS 1
+	TagPositionerPrimaryAngleIncrement                                = Tag{0x0018, 0x1520} // DS 1-n
+	TagPositionerSecondaryAngleIncrement                              = Tag{0x0018, 0x1521} // DS 1-n
+	TagDetectorPrimaryAngle                                           = Tag{0x0018, 0x1530} // DS 1
+	TagDetectorSecondaryAngle                                         = Tag{0x0018, 0x1531} // DS 1
+	TagShutterShape                                                   = Tag{0x0018, 0x1600} // CS 1-3
+	TagShutterLeftVerticalEdge                                        = Tag{0x0018, 0x1602} // IS 1
+	TagShutterRightVerticalEdge                                       = Tag{0x0018, 0x1604} // IS 1
+	TagShutterUpperHorizontalEdge                                     = Tag{0x0018, 0x1606} // IS 1
+	TagShutterLowerHorizontalEdge                                     = Tag{0x0018, 0x1608} // IS 1
+	TagCenterOfCircularShutter                                        = Tag{0x0018, 0x1610} // IS 2
+	TagRadiusOfCircularShutter                                        = Tag{0x0018, 0x1612} // IS 1
+	TagVerticesOfThePolygonalShutter                                  = Tag{0x0018, 0x1620} // IS 2-2n
+	TagShutterPresentationValue                                       = Tag{0x0018, 0x1622} // US 1
+	TagShutterOverlayGroup                                            = Tag{0x0018, 0x1623} // US 1
+	TagShutterPresentationColorCIELabValue                            = Tag{0x0018, 0x1624} // US 3
+	TagCollimatorShape                                                = Tag{0x0018, 0x1700} // CS 1-3
+	TagCollimatorLeftVerticalEdge                                     = Tag{0x0018, 0x1702} // IS 1
+	TagCollimatorRightVerticalEdge                                    = Tag{0x0018, 0x1704} // IS 1
+	TagCollimatorUpperHorizontalEdge                                  = Tag{0x0018, 0x1706} // IS 1
+	TagCollimatorLowerHorizontalEdge                                  = Tag{0x0018, 0x1708} // IS 1
+	TagCenterOfCircularCollimator                                     = Tag{0x0018, 0x1710} // IS 2
+	TagRadiusOfCircularCollimator                                     = Tag{0x0018, 0x1712} // IS 1
+	TagVerticesOfThePolygonalCollimator                               = Tag{0x0018, 0x1720} // IS 2-2n
+	TagAcquisitionTimeSynchronized                                    = Tag{0x0018, 0x1800} // CS 1
+	TagTimeSource                                                     = Tag{0x0018, 0x1801} // SH 1
+	TagTimeDistributionProtocol                                       = Tag{0x0018, 0x1802} // CS 1
+	TagNTPSourceAddress                                               = Tag{0x0018, 0x1803} // LO 1
+	TagPageNumberVector                                               = Tag{0x0018, 0x2001} // IS 1-n
+	TagFrameLabelVector                                               = Tag{0x0018, 0x2002} // SH 1-n
+	TagFramePrimaryAngleVector                                        = Tag{0x0018, 0x2003} // DS 1-n
+	TagFrameSecondaryAngleVector                                      = Tag{0x0018, 0x2004} // DS 1-n
+	TagSliceLocationVector                                            = Tag{0x0018, 0x2005} // DS 1-n
+	TagDisplayWindowLabelVector                                       = Tag{0x0018, 0x2006} // SH 1-n
+	TagNominalScannedPixelSpacing                                     = Tag{0x0018, 0x2010} // DS 2
+	TagDigitizingDeviceTransportDirection                             = Tag{0x0018, 0x2020} // CS 1
+	TagRotationOfScannedFilm                                          = Tag{0x0018, 0x2030} // DS 1
+	TagIVUSAcquisition                                                = Tag{0x0018, 0x3100} // CS 1
+	TagIVUSPullbackRate                                               = Tag{0x0018, 0x3101} // DS 1
+	TagIVUSGatedRate                                                  = Tag{0x0018, 0x3102} // DS 1
+	TagIVUSPullbackStartFrameNumber                                   = Tag{0x0018, 0x3103} // IS 1
+	TagIVUSPullbackStopFrameNumber                                    = Tag{0x0018, 0x3104} // IS 1
+	TagLesionNumber                                                   = Tag{0x0018, 0x3105} // IS 1-n
+	TagOutputPower                                                    = Tag{0x0018, 0x5000} // SH 1-n
+	TagTransducerData                                                 = Tag{0x0018, 0x5010} // LO 1-n
+	TagFocusDepth                                                     = Tag{0x0018, 0x5012} // DS 1
+	TagProcessingFunction                                             = Tag{0x0018, 0x5020} // LO 1
+	TagMechanicalIndex                                                = Tag{0x0018, 0x5022} // DS 1
+	TagBoneThermalIndex                                               = Tag{0x0018, 0x5024} // DS 1
+	TagCranialThermalIndex                                            = Tag{0x0018, 0x5026} // DS 1
+	TagSoftTissueThermalIndex                                         = Tag{0x0018, 0x5027} // DS 1
+	TagSoftTissueFocusThermalIndex                                    = Tag{0x0018, 0x5028} // DS 1
+	TagSoftTissueSurfaceThermalIndex                                  = Tag{0x0018, 0x5029} // DS 1
+	TagDepthOfScanField                                               = Tag{0x0018, 0x5050} // IS 1
+	TagPatientPosition                                                = Tag{0x0018, 0x5100} // CS 1
+	TagViewPosition                                                   = Tag{0x0018, 0x5101} // CS 1
+	TagProjectionEponymousNameCodeSequence                            = Tag{0x0018, 0x5104} // SQ 1
+	TagSensitivity                                                    = Tag{0x0018, 0x6000} // DS 1
+	TagSequenceOfUltrasoundRegions                                    = Tag{0x0018, 0x6011} // SQ 1
+	TagRegionSpatialFormat                                            = Tag{0x0018, 0x6012} // US 1
+	TagRegionDataType                                                 = Tag{0x0018, 0x6014} // US 1
+	TagRegionFlags                                                    = Tag{0x0018, 0x6016} // UL 1
+	TagRegionLocationMinX0                                            = Tag{0x0018, 0x6018} // UL 1
+	TagRegionLocationMinY0                                            = Tag{0x0018, 0x601A} // UL 1
+	TagRegionLocationMaxX1                                            = Tag{0x0018, 0x601C} // UL 1
+	TagRegionLocationMaxY1                                            = Tag{0x0018, 0x601E} // UL 1
+	TagReferencePixelX0                                               = Tag{0x0018, 0x6020} // SL 1
+	TagReferencePixelY0                                               = Tag{0x0018, 0x6022} // SL 1
+	TagPhysicalUnitsXDirection                                        = Tag{0x0018, 0x6024} // US 1
+	TagPhysicalUnitsYDirection                                        = Tag{0x0018, 0x6026} // US 1
+	TagReferencePixelPhysicalValueX                                   = Tag{0x0018, 0x6028} // FD 1
+	TagReferencePixelPhysicalValueY                                   = Tag{0x0018, 0x602A} // FD 1
+	TagPhysicalDeltaX                                                 = Tag{0x0018, 0x602C} // FD 1
+	TagPhysicalDeltaY                                                 = Tag{0x0018, 0x602E} // FD 1
+	TagTransducerFrequency                                            = Tag{0x0018, 0x6030} // UL 1
+	TagTransducerType                                                 = Tag{0x0018, 0x6031} // CS 1
+	TagPulseRepetitionFrequency                                       = Tag{0x0018, 0x6032} // UL 1
+	TagDopplerCorrectionAngle                                         = Tag{0x0018, 0x6034} // FD 1
+	TagSteeringAngle                                                  = Tag{0x0018, 0x6036} // FD 1
+	TagDopplerSampleVolumeXPosition                                   = Tag{0x0018, 0x6039} // SL 1
+	TagDopplerSampleVolumeYPosition                                   = Tag{0x0018, 0x603B} // SL 1
+	TagTMLinePositionX0                                               = Tag{0x0018, 0x603D} // SL 1
+	TagTMLinePositionY0                                               = Tag{0x0018, 0x603F} // SL 1
+	TagTMLinePositionX1                                               = Tag{0x0018, 0x6041} // SL 1
+	TagTMLinePositionY1                                               = Tag{0x0018, 0x6043} // SL 1
+	TagPixelComponentOrganization                                     = Tag{0x0018, 0x6044} // US 1
+	TagPixelComponentMask                                             = Tag{0x0018, 0x6046} // UL 1
+	TagPixelComponentRangeStart                                       = Tag{0x0018, 0x6048} // UL 1
+	TagPixelComponentRangeStop                                        = Tag{0x0018, 0x604A} // UL 1
+	TagPixelComponentPhysicalUnits                                    = Tag{0x0018, 0x604C} // US 1
+	TagPixelComponentDataType                                         = Tag{0x0018, 0x604E} // US 1
+	TagNumberOfTableBreakPoints                                       = Tag{0x0018, 0x6050} // UL 1
+	TagTableOfXBreakPoints                                            = Tag{0x0018, 0x6052} // UL 1-n
+	TagTableOfYBreakPoints                                            = Tag{0x0018, 0x6054} // FD 1-n
+	TagNumberOfTableEntries                                           = Tag{0x0018, 0x6056} // UL 1
+	TagTableOfPixelValues                                             = Tag{0x0018, 0x6058} // UL 1-n
+	TagTableOfParameterValues                                         = Tag{0x0018, 0x605A} // FL 1-n
+	TagRWaveTimeVector                                                = Tag{0x0018, 0x6060} // FL 1-n
+	TagDetectorConditionsNominalFlag                                  = Tag{0x0018, 0x7000} // CS 1
+	TagDetectorTemperature                                            = Tag{0x0018, 0x7001} // DS 1
+	TagDetectorType                                                   = Tag{0x0018, 0x7004} // CS 1
+	TagDetectorConfiguration                                          = Tag{0x0018, 0x7005} // CS 1
+	TagDetectorDescription                                            = Tag{0x0018, 0x7006} // LT 1
+	TagDetectorMode                                                   = Tag{0x0018, 0x7008} // LT 1
+	TagDetectorID                                                     = Tag{0x0018, 0x700A} // SH 1
+	TagDateOfLastDetectorCalibration                                  = Tag{0x0018, 0x700C} // DA 1
+	TagTimeOfLastDetectorCalibration                                  = Tag{0x0018, 0x700E} // TM 1
+	TagExposuresOnDetectorSinceLastCalibration                        = Tag{0x0018, 0x7010} // IS 1
+	TagExposuresOnDetectorSinceManufactured                           = Tag{0x0018, 0x7011} // IS 1
+	TagDetectorTimeSinceLastExposure                                  = Tag{0x0018, 0x7012} // DS 1
+	TagDetectorActiveTime                                             = Tag{0x0018, 0x7014} // DS 1
+	TagDetectorActivationOffsetFromExposure                           = Tag{0x0018, 0x7016} // DS 1
+	TagDetectorBinning                                                = Tag{0x0018, 0x701A} // DS 2
+	TagDetectorElementPhysicalSize                                    = Tag{0x0018, 0x7020} // DS 2
+	TagDetectorElementSpacing                                         = Tag{0x0018, 0x7022} // DS 2
+	TagDetectorActiveShape                                            = Tag{0x0018, 0x7024} // CS 1
+	TagDetectorActiveDimensions                                       = Tag{0x0018, 0x7026} // DS 1-2
+	TagDetectorActiveOrigin                                           = Tag{0x0018, 0x7028} // DS 2
+	TagDetectorManufacturerName                                       = Tag{0x0018, 0x702A} // LO 1
+	TagDetectorManufacturerModelName                                  = Tag{0x0018, 0x702B} // LO 1
+	TagFieldOfViewOrigin                                              = Tag{0x0018, 0x7030} // DS 2
+	TagFieldOfViewRotation                                            = Tag{0x0018, 0x7032} // DS 1
+	TagFieldOfViewHorizontalFlip                                      = Tag{0x0018, 0x7034} // CS 1
+	TagPixelDataAreaOriginRelativeToFOV                               = Tag{0x0018, 0x7036} // FL 2
+	TagPixelDataAreaRotationAngleRelativeToFOV                        = Tag{0x0018, 0x7038} // FL 1
+	TagGridAbsorbingMaterial                                          = Tag{0x0018, 0x7040} // LT 1
+	TagGridSpacingMaterial                                            = Tag{0x0018, 0x7041} // LT 1
+	TagGridThickness                                                  = Tag{0x0018, 0x7042} // DS 1
+	TagGridPitch                                                      = Tag{0x0018, 0x7044} // DS 1
+	TagGridAspectRatio                                                = Tag{0x0018, 0x7046} // IS 2
+	TagGridPeriod                                                     = Tag{0x0018, 0x7048} // DS 1
+	TagGridFocalDistance                                              = Tag{0x0018, 0x704C} // DS 1
+	TagFilterMaterial                                                 = Tag{0x0018, 0x7050} // CS 1-n
+	TagFilterThicknessMinimum                                         = Tag{0x0018, 0x7052} // DS 1-n
+	TagFilterThicknessMaximum                                         = Tag{0x0018, 0x7054} // DS 1-n
+	TagFilterBeamPathLengthMinimum                                    = Tag{0x0018, 0x7056} // FL 1-n
+	TagFilterBeamPathLengthMaximum                                    = Tag{0x0018, 0x7058} // FL 1-n
+	TagExposureControlMode                                            = Tag{0x0018, 0x7060} // CS 1
+	TagExposureControlModeDescription                                 = Tag{0x0018, 0x7062} // LT 1
+	TagExposureStatus                                                 = Tag{0x0018, 0x7064} // CS 1
+	TagPhototimerSetting                                              = Tag{0x0018, 0x7065} // DS 1
+	TagExposureTimeInuS                                               = Tag{0x0018, 0x8150} // DS 1
+	TagXRayTubeCurrentInuA                                            = Tag{0x0018, 0x8151} // DS 1
+	TagContentQualification                                           = Tag{0x0018, 0x9004} // CS 1
+	TagPulseSequenceName                                              = Tag{0x0018, 0x9005} // SH 1
+	TagMRImagingModifierSequence                                      = Tag{0x0018, 0x9006} // SQ 1
+	TagEchoPulseSequence                                              = Tag{0x0018, 0x9008} // CS 1
+	TagInversionRecovery                                              = Tag{0x0018, 0x9009} // CS 1
+	TagFlowCompensation                                               = Tag{0x0018, 0x9010} // CS 1
+	TagMultipleSpinEcho                                               = Tag{0x0018, 0x9011} // CS 1
+	TagMultiPlanarExcitation                                          = Tag{0x0018, 0x9012} // CS 1
+	TagPhaseContrast                                                  = Tag{0x0018, 0x9014} // CS 1
+	TagTimeOfFlightContrast                                           = Tag{0x0018, 0x9015} // CS 1
+	TagSpoiling                                                       = Tag{0x0018, 0x9016} // CS 1
+	TagSteadyStatePulseSequence                                       = Tag{0x0018, 0x9017} // CS 1
+	TagEchoPlanarPulseSequence                                        = Tag{0x0018, 0x9018} // CS 1
+	TagTagAngleFirstAxis                                              = Tag{0x0018, 0x9019} // FD 1
+	TagMagnetizationTransfer                                          = Tag{0x0018, 0x9020} // CS 1
+	TagT2Preparation                                                  = Tag{0x0018, 0x9021} // CS 1
+	TagBloodSignalNulling                                             = Tag{0x0018, 0x9022} // CS 1
+	TagSaturationRecovery                                             = Tag{0x0018, 0x9024} // CS 1
+	TagSpectrallySelectedSuppression                                  = Tag{0x0018, 0x9025} // CS 1
+	TagSpectrallySelectedExcitation                                   = Tag{0x0018, 0x9026} // CS 1
+	TagSpatialPresaturation                                           = Tag{0x0018, 0x9027} // CS 1
+	TagTagging                                                        = Tag{0x0018, 0x9028} // CS 1
+	TagOversamplingPhase                                              = Tag{0x0018, 0x9029} // CS 1
+	TagTagSpacingFirstDimension                                       = Tag{0x0018, 0x9030} // FD 1
+	TagGeometryOfKSpaceTraversal                                      = Tag{0x0018, 0x9032} // CS 1
+	TagSegmentedKSpaceTraversal                                       = Tag{0x0018, 0x9033} // CS 1
+	TagRectilinearPhaseEncodeReordering                               = Tag{0x0018, 0x9034} // CS 1
+	TagTagThickness                                                   = Tag{0x0018, 0x9035} // FD 1
+	TagPartialFourierDirection                                        = Tag{0x0018, 0x9036} // CS 1
+	TagCardiacSynchronizationTechnique                                = Tag{0x0018, 0x9037} // CS 1
+	TagReceiveCoilManufacturerName                                    = Tag{0x0018, 0x9041} // LO 1
+	TagMRReceiveCoilSequence                                          = Tag{0x0018, 0x9042} // SQ 1
+	TagReceiveCoilType                                                = Tag{0x0018, 0x9043} // CS 1
+	TagQuadratureReceiveCoil                                          = Tag{0x0018, 0x9044} // CS 1
+	TagMultiCoilDefinitionSequence                                    = Tag{0x0018, 0x9045} // SQ 1
+	TagMultiCoilConfiguration                                         = Tag{0x0018, 0x9046} // LO 1
+	TagMultiCoilElementName                                           = Tag{0x0018, 0x9047} // SH 1
+	TagMultiCoilElementUsed                                           = Tag{0x0018, 0x9048} // CS 1
+	TagMRTransmitCoilSequence                                         = Tag{0x0018, 0x9049} // SQ 1
+	TagTransmitCoilManufacturerName                                   = Tag{0x0018, 0x9050} // LO 1
+	TagTransmitCoilType                                               = Tag{0x0018, 0x9051} // CS 1
+	TagSpectralWidth                                                  = Tag{0x0018, 0x9052} // FD 1-2
+	TagChemicalShiftReference                                         = Tag{0x0018, 0x9053} // FD 1-2
+	TagVolumeLocalizationTechnique                                    = Tag{0x0018, 0x9054} // CS 1
+	TagMRAcquisitionFrequencyEncodingSteps                            = Tag{0x0018, 0x9058} // US 1
+	TagDecoupling                                                     = Tag{0x0018, 0x9059} // CS 1
+	TagDecoupledNucleus                                               = Tag{0x0018, 0x9060} // CS 1-2
+	TagDecouplingFrequency                                            = Tag{0x0018, 0x9061} // FD 1-2
+	TagDecouplingMethod                                               = Tag{0x0018, 0x9062} // CS 1
+	TagDecouplingChemicalShiftReference                               = Tag{0x0018, 0x9063} // FD 1-2
+	TagKSpaceFiltering                                                = Tag{0x0018, 0x9064} // CS 1
+	TagTimeDomainFiltering                                            = Tag{0x0018, 0x9065} // CS 1-2
+	TagNumberOfZeroFills                                              = Tag{0x0018, 0x9066} // US 1-2
+	TagBaselineCorrection                                             = Tag{0x0018, 0x9067} // CS 1
+	TagParallelReductionFactorInPlane                                 = Tag{0x0018, 0x9069} // FD 1
+	TagCardiacRRIntervalSpecified                                     = Tag{0x0018, 0x9070} // FD 1
+	TagAcquisitionDuration                                            = Tag{0x0018, 0x9073} // FD 1
+	TagFrameAcquisitionDateTime                                       = Tag{0x0018, 0x9074} // DT 1
+	TagDiffusionDirectionality                                        = Tag{0x0018, 0x9075} // CS 1
+	TagDiffusionGradientDirectionSequence                             = Tag{0x0018, 0x9076} // SQ 1
+	TagParallelAcquisition                                            = Tag{0x0018, 0x9077} // CS 1
+	TagParallelAcquisitionTechnique                                   = Tag{0x0018, 0x9078} // CS 1
+	TagInversionTimes                                                 = Tag{0x0018, 0x9079} // FD 1-n
+	TagMetaboliteMapDescription                                       = Tag{0x0018, 0x9080} // ST 1
+	TagPartialFourier                                                 = Tag{0x0018, 0x9081} // CS 1
+	TagEffectiveEchoTime                                              = Tag{0x0018, 0x9082} // FD 1
+	TagMetaboliteMapCodeSequence                                      = Tag{0x0018, 0x9083} // SQ 1
+	TagChemicalShiftSequence                                          = Tag{0x0018, 0x9084} // SQ 1
+	TagCardiacSignalSource                                            = Tag{0x0018, 0x9085} // CS 1
+	TagDiffusionBValue                                                = Tag{0x0018, 0x9087} // FD 1
+	TagDiffusionGradientOrientation                                   = Tag{0x0018, 0x9089} // FD 3
+	TagVelocityEncodingDirection                                      = Tag{0x0018, 0x9090} // FD 3
+	TagVelocityEncodingMinimumValue                                   = Tag{0x0018, 0x9091} // FD 1
+	TagVelocityEncodingAcquisitionSequence                            = Tag{0x0018, 0x9092} // SQ 1
+	TagNumberOfKSpaceTrajectories                                     = Tag{0x0018, 0x9093} // US 1
+	TagCoverageOfKSpace                                               = Tag{0x0018, 0x9094} // CS 1
+	TagSpectroscopyAcquisitionPhaseRows                               = Tag{0x0018, 0x9095} // UL 1
+	TagTransmitterFrequency                                           = Tag{0x0018, 0x9098} // FD 1-2
+	TagResonantNucleus                                                = Tag{0x0018, 0x9100} // CS 1-2
+	TagFrequencyCorrection                                            = Tag{0x0018, 0x9101} // CS 1
+	TagMRSpectroscopyFOVGeometrySequence                              = Tag{0x0018, 0x9103} // SQ 1
+	TagSlabThickness                                                  = Tag{0x0018, 0x9104} // FD 1
+	TagSlabOrientation                                                = Tag{0x0018, 0x9105} // FD 3
+	TagMidSlabPosition                                                = Tag{0x0018, 0x9106} // FD 3
+	TagMRSpatialSaturationSequence                                    = Tag{0x0018, 0x9107} // SQ 1
+	TagMRTimingAndRelatedParametersSequence                           = Tag{0x0018, 0x9112} // SQ 1
+	TagMREchoSequence                                                 = Tag{0x0018, 0x9114} // SQ 1
+	TagMRModifierSequence                                             = Tag{0x0018, 0x9115} // SQ 1
+	TagMRDiffusionSequence                                            = Tag{0x0018, 0x9117} // SQ 1
+	TagCardiacSynchronizationSequence                                 = Tag{0x0018, 0x9118} // SQ 1
+	TagMRAveragesSequence                                             = Tag{0x0018, 0x9119} // SQ 1
+	TagMRFOVGeometrySequence                                          = Tag{0x0018, 0x9125} // SQ 1
+	TagVolumeLocalizationSequence                                     = Tag{0x0018, 0x9126} // SQ 1
+	TagSpectroscopyAcquisitionDataColumns                             = Tag{0x0018, 0x9127} // UL 1
+	TagDiffusionAnisotropyType                                        = Tag{0x0018, 0x9147} // CS 1
+	TagFrameReferenceDateTime                                         = Tag{0x0018, 0x9151} // DT 1
+	TagMRMetaboliteMapSequence                                        = Tag{0x0018, 0x9152} // SQ 1
+	TagParallelReductionFactorOutOfPlane                              = Tag{0x0018, 0x9155} // FD 1
+	TagSpectroscopyAcquisitionOutOfPlanePhaseSteps                    = Tag{0x0018, 0x9159} // UL 1
+	TagParallelReductionFactorSecondInPlane                           = Tag{0x0018, 0x9168} // FD 1
+	TagCardiacBeatRejectionTechnique                                  = Tag{0x0018, 0x9169} // CS 1
+	TagRespiratoryMotionCompensationTechnique                         = Tag{0x0018, 0x9170} // CS 1
+	TagRespiratorySignalSource                                        = Tag{0x0018, 0x9171} // CS 1
+	TagBulkMotionCompensationTechnique                                = Tag{0x0018, 0x9172} // CS 1
+	TagBulkMotionSignalSource                                         = Tag{0x0018, 0x9173} // CS 1
+	TagApplicableSafetyStandardAgency                                 = Tag{0x0018, 0x9174} // CS 1
+	TagApplicableSafetyStandardDescription                            = Tag{0x0018, 0x9175} // LO 1
+	TagOperatingModeSequence                                          = Tag{0x0018, 0x9176} // SQ 1
+	TagOperatingModeType                                              = Tag{0x0018, 0x9177} // CS 1
+	TagOperatingMode                                                  = Tag{0x0018, 0x9178} // CS 1
+	TagSpecificAbsorptionRateDefinition                               = Tag{0x0018, 0x9179} // CS 1
+	TagGradientOutputType                                             = Tag{0x0018, 0x9180} // CS 1
+	TagSpecificAbsorptionRateValue                                    = Tag{0x0018, 0x9181} // FD 1
+	TagGradientOutput                                                 = Tag{0x0018, 0x9182} // FD 1
+	TagFlowCompensationDirection                                      = Tag{0x0018, 0x9183} // CS 1
+	TagTaggingDelay                                                   = Tag{0x0018, 0x9184} // FD 1
+	TagRespiratoryMotionCompensationTechniqueDescription              = Tag{0x0018, 0x9185} // ST 1
+	TagRespiratorySignalSourceID                                      = Tag{0x0018, 0x9186} // SH 1
+	TagMRVelocityEncodingSequence                                     = Tag{0x0018, 0x9197} // SQ 1
+	TagFirstOrderPhaseCorrection                                      = Tag{0x0018, 0x9198} // CS 1
+	TagWaterReferencedPhaseCorrection                                 = Tag{0x0018, 0x9199} // CS 1
+	TagMRSpectroscopyAcquisitionType                                  = Tag{0x0018, 0x9200} // CS 1
+	TagRespiratoryCyclePosition                                       = Tag{0x0018, 0x9214} // CS 1
+	TagVelocityEncodingMaximumValue                                   = Tag{0x0018, 0x9217} // FD 1
+	TagTagSpacingSecondDimension                                      = Tag{0x0018, 0x9218} // FD 1
+	TagTagAngleSecondAxis                                             = Tag{0x0018, 0x9219} // SS 1
+	TagFrameAcquisitionDuration                                       = Tag{0x0018, 0x9220} // FD 1
+	TagMRImageFrameTypeSequence                                       = Tag{0x0018, 0x9226} // SQ 1
+	TagMRSpectroscopyFrameTypeSequence                                = Tag{0x0018, 0x9227} // SQ 1
+	TagMRAcquisitionPhaseEncodingStepsInPlane                         = Tag{0x0018, 0x9231} // US 1
+	TagMRAcquisitionPhaseEncodingStepsOutOfPlane                      = Tag{0x0018, 0x9232} // US 1
+	TagSpectroscopyAcquisitionPhaseColumns                            = Tag{0x0018, 0x9234} // UL 1
+	TagCardiacCyclePosition                                           = Tag{0x0018, 0x9236} // CS 1
+	TagSpecificAbsorptionRateSequence                                 = Tag{0x0018, 0x9239} // SQ 1
+	TagRFEchoTrainLength                                              = Tag{0x0018, 0x9240} // US 1
+	TagGradientEchoTrainLength                                        = Tag{0x0018, 0x9241} // US 1
+	TagArterialSpinLabelingContrast                                   = Tag{0x0018, 0x9250} // CS 1
+	TagMRArterialSpinLabelingSequence                                 = Tag{0x0018, 0x9251} // SQ 1
+	TagASLTechniqueDescription                                        = Tag{0x0018, 0x9252} // LO 1
+	TagASLSlabNumber                                                  = Tag{0x0018, 0x9253} // US 1
+	TagASLSlabThickness                                               = Tag{0x0018, 0x9254} // FD 1
+	TagASLSlabOrientation                                             = Tag{0x0018, 0x9255} // FD 3
+	TagASLMidSlabPosition                                             = Tag{0x0018, 0x9256} // FD 3
+	TagASLContext                                                     = Tag{0x0018, 0x9257} // CS 1
+	TagASLPulseTrainDuration                                          = Tag{0x0018, 0x9258} // UL 1
+	TagASLCrusherFlag                                                 = Tag{0x0018, 0x9259} // CS 1
+	TagASLCrusherFlow                                                 = Tag{0x0018, 0x925A} // FD 1
+	TagASLCrusherDescription                                          = Tag{0x0018, 0x925B} // LO 1
+	TagASLBolusCutoffFlag                                             = Tag{0x0018, 0x925C} // CS 1
+	TagASLBolusCutoffTimingSequence                                   = Tag{0x0018, 0x925D} // SQ 1
+	TagASLBolusCutoffTechnique                                        = Tag{0x0018, 0x925E} // LO 1
+	TagASLBolusCutoffDelayTime                                        = Tag{0x0018, 0x925F} // UL 1
+	TagASLSlabSequence                                                = Tag{0x0018, 0x9260} // SQ 1
+	TagChemicalShiftMinimumIntegrationLimitInppm                      = Tag{0x0018, 0x9295} // FD 1
+	TagChemicalShiftMaximumIntegrationLimitInppm                      = Tag{0x0018, 0x9296} // FD 1
+	TagCTAcquisitionTypeSequence                                      = Tag{0x0018, 0x9301} // SQ 1
+	TagAcquisitionType                                                = Tag{0x0018, 0x9302} // CS 1
+	TagTubeAngle                                                      = Tag{0x0018, 0x9303} // FD 1
+	TagCTAcquisitionDetailsSequence                                   = Tag{0x0018, 0x9304} // SQ 1
+	TagRevolutionTime                                                 = Tag{0x0018, 0x9305} // FD 1
+	TagSingleCollimationWidth                                         = Tag{0x0018, 0x9306} // FD 1
+	TagTotalCollimationWidth                                          = Tag{0x0018, 0x9307} // FD 1
+	TagCTTableDynamicsSequence                                        = Tag{0x0018, 0x9308} // SQ 1
+	TagTableSpeed                                                     = Tag{0x0018, 0x9309} // FD 1
+	TagTableFeedPerRotation                                           = Tag{0x0018, 0x9310} // FD 1
+	TagSpiralPitchFactor                                              = Tag{0x0018, 0x9311} // FD 1
+	TagCTGeometrySequence                                             = Tag{0x0018, 0x9312} // SQ 1
+	TagDataCollectionCenterPatient                                    = Tag{0x0018, 0x9313} // FD 3
+	TagCTReconstructionSequence                                       = Tag{0x0018, 0x9314} // SQ 1
+	TagReconstructionAlgorithm                                        = Tag{0x0018, 0x9315} // CS 1
+	TagConvolutionKernelGroup                                         = Tag{0x0018, 0x9316} // CS 1
+	TagReconstructionFieldOfView                                      = Tag{0x0018, 0x9317} // FD 2
+	TagReconstructionTargetCenterPatient                              = Tag{0x0018, 0x9318} // FD 3
+	TagReconstructionAngle                                            = Tag{0x0018, 0x9319} // FD 1
+	TagImageFilter                                                    = Tag{0x0018, 0x9320} // SH 1
+	TagCTExposureSequence                                             = Tag{0x0018, 0x9321} // SQ 1
+	TagReconstructionPixelSpacing                                     = Tag{0x0018, 0x9322} // FD 2
+	TagExposureModulationType                                         = Tag{0x0018, 0x9323} // CS 1
+	TagEstimatedDoseSaving                                            = Tag{0x0018, 0x9324} // FD 1
+	TagCTXRayDetailsSequence                                          = Tag{0x0018, 0x9325} // SQ 1
+	TagCTPositionSequence                                             = Tag{0x0018, 0x9326} // SQ 1
+	TagTablePosition                                                  = Tag{0x0018, 0x9327} // FD 1
+	TagExposureTimeInms                                               = Tag{0x0018, 0x9328} // FD 1
+	TagCTImageFrameTypeSequence                                       = Tag{0x0018, 0x9329} // SQ 1
+	TagXRayTubeCurrentInmA                                            = Tag{0x0018, 0x9330} // FD 1
+	TagExposureInmAs                                                  = Tag{0x0018, 0x9332} // FD 1
+	TagConstantVolumeFlag                                             = Tag{0x0018, 0x9333} // CS 1
+	TagFluoroscopyFlag                                                = Tag{0x0018, 0x9334} // CS 1
+	TagDistanceSourceToDataCollectionCenter                           = Tag{0x0018, 0x9335} // FD 1
+	TagContrastBolusAgentNumber                                       = Tag{0x0018, 0x9337} // US 1
+	TagContrastBolusIngredientCodeSequence                            = Tag{0x0018, 0x9338} // SQ 1
+	TagContrastAdministrationProfileSequence                          = Tag{0x0018, 0x9340} // SQ 1
+	TagContrastBolusUsageSequence                                     = Tag{0x0018, 0x9341} // SQ 1
+	TagContrastBolusAgentAdministered                                 = Tag{0x0018, 0x9342} // CS 1
+	TagContrastBolusAgentDetected                                     = Tag{0x0018, 0x9343} // CS 1
+	TagContrastBolusAgentPhase                                        = Tag{0x0018, 0x9344} // CS 1
+	TagCTDIvol                                                        = Tag{0x0018, 0x9345} // FD 1
+	TagCTDIPhantomTypeCodeSequence                                    = Tag{0x0018, 0x9346} // SQ 1
+	TagCalciumScoringMassFactorPatient                                = Tag{0x0018, 0x9351} // FL 1
+	TagCalciumScoringMassFactorDevice                                 = Tag{0x0018, 0x9352} // FL 3
+	TagEnergyWeightingFactor                                          = Tag{0x0018, 0x9353} // FL 1
+	TagCTAdditionalXRaySourceSequence                                 = Tag{0x0018, 0x9360} // SQ 1
+	TagProjectionPixelCalibrationSequence                             = Tag{0x0018, 0x9401} // SQ 1
+	TagDistanceSourceToIsocenter                                      = Tag{0x0018, 0x9402} // FL 1
+	TagDistanceObjectToTableTop                                       = Tag{0x0018, 0x9403} // FL 1
+	TagObjectPixelSpacingInCenterOfBeam                               = Tag{0x0018, 0x9404} // FL 2
+	TagPositionerPositionSequence                                     = Tag{0x0018, 0x9405} // SQ 1
+	TagTablePositionSequence                                          = Tag{0x0018, 0x9406} // SQ 1
+	TagCollimatorShapeSequence                                        = Tag{0x0018, 0x9407} // SQ 1
+	TagPlanesInAcquisition                                            = Tag{0x0018, 0x9410} // CS 1
+	TagXAXRFFrameCharacteristicsSequence                              = Tag{0x0018, 0x9412} // SQ 1
+	TagFrameAcquisitionSequence                                       = Tag{0x0018, 0x9417} // SQ 1
+	TagXRayReceptorType                                               = Tag{0x0018, 0x9420} // CS 1
+	TagAcquisitionProtocolName                                        = Tag{0x0018, 0x9423} // LO 1
+	TagAcquisitionProtocolDescription                                 = Tag{0x0018, 0x9424} // LT 1
+	TagContrastBolusIngredientOpaque                                  = Tag{0x0018, 0x9425} // CS 1
+	TagDistanceReceptorPlaneToDetectorHousing                         = Tag{0x0018, 0x9426} // FL 1
+	TagIntensifierActiveShape                                         = Tag{0x0018, 0x9427} // CS 1
+	TagIntensifierActiveDimensions                                    = Tag{0x0018, 0x9428} // FL 1-2
+	TagPhysicalDetectorSize                                           = Tag{0x0018, 0x9429} // FL 2
+	TagPositionOfIsocenterProjection                                  = Tag{0x0018, 0x9430} // FL 2
+	TagFieldOfViewSequence                                            = Tag{0x0018, 0x9432} // SQ 1
+	TagFieldOfViewDescription                                         = Tag{0x0018, 0x9433} // LO 1
+	TagExposureControlSensingRegionsSequence                          = Tag{0x0018, 0x9434} // SQ 1
+	TagExposureControlSensingRegionShape                              = Tag{0x0018, 0x9435} // CS 1
+	TagExposureControlSensingRegionLeftVerticalEdge                   = Tag{0x0018, 0x9436} // SS 1
+	TagExposureControlSensingRegionRightVerticalEdge                  = Tag{0x0018, 0x9437} // SS 1
+	TagExposureControlSensingRegionUpperHorizontalEdge                = Tag{0x0018, 0x9438} // SS 1
+	TagExposureControlSensingRegionLowerHorizontalEdge                = Tag{0x0018, 0x9439} // SS 1
+	TagCenterOfCircularExposureControlSensingRegion                   = Tag{0x0018, 0x9440} // SS 2
+	TagRadiusOfCircularExposureControlSensingRegion                   = Tag{0x0018, 0x9441} // US 1
+	TagVerticesOfThePolygonalExposureControlSensingRegion             = Tag{0x0018, 0x9442} // SS 2-n
+	TagColumnAngulationPatient                                        = Tag{0x0018, 0x9447} // FL 1
+	TagBeamAngle                                                      = Tag{0x0018, 0x9449} // FL 1
+	TagFrameDetectorParametersSequence                                = Tag{0x0018, 0x9451} // SQ 1
+	TagCalculatedAnatomyThickness                                     = Tag{0x0018, 0x9452} // FL 1
+	TagCalibrationSequence                                            = Tag{0x0018, 0x9455} // SQ 1
+	TagObjectThicknessSequence                                        = Tag{0x0018, 0x9456} // SQ 1
+	TagPlaneIdentification                                            = Tag{0x0018, 0x9457} // CS 1
+	TagFieldOfViewDimensionsInFloat                                   = Tag{0x0018, 0x9461} // FL 1-2
+	TagIsocenterReferenceSystemSequence                               = Tag{0x0018, 0x9462} // SQ 1
+	TagPositionerIsocenterPrimaryAngle                                = Tag{0x0018, 0x9463} // FL 1
+	TagPositionerIsocenterSecondaryAngle                              = Tag{0x0018, 0x9464} // FL 1
+	TagPositionerIsocenterDetectorRotationAngle                       = Tag{0x0018, 0x9465} // FL 1
+	TagTableXPositionToIsocenter                                      = Tag{0x0018, 0x9466} // FL 1
+	TagTableYPositionToIsocenter                                      = Tag{0x0018, 0x9467} // FL 1
+	TagTableZPositionToIsocenter                                      = Tag{0x0018, 0x9468} // FL 1
+	TagTableHorizontalRotationAngle                                   = Tag{0x0018, 0x9469} // FL 1
+	TagTableHeadTiltAngle                                             = Tag{0x0018, 0x9470} // FL 1
+	TagTableCradleTiltAngle                                           = Tag{0x0018, 0x9471} // FL 1
+	TagFrameDisplayShutterSequence                                    = Tag{0x0018, 0x9472} // SQ 1
+	TagAcquiredImageAreaDoseProduct                                   = Tag{0x0018, 0x9473} // FL 1
+	TagCArmPositionerTabletopRelationship                             = Tag{0x0018, 0x9474} // CS 1
+	TagXRayGeometrySequence                                           = Tag{0x0018, 0x9476} // SQ 1
+	TagIrradiationEventIdentificationSequence                         = Tag{0x0018, 0x9477} // SQ 1
+	TagXRay3DFrameTypeSequence                                        = Tag{0x0018, 0x9504} // SQ 1
+	TagContributingSourcesSequence                                    = Tag{0x0018, 0x9506} // SQ 1
+	TagXRay3DAcquisitionSequence                                      = Tag{0x0018, 0x9507} // SQ 1
+	TagPrimaryPositionerScanArc                                       = Tag{0x0018, 0x9508} // FL 1
+	TagSecondaryPositionerScanArc                                     = Tag{0x0018, 0x9509} // FL 1
+	TagPrimaryPositionerScanStartAngle                                = Tag{0x0018, 0x9510} // FL 1
+	TagSecondaryPositionerScanStartAngle                              = Tag{0x0018, 0x9511} // FL 1
+	TagPrimaryPositionerIncrement                                     = Tag{0x0018, 0x9514} // FL 1
+	TagSecondaryPositionerIncrement                                   = Tag{0x0018, 0x9515} // FL 1
+	TagStartAcquisitionDateTime                                       = Tag{0x0018, 0x9516} // DT 1
+	TagEndAcquisitionDateTime                                         = Tag{0x0018, 0x9517} // DT 1
+	TagApplicationName                                                = Tag{0x0018, 0x9524} // LO 1
+	TagApplicationVersion                                             = Tag{0x0018, 0x9525} // LO 1
+	TagApplicationManufacturer                                        = Tag{0x0018, 0x9526} // LO 1
+	TagAlgorithmType                                                  = Tag{0x0018, 0x9527} // CS 1
+	TagAlgorithmDescription                                           = Tag{0x0018, 0x9528} // LO 1
+	TagXRay3DReconstructionSequence                                   = Tag{0x0018, 0x9530} // SQ 1
+	TagReconstructionDescription                                      = Tag{0x0018, 0x9531} // LO 1
+	TagPerProjectionAcquisitionSequence                               = Tag{0x0018, 0x9538} // SQ 1
+	TagDiffusionBMatrixSequence                                       = Tag{0x0018, 0x9601} // SQ 1
+	TagDiffusionBValueXX                                              = Tag{0x0018, 0x9602} // FD 1
+	TagDiffusionBValueXY                                              = Tag{0x0018, 0x9603} // FD 1
+	TagDiffusionBValueXZ                                              = Tag{0x0018, 0x9604} // FD 1
+	TagDiffusionBValueYY                                              = Tag{0x0018, 0x9605} // FD 1
+	TagDiffusionBValueYZ                                              = Tag{0x0018, 0x9606} // FD 1
+	TagDiffusionBValueZZ                                              = Tag{0x0018, 0x9607} // FD 1
+	TagDecayCorrectionDateTime                                        = Tag{0x0018, 0x9701} // DT 1
+	TagStartDensityThreshold                                          = Tag{0x0018, 0x9715} // FD 1
+	TagStartRelativeDensityDifferenceThreshold                        = Tag{0x0018, 0x9716} // FD 1
+	TagStartCardiacTriggerCountThreshold                              = Tag{0x0018, 0x9717} // FD 1
+	TagStartRespiratoryTriggerCountThreshold                          = Tag{0x0018, 0x9718} // FD 1
+	TagTerminationCountsThreshold                                     = Tag{0x0018, 0x9719} // FD 1
+	TagTerminationDensityThreshold                                    = Tag{0x0018, 0x9720} // FD 1
+	TagTerminationRelativeDensityThreshold                            = Tag{0x0018, 0x9721} // FD 1
+	TagTerminationTimeThreshold                                       = Tag{0x0018, 0x9722} // FD 1
+	TagTerminationCardiacTriggerCountThreshold                        = Tag{0x0018, 0x9723} // FD 1
+	TagTerminationRespiratoryTriggerCountThreshold                    = Tag{0x0018, 0x9724} // FD 1
+	TagDetectorGeometry                                               = Tag{0x0018, 0x9725} // CS 1
+	TagTransverseDetectorSeparation                                   = Tag{0x0018, 0x9726} // FD 1
+	TagAxialDetectorDimension                                         = Tag{0x0018, 0x9727} // FD 1
+	TagRadiopharmaceuticalAgentNumber                                 = Tag{0x0018, 0x9729} // US 1
+	TagPETFrameAcquisitionSequence                                    = Tag{0x0018, 0x9732} // SQ 1
+	TagPETDetectorMotionDetailsSequence                               = Tag{0x0018, 0x9733} // SQ 1
+	TagPETTableDynamicsSequence                                       = Tag{0x0018, 0x9734} // SQ 1
+	TagPETPositionSequence                                            = Tag{0x0018, 0x9735} // SQ 1
+	TagPETFrameCorrectionFactorsSequence                              = Tag{0x0018, 0x9736} // SQ 1
+	TagRadiopharmaceuticalUsageSequence                               = Tag{0x0018, 0x9737} // SQ 1
+	TagAttenuationCorrectionSource                                    = Tag{0x0018, 0x9738} // CS 1
+	TagNumberOfIterations                                             = Tag{0x0018, 0x9739} // US 1
+	TagNumberOfSubsets                                                = Tag{0x0018, 0x9740} // US 1
+	TagPETReconstructionSequence                                      = Tag{0x0018, 0x9749} // SQ 1
+	TagPETFrameTypeSequence                                           = Tag{0x0018, 0x9751} // SQ 1
+	TagTimeOfFlightInformationUsed                                    = Tag{0x0018, 0x9755} // CS 1
+	TagReconstructionType                                             = Tag{0x0018, 0x9756} // CS 1
+	TagDecayCorrected                                                 = Tag{0x0018, 0x9758} // CS 1
+	TagAttenuationCorrected                                           = Tag{0x0018, 0x9759} // CS 1
+	TagScatterCorrected                                               = Tag{0x0018, 0x9760} // CS 1
+	TagDeadTimeCorrected                                              = Tag{0x0018, 0x9761} // CS 1
+	TagGantryMotionCorrected                                          = Tag{0x0018, 0x9762} // CS 1
+	TagPatientMotionCorrected                                         = Tag{0x0018, 0x9763} // CS 1
+	TagCountLossNormalizationCorrected                                = Tag{0x0018, 0x9764} // CS 1
+	TagRandomsCorrected                                               = Tag{0x0018, 0x9765} // CS 1
+	TagNonUniformRadialSamplingCorrected                              = Tag{0x0018, 0x9766} // CS 1
+	TagSensitivityCalibrated                                          = Tag{0x0018, 0x9767} // CS 1
+	TagDetectorNormalizationCorrection                                = Tag{0x0018, 0x9768} // CS 1
+	TagIterativeReconstructionMethod                                  = Tag{0x0018, 0x9769} // CS 1
+	TagAttenuationCorrectionTemporalRelationship                      = Tag{0x0018, 0x9770} // CS 1
+	TagPatientPhysiologicalStateSequence                              = Tag{0x0018, 0x9771} // SQ 1
+	TagPatientPhysiologicalStateCodeSequence                          = Tag{0x0018, 0x9772} // SQ 1
+	TagDepthsOfFocus                                                  = Tag{0x0018, 0x9801} // FD 1-n
+	TagExcludedIntervalsSequence                                      = Tag{0x0018, 0x9803} // SQ 1
+	TagExclusionStartDatetime                                         = Tag{0x0018, 0x9804} // DT 1
+	TagExclusionDuration                                              = Tag{0x0018, 0x9805} // FD 1
+	TagUSImageDescriptionSequence                                     = Tag{0x0018, 0x9806} // SQ 1
+	TagImageDataTypeSequence                                          = Tag{0x0018, 0x9807} // SQ 1
+	TagDataType                                                       = Tag{0x0018, 0x9808} // CS 1
+	TagTransducerScanPatternCodeSequence                              = Tag{0x0018, 0x9809} // SQ 1
+	TagAliasedDataType                                                = Tag{0x0018, 0x980B} // CS 1
+	TagPositionMeasuringDeviceUsed                                    = Tag{0x0018, 0x980C} // CS 1
+	TagTransducerGeometryCodeSequence                                 = Tag{0x0018, 0x980D} // SQ 1
+	TagTransducerBeamSteeringCodeSequence                             = Tag{0x0018, 0x980E} // SQ 1
+	TagTransducerApplicationCodeSequence                              = Tag{0x0018, 0x980F} // SQ 1
+	TagContributingEquipmentSequence                                  = Tag{0x0018, 0xA001} // SQ 1
+	TagContributionDateTime                                           = Tag{0x0018, 0xA002} // DT 1
+	TagContributionDescription                                        = Tag{0x0018, 0xA003} // ST 1
+	TagStudyInstanceUID                                               = Tag{0x0020, 0x000D} // UI 1
+	TagSeriesInstanceUID                                              = Tag{0x0020, 0x000E} // UI 1
+	TagStudyID                                                        = Tag{0x0020, 0x0010} // SH 1
+	TagSeriesNumber                                                   = Tag{0x0020, 0x0011} // IS 1
+	TagAcquisitionNumber                                              = Tag{0x0020, 0x0012} // IS 1
+	TagInstanceNumber                                                 = Tag{0x0020, 0x0013} // IS 1
+	TagItemNumber                                                     = Tag{0x0020, 0x0019} // IS 1
+	TagPatientOrientation                                             = Tag{0x0020, 0x0020} // CS 2
+	TagImagePositionPatient                                           = Tag{0x0020, 0x0032} // DS 3
+	TagImageOrientationPatient                                        = Tag{0x0020, 0x0037} // DS 6
+	TagFrameOfReferenceUID                                            = Tag{0x0020, 0x0052} // UI 1
+	TagLaterality                                                     = Tag{0x0020, 0x0060} // CS 1
+	TagImageLaterality                                                = Tag{0x0020, 0x0062} // CS 1
+	TagTemporalPositionIdentifier                                     = Tag{0x0020, 0x0100} // IS 1
+	TagNumberOfTemporalPositions                                      = Tag{0x0020, 0x0105} // IS 1
+	TagTemporalResolution                                             = Tag{0x0020, 0x0110} // DS 1
+	TagSynchronizationFrameOfReferenceUID                             = Tag{0x0020, 0x0200} // UI 1
+	TagSOPInstanceUIDOfConcatenationSource                            = Tag{0x0020, 0x0242} // UI 1
+	TagImagesInAcquisition                                            = Tag{0x0020, 0x1002} // IS 1
+	TagPositionReferenceIndicator                                     = Tag{0x0020, 0x1040} // LO 1
+	TagSliceLocation                                                  = Tag{0x0020, 0x1041} // DS 1
+	TagNumberOfPatientRelatedStudies                                  = Tag{0x0020, 0x1200} // IS 1
+	TagNumberOfPatientRelatedSeries                                   = Tag{0x0020, 0x1202} // IS 1
+	TagNumberOfPatientRelatedInstances                                = Tag{0x0020, 0x1204} // IS 1
+	TagNumberOfStudyRelatedSeries                                     = Tag{0x0020, 0x1206} // IS 1
+	TagNumberOfStudyRelatedInstances                                  = Tag{0x0020, 0x1208} // IS 1
+	TagNumberOfSeriesRelatedInstances                                 = Tag{0x0020, 0x1209} // IS 1
+	TagImageComments                                                  = Tag{0x0020, 0x4000} // LT 1
+	TagStackID                                                        = Tag{0x0020, 0x9056} // SH 1
+	TagInStackPositionNumber                                          = Tag{0x0020, 0x9057} // UL 1
+	TagFrameAnatomySequence                                           = Tag{0x0020, 0x9071} // SQ 1
+	TagFrameLaterality                                                = Tag{0x0020, 0x9072} // CS 1
+	TagFrameContentSequence                                           = Tag{0x0020, 0x9111} // SQ 1
+	TagPlanePositionSequence                                          = Tag{0x0020, 0x9113} // SQ 1
+	TagPlaneOrientationSequence                                       = Tag{0x0020, 0x9116} // SQ 1
+	TagTemporalPositionIndex                                          = Tag{0x0020, 0x9128} // UL 1
+	TagNominalCardiacTriggerDelayTime                                 = Tag{0x0020, 0x9153} // FD 1
+	TagNominalCardiacTriggerTimePriorToRPeak                          = Tag{0x0020, 0x9154} // FL 1
+	TagActualCardiacTriggerTimePriorToRPeak                           = Tag{0x0020, 0x9155} // FL 1
+	TagFrameAcquisitionNumber                                         = Tag{0x0020, 0x9156} // US 1
+	TagDimensionIndexValues                                           = Tag{0x0020, 0x9157} // UL 1-n
+	TagFrameComments                                                  = Tag{0x0020, 0x9158} // LT 1
+	TagConcatenationUID                                               = Tag{0x0020, 0x9161} // UI 1
+	TagInConcatenationNumber                                          = Tag{0x0020, 0x9162} // US 1
+	TagInConcatenationTotalNumber                                     = Tag{0x0020, 0x9163} // US 1
+	TagDimensionOrganizationUID                                       = Tag{0x0020, 0x9164} // UI 1
+	TagDimensionIndexPointer                                          = Tag{0x0020, 0x9165} // AT 1
+	TagFunctionalGroupPointer                                         = Tag{0x0020, 0x9167} // AT 1
+	TagDimensionIndexPrivateCreator                                   = Tag{0x0020, 0x9213} // LO 1
+	TagDimensionOrganizationSequence                                  = Tag{0x0020, 0x9221} // SQ 1
+	TagDimensionIndexSequence                                         = Tag{0x0020, 0x9222} // SQ 1
+	TagConcatenationFrameOffsetNumber                                 = Tag{0x0020, 0x9228} // UL 1
+	TagFunctionalGroupPrivateCreator                                  = Tag{0x0020, 0x9238} // LO 1
+	TagNominalPercentageOfCardiacPhase                                = Tag{0x0020, 0x9241} // FL 1
+	TagNominalPercentageOfRespiratoryPhase                            = Tag{0x0020, 0x9245} // FL 1
+	TagStartingRespiratoryAmplitude                                   = Tag{0x0020, 0x9246} // FL 1
+	TagStartingRespiratoryPhase                                       = Tag{0x0020, 0x9247} // CS 1
+	TagEndingRespiratoryAmplitude                                     = Tag{0x0020, 0x9248} // FL 1
+	TagEndingRespiratoryPhase                                         = Tag{0x0020, 0x9249} // CS 1
+	TagRespiratoryTriggerType                                         = Tag{0x0020, 0x9250} // CS 1
+	TagRRIntervalTimeNominal                                          = Tag{0x0020, 0x9251} // FD 1
+	TagActualCardiacTriggerDelayTime                                  = Tag{0x0020, 0x9252} // FD 1
+	TagRespiratorySynchronizationSequence                             = Tag{0x0020, 0x9253} // SQ 1
+	TagRespiratoryIntervalTime                                        = Tag{0x0020, 0x9254} // FD 1
+	TagNominalRespiratoryTriggerDelayTime                             = Tag{0x0020, 0x9255} // FD 1
+	TagRespiratoryTriggerDelayThreshold                               = Tag{0x0020, 0x9256} // FD 1
+	TagActualRespiratoryTriggerDelayTime                              = Tag{0x0020, 0x9257} // FD 1
+	TagImagePositionVolume                                            = Tag{0x0020, 0x9301} // FD 3
+	TagImageOrientationVolume                                         = Tag{0x0020, 0x9302} // FD 6
+	TagUltrasoundAcquisitionGeometry                                  = Tag{0x0020, 0x9307} // CS 1
+	TagApexPosition                                                   = Tag{0x0020, 0x9308} // FD 3
+	TagVolumeToTransducerMappingMatrix                                = Tag{0x0020, 0x9309} // FD 16
+	TagVolumeToTableMappingMatrix                                     = Tag{0x0020, 0x930A} // FD 16
+	TagPatientFrameOfReferenceSource                                  = Tag{0x0020, 0x930C} // CS 1
+	TagTemporalPositionTimeOffset                                     = Tag{0x0020, 0x930D} // FD 1
+	TagPlanePositionVolumeSequence                                    = Tag{0x0020, 0x930E} // SQ 1
+	TagPlaneOrientationVolumeSequence                                 = Tag{0x0020, 0x930F} // SQ 1
+	TagTemporalPositionSequence                                       = Tag{0x0020, 0x9310} // SQ 1
+	TagDimensionOrganizationType                                      = Tag{0x0020, 0x9311} // CS 1
+	TagVolumeFrameOfReferenceUID                                      = Tag{0x0020, 0x9312} // UI 1
+	TagTableFrameOfReferenceUID                                       = Tag{0x0020, 0x9313} // UI 1
+	TagDimensionDescriptionLabel                                      = Tag{0x0020, 0x9421} // LO 1
+	TagPatientOrientationInFrameSequence                              = Tag{0x0020, 0x9450} // SQ 1
+	TagFrameLabel                                                     = Tag{0x0020, 0x9453} // LO 1
+	TagAcquisitionIndex                                               = Tag{0x0020, 0x9518} // US 1-n
+	TagContributingSOPInstancesReferenceSequence                      = Tag{0x0020, 0x9529} // SQ 1
+	TagReconstructionIndex                                            = Tag{0x0020, 0x9536} // US 1
+	TagLightPathFilterPassThroughWavelength                           = Tag{0x0022, 0x0001} // US 1
+	TagLightPathFilterPassBand                                        = Tag{0x0022, 0x0002} // US 2
+	TagImagePathFilterPassThroughWavelength                           = Tag{0x0022, 0x0003} // US 1
+	TagImagePathFilterPassBand                                        = Tag{0x0022, 0x0004} // US 2
+	TagPatientEyeMovementCommanded                                    = Tag{0x0022, 0x0005} // CS 1
+	TagPatientEyeMovementCommandCodeSequence                          = Tag{0x0022, 0x0006} // SQ 1
+	TagSphericalLensPower                                             = Tag{0x0022, 0x0007} // FL 1
+	TagCylinderLensPower                                              = Tag{0x0022, 0x0008} // FL 1
+	TagCylinderAxis                                                   = Tag{0x0022, 0x0009} // FL 1
+	TagEmmetropicMagnification                                        = Tag{0x0022, 0x000A} // FL 1
+	TagIntraOcularPressure                                            = Tag{0x0022, 0x000B} // FL 1
+	TagHorizontalFieldOfView                                          = Tag{0x0022, 0x000C} // FL 1
+	TagPupilDilated                                                   = Tag{0x0022, 0x000D} // CS 1
+	TagDegreeOfDilation                                               = Tag{0x0022, 0x000E} // FL 1
+	TagStereoBaselineAngle                                            = Tag{0x0022, 0x0010} // FL 1
+	TagStereoBaselineDisplacement                                     = Tag{0x0022, 0x0011} // FL 1
+	TagStereoHorizontalPixelOffset                                    = Tag{0x0022, 0x0012} // FL 1
+	TagStereoVerticalPixelOffset                                      = Tag{0x0022, 0x0013} // FL 1
+	TagStereoRotation                                                 = Tag{0x0022, 0x0014} // FL 1
+	TagAcquisitionDeviceTypeCodeSequence                              = Tag{0x0022, 0x0015} // SQ 1
+	TagIlluminationTypeCodeSequence                                   = Tag{0x0022, 0x0016} // SQ 1
+	TagLightPathFilterTypeStackCodeSequence                           = Tag{0x0022, 0x0017} // SQ 1
+	TagImagePathFilterTypeStackCodeSequence                           = Tag{0x0022, 0x0018} // SQ 1
+	TagLensesCodeSequence                                             = Tag{0x0022, 0x0019} // SQ 1
+	TagChannelDescriptionCodeSequence                                 = Tag{0x0022, 0x001A} // SQ 1
+	TagRefractiveStateSequence                                        = Tag{0x0022, 0x001B} // SQ 1
+	TagMydriaticAgentCodeSequence                                     = Tag{0x0022, 0x001C} // SQ 1
+	TagRelativeImagePositionCodeSequence                              = Tag{0x0022, 0x001D} // SQ 1
+	TagCameraAngleOfView                                              = Tag{0x0022, 0x001E} // FL 1
+	TagStereoPairsSequence                                            = Tag{0x0022, 0x0020} // SQ 1
+	TagLeftImageSequence                                              = Tag{0x0022, 0x0021} // SQ 1
+	TagRightImageSequence                                             = Tag{0x0022, 0x0022} // SQ 1
+	TagAxialLengthOfTheEye                                            = Tag{0x0022, 0x0030} // FL 1
+	TagOphthalmicFrameLocationSequence                                = Tag{0x0022, 0x0031} // SQ 1
+	TagReferenceCoordinates                                           = Tag{0x0022, 0x0032} // FL 2-2n
+	TagDepthSpatialResolution                                         = Tag{0x0022, 0x0035} // FL 1
+	TagMaximumDepthDistortion                                         = Tag{0x0022, 0x0036} // FL 1
+	TagAlongScanSpatialResolution                                     = Tag{0x0022, 0x0037} // FL 1
+	TagMaximumAlongScanDistortion                                     = Tag{0x0022, 0x0038} // FL 1
+	TagOphthalmicImageOrientation                                     = Tag{0x0022, 0x0039} // CS 1
+	TagDepthOfTransverseImage                                         = Tag{0x0022, 0x0041} // FL 1
+	TagMydriaticAgentConcentrationUnitsSequence                       = Tag{0x0022, 0x0042} // SQ 1
+	TagAcrossScanSpatialResolution                                    = Tag{0x0022, 0x0048} // FL 1
+	TagMaximumAcrossScanDistortion                                    = Tag{0x0022, 0x0049} // FL 1
+	TagMydriaticAgentConcentration                                    = Tag{0x0022, 0x004E} // DS 1
+	TagIlluminationWaveLength                                         = Tag{0x0022, 0x0055} // FL 1
+	TagIlluminationPower                                              = Tag{0x0022, 0x0056} // FL 1
+	TagIlluminationBandwidth                                          = Tag{0x0022, 0x0057} // FL 1
+	TagMydriaticAgentSequence                                         = Tag{0x0022, 0x0058} // SQ 1
+	TagOphthalmicAxialMeasurementsRightEyeSequence                    = Tag{0x0022, 0x1007} // SQ 1
+	TagOphthalmicAxialMeasurementsLeftEyeSequence                     = Tag{0x0022, 0x1008} // SQ 1
+	TagOphthalmicAxialMeasurementsDeviceType                          = Tag{0x0022, 0x1009} // CS 1
+	TagOphthalmicAxialLengthMeasurementsType                          = Tag{0x0022, 0x1010} // CS 1
+	TagOphthalmicAxialLengthSequence                                  = Tag{0x0022, 0x1012} // SQ 1
+	TagOphthalmicAxialLength                                          = Tag{0x0022, 0x1019} // FL 1
+	TagLensStatusCodeSequence                                         = Tag{0x0022, 0x1024} // SQ 1
+	TagVitreousStatusCodeSequence                                     = Tag{0x0022, 0x1025} // SQ 1
+	TagIOLFormulaCodeSequence                                         = Tag{0x0022, 0x1028} // SQ 1
+	TagIOLFormulaDetail                                               = Tag{0x0022, 0x1029} // LO 1
+	TagKeratometerIndex                                               = Tag{0x0022, 0x1033} // FL 1
+	TagSourceOfOphthalmicAxialLengthCodeSequence                      = Tag{0x0022, 0x1035} // SQ 1
+	TagTargetRefraction                                               = Tag{0x0022, 0x1037} // FL 1
+	TagRefractiveProcedureOccurred                                    = Tag{0x0022, 0x1039} // CS 1
+	TagRefractiveSurgeryTypeCodeSequence                              = Tag{0x0022, 0x1040} // SQ 1
+	TagOphthalmicUltrasoundMethodCodeSequence                         = Tag{0x0022, 0x1044} // SQ 1
+	TagOphthalmicAxialLengthMeasurementsSequence                      = Tag{0x0022, 0x1050} // SQ 1
+	TagIOLPower                                                       = Tag{0x0022, 0x1053} // FL 1
+	TagPredictedRefractiveError                                       = Tag{0x0022, 0x1054} // FL 1
+	TagOphthalmicAxialLengthVelocity                                  = Tag{0x0022, 0x1059} // FL 1
+	TagLensStatusDescription                                          = Tag{0x0022, 0x1065} // LO 1
+	TagVitreousStatusDescription                                      = Tag{0x0022, 0x1066} // LO 1
+	TagIOLPowerSequence                                               = Tag{0x0022, 0x1090} // SQ 1
+	TagLensConstantSequence                                           = Tag{0x0022, 0x1092} // SQ 1
+	TagIOLManufacturer                                                = Tag{0x0022, 0x1093} // LO 1
+	TagLensConstantDescription                                        = Tag{0x0022, 0x1094} // LO 1
+	TagImplantName                                                    = Tag{0x0022, 0x1095} // LO 1
+	TagKeratometryMeasurementTypeCodeSequence                         = Tag{0x0022, 0x1096} // SQ 1
+	TagImplantPartNumber                                              = Tag{0x0022, 0x1097} // LO 1
+	TagReferencedOphthalmicAxialMeasurementsSequence                  = Tag{0x0022, 0x1100} // SQ 1
+	TagOphthalmicAxialLengthMeasurementsSegmentNameCodeSequence       = Tag{0x0022, 0x1101} // SQ 1
+	TagRefractiveErrorBeforeRefractiveSurgeryCodeSequence             = Tag{0x0022, 0x1103} // SQ 1
+	TagIOLPowerForExactEmmetropia                                     = Tag{0x0022, 0x1121} // FL 1
+	TagIOLPowerForExactTargetRefraction                               = Tag{0x0022, 0x1122} // FL 1
+	TagAnteriorChamberDepthDefinitionCodeSequence                     = Tag{0x0022, 0x1125} // SQ 1
+	TagLensThicknessSequence                                          = Tag{0x0022, 0x1127} // SQ 1
+	TagAnteriorChamberDepthSequence                                   = Tag{0x0022, 0x1128} // SQ 1
+	TagLensThickness                                                  = Tag{0x0022, 0x1130} // FL 1
+	TagAnteriorChamberDepth                                           = Tag{0x0022, 0x1131} // FL 1
+	TagSourceOfLensThicknessDataCodeSequence                          = Tag{0x0022, 0x1132} // SQ 1
+	TagSourceOfAnteriorChamberDepthDataCodeSequence                   = Tag{0x0022, 0x1133} // SQ 1
+	TagSourceOfRefractiveMeasurementsSequence                         = Tag{0x0022, 0x1134} // SQ 1
+	TagSourceOfRefractiveMeasurementsCodeSequence                     = Tag{0x0022, 0x1135} // SQ 1
+	TagOphthalmicAxialLengthMeasurementModified                       = Tag{0x0022, 0x1140} // CS 1
+	TagOphthalmicAxialLengthDataSourceCodeSequence                    = Tag{0x0022, 0x1150} // SQ 1
+	TagOphthalmicAxialLengthAcquisitionMethodCodeSequence             = Tag{0x0022, 0x1153} // SQ 1
+	TagSignalToNoiseRatio                                             = Tag{0x0022, 0x1155} // FL 1
+	TagOphthalmicAxialLengthDataSourceDescription                     = Tag{0x0022, 0x1159} // LO 1
+	TagOphthalmicAxialLengthMeasurementsTotalLengthSequence           = Tag{0x0022, 0x1210} // SQ 1
+	TagOphthalmicAxialLengthMeasurementsSegmentalLengthSequence       = Tag{0x0022, 0x1211} // SQ 1
+	TagOphthalmicAxialLengthMeasurementsLengthSummationSequence       = Tag{0x0022, 0x1212} // SQ 1
+	TagUltrasoundOphthalmicAxialLengthMeasurementsSequence            = Tag{0x0022, 0x1220} // SQ 1
+	TagOpticalOphthalmicAxialLengthMeasurementsSequence               = Tag{0x0022, 0x1225} // SQ 1
+	TagUltrasoundSelectedOphthalmicAxialLengthSequence                = Tag{0x0022, 0x1230} // SQ 1
+	TagOphthalmicAxialLengthSelectionMethodCodeSequence               = Tag{0x0022, 0x1250} // SQ 1
+	TagOpticalSelectedOphthalmicAxialLengthSequence                   = Tag{0x0022, 0x1255} // SQ 1
+	TagSelectedSegmentalOphthalmicAxialLengthSequence                 = Tag{0x0022, 0x1257} // SQ 1
+	TagSelectedTotalOphthalmicAxialLengthSequence                     = Tag{0x0022, 0x1260} // SQ 1
+	TagOphthalmicAxialLengthQualityMetricSequence                     = Tag{0x0022, 0x1262} // SQ 1
+	TagOphthalmicAxialLengthQualityMetricTypeCodeSequence             = Tag{0x0022, 0x1265} // SQ 1
+	TagOphthalmicAxialLengthQualityMetricTypeDescription              = Tag{0x0022, 0x1273} // LO 1
+	TagIntraocularLensCalculationsRightEyeSequence                    = Tag{0x0022, 0x1300} // SQ 1
+	TagIntraocularLensCalculationsLeftEyeSequence                     = Tag{0x0022, 0x1310} // SQ 1
+	TagReferencedOphthalmicAxialLengthMeasurementQCImageSequence      = Tag{0x0022, 0x1330} // SQ 1
+	TagVisualFieldHorizontalExtent                                    = Tag{0x0024, 0x0010} // FL 1
+	TagVisualFieldVerticalExtent                                      = Tag{0x0024, 0x0011} // FL 1
+	TagVisualFieldShape                                               = Tag{0x0024, 0x0012} // CS 1
+	TagScreeningTestModeCodeSequence                                  = Tag{0x0024, 0x0016} // SQ 1
+	TagMaximumStimulusLuminance                                       = Tag{0x0024, 0x0018} // FL 1
+	TagBackgroundLuminance                                            = Tag{0x0024, 0x0020} // FL 1
+	TagStimulusColorCodeSequence                                      = Tag{0x0024, 0x0021} // SQ 1
+	TagBackgroundIlluminationColorCodeSequence                        = Tag{0x0024, 0x0024} // SQ 1
+	TagStimulusArea                                                   = Tag{0x0024, 0x0025} // FL 1
+	TagStimulusPresentationTime                                       = Tag{0x0024, 0x0028} // FL 1
+	TagFixationSequence                                               = Tag{0x0024, 0x0032} // SQ 1
+	TagFixationMonitoringCodeSequence                                 = Tag{0x0024, 0x0033} // SQ 1
+	TagVisualFieldCatchTrialSequence                                  = Tag{0x0024, 0x0034} // SQ 1
+	TagFixationCheckedQuantity                                        = Tag{0x0024, 0x0035} // US 1
+	TagPatientNotProperlyFixatedQuantity                              = Tag{0x0024, 0x0036} // US 1
+	TagPresentedVisualStimuliDataFlag                                 = Tag{0x0024, 0x0037} // CS 1
+	TagNumberOfVisualStimuli                                          = Tag{0x0024, 0x0038} // US 1
+	TagExcessiveFixationLossesDataFlag                                = Tag{0x0024, 0x0039} // CS 1
+	TagExcessiveFixationLosses                                        = Tag{0x0024, 0x0040} // CS 1
+	TagStimuliRetestingQuantity                                       = Tag{0x0024, 0x0042} // US 1
+	TagCommentsOnPatientPerformanceOfVisualField                      = Tag{0x0024, 0x0044} // LT 1
+	TagFalseNegativesEstimateFlag                                     = Tag{0x0024, 0x0045} // CS 1
+	TagFalseNegativesEstimate                                         = Tag{0x0024, 0x0046} // FL 1
+	TagNegativeCatchTrialsQuantity                                    = Tag{0x0024, 0x0048} // US 1
+	TagFalseNegativesQuantity                                         = Tag{0x0024, 0x0050} // US 1
+	TagExcessiveFalseNegativesDataFlag                                = Tag{0x0024, 0x0051} // CS 1
+	TagExcessiveFalseNegatives                                        = Tag{0x0024, 0x0052} // CS 1
+	TagFalsePositivesEstimateFlag                                     = Tag{0x0024, 0x0053} // CS 1
+	TagFalsePositivesEstimate                                         = Tag{0x0024, 0x0054} // FL 1
+	TagCatchTrialsDataFlag                                            = Tag{0x0024, 0x0055} // CS 1
+	TagPositiveCatchTrialsQuantity                                    = Tag{0x0024, 0x0056} // US 1
+	TagTestPointNormalsDataFlag                                       = Tag{0x0024, 0x0057} // CS 1
+	TagTestPointNormalsSequence                                       = Tag{0x0024, 0x0058} // SQ 1
+	TagGlobalDeviationProbabilityNormalsFlag                          = Tag{0x0024, 0x0059} // CS 1
+	TagFalsePositivesQuantity                                         = Tag{0x0024, 0x0060} // US 1
+	TagExcessiveFalsePositivesDataFlag                                = Tag{0x0024, 0x0061} // CS 1
+	TagExcessiveFalsePositives                                        = Tag{0x0024, 0x0062} // CS 1
+	TagVisualFieldTestNormalsFlag                                     = Tag{0x0024, 0x0063} // CS 1
+	TagResultsNormalsSequence                                         = Tag{0x0024, 0x0064} // SQ 1
+	TagAgeCorrectedSensitivityDeviationAlgorithmSequence              = Tag{0x0024, 0x0065} // SQ 1
+	TagGlobalDeviationFromNormal                                      = Tag{0x0024, 0x0066} // FL 1
+	TagGeneralizedDefectSensitivityDeviationAlgorithmSequence         = Tag{0x0024, 0x0067} // SQ 1
+	TagLocalizedDeviationfromNormal                                   = Tag{0x0024, 0x0068} // FL 1
+	TagPatientReliabilityIndicator                                    = Tag{0x0024, 0x0069} // LO 1
+	TagVisualFieldMeanSensitivity                                     = Tag{0x0024, 0x0070} // FL 1
+	TagGlobalDeviationProbability                                     = Tag{0x0024, 0x0071} // FL 1
+	TagLocalDeviationProbabilityNormalsFlag                           = Tag{0x0024, 0x0072} // CS 1
+	TagLocalizedDeviationProbability                                  = Tag{0x0024, 0x0073} // FL 1
+	TagShortTermFluctuationCalculated                                 = Tag{0x0024, 0x0074} // CS 1
+	TagShortTermFluctuation                                           = Tag{0x0024, 0x0075} // FL 1
+	TagShortTermFluctuationProbabilityCalculated                      = Tag{0x0024, 0x0076} // CS 1
+	TagShortTermFluctuationProbability                                = Tag{0x0024, 0x0077} // FL 1
+	TagCorrectedLocalizedDeviationFromNormalCalculated                = Tag{0x0024, 0x0078} // CS 1
+	TagCorrectedLocalizedDeviationFromNormal                          = Tag{0x0024, 0x0079} // FL 1
+	TagCorrectedLocalizedDeviationFromNormalProbabilityCalculated     = Tag{0x0024, 0x0080} // CS 1
+	TagCorrectedLocalizedDeviationFromNormalProbability               = Tag{0x0024, 0x0081} // FL 1
+	TagGlobalDeviationProbabilitySequence                             = Tag{0x0024, 0x0083} // SQ 1
+	TagLocalizedDeviationProbabilitySequence                          = Tag{0x0024, 0x0085} // SQ 1
+	TagFovealSensitivityMeasured                                      = Tag{0x0024, 0x0086} // CS 1
+	TagFovealSensitivity                                              = Tag{0x0024, 0x0087} // FL 1
+	TagVisualFieldTestDuration                                        = Tag{0x0024, 0x0088} // FL 1
+	TagVisualFieldTestPointSequence                                   = Tag{0x0024, 0x0089} // SQ 1
+	TagVisualFieldTestPointXCoordinate                                = Tag{0x0024, 0x0090} // FL 1
+	TagVisualFieldTestPointYCoordinate                                = Tag{0x0024, 0x0091} // FL 1
+	TagAgeCorrectedSensitivityDeviationValue                          = Tag{0x0024, 0x0092} // FL 1
+	TagStimulusResults                                                = Tag{0x0024, 0x0093} // CS 1
+	TagSensitivityValue                                               = Tag{0x0024, 0x0094} // FL 1
+	TagRetestStimulusSeen                                             = Tag{0x0024, 0x0095} // CS 1
+	TagRetestSensitivityValue                                         = Tag{0x0024, 0x0096} // FL 1
+	TagVisualFieldTestPointNormalsSequence                            = Tag{0x0024, 0x0097} // SQ 1
+	TagQuantifiedDefect                                               = Tag{0x0024, 0x0098} // FL 1
+	TagAgeCorrectedSensitivityDeviationProbabilityValue               = Tag{0x0024, 0x0100} // FL 1
+	TagGeneralizedDefectCorrectedSensitivityDeviationFlag             = Tag{0x0024, 0x0102} // CS 1
+	TagGeneralizedDefectCorrectedSensitivityDeviationValue            = Tag{0x0024, 0x0103} // FL 1
+	TagGeneralizedDefectCorrectedSensitivityDeviationProbabilityValue = Tag{0x0024, 0x0104} // FL 1
+	TagMinimumSensitivityValue                                        = Tag{0x0024, 0x0105} // FL 1
+	TagBlindSpotLocalized                                             = Tag{0x0024, 0x0106} // CS 1
+	TagBlindSpotXCoordinate                                           = Tag{0x0024, 0x0107} // FL 1
+	TagBlindSpotYCoordinate                                           = Tag{0x0024, 0x0108} // FL 1
+	TagVisualAcuityMeasurementSequence                                = Tag{0x0024, 0x0110} // SQ 1
+	TagRefractiveParametersUsedOnPatientSequence                      = Tag{0x0024, 0x0112} // SQ 1
+	TagMeasurementLaterality                                          = Tag{0x0024, 0x0113} // CS 1
+	TagOphthalmicPatientClinicalInformationLeftEyeSequence            = Tag{0x0024, 0x0114} // SQ 1
+	TagOphthalmicPatientClinicalInformationRightEyeSequence           = Tag{0x0024, 0x0115} // SQ 1
+	TagFovealPointNormativeDataFlag                                   = Tag{0x0024, 0x0117} // CS 1
+	TagFovealPointProbabilityValue                                    = Tag{0x0024, 0x0118} // FL 1
+	TagScreeningBaselineMeasured                                      = Tag{0x0024, 0x0120} // CS 1
+	TagScreeningBaselineMeasuredSequence                              = Tag{0x0024, 0x0122} // SQ 1
+	TagScreeningBaselineType                                          = Tag{0x0024, 0x0124} // CS 1
+	TagScreeningBaselineValue                                         = Tag{0x0024, 0x0126} // FL 1
+	TagAlgorithmSource                                                = Tag{0x0024, 0x0202} // LO 1
+	TagDataSetName                                                    = Tag{0x0024, 0x0306} // LO 1
+	TagDataSetVersion                                                 = Tag{0x0024, 0x0307} // LO 1
+	TagDataSetSource                                                  = Tag{0x0024, 0x0308} // LO 1
+	TagDataSetDescription                                             = Tag{0x0024, 0x0309} // LO 1
+	TagVisualFieldTestReliabilityGlobalIndexSequence                  = Tag{0x0024, 0x0317} // SQ 1
+	TagVisualFieldGlobalResultsIndexSequence                          = Tag{0x0024, 0x0320} // SQ 1
+	TagDataObservationSequence                                        = Tag{0x0024, 0x0325} // SQ 1
+	TagIndexNormalsFlag                                               = Tag{0x0024, 0x0338} // CS 1
+	TagIndexProbability                                               = Tag{0x0024, 0x0341} // FL 1
+	TagIndexProbabilitySequence                                       = Tag{0x0024, 0x0344} // SQ 1
+	TagSamplesPerPixel                                                = Tag{0x0028, 0x0002} // US 1
+	TagSamplesPerPixelUsed                                            = Tag{0x0028, 0x0003} // US 1
+	TagPhotometricInterpretation                                      = Tag{0x0028, 0x0004} // CS 1
+	TagPlanarConfiguration                                            = Tag{0x0028, 0x0006} // US 1
+	TagNumberOfFrames                                                 = Tag{0x0028, 0x0008} // IS 1
+	TagFrameIncrementPointer                                          = Tag{0x0028, 0x0009} // AT 1-n
+	TagFrameDimensionPointer                                          = Tag{0x0028, 0x000A} // AT 1-n
+	TagRows                                                           = Tag{0x0028, 0x0010} // US 1
+	TagColumns                                                        = Tag{0x0028, 0x0011} // US 1
+	TagUltrasoundColorDataPresent                                     = Tag{0x0028, 0x0014} // US 1
+	TagPixelSpacing                                                   = Tag{0x0028, 0x0030} // DS 2
+	TagZoomFactor                                                     = Tag{0x0028, 0x0031} // DS 2
+	TagZoomCenter                                                     = Tag{0x0028, 0x0032} // DS 2
+	TagPixelAspectRatio                                               = Tag{0x0028, 0x0034} // IS 2
+	TagCorrectedImage                                                 = Tag{0x0028, 0x0051} // CS 1-n
+	TagBitsAllocated                                                  = Tag{0x0028, 0x0100} // US 1
+	TagBitsStored                                                     = Tag{0x0028, 0x0101} // US 1
+	TagHighBit                                                        = Tag{0x0028, 0x0102} // US 1
+	TagPixelRepresentation                                            = Tag{0x0028, 0x0103} // US 1
+	TagSmallestImagePixelValue                                        = Tag{0x0028, 0x0106} // XS 1
+	TagLargestImagePixelValue                                         = Tag{0x0028, 0x0107} // XS 1
+	TagSmallestPixelValueInSeries                                     = Tag{0x0028, 0x0108} // XS 1
+	TagLargestPixelValueInSeries                                      = Tag{0x0028, 0x0109} // XS 1
+	TagPixelPaddingValue                                              = Tag{0x0028, 0x0120} // XS 1
+	TagPixelPaddingRangeLimit                                         = Tag{0x0028, 0x0121} // XS 1
+	TagQualityControlImage                                            = Tag{0x0028, 0x0300} // CS 1
+	TagBurnedInAnnotation                                             = Tag{0x0028, 0x0301} // CS 1
+	TagRecognizableVisualFeatures                                     = Tag{0x0028, 0x0302} // CS 1
+	TagLongitudinalTemporalInformationModified                        = Tag{0x0028, 0x0303} // CS 1
+	TagReferencedColorPaletteInstanceUID                              = Tag{0x0028, 0x0304} // UI 1
+	TagPixelSpacingCalibrationType                                    = Tag{0x0028, 0x0A02} // CS 1
+	TagPixelSpacingCalibrationDescription                             = Tag{0x0028, 0x0A04} // LO 1
+	TagPixelIntensityRelationship                                     = Tag{0x0028, 0x1040} // CS 1
+	TagPixelIntensityRelationshipSign                                 = Tag{0x0028, 0x1041} // SS 1
+	TagWindowCenter                                                   = Tag{0x0028, 0x1050} // DS 1-n
+	TagWindowWidth                                                    = Tag{0x0028, 0x1051} // DS 1-n
+	TagRescaleIntercept                                               = Tag{0x0028, 0x1052} // DS 1
+	TagRescaleSlope                                                   = Tag{0x0028, 0x1053} // DS 1
+	TagRescaleType                                                    = Tag{0x0028, 0x1054} // LO 1
+	TagWindowCenterWidthExplanation                                   = Tag{0x0028, 0x1055} // LO 1-n
+	TagVOILUTFunction                                                 = Tag{0x0028, 0x1056} // CS 1
+	TagRecommendedViewingMode                                         = Tag{0x0028, 0x1090} // CS 1
+	TagRedPaletteColorLookupTableDescriptor                           = Tag{0x0028, 0x1101} // XS 3
+	TagGreenPaletteColorLookupTableDescriptor                         = Tag{0x0028, 0x1102} // XS 3
+	TagBluePaletteColorLookupTableDescriptor                          = Tag{0x0028, 0x1103} // XS 3
+	TagAlphaPaletteColorLookupTableDescriptor                         = Tag{0x0028, 0x1104} // US 3
+	TagPaletteColorLookupTableUID                                     = Tag{0x0028, 0x1199} // UI 1
+	TagRedPaletteColorLookupTableData                                 = Tag{0x0028, 0x1201} // OW 1
+	TagGreenPaletteColorLookupTableData                               = Tag{0x0028, 0x1202} // OW 1
+	TagBluePaletteColorLookupTableData                                = Tag{0x0028, 0x1203} // OW 1
+	TagAlphaPaletteColorLookupTableData                               = Tag{0x0028, 0x1204} // OW 1
+	TagSegmentedRedPaletteColorLookupTableData                        = Tag{0x0028, 0x1221} // OW 1
+	TagSegmentedGreenPaletteColorLookupTableData                      = Tag{0x0028, 0x1222} // OW 1
+	TagSegmentedBluePaletteColorLookupTableData                       = Tag{0x0028, 0x1223} // OW 1
+	TagBreastImplantPresent                                           = Tag{0x0028, 0x1300} // CS 1
+	TagPartialView                                                    = Tag{0x0028, 0x1350} // CS 1
+	TagPartialViewDescription                                         = Tag{0x0028, 0x1351} // ST 1
+	TagPartialViewCodeSequence                                        = Tag{0x0028, 0x1352} // SQ 1
+	TagSpatialLocationsPreserved                                      = Tag{0x0028, 0x135A} // CS 1
+	TagDataFrameAssignmentSequence                                    = Tag{0x0028, 0x1401} // SQ 1
+	TagDataPathAssignment                                             = Tag{0x0028, 0x1402} // CS 1
+	TagBitsMappedToColorLookupTable                                   = Tag{0x0028, 0x1403} // US 1
+	TagBlendingLUT1Sequence                                           = Tag{0x0028, 0x1404} // SQ 1
+	TagBlendingLUT1TransferFunction                                   = Tag{0x0028, 0x1405} // CS 1
+	TagBlendingWeightConstant                                         = Tag{0x0028, 0x1406} // FD 1
+	TagBlendingLookupTableDescriptor                                  = Tag{0x0028, 0x1407} // US 3
+	TagBlendingLookupTableData                                        = Tag{0x0028, 0x1408} // OW 1
+	TagEnhancedPaletteColorLookupTableSequence                        = Tag{0x0028, 0x140B} // SQ 1
+	TagBlendingLUT2Sequence                                           = Tag{0x0028, 0x140C} // SQ 1
+	TagBlendingLUT2TransferFunction                                   = Tag{0x0028, 0x140D} // CS 1
+	TagDataPathID                                                     = Tag{0x0028, 0x140E} // CS 1
+	TagRGBLUTTransferFunction                                         = Tag{0x0028, 0x140F} // CS 1
+	TagAlphaLUTTransferFunction                                       = Tag{0x0028, 0x1410} // CS 1
+	TagICCProfile                                                     = Tag{0x0028, 0x2000} // OB 1
+	TagLossyImageCompression                                          = Tag{0x0028, 0x2110} // CS 1
+	TagLossyImageCompressionRatio                                     = Tag{0x0028, 0x2112} // DS 1-n
+	TagLossyImageCompressionMethod                                    = Tag{0x0028, 0x2114} // CS 1-n
+	TagModalityLUTSequence                                            = Tag{0x0028, 0x3000} // SQ 1
+	TagLUTDescriptor                                                  = Tag{0x0028, 0x3002} // XS 3
+	TagLUTExplanation                                                 = Tag{0x0028, 0x3003} // LO 1
+	TagModalityLUTType                                                = Tag{0x0028, 0x3004} // LO 1
+	TagLUTData                                                        = Tag{0x0028, 0x3006} // LT 1-n
+	TagVOILUTSequence                                                 = Tag{0x0028, 0x3010} // SQ 1
+	TagSoftcopyVOILUTSequence                                         = Tag{0x0028, 0x3110} // SQ 1
+	TagRepresentativeFrameNumber                                      = Tag{0x0028, 0x6010} // US 1
+	TagFrameNumbersOfInterest                                         = Tag{0x0028, 0x6020} // US 1-n
+	TagFrameOfInterestDescription                                     = Tag{0x0028, 0x6022} // LO 1-n
+	TagFrameOfInterestType                                            = Tag{0x0028, 0x6023} // CS 1-n
+	TagRWavePointer                                                   = Tag{0x0028, 0x6040} // US 1-n
+	TagMaskSubtractionSequence                                        = Tag{0x0028, 0x6100} // SQ 1
+	TagMaskOperation                                                  = Tag{0x0028, 0x6101} // CS 1
+	TagApplicableFrameRange                                           = Tag{0x0028, 0x6102} // US 2-2n
+	TagMaskFrameNumbers                                               = Tag{0x0028, 0x6110} // US 1-n
+	TagContrastFrameAveraging                                         = Tag{0x0028, 0x6112} // US 1
+	TagMaskSubPixelShift                                              = Tag{0x0028, 0x6114} // FL 2
+	TagTIDOffset                                                      = Tag{0x0028, 0x6120} // SS 1
+	TagMaskOperationExplanation                                       = Tag{0x0028, 0x6190} // ST 1
+	TagPixelDataProviderURL                                           = Tag{0x0028, 0x7FE0} // UT 1
+	TagDataPointRows                                                  = Tag{0x0028, 0x9001} // UL 1
+	TagDataPointColumns                                               = Tag{0x0028, 0x9002} // UL 1
+	TagSignalDomainColumns                                            = Tag{0x0028, 0x9003} // CS 1
+	TagDataRepresentation                                             = Tag{0x0028, 0x9108} // CS 1
+	TagPixelMeasuresSequence                                          = Tag{0x0028, 0x9110} // SQ 1
+	TagFrameVOILUTSequence                                            = Tag{0x0028, 0x9132} // SQ 1
+	TagPixelValueTransformationSequence                               = Tag{0x0028, 0x9145} // SQ 1
+	TagSignalDomainRows                                               = Tag{0x0028, 0x9235} // CS 1
+	TagDisplayFilterPercentage                                        = Tag{0x0028, 0x9411} // FL 1
+	TagFramePixelShiftSequence                                        = Tag{0x0028, 0x9415} // SQ 1
+	TagSubtractionItemID                                              = Tag{0x0028, 0x9416} // US 1
+	TagPixelIntensityRelationshipLUTSequence                          = Tag{0x0028, 0x9422} // SQ 1
+	TagFramePixelDataPropertiesSequence                               = Tag{0x0028, 0x9443} // SQ 1
+	TagGeometricalProperties                                          = Tag{0x0028, 0x9444} // CS 1
+	TagGeometricMaximumDistortion                                     = Tag{0x0028, 0x9445} // FL 1
+	TagImageProcessingApplied                                         = Tag{0x0028, 0x9446} // CS 1-n
+	TagMaskSelectionMode                                              = Tag{0x0028, 0x9454} // CS 1
+	TagLUTFunction                                                    = Tag{0x0028, 0x9474} // CS 1
+	TagMaskVisibilityPercentage                                       = Tag{0x0028, 0x9478} // FL 1
+	TagPixelShiftSequence                                             = Tag{0x0028, 0x9501} // SQ 1
+	TagRegionPixelShiftSequence                                       = Tag{0x0028, 0x9502} // SQ 1
+	TagVerticesOfTheRegion                                            = Tag{0x0028, 0x9503} // SS 2-2n
+	TagMultiFramePresentationSequence                                 = Tag{0x0028, 0x9505} // SQ 1
+	TagPixelShiftFrameRange                                           = Tag{0x0028, 0x9506} // US 2-2n
+	TagLUTFrameRange                                                  = Tag{0x0028, 0x9507} // US 2-2n
+	TagImageToEquipmentMappingMatrix                                  = Tag{0x0028, 0x9520} // DS 16
+	TagEquipmentCoordinateSystemIdentification                        = Tag{0x0028, 0x9537} // CS 1
+	TagRequestingPhysicianIdentificationSequence                      = Tag{0x0032, 0x1031} // SQ 1
+	TagRequestingPhysician                                            = Tag{0x0032, 0x1032} // PN 1
+	TagRequestingService                                              = Tag{0x0032, 0x1033} // LO 1
+	TagRequestingServiceCodeSequence                                  = Tag{0x0032, 0x1034} // SQ 1
+	TagRequestedProcedureDescription                                  = Tag{0x0032, 0x1060} // LO 1
+	TagRequestedProcedureCodeSequence                                 = Tag{0x0032, 0x1064} // SQ 1
+	TagRequestedContrastAgent                                         = Tag{0x0032, 0x1070} // LO 1
+	TagReferencedPatientAliasSequence                                 = Tag{0x0038, 0x0004} // SQ 1
+	TagVisitStatusID                                                  = Tag{0x0038, 0x0008} // CS 1
+	TagAdmissionID                                                    = Tag{0x0038, 0x0010} // LO 1
+	TagIssuerOfAdmissionIDSequence                                    = Tag{0x0038, 0x0014} // SQ 1
+	TagRouteOfAdmissions                                              = Tag{0x0038, 0x0016} // LO 1
+	TagAdmittingDate                                                  = Tag{0x0038, 0x0020} // DA 1
+	TagAdmittingTime                                                  = Tag{0x0038, 0x0021} // TM 1
+	TagSpecialNeeds                                                   = Tag{0x0038, 0x0050} // LO 1
+	TagServiceEpisodeID                                               = Tag{0x0038, 0x0060} // LO 1
+	TagServiceEpisodeDescription                                      = Tag{0x0038, 0x0062} // LO 1
+	TagIssuerOfServiceEpisodeIDSequence                               = Tag{0x0038, 0x0064} // SQ 1
+	TagPertinentDocumentsSequence                                     = Tag{0x0038, 0x0100} // SQ 1
+	TagCurrentPatientLocation                                         = Tag{0x0038, 0x0300} // LO 1
+	TagPatientInstitutionResidence                                    = Tag{0x0038, 0x0400} // LO 1
+	TagPatientState                                                   = Tag{0x0038, 0x0500} // LO 1
+	TagPatientClinicalTrialParticipationSequence                      = Tag{0x0038, 0x0502} // SQ 1
+	TagVisitComments                                                  = Tag{0x0038, 0x4000} // LT 1
+	TagWaveformOriginality                                            = Tag{0x003A, 0x0004} // CS 1
+	TagNumberOfWaveformChannels                                       = Tag{0x003A, 0x0005} // US 1
+	TagNumberOfWaveformSamples                                        = Tag{0x003A, 0x0010} // UL 1
+	TagSamplingFrequency                                              = Tag{0x003A, 0x001A} // DS 1
+	TagMultiplexGroupLabel                                            = Tag{0x003A, 0x0020} // SH 1
+	TagChannelDefinitionSequence                                      = Tag{0x003A, 0x0200} // SQ 1
+	TagWaveformChannelNumber                                          = Tag{0x003A, 0x0202} // IS 1
+	TagChannelLabel                                                   = Tag{0x003A, 0x0203} // SH 1
+	TagChannelStatus                                                  = Tag{0x003A, 0x0205} // CS 1-n
+	TagChannelSourceSequence                                          = Tag{0x003A, 0x0208} // SQ 1
+	TagChannelSourceModifiersSequence                                 = Tag{0x003A, 0x0209} // SQ 1
+	TagSourceWaveformSequence                                         = Tag{0x003A, 0x020A} // SQ 1
+	TagChannelDerivationDescription                                   = Tag{0x003A, 0x020C} // LO 1
+	TagChannelSensitivity                                             = Tag{0x003A, 0x0210} // DS 1
+	TagChannelSensitivityUnitsSequence                                = Tag{0x003A, 0x0211} // SQ 1
+	TagChannelSensitivityCorrectionFactor                             = Tag{0x003A, 0x0212} // DS 1
+	TagChannelBaseline                                                = Tag{0x003A, 0x0213} // DS 1
+	TagChannelTimeSkew                                                = Tag{0x003A, 0x0214} // DS 1
+	TagChannelSampleSkew                                              = Tag{0x003A, 0x0215} // DS 1
+	TagChannelOffset                                                  = Tag{0x003A, 0x0218} // DS 1
+	TagWaveformBitsStored                                             = Tag{0x003A, 0x021A} // US 1
+	TagFilterLowFrequency                                             = Tag{0x003A, 0x0220} // DS 1
+	TagFilterHighFrequency                                            = Tag{0x003A, 0x0221} // DS 1
+	TagNotchFilterFrequency                                           = Tag{0x003A, 0x0222} // DS 1
+	TagNotchFilterBandwidth                                           = Tag{0x003A, 0x0223} // DS 1
+	TagWaveformDataDisplayScale                                       = Tag{0x003A, 0x0230} // FL 1
+	TagWaveformDisplayBackgroundCIELabValue                           = Tag{0x003A, 0x0231} // US 3
+	TagWaveformPresentationGroupSequence                              = Tag{0x003A, 0x0240} // SQ 1
+	TagPresentationGroupNumber                                        = Tag{0x003A, 0x0241} // US 1
+	TagChannelDisplaySequence                                         = Tag{0x003A, 0x0242} // SQ 1
+	TagChannelRecommendedDisplayCIELabValue                           = Tag{0x003A, 0x0244} // US 3
+	TagChannelPosition                                                = Tag{0x003A, 0x0245} // FL 1
+	TagDisplayShadingFlag                                             = Tag{0x003A, 0x0246} // CS 1
+	TagFractionalChannelDisplayScale                                  = Tag{0x003A, 0x0247} // FL 1
+	TagAbsoluteChannelDisplayScale                                    = Tag{0x003A, 0x0248} // FL 1
+	TagMultiplexedAudioChannelsDescriptionCodeSequence                = Tag{0x003A, 0x0300} // SQ 1
+	TagChannelIdentificationCode                                      = Tag{0x003A, 0x0301} // IS 1
+	TagChannelMode                                                    = Tag{0x003A, 0x0302} // CS 1
+	TagScheduledStationAETitle                                        = Tag{0x0040, 0x0001} // AE 1-n
+	TagScheduledProcedureStepStartDate                                = Tag{0x0040, 0x0002} // DA 1
+	TagScheduledProcedureStepStartTime                                = Tag{0x0040, 0x0003} // TM 1
+	TagScheduledProcedureStepEndDate                                  = Tag{0x0040, 0x0004} // DA 1
+	TagScheduledProcedureStepEndTime                                  = Tag{0x0040, 0x0005} // TM 1
+	TagScheduledPerformingPhysicianName                               = Tag{0x0040, 0x0006} // PN 1
+	TagScheduledProcedureStepDescription                              = Tag{0x0040, 0x0007} // LO 1
+	TagScheduledProtocolCodeSequence                                  = Tag{0x0040, 0x0008} // SQ 1
+	TagScheduledProcedureStepID                                       = Tag{0x0040, 0x0009} // SH 1
+	TagStageCodeSequence                                              = Tag{0x0040, 0x000A} // SQ 1
+	TagScheduledPerformingPhysicianIdentificationSequence             = Tag{0x0040, 0x000B} // SQ 1
+	TagScheduledStationName                                           = Tag{0x0040, 0x0010} // SH 1-n
+	TagScheduledProcedureStepLocation                                 = Tag{0x0040, 0x0011} // SH 1
+	TagPreMedication                                                  = Tag{0x0040, 0x0012} // LO 1
+	TagScheduledProcedureStepStatus                                   = Tag{0x0040, 0x0020} // CS 1
+	TagOrderPlacerIdentifierSequence                                  = Tag{0x0040, 0x0026} // SQ 1
+	TagOrderFillerIdentifierSequence                                  = Tag{0x0040, 0x0027} // SQ 1
+	TagLocalNamespaceEntityID                                         = Tag{0x0040, 0x0031} // UT 1
+	TagUniversalEntityID                                              = Tag{0x0040, 0x0032} // UT 1
+	TagUniversalEntityIDType                                          = Tag{0x0040, 0x0033} // CS 1
+	TagIdentifierTypeCode                                             = Tag{0x0040, 0x0035} // CS 1
+	TagAssigningFacilitySequence                                      = Tag{0x0040, 0x0036} // SQ 1
+	TagAssigningJurisdictionCodeSequence                              = Tag{0x0040, 0x0039} // SQ 1
+	TagAssigningAgencyOrDepartmentCodeSequence                        = Tag{0x0040, 0x003A} // SQ 1
+	TagScheduledProcedureStepSequence                                 = Tag{0x0040, 0x0100} // SQ 1
+	TagReferencedNonImageCompositeSOPInstanceSequence                 = Tag{0x0040, 0x0220} // SQ 1
+	TagPerformedStationAETitle                                        = Tag{0x0040, 0x0241} // AE 1
+	TagPerformedStationName                                           = Tag{0x0040, 0x0242} // SH 1
+	TagPerformedLocation                                              = Tag{0x0040, 0x0243} // SH 1
+	TagPerformedProcedureStepStartDate                                = Tag{0x0040, 0x0244} // DA 1
+	TagPerformedProcedureStepStartTime                                = Tag{0x0040, 0x0245} // TM 1
+	TagPerformedProcedureStepEndDate                                  = Tag{0x0040, 0x0250} // DA 1
+	TagPerformedProcedureStepEndTime                                  = Tag{0x0040, 0x0251} // TM 1
+	TagPerformedProcedureStepStatus                                   = Tag{0x0040, 0x0252} // CS 1
+	TagPerformedProcedureStepID                                       = Tag{0x0040, 0x0253} // SH 1
+	TagPerformedProcedureStepDescription                              = Tag{0x0040, 0x0254} // LO 1
+	TagPerformedProcedureTypeDescription                              = Tag{0x0040, 0x0255} // LO 1
+	TagPerformedProtocolCodeSequence                                  = Tag{0x0040, 0x0260} // SQ 1
+	TagPerformedProtocolType                                          = Tag{0x0040, 0x0261} // CS 1
+	TagScheduledStepAttributesSequence                                = Tag{0x0040, 0x0270} // SQ 1
+	TagRequestAttributesSequence                                      = Tag{0x0040, 0x0275} // SQ 1
+	TagCommentsOnThePerformedProcedureStep                            = Tag{0x0040, 0x0280} // ST 1
+	TagPerformedProcedureStepDiscontinuationReasonCodeSequence        = Tag{0x0040, 0x0281} // SQ 1
+	TagQuantitySequence                                               = Tag{0x0040, 0x0293} // SQ 1
+	TagQuantity                                                       = Tag{0x0040, 0x0294} // DS 1
+	TagMeasuringUnitsSequence                                         = Tag{0x0040, 0x0295} // SQ 1
+	TagBillingItemSequence                                            = Tag{0x0040, 0x0296} // SQ 1
+	TagTotalTimeOfFluoroscopy                                         = Tag{0x0040, 0x0300} // US 1
+	TagTotalNumberOfExposures                                         = Tag{0x0040, 0x0301} // US 1
+	TagEntranceDose                                                   = Tag{0x0040, 0x0302} // US 1
+	TagExposedArea                                                    = Tag{0x0040, 0x0303} // US 1-2
+	TagDistanceSourceToEntrance                                       = Tag{0x0040, 0x0306} // DS 1
+	TagExposureDoseSequence                                           = Tag{0x0040, 0x030E} // SQ 1
+	TagCommentsOnRadiationDose                                        = Tag{0x0040, 0x0310} // ST 1
+	TagXRayOutput                                                     = Tag{0x0040, 0x0312} // DS 1
+	TagHalfValueLayer                                                 = Tag{0x0040, 0x0314} // DS 1
+	TagOrganDose                                                      = Tag{0x0040, 0x0316} // DS 1
+	TagOrganExposed                                                   = Tag{0x0040, 0x0318} // CS 1
+	TagBillingProcedureStepSequence                                   = Tag{0x0040, 0x0320} // SQ 1
+	TagFilmConsumptionSequence                                        = Tag{0x0040, 0x0321} // SQ 1
+	TagBillingSuppliesAndDevicesSequence                              = Tag{0x0040, 0x0324} // SQ 1
+	TagPerformedSeriesSequence                                        = Tag{0x0040, 0x0340} // SQ 1
+	TagCommentsOnTheScheduledProcedureStep                            = Tag{0x0040, 0x0400} // LT 1
+	TagProtocolContextSequence                                        = Tag{0x0040, 0x0440} // SQ 1
+	TagContentItemModifierSequence                                    = Tag{0x0040, 0x0441} // SQ 1
+	TagScheduledSpecimenSequence                                      = Tag{0x0040, 0x0500} // SQ 1
+	TagContainerIdentifier                                            = Tag{0x0040, 0x0512} // LO 1
+	TagIssuerOfTheContainerIdentifierSequence                         = Tag{0x0040, 0x0513} // SQ 1
+	TagAlternateContainerIdentifierSequence                           = Tag{0x0040, 0x0515} // SQ 1
+	TagContainerTypeCodeSequence                                      = Tag{0x0040, 0x0518} // SQ 1
+	TagContainerDescription                                           = Tag{0x0040, 0x051A} // LO 1
+	TagContainerComponentSequence                                     = Tag{0x0040, 0x0520} // SQ 1
+	TagSpecimenIdentifier                                             = Tag{0x0040, 0x0551} // LO 1
+	TagSpecimenUID                                                    = Tag{0x0040, 0x0554} // UI 1
+	TagAcquisitionContextSequence                                     = Tag{0x0040, 0x0555} // SQ 1
+	TagAcquisitionContextDescription                                  = Tag{0x0040, 0x0556} // ST 1
+	TagSpecimenDescriptionSequence                                    = Tag{0x0040, 0x0560} // SQ 1
+	TagIssuerOfTheSpecimenIdentifierSequence                          = Tag{0x0040, 0x0562} // SQ 1
+	TagSpecimenTypeCodeSequence                                       = Tag{0x0040, 0x059A} // SQ 1
+	TagSpecimenShortDescription                                       = Tag{0x0040, 0x0600} // LO 1
+	TagSpecimenDetailedDescription                                    = Tag{0x0040, 0x0602} // UT 1
+	TagSpecimenPreparationSequence                                    = Tag{0x0040, 0x0610} // SQ 1
+	TagSpecimenPreparationStepContentItemSequence                     = Tag{0x0040, 0x0612} // SQ 1
+	TagSpecimenLocalizationContentItemSequence                        = Tag{0x0040, 0x0620} // SQ 1
+	TagImageCenterPointCoordinatesSequence                            = Tag{0x0040, 0x071A} // SQ 1
+	TagXOffsetInSlideCoordinateSystem                                 = Tag{0x0040, 0x072A} // DS 1
+	TagYOffsetInSlideCoordinateSystem                                 = Tag{0x0040, 0x073A} // DS 1
+	TagZOffsetInSlideCoordinateSystem                                 = Tag{0x0040, 0x074A} // DS 1
+	TagMeasurementUnitsCodeSequence                                   = Tag{0x0040, 0x08EA} // SQ 1
+	TagRequestedProcedureID                                           = Tag{0x0040, 0x1001} // SH 1
+	TagReasonForTheRequestedProcedure                                 = Tag{0x0040, 0x1002} // LO 1
+	TagRequestedProcedurePriority                                     = Tag{0x0040, 0x1003} // SH 1
+	TagPatientTransportArrangements                                   = Tag{0x0040, 0x1004} // LO 1
+	TagRequestedProcedureLocation                                     = Tag{0x0040, 0x1005} // LO 1
+	TagConfidentialityCode                                            = Tag{0x0040, 0x1008} // LO 1
+	TagReportingPriority                                              = Tag{0x0040, 0x1009} // SH 1
+	TagReasonForRequestedProcedureCodeSequence                        = Tag{0x0040, 0x100A} // SQ 1
+	TagNamesOfIntendedRecipientsOfResults                             = Tag{0x0040, 0x1010} // PN 1-n
+	TagIntendedRecipientsOfResultsIdentificationSequence              = Tag{0x0040, 0x1011} // SQ 1
+	TagReasonForPerformedProcedureCodeSequence                        = Tag{0x0040, 0x1012} // SQ 1
+	TagPersonIdentificationCodeSequence                               = Tag{0x0040, 0x1101} // SQ 1
+	TagPersonAddress                                                  = Tag{0x0040, 0x1102} // ST 1
+	TagPersonTelephoneNumbers                                         = Tag{0x0040, 0x1103} // LO 1-n
+	TagRequestedProcedureComments                                     = Tag{0x0040, 0x1400} // LT 1
+	TagIssueDateOfImagingServiceRequest                               = Tag{0x0040, 0x2004} // DA 1
+	TagIssueTimeOfImagingServiceRequest                               = Tag{0x0040, 0x2005} // TM 1
+	TagOrderEnteredBy                                                 = Tag{0x0040, 0x2008} // PN 1
+	TagOrderEntererLocation                                           = Tag{0x0040, 0x2009} // SH 1
+	TagOrderCallbackPhoneNumber                                       = Tag{0x0040, 0x2010} // SH 1
+	TagPlacerOrderNumberImagingServiceRequest                         = Tag{0x0040, 0x2016} // LO 1
+	TagFillerOrderNumberImagingServiceRequest                         = Tag{0x0040, 0x2017} // LO 1
+	TagImagingServiceRequestComments                                  = Tag{0x0040, 0x2400} // LT 1
+	TagConfidentialityConstraintOnPatientDataDescription              = Tag{0x0040, 0x3001} // LO 1
+	TagGeneralPurposeScheduledProcedureStepStatus                     = Tag{0x0040, 0x4001} // CS 1
+	TagGeneralPurposePerformedProcedureStepStatus                     = Tag{0x0040, 0x4002} // CS 1
+	TagGeneralPurposeScheduledProcedureStepPriority                   = Tag{0x0040, 0x4003} // CS 1
+	TagScheduledProcessingApplicationsCodeSequence                    = Tag{0x0040, 0x4004} // SQ 1
+	TagScheduledProcedureStepStartDateTime                            = Tag{0x0040, 0x4005} // DT 1
+	TagMultipleCopiesFlag                                             = Tag{0x0040, 0x4006} // CS 1
+	TagPerformedProcessingApplicationsCodeSequence                    = Tag{0x0040, 0x4007} // SQ 1
+	TagHumanPerformerCodeSequence                                     = Tag{0x0040, 0x4009} // SQ 1
+	TagScheduledProcedureStepModificationDateTime                     = Tag{0x0040, 0x4010} // DT 1
+	TagExpectedCompletionDateTime                                     = Tag{0x0040, 0x4011} // DT 1
+	TagResultingGeneralPurposePerformedProcedureStepsSequence         = Tag{0x0040, 0x4015} // SQ 1
+	TagReferencedGeneralPurposeScheduledProcedureStepSequence         = Tag{0x0040, 0x4016} // SQ 1
+	TagScheduledWorkitemCodeSequence                                  = Tag{0x0040, 0x4018} // SQ 1
+	TagPerformedWorkitemCodeSequence                                  = Tag{0x0040, 0x4019} // SQ 1
+	TagInputAvailabilityFlag                                          = Tag{0x0040, 0x4020} // CS 1
+	TagInputInformationSequence                                       = Tag{0x0040, 0x4021} // SQ 1
+	TagRelevantInformationSequence                                    = Tag{0x0040, 0x4022} // SQ 1
+	TagReferencedGeneralPurposeScheduledProcedureStepTransactionUID   = Tag{0x0040, 0x4023} // UI 1
+	TagScheduledStationNameCodeSequence                               = Tag{0x0040, 0x4025} // SQ 1
+	TagScheduledStationClassCodeSequence                              = Tag{0x0040, 0x4026} // SQ 1
+	TagScheduledStationGeographicLocationCodeSequence                 = Tag{0x0040, 0x4027} // SQ 1
+	TagPerformedStationNameCodeSequence                               = Tag{0x0040, 0x4028} // SQ 1
+	TagPerformedStationClassCodeSequence                              = Tag{0x0040, 0x4029} // SQ 1
+	TagPerformedStationGeographicLocationCodeSequence                 = Tag{0x0040, 0x4030} // SQ 1
+	TagRequestedSubsequentWorkitemCodeSequence                        = Tag{0x0040, 0x4031} // SQ 1
+	TagNonDICOMOutputCodeSequence                                     = Tag{0x0040, 0x4032} // SQ 1
+	TagOutputInformationSequence                                      = Tag{0x0040, 0x4033} // SQ 1
+	TagScheduledHumanPerformersSequence                               = Tag{0x0040, 0x4034} // SQ 1
+	TagActualHumanPerformersSequence                                  = Tag{0x0040, 0x4035} // SQ 1
+	TagHumanPerformerOrganization                                     = Tag{0x0040, 0x4036} // LO 1
+	TagHumanPerformerName                                             = Tag{0x0040, 0x4037} // PN 1
+	TagRawDataHandling                                                = Tag{0x0040, 0x4040} // CS 1
+	TagInputReadinessState                                            = Tag{0x0040, 0x4041} // CS 1
+	TagPerformedProcedureStepStartDateTime                            = Tag{0x0040, 0x4050} // DT 1
+	TagPerformedProcedureStepEndDateTime                              = Tag{0x0040, 0x4051} // DT 1
+	TagProcedureStepCancellationDateTime                              = Tag{0x0040, 0x4052} // DT 1
+	TagEntranceDoseInmGy                                              = Tag{0x0040, 0x8302} // DS 1
+	TagReferencedImageRealWorldValueMappingSequence                   = Tag{0x0040, 0x9094} // SQ 1
+	TagRealWorldValueMappingSequence                                  = Tag{0x0040, 0x9096} // SQ 1
+	TagPixelValueMappingCodeSequence                                  = Tag{0x0040, 0x9098} // SQ 1
+	TagLUTLabel                                                       = Tag{0x0040, 0x9210} // SH 1
+	TagRealWorldValueLastValueMapped                                  = Tag{0x0040, 0x9211} // XS 1
+	TagRealWorldValueLUTData                                          = Tag{0x0040, 0x9212} // FD 1-n
+	TagRealWorldValueFirstValueMapped                                 = Tag{0x0040, 0x9216} // XS 1
+	TagRealWorldValueIntercept                                        = Tag{0x0040, 0x9224} // FD 1
+	TagRealWorldValueSlope                                            = Tag{0x0040, 0x9225} // FD 1
+	TagRelationshipType                                               = Tag{0x0040, 0xA010} // CS 1
+	TagVerifyingOrganization                                          = Tag{0x0040, 0xA027} // LO 1
+	TagVerificationDateTime                                           = Tag{0x0040, 0xA030} // DT 1
+	TagObservationDateTime                                            = Tag{0x0040, 0xA032} // DT 1
+	TagValueType                                                      = Tag{0x0040, 0xA040} // CS 1
+	TagConceptNameCodeSequence                                        = Tag{0x0040, 0xA043} // SQ 1
+	TagContinuityOfContent                                            = Tag{0x0040, 0xA050} // CS 1
+	TagVerifyingObserverSequence                                      = Tag{0x0040, 0xA073} // SQ 1
+	TagVerifyingObserverName                                          = Tag{0x0040, 0xA075} // PN 1
+	TagAuthorObserverSequence                                         = Tag{0x0040, 0xA078} // SQ 1
+	TagParticipantSequence                                            = Tag{0x0040, 0xA07A} // SQ 1
+	TagCustodialOrganizationSequence                                  = Tag{0x0040, 0xA07C} // SQ 1
+	TagParticipationType                                              = Tag{0x0040, 0xA080} // CS 1
+	TagParticipationDateTime                                          = Tag{0x0040, 0xA082} // DT 1
+	TagObserverType                                                   = Tag{0x0040, 0xA084} // CS 1
+	TagVerifyingObserverIdentificationCodeSequence                    = Tag{0x0040, 0xA088} // SQ 1
+	TagReferencedWaveformChannels                                     = Tag{0x0040, 0xA0B0} // US 2-2n
+	TagDateTime                                                       = Tag{0x0040, 0xA120} // DT 1
+	TagDate                                                           = Tag{0x0040, 0xA121} // DA 1
+	TagTime                                                           = Tag{0x0040, 0xA122} // TM 1
+	TagPersonName                                                     = Tag{0x0040, 0xA123} // PN 1
+	TagUID                                                            = Tag{0x0040, 0xA124} // UI 1
+	TagTemporalRangeType                                              = Tag{0x0040, 0xA130} // CS 1
+	TagReferencedSamplePositions                                      = Tag{0x0040, 0xA132} // UL 1-n
+	TagReferencedFrameNumbers                                         = Tag{0x0040, 0xA136} // US 1-n
+	TagReferencedTimeOffsets                                          = Tag{0x0040, 0xA138} // DS 1-n
+	TagReferencedDateTime                                             = Tag{0x0040, 0xA13A} // DT 1-n
+	TagTextValue                                                      = Tag{0x0040, 0xA160} // UT 1
+	TagConceptCodeSequence                                            = Tag{0x0040, 0xA168} // SQ 1
+	TagPurposeOfReferenceCodeSequence                                 = Tag{0x0040, 0xA170} // SQ 1
+	TagAnnotationGroupNumber                                          = Tag{0x0040, 0xA180} // US 1
+	TagModifierCodeSequence                                           = Tag{0x0040, 0xA195} // SQ 1
+	TagMeasuredValueSequence                                          = Tag{0x0040, 0xA300} // SQ 1
+	TagNumericValueQualifierCodeSequence                              = Tag{0x0040, 0xA301} // SQ 1
+	TagNumericValue                                                   = Tag{0x0040, 0xA30A} // DS 1-n
+	TagPredecessorDocumentsSequence                                   = Tag{0x0040, 0xA360} // SQ 1
+	TagReferencedRequestSequence                                      = Tag{0x0040, 0xA370} // SQ 1
+	TagPerformedProcedureCodeSequence                                 = Tag{0x0040, 0xA372} // SQ 1
+	TagCurrentRequestedProcedureEvidenceSequence                      = Tag{0x0040, 0xA375} // SQ 1
+	TagPertinentOtherEvidenceSequence                                 = Tag{0x0040, 0xA385} // SQ 1
+	TagHL7StructuredDocumentReferenceSequence                         = Tag{0x0040, 0xA390} // SQ 1
+	TagCompletionFlag                                                 = Tag{0x0040, 0xA491} // CS 1
+	TagCompletionFlagDescription                                      = Tag{0x0040, 0xA492} // LO 1
+	TagVerificationFlag                                               = Tag{0x0040, 0xA493} // CS 1
+	TagArchiveRequested                                               = Tag{0x0040, 0xA494} // CS 1
+	TagPreliminaryFlag                                                = Tag{0x0040, 0xA496} // CS 1
+	TagContentTemplateSequence                                        = Tag{0x0040, 0xA504} // SQ 1
+	TagIdenticalDocumentsSequence                                     = Tag{0x0040, 0xA525} // SQ 1
+	TagContentSequence                                                = Tag{0x0040, 0xA730} // SQ 1
+	TagWaveformAnnotationSequence                                     = Tag{0x0040, 0xB020} // SQ 1
+	TagTemplateIdentifier                                             = Tag{0x0040, 0xDB00} // CS 1
+	TagReferencedContentItemIdentifier                                = Tag{0x0040, 0xDB73} // UL 1-n
+	TagHL7InstanceIdentifier                                          = Tag{0x0040, 0xE001} // ST 1
+	TagHL7DocumentEffectiveTime                                       = Tag{0x0040, 0xE004} // DT 1
+	TagHL7DocumentTypeCodeSequence                                    = Tag{0x0040, 0xE006} // SQ 1
+	TagDocumentClassCodeSequence                                      = Tag{0x0040, 0xE008} // SQ 1
+	TagRetrieveURI                                                    = Tag{0x0040, 0xE010} // UT 1
+	TagRetrieveLocationUID                                            = Tag{0x0040, 0xE011} // UI 1
+	TagTypeOfInstances                                                = Tag{0x0040, 0xE020} // CS 1
+	TagDICOMRetrievalSequence                                         = Tag{0x0040, 0xE021} // SQ 1
+	TagDICOMMediaRetrievalSequence                                    = Tag{0x0040, 0xE022} // SQ 1
+	TagWADORetrievalSequence                                          = Tag{0x0040, 0xE023} // SQ 1
+	TagXDSRetrievalSequence                                           = Tag{0x0040, 0xE024} // SQ 1
+	TagRepositoryUniqueID                                             = Tag{0x0040, 0xE030} // UI 1
+	TagHomeCommunityID                                                = Tag{0x0040, 0xE031} // UI 1
+	TagDocumentTitle                                                  = Tag{0x0042, 0x0010} // ST 1
+	TagEncapsulatedDocument                                           = Tag{0x0042, 0x0011} // OB 1
+	TagMIMETypeOfEncapsulatedDocument                                 = Tag{0x0042, 0x0012} // LO 1
+	TagSourceInstanceSequence                                         = Tag{0x0042, 0x0013} // SQ 1
+	TagListOfMIMETypes                                                = Tag{0x0042, 0x0014} // LO 1-n
+	TagProductPackageIdentifier                                       = Tag{0x0044, 0x0001} // ST 1
+	TagSubstanceAdministrationApproval                                = Tag{0x0044, 0x0002} // CS 1
+	TagApprovalStatusFurtherDescription                               = Tag{0x0044, 0x0003} // LT 1
+	TagApprovalStatusDateTime                                         = Tag{0x0044, 0x0004} // DT 1
+	TagProductTypeCodeSequence                                        = Tag{0x0044, 0x0007} // SQ 1
+	TagProductName                                                    = Tag{0x0044, 0x0008} // LO 1-n
+	TagProductDescription                                             = Tag{0x0044, 0x0009} // LT 1
+	TagProductLotIdentifier                                           = Tag{0x0044, 0x000A} // LO 1
+	TagProductExpirationDateTime                                      = Tag{0x0044, 0x000B} // DT 1
+	TagSubstanceAdministrationDateTime                                = Tag{0x0044, 0x0010} // DT 1
+	TagSubstanceAdministrationNotes                                   = Tag{0x0044, 0x0011} // LO 1
+	TagSubstanceAdministrationDeviceID                                = Tag{0x0044, 0x0012} // LO 1
+	TagProductParameterSequence                                       = Tag{0x0044, 0x0013} // SQ 1
+	TagSubstanceAdministrationParameterSequence                       = Tag{0x0044, 0x0019} // SQ 1
+	TagLensDescription                                                = Tag{0x0046, 0x0012} // LO 1
+	TagRightLensSequence                                              = Tag{0x0046, 0x0014} // SQ 1
+	TagLeftLensSequence                                               = Tag{0x0046, 0x0015} // SQ 1
+	TagUnspecifiedLateralityLensSequence                              = Tag{0x0046, 0x0016} // SQ 1
+	TagCylinderSequence                                               = Tag{0x0046, 0x0018} // SQ 1
+	TagPrismSequence                                                  = Tag{0x0046, 0x0028} // SQ 1
+	TagHorizontalPrismPower                                           = Tag{0x0046, 0x0030} // FD 1
+	TagHorizontalPrismBase                                            = Tag{0x0046, 0x0032} // CS 1
+	TagVerticalPrismPower                                             = Tag{0x0046, 0x0034} // FD 1
+	TagVerticalPrismBase                                              = Tag{0x0046, 0x0036} // CS 1
+	TagLensSegmentType                                                = Tag{0x0046, 0x0038} // CS 1
+	TagOpticalTransmittance                                           = Tag{0x0046, 0x0040} // FD 1
+	TagChannelWidth                                                   = Tag{0x0046, 0x0042} // FD 1
+	TagPupilSize                                                      = Tag{0x0046, 0x0044} // FD 1
+	TagCornealSize                                                    = Tag{0x0046, 0x0046} // FD 1
+	TagAutorefractionRightEyeSequence                                 = Tag{0x0046, 0x0050} // SQ 1
+	TagAutorefractionLeftEyeSequence                                  = Tag{0x0046, 0x0052} // SQ 1
+	TagDistancePupillaryDistance                                      = Tag{0x0046, 0x0060} // FD 1
+	TagNearPupillaryDistance                                          = Tag{0x0046, 0x0062} // FD 1
+	TagIntermediatePupillaryDistance                                  = Tag{0x0046, 0x0063} // FD 1
+	TagOtherPupillaryDistance                                         = Tag{0x0046, 0x0064} // FD 1
+	TagKeratometryRightEyeSequence                                    = Tag{0x0046, 0x0070} // SQ 1
+	TagKeratometryLeftEyeSequence                                     = Tag{0x0046, 0x0071} // SQ 1
+	TagSteepKeratometricAxisSequence                                  = Tag{0x0046, 0x0074} // SQ 1
+	TagRadiusOfCurvature                                              = Tag{0x0046, 0x0075} // FD 1
+	TagKeratometricPower                                              = Tag{0x0046, 0x0076} // FD 1
+	TagKeratometricAxis                                               = Tag{0x0046, 0x0077} // FD 1
+	TagFlatKeratometricAxisSequence                                   = Tag{0x0046, 0x0080} // SQ 1
+	TagBackgroundColor                                                = Tag{0x0046, 0x0092} // CS 1
+	TagOptotype                                                       = Tag{0x0046, 0x0094} // CS 1
+	TagOptotypePresentation                                           = Tag{0x0046, 0x0095} // CS 1
+	TagSubjectiveRefractionRightEyeSequence                           = Tag{0x0046, 0x0097} // SQ 1
+	TagSubjectiveRefractionLeftEyeSequence                            = Tag{0x0046, 0x0098} // SQ 1
+	TagAddNearSequence                                                = Tag{0x0046, 0x0100} // SQ 1
+	TagAddIntermediateSequence                                        = Tag{0x0046, 0x0101} // SQ 1
+	TagAddOtherSequence                                               = Tag{0x0046, 0x0102} // SQ 1
+	TagAddPower                                                       = Tag{0x0046, 0x0104} // FD 1
+	TagViewingDistance                                                = Tag{0x0046, 0x0106} // FD 1
+	TagVisualAcuityTypeCodeSequence                                   = Tag{0x0046, 0x0121} // SQ 1
+	TagVisualAcuityRightEyeSequence                                   = Tag{0x0046, 0x0122} // SQ 1
+	TagVisualAcuityLeftEyeSequence                                    = Tag{0x0046, 0x0123} // SQ 1
+	TagVisualAcuityBothEyesOpenSequence                               = Tag{0x0046, 0x0124} // SQ 1
+	TagViewingDistanceType                                            = Tag{0x0046, 0x0125} // CS 1
+	TagVisualAcuityModifiers                                          = Tag{0x0046, 0x0135} // SS 2
+	TagDecimalVisualAcuity                                            = Tag{0x0046, 0x0137} // FD 1
+	TagOptotypeDetailedDefinition                                     = Tag{0x0046, 0x0139} // LO 1
+	TagReferencedRefractiveMeasurementsSequence                       = Tag{0x0046, 0x0145} // SQ 1
+	TagSpherePower                                                    = Tag{0x0046, 0x0146} // FD 1
+	TagCylinderPower                                                  = Tag{0x0046, 0x0147} // FD 1
+	TagImagedVolumeWidth                                              = Tag{0x0048, 0x0001} // FL 1
+	TagImagedVolumeHeight                                             = Tag{0x0048, 0x0002} // FL 1
+	TagImagedVolumeDepth                                              = Tag{0x0048, 0x0003} // FL 1
+	TagTotalPixelMatrixColumns                                        = Tag{0x0048, 0x0006} // UL 1
+	TagTotalPixelMatrixRows                                           = Tag{0x0048, 0x0007} // UL 1
+	TagTotalPixelMatrixOriginSequence                                 = Tag{0x0048, 0x0008} // SQ 1
+	TagSpecimenLabelInImage                                           = Tag{0x0048, 0x0010} // CS 1
+	TagFocusMethod                                                    = Tag{0x0048, 0x0011} // CS 1
+	TagExtendedDepthOfField                                           = Tag{0x0048, 0x0012} // CS 1
+	TagNumberOfFocalPlanes                                            = Tag{0x0048, 0x0013} // US 1
+	TagDistanceBetweenFocalPlanes                                     = Tag{0x0048, 0x0014} // FL 1
+	TagRecommendedAbsentPixelCIELabValue                              = Tag{0x0048, 0x0015} // US 3
+	TagIlluminatorTypeCodeSequence                                    = Tag{0x0048, 0x0100} // SQ 1
+	TagImageOrientationSlide                                          = Tag{0x0048, 0x0102} // DS 6
+	TagOpticalPathSequence                                            = Tag{0x0048, 0x0105} // SQ 1
+	TagOpticalPathIdentifier                                          = Tag{0x0048, 0x0106} // SH 1
+	TagOpticalPathDescription                                         = Tag{0x0048, 0x0107} // ST 1
+	TagIlluminationColorCodeSequence                                  = Tag{0x0048, 0x0108} // SQ 1
+	TagSpecimenReferenceSequence                                      = Tag{0x0048, 0x0110} // SQ 1
+	TagCondenserLensPower                                             = Tag{0x0048, 0x0111} // DS 1
+	TagObjectiveLensPower                                             = Tag{0x0048, 0x0112} // DS 1
+	TagObjectiveLensNumericalAperture                                 = Tag{0x0048, 0x0113} // DS 1
+	TagPaletteColorLookupTableSequence                                = Tag{0x0048, 0x0120} // SQ 1
+	TagReferencedImageNavigationSequence                              = Tag{0x0048, 0x0200} // SQ 1
+	TagTopLeftHandCornerOfLocalizerArea                               = Tag{0x0048, 0x0201} // US 2
+	TagBottomRightHandCornerOfLocalizerArea                           = Tag{0x0048, 0x0202} // US 2
+	TagOpticalPathIdentificationSequence                              = Tag{0x0048, 0x0207} // SQ 1
+	TagPlanePositionSlideSequence                                     = Tag{0x0048, 0x021A} // SQ 1
+	TagColumnPositionInTotalImagePixelMatrix                          = Tag{0x0048, 0x021E} // SL 1
+	TagRowPositionInTotalImagePixelMatrix                             = Tag{0x0048, 0x021F} // SL 1
+	TagPixelOriginInterpretation                                      = Tag{0x0048, 0x0301} // CS 1
+	TagCalibrationImage                                               = Tag{0x0050, 0x0004} // CS 1
+	TagDeviceSequence                                                 = Tag{0x0050, 0x0010} // SQ 1
+	TagContainerComponentTypeCodeSequence                             = Tag{0x0050, 0x0012} // SQ 1
+	TagContainerComponentThickness                                    = Tag{0x0050, 0x0013} // FD 1
+	TagDeviceLength                                                   = Tag{0x0050, 0x0014} // DS 1
+	TagContainerComponentWidth                                        = Tag{0x0050, 0x0015} // FD 1
+	TagDeviceDiameter                                                 = Tag{0x0050, 0x0016} // DS 1
+	TagDeviceDiameterUnits                                            = Tag{0x0050, 0x0017} // CS 1
+	TagDeviceVolume                                                   = Tag{0x0050, 0x0018} // DS 1
+	TagInterMarkerDistance                                            = Tag{0x0050, 0x0019} // DS 1
+	TagContainerComponentMaterial                                     = Tag{0x0050, 0x001A} // CS 1
+	TagContainerComponentID                                           = Tag{0x0050, 0x001B} // LO 1
+	TagContainerComponentLength                                       = Tag{0x0050, 0x001C} // FD 1
+	TagContainerComponentDiameter                                     = Tag{0x0050, 0x001D} // FD 1
+	TagContainerComponentDescription                                  = Tag{0x0050, 0x001E} // LO 1
+	TagDeviceDescription                                              = Tag{0x0050, 0x0020} // LO 1
+	TagContrastBolusIngredientPercentByVolume                         = Tag{0x0052, 0x0001} // FL 1
+	TagOCTFocalDistance                                               = Tag{0x0052, 0x0002} // FD 1
+	TagBeamSpotSize                                                   = Tag{0x0052, 0x0003} // FD 1
+	TagEffectiveRefractiveIndex                                       = Tag{0x0052, 0x0004} // FD 1
+	TagOCTAcquisitionDomain                                           = Tag{0x0052, 0x0006} // CS 1
+	TagOCTOpticalCenterWavelength                                     = Tag{0x0052, 0x0007} // FD 1
+	TagAxialResolution                                                = Tag{0x0052, 0x0008} // FD 1
+	TagRangingDepth                                                   = Tag{0x0052, 0x0009} // FD 1
+	TagALineRate                                                      = Tag{0x0052, 0x0011} // FD 1
+	TagALinesPerFrame                                                 = Tag{0x0052, 0x0012} // US 1
+	TagCatheterRotationalRate                                         = Tag{0x0052, 0x0013} // FD 1
+	TagALinePixelSpacing                                              = Tag{0x0052, 0x0014} // FD 1
+	TagModeOfPercutaneousAccessSequence                               = Tag{0x0052, 0x0016} // SQ 1
+	TagIntravascularOCTFrameTypeSequence                              = Tag{0x0052, 0x0025} // SQ 1
+	TagOCTZOffsetApplied                                              = Tag{0x0052, 0x0026} // CS 1
+	TagIntravascularFrameContentSequence                              = Tag{0x0052, 0x0027} // SQ 1
+	TagIntravascularLongitudinalDistance                              = Tag{0x0052, 0x0028} // FD 1
+	TagIntravascularOCTFrameContentSequence                           = Tag{0x0052, 0x0029} // SQ 1
+	TagOCTZOffsetCorrection                                           = Tag{0x0052, 0x0030} // SS 1
+	TagCatheterDirectionOfRotation                                    = Tag{0x0052, 0x0031} // CS 1
+	TagSeamLineLocation                                               = Tag{0x0052, 0x0033} // FD 1
+	TagFirstALineLocation                                             = Tag{0x0052, 0x0034} // FD 1
+	TagSeamLineIndex                                                  = Tag{0x0052, 0x0036} // US 1
+	TagNumberOfPaddedAlines                                           = Tag{0x0052, 0x0038} // US 1
+	TagInterpolationType                                              = Tag{0x0052, 0x0039} // CS 1
+	TagRefractiveIndexApplied                                         = Tag{0x0052, 0x003A} // CS 1
+	TagEnergyWindowVector                                             = Tag{0x0054, 0x0010} // US 1-n
+	TagNumberOfEnergyWindows                                          = Tag{0x0054, 0x0011} // US 1
+	TagEnergyWindowInformationSequence                                = Tag{0x0054, 0x0012} // SQ 1
+	TagEnergyWindowRangeSequence                                      = Tag{0x0054, 0x0013} // SQ 1
+	TagEnergyWindowLowerLimit                                         = Tag{0x0054, 0x0014} // DS 1
+	TagEnergyWindowUpperLimit                                         = Tag{0x0054, 0x0015} // DS 1
+	TagRadiopharmaceuticalInformationSequence                         = Tag{0x0054, 0x0016} // SQ 1
+	TagResidualSyringeCounts                                          = Tag{0x0054, 0x0017} // IS 1
+	TagEnergyWindowName                                               = Tag{0x0054, 0x0018} // SH 1
+	TagDetectorVector                                                 = Tag{0x0054, 0x0020} // US 1-n
+	TagNumberOfDetectors                                              = Tag{0x0054, 0x0021} // US 1
+	TagDetectorInformationSequence                                    = Tag{0x0054, 0x0022} // SQ 1
+	TagPhaseVector                                                    = Tag{0x0054, 0x0030} // US 1-n
+	TagNumberOfPhases                                                 = Tag{0x0054, 0x0031} // US 1
+	TagPhaseInformationSequence                                       = Tag{0x0054, 0x0032} // SQ 1
+	TagNumberOfFramesInPhase                                          = Tag{0x0054, 0x0033} // US 1
+	TagPhaseDelay                                                     = Tag{0x0054, 0x0036} // IS 1
+	TagPauseBetweenFrames                                             = Tag{0x0054, 0x0038} // IS 1
+	TagPhaseDescription                                               = Tag{0x0054, 0x0039} // CS 1
+	TagRotationVector                                                 = Tag{0x0054, 0x0050} // US 1-n
+	TagNumberOfRotations                                              = Tag{0x0054, 0x0051} // US 1
+	TagRotationInformationSequence                                    = Tag{0x0054, 0x0052} // SQ 1
+	TagNumberOfFramesInRotation                                       = Tag{0x0054, 0x0053} // US 1
+	TagRRIntervalVector                                               = Tag{0x0054, 0x0060} // US 1-n
+	TagNumberOfRRIntervals                                            = Tag{0x0054, 0x0061} // US 1
+	TagGatedInformationSequence                                       = Tag{0x0054, 0x0062} // SQ 1
+	TagDataInformationSequence                                        = Tag{0x0054, 0x0063} // SQ 1
+	TagTimeSlotVector                                                 = Tag{0x0054, 0x0070} // US 1-n
+	TagNumberOfTimeSlots                                              = Tag{0x0054, 0x0071} // US 1
+	TagTimeSlotInformationSequence                                    = Tag{0x0054, 0x0072} // SQ 1
+	TagTimeSlotTime                                                   = Tag{0x0054, 0x0073} // DS 1
+	TagSliceVector                                                    = Tag{0x0054, 0x0080} // US 1-n
+	TagNumberOfSlices                                                 = Tag{0x0054, 0x0081} // US 1
+	TagAngularViewVector                                              = Tag{0x0054, 0x0090} // US 1-n
+	TagTimeSliceVector                                                = Tag{0x0054, 0x0100} // US 1-n
+	TagNumberOfTimeSlices                                             = Tag{0x0054, 0x0101} // US 1
+	TagStartAngle                                                     = Tag{0x0054, 0x0200} // DS 1
+	TagTypeOfDetectorMotion                                           = Tag{0x0054, 0x0202} // CS 1
+	TagTriggerVector                                                  = Tag{0x0054, 0x0210} // IS 1-n
+	TagNumberOfTriggersInPhase                                        = Tag{0x0054, 0x0211} // US 1
+	TagViewCodeSequence                                               = Tag{0x0054, 0x0220} // SQ 1
+	TagViewModifierCodeSequence                                       = Tag{0x0054, 0x0222} // SQ 1
+	TagRadionuclideCodeSequence                                       = Tag{0x0054, 0x0300} // SQ 1
+	TagAdministrationRouteCodeSequence                                = Tag{0x0054, 0x0302} // SQ 1
+	TagRadiopharmaceuticalCodeSequence                                = Tag{0x0054, 0x0304} // SQ 1
+	TagCalibrationDataSequence                                        = Tag{0x0054, 0x0306} // SQ 1
+	TagEnergyWindowNumber                                             = Tag{0x0054, 0x0308} // US 1
+	TagImageID                                                        = Tag{0x0054, 0x0400} // SH 1
+	TagPatientOrientationCodeSequence                                 = Tag{0x0054, 0x0410} // SQ 1
+	TagPatientOrientationModifierCodeSequence                         = Tag{0x0054, 0x0412} // SQ 1
+	TagPatientGantryRelationshipCodeSequence                          = Tag{0x0054, 0x0414} // SQ 1
+	TagSliceProgressionDirection                                      = Tag{0x0054, 0x0500} // CS 1
+	TagSeriesType                                                     = Tag{0x0054, 0x1000} // CS 2
+	TagUnits                                                          = Tag{0x0054, 0x1001} // CS 1
+	TagCountsSource                                                   = Tag{0x0054, 0x1002} // CS 1
+	TagReprojectionMethod                                             = Tag{0x0054, 0x1004} // CS 1
+	TagSUVType                                                        = Tag{0x0054, 0x1006} // CS 1
+	TagRandomsCorrectionMethod                                        = Tag{0x0054, 0x1100} // CS 1
+	TagAttenuationCorrectionMethod                                    = Tag{0x0054, 0x1101} // LO 1
+	TagDecayCorrection                                                = Tag{0x0054, 0x1102} // CS 1
+	TagReconstructionMethod                                           = Tag{0x0054, 0x1103} // LO 1
+	TagDetectorLinesOfResponseUsed                                    = Tag{0x0054, 0x1104} // LO 1
+	TagScatterCorrectionMethod                                        = Tag{0x0054, 0x1105} // LO 1
+	TagAxialAcceptance                                                = Tag{0x0054, 0x1200} // DS 1
+	TagAxialMash                                                      = Tag{0x0054, 0x1201} // IS 2
+	TagTransverseMash                                                 = Tag{0x0054, 0x1202} // IS 1
+	TagDetectorElementSize                                            = Tag{0x0054, 0x1203} // DS 2
+	TagCoincidenceWindowWidth                                         = Tag{0x0054, 0x1210} // DS 1
+	TagSecondaryCountsType                                            = Tag{0x0054, 0x1220} // CS 1-n
+	TagFrameReferenceTime                                             = Tag{0x0054, 0x1300} // DS 1
+	TagPrimaryPromptsCountsAccumulated                                = Tag{0x0054, 0x1310} // IS 1
+	TagSecondaryCountsAccumulated                                     = Tag{0x0054, 0x1311} // IS 1-n
+	TagSliceSensitivityFactor                                         = Tag{0x0054, 0x1320} // DS 1
+	TagDecayFactor                                                    = Tag{0x0054, 0x1321} // DS 1
+	TagDoseCalibrationFactor                                          = Tag{0x0054, 0x1322} // DS 1
+	TagScatterFractionFactor                                          = Tag{0x0054, 0x1323} // DS 1
+	TagDeadTimeFactor                                                 = Tag{0x0054, 0x1324} // DS 1
+	TagImageIndex                                                     = Tag{0x0054, 0x1330} // US 1
+	TagHistogramSequence                                              = Tag{0x0060, 0x3000} // SQ 1
+	TagHistogramNumberOfBins                                          = Tag{0x0060, 0x3002} // US 1
+	TagHistogramFirstBinValue                                         = Tag{0x0060, 0x3004} // XS 1
+	TagHistogramLastBinValue                                          = Tag{0x0060, 0x3006} // XS 1
+	TagHistogramBinWidth                                              = Tag{0x0060, 0x3008} // US 1
+	TagHistogramExplanation                                           = Tag{0x0060, 0x3010} // LO 1
+	TagHistogramData                                                  = Tag{0x0060, 0x3020} // UL 1-n
+	TagSegmentationType                                               = Tag{0x0062, 0x0001} // CS 1
+	TagSegmentSequence                                                = Tag{0x0062, 0x0002} // SQ 1
+	TagSegmentedPropertyCategoryCodeSequence                          = Tag{0x0062, 0x0003} // SQ 1
+	TagSegmentNumber                                                  = Tag{0x0062, 0x0004} // US 1
+	TagSegmentLabel                                                   = Tag{0x0062, 0x0005} // LO 1
+	TagSegmentDescription                                             = Tag{0x0062, 0x0006} // ST 1
+	TagSegmentAlgorithmType                                           = Tag{0x0062, 0x0008} // CS 1
+	TagSegmentAlgorithmName                                           = Tag{0x0062, 0x0009} // LO 1
+	TagSegmentIdentificationSequence                                  = Tag{0x0062, 0x000A} // SQ 1
+	TagReferencedSegmentNumber                                        = Tag{0x0062, 0x000B} // US 1-n
+	TagRecommendedDisplayGrayscaleValue                               = Tag{0x0062, 0x000C} // US 1
+	TagRecommendedDisplayCIELabValue                                  = Tag{0x0062, 0x000D} // US 3
+	TagMaximumFractionalValue                                         = Tag{0x0062, 0x000E} // US 1
+	TagSegmentedPropertyTypeCodeSequence                              = Tag{0x0062, 0x000F} // SQ 1
+	TagSegmentationFractionalType                                     = Tag{0x0062, 0x0010} // CS 1
+	TagDeformableRegistrationSequence                                 = Tag{0x0064, 0x0002} // SQ 1
+	TagSourceFrameOfReferenceUID                                      = Tag{0x0064, 0x0003} // UI 1
+	TagDeformableRegistrationGridSequence                             = Tag{0x0064, 0x0005} // SQ 1
+	TagGridDimensions                                                 = Tag{0x0064, 0x0007} // UL 3
+	TagGridResolution                                                 = Tag{0x0064, 0x0008} // FD 3
+	TagVectorGridData                                                 = Tag{0x0064, 0x0009} // OF 1
+	TagPreDeformationMatrixRegistrationSequence                       = Tag{0x0064, 0x000F} // SQ 1
+	TagPostDeformationMatrixRegistrationSequence                      = Tag{0x0064, 0x0010} // SQ 1
+	TagNumberOfSurfaces                                               = Tag{0x0066, 0x0001} // UL 1
+	TagSurfaceSequence                                                = Tag{0x0066, 0x0002} // SQ 1
+	TagSurfaceNumber                                                  = Tag{0x0066, 0x0003} // UL 1
+	TagSurfaceComments                                                = Tag{0x0066, 0x0004} // LT 1
+	TagSurfaceProcessing                                              = Tag{0x0066, 0x0009} // CS 1
+	TagSurfaceProcessingRatio                                         = Tag{0x0066, 0x000A} // FL 1
+	TagSurfaceProcessingDescription                                   = Tag{0x0066, 0x000B} // LO 1
+	TagRecommendedPresentationOpacity                                 = Tag{0x0066, 0x000C} // FL 1
+	TagRecommendedPresentationType                                    = Tag{0x0066, 0x000D} // CS 1
+	TagFiniteVolume                                                   = Tag{0x0066, 0x000E} // CS 1
+	TagManifold                                                       = Tag{0x0066, 0x0010} // CS 1
+	TagSurfacePointsSequence                                          = Tag{0x0066, 0x0011} // SQ 1
+	TagSurfacePointsNormalsSequence                                   = Tag{0x0066, 0x0012} // SQ 1
+	TagSurfaceMeshPrimitivesSequence                                  = Tag{0x0066, 0x0013} // SQ 1
+	TagNumberOfSurfacePoints                                          = Tag{0x0066, 0x0015} // UL 1
+	TagPointCoordinatesData                                           = Tag{0x0066, 0x0016} // OF 1
+	TagPointPositionAccuracy                                          = Tag{0x0066, 0x0017} // FL 3
+	TagMeanPointDistance                                              = Tag{0x0066, 0x0018} // FL 1
+	TagMaximumPointDistance                                           = Tag{0x0066, 0x0019} // FL 1
+	TagPointsBoundingBoxCoordinates                                   = Tag{0x0066, 0x001A} // FL 6
+	TagAxisOfRotation                                                 = Tag{0x0066, 0x001B} // FL 3
+	TagCenterOfRotation                                               = Tag{0x0066, 0x001C} // FL 3
+	TagNumberOfVectors                                                = Tag{0x0066, 0x001E} // UL 1
+	TagVectorDimensionality                                           = Tag{0x0066, 0x001F} // US 1
+	TagVectorAccuracy                                                 = Tag{0x0066, 0x0020} // FL 1-n
+	TagVectorCoordinateData                                           = Tag{0x0066, 0x0021} // OF 1
+	TagTrianglePointIndexList                                         = Tag{0x0066, 0x0023} // OW 1
+	TagEdgePointIndexList                                             = Tag{0x0066, 0x0024} // OW 1
+	TagVertexPointIndexList                                           = Tag{0x0066, 0x0025} // OW 1
+	TagTriangleStripSequence                                          = Tag{0x0066, 0x0026} // SQ 1
+	TagTriangleFanSequence                                            = Tag{0x0066, 0x0027} // SQ 1
+	TagLineSequence                                                   = Tag{0x0066, 0x0028} // SQ 1
+	TagPrimitivePointIndexList                                        = Tag{0x0066, 0x0029} // OW 1
+	TagSurfaceCount                                                   = Tag{0x0066, 0x002A} // UL 1
+	TagReferencedSurfaceSequence                                      = Tag{0x0066, 0x002B} // SQ 1
+	TagReferencedSurfaceNumber                                        = Tag{0x0066, 0x002C} // UL 1
+	TagSegmentSurfaceGenerationAlgorithmIdentificationSequence        = Tag{0x0066, 0x002D} // SQ 1
+	TagSegmentSurfaceSourceInstanceSequence                           = Tag{0x0066, 0x002E} // SQ 1
+	TagAlgorithmFamilyCodeSequence                                    = Tag{0x0066, 0x002F} // SQ 1
+	TagAlgorithmNameCodeSequence                                      = Tag{0x0066, 0x0030} // SQ 1
+	TagAlgorithmVersion                                               = Tag{0x0066, 0x0031} // LO 1
+	TagAlgorithmParameters                                            = Tag{0x0066, 0x0032} // LT 1
+	TagFacetSequence                                                  = Tag{0x0066, 0x0034} // SQ 1
+	TagSurfaceProcessingAlgorithmIdentificationSequence               = Tag{0x0066, 0x0035} // SQ 1
+	TagAlgorithmName                                                  = Tag{0x0066, 0x0036} // LO 1
+	TagImplantSize                                                    = Tag{0x0068, 0x6210} // LO 1
+	TagImplantTemplateVersion                                         = Tag{0x0068, 0x6221} // LO 1
+	TagReplacedImplantTemplateSequence                                = Tag{0x0068, 0x6222} // SQ 1
+	TagImplantType                                                    = Tag{0x0068, 0x6223} // CS 1
+	TagDerivationImplantTemplateSequence                              = Tag{0x0068, 0x6224} // SQ 1
+	TagOriginalImplantTemplateSequence                                = Tag{0x0068, 0x6225} // SQ 1
+	TagEffectiveDateTime                                              = Tag{0x0068, 0x6226} // DT 1
+	TagImplantTargetAnatomySequence                                   = Tag{0x0068, 0x6230} // SQ 1
+	TagInformationFromManufacturerSequence                            = Tag{0x0068, 0x6260} // SQ 1
+	TagNotificationFromManufacturerSequence                           = Tag{0x0068, 0x6265} // SQ 1
+	TagInformationIssueDateTime                                       = Tag{0x0068, 0x6270} // DT 1
+	TagInformationSummary                                             = Tag{0x0068, 0x6280} // ST 1
+	TagImplantRegulatoryDisapprovalCodeSequence                       = Tag{0x0068, 0x62A0} // SQ 1
+	TagOverallTemplateSpatialTolerance                                = Tag{0x0068, 0x62A5} // FD 1
+	TagHPGLDocumentSequence                                           = Tag{0x0068, 0x62C0} // SQ 1
+	TagHPGLDocumentID                                                 = Tag{0x0068, 0x62D0} // US 1
+	TagHPGLDocumentLabel                                              = Tag{0x0068, 0x62D5} // LO 1
+	TagViewOrientationCodeSequence                                    = Tag{0x0068, 0x62E0} // SQ 1
+	TagViewOrientationModifier                                        = Tag{0x0068, 0x62F0} // FD 9
+	TagHPGLDocumentScaling                                            = Tag{0x0068, 0x62F2} // FD 1
+	TagHPGLDocument                                                   = Tag{0x0068, 0x6300} // OB 1
+	TagHPGLContourPenNumber                                           = Tag{0x0068, 0x6310} // US 1
+	TagHPGLPenSequence                                                = Tag{0x0068, 0x6320} // SQ 1
+	TagHPGLPenNumber                                                  = Tag{0x0068, 0x6330} // US 1
+	TagHPGLPenLabel                                                   = Tag{0x0068, 0x6340} // LO 1
+	TagHPGLPenDescription                                             = Tag{0x0068, 0x6345} // ST 1
+	TagRecommendedRotationPoint                                       = Tag{0x0068, 0x6346} // FD 2
+	TagBoundingRectangle                                              = Tag{0x0068, 0x6347} // FD 4
+	TagImplantTemplate3DModelSurfaceNumber                            = Tag{0x0068, 0x6350} // US 1-n
+	TagSurfaceModelDescriptionSequence                                = Tag{0x0068, 0x6360} // SQ 1
+	TagSurfaceModelLabel                                              = Tag{0x0068, 0x6380} // LO 1
+	TagSurfaceModelScalingFactor                                      = Tag{0x0068, 0x6390} // FD 1
+	TagMaterialsCodeSequence                                          = Tag{0x0068, 0x63A0} // SQ 1
+	TagCoatingMaterialsCodeSequence                                   = Tag{0x0068, 0x63A4} // SQ 1
+	TagImplantTypeCodeSequence                                        = Tag{0x0068, 0x63A8} // SQ 1
+	TagFixationMethodCodeSequence                                     = Tag{0x0068, 0x63AC} // SQ 1
+	TagMatingFeatureSetsSequence                                      = Tag{0x0068, 0x63B0} // SQ 1
+	TagMatingFeatureSetID                                             = Tag{0x0068, 0x63C0} // US 1
+	TagMatingFeatureSetLabel                                          = Tag{0x0068, 0x63D0} // LO 1
+	TagMatingFeatureSequence                                          = Tag{0x0068, 0x63E0} // SQ 1
+	TagMatingFeatureID                                                = Tag{0x0068, 0x63F0} // US 1
+	TagMatingFeatureDegreeOfFreedomSequence                           = Tag{0x0068, 0x6400} // SQ 1
+	TagDegreeOfFreedomID                                              = Tag{0x0068, 0x6410} // US 1
+	TagDegreeOfFreedomType                                            = Tag{0x0068, 0x6420} // CS 1
+	TagTwoDMatingFeatureCoordinatesSequence                           = Tag{0x0068, 0x6430} // SQ 1
+	TagReferencedHPGLDocumentID                                       = Tag{0x0068, 0x6440} // US 1
+	TagTwoDMatingPoint                                                = Tag{0x0068, 0x6450} // FD 2
+	TagTwoDMatingAxes                                                 = Tag{0x0068, 0x6460} // FD 4
+	TagTwoDDegreeOfFreedomSequence                                    = Tag{0x0068, 0x6470} // SQ 1
+	TagThreeDDegreeOfFreedomAxis                                      = Tag{0x0068, 0x6490} // FD 3
+	TagRangeOfFreedom                                                 = Tag{0x0068, 0x64A0} // FD 2
+	TagThreeDMatingPoint                                              = Tag{0x0068, 0x64C0} // FD 3
+	TagThreeDMatingAxes                                               = Tag{0x0068, 0x64D0} // FD 9
+	TagTwoDDegreeOfFreedomAxis                                        = Tag{0x0068, 0x64F0} // FD 3
+	TagPlanningLandmarkPointSequence                                  = Tag{0x0068, 0x6500} // SQ 1
+	TagPlanningLandmarkLineSequence                                   = Tag{0x0068, 0x6510} // SQ 1
+	TagPlanningLandmarkPlaneSequence                                  = Tag{0x0068, 0x6520} // SQ 1
+	TagPlanningLandmarkID                                             = Tag{0x0068, 0x6530} // US 1
+	TagPlanningLandmarkDescription                                    = Tag{0x0068, 0x6540} // LO 1
+	TagPlanningLandmarkIdentificationCodeSequence                     = Tag{0x0068, 0x6545} // SQ 1
+	TagTwoDPointCoordinatesSequence                                   = Tag{0x0068, 0x6550} // SQ 1
+	TagTwoDPointCoordinates                                           = Tag{0x0068, 0x6560} // FD 2
+	TagThreeDPointCoordinates                                         = Tag{0x0068, 0x6590} // FD 3
+	TagTwoDLineCoordinatesSequence                                    = Tag{0x0068, 0x65A0} // SQ 1
+	TagTwoDLineCoordinates                                            = Tag{0x0068, 0x65B0} // FD 4
+	TagThreeDLineCoordinates                                          = Tag{0x0068, 0x65D0} // FD 6
+	TagTwoDPlaneCoordinatesSequence                                   = Tag{0x0068, 0x65E0} // SQ 1
+	TagTwoDPlaneIntersection                                          = Tag{0x0068, 0x65F0} // FD 4
+	TagThreeDPlaneOrigin                                              = Tag{0x0068, 0x6610} // FD 3
+	TagThreeDPlaneNormal                                              = Tag{0x0068, 0x6620} // FD 3
+	TagGraphicAnnotationSequence                                      = Tag{0x0070, 0x0001} // SQ 1
+	TagGraphicLayer                                                   = Tag{0x0070, 0x0002} // CS 1
+	TagBoundingBoxAnnotationUnits                                     = Tag{0x0070, 0x0003} // CS 1
+	TagAnchorPointAnnotationUnits                                     = Tag{0x0070, 0x0004} // CS 1
+	TagGraphicAnnotationUnits                                         = Tag{0x0070, 0x0005} // CS 1
+	TagUnformattedTextValue                                           = Tag{0x0070, 0x0006} // ST 1
+	TagTextObjectSequence                                             = Tag{0x0070, 0x0008} // SQ 1
+	TagGraphicObjectSequence                                          = Tag{0x0070, 0x0009} // SQ 1
+	TagBoundingBoxTopLeftHandCorner                                   = Tag{0x0070, 0x0010} // FL 2
+	TagBoundingBoxBottomRightHandCorner                               = Tag{0x0070, 0x0011} // FL 2
+	TagBoundingBoxTextHorizontalJustification                         = Tag{0x0070, 0x0012} // CS 1
+	TagAnchorPoint                                                    = Tag{0x0070, 0x0014} // FL 2
+	TagAnchorPointVisibility                                          = Tag{0x0070, 0x0015} // CS 1
+	TagGraphicDimensions                                              = Tag{0x0070, 0x0020} // US 1
+	TagNumberOfGraphicPoints                                          = Tag{0x0070, 0x0021} // US 1
+	TagGraphicData                                                    = Tag{0x0070, 0x0022} // FL 2-n
+	TagGraphicType                                                    = Tag{0x0070, 0x0023} // CS 1
+	TagGraphicFilled                                                  = Tag{0x0070, 0x0024} // CS 1
+	TagImageHorizontalFlip                                            = Tag{0x0070, 0x0041} // CS 1
+	TagImageRotation                                                  = Tag{0x0070, 0x0042} // US 1
+	TagDisplayedAreaTopLeftHandCorner                                 = Tag{0x0070, 0x0052} // SL 2
+	TagDisplayedAreaBottomRightHandCorner                             = Tag{0x0070, 0x0053} // SL 2
+	TagDisplayedAreaSelectionSequence                                 = Tag{0x0070, 0x005A} // SQ 1
+	TagGraphicLayerSequence                                           = Tag{0x0070, 0x0060} // SQ 1
+	TagGraphicLayerOrder                                              = Tag{0x0070, 0x0062} // IS 1
+	TagGraphicLayerRecommendedDisplayGrayscaleValue                   = Tag{0x0070, 0x0066} // US 1
+	TagGraphicLayerDescription                                        = Tag{0x0070, 0x0068} // LO 1
+	TagContentLabel                                                   = Tag{0x0070, 0x0080} // CS 1
+	TagContentDescription                                             = Tag{0x0070, 0x0081} // LO 1
+	TagPresentationCreationDate                                       = Tag{0x0070, 0x0082} // DA 1
+	TagPresentationCreationTime                                       = Tag{0x0070, 0x0083} // TM 1
+	TagContentCreatorName                                             = Tag{0x0070, 0x0084} // PN 1
+	TagContentCreatorIdentificationCodeSequence                       = Tag{0x0070, 0x0086} // SQ 1
+	TagAlternateContentDescriptionSequence                            = Tag{0x0070, 0x0087} // SQ 1
+	TagPresentationSizeMode                                           = Tag{0x0070, 0x0100} // CS 1
+	TagPresentationPixelSpacing                                       = Tag{0x0070, 0x0101} // DS 2
+	TagPresentationPixelAspectRatio                                   = Tag{0x0070, 0x0102} // IS 2
+	TagPresentationPixelMagnificationRatio                            = Tag{0x0070, 0x0103} // FL 1
+	TagGraphicGroupLabel                                              = Tag{0x0070, 0x0207} // LO 1
+	TagGraphicGroupDescription                                        = Tag{0x0070, 0x0208} // ST 1
+	TagCompoundGraphicSequence                                        = Tag{0x0070, 0x0209} // SQ 1
+	TagCompoundGraphicInstanceID                                      = Tag{0x0070, 0x0226} // UL 1
+	TagFontName                                                       = Tag{0x0070, 0x0227} // LO 1
+	TagFontNameType                                                   = Tag{0x0070, 0x0228} // CS 1
+	TagCSSFontName                                                    = Tag{0x0070, 0x0229} // LO 1
+	TagRotationAngle                                                  = Tag{0x0070, 0x0230} // FD 1
+	TagTextStyleSequence                                              = Tag{0x0070, 0x0231} // SQ 1
+	TagLineStyleSequence                                              = Tag{0x0070, 0x0232} // SQ 1
+	TagFillStyleSequence                                              = Tag{0x0070, 0x0233} // SQ 1
+	TagGraphicGroupSequence                                           = Tag{0x0070, 0x0234} // SQ 1
+	TagTextColorCIELabValue                                           = Tag{0x0070, 0x0241} // US 3
+	TagHorizontalAlignment                                            = Tag{0x0070, 0x0242} // CS 1
+	TagVerticalAlignment                                              = Tag{0x0070, 0x0243} // CS 1
+	TagShadowStyle                                                    = Tag{0x0070, 0x0244} // CS 1
+	TagShadowOffsetX                                                  = Tag{0x0070, 0x0245} // FL 1
+	TagShadowOffsetY                                                  = Tag{0x0070, 0x0246} // FL 1
+	TagShadowColorCIELabValue                                         = Tag{0x0070, 0x0247} // US 3
+	TagUnderlined                                                     = Tag{0x0070, 0x0248} // CS 1
+	TagBold                                                           = Tag{0x0070, 0x0249} // CS 1
+	TagItalic                                                         = Tag{0x0070, 0x0250} // CS 1
+	TagPatternOnColorCIELabValue                                      = Tag{0x0070, 0x0251} // US 3
+	TagPatternOffColorCIELabValue                                     = Tag{0x0070, 0x0252} // US 3
+	TagLineThickness                                                  = Tag{0x0070, 0x0253} // FL 1
+	TagLineDashingStyle                                               = Tag{0x0070, 0x0254} // CS 1
+	TagLinePattern                                                    = Tag{0x0070, 0x0255} // UL 1
+	TagFillPattern                                                    = Tag{0x0070, 0x0256} // OB 1
+	TagFillMode                                                       = Tag{0x0070, 0x0257} // CS 1
+	TagShadowOpacity                                                  = Tag{0x0070, 0x0258} // FL 1
+	TagGapLength                                                      = Tag{0x0070, 0x0261} // FL 1
+	TagDiameterOfVisibility                                           = Tag{0x0070, 0x0262} // FL 1
+	TagRotationPoint                                                  = Tag{0x0070, 0x0273} // FL 2
+	TagTickAlignment                                                  = Tag{0x0070, 0x0274} // CS 1
+	TagShowTickLabel                                                  = Tag{0x0070, 0x0278} // CS 1
+	TagTickLabelAlignment                                             = Tag{0x0070, 0x0279} // CS 1
+	TagCompoundGraphicUnits                                           = Tag{0x0070, 0x0282} // CS 1
+	TagPatternOnOpacity                                               = Tag{0x0070, 0x0284} // FL 1
+	TagPatternOffOpacity                                              = Tag{0x0070, 0x0285} // FL 1
+	TagMajorTicksSequence                                             = Tag{0x0070, 0x0287} // SQ 1
+	TagTickPosition                                                   = Tag{0x0070, 0x0288} // FL 1
+	TagTickLabel                                                      = Tag{0x0070, 0x0289} // SH 1
+	TagCompoundGraphicType                                            = Tag{0x0070, 0x0294} // CS 1
+	TagGraphicGroupID                                                 = Tag{0x0070, 0x0295} // UL 1
+	TagShapeType                                                      = Tag{0x0070, 0x0306} // CS 1
+	TagRegistrationSequence                                           = Tag{0x0070, 0x0308} // SQ 1
+	TagMatrixRegistrationSequence                                     = Tag{0x0070, 0x0309} // SQ 1
+	TagMatrixSequence                                                 = Tag{0x0070, 0x030A} // SQ 1
+	TagFrameOfReferenceTransformationMatrixType                       = Tag{0x0070, 0x030C} // CS 1
+	TagRegistrationTypeCodeSequence                                   = Tag{0x0070, 0x030D} // SQ 1
+	TagFiducialDescription                                            = Tag{0x0070, 0x030F} // ST 1
+	TagFiducialIdentifier                                             = Tag{0x0070, 0x0310} // SH 1
+	TagFiducialIdentifierCodeSequence                                 = Tag{0x0070, 0x0311} // SQ 1
+	TagContourUncertaintyRadius                                       = Tag{0x0070, 0x0312} // FD 1
+	TagUsedFiducialsSequence                                          = Tag{0x0070, 0x0314} // SQ 1
+	TagGraphicCoordinatesDataSequence                                 = Tag{0x0070, 0x0318} // SQ 1
+	TagFiducialUID                                                    = Tag{0x0070, 0x031A} // UI 1
+	TagFiducialSetSequence                                            = Tag{0x0070, 0x031C} // SQ 1
+	TagFiducialSequence                                               = Tag{0x0070, 0x031E} // SQ 1
+	TagGraphicLayerRecommendedDisplayCIELabValue                      = Tag{0x0070, 0x0401} // US 3
+	TagBlendingSequence                                               = Tag{0x0070, 0x0402} // SQ 1
+	TagRelativeOpacity                                                = Tag{0x0070, 0x0403} // FL 1
+	TagReferencedSpatialRegistrationSequence                          = Tag{0x0070, 0x0404} // SQ 1
+	TagBlendingPosition                                               = Tag{0x0070, 0x0405} // CS 1
+	TagHangingProtocolName                                            = Tag{0x0072, 0x0002} // SH 1
+	TagHangingProtocolDescription                                     = Tag{0x0072, 0x0004} // LO 1
+	TagHangingProtocolLevel                                           = Tag{0x0072, 0x0006} // CS 1
+	TagHangingProtocolCreator                                         = Tag{0x0072, 0x0008} // LO 1
+	TagHangingProtocolCreationDateTime                                = Tag{0x0072, 0x000A} // DT 1
+	TagHangingProtocolDefinitionSequence                              = Tag{0x0072, 0x000C} // SQ 1
+	TagHangingProtocolUserIdentificationCodeSequence                  = Tag{0x0072, 0x000E} // SQ 1
+	TagHangingProtocolUserGroupName                                   = Tag{0x0072, 0x0010} // LO 1
+	TagSourceHangingProtocolSequence                                  = Tag{0x0072, 0x0012} // SQ 1
+	TagNumberOfPriorsReferenced                                       = Tag{0x0072, 0x0014} // US 1
+	TagImageSetsSequence                                              = Tag{0x0072, 0x0020} // SQ 1
+	TagImageSetSelectorSequence                                       = Tag{0x0072, 0x0022} // SQ 1
+	TagImageSetSelectorUsageFlag                                      = Tag{0x0072, 0x0024} // CS 1
+	TagSelectorAttribute                                              = Tag{0x0072, 0x0026} // AT 1
+	TagSelectorValueNumber                                            = Tag{0x0072, 0x0028} // US 1
+	TagTimeBasedImageSetsSequence                                     = Tag{0x0072, 0x0030} // SQ 1
+	TagImageSetNumber                                                 = Tag{0x0072, 0x0032} // US 1
+	TagImageSetSelectorCategory                                       = Tag{0x0072, 0x0034} // CS 1
+	TagRelativeTime                                                   = Tag{0x0072, 0x0038} // US 2
+	TagRelativeTimeUnits                                              = Tag{0x0072, 0x003A} // CS 1
+	TagAbstractPriorValue                                             = Tag{0x0072, 0x003C} // SS 2
+	TagAbstractPriorCodeSequence                                      = Tag{0x0072, 0x003E} // SQ 1
+	TagImageSetLabel                                                  = Tag{0x0072, 0x0040} // LO 1
+	TagSelectorAttributeVR                                            = Tag{0x0072, 0x0050} // CS 1
+	TagSelectorSequencePointer                                        = Tag{0x0072, 0x0052} // AT 1-n
+	TagSelectorSequencePointerPrivateCreator                          = Tag{0x0072, 0x0054} // LO 1-n
+	TagSelectorAttributePrivateCreator                                = Tag{0x0072, 0x0056} // LO 1
+	TagSelectorATValue                                                = Tag{0x0072, 0x0060} // AT 1-n
+	TagSelectorCSValue                                                = Tag{0x0072, 0x0062} // CS 1-n
+	TagSelectorISValue                                                = Tag{0x0072, 0x0064} // IS 1-n
+	TagSelectorLOValue                                                = Tag{0x0072, 0x0066} // LO 1-n
+	TagSelectorLTValue                                                = Tag{0x0072, 0x0068} // LT 1
+	TagSelectorPNValue                                                = Tag{0x0072, 0x006A} // PN 1-n
+	TagSelectorSHValue                                                = Tag{0x0072, 0x006C} // SH 1-n
+	TagSelectorSTValue                                                = Tag{0x0072, 0x006E} // ST 1
+	TagSelectorUTValue                                                = Tag{0x0072, 0x0070} // UT 1
+	TagSelectorDSValue                                                = Tag{0x0072, 0x0072} // DS 1-n
+	TagSelectorFDValue                                                = Tag{0x0072, 0x0074} // FD 1-n
+	TagSelectorFLValue                                                = Tag{0x0072, 0x0076} // FL 1-n
+	TagSelectorULValue                                                = Tag{0x0072, 0x0078} // UL 1-n
+	TagSelectorUSValue                                                = Tag{0x0072, 0x007A} // US 1-n
+	TagSelectorSLValue                                                = Tag{0x0072, 0x007C} // SL 1-n
+	TagSelectorSSValue                                                = Tag{0x0072, 0x007E} // SS 1-n
+	TagSelectorCodeSequenceValue                                      = Tag{0x0072, 0x0080} // SQ 1
+	TagNumberOfScreens                                                = Tag{0x0072, 0x0100} // US 1
+	TagNominalScreenDefinitionSequence                                = Tag{0x0072, 0x0102} // SQ 1
+	TagNumberOfVerticalPixels                                         = Tag{0x0072, 0x0104} // US 1
+	TagNumberOfHorizontalPixels                                       = Tag{0x0072, 0x0106} // US 1
+	TagDisplayEnvironmentSpatialPosition                              = Tag{0x0072, 0x0108} // FD 4
+	TagScreenMinimumGrayscaleBitDepth                                 = Tag{0x0072, 0x010A} // US 1
+	TagScreenMinimumColorBitDepth                                     = Tag{0x0072, 0x010C} // US 1
+	TagApplicationMaximumRepaintTime                                  = Tag{0x0072, 0x010E} // US 1
+	TagDisplaySetsSequence                                            = Tag{0x0072, 0x0200} // SQ 1
+	TagDisplaySetNumber                                               = Tag{0x0072, 0x0202} // US 1
+	TagDisplaySetLabel                                                = Tag{0x0072, 0x0203} // LO 1
+	TagDisplaySetPresentationGroup                                    = Tag{0x0072, 0x0204} // US 1
+	TagDisplaySetPresentationGroupDescription                         = Tag{0x0072, 0x0206} // LO 1
+	TagPartialDataDisplayHandling                                     = Tag{0x0072, 0x0208} // CS 1
+	TagSynchronizedScrollingSequence                                  = Tag{0x0072, 0x0210} // SQ 1
+	TagDisplaySetScrollingGroup                                       = Tag{0x0072, 0x0212} // US 2-n
+	TagNavigationIndicatorSequence                                    = Tag{0x0072, 0x0214} // SQ 1
+	TagNavigationDisplaySet                                           = Tag{0x0072, 0x0216} // US 1
+	TagReferenceDisplaySets                                           = Tag{0x0072, 0x0218} // US 1-n
+	TagImageBoxesSequence                                             = Tag{0x0072, 0x0300} // SQ 1
+	TagImageBoxNumber                                                 = Tag{0x0072, 0x0302} // US 1
+	TagImageBoxLayoutType                                             = Tag{0x0072, 0x0304} // CS 1
+	TagImageBoxTileHorizontalDimension                                = Tag{0x0072, 0x0306} // US 1
+	TagImageBoxTileVerticalDimension                                  = Tag{0x0072, 0x0308} // US 1
+	TagImageBoxScrollDirection                                        = Tag{0x0072, 0x0310} // CS 1
+	TagImageBoxSmallScrollType                                        = Tag{0x0072, 0x0312} // CS 1
+	TagImageBoxSmallScrollAmount                                      = Tag{0x0072, 0x0314} // US 1
+	TagImageBoxLargeScrollType                                        = Tag{0x0072, 0x0316} // CS 1
+	TagImageBoxLargeScrollAmount                                      = Tag{0x0072, 0x0318} // US 1
+	TagImageBoxOverlapPriority                                        = Tag{0x0072, 0x0320} // US 1
+	TagCineRelativeToRealTime                                         = Tag{0x0072, 0x0330} // FD 1
+	TagFilterOperationsSequence                                       = Tag{0x0072, 0x0400} // SQ 1
+	TagFilterByCategory                                               = Tag{0x0072, 0x0402} // CS 1
+	TagFilterByAttributePresence                                      = Tag{0x0072, 0x0404} // CS 1
+	TagFilterByOperator                                               = Tag{0x0072, 0x0406} // CS 1
+	TagStructuredDisplayBackgroundCIELabValue                         = Tag{0x0072, 0x0420} // US 3
+	TagEmptyImageBoxCIELabValue                                       = Tag{0x0072, 0x0421} // US 3
+	TagStructuredDisplayImageBoxSequence                              = Tag{0x0072, 0x0422} // SQ 1
+	TagStructuredDisplayTextBoxSequence                               = Tag{0x0072, 0x0424} // SQ 1
+	TagReferencedFirstFrameSequence                                   = Tag{0x0072, 0x0427} // SQ 1
+	TagImageBoxSynchronizationSequence                                = Tag{0x0072, 0x0430} // SQ 1
+	TagSynchronizedImageBoxList                                       = Tag{0x0072, 0x0432} // US 2-n
+	TagTypeOfSynchronization                                          = Tag{0x0072, 0x0434} // CS 1
+	TagBlendingOperationType                                          = Tag{0x0072, 0x0500} // CS 1
+	TagReformattingOperationType                                      = Tag{0x0072, 0x0510} // CS 1
+	TagReformattingThickness                                          = Tag{0x0072, 0x0512} // FD 1
+	TagReformattingInterval                                           = Tag{0x0072, 0x0514} // FD 1
+	TagReformattingOperationInitialViewDirection                      = Tag{0x0072, 0x0516} // CS 1
+	TagThreeDRenderingType                                            = Tag{0x0072, 0x0520} // CS 1-n
+	TagSortingOperationsSequence                                      = Tag{0x0072, 0x0600} // SQ 1
+	TagSortByCategory                                                 = Tag{0x0072, 0x0602} // CS 1
+	TagSortingDirection                                               = Tag{0x0072, 0x0604} // CS 1
+	TagDisplaySetPatientOrientation                                   = Tag{0x0072, 0x0700} // CS 2
+	TagVOIType                                                        = Tag{0x0072, 0x0702} // CS 1
+	TagPseudoColorType                                                = Tag{0x0072, 0x0704} // CS 1
+	TagPseudoColorPaletteInstanceReferenceSequence                    = Tag{0x0072, 0x0705} // SQ 1
+	TagShowGrayscaleInverted                                          = Tag{0x0072, 0x0706} // CS 1
+	TagShowImageTrueSizeFlag                                          = Tag{0x0072, 0x0710} // CS 1
+	TagShowGraphicAnnotationFlag                                      = Tag{0x0072, 0x0712} // CS 1
+	TagShowPatientDemographicsFlag                                    = Tag{0x0072, 0x0714} // CS 1
+	TagShowAcquisitionTechniquesFlag                                  = Tag{0x0072, 0x0716} // CS 1
+	TagDisplaySetHorizontalJustification                              = Tag{0x0072, 0x0717} // CS 1
+	TagDisplaySetVerticalJustification                                = Tag{0x0072, 0x0718} // CS 1
+	TagContinuationStartMeterset                                      = Tag{0x0074, 0x0120} // FD 1
+	TagContinuationEndMeterset                                        = Tag{0x0074, 0x0121} // FD 1
+	TagProcedureStepState                                             = Tag{0x0074, 0x1000} // CS 1
+	TagProcedureStepProgressInformationSequence                       = Tag{0x0074, 0x1002} // SQ 1
+	TagProcedureStepProgress                                          = Tag{0x0074, 0x1004} // DS 1
+	TagProcedureStepProgressDescription                               = Tag{0x0074, 0x1006} // ST 1
+	TagProcedureStepCommunicationsURISequence                         = Tag{0x0074, 0x1008} // SQ 1
+	TagContactURI                                                     = Tag{0x0074, 0x100A} // ST 1
+	TagContactDisplayName                                             = Tag{0x0074, 0x100C} // LO 1
+	TagProcedureStepDiscontinuationReasonCodeSequence                 = Tag{0x0074, 0x100E} // SQ 1
+	TagBeamTaskSequence                                               = Tag{0x0074, 0x1020} // SQ 1
+	TagBeamTaskType                                                   = Tag{0x0074, 0x1022} // CS 1
+	TagTableTopVerticalAdjustedPosition                               = Tag{0x0074, 0x1026} // FD 1
+	TagTableTopLongitudinalAdjustedPosition                           = Tag{0x0074, 0x1027} // FD 1
+	TagTableTopLateralAdjustedPosition                                = Tag{0x0074, 0x1028} // FD 1
+	TagPatientSupportAdjustedAngle                                    = Tag{0x0074, 0x102A} // FD 1
+	TagTableTopEccentricAdjustedAngle                                 = Tag{0x0074, 0x102B} // FD 1
+	TagTableTopPitchAdjustedAngle                                     = Tag{0x0074, 0x102C} // FD 1
+	TagTableTopRollAdjustedAngle                                      = Tag{0x0074, 0x102D} // FD 1
+	TagDeliveryVerificationImageSequence                              = Tag{0x0074, 0x1030} // SQ 1
+	TagVerificationImageTiming                                        = Tag{0x0074, 0x1032} // CS 1
+	TagDoubleExposureFlag                                             = Tag{0x0074, 0x1034} // CS 1
+	TagDoubleExposureOrdering                                         = Tag{0x0074, 0x1036} // CS 1
+	TagRelatedReferenceRTImageSequence                                = Tag{0x0074, 0x1040} // SQ 1
+	TagGeneralMachineVerificationSequence                             = Tag{0x0074, 0x1042} // SQ 1
+	TagConventionalMachineVerificationSequence                        = Tag{0x0074, 0x1044} // SQ 1
+	TagIonMachineVerificationSequence                                 = Tag{0x0074, 0x1046} // SQ 1
+	TagFailedAttributesSequence                                       = Tag{0x0074, 0x1048} // SQ 1
+	TagOverriddenAttributesSequence                                   = Tag{0x0074, 0x104A} // SQ 1
+	TagConventionalControlPointVerificationSequence                   = Tag{0x0074, 0x104C} // SQ 1
+	TagIonControlPointVerificationSequence                            = Tag{0x0074, 0x104E} // SQ 1
+	TagAttributeOccurrenceSequence                                    = Tag{0x0074, 0x1050} // SQ 1
+	TagAttributeOccurrencePointer                                     = Tag{0x0074, 0x1052} // AT 1
+	TagAttributeItemSelector                                          = Tag{0x0074, 0x1054} // UL 1
+	TagAttributeOccurrencePrivateCreator                              = Tag{0x0074, 0x1056} // LO 1
+	TagSelectorSequencePointerItems                                   = Tag{0x0074, 0x1057} // IS 1-n
+	TagScheduledProcedureStepPriority                                 = Tag{0x0074, 0x1200} // CS 1
+	TagWorklistLabel                                                  = Tag{0x0074, 0x1202} // LO 1
+	TagProcedureStepLabel                                             = Tag{0x0074, 0x1204} // LO 1
+	TagScheduledProcessingParametersSequence                          = Tag{0x0074, 0x1210} // SQ 1
+	TagPerformedProcessingParametersSequence                          = Tag{0x0074, 0x1212} // SQ 1
+	TagUnifiedProcedureStepPerformedProcedureSequence                 = Tag{0x0074, 0x1216} // SQ 1
+	TagReplacedProcedureStepSequence                                  = Tag{0x0074, 0x1224} // SQ 1
+	TagDeletionLock                                                   = Tag{0x0074, 0x1230} // LO 1
+	TagReceivingAE                                                    = Tag{0x0074, 0x1234} // AE 1
+	TagRequestingAE                                                   = Tag{0x0074, 0x1236} // AE 1
+	TagReasonForCancellation                                          = Tag{0x0074, 0x1238} // LT 1
+	TagSCPStatus                                                      = Tag{0x0074, 0x1242} // CS 1
+	TagSubscriptionListStatus                                         = Tag{0x0074, 0x1244} // CS 1
+	TagUnifiedProcedureStepListStatus                                 = Tag{0x0074, 0x1246} // CS 1
+	TagBeamOrderIndex                                                 = Tag{0x0074, 0x1324} // UL 1
+	TagDoubleExposureMeterset                                         = Tag{0x0074, 0x1338} // FD 1
+	TagDoubleExposureFieldDelta                                       = Tag{0x0074, 0x133A} // FD 4
+	TagImplantAssemblyTemplateName                                    = Tag{0x0076, 0x0001} // LO 1
+	TagImplantAssemblyTemplateIssuer                                  = Tag{0x0076, 0x0003} // LO 1
+	TagImplantAssemblyTemplateVersion                                 = Tag{0x0076, 0x0006} // LO 1
+	TagReplacedImplantAssemblyTemplateSequence                        = Tag{0x0076, 0x0008} // SQ 1
+	TagImplantAssemblyTemplateType                                    = Tag{0x0076, 0x000A} // CS 1
+	TagOriginalImplantAssemblyTemplateSequence                        = Tag{0x0076, 0x000C} // SQ 1
+	TagDerivationImplantAssemblyTemplateSequence                      = Tag{0x0076, 0x000E} // SQ 1
+	TagImplantAssemblyTemplateTargetAnatomySequence                   = Tag{0x0076, 0x0010} // SQ 1
+	TagProcedureTypeCodeSequence                                      = Tag{0x0076, 0x0020} // SQ 1
+	TagSurgicalTechnique                                              = Tag{0x0076, 0x0030} // LO 1
+	TagComponentTypesSequence                                         = Tag{0x0076, 0x0032} // SQ 1
+	TagComponentTypeCodeSequence                                      = Tag{0x0076, 0x0034} // CS 1
+	TagExclusiveComponentType                                         = Tag{0x0076, 0x0036} // CS 1
+	TagMandatoryComponentType                                         = Tag{0x0076, 0x0038} // CS 1
+	TagComponentSequence                                              = Tag{0x0076, 0x0040} // SQ 1
+	TagComponentID                                                    = Tag{0x0076, 0x0055} // US 1
+	TagComponentAssemblySequence                                      = Tag{0x0076, 0x0060} // SQ 1
+	TagComponent1ReferencedID                                         = Tag{0x0076, 0x0070} // US 1
+	TagComponent1ReferencedMatingFeatureSetID                         = Tag{0x0076, 0x0080} // US 1
+	TagComponent1ReferencedMatingFeatureID                            = Tag{0x0076, 0x0090} // US 1
+	TagComponent2ReferencedID                                         = Tag{0x0076, 0x00A0} // US 1
+	TagComponent2ReferencedMatingFeatureSetID                         = Tag{0x0076, 0x00B0} // US 1
+	TagComponent2ReferencedMatingFeatureID                            = Tag{0x0076, 0x00C0} // US 1
+	TagImplantTemplateGroupName                                       = Tag{0x0078, 0x0001} // LO 1
+	TagImplantTemplateGroupDescription                                = Tag{0x0078, 0x0010} // ST 1
+	TagImplantTemplateGroupIssuer                                     = Tag{0x0078, 0x0020} // LO 1
+	TagImplantTemplateGroupVersion                                    = Tag{0x0078, 0x0024} // LO 1
+	TagReplacedImplantTemplateGroupSequence                           = Tag{0x0078, 0x0026} // SQ 1
+	TagImplantTemplateGroupTargetAnatomySequence                      = Tag{0x0078, 0x0028} // SQ 1
+	TagImplantTemplateGroupMembersSequence                            = Tag{0x0078, 0x002A} // SQ 1
+	TagImplantTemplateGroupMemberID                                   = Tag{0x0078, 0x002E} // US 1
+	TagThreeDImplantTemplateGroupMemberMatchingPoint                  = Tag{0x0078, 0x0050} // FD 3
+	TagThreeDImplantTemplateGroupMemberMatchingAxes                   = Tag{0x0078, 0x0060} // FD 9
+	TagImplantTemplateGroupMemberMatching2DCoordinatesSequence        = Tag{0x0078, 0x0070} // SQ 1
+	TagTwoDImplantTemplateGroupMemberMatchingPoint                    = Tag{0x0078, 0x0090} // FD 2
+	TagTwoDImplantTemplateGroupMemberMatchingAxes                     = Tag{0x0078, 0x00A0} // FD 4
+	TagImplantTemplateGroupVariationDimensionSequence                 = Tag{0x0078, 0x00B0} // SQ 1
+	TagImplantTemplateGroupVariationDimensionName                     = Tag{0x0078, 0x00B2} // LO 1
+	TagImplantTemplateGroupVariationDimensionRankSequence             = Tag{0x0078, 0x00B4} // SQ 1
+	TagReferencedImplantTemplateGroupMemberID                         = Tag{0x0078, 0x00B6} // US 1
+	TagImplantTemplateGroupVariationDimensionRank                     = Tag{0x0078, 0x00B8} // US 1
+	TagStorageMediaFileSetID                                          = Tag{0x0088, 0x0130} // SH 1
+	TagStorageMediaFileSetUID                                         = Tag{0x0088, 0x0140} // UI 1
+	TagIconImageSequence                                              = Tag{0x0088, 0x0200} // SQ 1
+	TagSOPInstanceStatus                                              = Tag{0x0100, 0x0410} // CS 1
+	TagSOPAuthorizationDateTime                                       = Tag{0x0100, 0x0420} // DT 1
+	TagSOPAuthorizationComment                                        = Tag{0x0100, 0x0424} // LT 1
+	TagAuthorizationEquipmentCertificationNumber                      = Tag{0x0100, 0x0426} // LO 1
+	TagMACIDNumber                                                    = Tag{0x0400, 0x0005} // US 1
+	TagMACCalculationTransferSyntaxUID                                = Tag{0x0400, 0x0010} // UI 1
+	TagMACAlgorithm                                                   = Tag{0x0400, 0x0015} // CS 1
+	TagDataElementsSigned                                             = Tag{0x0400, 0x0020} // AT 1-n
+	TagDigitalSignatureUID                                            = Tag{0x0400, 0x0100} // UI 1
+	TagDigitalSignatureDateTime                                       = Tag{0x0400, 0x0105} // DT 1
+	TagCertificateType                                                = Tag{0x0400, 0x0110} // CS 1
+	TagCertificateOfSigner                                            = Tag{0x0400, 0x0115} // OB 1
+	TagSignature                                                      = Tag{0x0400, 0x0120} // OB 1
+	TagCertifiedTimestampType                                         = Tag{0x0400, 0x0305} // CS 1
+	TagCertifiedTimestamp                                             = Tag{0x0400, 0x0310} // OB 1
+	TagDigitalSignaturePurposeCodeSequence                            = Tag{0x0400, 0x0401} // SQ 1
+	TagReferencedDigitalSignatureSequence                             = Tag{0x0400, 0x0402} // SQ 1
+	TagReferencedSOPInstanceMACSequence                               = Tag{0x0400, 0x0403} // SQ 1
+	TagMAC                                                            = Tag{0x0400, 0x0404} // OB 1
+	TagEncryptedAttributesSequence                                    = Tag{0x0400, 0x0500} // SQ 1
+	TagEncryptedContentTransferSyntaxUID                              = Tag{0x0400, 0x0510} // UI 1
+	TagEncryptedContent                                               = Tag{0x0400, 0x0520} // OB 1
+	TagModifiedAttributesSequence                                     = Tag{0x0400, 0x0550} // SQ 1
+	TagOriginalAttributesSequence                                     = Tag{0x0400, 0x0561} // SQ 1
+	TagAttributeModificationDateTime                                  = Tag{0x0400, 0x0562} // DT 1
+	TagModifyingSystem                                                = Tag{0x0400, 0x0563} // LO 1
+	TagSourceOfPreviousValues                                         = Tag{0x0400, 0x0564} // LO 1
+	TagReasonForTheAttributeModification                              = Tag{0x0400, 0x0565} // CS 1
+	TagNumberOfCopies                                                 = Tag{0x2000, 0x0010} // IS 1
+	TagPrinterConfigurationSequence                                   = Tag{0x2000, 0x001E} // SQ 1
+	TagPrintPriority                                                  = Tag{0x2000, 0x0020} // CS 1
+	TagMediumType                                                     = Tag{0x2000, 0x0030} // CS 1
+	TagFilmDestination                                                = Tag{0x2000, 0x0040} // CS 1
+	TagFilmSessionLabel                                               = Tag{0x2000, 0x0050} // LO 1
+	TagMemoryAllocation                                               = Tag{0x2000, 0x0060} // IS 1
+	TagMaximumMemoryAllocation                                        = Tag{0x2000, 0x0061} // IS 1
+	TagMemoryBitDepth                                                 = Tag{0x2000, 0x00A0} // US 1
+	TagPrintingBitDepth                                               = Tag{0x2000, 0x00A1} // US 1
+	TagMediaInstalledSequence                                         = Tag{0x2000, 0x00A2} // SQ 1
+	TagOtherMediaAvailableSequence                                    = Tag{0x2000, 0x00A4} // SQ 1
+	TagSupportedImageDisplayFormatsSequence                           = Tag{0x2000, 0x00A8} // SQ 1
+	TagReferencedFilmBoxSequence                                      = Tag{0x2000, 0x0500} // SQ 1
+	TagImageDisplayFormat                                             = Tag{0x2010, 0x0010} // ST 1
+	TagAnnotationDisplayFormatID                                      = Tag{0x2010, 0x0030} // CS 1
+	TagFilmOrientation                                                = Tag{0x2010, 0x0040} // CS 1
+	TagFilmSizeID                                                     = Tag{0x2010, 0x0050} // CS 1
+	TagPrinterResolutionID                                            = Tag{0x2010, 0x0052} // CS 1
+	TagDefaultPrinterResolutionID                                     = Tag{0x2010, 0x0054} // CS 1
+	TagMagnificationType                                              = Tag{0x2010, 0x0060} // CS 1
+	TagSmoothingType                                                  = Tag{0x2010, 0x0080} // CS 1
+	TagDefaultMagnificationType                                       = Tag{0x2010, 0x00A6} // CS 1
+	TagOtherMagnificationTypesAvailable                               = Tag{0x2010, 0x00A7} // CS 1-n
+	TagDefaultSmoothingType                                           = Tag{0x2010, 0x00A8} // CS 1
+	TagOtherSmoothingTypesAvailable                                   = Tag{0x2010, 0x00A9} // CS 1-n
+	TagBorderDensity                                                  = Tag{0x2010, 0x0100} // CS 1
+	TagEmptyImageDensity                                              = Tag{0x2010, 0x0110} // CS 1
+	TagMinDensity                                                     = Tag{0x2010, 0x0120} // US 1
+	TagMaxDensity                                                     = Tag{0x2010, 0x0130} // US 1
+	TagTrim                                                           = Tag{0x2010, 0x0140} // CS 1
+	TagConfigurationInformation                                       = Tag{0x2010, 0x0150} // ST 1
+	TagConfigurationInformationDescription                            = Tag{0x2010, 0x0152} // LT 1
+	TagMaximumCollatedFilms                                           = Tag{0x2010, 0x0154} // IS 1
+	TagIllumination                                                   = Tag{0x2010, 0x015E} // US 1
+	TagReflectedAmbientLight                                          = Tag{0x2010, 0x0160} // US 1
+	TagPrinterPixelSpacing                                            = Tag{0x2010, 0x0376} // DS 2
+	TagReferencedFilmSessionSequence                                  = Tag{0x2010, 0x0500} // SQ 1
+	TagReferencedImageBoxSequence                                     = Tag{0x2010, 0x0510} // SQ 1
+	TagReferencedBasicAnnotationBoxSequence                           = Tag{0x2010, 0x0520} // SQ 1
+	TagImageBoxPosition                                               = Tag{0x2020, 0x0010} // US 1
+	TagPolarity                                                       = Tag{0x2020, 0x0020} // CS 1
+	TagRequestedImageSize                                             = Tag{0x2020, 0x0030} // DS 1
+	TagRequestedDecimateCropBehavior                                  = Tag{0x2020, 0x0040} // CS 1
+	TagRequestedResolutionID                                          = Tag{0x2020, 0x0050} // CS 1
+	TagRequestedImageSizeFlag                                         = Tag{0x2020, 0x00A0} // CS 1
+	TagDecimateCropResult                                             = Tag{0x2020, 0x00A2} // CS 1
+	TagBasicGrayscaleImageSequence                                    = Tag{0x2020, 0x0110} // SQ 1
+	TagBasicColorImageSequence                                        = Tag{0x2020, 0x0111} // SQ 1
+	TagAnnotationPosition                                             = Tag{0x2030, 0x0010} // US 1
+	TagTextString                                                     = Tag{0x2030, 0x0020} // LO 1
+	TagPresentationLUTSequence                                        = Tag{0x2050, 0x0010} // SQ 1
+	TagPresentationLUTShape                                           = Tag{0x2050, 0x0020} // CS 1
+	TagReferencedPresentationLUTSequence                              = Tag{0x2050, 0x0500} // SQ 1
+	TagExecutionStatus                                                = Tag{0x2100, 0x0020} // CS 1
+	TagExecutionStatusInfo                                            = Tag{0x2100, 0x0030} // CS 1
+	TagCreationDate                                                   = Tag{0x2100, 0x0040} // DA 1
+	TagCreationTime                                                   = Tag{0x2100, 0x0050} // TM 1
+	TagOriginator                                                     = Tag{0x2100, 0x0070} // AE 1
+	TagOwnerID                                                        = Tag{0x2100, 0x0160} // SH 1
+	TagNumberOfFilms                                                  = Tag{0x2100, 0x0170} // IS 1
+	TagPrinterStatus                                                  = Tag{0x2110, 0x0010} // CS 1
+	TagPrinterStatusInfo                                              = Tag{0x2110, 0x0020} // CS 1
+	TagPrinterName                                                    = Tag{0x2110, 0x0030} // LO 1
+	TagLabelUsingInformationExtractedFromInstances                    = Tag{0x2200, 0x0001} // CS 1
+	TagLabelText                                                      = Tag{0x2200, 0x0002} // UT 1
+	TagLabelStyleSelection                                            = Tag{0x2200, 0x0003} // CS 1
+	TagMediaDisposition                                               = Tag{0x2200, 0x0004} // LT 1
+	TagBarcodeValue                                                   = Tag{0x2200, 0x0005} // LT 1
+	TagBarcodeSymbology                                               = Tag{0x2200, 0x0006} // CS 1
+	TagAllowMediaSplitting                                            = Tag{0x2200, 0x0007} // CS 1
+	TagIncludeNonDICOMObjects                                         = Tag{0x2200, 0x0008} // CS 1
+	TagIncludeDisplayApplication                                      = Tag{0x2200, 0x0009} // CS 1
+	TagPreserveCompositeInstancesAfterMediaCreation                   = Tag{0x2200, 0x000A} // CS 1
+	TagTotalNumberOfPiecesOfMediaCreated                              = Tag{0x2200, 0x000B} // US 1
+	TagRequestedMediaApplicationProfile                               = Tag{0x2200, 0x000C} // LO 1
+	TagReferencedStorageMediaSequence                                 = Tag{0x2200, 0x000D} // SQ 1
+	TagFailureAttributes                                              = Tag{0x2200, 0x000E} // AT 1-n
+	TagAllowLossyCompression                                          = Tag{0x2200, 0x000F} // CS 1
+	TagRequestPriority                                                = Tag{0x2200, 0x0020} // CS 1
+	TagRTImageLabel                                                   = Tag{0x3002, 0x0002} // SH 1
+	TagRTImageName                                                    = Tag{0x3002, 0x0003} // LO 1
+	TagRTImageDescription                                             = Tag{0x3002, 0x0004} // ST 1
+	TagReportedValuesOrigin                                           = Tag{0x3002, 0x000A} // CS 1
+	TagRTImagePlane                                                   = Tag{0x3002, 0x000C} // CS 1
+	TagXRayImageReceptorTranslation                                   = Tag{0x3002, 0x000D} // DS 3
+	TagXRayImageReceptorAngle                                         = Tag{0x3002, 0x000E} // DS 1
+	TagRTImageOrientation                                             = Tag{0x3002, 0x0010} // DS 6
+	TagImagePlanePixelSpacing                                         = Tag{0x3002, 0x0011} // DS 2
+	TagRTImagePosition                                                = Tag{0x3002, 0x0012} // DS 2
+	TagRadiationMachineName                                           = Tag{0x3002, 0x0020} // SH 1
+	TagRadiationMachineSAD                                            = Tag{0x3002, 0x0022} // DS 1
+	TagRadiationMachineSSD                                            = Tag{0x3002, 0x0024} // DS 1
+	TagRTImageSID                                                     = Tag{0x3002, 0x0026} // DS 1
+	TagSourceToReferenceObjectDistance                                = Tag{0x3002, 0x0028} // DS 1
+	TagFractionNumber                                                 = Tag{0x3002, 0x0029} // IS 1
+	TagExposureSequence                                               = Tag{0x3002, 0x0030} // SQ 1
+	TagMetersetExposure                                               = Tag{0x3002, 0x0032} // DS 1
+	TagDiaphragmPosition                                              = Tag{0x3002, 0x0034} // DS 4
+	TagFluenceMapSequence                                             = Tag{0x3002, 0x0040} // SQ 1
+	TagFluenceDataSource                                              = Tag{0x3002, 0x0041} // CS 1
+	TagFluenceDataScale                                               = Tag{0x3002, 0x0042} // DS 1
+	TagPrimaryFluenceModeSequence                                     = Tag{0x3002, 0x0050} // SQ 1
+	TagFluenceMode                                                    = Tag{0x3002, 0x0051} // CS 1
+	TagFluenceModeID                                                  = Tag{0x3002, 0x0052} // SH 1
+	TagDVHType                                                        = Tag{0x3004, 0x0001} // CS 1
+	TagDoseUnits                                                      = Tag{0x3004, 0x0002} // CS 1
+	TagDoseType                                                       = Tag{0x3004, 0x0004} // CS 1
+	TagDoseComment                                                    = Tag{0x3004, 0x0006} // LO 1
+	TagNormalizationPoint                                             = Tag{0x3004, 0x0008} // DS 3
+	TagDoseSummationType                                              = Tag{0x3004, 0x000A} // CS 1
+	TagGridFrameOffsetVector                                          = Tag{0x3004, 0x000C} // DS 2-n
+	TagDoseGridScaling                                                = Tag{0x3004, 0x000E} // DS 1
+	TagRTDoseROISequence                                              = Tag{0x3004, 0x0010} // SQ 1
+	TagDoseValue                                                      = Tag{0x3004, 0x0012} // DS 1
+	TagTissueHeterogeneityCorrection                                  = Tag{0x3004, 0x0014} // CS 1-3
+	TagDVHNormalizationPoint                                          = Tag{0x3004, 0x0040} // DS 3
+	TagDVHNormalizationDoseValue                                      = Tag{0x3004, 0x0042} // DS 1
+	TagDVHSequence                                                    = Tag{0x3004, 0x0050} // SQ 1
+	TagDVHDoseScaling                                                 = Tag{0x3004, 0x0052} // DS 1
+	TagDVHVolumeUnits                                                 = Tag{0x3004, 0x0054} // CS 1
+	TagDVHNumberOfBins                                                = Tag{0x3004, 0x0056} // IS 1
+	TagDVHData                                                        = Tag{0x3004, 0x0058} // DS 2-2n
+	TagDVHReferencedROISequence                                       = Tag{0x3004, 0x0060} // SQ 1
+	TagDVHROIContributionType                                         = Tag{0x3004, 0x0062} // CS 1
+	TagDVHMinimumDose                                                 = Tag{0x3004, 0x0070} // DS 1
+	TagDVHMaximumDose                                                 = Tag{0x3004, 0x0072} // DS 1
+	TagDVHMeanDose                                                    = Tag{0x3004, 0x0074} // DS 1
+	TagStructureSetLabel                                              = Tag{0x3006, 0x0002} // SH 1
+	TagStructureSetName                                               = Tag{0x3006, 0x0004} // LO 1
+	TagStructureSetDescription                                        = Tag{0x3006, 0x0006} // ST 1
+	TagStructureSetDate                                               = Tag{0x3006, 0x0008} // DA 1
+	TagStructureSetTime                                               = Tag{0x3006, 0x0009} // TM 1
+	TagReferencedFrameOfReferenceSequence                             = Tag{0x3006, 0x0010} // SQ 1
+	TagRTReferencedStudySequence                                      = Tag{0x3006, 0x0012} // SQ 1
+	TagRTReferencedSeriesSequence                                     = Tag{0x3006, 0x0014} // SQ 1
+	TagContourImageSequence                                           = Tag{0x3006, 0x0016} // SQ 1
+	TagStructureSetROISequence                                        = Tag{0x3006, 0x0020} // SQ 1
+	TagROINumber                                                      = Tag{0x3006, 0x0022} // IS 1
+	TagReferencedFrameOfReferenceUID                                  = Tag{0x3006, 0x0024} // UI 1
+	TagROIName                                                        = Tag{0x3006, 0x0026} // LO 1
+	TagROIDescription                                                 = Tag{0x3006, 0x0028} // ST 1
+	TagROIDisplayColor                                                = Tag{0x3006, 0x002A} // IS 3
+	TagROIVolume                                                      = Tag{0x3006, 0x002C} // DS 1
+	TagRTRelatedROISequence                                           = Tag{0x3006, 0x0030} // SQ 1
+	TagRTROIRelationship                                              = Tag{0x3006, 0x0033} // CS 1
+	TagROIGenerationAlgorithm                                         = Tag{0x3006, 0x0036} // CS 1
+	TagROIGenerationDescription                                       = Tag{0x3006, 0x0038} // LO 1
+	TagROIContourSequence                                             = Tag{0x3006, 0x0039} // SQ 1
+	TagContourSequence                                                = Tag{0x3006, 0x0040} // SQ 1
+	TagContourGeometricType                                           = Tag{0x3006, 0x0042} // CS 1
+	TagContourSlabThickness                                           = Tag{0x3006, 0x0044} // DS 1
+	TagContourOffsetVector                                            = Tag{0x3006, 0x0045} // DS 3
+	TagNumberOfContourPoints                                          = Tag{0x3006, 0x0046} // IS 1
+	TagContourNumber                                                  = Tag{0x3006, 0x0048} // IS 1
+	TagAttachedContours                                               = Tag{0x3006, 0x0049} // IS 1-n
+	TagContourData                                                    = Tag{0x3006, 0x0050} // DS 3-3n
+	TagRTROIObservationsSequence                                      = Tag{0x3006, 0x0080} // SQ 1
+	TagObservationNumber                                              = Tag{0x3006, 0x0082} // IS 1
+	TagReferencedROINumber                                            = Tag{0x3006, 0x0084} // IS 1
+	TagROIObservationLabel                                            = Tag{0x3006, 0x0085} // SH 1
+	TagRTROIIdentificationCodeSequence                                = Tag{0x3006, 0x0086} // SQ 1
+	TagROIObservationDescription                                      = Tag{0x3006, 0x0088} // ST 1
+	TagRelatedRTROIObservationsSequence                               = Tag{0x3006, 0x00A0} // SQ 1
+	TagRTROIInterpretedType                                           = Tag{0x3006, 0x00A4} // CS 1
+	TagROIInterpreter                                                 = Tag{0x3006, 0x00A6} // PN 1
+	TagROIPhysicalPropertiesSequence                                  = Tag{0x3006, 0x00B0} // SQ 1
+	TagROIPhysicalProperty                                            = Tag{0x3006, 0x00B2} // CS 1
+	TagROIPhysicalPropertyValue                                       = Tag{0x3006, 0x00B4} // DS 1
+	TagROIElementalCompositionSequence                                = Tag{0x3006, 0x00B6} // SQ 1
+	TagROIElementalCompositionAtomicNumber                            = Tag{0x3006, 0x00B7} // US 1
+	TagROIElementalCompositionAtomicMassFraction                      = Tag{0x3006, 0x00B8} // FL 1
+	TagFrameOfReferenceRelationshipSequence                           = Tag{0x3006, 0x00C0} // SQ 1
+	TagRelatedFrameOfReferenceUID                                     = Tag{0x3006, 0x00C2} // UI 1
+	TagFrameOfReferenceTransformationType                             = Tag{0x3006, 0x00C4} // CS 1
+	TagFrameOfReferenceTransformationMatrix                           = Tag{0x3006, 0x00C6} // DS 16
+	TagFrameOfReferenceTransformationComment                          = Tag{0x3006, 0x00C8} // LO 1
+	TagMeasuredDoseReferenceSequence                                  = Tag{0x3008, 0x0010} // SQ 1
+	TagMeasuredDoseDescription                                        = Tag{0x3008, 0x0012} // ST 1
+	TagMeasuredDoseType                                               = Tag{0x3008, 0x0014} // CS 1
+	TagMeasuredDoseValue                                              = Tag{0x3008, 0x0016} // DS 1
+	TagTreatmentSessionBeamSequence                                   = Tag{0x3008, 0x0020} // SQ 1
+	TagTreatmentSessionIonBeamSequence                                = Tag{0x3008, 0x0021} // SQ 1
+	TagCurrentFractionNumber                                          = Tag{0x3008, 0x0022} // IS 1
+	TagTreatmentControlPointDate                                      = Tag{0x3008, 0x0024} // DA 1
+	TagTreatmentControlPointTime                                      = Tag{0x3008, 0x0025} // TM 1
+	TagTreatmentTerminationStatus                                     = Tag{0x3008, 0x002A} // CS 1
+	TagTreatmentTerminationCode                                       = Tag{0x3008, 0x002B} // SH 1
+	TagTreatmentVerificationStatus                                    = Tag{0x3008, 0x002C} // CS 1
+	TagReferencedTreatmentRecordSequence                              = Tag{0x3008, 0x0030} // SQ 1
+	TagSpecifiedPrimaryMeterset                                       = Tag{0x3008, 0x0032} // DS 1
+	TagSpecifiedSecondaryMeterset                                     = Tag{0x3008, 0x0033} // DS 1
+	TagDeliveredPrimaryMeterset                                       = Tag{0x3008, 0x0036} // DS 1
+	TagDeliveredSecondaryMeterset                                     = Tag{0x3008, 0x0037} // DS 1
+	TagSpecifiedTreatmentTime                                         = Tag{0x3008, 0x003A} // DS 1
+	TagDeliveredTreatmentTime                                         = Tag{0x3008, 0x003B} // DS 1
+	TagControlPointDeliverySequence                                   = Tag{0x3008, 0x0040} // SQ 1
+	TagIonControlPointDeliverySequence                                = Tag{0x3008, 0x0041} // SQ 1
+	TagSpecifiedMeterset                                              = Tag{0x3008, 0x0042} // DS 1
+	TagDeliveredMeterset                                              = Tag{0x3008, 0x0044} // DS 1
+	TagMetersetRateSet                                                = Tag{0x3008, 0x0045} // FL 1
+	TagMetersetRateDelivered                                          = Tag{0x3008, 0x0046} // FL 1
+	TagScanSpotMetersetsDelivered                                     = Tag{0x3008, 0x0047} // FL 1-n
+	TagDoseRateDelivered                                              = Tag{0x3008, 0x0048} // DS 1
+	TagTreatmentSummaryCalculatedDoseReferenceSequence                = Tag{0x3008, 0x0050} // SQ 1
+	TagCumulativeDoseToDoseReference                                  = Tag{0x3008, 0x0052} // DS 1
+	TagFirstTreatmentDate                                             = Tag{0x3008, 0x0054} // DA 1
+	TagMostRecentTreatmentDate                                        = Tag{0x3008, 0x0056} // DA 1
+	TagNumberOfFractionsDelivered                                     = Tag{0x3008, 0x005A} // IS 1
+	TagOverrideSequence                                               = Tag{0x3008, 0x0060} // SQ 1
+	TagParameterSequencePointer                                       = Tag{0x3008, 0x0061} // AT 1
+	TagOverrideParameterPointer                                       = Tag{0x3008, 0x0062} // AT 1
+	TagParameterItemIndex                                             = Tag{0x3008, 0x0063} // IS 1
+	TagMeasuredDoseReferenceNumber                                    = Tag{0x3008, 0x0064} // IS 1
+	TagParameterPointer                                               = Tag{0x3008, 0x0065} // AT 1
+	TagOverrideReason                                                 = Tag{0x3008, 0x0066} // ST 1
+	TagCorrectedParameterSequence                                     = Tag{0x3008, 0x0068} // SQ 1
+	TagCorrectionValue                                                = Tag{0x3008, 0x006A} // FL 1
+	TagCalculatedDoseReferenceSequence                                = Tag{0x3008, 0x0070} // SQ 1
+	TagCalculatedDoseReferenceNumber                                  = Tag{0x3008, 0x0072} // IS 1
+	TagCalculatedDoseReferenceDescription                             = Tag{0x3008, 0x0074} // ST 1
+	TagCalculatedDoseReferenceDoseValue                               = Tag{0x3008, 0x0076} // DS 1
+	TagStartMeterset                                                  = Tag{0x3008, 0x0078} // DS 1
+	TagEndMeterset                                                    = Tag{0x3008, 0x007A} // DS 1
+	TagReferencedMeasuredDoseReferenceSequence                        = Tag{0x3008, 0x0080} // SQ 1
+	TagReferencedMeasuredDoseReferenceNumber                          = Tag{0x3008, 0x0082} // IS 1
+	TagReferencedCalculatedDoseReferenceSequence                      = Tag{0x3008, 0x0090} // SQ 1
+	TagReferencedCalculatedDoseReferenceNumber                        = Tag{0x3008, 0x0092} // IS 1
+	TagBeamLimitingDeviceLeafPairsSequence                            = Tag{0x3008, 0x00A0} // SQ 1
+	TagRecordedWedgeSequence                                          = Tag{0x3008, 0x00B0} // SQ 1
+	TagRecordedCompensatorSequence                                    = Tag{0x3008, 0x00C0} // SQ 1
+	TagRecordedBlockSequence                                          = Tag{0x3008, 0x00D0} // SQ 1
+	TagTreatmentSummaryMeasuredDoseReferenceSequence                  = Tag{0x3008, 0x00E0} // SQ 1
+	TagRecordedSnoutSequence                                          = Tag{0x3008, 0x00F0} // SQ 1
+	TagRecordedRangeShifterSequence                                   = Tag{0x3008, 0x00F2} // SQ 1
+	TagRecordedLateralSpreadingDeviceSequence                         = Tag{0x3008, 0x00F4} // SQ 1
+	TagRecordedRangeModulatorSequence                                 = Tag{0x3008, 0x00F6} // SQ 1
+	TagRecordedSourceSequence                                         = Tag{0x3008, 0x0100} // SQ 1
+	TagSourceSerialNumber                                             = Tag{0x3008, 0x0105} // LO 1
+	TagTreatmentSessionApplicationSetupSequence                       = Tag{0x3008, 0x0110} // SQ 1
+	TagApplicationSetupCheck                                          = Tag{0x3008, 0x0116} // CS 1
+	TagRecordedBrachyAccessoryDeviceSequence                          = Tag{0x3008, 0x0120} // SQ 1
+	TagReferencedBrachyAccessoryDeviceNumber                          = Tag{0x3008, 0x0122} // IS 1
+	TagRecordedChannelSequence                                        = Tag{0x3008, 0x0130} // SQ 1
+	TagSpecifiedChannelTotalTime                                      = Tag{0x3008, 0x0132} // DS 1
+	TagDeliveredChannelTotalTime                                      = Tag{0x3008, 0x0134} // DS 1
+	TagSpecifiedNumberOfPulses                                        = Tag{0x3008, 0x0136} // IS 1
+	TagDeliveredNumberOfPulses                                        = Tag{0x3008, 0x0138} // IS 1
+	TagSpecifiedPulseRepetitionInterval                               = Tag{0x3008, 0x013A} // DS 1
+	TagDeliveredPulseRepetitionInterval                               = Tag{0x3008, 0x013C} // DS 1
+	TagRecordedSourceApplicatorSequence                               = Tag{0x3008, 0x0140} // SQ 1
+	TagReferencedSourceApplicatorNumber                               = Tag{0x3008, 0x0142} // IS 1
+	TagRecordedChannelShieldSequence                                  = Tag{0x3008, 0x0150} // SQ 1
+	TagReferencedChannelShieldNumber                                  = Tag{0x3008, 0x0152} // IS 1
+	TagBrachyControlPointDeliveredSequence                            = Tag{0x3008, 0x0160} // SQ 1
+	TagSafePositionExitDate                                           = Tag{0x3008, 0x0162} // DA 1
+	TagSafePositionExitTime                                           = Tag{0x3008, 0x0164} // TM 1
+	TagSafePositionReturnDate                                         = Tag{0x3008, 0x0166} // DA 1
+	TagSafePositionReturnTime                                         = Tag{0x3008, 0x0168} // TM 1
+	TagCurrentTreatmentStatus                                         = Tag{0x3008, 0x0200} // CS 1
+	TagTreatmentStatusComment                                         = Tag{0x3008, 0x0202} // ST 1
+	TagFractionGroupSummarySequence                                   = Tag{0x3008, 0x0220} // SQ 1
+	TagReferencedFractionNumber                                       = Tag{0x3008, 0x0223} // IS 1
+	TagFractionGroupType                                              = Tag{0x3008, 0x0224} // CS 1
+	TagBeamStopperPosition                                            = Tag{0x3008, 0x0230} // CS 1
+	TagFractionStatusSummarySequence                                  = Tag{0x3008, 0x0240} // SQ 1
+	TagTreatmentDate                                                  = Tag{0x3008, 0x0250} // DA 1
+	TagTreatmentTime                                                  = Tag{0x3008, 0x0251} // TM 1
+	TagRTPlanLabel                                                    = Tag{0x300A, 0x0002} // SH 1
+	TagRTPlanName                                                     = Tag{0x300A, 0x0003} // LO 1
+	TagRTPlanDescription                                              = Tag{0x300A, 0x0004} // ST 1
+	TagRTPlanDate                                                     = Tag{0x300A, 0x0006} // DA 1
+	TagRTPlanTime                                                     = Tag{0x300A, 0x0007} // TM 1
+	TagTreatmentProtocols                                             = Tag{0x300A, 0x0009} // LO 1-n
+	TagPlanIntent                                                     = Tag{0x300A, 0x000A} // CS 1
+	TagTreatmentSites                                                 = Tag{0x300A, 0x000B} // LO 1-n
+	TagRTPlanGeometry                                                 = Tag{0x300A, 0x000C} // CS 1
+	TagPrescriptionDescription                                        = Tag{0x300A, 0x000E} // ST 1
+	TagDoseReferenceSequence                                          = Tag{0x300A, 0x0010} // SQ 1
+	TagDoseReferenceNumber                                            = Tag{0x300A, 0x0012} // IS 1
+	TagDoseReferenceUID                                               = Tag{0x300A, 0x0013} // UI 1
+	TagDoseReferenceStructureType                                     = Tag{0x300A, 0x0014} // CS 1
+	TagNominalBeamEnergyUnit                                          = Tag{0x300A, 0x0015} // CS 1
+	TagDoseReferenceDescription                                       = Tag{0x300A, 0x0016} // LO 1
+	TagDoseReferencePointCoordinates                                  = Tag{0x300A, 0x0018} // DS 3
+	TagNominalPriorDose                                               = Tag{0x300A, 0x001A} // DS 1
+	TagDoseReferenceType                                              = Tag{0x300A, 0x0020} // CS 1
+	TagConstraintWeight                                               = Tag{0x300A, 0x0021} // DS 1
+	TagDeliveryWarningDose                                            = Tag{0x300A, 0x0022} // DS 1
+	TagDeliveryMaximumDose                                            = Tag{0x300A, 0x0023} // DS 1
+	TagTargetMinimumDose                                              = Tag{0x300A, 0x0025} // DS 1
+	TagTargetPrescriptionDose                                         = Tag{0x300A, 0x0026} // DS 1
+	TagTargetMaximumDose                                              = Tag{0x300A, 0x0027} // DS 1
+	TagTargetUnderdoseVolumeFraction                                  = Tag{0x300A, 0x0028} // DS 1
+	TagOrganAtRiskFullVolumeDose                                      = Tag{0x300A, 0x002A} // DS 1
+	TagOrganAtRiskLimitDose                                           = Tag{0x300A, 0x002B} // DS 1
+	TagOrganAtRiskMaximumDose                                         = Tag{0x300A, 0x002C} // DS 1
+	TagOrganAtRiskOverdoseVolumeFraction                              = Tag{0x300A, 0x002D} // DS 1
+	TagToleranceTableSequence                                         = Tag{0x300A, 0x0040} // SQ 1
+	TagToleranceTableNumber                                           = Tag{0x300A, 0x0042} // IS 1
+	TagToleranceTableLabel                                            = Tag{0x300A, 0x0043} // SH 1
+	TagGantryAngleTolerance                                           = Tag{0x300A, 0x0044} // DS 1
+	TagBeamLimitingDeviceAngleTolerance                               = Tag{0x300A, 0x0046} // DS 1
+	TagBeamLimitingDeviceToleranceSequence                            = Tag{0x300A, 0x0048} // SQ 1
+	TagBeamLimitingDevicePositionTolerance                            = Tag{0x300A, 0x004A} // DS 1
+	TagSnoutPositionTolerance                                         = Tag{0x300A, 0x004B} // FL 1
+	TagPatientSupportAngleTolerance                                   = Tag{0x300A, 0x004C} // DS 1
+	TagTableTopEccentricAngleTolerance                                = Tag{0x300A, 0x004E} // DS 1
+	TagTableTopPitchAngleTolerance                                    = Tag{0x300A, 0x004F} // FL 1
+	TagTableTopRollAngleTolerance                                     = Tag{0x300A, 0x0050} // FL 1
+	TagTableTopVerticalPositionTolerance                              = Tag{0x300A, 0x0051} // DS 1
+	TagTableTopLongitudinalPositionTolerance                          = Tag{0x300A, 0x0052} // DS 1
+	TagTableTopLateralPositionTolerance                               = Tag{0x300A, 0x0053} // DS 1
+	TagRTPlanRelationship                                             = Tag{0x300A, 0x0055} // CS 1
+	TagFractionGroupSequence                                          = Tag{0x300A, 0x0070} // SQ 1
+	TagFractionGroupNumber                                            = Tag{0x300A, 0x0071} // IS 1
+	TagFractionGroupDescription                                       = Tag{0x300A, 0x0072} // LO 1
+	TagNumberOfFractionsPlanned                                       = Tag{0x300A, 0x0078} // IS 1
+	TagNumberOfFractionPatternDigitsPerDay                            = Tag{0x300A, 0x0079} // IS 1
+	TagRepeatFractionCycleLength                                      = Tag{0x300A, 0x007A} // IS 1
+	TagFractionPattern                                                = Tag{0x300A, 0x007B} // LT 1
+	TagNumberOfBeams                                                  = Tag{0x300A, 0x0080} // IS 1
+	TagBeamDoseSpecificationPoint                                     = Tag{0x300A, 0x0082} // DS 3
+	TagBeamDose                                                       = Tag{0x300A, 0x0084} // DS 1
+	TagBeamMeterset                                                   = Tag{0x300A, 0x0086} // DS 1
+	TagBeamDosePointDepth                                             = Tag{0x300A, 0x0088} // FL 1
+	TagBeamDosePointEquivalentDepth                                   = Tag{0x300A, 0x0089} // FL 1
+	TagBeamDosePointSSD                                               = Tag{0x300A, 0x008A} // FL 1
+	TagNumberOfBrachyApplicationSetups                                = Tag{0x300A, 0x00A0} // IS 1
+	TagBrachyApplicationSetupDoseSpecificationPoint                   = Tag{0x300A, 0x00A2} // DS 3
+	TagBrachyApplicationSetupDose                                     = Tag{0x300A, 0x00A4} // DS 1
+	TagBeamSequence                                                   = Tag{0x300A, 0x00B0} // SQ 1
+	TagTreatmentMachineName                                           = Tag{0x300A, 0x00B2} // SH 1
+	TagPrimaryDosimeterUnit                                           = Tag{0x300A, 0x00B3} // CS 1
+	TagSourceAxisDistance                                             = Tag{0x300A, 0x00B4} // DS 1
+	TagBeamLimitingDeviceSequence                                     = Tag{0x300A, 0x00B6} // SQ 1
+	TagRTBeamLimitingDeviceType                                       = Tag{0x300A, 0x00B8} // CS 1
+	TagSourceToBeamLimitingDeviceDistance                             = Tag{0x300A, 0x00BA} // DS 1
+	TagIsocenterToBeamLimitingDeviceDistance                          = Tag{0x300A, 0x00BB} // FL 1
+	TagNumberOfLeafJawPairs                                           = Tag{0x300A, 0x00BC} // IS 1
+	TagLeafPositionBoundaries                                         = Tag{0x300A, 0x00BE} // DS 3-n
+	TagBeamNumber                                                     = Tag{0x300A, 0x00C0} // IS 1
+	TagBeamName                                                       = Tag{0x300A, 0x00C2} // LO 1
+	TagBeamDescription                                                = Tag{0x300A, 0x00C3} // ST 1
+	TagBeamType                                                       = Tag{0x300A, 0x00C4} // CS 1
+	TagRadiationType                                                  = Tag{0x300A, 0x00C6} // CS 1
+	TagHighDoseTechniqueType                                          = Tag{0x300A, 0x00C7} // CS 1
+	TagReferenceImageNumber                                           = Tag{0x300A, 0x00C8} // IS 1
+	TagPlannedVerificationImageSequence                               = Tag{0x300A, 0x00CA} // SQ 1
+	TagImagingDeviceSpecificAcquisitionParameters                     = Tag{0x300A, 0x00CC} // LO 1-n
+	TagTreatmentDeliveryType                                          = Tag{0x300A, 0x00CE} // CS 1
+	TagNumberOfWedges                                                 = Tag{0x300A, 0x00D0} // IS 1
+	TagWedgeSequence                                                  = Tag{0x300A, 0x00D1} // SQ 1
+	TagWedgeNumber                                                    = Tag{0x300A, 0x00D2} // IS 1
+	TagWedgeType                                                      = Tag{0x300A, 0x00D3} // CS 1
+	TagWedgeID                                                        = Tag{0x300A, 0x00D4} // SH 1
+	TagWedgeAngle                                                     = Tag{0x300A, 0x00D5} // IS 1
+	TagWedgeFactor                                                    = Tag{0x300A, 0x00D6} // DS 1
+	TagTotalWedgeTrayWaterEquivalentThickness                         = Tag{0x300A, 0x00D7} // FL 1
+	TagWedgeOrientation                                               = Tag{0x300A, 0x00D8} // DS 1
+	TagIsocenterToWedgeTrayDistance                                   = Tag{0x300A, 0x00D9} // FL 1
+	TagSourceToWedgeTrayDistance                                      = Tag{0x300A, 0x00DA} // DS 1
+	TagWedgeThinEdgePosition                                          = Tag{0x300A, 0x00DB} // FL 1
+	TagBolusID                                                        = Tag{0x300A, 0x00DC} // SH 1
+	TagBolusDescription                                               = Tag{0x300A, 0x00DD} // ST 1
+	TagNumberOfCompensators                                           = Tag{0x300A, 0x00E0} // IS 1
+	TagMaterialID                                                     = Tag{0x300A, 0x00E1} // SH 1
+	TagTotalCompensatorTrayFactor                                     = Tag{0x300A, 0x00E2} // DS 1
+	TagCompensatorSequence                                            = Tag{0x300A, 0x00E3} // SQ 1
+	TagCompensatorNumber                                              = Tag{0x300A, 0x00E4} // IS 1
+	TagCompensatorID                                                  = Tag{0x300A, 0x00E5} // SH 1
+	TagSourceToCompensatorTrayDistance                                = Tag{0x300A, 0x00E6} // DS 1
+	TagCompensatorRows                                                = Tag{0x300A, 0x00E7} // IS 1
+	TagCompensatorColumns                                             = Tag{0x300A, 0x00E8} // IS 1
+	TagCompensatorPixelSpacing                                        = Tag{0x300A, 0x00E9} // DS 2
+	TagCompensatorPosition                                            = Tag{0x300A, 0x00EA} // DS 2
+	TagCompensatorTransmissionData                                    = Tag{0x300A, 0x00EB} // DS 1-n
+	TagCompensatorThicknessData                                       = Tag{0x300A, 0x00EC} // DS 1-n
+	TagNumberOfBoli                                                   = Tag{0x300A, 0x00ED} // IS 1
+	TagCompensatorType                                                = Tag{0x300A, 0x00EE} // CS 1
+	TagNumberOfBlocks                                                 = Tag{0x300A, 0x00F0} // IS 1
+	TagTotalBlockTrayFactor                                           = Tag{0x300A, 0x00F2} // DS 1
+	TagTotalBlockTrayWaterEquivalentThickness                         = Tag{0x300A, 0x00F3} // FL 1
+	TagBlockSequence                                                  = Tag{0x300A, 0x00F4} // SQ 1
+	TagBlockTrayID                                                    = Tag{0x300A, 0x00F5} // SH 1
+	TagSourceToBlockTrayDistance                                      = Tag{0x300A, 0x00F6} // DS 1
+	TagIsocenterToBlockTrayDistance                                   = Tag{0x300A, 0x00F7} // FL 1
+	TagBlockType                                                      = Tag{0x300A, 0x00F8} // CS 1
+	TagAccessoryCode                                                  = Tag{0x300A, 0x00F9} // LO 1
+	TagBlockDivergence                                                = Tag{0x300A, 0x00FA} // CS 1
+	TagBlockMountingPosition                                          = Tag{0x300A, 0x00FB} // CS 1
+	TagBlockNumber                                                    = Tag{0x300A, 0x00FC} // IS 1
+	TagBlockName                                                      = Tag{0x300A, 0x00FE} // LO 1
+	TagBlockThickness                                                 = Tag{0x300A, 0x0100} // DS 1
+	TagBlockTransmission                                              = Tag{0x300A, 0x0102} // DS 1
+	TagBlockNumberOfPoints                                            = Tag{0x300A, 0x0104} // IS 1
+	TagBlockData                                                      = Tag{0x300A, 0x0106} // DS 2-2n
+	TagApplicatorSequence                                             = Tag{0x300A, 0x0107} // SQ 1
+	TagApplicatorID                                                   = Tag{0x300A, 0x0108} // SH 1
+	TagApplicatorType                                                 = Tag{0x300A, 0x0109} // CS 1
+	TagApplicatorDescription                                          = Tag{0x300A, 0x010A} // LO 1
+	TagCumulativeDoseReferenceCoefficient                             = Tag{0x300A, 0x010C} // DS 1
+	TagFinalCumulativeMetersetWeight                                  = Tag{0x300A, 0x010E} // DS 1
+	TagNumberOfControlPoints                                          = Tag{0x300A, 0x0110} // IS 1
+	TagControlPointSequence                                           = Tag{0x300A, 0x0111} // SQ 1
+	TagControlPointIndex                                              = Tag{0x300A, 0x0112} // IS 1
+	TagNominalBeamEnergy                                              = Tag{0x300A, 0x0114} // DS 1
+	TagDoseRateSet                                                    = Tag{0x300A, 0x0115} // DS 1
+	TagWedgePositionSequence                                          = Tag{0x300A, 0x0116} // SQ 1
+	TagWedgePosition                                                  = Tag{0x300A, 0x0118} // CS 1
+	TagBeamLimitingDevicePositionSequence                             = Tag{0x300A, 0x011A} // SQ 1
+	TagLeafJawPositions                                               = Tag{0x300A, 0x011C} // DS 2-2n
+	TagGantryAngle                                                    = Tag{0x300A, 0x011E} // DS 1
+	TagGantryRotationDirection                                        = Tag{0x300A, 0x011F} // CS 1
+	TagBeamLimitingDeviceAngle                                        = Tag{0x300A, 0x0120} // DS 1
+	TagBeamLimitingDeviceRotationDirection                            = Tag{0x300A, 0x0121} // CS 1
+	TagPatientSupportAngle                                            = Tag{0x300A, 0x0122} // DS 1
+	TagPatientSupportRotationDirection                                = Tag{0x300A, 0x0123} // CS 1
+	TagTableTopEccentricAxisDistance                                  = Tag{0x300A, 0x0124} // DS 1
+	TagTableTopEccentricAngle                                         = Tag{0x300A, 0x0125} // DS 1
+	TagTableTopEccentricRotationDirection                             = Tag{0x300A, 0x0126} // CS 1
+	TagTableTopVerticalPosition                                       = Tag{0x300A, 0x0128} // DS 1
+	TagTableTopLongitudinalPosition                                   = Tag{0x300A, 0x0129} // DS 1
+	TagTableTopLateralPosition                                        = Tag{0x300A, 0x012A} // DS 1
+	TagIsocenterPosition                                              = Tag{0x300A, 0x012C} // DS 3
+	TagSurfaceEntryPoint                                              = Tag{0x300A, 0x012E} // DS 3
+	TagSourceToSurfaceDistance                                        = Tag{0x300A, 0x0130} // DS 1
+	TagCumulativeMetersetWeight                                       = Tag{0x300A, 0x0134} // DS 1
+	TagTableTopPitchAngle                                             = Tag{0x300A, 0x0140} // FL 1
+	TagTableTopPitchRotationDirection                                 = Tag{0x300A, 0x0142} // CS 1
+	TagTableTopRollAngle                                              = Tag{0x300A, 0x0144} // FL 1
+	TagTableTopRollRotationDirection                                  = Tag{0x300A, 0x0146} // CS 1
+	TagHeadFixationAngle                                              = Tag{0x300A, 0x0148} // FL 1
+	TagGantryPitchAngle                                               = Tag{0x300A, 0x014A} // FL 1
+	TagGantryPitchRotationDirection                                   = Tag{0x300A, 0x014C} // CS 1
+	TagGantryPitchAngleTolerance                                      = Tag{0x300A, 0x014E} // FL 1
+	TagPatientSetupSequence                                           = Tag{0x300A, 0x0180} // SQ 1
+	TagPatientSetupNumber                                             = Tag{0x300A, 0x0182} // IS 1
+	TagPatientSetupLabel                                              = Tag{0x300A, 0x0183} // LO 1
+	TagPatientAdditionalPosition                                      = Tag{0x300A, 0x0184} // LO 1
+	TagFixationDeviceSequence                                         = Tag{0x300A, 0x0190} // SQ 1
+	TagFixationDeviceType                                             = Tag{0x300A, 0x0192} // CS 1
+	TagFixationDeviceLabel                                            = Tag{0x300A, 0x0194} // SH 1
+	TagFixationDeviceDescription                                      = Tag{0x300A, 0x0196} // ST 1
+	TagFixationDevicePosition                                         = Tag{0x300A, 0x0198} // SH 1
+	TagFixationDevicePitchAngle                                       = Tag{0x300A, 0x0199} // FL 1
+	TagFixationDeviceRollAngle                                        = Tag{0x300A, 0x019A} // FL 1
+	TagShieldingDeviceSequence                                        = Tag{0x300A, 0x01A0} // SQ 1
+	TagShieldingDeviceType                                            = Tag{0x300A, 0x01A2} // CS 1
+	TagShieldingDeviceLabel                                           = Tag{0x300A, 0x01A4} // SH 1
+	TagShieldingDeviceDescription                                     = Tag{0x300A, 0x01A6} // ST 1
+	TagShieldingDevicePosition                                        = Tag{0x300A, 0x01A8} // SH 1
+	TagSetupTechnique                                                 = Tag{0x300A, 0x01B0} // CS 1
+	TagSetupTechniqueDescription                                      = Tag{0x300A, 0x01B2} // ST 1
+	TagSetupDeviceSequence                                            = Tag{0x300A, 0x01B4} // SQ 1
+	TagSetupDeviceType                                                = Tag{0x300A, 0x01B6} // CS 1
+	TagSetupDeviceLabel                                               = Tag{0x300A, 0x01B8} // SH 1
+	TagSetupDeviceDescription                                         = Tag{0x300A, 0x01BA} // ST 1
+	TagSetupDeviceParameter                                           = Tag{0x300A, 0x01BC} // DS 1
+	TagSetupReferenceDescription                                      = Tag{0x300A, 0x01D0} // ST 1
+	TagTableTopVerticalSetupDisplacement                              = Tag{0x300A, 0x01D2} // DS 1
+	TagTableTopLongitudinalSetupDisplacement                          = Tag{0x300A, 0x01D4} // DS 1
+	TagTableTopLateralSetupDisplacement                               = Tag{0x300A, 0x01D6} // DS 1
+	TagBrachyTreatmentTechnique                                       = Tag{0x300A, 0x0200} // CS 1
+	TagBrachyTreatmentType                                            = Tag{0x300A, 0x0202} // CS 1
+	TagTreatmentMachineSequence                                       = Tag{0x300A, 0x0206} // SQ 1
+	TagSourceSequence                                                 = Tag{0x300A, 0x0210} // SQ 1
+	TagSourceNumber                                                   = Tag{0x300A, 0x0212} // IS 1
+	TagSourceType                                                     = Tag{0x300A, 0x0214} // CS 1
+	TagSourceManufacturer                                             = Tag{0x300A, 0x0216} // LO 1
+	TagActiveSourceDiameter                                           = Tag{0x300A, 0x0218} // DS 1
+	TagActiveSourceLength                                             = Tag{0x300A, 0x021A} // DS 1
+	TagSourceEncapsulationNominalThickness                            = Tag{0x300A, 0x0222} // DS 1
+	TagSourceEncapsulationNominalTransmission                         = Tag{0x300A, 0x0224} // DS 1
+	TagSourceIsotopeName                                              = Tag{0x300A, 0x0226} // LO 1
+	TagSourceIsotopeHalfLife                                          = Tag{0x300A, 0x0228} // DS 1
+	TagSourceStrengthUnits                                            = Tag{0x300A, 0x0229} // CS 1
+	TagReferenceAirKermaRate                                          = Tag{0x300A, 0x022A} // DS 1
+	TagSourceStrength                                                 = Tag{0x300A, 0x022B} // DS 1
+	TagSourceStrengthReferenceDate                                    = Tag{0x300A, 0x022C} // DA 1
+	TagSourceStrengthReferenceTime                                    = Tag{0x300A, 0x022E} // TM 1
+	TagApplicationSetupSequence                                       = Tag{0x300A, 0x0230} // SQ 1
+	TagApplicationSetupType                                           = Tag{0x300A, 0x0232} // CS 1
+	TagApplicationSetupNumber                                         = Tag{0x300A, 0x0234} // IS 1
+	TagApplicationSetupName                                           = Tag{0x300A, 0x0236} // LO 1
+	TagApplicationSetupManufacturer                                   = Tag{0x300A, 0x0238} // LO 1
+	TagTemplateNumber                                                 = Tag{0x300A, 0x0240} // IS 1
+	TagTemplateType                                                   = Tag{0x300A, 0x0242} // SH 1
+	TagTemplateName                                                   = Tag{0x300A, 0x0244} // LO 1
+	TagTotalReferenceAirKerma                                         = Tag{0x300A, 0x0250} // DS 1
+	TagBrachyAccessoryDeviceSequence                                  = Tag{0x300A, 0x0260} // SQ 1
+	TagBrachyAccessoryDeviceNumber                                    = Tag{0x300A, 0x0262} // IS 1
+	TagBrachyAccessoryDeviceID                                        = Tag{0x300A, 0x0263} // SH 1
+	TagBrachyAccessoryDeviceType                                      = Tag{0x300A, 0x0264} // CS 1
+	TagBrachyAccessoryDeviceName                                      = Tag{0x300A, 0x0266} // LO 1
+	TagBrachyAccessoryDeviceNominalThickness                          = Tag{0x300A, 0x026A} // DS 1
+	TagBrachyAccessoryDeviceNominalTransmission                       = Tag{0x300A, 0x026C} // DS 1
+	TagChannelSequence                                                = Tag{0x300A, 0x0280} // SQ 1
+	TagChannelNumber                                                  = Tag{0x300A, 0x0282} // IS 1
+	TagChannelLength                                                  = Tag{0x300A, 0x0284} // DS 1
+	TagChannelTotalTime                                               = Tag{0x300A, 0x0286} // DS 1
+	TagSourceMovementType                                             = Tag{0x300A, 0x0288} // CS 1
+	TagNumberOfPulses                                                 = Tag{0x300A, 0x028A} // IS 1
+	TagPulseRepetitionInterval                                        = Tag{0x300A, 0x028C} // DS 1
+	TagSourceApplicatorNumber                                         = Tag{0x300A, 0x0290} // IS 1
+	TagSourceApplicatorID                                             = Tag{0x300A, 0x0291} // SH 1
+	TagSourceApplicatorType                                           = Tag{0x300A, 0x0292} // CS 1
+	TagSourceApplicatorName                                           = Tag{0x300A, 0x0294} // LO 1
+	TagSourceApplicatorLength                                         = Tag{0x300A, 0x0296} // DS 1
+	TagSourceApplicatorManufacturer                                   = Tag{0x300A, 0x0298} // LO 1
+	TagSourceApplicatorWallNominalThickness                           = Tag{0x300A, 0x029C} // DS 1
+	TagSourceApplicatorWallNominalTransmission                        = Tag{0x300A, 0x029E} // DS 1
+	TagSourceApplicatorStepSize                                       = Tag{0x300A, 0x02A0} // DS 1
+	TagTransferTubeNumber                                             = Tag{0x300A, 0x02A2} // IS 1
+	TagTransferTubeLength                                             = Tag{0x300A, 0x02A4} // DS 1
+	TagChannelShieldSequence                                          = Tag{0x300A, 0x02B0} // SQ 1
+	TagChannelShieldNumber                                            = Tag{0x300A, 0x02B2} // IS 1
+	TagChannelShieldID                                                = Tag{0x300A, 0x02B3} // SH 1
+	TagChannelShieldName                                              = Tag{0x300A, 0x02B4} // LO 1
+	TagChannelShieldNominalThickness                                  = Tag{0x300A, 0x02B8} // DS 1
+	TagChannelShieldNominalTransmission                               = Tag{0x300A, 0x02BA} // DS 1
+	TagFinalCumulativeTimeWeight                                      = Tag{0x300A, 0x02C8} // DS 1
+	TagBrachyControlPointSequence                                     = Tag{0x300A, 0x02D0} // SQ 1
+	TagControlPointRelativePosition                                   = Tag{0x300A, 0x02D2} // DS 1
+	TagControlPoint3DPosition                                         = Tag{0x300A, 0x02D4} // DS 3
+	TagCumulativeTimeWeight                                           = Tag{0x300A, 0x02D6} // DS 1
+	TagCompensatorDivergence                                          = Tag{0x300A, 0x02E0} // CS 1
+	TagCompensatorMountingPosition                                    = Tag{0x300A, 0x02E1} // CS 1
+	TagSourceToCompensatorDistance                                    = Tag{0x300A, 0x02E2} // DS 1-n
+	TagTotalCompensatorTrayWaterEquivalentThickness                   = Tag{0x300A, 0x02E3} // FL 1
+	TagIsocenterToCompensatorTrayDistance                             = Tag{0x300A, 0x02E4} // FL 1
+	TagCompensatorColumnOffset                                        = Tag{0x300A, 0x02E5} // FL 1
+	TagIsocenterToCompensatorDistances                                = Tag{0x300A, 0x02E6} // FL 1-n
+	TagCompensatorRelativeStoppingPowerRatio                          = Tag{0x300A, 0x02E7} // FL 1
+	TagCompensatorMillingToolDiameter                                 = Tag{0x300A, 0x02E8} // FL 1
+	TagIonRangeCompensatorSequence                                    = Tag{0x300A, 0x02EA} // SQ 1
+	TagCompensatorDescription                                         = Tag{0x300A, 0x02EB} // LT 1
+	TagRadiationMassNumber                                            = Tag{0x300A, 0x0302} // IS 1
+	TagRadiationAtomicNumber                                          = Tag{0x300A, 0x0304} // IS 1
+	TagRadiationChargeState                                           = Tag{0x300A, 0x0306} // SS 1
+	TagScanMode                                                       = Tag{0x300A, 0x0308} // CS 1
+	TagVirtualSourceAxisDistances                                     = Tag{0x300A, 0x030A} // FL 2
+	TagSnoutSequence                                                  = Tag{0x300A, 0x030C} // SQ 1
+	TagSnoutPosition                                                  = Tag{0x300A, 0x030D} // FL 1
+	TagSnoutID                                                        = Tag{0x300A, 0x030F} // SH 1
+	TagNumberOfRangeShifters                                          = Tag{0x300A, 0x0312} // IS 1
+	TagRangeShifterSequence                                           = Tag{0x300A, 0x0314} // SQ 1
+	TagRangeShifterNumber                                             = Tag{0x300A, 0x0316} // IS 1
+	TagRangeShifterID                                                 = Tag{0x300A, 0x0318} // SH 1
+	TagRangeShifterType                                               = Tag{0x300A, 0x0320} // CS 1
+	TagRangeShifterDescription                                        = Tag{0x300A, 0x0322} // LO 1
+	TagNumberOfLateralSpreadingDevices                                = Tag{0x300A, 0x0330} // IS 1
+	TagLateralSpreadingDeviceSequence                                 = Tag{0x300A, 0x0332} // SQ 1
+	TagLateralSpreadingDeviceNumber                                   = Tag{0x300A, 0x0334} // IS 1
+	TagLateralSpreadingDeviceID                                       = Tag{0x300A, 0x0336} // SH 1
+	TagLateralSpreadingDeviceType                                     = Tag{0x300A, 0x0338} // CS 1
+	TagLateralSpreadingDeviceDescription                              = Tag{0x300A, 0x033A} // LO 1
+	TagLateralSpreadingDeviceWaterEquivalentThickness                 = Tag{0x300A, 0x033C} // FL 1
+	TagNumberOfRangeModulators                                        = Tag{0x300A, 0x0340} // IS 1
+	TagRangeModulatorSequence                                         = Tag{0x300A, 0x0342} // SQ 1
+	TagRangeModulatorNumber                                           = Tag{0x300A, 0x0344} // IS 1
+	TagRangeModulatorID                                               = Tag{0x300A, 0x0346} // SH 1
+	TagRangeModulatorType                                             = Tag{0x300A, 0x0348} // CS 1
+	TagRangeModulatorDescription                                      = Tag{0x300A, 0x034A} // LO 1
+	TagBeamCurrentModulationID                                        = Tag{0x300A, 0x034C} // SH 1
+	TagPatientSupportType                                             = Tag{0x300A, 0x0350} // CS 1
+	TagPatientSupportID                                               = Tag{0x300A, 0x0352} // SH 1
+	TagPatientSupportAccessoryCode                                    = Tag{0x300A, 0x0354} // LO 1
+	TagFixationLightAzimuthalAngle                                    = Tag{0x300A, 0x0356} // FL 1
+	TagFixationLightPolarAngle                                        = Tag{0x300A, 0x0358} // FL 1
+	TagMetersetRate                                                   = Tag{0x300A, 0x035A} // FL 1
+	TagRangeShifterSettingsSequence                                   = Tag{0x300A, 0x0360} // SQ 1
+	TagRangeShifterSetting                                            = Tag{0x300A, 0x0362} // LO 1
+	TagIsocenterToRangeShifterDistance                                = Tag{0x300A, 0x0364} // FL 1
+	TagRangeShifterWaterEquivalentThickness                           = Tag{0x300A, 0x0366} // FL 1
+	TagLateralSpreadingDeviceSettingsSequence                         = Tag{0x300A, 0x0370} // SQ 1
+	TagLateralSpreadingDeviceSetting                                  = Tag{0x300A, 0x0372} // LO 1
+	TagIsocenterToLateralSpreadingDeviceDistance                      = Tag{0x300A, 0x0374} // FL 1
+	TagRangeModulatorSettingsSequence                                 = Tag{0x300A, 0x0380} // SQ 1
+	TagRangeModulatorGatingStartValue                                 = Tag{0x300A, 0x0382} // FL 1
+	TagRangeModulatorGatingStopValue                                  = Tag{0x300A, 0x0384} // FL 1
+	TagRangeModulatorGatingStartWaterEquivalentThickness              = Tag{0x300A, 0x0386} // FL 1
+	TagRangeModulatorGatingStopWaterEquivalentThickness               = Tag{0x300A, 0x0388} // FL 1
+	TagIsocenterToRangeModulatorDistance                              = Tag{0x300A, 0x038A} // FL 1
+	TagScanSpotTuneID                                                 = Tag{0x300A, 0x0390} // SH 1
+	TagNumberOfScanSpotPositions                                      = Tag{0x300A, 0x0392} // IS 1
+	TagScanSpotPositionMap                                            = Tag{0x300A, 0x0394} // FL 1-n
+	TagScanSpotMetersetWeights                                        = Tag{0x300A, 0x0396} // FL 1-n
+	TagScanningSpotSize                                               = Tag{0x300A, 0x0398} // FL 2
+	TagNumberOfPaintings                                              = Tag{0x300A, 0x039A} // IS 1
+	TagIonToleranceTableSequence                                      = Tag{0x300A, 0x03A0} // SQ 1
+	TagIonBeamSequence                                                = Tag{0x300A, 0x03A2} // SQ 1
+	TagIonBeamLimitingDeviceSequence                                  = Tag{0x300A, 0x03A4} // SQ 1
+	TagIonBlockSequence                                               = Tag{0x300A, 0x03A6} // SQ 1
+	TagIonControlPointSequence                                        = Tag{0x300A, 0x03A8} // SQ 1
+	TagIonWedgeSequence                                               = Tag{0x300A, 0x03AA} // SQ 1
+	TagIonWedgePositionSequence                                       = Tag{0x300A, 0x03AC} // SQ 1
+	TagReferencedSetupImageSequence                                   = Tag{0x300A, 0x0401} // SQ 1
+	TagSetupImageComment                                              = Tag{0x300A, 0x0402} // ST 1
+	TagMotionSynchronizationSequence                                  = Tag{0x300A, 0x0410} // SQ 1
+	TagControlPointOrientation                                        = Tag{0x300A, 0x0412} // FL 3
+	TagGeneralAccessorySequence                                       = Tag{0x300A, 0x0420} // SQ 1
+	TagGeneralAccessoryID                                             = Tag{0x300A, 0x0421} // SH 1
+	TagGeneralAccessoryDescription                                    = Tag{0x300A, 0x0422} // ST 1
+	TagGeneralAccessoryType                                           = Tag{0x300A, 0x0423} // CS 1
+	TagGeneralAccessoryNumber                                         = Tag{0x300A, 0x0424} // IS 1
+	TagApplicatorGeometrySequence                                     = Tag{0x300A, 0x0431} // SQ 1
+	TagApplicatorApertureShape                                        = Tag{0x300A, 0x0432} // CS 1
+	TagApplicatorOpening                                              = Tag{0x300A, 0x0433} // FL 1
+	TagApplicatorOpeningX                                             = Tag{0x300A, 0x0434} // FL 1
+	TagApplicatorOpeningY                                             = Tag{0x300A, 0x0435} // FL 1
+	TagSourceToApplicatorMountingPositionDistance                     = Tag{0x300A, 0x0436} // FL 1
+	TagReferencedRTPlanSequence                                       = Tag{0x300C, 0x0002} // SQ 1
+	TagReferencedBeamSequence                                         = Tag{0x300C, 0x0004} // SQ 1
+	TagReferencedBeamNumber                                           = Tag{0x300C, 0x0006} // IS 1
+	TagReferencedReferenceImageNumber                                 = Tag{0x300C, 0x0007} // IS 1
+	TagStartCumulativeMetersetWeight                                  = Tag{0x300C, 0x0008} // DS 1
+	TagEndCumulativeMetersetWeight                                    = Tag{0x300C, 0x0009} // DS 1
+	TagReferencedBrachyApplicationSetupSequence                       = Tag{0x300C, 0x000A} // SQ 1
+	TagReferencedBrachyApplicationSetupNumber                         = Tag{0x300C, 0x000C} // IS 1
+	TagReferencedSourceNumber                                         = Tag{0x300C, 0x000E} // IS 1
+	TagReferencedFractionGroupSequence                                = Tag{0x300C, 0x0020} // SQ 1
+	TagReferencedFractionGroupNumber                                  = Tag{0x300C, 0x0022} // IS 1
+	TagReferencedVerificationImageSequence                            = Tag{0x300C, 0x0040} // SQ 1
+	TagReferencedReferenceImageSequence                               = Tag{0x300C, 0x0042} // SQ 1
+	TagReferencedDoseReferenceSequence                                = Tag{0x300C, 0x0050} // SQ 1
+	TagReferencedDoseReferenceNumber                                  = Tag{0x300C, 0x0051} // IS 1
+	TagBrachyReferencedDoseReferenceSequence                          = Tag{0x300C, 0x0055} // SQ 1
+	TagReferencedStructureSetSequence                                 = Tag{0x300C, 0x0060} // SQ 1
+	TagReferencedPatientSetupNumber                                   = Tag{0x300C, 0x006A} // IS 1
+	TagReferencedDoseSequence                                         = Tag{0x300C, 0x0080} // SQ 1
+	TagReferencedToleranceTableNumber                                 = Tag{0x300C, 0x00A0} // IS 1
+	TagReferencedBolusSequence                                        = Tag{0x300C, 0x00B0} // SQ 1
+	TagReferencedWedgeNumber                                          = Tag{0x300C, 0x00C0} // IS 1
+	TagReferencedCompensatorNumber                                    = Tag{0x300C, 0x00D0} // IS 1
+	TagReferencedBlockNumber                                          = Tag{0x300C, 0x00E0} // IS 1
+	TagReferencedControlPointIndex                                    = Tag{0x300C, 0x00F0} // IS 1
+	TagReferencedControlPointSequence                                 = Tag{0x300C, 0x00F2} // SQ 1
+	TagReferencedStartControlPointIndex                               = Tag{0x300C, 0x00F4} // IS 1
+	TagReferencedStopControlPointIndex                                = Tag{0x300C, 0x00F6} // IS 1
+	TagReferencedRangeShifterNumber                                   = Tag{0x300C, 0x0100} // IS 1
+	TagReferencedLateralSpreadingDeviceNumber                         = Tag{0x300C, 0x0102} // IS 1
+	TagReferencedRangeModulatorNumber                                 = Tag{0x300C, 0x0104} // IS 1
+	TagApprovalStatus                                                 = Tag{0x300E, 0x0002} // CS 1
+	TagReviewDate                                                     = Tag{0x300E, 0x0004} // DA 1
+	TagReviewTime                                                     = Tag{0x300E, 0x0005} // TM 1
+	TagReviewerName                                                   = Tag{0x300E, 0x0008} // PN 1
+	TagLowEnergyDetectors                                             = Tag{0x4010, 0x0001} // CS 1
+	TagHighEnergyDetectors                                            = Tag{0x4010, 0x0002} // CS 1
+	TagDetectorGeometrySequence                                       = Tag{0x4010, 0x0004} // SQ 1
+	TagThreatROIVoxelSequence                                         = Tag{0x4010, 0x1001} // SQ 1
+	TagThreatROIBase                                                  = Tag{0x4010, 0x1004} // FL 3
+	TagThreatROIExtents                                               = Tag{0x4010, 0x1005} // FL 3
+	TagThreatROIBitmap                                                = Tag{0x4010, 0x1006} // OB 1
+	TagRouteSegmentID                                                 = Tag{0x4010, 0x1007} // SH 1
+	TagGantryType                                                     = Tag{0x4010, 0x1008} // CS 1
+	TagOOIOwnerType                                                   = Tag{0x4010, 0x1009} // CS 1
+	TagRouteSegmentSequence                                           = Tag{0x4010, 0x100A} // SQ 1
+	TagPotentialThreatObjectID                                        = Tag{0x4010, 0x1010} // US 1
+	TagThreatSequence                                                 = Tag{0x4010, 0x1011} // SQ 1
+	TagThreatCategory                                                 = Tag{0x4010, 0x1012} // CS 1
+	TagThreatCategoryDescription                                      = Tag{0x4010, 0x1013} // LT 1
+	TagATDAbilityAssessment                                           = Tag{0x4010, 0x1014} // CS 1
+	TagATDAssessmentFlag                                              = Tag{0x4010, 0x1015} // CS 1
+	TagATDAssessmentProbability                                       = Tag{0x4010, 0x1016} // FL 1
+	TagMass                                                           = Tag{0x4010, 0x1017} // FL 1
+	TagDensity                                                        = Tag{0x4010, 0x1018} // FL 1
+	TagZEffective                                                     = Tag{0x4010, 0x1019} // FL 1
+	TagBoardingPassID                                                 = Tag{0x4010, 0x101A} // SH 1
+	TagCenterOfMass                                                   = Tag{0x4010, 0x101B} // FL 3
+	TagCenterOfPTO                                                    = Tag{0x4010, 0x101C} // FL 3
+	TagBoundingPolygon                                                = Tag{0x4010, 0x101D} // FL 6-n
+	TagRouteSegmentStartLocationID                                    = Tag{0x4010, 0x101E} // SH 1
+	TagRouteSegmentEndLocationID                                      = Tag{0x4010, 0x101F} // SH 1
+	TagRouteSegmentLocationIDType                                     = Tag{0x4010, 0x1020} // CS 1
+	TagAbortReason                                                    = Tag{0x4010, 0x1021} // CS 1-n
+	TagVolumeOfPTO                                                    = Tag{0x4010, 0x1023} // FL 1
+	TagAbortFlag                                                      = Tag{0x4010, 0x1024} // CS 1
+	TagRouteSegmentStartTime                                          = Tag{0x4010, 0x1025} // DT 1
+	TagRouteSegmentEndTime                                            = Tag{0x4010, 0x1026} // DT 1
+	TagTDRType                                                        = Tag{0x4010, 0x1027} // CS 1
+	TagInternationalRouteSegment                                      = Tag{0x4010, 0x1028} // CS 1
+	TagThreatDetectionAlgorithmandVersion                             = Tag{0x4010, 0x1029} // LO 1-n
+	TagAssignedLocation                                               = Tag{0x4010, 0x102A} // SH 1
+	TagAlarmDecisionTime                                              = Tag{0x4010, 0x102B} // DT 1
+	TagAlarmDecision                                                  = Tag{0x4010, 0x1031} // CS 1
+	TagNumberOfTotalObjects                                           = Tag{0x4010, 0x1033} // US 1
+	TagNumberOfAlarmObjects                                           = Tag{0x4010, 0x1034} // US 1
+	TagPTORepresentationSequence                                      = Tag{0x4010, 0x1037} // SQ 1
+	TagATDAssessmentSequence                                          = Tag{0x4010, 0x1038} // SQ 1
+	TagTIPType                                                        = Tag{0x4010, 0x1039} // CS 1
+	TagDICOSVersion                                                   = Tag{0x4010, 0x103A} // CS 1
+	TagOOIOwnerCreationTime                                           = Tag{0x4010, 0x1041} // DT 1
+	TagOOIType                                                        = Tag{0x4010, 0x1042} // CS 1
+	TagOOISize                                                        = Tag{0x4010, 0x1043} // FL 3
+	TagAcquisitionStatus                                              = Tag{0x4010, 0x1044} // CS 1
+	TagBasisMaterialsCodeSequence                                     = Tag{0x4010, 0x1045} // SQ 1
+	TagPhantomType                                                    = Tag{0x4010, 0x1046} // CS 1
+	TagOOIOwnerSequence                                               = Tag{0x4010, 0x1047} // SQ 1
+	TagScanType                                                       = Tag{0x4010, 0x1048} // CS 1
+	TagItineraryID                                                    = Tag{0x4010, 0x1051} // LO 1
+	TagItineraryIDType                                                = Tag{0x4010, 0x1052} // SH 1
+	TagItineraryIDAssigningAuthority                                  = Tag{0x4010, 0x1053} // LO 1
+	TagRouteID                                                        = Tag{0x4010, 0x1054} // SH 1
+	TagRouteIDAssigningAuthority                                      = Tag{0x4010, 0x1055} // SH 1
+	TagInboundArrivalType                                             = Tag{0x4010, 0x1056} // CS 1
+	TagCarrierID                                                      = Tag{0x4010, 0x1058} // SH 1
+	TagCarrierIDAssigningAuthority                                    = Tag{0x4010, 0x1059} // CS 1
+	TagSourceOrientation                                              = Tag{0x4010, 0x1060} // FL 3
+	TagSourcePosition                                                 = Tag{0x4010, 0x1061} // FL 3
+	TagBeltHeight                                                     = Tag{0x4010, 0x1062} // FL 1
+	TagAlgorithmRoutingCodeSequence                                   = Tag{0x4010, 0x1064} // SQ 1
+	TagTransportClassification                                        = Tag{0x4010, 0x1067} // CS 1
+	TagOOITypeDescriptor                                              = Tag{0x4010, 0x1068} // LT 1
+	TagTotalProcessingTime                                            = Tag{0x4010, 0x1069} // FL 1
+	TagDetectorCalibrationData                                        = Tag{0x4010, 0x106C} // OB 1
+	TagMACParametersSequence                                          = Tag{0x4FFE, 0x0001} // SQ 1
+	TagSharedFunctionalGroupsSequence                                 = Tag{0x5200, 0x9229} // SQ 1
+	TagPerFrameFunctionalGroupsSequence                               = Tag{0x5200, 0x9230} // SQ 1
+	TagWaveformSequence                                               = Tag{0x5400, 0x0100} // SQ 1
+	TagChannelMinimumValue                                            = Tag{0x5400, 0x0110} // OX 1
+	TagChannelMaximumValue                                            = Tag{0x5400, 0x0112} // OX 1
+	TagWaveformBitsAllocated                                          = Tag{0x5400, 0x1004} // US 1
+	TagWaveformSampleInterpretation                                   = Tag{0x5400, 0x1006} // CS 1
+	TagWaveformPaddingValue                                           = Tag{0x5400, 0x100A} // OX 1
+	TagWaveformData                                                   = Tag{0x5400, 0x1010} // OX 1
+	TagFirstOrderPhaseCorrectionAngle                                 = Tag{0x5600, 0x0010} // OF 1
+	TagSpectroscopyData                                               = Tag{0x5600, 0x0020} // OF 1
+	TagPixelData                                                      = Tag{0x7FE0, 0x0010} // OX 1
+	TagDigitalSignaturesSequence                                      = Tag{0xFFFA, 0xFFFA} // SQ 1
+	TagDataSetTrailingPadding                                         = Tag{0xFFFC, 0xFFFC} // OB 1
+	TagItem                                                           = Tag{0xFFFE, 0xE000} // NA 1
+	TagItemDelimitationItem                                           = Tag{0xFFFE, 0xE00D} // NA 1
+	TagSequenceDelimitationItem                                       = Tag{0xFFFE, 0xE0DD} // NA 1
+	TagOphthalmicMappingDeviceType                                    = Tag{0x0022, 0x1415} // CS 1
+	TagAcquisitionTypeMethodSequence                                  = Tag{0x0022, 0x1420} // SQ 1
+	TagAcquisitonMethodAlgorithmSequence                              = Tag{0x0022, 0x1423} // SQ 1
+	TagOphthalmicThicknessMapTypeCodeSequence                         = Tag{0x0022, 0x1436} // SQ 1
+	TagOphthalmicThicknessMappingNormalsSequence                      = Tag{0x0022, 0x1443} // SQ 1
+	TagRetinalThicknessDefinitionCodeSequence                         = Tag{0x0022, 0x1445} // SQ 1
+	TagPixelValueMappingtoCodedConceptSequence                        = Tag{0x0022, 0x1450} // SQ 1
+	TagMappedPixelValue                                               = Tag{0x0022, 0x1452} // XS 1
+	TagPixelValueMappingExplanation                                   = Tag{0x0022, 0x1454} // LO 1
+	TagOphthalmicThicknessMapQualityThresholdSequence                 = Tag{0x0022, 0x1458} // SQ 1
+	TagOphthalmicThicknessMapThresholdQualityRating                   = Tag{0x0022, 0x1460} // FL 1
+	TagAnatomicStructureReferencePoint                                = Tag{0x0022, 0x1463} // FL 2
+	TagRegistrationtoLocalizerSequence                                = Tag{0x0022, 0x1465} // SQ 1
+	TagRegisteredLocalizerUnits                                       = Tag{0x0022, 0x1466} // CS 1
+	TagRegisteredTopLeftHandCorner                                    = Tag{0x0022, 0x1467} // FL 2
+	TagRegisteredBottomRightHandCorner                                = Tag{0x0022, 0x1468} // FL 2
+	TagOphthalmicThicknessMapQualityRatingSequence                    = Tag{0x0022, 0x1470} // SQ 1
+	TagRelevantOPTAttributesSequence                                  = Tag{0x0022, 0x1472} // SQ 1
+	TagFloatingPointValue                                             = Tag{0x0040, 0xA161} // FD 1-n
+	TagRationalNumeratorValue                                         = Tag{0x0040, 0xA162} // SL 1-n
+	TagRationalDenominatorValue                                       = Tag{0x0040, 0xA163} // UL 1-n
+	TagQualityControlSubject                                          = Tag{0x0010, 0x0200} // CS 1
+	TagPredecessorStructureSetSequence                                = Tag{0x3006, 0x0018} // SQ 1
+	TagBeamDoseVerificationControlPointSequence                       = Tag{0x300A, 0x008C} // SQ 1
+	TagAverageBeamDosePointDepth                                      = Tag{0x300A, 0x008D} // FL 1
+	TagAverageBeamDosePointEquivalentDepth                            = Tag{0x300A, 0x008E} // FL 1
+	TagAverageBeamDosePointSSD                                        = Tag{0x300A, 0x008F} // FL 1
+	TagObservationUID                                                 = Tag{0x0040, 0xA171} // UI 1
+	TagCompensatorTrayID                                              = Tag{0x300A, 0x00EF} // SH 1
+	TagRecommendedPointRadius                                         = Tag{0x0066, 0x0037} // FL 1
+	TagRecommendedLineThickness                                       = Tag{0x0066, 0x0038} // FL 1
+	TagSourceModel                                                    = Tag{0x300A, 0x021B} // SH 1
+	TagSourceDescription                                              = Tag{0x300A, 0x021C} // LO 1
+	TagInstanceCoercionDateTime                                       = Tag{0x0008, 0x0015} // DT 1
+	TagFloatPixelData                                                 = Tag{0x7FE0, 0x0008} // OF 1
+	TagDoubleFloatPixelData                                           = Tag{0x7FE0, 0x0009} // OD 1
+	TagTrackPointIndexList                                            = Tag{0x0066, 0x0129} // OL 1
+	TagExtendedOffsetTable                                            = Tag{0x7FE0, 0x0001} // OV 1
+	TagExtendedOffsetTableLengths                                     = Tag{0x7FE0, 0x0002} // OV 1
+	TagUnassignedSharedConvertedAttributesSequence                    = Tag{0x0020, 0x9170} // SQ 1
+	TagUnassignedPerFrameConvertedAttributesSequence                  = Tag{0x0020, 0x9171} // SQ 1
+	TagConversionSourceAttributesSequence                             = Tag{0x0020, 0x9172} // SQ 1
+	TagACR_NEMA_CommandGroupLengthToEnd                               = Tag{0x0000, 0x0001} // UL 1
+	TagACR_NEMA_CommandRecognitionCode                                = Tag{0x0000, 0x0010} // CS 1
+	TagACR_NEMA_Initiator                                             = Tag{0x0000, 0x0200} // LO 1
+	TagACR_NEMA_Receiver                                              = Tag{0x0000, 0x0300} // LO 1
+	TagACR_NEMA_FindLocation                                          = Tag{0x0000, 0x0400} // LO 1
+	TagACR_NEMA_NumberOfMatches                                       = Tag{0x0000, 0x0850} // US 1
+	TagACR_NEMA_ResponseSequenceNumber                                = Tag{0x0000, 0x0860} // US 1
+	TagACR_NEMA_DialogReceiver                                        = Tag{0x0000, 0x4000} // LO 1
+	TagACR_NEMA_TerminalType                                          = Tag{0x0000, 0x4010} // LO 1
+	TagACR_NEMA_MessageSetID                                          = Tag{0x0000, 0x5010} // LO 1
+	TagACR_NEMA_EndMessageSet                                         = Tag{0x0000, 0x5020} // LO 1
+	TagACR_NEMA_DisplayFormat                                         = Tag{0x0000, 0x5110} // LO 1
+	TagACR_NEMA_PagePositionID                                        = Tag{0x0000, 0x5120} // LO 1
+	TagACR_NEMA_TextFormatID                                          = Tag{0x0000, 0x5130} // LO 1
+	TagACR_NEMA_NormalReverse                                         = Tag{0x0000, 0x5140} // CS 1
+	TagACR_NEMA_AddGrayScale                                          = Tag{0x0000, 0x5150} // CS 1
+	TagACR_NEMA_Borders                                               = Tag{0x0000, 0x5160} // CS 1
+	TagACR_NEMA_Copies                                                = Tag{0x0000, 0x5170} // IS 1
+	TagACR_NEMA_MagnificationType                                     = Tag{0x0000, 0x5180} // LO 1
+	TagACR_NEMA_Erase                                                 = Tag{0x0000, 0x5190} // LO 1-n
+	TagACR_NEMA_Print                                                 = Tag{0x0000, 0x51A0} // CS 1
+	TagACR_NEMA_Overlays                                              = Tag{0x0000, 0x51B0} // US 1-n
+	TagACR_NEMA_IdentifyingGroupLengthToEnd                           = Tag{0x0008, 0x0001} // UL 1
+	TagACR_NEMA_RecognitionCode                                       = Tag{0x0008, 0x0010} // LO 1
+	TagACR_NEMA_OldDataSetType                                        = Tag{0x0008, 0x0040} // US 1
+	TagACR_NEMA_DataSetSubtype                                        = Tag{0x0008, 0x0041} // LO 1
+	TagACR_NEMA_NetworkID                                             = Tag{0x0008, 0x1000} // LO 1
+	TagACR_NEMA_IdentifyingComments                                   = Tag{0x0008, 0x4000} // LT 1-n
+	TagACR_NEMA_InsurancePlanIdentification                           = Tag{0x0010, 0x1050} // LT 1-n
+	TagACR_NEMA_UpperLowerPixelValues                                 = Tag{0x0018, 0x1240} // IS 1-n
+	TagACR_NEMA_AcquisitionComments                                   = Tag{0x0018, 0x4000} // LT 1-n
+	TagACR_NEMA_DynamicRange                                          = Tag{0x0018, 0x5030} // DS 1
+	TagACR_NEMA_TotalGain                                             = Tag{0x0018, 0x5040} // DS 1
+	TagACR_NEMA_ImagePosition                                         = Tag{0x0020, 0x0030} // DS 3
+	TagACR_NEMA_ImageOrientation                                      = Tag{0x0020, 0x0035} // DS 6
+	TagACR_NEMA_Location                                              = Tag{0x0020, 0x0050} // DS 1
+	TagACR_NEMA_ImageGeometryType                                     = Tag{0x0020, 0x0070} // LO 1
+	TagACR_NEMA_MaskingImage                                          = Tag{0x0020, 0x0080} // LO 1-n
+	TagACR_NEMA_AcquisitionsInSeries                                  = Tag{0x0020, 0x1001} // IS 1
+	TagACR_NEMA_ImagesInSeries                                        = Tag{0x0020, 0x1003} // IS 1
+	TagACR_NEMA_ImagesInStudy                                         = Tag{0x0020, 0x1005} // IS 1
+	TagACR_NEMA_Reference                                             = Tag{0x0020, 0x1020} // LO 1-n
+	TagACR_NEMA_ModifyingDeviceID                                     = Tag{0x0020, 0x3401} // LO 1
+	TagACR_NEMA_ModifiedImageID                                       = Tag{0x0020, 0x3402} // LO 1
+	TagACR_NEMA_ModifiedImageDate                                     = Tag{0x0020, 0x3403} // DA 1
+	TagACR_NEMA_ModifyingDeviceManufacturer                           = Tag{0x0020, 0x3404} // LO 1
+	TagACR_NEMA_ModifiedImageTime                                     = Tag{0x0020, 0x3405} // TM 1
+	TagACR_NEMA_ModifiedImageDescription                              = Tag{0x0020, 0x3406} // LO 1
+	TagACR_NEMA_OriginalImageIdentification                           = Tag{0x0020, 0x5000} // AT 1-n
+	TagACR_NEMA_OriginalImageIdentificationNomenclature               = Tag{0x0020, 0x5002} // LO 1-n
+	TagACR_NEMA_ImageDimensions                                       = Tag{0x0028, 0x0005} // US 1
+	TagACR_NEMA_ImageFormat                                           = Tag{0x0028, 0x0040} // CS 1
+	TagACR_NEMA_ManipulatedImage                                      = Tag{0x0028, 0x0050} // LO 1-n
+	TagACR_NEMA_CompressionCode                                       = Tag{0x0028, 0x0060} // CS 1
+	TagACR_NEMA_SmallestValidPixelValue                               = Tag{0x0028, 0x0104} // XS 1
+	TagACR_NEMA_LargestValidPixelValue                                = Tag{0x0028, 0x0105} // XS 1
+	TagACR_NEMA_ImageLocation                                         = Tag{0x0028, 0x0200} // US 1
+	TagACR_NEMA_GrayScale                                             = Tag{0x0028, 0x1080} // CS 1
+	TagACR_NEMA_GrayLookupTableDescriptor                             = Tag{0x0028, 0x1100} // XS 3
+	TagACR_NEMA_GrayLookupTableData                                   = Tag{0x0028, 0x1200} // XS 1-n
+	TagACR_NEMA_ImagePresentationComments                             = Tag{0x0028, 0x4000} // LT 1-n
+	TagACR_NEMA_TextGroupLength                                       = Tag{0x4000, 0x0000} // UL 1
+	TagACR_NEMA_TextArbitrary                                         = Tag{0x4000, 0x0010} // LT 1-n
+	TagACR_NEMA_TextComments                                          = Tag{0x4000, 0x4000} // LT 1-n
+	TagACR_NEMA_2C_CompressionRecognitionCode                         = Tag{0x0028, 0x005F} // CS 1
+	TagACR_NEMA_2C_CompressionOriginator                              = Tag{0x0028, 0x0061} // SH 1
+	TagACR_NEMA_2C_CompressionLabel                                   = Tag{0x0028, 0x0062} // SH 1
+	TagACR_NEMA_2C_CompressionDescription                             = Tag{0x0028, 0x0063} // SH 1
+	TagACR_NEMA_2C_CompressionSequence                                = Tag{0x0028, 0x0065} // CS 1-n
+	TagACR_NEMA_2C_CompressionStepPointers                            = Tag{0x0028, 0x0066} // AT 1-n
+	TagACR_NEMA_2C_RepeatInterval                                     = Tag{0x0028, 0x0068} // US 1
+	TagACR_NEMA_2C_BitsGrouped                                        = Tag{0x0028, 0x0069} // US 1
+	TagACR_NEMA_2C_PerimeterTable                                     = Tag{0x0028, 0x0070} // US 1-n
+	TagACR_NEMA_2C_PerimeterValue                                     = Tag{0x0028, 0x0071} // XS 1
+	TagACR_NEMA_2C_PredictorRows                                      = Tag{0x0028, 0x0080} // US 1
+	TagACR_NEMA_2C_PredictorColumns                                   = Tag{0x0028, 0x0081} // US 1
+	TagACR_NEMA_2C_PredictorConstants                                 = Tag{0x0028, 0x0082} // US 1-n
+	TagACR_NEMA_2C_BlockedPixels                                      = Tag{0x0028, 0x0090} // CS 1
+	TagACR_NEMA_2C_BlockRows                                          = Tag{0x0028, 0x0091} // US 1
+	TagACR_NEMA_2C_BlockColumns                                       = Tag{0x0028, 0x0092} // US 1
+	TagACR_NEMA_2C_RowOverlap                                         = Tag{0x0028, 0x0093} // US 1
+	TagACR_NEMA_2C_ColumnOverlap                                      = Tag{0x0028, 0x0094} // US 1
+	TagACR_NEMA_2C_TransformLabel                                     = Tag{0x0028, 0x0400} // CS 1
+	TagACR_NEMA_2C_TransformVersionNumber                             = Tag{0x0028, 0x0401} // CS 1
+	TagACR_NEMA_2C_NumberOfTransformSteps                             = Tag{0x0028, 0x0402} // US 1
+	TagACR_NEMA_2C_SequenceOfCompressedData                           = Tag{0x0028, 0x0403} // CS 1-n
+	TagACR_NEMA_2C_DetailsOfCoefficients                              = Tag{0x0028, 0x0404} // AT 1-n
+	TagACR_NEMA_2C_RowsForNthOrderCoefficients                        = Tag{0x0028, 0x0410} // US 1
+	TagACR_NEMA_2C_ColumnsForNthOrderCoefficients                     = Tag{0x0028, 0x0411} // US 1
+	TagACR_NEMA_2C_CoefficientCoding                                  = Tag{0x0028, 0x0412} // CS 1-n
+	TagACR_NEMA_2C_CoefficientCodingPointers                          = Tag{0x0028, 0x0413} // AT 1-n
+	TagACR_NEMA_2C_DCTLabel                                           = Tag{0x0028, 0x0700} // CS 1
+	TagACR_NEMA_2C_DataBlockDescription                               = Tag{0x0028, 0x0701} // CS 1-n
+	TagACR_NEMA_2C_DataBlock                                          = Tag{0x0028, 0x0702} // AT 1-n
+	TagACR_NEMA_2C_NormalizationFactorFormat                          = Tag{0x0028, 0x0710} // US 1
+	TagACR_NEMA_2C_ZonalMapNumberFormat                               = Tag{0x0028, 0x0720} // US 1
+	TagACR_NEMA_2C_ZonalMapLocation                                   = Tag{0x0028, 0x0721} // AT 1-n
+	TagACR_NEMA_2C_ZonalMapFormat                                     = Tag{0x0028, 0x0722} // US 1
+	TagACR_NEMA_2C_AdaptiveMapFormat                                  = Tag{0x0028, 0x0730} // US 1
+	TagACR_NEMA_2C_CodeNumberFormat                                   = Tag{0x0028, 0x0740} // US 1
+	TagACR_NEMA_2C_CodeLabel                                          = Tag{0x0028, 0x0800} // CS 1-n
+	TagACR_NEMA_2C_NumberOfTables                                     = Tag{0x0028, 0x0802} // US 1
+	TagACR_NEMA_2C_CodeTableLocation                                  = Tag{0x0028, 0x0803} // AT 1-n
+	TagACR_NEMA_2C_BitsForCodeWord                                    = Tag{0x0028, 0x0804} // US 1
+	TagACR_NEMA_2C_ImageDataLocation                                  = Tag{0x0028, 0x0808} // AT 1-n
+	TagACR_NEMA_2C_CodeTableGroupLength                               = Tag{0x1000, 0x0000} // UL 1
+	TagACR_NEMA_2C_EscapeTriplet                                      = Tag{0x1000, 0x0010} // US 3
+	TagACR_NEMA_2C_RunLengthTriplet                                   = Tag{0x1000, 0x0011} // US 3
+	TagACR_NEMA_2C_HuffmanTableSize                                   = Tag{0x1000, 0x0012} // US 1
+	TagACR_NEMA_2C_HuffmanTableTriplet                                = Tag{0x1000, 0x0013} // US 3
+	TagACR_NEMA_2C_ShiftTableSize                                     = Tag{0x1000, 0x0014} // US 1
+	TagACR_NEMA_2C_ShiftTableTriplet                                  = Tag{0x1000, 0x0015} // US 3
+	TagACR_NEMA_2C_ZonalMapGroupLength                                = Tag{0x1010, 0x0000} // UL 1
+	TagACR_NEMA_2C_ZonalMap                                           = Tag{0x1010, 0x0004} // US 1-n
+	TagACR_NEMA_2C_CoefficientsSDVN                                   = Tag{0x7FE0, 0x0020} // OW 1-n
+	TagACR_NEMA_2C_CoefficientsSDHN                                   = Tag{0x7FE0, 0x0030} // OW 1-n
+	TagACR_NEMA_2C_CoefficientsSDDN                                   = Tag{0x7FE0, 0x0040} // OW 1-n
+	TagCommandLengthToEnd                                             = Tag{0x0000, 0x0001} // UL 1
+	TagCommandRecognitionCode                                         = Tag{0x0000, 0x0010} // SH 1
+	TagInitiator                                                      = Tag{0x0000, 0x0200} // AE 1
+	TagReceiver                                                       = Tag{0x0000, 0x0300} // AE 1
+	TagFindLocation                                                   = Tag{0x0000, 0x0400} // AE 1
+	TagNumberOfMatches                                                = Tag{0x0000, 0x0850} // US 1
+	TagResponseSequenceNumber                                         = Tag{0x0000, 0x0860} // US 1
+	TagDialogReceiver                                                 = Tag{0x0000, 0x4000} // AT 1
+	TagTerminalType                                                   = Tag{0x0000, 0x4010} // AT 1
+	TagMessageSetID                                                   = Tag{0x0000, 0x5010} // SH 1
+	TagEndMessageID                                                   = Tag{0x0000, 0x5020} // SH 1
+	TagDisplayFormat                                                  = Tag{0x0000, 0x5110} // AT 1
+	TagPagePositionID                                                 = Tag{0x0000, 0x5120} // AT 1
+	TagTextFormatID                                                   = Tag{0x0000, 0x5130} // CS 1
+	TagNormalReverse                                                  = Tag{0x0000, 0x5140} // CS 1
+	TagAddGrayScale                                                   = Tag{0x0000, 0x5150} // CS 1
+	TagBorders                                                        = Tag{0x0000, 0x5160} // CS 1
+	TagCopies                                                         = Tag{0x0000, 0x5170} // IS 1
+	TagCommandMagnificationType                                       = Tag{0x0000, 0x5180} // CS 1
+	TagErase                                                          = Tag{0x0000, 0x5190} // CS 1
+	TagPrint                                                          = Tag{0x0000, 0x51A0} // CS 1
+	TagOverlays                                                       = Tag{0x0000, 0x51B0} // US 1-n
+	TagMRDRDirectoryRecordOffset                                      = Tag{0x0004, 0x1504} // UP 1
+	TagNumberOfReferences                                             = Tag{0x0004, 0x1600} // UL 1
+	TagLengthToEnd                                                    = Tag{0x0008, 0x0001} // UL 1
+	TagRecognitionCode                                                = Tag{0x0008, 0x0010} // SH 1
+	TagOverlayDate                                                    = Tag{0x0008, 0x0024} // DA 1
+	TagCurveDate                                                      = Tag{0x0008, 0x0025} // DA 1
+	TagOverlayTime                                                    = Tag{0x0008, 0x0034} // TM 1
+	TagCurveTime                                                      = Tag{0x0008, 0x0035} // TM 1
+	TagDataSetType                                                    = Tag{0x0008, 0x0040} // US 1
+	TagDataSetSubtype                                                 = Tag{0x0008, 0x0041} // LO 1
+	TagNuclearMedicineSeriesType                                      = Tag{0x0008, 0x0042} // CS 1
+	TagNetworkID                                                      = Tag{0x0008, 0x1000} // AE 1
+	TagReferencedResultsSequence                                      = Tag{0x0008, 0x1100} // SQ 1
+	TagReferencedOverlaySequence                                      = Tag{0x0008, 0x1130} // SQ 1
+	TagReferencedCurveSequence                                        = Tag{0x0008, 0x1145} // SQ 1
+	TagLossyImageCompressionRetired                                   = Tag{0x0008, 0x2110} // CS 1
+	TagTransducerPosition                                             = Tag{0x0008, 0x2200} // CS 1
+	TagTransducerOrientation                                          = Tag{0x0008, 0x2204} // CS 1
+	TagAnatomicStructure                                              = Tag{0x0008, 0x2208} // CS 1
+	TagTransducerPositionSequence                                     = Tag{0x0008, 0x2240} // SQ 1
+	TagTransducerPositionModifierSequence                             = Tag{0x0008, 0x2242} // SQ 1
+	TagTransducerOrientationSequence                                  = Tag{0x0008, 0x2244} // SQ 1
+	TagTransducerOrientationModifierSequence                          = Tag{0x0008, 0x2246} // SQ 1
+	TagAnatomicStructureSpaceOrRegionCodeSequenceTrial                = Tag{0x0008, 0x2251} // SQ 1
+	TagAnatomicPortalOfEntranceCodeSequenceTrial                      = Tag{0x0008, 0x2253} // SQ 1
+	TagAnatomicApproachDirectionCodeSequenceTrial                     = Tag{0x0008, 0x2255} // SQ 1
+	TagAnatomicPerspectiveDescriptionTrial                            = Tag{0x0008, 0x2256} // ST 1
+	TagAnatomicPerspectiveCodeSequenceTrial                           = Tag{0x0008, 0x2257} // SQ 1
+	TagAnatomicLocationOfExaminingInstrumentDescriptionTrial          = Tag{0x0008, 0x2258} // ST 1
+	TagAnatomicLocationOfExaminingInstrumentCodeSequenceTrial         = Tag{0x0008, 0x2259} // SQ 1
+	TagAnatomicStructureSpaceOrRegionModifierCodeSequenceTrial        = Tag{0x0008, 0x225A} // SQ 1
+	TagOnAxisBackgroundAnatomicStructureCodeSequenceTrial             = Tag{0x0008, 0x225C} // SQ 1
+	TagIdentifyingComments                                            = Tag{0x0008, 0x4000} // LT 1
+	TagInsurancePlanIdentification                                    = Tag{0x0010, 0x1050} // LO 1-n
+	TagRadionuclide                                                   = Tag{0x0018, 0x0030} // LO 1-n
+	TagEnergyWindowCenterline                                         = Tag{0x0018, 0x0032} // DS 1
+	TagEnergyWindowTotalWidth                                         = Tag{0x0018, 0x0033} // DS 1-n
+	TagTherapyType                                                    = Tag{0x0018, 0x0037} // CS 1
+	TagTherapyDescription                                             = Tag{0x0018, 0x0039} // CS 1
+	TagHardcopyCreationDeviceID                                       = Tag{0x0018, 0x1011} // LO 1
+	TagHardcopyDeviceManufacturer                                     = Tag{0x0018, 0x1017} // LO 1
+	TagHardcopyDeviceSoftwareVersion                                  = Tag{0x0018, 0x101A} // LO 1-n
+	TagHardcopyDeviceManufacturerModelName                            = Tag{0x0018, 0x101B} // LO 1
+	TagAngularPosition                                                = Tag{0x0018, 0x1141} // DS 1
+	TagRotationOffset                                                 = Tag{0x0018, 0x1146} // DS 1-n
+	TagUpperLowerPixelValues                                          = Tag{0x0018, 0x1240} // IS 1-n
+	TagAcquisitionComments                                            = Tag{0x0018, 0x4000} // LT 1
+	TagPostprocessingFunction                                         = Tag{0x0018, 0x5021} // LO 1
+	TagDynamicRange                                                   = Tag{0x0018, 0x5030} // DS 1
+	TagTotalGain                                                      = Tag{0x0018, 0x5040} // DS 1
+	TagImageTransformationMatrix                                      = Tag{0x0018, 0x5210} // DS 6
+	TagImageTranslationVector                                         = Tag{0x0018, 0x5212} // DS 3
+	TagDopplerSampleVolumeXPositionRetired                            = Tag{0x0018, 0x6038} // UL 1
+	TagDopplerSampleVolumeYPositionRetired                            = Tag{0x0018, 0x603A} // UL 1
+	TagTMLinePositionX0Retired                                        = Tag{0x0018, 0x603C} // UL 1
+	TagTMLinePositionY0Retired                                        = Tag{0x0018, 0x603E} // UL 1
+	TagTMLinePositionX1Retired                                        = Tag{0x0018, 0x6040} // UL 1
+	TagTMLinePositionY1Retired                                        = Tag{0x0018, 0x6042} // UL 1
+	TagParallelReductionFactorInPlaneRetired                          = Tag{0x0018, 0x9096} // FD 1
+	TagBulkMotionStatus                                               = Tag{0x0018, 0x9166} // CS 1
+	TagChemicalShiftMinimumIntegrationLimitInHz                       = Tag{0x0018, 0x9195} // FD 1
+	TagChemicalShiftMaximumIntegrationLimitInHz                       = Tag{0x0018, 0x9196} // FD 1
+	TagIsotopeNumber                                                  = Tag{0x0020, 0x0014} // IS 1
+	TagPhaseNumber                                                    = Tag{0x0020, 0x0015} // IS 1
+	TagIntervalNumber                                                 = Tag{0x0020, 0x0016} // IS 1
+	TagTimeSlotNumber                                                 = Tag{0x0020, 0x0017} // IS 1
+	TagAngleNumber                                                    = Tag{0x0020, 0x0018} // IS 1
+	TagOverlayNumber                                                  = Tag{0x0020, 0x0022} // IS 1
+	TagCurveNumber                                                    = Tag{0x0020, 0x0024} // IS 1
+	TagLUTNumber                                                      = Tag{0x0020, 0x0026} // IS 1
+	TagImagePosition                                                  = Tag{0x0020, 0x0030} // DS 3
+	TagImageOrientation                                               = Tag{0x0020, 0x0035} // DS 6
+	TagLocation                                                       = Tag{0x0020, 0x0050} // DS 1
+	TagImageGeometryType                                              = Tag{0x0020, 0x0070} // LO 1
+	TagMaskingImage                                                   = Tag{0x0020, 0x0080} // CS 1-n
+	TagReportNumber                                                   = Tag{0x0020, 0x00AA} // IS 1
+	TagSeriesInStudy                                                  = Tag{0x0020, 0x1000} // IS 1
+	TagAcquisitionsInSeries                                           = Tag{0x0020, 0x1001} // IS 1
+	TagImagesInSeries                                                 = Tag{0x0020, 0x1003} // IS 1
+	TagAcquisitionsInStudy                                            = Tag{0x0020, 0x1004} // IS 1
+	TagImagesInStudy                                                  = Tag{0x0020, 0x1005} // IS 1
+	TagReference                                                      = Tag{0x0020, 0x1020} // LO 1-n
+	TagOtherStudyNumbers                                              = Tag{0x0020, 0x1070} // IS 1-n
+	TagModifyingDeviceID                                              = Tag{0x0020, 0x3401} // CS 1
+	TagModifiedImageID                                                = Tag{0x0020, 0x3402} // CS 1
+	TagModifiedImageDate                                              = Tag{0x0020, 0x3403} // DA 1
+	TagModifyingDeviceManufacturer                                    = Tag{0x0020, 0x3404} // LO 1
+	TagModifiedImageTime                                              = Tag{0x0020, 0x3405} // TM 1
+	TagModifiedImageDescription                                       = Tag{0x0020, 0x3406} // LO 1
+	TagOriginalImageIdentification                                    = Tag{0x0020, 0x5000} // AT 1-n
+	TagOriginalImageIdentificationNomenclature                        = Tag{0x0020, 0x5002} // LO 1-n
+	TagImageDimensions                                                = Tag{0x0028, 0x0005} // US 1
+	TagPlanes                                                         = Tag{0x0028, 0x0012} // US 1
+	TagImageFormat                                                    = Tag{0x0028, 0x0040} // CS 1
+	TagManipulatedImage                                               = Tag{0x0028, 0x0050} // LO 1-n
+	TagCompressionRecognitionCode                                     = Tag{0x0028, 0x005F} // LO 1
+	TagCompressionCode                                                = Tag{0x0028, 0x0060} // CS 1
+	TagCompressionOriginator                                          = Tag{0x0028, 0x0061} // SH 1
+	TagCompressionLabel                                               = Tag{0x0028, 0x0062} // LO 1
+	TagCompressionDescription                                         = Tag{0x0028, 0x0063} // SH 1
+	TagCompressionSequence                                            = Tag{0x0028, 0x0065} // CS 1-n
+	TagCompressionStepPointers                                        = Tag{0x0028, 0x0066} // AT 1-n
+	TagRepeatInterval                                                 = Tag{0x0028, 0x0068} // US 1
+	TagBitsGrouped                                                    = Tag{0x0028, 0x0069} // US 1
+	TagPerimeterTable                                                 = Tag{0x0028, 0x0070} // US 1-n
+	TagPerimeterValue                                                 = Tag{0x0028, 0x0071} // XS 1
+	TagPredictorRows                                                  = Tag{0x0028, 0x0080} // US 1
+	TagPredictorColumns                                               = Tag{0x0028, 0x0081} // US 1
+	TagPredictorConstants                                             = Tag{0x0028, 0x0082} // US 1-n
+	TagBlockedPixels                                                  = Tag{0x0028, 0x0090} // CS 1
+	TagBlockRows                                                      = Tag{0x0028, 0x0091} // US 1
+	TagBlockColumns                                                   = Tag{0x0028, 0x0092} // US 1
+	TagRowOverlap                                                     = Tag{0x0028, 0x0093} // US 1
+	TagColumnOverlap                                                  = Tag{0x0028, 0x0094} // US 1
+	TagSmallestValidPixelValue                                        = Tag{0x0028, 0x0104} // XS 1
+	TagLargestValidPixelValue                                         = Tag{0x0028, 0x0105} // XS 1
+	TagSmallestImagePixelValueInPlane                                 = Tag{0x0028, 0x0110} // XS 1
+	TagLargestImagePixelValueInPlane                                  = Tag{0x0028, 0x0111} // XS 1
+	TagImageLocation                                                  = Tag{0x0028, 0x0200} // US 1
+	TagTransformLabel                                                 = Tag{0x0028, 0x0400} // LO 1
+	TagTransformVersionNumber                                         = Tag{0x0028, 0x0401} // LO 1
+	TagNumberOfTransformSteps                                         = Tag{0x0028, 0x0402} // US 1
+	TagSequenceOfCompressedData                                       = Tag{0x0028, 0x0403} // LO 1-n
+	TagDetailsOfCoefficients                                          = Tag{0x0028, 0x0404} // AT 1-n
+	TagDCTLabel                                                       = Tag{0x0028, 0x0700} // LO 1
+	TagDataBlockDescription                                           = Tag{0x0028, 0x0701} // CS 1-n
+	TagDataBlock                                                      = Tag{0x0028, 0x0702} // AT 1-n
+	TagNormalizationFactorFormat                                      = Tag{0x0028, 0x0710} // US 1
+	TagZonalMapNumberFormat                                           = Tag{0x0028, 0x0720} // US 1
+	TagZonalMapLocation                                               = Tag{0x0028, 0x0721} // AT 1-n
+	TagZonalMapFormat                                                 = Tag{0x0028, 0x0722} // US 1
+	TagAdaptiveMapFormat                                              = Tag{0x0028, 0x0730} // US 1
+	TagCodeNumberFormat                                               = Tag{0x0028, 0x0740} // US 1
+	TagGrayScale                                                      = Tag{0x0028, 0x1080} // CS 1
+	TagGrayLookupTableDescriptor                                      = Tag{0x0028, 0x1100} // XS 3
+	TagLargeRedPaletteColorLookupTableDescriptor                      = Tag{0x0028, 0x1111} // XS 4
+	TagLargeGreenPaletteColorLookupTableDescriptor                    = Tag{0x0028, 0x1112} // XS 4
+	TagLargeBluePaletteColorLookupTableDescriptor                     = Tag{0x0028, 0x1113} // XS 4
+	TagGrayLookupTableData                                            = Tag{0x0028, 0x1200} // LT 1-n
+	TagLargeRedPaletteColorLookupTableData                            = Tag{0x0028, 0x1211} // OW 1
+	TagLargeGreenPaletteColorLookupTableData                          = Tag{0x0028, 0x1212} // OW 1
+	TagLargeBluePaletteColorLookupTableData                           = Tag{0x0028, 0x1213} // OW 1
+	TagLargePaletteColorLookupTableUID                                = Tag{0x0028, 0x1214} // UI 1
+	TagImagePresentationComments                                      = Tag{0x0028, 0x4000} // LT 1
+	TagBiPlaneAcquisitionSequence                                     = Tag{0x0028, 0x5000} // SQ 1
+	TagMaskPointers                                                   = Tag{0x0028, 0x6030} // US 1-n
+	TagLargestMonochromePixelValue                                    = Tag{0x0028, 0x9099} // US 1
+	TagStudyStatusID                                                  = Tag{0x0032, 0x000A} // CS 1
+	TagStudyPriorityID                                                = Tag{0x0032, 0x000C} // CS 1
+	TagStudyIDIssuer                                                  = Tag{0x0032, 0x0012} // LO 1
+	TagStudyVerifiedDate                                              = Tag{0x0032, 0x0032} // DA 1
+	TagStudyVerifiedTime                                              = Tag{0x0032, 0x0033} // TM 1
+	TagStudyReadDate                                                  = Tag{0x0032, 0x0034} // DA 1
+	TagStudyReadTime                                                  = Tag{0x0032, 0x0035} // TM 1
+	TagScheduledStudyStartDate                                        = Tag{0x0032, 0x1000} // DA 1
+	TagScheduledStudyStartTime                                        = Tag{0x0032, 0x1001} // TM 1
+	TagScheduledStudyStopDate                                         = Tag{0x0032, 0x1010} // DA 1
+	TagScheduledStudyStopTime                                         = Tag{0x0032, 0x1011} // TM 1
+	TagScheduledStudyLocation                                         = Tag{0x0032, 0x1020} // LO 1
+	TagScheduledStudyLocationAETitle                                  = Tag{0x0032, 0x1021} // AE 1-n
+	TagReasonForStudy                                                 = Tag{0x0032, 0x1030} // LO 1
+	TagStudyArrivalDate                                               = Tag{0x0032, 0x1040} // DA 1
+	TagStudyArrivalTime                                               = Tag{0x0032, 0x1041} // TM 1
+	TagStudyCompletionDate                                            = Tag{0x0032, 0x1050} // DA 1
+	TagStudyCompletionTime                                            = Tag{0x0032, 0x1051} // TM 1
+	TagStudyComponentStatusID                                         = Tag{0x0032, 0x1055} // CS 1
+	TagStudyComments                                                  = Tag{0x0032, 0x4000} // LT 1
+	TagIssuerOfAdmissionID                                            = Tag{0x0038, 0x0011} // LO 1
+	TagScheduledAdmissionDate                                         = Tag{0x0038, 0x001A} // DA 1
+	TagScheduledAdmissionTime                                         = Tag{0x0038, 0x001B} // TM 1
+	TagScheduledDischargeDate                                         = Tag{0x0038, 0x001C} // DA 1
+	TagScheduledDischargeTime                                         = Tag{0x0038, 0x001D} // TM 1
+	TagScheduledPatientInstitutionResidence                           = Tag{0x0038, 0x001E} // LO 1
+	TagDischargeDate                                                  = Tag{0x0038, 0x0030} // DA 1
+	TagDischargeTime                                                  = Tag{0x0038, 0x0032} // TM 1
+	TagDischargeDiagnosisDescription                                  = Tag{0x0038, 0x0040} // LO 1
+	TagDischargeDiagnosisCodeSequence                                 = Tag{0x0038, 0x0044} // SQ 1
+	TagIssuerOfServiceEpisodeID                                       = Tag{0x0038, 0x0061} // LO 1
+	TagDistanceSourceToSupport                                        = Tag{0x0040, 0x0307} // DS 1
+	TagReferencedProcedureStepSequence                                = Tag{0x0040, 0x0330} // SQ 1
+	TagSpecimenAccessionNumber                                        = Tag{0x0040, 0x050A} // LO 1
+	TagSpecimenSequence                                               = Tag{0x0040, 0x0550} // SQ 1
+	TagSpecimenDescriptionSequenceTrial                               = Tag{0x0040, 0x0552} // SQ 1
+	TagSpecimenDescriptionTrial                                       = Tag{0x0040, 0x0553} // ST 1
+	TagSlideIdentifier                                                = Tag{0x0040, 0x06FA} // LO 1
+	TagPixelSpacingSequence                                           = Tag{0x0040, 0x08D8} // SQ 1
+	TagCoordinateSystemAxisCodeSequence                               = Tag{0x0040, 0x08DA} // SQ 1
+	TagVitalStainCodeSequenceTrial                                    = Tag{0x0040, 0x09F8} // SQ 1
+	TagPlacerOrderNumberProcedure                                     = Tag{0x0040, 0x1006} // SH 1
+	TagFillerOrderNumberProcedure                                     = Tag{0x0040, 0x1007} // SH 1
+	TagRequestedProcedureDescriptionTrial                             = Tag{0x0040, 0x1060} // LO 1
+	TagReasonForTheImagingServiceRequest                              = Tag{0x0040, 0x2001} // LO 1
+	TagPlacerOrderNumberImagingServiceRequestRetired                  = Tag{0x0040, 0x2006} // SH 1
+	TagFillerOrderNumberImagingServiceRequestRetired                  = Tag{0x0040, 0x2007} // SH 1
+	TagFindingsFlagTrial                                              = Tag{0x0040, 0xA007} // CS 1
+	TagFindingsSequenceTrial                                          = Tag{0x0040, 0xA020} // SQ 1
+	TagFindingsGroupUIDTrial                                          = Tag{0x0040, 0xA021} // UI 1
+	TagReferencedFindingsGroupUIDTrial                                = Tag{0x0040, 0xA022} // UI 1
+	TagFindingsGroupRecordingDateTrial                                = Tag{0x0040, 0xA023} // DA 1
+	TagFindingsGroupRecordingTimeTrial                                = Tag{0x0040, 0xA024} // TM 1
+	TagFindingsSourceCategoryCodeSequenceTrial                        = Tag{0x0040, 0xA026} // SQ 1
+	TagDocumentingOrganizationIdentifierCodeSequenceTrial             = Tag{0x0040, 0xA028} // SQ 1
+	TagMeasurementPrecisionDescriptionTrial                           = Tag{0x0040, 0xA047} // LO 1
+	TagUrgencyOrPriorityAlertsTrial                                   = Tag{0x0040, 0xA057} // CS 1-n
+	TagSequencingIndicatorTrial                                       = Tag{0x0040, 0xA060} // LO 1
+	TagDocumentIdentifierCodeSequenceTrial                            = Tag{0x0040, 0xA066} // SQ 1
+	TagDocumentAuthorTrial                                            = Tag{0x0040, 0xA067} // PN 1
+	TagDocumentAuthorIdentifierCodeSequenceTrial                      = Tag{0x0040, 0xA068} // SQ 1
+	TagIdentifierCodeSequenceTrial                                    = Tag{0x0040, 0xA070} // SQ 1
+	TagObjectBinaryIdentifierTrial                                    = Tag{0x0040, 0xA074} // OB 1
+	TagDocumentingObserverIdentifierCodeSequenceTrial                 = Tag{0x0040, 0xA076} // SQ 1
+	TagProcedureIdentifierCodeSequenceTrial                           = Tag{0x0040, 0xA085} // SQ 1
+	TagObjectDirectoryBinaryIdentifierTrial                           = Tag{0x0040, 0xA089} // OB 1
+	TagEquivalentCDADocumentSequence                                  = Tag{0x0040, 0xA090} // SQ 1
+	TagDateOfDocumentOrVerbalTransactionTrial                         = Tag{0x0040, 0xA110} // DA 1
+	TagTimeOfDocumentCreationOrVerbalTransactionTrial                 = Tag{0x0040, 0xA112} // TM 1
+	TagReportStatusIDTrial                                            = Tag{0x0040, 0xA125} // CS 2
+	TagObservationCategoryCodeSequenceTrial                           = Tag{0x0040, 0xA167} // SQ 1
+	TagBibliographicCitationTrial                                     = Tag{0x0040, 0xA16A} // ST 1
+	TagReferencedObservationUIDTrial                                  = Tag{0x0040, 0xA172} // UI 1
+	TagReferencedObservationClassTrial                                = Tag{0x0040, 0xA173} // CS 1
+	TagReferencedObjectObservationClassTrial                          = Tag{0x0040, 0xA174} // CS 1
+	TagObservationDateTrial                                           = Tag{0x0040, 0xA192} // DA 1
+	TagObservationTimeTrial                                           = Tag{0x0040, 0xA193} // TM 1
+	TagMeasurementAutomationTrial                                     = Tag{0x0040, 0xA194} // CS 1
+	TagIdentificationDescriptionTrial                                 = Tag{0x0040, 0xA224} // ST 1
+	TagCoordinatesSetGeometricTypeTrial                               = Tag{0x0040, 0xA290} // CS 1
+	TagAlgorithmCodeSequenceTrial                                     = Tag{0x0040, 0xA296} // SQ 1
+	TagAlgorithmDescriptionTrial                                      = Tag{0x0040, 0xA297} // ST 1
+	TagPixelCoordinatesSetTrial                                       = Tag{0x0040, 0xA29A} // SL 2-2n
+	TagCurrentObserverTrial                                           = Tag{0x0040, 0xA307} // PN 1
+	TagReferencedAccessionSequenceTrial                               = Tag{0x0040, 0xA313} // SQ 1
+	TagReportStatusCommentTrial                                       = Tag{0x0040, 0xA33A} // ST 1
+	TagProcedureContextSequenceTrial                                  = Tag{0x0040, 0xA340} // SQ 1
+	TagVerbalSourceTrial                                              = Tag{0x0040, 0xA352} // PN 1
+	TagAddressTrial                                                   = Tag{0x0040, 0xA353} // ST 1
+	TagTelephoneNumberTrial                                           = Tag{0x0040, 0xA354} // LO 1
+	TagVerbalSourceIdentifierCodeSequenceTrial                        = Tag{0x0040, 0xA358} // SQ 1
+	TagReportDetailSequenceTrial                                      = Tag{0x0040, 0xA380} // SQ 1
+	TagObservationSubjectUIDTrial                                     = Tag{0x0040, 0xA402} // UI 1
+	TagObservationSubjectClassTrial                                   = Tag{0x0040, 0xA403} // CS 1
+	TagObservationSubjectTypeCodeSequenceTrial                        = Tag{0x0040, 0xA404} // SQ 1
+	TagObservationSubjectContextFlagTrial                             = Tag{0x0040, 0xA600} // CS 1
+	TagObserverContextFlagTrial                                       = Tag{0x0040, 0xA601} // CS 1
+	TagProcedureContextFlagTrial                                      = Tag{0x0040, 0xA603} // CS 1
+	TagRelationshipSequenceTrial                                      = Tag{0x0040, 0xA731} // SQ 1
+	TagRelationshipTypeCodeSequenceTrial                              = Tag{0x0040, 0xA732} // SQ 1
+	TagLanguageCodeSequenceTrial                                      = Tag{0x0040, 0xA744} // SQ 1
+	TagUniformResourceLocatorTrial                                    = Tag{0x0040, 0xA992} // ST 1
+	TagTemplateVersion                                                = Tag{0x0040, 0xDB06} // DT 1
+	TagTemplateLocalVersion                                           = Tag{0x0040, 0xDB07} // DT 1
+	TagTemplateExtensionFlag                                          = Tag{0x0040, 0xDB0B} // CS 1
+	TagTemplateExtensionOrganizationUID                               = Tag{0x0040, 0xDB0C} // UI 1
+	TagTemplateExtensionCreatorUID                                    = Tag{0x0040, 0xDB0D} // UI 1
+	TagCountsIncluded                                                 = Tag{0x0054, 0x1400} // CS 1-n
+	TagDeadTimeCorrectionFlag                                         = Tag{0x0054, 0x1401} // CS 1
+	TagImageRotationRetired                                           = Tag{0x0070, 0x0040} // IS 1
+	TagDisplayedAreaTopLeftHandCornerTrial                            = Tag{0x0070, 0x0050} // US 2
+	TagDisplayedAreaBottomRightHandCornerTrial                        = Tag{0x0070, 0x0051} // US 2
+	TagGraphicLayerRecommendedDisplayRGBValue                         = Tag{0x0070, 0x0067} // US 3
+	TagBeamOrderIndexTrial                                            = Tag{0x0074, 0x1024} // IS 1
+	TagDoubleExposureMetersetTrial                                    = Tag{0x0074, 0x1038} // DS 1
+	TagDoubleExposureFieldDeltaTrial                                  = Tag{0x0074, 0x103A} // DS 4
+	TagRelatedProcedureStepSequence                                   = Tag{0x0074, 0x1220} // SQ 1
+	TagProcedureStepRelationshipType                                  = Tag{0x0074, 0x1222} // LO 1
+	TagTopicTitle                                                     = Tag{0x0088, 0x0904} // LO 1
+	TagTopicSubject                                                   = Tag{0x0088, 0x0906} // ST 1
+	TagTopicAuthor                                                    = Tag{0x0088, 0x0910} // LO 1
+	TagTopicKeywords                                                  = Tag{0x0088, 0x0912} // LO 1-32
+	TagColorImagePrintingFlag                                         = Tag{0x2000, 0x0062} // CS 1
+	TagCollationFlag                                                  = Tag{0x2000, 0x0063} // CS 1
+	TagAnnotationFlag                                                 = Tag{0x2000, 0x0065} // CS 1
+	TagImageOverlayFlag                                               = Tag{0x2000, 0x0067} // CS 1
+	TagPresentationLUTFlag                                            = Tag{0x2000, 0x0069} // CS 1
+	TagImageBoxPresentationLUTFlag                                    = Tag{0x2000, 0x006A} // CS 1
+	TagReferencedStoredPrintSequence                                  = Tag{0x2000, 0x0510} // SQ 1
+	TagReferencedImageOverlayBoxSequence                              = Tag{0x2020, 0x0130} // SQ 1
+	TagReferencedVOILUTBoxSequence                                    = Tag{0x2020, 0x0140} // SQ 1
+	TagReferencedOverlayPlaneSequence                                 = Tag{0x2040, 0x0010} // SQ 1
+	TagReferencedOverlayPlaneGroups                                   = Tag{0x2040, 0x0011} // US 1-99
+	TagOverlayPixelDataSequence                                       = Tag{0x2040, 0x0020} // SQ 1
+	TagOverlayMagnificationType                                       = Tag{0x2040, 0x0060} // CS 1
+	TagOverlaySmoothingType                                           = Tag{0x2040, 0x0070} // CS 1
+	TagOverlayOrImageMagnification                                    = Tag{0x2040, 0x0072} // CS 1
+	TagMagnifyToNumberOfColumns                                       = Tag{0x2040, 0x0074} // US 1
+	TagOverlayForegroundDensity                                       = Tag{0x2040, 0x0080} // CS 1
+	TagOverlayBackgroundDensity                                       = Tag{0x2040, 0x0082} // CS 1
+	TagOverlayMode                                                    = Tag{0x2040, 0x0090} // CS 1
+	TagThresholdDensity                                               = Tag{0x2040, 0x0100} // CS 1
+	TagReferencedImageBoxSequenceRetired                              = Tag{0x2040, 0x0500} // SQ 1
+	TagPrintJobID                                                     = Tag{0x2100, 0x0010} // SH 1
+	TagDestinationAE                                                  = Tag{0x2100, 0x0140} // AE 1
+	TagReferencedPrintJobSequencePullStoredPrint                      = Tag{0x2100, 0x0500} // SQ 1
+	TagPrintQueueID                                                   = Tag{0x2110, 0x0099} // SH 1
+	TagQueueStatus                                                    = Tag{0x2120, 0x0010} // CS 1
+	TagPrintJobDescriptionSequence                                    = Tag{0x2120, 0x0050} // SQ 1
+	TagReferencedPrintJobSequence                                     = Tag{0x2120, 0x0070} // SQ 1
+	TagPrintManagementCapabilitiesSequence                            = Tag{0x2130, 0x0010} // SQ 1
+	TagPrinterCharacteristicsSequence                                 = Tag{0x2130, 0x0015} // SQ 1
+	TagFilmBoxContentSequence                                         = Tag{0x2130, 0x0030} // SQ 1
+	TagImageBoxContentSequence                                        = Tag{0x2130, 0x0040} // SQ 1
+	TagAnnotationContentSequence                                      = Tag{0x2130, 0x0050} // SQ 1
+	TagImageOverlayBoxContentSequence                                 = Tag{0x2130, 0x0060} // SQ 1
+	TagPresentationLUTContentSequence                                 = Tag{0x2130, 0x0080} // SQ 1
+	TagProposedStudySequence                                          = Tag{0x2130, 0x00A0} // SQ 1
+	TagOriginalImageSequence                                          = Tag{0x2130, 0x00C0} // SQ 1
+	TagArbitrary                                                      = Tag{0x4000, 0x0010} // LT 1
+	TagTextComments                                                   = Tag{0x4000, 0x4000} // LT 1
+	TagResultsID                                                      = Tag{0x4008, 0x0040} // SH 1
+	TagResultsIDIssuer                                                = Tag{0x4008, 0x0042} // LO 1
+	TagReferencedInterpretationSequence                               = Tag{0x4008, 0x0050} // SQ 1
+	TagReportProductionStatusTrial                                    = Tag{0x4008, 0x00FF} // CS 1
+	TagInterpretationRecordedDate                                     = Tag{0x4008, 0x0100} // DA 1
+	TagInterpretationRecordedTime                                     = Tag{0x4008, 0x0101} // TM 1
+	TagInterpretationRecorder                                         = Tag{0x4008, 0x0102} // PN 1
+	TagReferenceToRecordedSound                                       = Tag{0x4008, 0x0103} // LO 1
+	TagInterpretationTranscriptionDate                                = Tag{0x4008, 0x0108} // DA 1
+	TagInterpretationTranscriptionTime                                = Tag{0x4008, 0x0109} // TM 1
+	TagInterpretationTranscriber                                      = Tag{0x4008, 0x010A} // PN 1
+	TagInterpretationText                                             = Tag{0x4008, 0x010B} // ST 1
+	TagInterpretationAuthor                                           = Tag{0x4008, 0x010C} // PN 1
+	TagInterpretationApproverSequence                                 = Tag{0x4008, 0x0111} // SQ 1
+	TagInterpretationApprovalDate                                     = Tag{0x4008, 0x0112} // DA 1
+	TagInterpretationApprovalTime                                     = Tag{0x4008, 0x0113} // TM 1
+	TagPhysicianApprovingInterpretation                               = Tag{0x4008, 0x0114} // PN 1
+	TagInterpretationDiagnosisDescription                             = Tag{0x4008, 0x0115} // LT 1
+	TagInterpretationDiagnosisCodeSequence                            = Tag{0x4008, 0x0117} // SQ 1
+	TagResultsDistributionListSequence                                = Tag{0x4008, 0x0118} // SQ 1
+	TagDistributionName                                               = Tag{0x4008, 0x0119} // PN 1
+	TagDistributionAddress                                            = Tag{0x4008, 0x011A} // LO 1
+	TagInterpretationID                                               = Tag{0x4008, 0x0200} // SH 1
+	TagInterpretationIDIssuer                                         = Tag{0x4008, 0x0202} // LO 1
+	TagInterpretationTypeID                                           = Tag{0x4008, 0x0210} // CS 1
+	TagInterpretationStatusID                                         = Tag{0x4008, 0x0212} // CS 1
+	TagImpressions                                                    = Tag{0x4008, 0x0300} // ST 1
+	TagResultsComments                                                = Tag{0x4008, 0x4000} // ST 1
+	TagCoefficientsSDVN                                               = Tag{0x7FE0, 0x0020} // OW 1
+	TagCoefficientsSDHN                                               = Tag{0x7FE0, 0x0030} // OW 1
+	TagCoefficientsSDDN                                               = Tag{0x7FE0, 0x0040} // OW 1
+)
@@ -0,0 +1,41 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidUID = errors.New("Invalid UID")
+
+const maxUIDLength = 64
+
+// Check the syntax of a UID as defined in PS 3.5 9.1: at most 64
+// characters, made of numeric components separated by dots, without empty
+// components or components with a leading zero
+func ValidateUID(uid string) error {
+
+	if uid == "" {
+		return fmt.Errorf("%w: empty UID", ErrInvalidUID)
+	}
+
+	if len(uid) > maxUIDLength {
+		return fmt.Errorf("%w: %q is longer than %d characters", ErrInvalidUID, uid, maxUIDLength)
+	}
+
+	for _, c := range strings.Split(uid, ".") {
+		if c == "" {
+			return fmt.Errorf("%w: %q has an empty component", ErrInvalidUID, uid)
+		}
+		for _, r := range c {
+			if r < '0' || r > '9' {
+				return fmt.Errorf("%w: %q contains %q", ErrInvalidUID, uid, r)
+			}
+		}
+		if len(c) > 1 && c[0] == '0' {
+			return fmt.Errorf("%w: %q has a component with a leading zero", ErrInvalidUID, uid)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package dicom
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateUID(t *testing.T) {
+
+	valid := []string{
+		"1.2.840.10008.1.2",
+		"1.2.840.10008.5.1.4.1.1.2",
+		"1.3.12.2.1107.5.1.4.54023.30000005032916373504600004748",
+		"2.25.0",
+	}
+
+	for _, uid := range valid {
+		if err := ValidateUID(uid); err != nil {
+			t.Errorf("UID %s should be valid: %s", uid, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"1.2..3",
+		".1.2",
+		"1.2.",
+		"1.02.3",
+		"1.2.a",
+		"1.2.3 ",
+		"1." + strings.Repeat("2", 63),
+	}
+
+	for _, uid := range invalid {
+		if err := ValidateUID(uid); !errors.Is(err, ErrInvalidUID) {
+			t.Errorf("UID %q should be invalid, got %v", uid, err)
+		}
+	}
+
+}
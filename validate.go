@@ -0,0 +1,100 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+)
+
+// A ConformanceError reports an element that violates the standard, ie. with
+// an odd Value Length or, in strict mode, a number of values that does not
+// match its VM. The underlying error is available through errors.Is and
+// errors.As.
+type ConformanceError struct {
+	Tag Tag
+	P   uint32 // position of the element in the file
+	Err error
+}
+
+func (e *ConformanceError) Error() string {
+	return fmt.Sprintf("%08d %s: %s", e.P, e.Tag, e.Err)
+}
+
+func (e *ConformanceError) Unwrap() error {
+	return e.Err
+}
+
+// Enables strict mode: the problems recorded as warnings, ie. elements whose
+// number of values does not match their VM, that hold malformed UIDs or URIs,
+// or values too long or with characters not allowed by their VR, are errors
+// while parsing
+func Strict() func(*Parser) error {
+	return func(p *Parser) error {
+		p.strict = true
+		return nil
+	}
+}
+
+// Check an element for problems that are errors in strict mode, and warnings
+// otherwise
+func (p *Parser) validate(elem *DicomElement) error {
+
+	if err := p.validateVM(elem); err != nil {
+		return err
+	}
+
+	if elem.Vr == "UR" && elem.Len() == 1 {
+		if err := validateURI(elem.MustGetString()); err != nil {
+			return err
+		}
+	}
+
+	if values, ok := elem.Value.(Strings); ok && elem.Vr != "UI" && elem.Vr != "UR" {
+		for _, value := range values {
+			if err := ValidateValue(elem.Vr, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return validateUIDs(elem)
+}
+
+// Check the values of a UI element against the VR and the UID syntax
+func validateUIDs(elem *DicomElement) error {
+
+	if elem.Vr != "UI" {
+		return nil
+	}
+
+	uids, err := elem.GetStrings()
+	if err != nil {
+		return err
+	}
+	for _, uid := range uids {
+		if err := ValidateValue("UI", uid); err != nil {
+			return err
+		}
+		if err := ValidateUID(uid); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Check that the type of the value of every element, at any depth, is the
+// one used for its VR, ie. to check a data set built or modified by hand
+// before writing it. Returns the mismatches, which match ErrWrongVR with
+// errors.Is, or nil.
+func (file *DicomFile) ValidateValueTypes() error {
+
+	var errs []error
+	file.Walk(func(path TagPath, elem *DicomElement) error {
+		if !valueMatchesVR(elem.Value, elem.Vr) {
+			errs = append(errs, fmt.Errorf("%w: %s %s holds %T", ErrWrongVR, path, elem.Vr, elem.Value))
+		}
+		return nil
+	})
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,39 @@
+package dicom
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestValidateValueTypes(t *testing.T) {
+
+	buff, err := os.ReadFile("examples/IM-0001-0001.dcm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, _ := NewParser()
+	file, err := p.Parse(buff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := file.ValidateValueTypes(); err != nil {
+		t.Errorf("Unexpected error for a parsed file: %v", err)
+	}
+
+	file = &DicomFile{Elements: []DicomElement{
+		{Group: 0x0008, Element: 0x0060, Vr: "CS", Value: Strings{"CT"}},
+		{Group: 0x0008, Element: 0x1115, Vr: "SQ", Value: Sequence{
+			&Item{[]*DicomElement{{Group: 0x0028, Element: 0x0010, Vr: "US", Value: Strings{"512"}}}},
+		}},
+		{Group: 0x0028, Element: 0x0030, Vr: "DS", Value: Float64s{0.5, 0.5}},
+	}}
+	err = file.ValidateValueTypes()
+	if !errors.Is(err, ErrWrongVR) {
+		t.Fatalf("Incorrect error %v", err)
+	}
+	if s := err.Error(); !strings.Contains(s, "(0008,1115)[0].(0028,0010) US") || !strings.Contains(s, "(0028,0030) DS") || strings.Contains(s, "(0008,0060)") {
+		t.Errorf("Incorrect error %q", s)
+	}
+}
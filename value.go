@@ -0,0 +1,128 @@
+package dicom
+
+// The value of a data element. Values are stored in typed slices, one type
+// per kind of VR:
+//
+//	Strings    AE, AS, CS, DA, DS, DT, IS, LO, LT, PN, SH, ST, TM, UI, UT
+//	UInt16s    US, OW
+//	Int16s     SS
+//	UInt32s    UL
+//	Int32s     SL
+//	UInt64s    UV, OV
+//	Int64s     SV
+//	Float32s   FL
+//	Float64s   FD
+//	Bytes      OB, UN
+//	Tags       AT
+//	Sequence   SQ
+//	PixelData  encapsulated PixelData
+type Value interface {
+	// Number of values
+	Len() int
+
+	// the values as a plain slice, ie. []string for Strings
+	slice() interface{}
+}
+
+type (
+	Strings  []string
+	UInt16s  []uint16
+	Int16s   []int16
+	UInt32s  []uint32
+	Int32s   []int32
+	UInt64s  []uint64
+	Int64s   []int64
+	Float32s []float32
+	Float64s []float64
+	Bytes    []byte
+	Tags     []Tag
+)
+
+// Encapsulated pixel data (PS 3.5 A.4): the Basic Offset Table, read from
+// the first item, and the fragments of the following items
+type PixelData struct {
+	Offsets   []uint32
+	Fragments [][]byte
+}
+
+func (v Strings) Len() int  { return len(v) }
+func (v UInt16s) Len() int  { return len(v) }
+func (v Int16s) Len() int   { return len(v) }
+func (v UInt32s) Len() int  { return len(v) }
+func (v Int32s) Len() int   { return len(v) }
+func (v UInt64s) Len() int  { return len(v) }
+func (v Int64s) Len() int   { return len(v) }
+func (v Float32s) Len() int { return len(v) }
+func (v Float64s) Len() int { return len(v) }
+func (v Bytes) Len() int    { return len(v) }
+func (v Tags) Len() int     { return len(v) }
+func (v Sequence) Len() int { return len(v) }
+
+// Number of fragments
+func (v *PixelData) Len() int { return len(v.Fragments) }
+
+func (v Strings) slice() interface{}    { return []string(v) }
+func (v UInt16s) slice() interface{}    { return []uint16(v) }
+func (v Int16s) slice() interface{}     { return []int16(v) }
+func (v UInt32s) slice() interface{}    { return []uint32(v) }
+func (v Int32s) slice() interface{}     { return []int32(v) }
+func (v UInt64s) slice() interface{}    { return []uint64(v) }
+func (v Int64s) slice() interface{}     { return []int64(v) }
+func (v Float32s) slice() interface{}   { return []float32(v) }
+func (v Float64s) slice() interface{}   { return []float64(v) }
+func (v Bytes) slice() interface{}      { return []byte(v) }
+func (v Tags) slice() interface{}       { return []Tag(v) }
+func (v Sequence) slice() interface{}   { return []*Item(v) }
+func (v *PixelData) slice() interface{} { return v.Fragments }
+
+// Number of values of an element, 0 when it has no value
+func (e *DicomElement) Len() int {
+	if e.Value == nil {
+		return 0
+	}
+	return e.Value.Len()
+}
+
+// Reports whether a value has the type used for a VR. The ambiguous VRs of
+// the dictionary (OX, XS, UP) accept the types of the VRs they stand for.
+func valueMatchesVR(value Value, vr string) bool {
+
+	switch value.(type) {
+	case nil:
+		return true
+	case Strings:
+		return isStringVR(vr)
+	case UInt16s:
+		return vr == "US" || vr == "OW" || vr == "OX" || vr == "XS"
+	case Int16s:
+		return vr == "SS" || vr == "XS"
+	case UInt32s:
+		return vr == "UL" || vr == "OL" || vr == "UP"
+	case Int32s:
+		return vr == "SL"
+	case UInt64s:
+		return vr == "UV" || vr == "OV"
+	case Int64s:
+		return vr == "SV"
+	case Float32s:
+		return vr == "FL" || vr == "OF"
+	case Float64s:
+		return vr == "FD" || vr == "OD"
+	case Bytes:
+		return vr == "OB" || vr == "UN" || vr == "OX"
+	case Tags:
+		return vr == "AT"
+	case Sequence:
+		return vr == "SQ"
+	case *PixelData:
+		return vr == "OB" || vr == "OW" || vr == "OX"
+	}
+
+	return false
+}
+
+// VRs whose values are character strings (PS 3.5 6.2)
+func isStringVR(vr string) bool {
+	kind := GetVRKind(vr)
+	return kind == VRKindString || kind == VRKindText
+}
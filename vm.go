@@ -0,0 +1,158 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Value Multiplicity PS 3.5 6.4
+//
+// Min and Max are the bounds of a fixed VM such as "1-3". When N is set the
+// VM is open ended ("1-n", "2-2n") and the number of values must be at least
+// Min and a multiple of Max.
+type dcmVM struct {
+	s   string
+	Min uint8
+	Max uint8
+	N   bool
+}
+
+// A VMError reports an element whose number of values does not match the
+// Value Multiplicity of its dictionary entry
+type VMError struct {
+	Group   uint16
+	Element uint16
+	Name    string
+	VM      string
+	Count   int
+}
+
+func (e *VMError) Error() string {
+	return fmt.Sprintf("(%04X,%04X) %s: %d values do not match VM %s", e.Group, e.Element, e.Name, e.Count, e.VM)
+}
+
+var (
+	ErrWrongVR   = errors.New("Value does not match the VR of the element")
+	errInvalidVM = errors.New("Invalid Value Multiplicity")
+)
+
+// Parse a VM as found in the dictionary, ie. "1", "1-3", "1-n" or "2-2n"
+func parseVM(s string) (*dcmVM, error) {
+
+	vm := &dcmVM{s: s}
+
+	parts := strings.Split(s, "-")
+	if len(parts) > 2 {
+		return nil, errInvalidVM
+	}
+
+	min, err := strconv.ParseUint(parts[0], 10, 8)
+	if err != nil {
+		return nil, errInvalidVM
+	}
+	vm.Min = uint8(min)
+	vm.Max = uint8(min)
+
+	if len(parts) == 1 {
+		return vm, nil
+	}
+
+	max := parts[1]
+	if strings.HasSuffix(max, "n") {
+		vm.N = true
+		max = strings.TrimSuffix(max, "n")
+		if max == "" {
+			max = "1"
+		}
+	}
+
+	n, err := strconv.ParseUint(max, 10, 8)
+	if err != nil || n == 0 {
+		return nil, errInvalidVM
+	}
+	vm.Max = uint8(n)
+
+	return vm, nil
+}
+
+// Reports whether n values satisfy the VM
+func (vm *dcmVM) allows(n int) bool {
+	if n < int(vm.Min) {
+		return false
+	}
+	if vm.N {
+		return n%int(vm.Max) == 0
+	}
+	return n <= int(vm.Max)
+}
+
+// Check the number of values of an element against the VM in the dictionary.
+// Empty values are always allowed, as are elements without a dictionary
+// entry or with an unparseable VM.
+func (p *Parser) validateVM(elem *DicomElement) error {
+
+	// binary values always have a VM of 1
+	switch elem.Vr {
+	case "OB", "OD", "OF", "OL", "OV", "OW", "OX", "UN", "SQ":
+		return nil
+	}
+
+	if elem.Len() == 0 {
+		return nil
+	}
+
+	entry, err := p.getDictEntry(elem.Group, elem.Element)
+	if err != nil {
+		return nil
+	}
+
+	vm, err := parseVM(entry.vm)
+	if err != nil {
+		return nil
+	}
+
+	if !vm.allows(elem.Len()) {
+		return &VMError{elem.Group, elem.Element, entry.name, entry.vm, elem.Len()}
+	}
+
+	return nil
+}
+
+// Check the value of an element against its VR, and the number of values
+// against the VM of its dictionary entry
+func (p *Parser) checkValue(elem *DicomElement) error {
+
+	if !valueMatchesVR(elem.Value, elem.Vr) {
+		return ErrWrongVR
+	}
+
+	return p.validateVM(elem)
+}
+
+// Create a new element for the given tag, with its name and VR taken from the
+// dictionary. Returns ErrWrongVR if the type of the value does not match the
+// VR, or a *VMError if the number of values does not match the VM of the
+// dictionary entry.
+func (p *Parser) NewElement(tag Tag, value Value) (*DicomElement, error) {
+
+	entry, err := p.getDictEntry(tag.Group, tag.Element)
+	if err != nil {
+		return nil, err
+	}
+
+	elem := &DicomElement{
+		Group:   tag.Group,
+		Element: tag.Element,
+		Name:    entry.name,
+		Vr:      entry.vr,
+		Value:   value,
+	}
+
+	if err := p.checkValue(elem); err != nil {
+		return nil, err
+	}
+
+	return elem, nil
+}
@@ -0,0 +1,74 @@
+package dicom
+
+import (
+	"testing"
+)
+
+func TestParseVM(t *testing.T) {
+
+	cases := []struct {
+		s     string
+		allow []int
+		deny  []int
+	}{
+		{"1", []int{1}, []int{2}},
+		{"6", []int{6}, []int{1, 5, 7}},
+		{"1-3", []int{1, 2, 3}, []int{4}},
+		{"1-n", []int{1, 2, 100}, []int{}},
+		{"2-n", []int{2, 3}, []int{1}},
+		{"2-2n", []int{2, 4, 6}, []int{1, 3}},
+		{"3-3n", []int{3, 6}, []int{2, 4}},
+	}
+
+	for _, c := range cases {
+		vm, err := parseVM(c.s)
+		if err != nil {
+			t.Errorf("Could not parse VM %s: %s", c.s, err)
+			continue
+		}
+		for _, n := range c.allow {
+			if !vm.allows(n) {
+				t.Errorf("VM %s should allow %d values", c.s, n)
+			}
+		}
+		for _, n := range c.deny {
+			if vm.allows(n) {
+				t.Errorf("VM %s should not allow %d values", c.s, n)
+			}
+		}
+	}
+
+	if _, err := parseVM("n"); err == nil {
+		t.Error("Expected an error parsing VM n")
+	}
+}
+
+func TestNewElementVM(t *testing.T) {
+
+	p, _ := NewParser()
+
+	elem, err := p.NewElement(TagImageOrientationPatient, Strings{"1", "0", "0", "0", "1", "0"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if elem.Name != "ImageOrientationPatient" || elem.Vr != "DS" {
+		t.Errorf("Incorrect element %s %s", elem.Name, elem.Vr)
+	}
+
+	_, err = p.NewElement(TagImageOrientationPatient, Strings{"1", "0", "0"})
+	if _, ok := err.(*VMError); !ok {
+		t.Errorf("Expected a VMError, got %v", err)
+	}
+
+}
+
+func TestNewElementVR(t *testing.T) {
+
+	p, _ := NewParser()
+
+	if _, err := p.NewElement(TagRows, Strings{"512"}); err != ErrWrongVR {
+		t.Errorf("Expected ErrWrongVR, got %v", err)
+	}
+
+}
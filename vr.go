@@ -0,0 +1,137 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	ErrInvalidURI        = errors.New("Invalid URI")
+	ErrInvalidIS         = errors.New("Invalid IS value")
+	ErrValueLongerThanVR = errors.New("Value longer than the maximum length of its VR")
+	ErrInvalidCharacter  = errors.New("Character not allowed in the VR")
+)
+
+// Maximum number of characters of the values of string VRs (PS 3.5 6.2), of
+// each component group for PN. UIDs are checked by ValidateUID.
+var maxValueLength = map[string]int{
+	"AE": 16, "AS": 4, "CS": 16, "DA": 8, "DS": maxDSLength, "DT": 26, "IS": 12,
+	"LO": 64, "LT": 10240, "PN": 64, "SH": 16, "ST": 1024, "TM": 16,
+}
+
+// The kinds of values of the VRs, as decoded by the parser
+type VRKind int
+
+const (
+	VRKindUnknown  VRKind = iota
+	VRKindString          // character strings, multi-valued with backslashes
+	VRKindText            // a single character string, in which backslashes are not delimiters
+	VRKindInteger         // binary integers
+	VRKindFloat           // binary floating point numbers
+	VRKindBytes           // bytes, OB and UN
+	VRKindTag             // attribute tags, AT
+	VRKindSequence        // sequences of items, SQ
+)
+
+// Return the kind of values of a VR (PS 3.5 6.2). UC values may be as long as
+// the UT ones but are multi-valued, while UR values are single URIs whose
+// trailing spaces are insignificant.
+func GetVRKind(vr string) VRKind {
+	switch vr {
+	case "AE", "AS", "CS", "DA", "DS", "DT", "IS", "LO", "PN", "SH", "TM", "UC", "UI":
+		return VRKindString
+	case "LT", "ST", "UR", "UT":
+		return VRKindText
+	case "SS", "US", "SL", "UL", "SV", "UV", "OW", "OL", "OV":
+		return VRKindInteger
+	case "FL", "FD", "OF", "OD":
+		return VRKindFloat
+	case "OB", "UN":
+		return VRKindBytes
+	case "AT":
+		return VRKindTag
+	case "SQ":
+		return VRKindSequence
+	}
+	return VRKindUnknown
+}
+
+// Check an UR value: trailing spaces are insignificant, but leading spaces
+// are not allowed, and spaces within URIs must be percent-encoded (RFC 3986)
+func validateURI(uri string) error {
+	uri = strings.TrimRight(uri, " \x00")
+	if strings.ContainsAny(uri, " \t\r\n") {
+		return fmt.Errorf("%w: %q contains spaces", ErrInvalidURI, uri)
+	}
+	return nil
+}
+
+// Check a value of a string VR against the maximum length and the character
+// repertoire of the VR, and the syntax of DS and IS values. Padding is
+// ignored, and so are empty values.
+func ValidateValue(vr, value string) error {
+
+	value = trimString(value, vr)
+	if value == "" {
+		return nil
+	}
+
+	if max, ok := maxValueLength[vr]; ok {
+		values := []string{value}
+		if vr == "PN" {
+			values = strings.Split(value, "=")
+		}
+		for _, v := range values {
+			if n := utf8.RuneCountInString(v); n > max {
+				return fmt.Errorf("%w: %s value %q has %d characters, at most %d allowed", ErrValueLongerThanVR, vr, value, n, max)
+			}
+		}
+	}
+
+	switch vr {
+	case "AE":
+		return checkCharacters(vr, value, func(r rune) bool { return r >= ' ' && r <= '~' && r != '\\' })
+	case "AS":
+		if len(value) != 4 || strings.IndexByte("DWMY", value[3]) < 0 {
+			return fmt.Errorf("%w: AS value %q", ErrInvalidCharacter, value)
+		}
+		return checkCharacters(vr, value[:3], isDigit)
+	case "CS":
+		return checkCharacters(vr, value, func(r rune) bool { return r >= 'A' && r <= 'Z' || isDigit(r) || r == ' ' || r == '_' })
+	case "DS":
+		if err := checkCharacters(vr, value, func(r rune) bool { return isDigit(r) || strings.ContainsRune("+-.Ee", r) }); err != nil {
+			return fmt.Errorf("%w: %q", ErrInvalidDS, value)
+		}
+		if _, err := DS(value).Float64(); err != nil {
+			return fmt.Errorf("%w: %q", ErrInvalidDS, value)
+		}
+	case "IS":
+		if _, err := strconv.ParseInt(value, 10, 32); err != nil || strings.Contains(value, "_") {
+			return fmt.Errorf("%w: %q", ErrInvalidIS, value)
+		}
+	case "LO", "SH", "PN":
+		// the escape character may remain from undecoded character sets
+		return checkCharacters(vr, value, func(r rune) bool { return r >= ' ' || r == 0x1B })
+	case "LT", "ST", "UT":
+		return checkCharacters(vr, value, func(r rune) bool { return r >= ' ' || strings.ContainsRune("\t\n\f\r\x1b", r) })
+	}
+
+	return nil
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// Check that the characters of a value are allowed by a VR
+func checkCharacters(vr, value string, allowed func(rune) bool) error {
+	for _, r := range value {
+		if !allowed(r) || r == utf8.RuneError {
+			return fmt.Errorf("%w: %s value %q contains %q", ErrInvalidCharacter, vr, value, r)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+// An explicit VR little endian element with a long VR
+func longElement(tag Tag, vr string, value string) []byte {
+	b := binary.LittleEndian.AppendUint16(nil, tag.Group)
+	b = binary.LittleEndian.AppendUint16(b, tag.Element)
+	b = append(b, vr...)
+	b = append(b, 0, 0)
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(value)))
+	return append(b, value...)
+}
+
+// An explicit VR little endian element with a 16-bit Value Length
+func shortElement(tag Tag, vr string, value string) []byte {
+	b := binary.LittleEndian.AppendUint16(nil, tag.Group)
+	b = binary.LittleEndian.AppendUint16(b, tag.Element)
+	b = append(b, vr...)
+	b = binary.LittleEndian.AppendUint16(b, uint16(len(value)))
+	return append(b, value...)
+}
+
+func TestGetVRKind(t *testing.T) {
+
+	for vr, kind := range map[string]VRKind{
+		"UC": VRKindString,
+		"UR": VRKindText,
+		"UT": VRKindText,
+		"OL": VRKindInteger,
+		"OD": VRKindFloat,
+		"UN": VRKindBytes,
+		"AT": VRKindTag,
+		"SQ": VRKindSequence,
+		"XX": VRKindUnknown,
+	} {
+		if k := GetVRKind(vr); k != kind {
+			t.Errorf("Kind of %s is %d, expected %d", vr, k, kind)
+		}
+	}
+}
+
+func TestURAndUC(t *testing.T) {
+
+	retrieveURL := Tag{0x0008, 0x1190}
+	longCodeValue := Tag{0x0008, 0x0119}
+	data := append(longElement(longCodeValue, "UC", " A\\B  "), longElement(retrieveURL, "UR", "http://a/b?c=d\\e  ")...)
+
+	p, _ := NewParser()
+	file, err := p.ParseDataSet(data, ExplicitVRLittleEndian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(file.Warnings) > 0 {
+		t.Errorf("Unexpected warnings %v", file.Warnings)
+	}
+
+	uc, _ := file.LookupElementByTag(longCodeValue)
+	if v := uc.MustGetStrings(); len(v) != 2 || v[0] != " A" || v[1] != "B" {
+		t.Errorf("Incorrect UC values %q", v)
+	}
+	ur, _ := file.LookupElementByTag(retrieveURL)
+	if v := ur.MustGetStrings(); len(v) != 1 || v[0] != "http://a/b?c=d\\e" {
+		t.Errorf("Incorrect UR values %q", v)
+	}
+
+	strict, _ := NewParser(Strict())
+	for _, uri := range []string{" http://a/b", "http://a/b c"} {
+		_, err := strict.ParseDataSet(longElement(retrieveURL, "UR", uri+" "[:len(uri)%2]), ExplicitVRLittleEndian)
+		if !errors.Is(err, ErrInvalidURI) {
+			t.Errorf("Incorrect error for %q: %v", uri, err)
+		}
+	}
+}
+
+func TestVeryLongVRs(t *testing.T) {
+
+	selectorSVValue := Tag{0x0072, 0x0082}
+	selectorUVValue := Tag{0x0072, 0x0083}
+	extendedOffsetTable := Tag{0x7FE0, 0x0001}
+
+	var sv, uv, ov []byte
+	sv = binary.LittleEndian.AppendUint64(sv, uint64(1<<62))
+	sv = binary.LittleEndian.AppendUint64(sv, 1<<64-2)
+	uv = binary.LittleEndian.AppendUint64(uv, 1<<64-1)
+	ov = binary.LittleEndian.AppendUint64(ov, 0)
+	ov = binary.LittleEndian.AppendUint64(ov, 1<<40)
+	data := longElement(selectorSVValue, "SV", string(sv))
+	data = append(data, longElement(selectorUVValue, "UV", string(uv))...)
+	data = append(data, longElement(extendedOffsetTable, "OV", string(ov))...)
+
+	p, _ := NewParser()
+	file, err := p.ParseDataSet(data, ExplicitVRLittleEndian)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	elem, _ := file.LookupElementByTag(selectorSVValue)
+	if v := elem.MustGetInt64s(); len(v) != 2 || v[0] != 1<<62 || v[1] != -2 {
+		t.Errorf("Incorrect SV values %v", v)
+	}
+	elem, _ = file.LookupElementByTag(selectorUVValue)
+	if v := elem.MustGetUInt64(); v != 1<<64-1 {
+		t.Errorf("Incorrect UV value %v", v)
+	}
+	elem, _ = file.LookupElementByTag(extendedOffsetTable)
+	if v := elem.MustGetUInt64s(); len(v) != 2 || v[1] != 1<<40 {
+		t.Errorf("Incorrect OV values %v", v)
+	}
+
+	for _, ts := range []string{ExplicitVRLittleEndian, ExplicitVRBigEndian} {
+		buff := new(bytes.Buffer)
+		if err := file.WriteDataSet(buff, ts); err != nil {
+			t.Fatal(err)
+		}
+		if ts == ExplicitVRLittleEndian && !bytes.Equal(buff.Bytes(), data) {
+			t.Errorf("Incorrect encoding % x", buff.Bytes())
+		}
+		written, err := p.ParseDataSet(buff.Bytes(), ts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !Equal(file, written, EqualOptions{}) {
+			t.Errorf("Values differ once written in %s: %v", ts, Diff(file, written, EqualOptions{}))
+		}
+	}
+}
+
+func TestOtherVRs(t *testing.T) {
+
+	p, _ := NewParser()
+	file := &DicomFile{}
+	for _, v := range []struct {
+		tag   Tag
+		value Value
+	}{
+		{TagTrackPointIndexList, UInt32s{0, 3, 70000}},
+		{TagFloatPixelData, Float32s{0.5, -1, 3.25}},
+		{TagDoubleFloatPixelData, Float64s{0.1, -2e300}},
+	} {
+		elem, err := p.NewElement(v.tag, v.value)
+		if err != nil {
+			t.Fatalf("Failed to create %s: %s", v.tag, err)
+		}
+		file.appendDataElement(elem)
+	}
+
+	// 40 bytes of values, with 8 or 12 byte headers
+	for ts, length := range map[string]int{ImplicitVRLittleEndian: 64, ExplicitVRLittleEndian: 76, ExplicitVRBigEndian: 76} {
+		buff := new(bytes.Buffer)
+		if err := file.WriteDataSet(buff, ts); err != nil {
+			t.Fatal(err)
+		}
+		if buff.Len() != length {
+			t.Errorf("Incorrect length %d in %s", buff.Len(), ts)
+		}
+		written, err := p.ParseDataSet(buff.Bytes(), ts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !Equal(file, written, EqualOptions{}) {
+			t.Errorf("Values differ once written in %s: %v", ts, Diff(file, written, EqualOptions{}))
+		}
+	}
+}
+
+func TestValidateValue(t *testing.T) {
+
+	for _, v := range []struct {
+		vr, value string
+		err       error
+	}{
+		{"SH", "ABCDEFGHIJKLMNOP ", nil},
+		{"SH", "ABCDEFGHIJKLMNOPQ", ErrValueLongerThanVR},
+		{"LO", "Müller", nil},
+		{"LO", "A\x01", ErrInvalidCharacter},
+		{"AE", "STORESCP", nil},
+		{"AE", "AN_AE_TITLE_TOO_LONG", ErrValueLongerThanVR},
+		{"CS", "ORIGINAL", nil},
+		{"CS", "original", ErrInvalidCharacter},
+		{"AS", "045Y", nil},
+		{"AS", "45Y", ErrInvalidCharacter},
+		{"DS", " -1.5e3 ", nil},
+		{"DS", "1,5", ErrInvalidDS},
+		{"DS", "NaN", ErrInvalidDS},
+		{"IS", "-2147483648", nil},
+		{"IS", "2147483648", ErrInvalidIS},
+		{"IS", "1.0", ErrInvalidIS},
+		{"PN", "Yamada^Tarou=山田^太郎", nil},
+		{"ST", "line\r\nline", nil},
+		{"UT", "", nil},
+	} {
+		if err := ValidateValue(v.vr, v.value); !errors.Is(err, v.err) || (err == nil) != (v.err == nil) {
+			t.Errorf("Incorrect error for %s %q: %v", v.vr, v.value, err)
+		}
+	}
+}
+
+func TestStrictValues(t *testing.T) {
+
+	stationName := shortElement(Tag{0x0008, 0x1010}, "SH", "A_VERY_LONG_NAME_1")
+
+	p, _ := NewParser()
+	file, err := p.ParseDataSet(stationName, ExplicitVRLittleEndian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(file.Warnings) != 1 || !errors.Is(&file.Warnings[0], ErrValueLongerThanVR) {
+		t.Errorf("Incorrect warnings %v", file.Warnings)
+	}
+
+	strict, _ := NewParser(Strict())
+	if _, err := strict.ParseDataSet(stationName, ExplicitVRLittleEndian); !errors.Is(err, ErrValueLongerThanVR) {
+		t.Errorf("Incorrect error %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package dicom
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// One step of a TagPath. Item is the index of the item within the
+// sequence identified by Tag, and is unused for the last step.
+type PathStep struct {
+	Tag  Tag
+	Item int
+}
+
+// The location of an element in a data set, from the top level sequence
+// down to the element itself, ie. (0040,0275)[0].(0008,0050)
+type TagPath []PathStep
+
+// Stringer
+func (path TagPath) String() string {
+	buf := new(bytes.Buffer)
+	for i, step := range path {
+		if i > 0 {
+			buf.WriteByte('.')
+		}
+		buf.WriteString(step.Tag.String())
+		if i < len(path)-1 {
+			fmt.Fprintf(buf, "[%d]", step.Item)
+		}
+	}
+	return buf.String()
+}
+
+// The tag of the element the path leads to
+func (path TagPath) Tag() Tag {
+	if len(path) == 0 {
+		return Tag{}
+	}
+	return path[len(path)-1].Tag
+}
+
+// Call fn for every element of the file in order, descending into the items
+// of sequences. Items themselves are not visited. Walking stops at the first
+// error returned by fn, which is returned.
+func (file *DicomFile) Walk(fn func(path TagPath, elem *DicomElement) error) error {
+	for i := range file.Elements {
+		if err := walk(nil, &file.Elements[i], fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func walk(parent TagPath, elem *DicomElement, fn func(TagPath, *DicomElement) error) error {
+
+	path := make(TagPath, len(parent), len(parent)+1)
+	copy(path, parent)
+	path = append(path, PathStep{elem.Tag(), 0})
+
+	if err := fn(path, elem); err != nil {
+		return err
+	}
+
+	seq, err := elem.GetSequence()
+	if err != nil {
+		return nil
+	}
+
+	for i, item := range seq {
+		path[len(path)-1].Item = i
+		for _, child := range item.Elements {
+			if err := walk(path, child, fn); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
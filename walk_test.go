@@ -0,0 +1,59 @@
+package dicom
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWalk(t *testing.T) {
+
+	parser, _ := NewParser()
+	data, err := parser.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var paths []string
+	err = data.Walk(func(path TagPath, elem *DicomElement) error {
+		if elem.Tag() == TagCodeValue {
+			paths = append(paths, path.String())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+
+	expect := "(0040,0275)[0].(0040,0008)[0].(0008,0100)"
+	found := false
+	for _, p := range paths {
+		if p == expect {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Could not find %s in %v", expect, paths)
+	}
+
+}
+
+func TestWalkStops(t *testing.T) {
+
+	parser, _ := NewParser()
+	data, _ := parser.Parse(readFile())
+
+	stop := errors.New("stop")
+	n := 0
+	err := data.Walk(func(path TagPath, elem *DicomElement) error {
+		n++
+		if n == 3 {
+			return stop
+		}
+		return nil
+	})
+
+	if err != stop || n != 3 {
+		t.Errorf("Walk did not stop: %v after %d elements", err, n)
+	}
+
+}
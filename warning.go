@@ -0,0 +1,31 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrTrailingBytes = errors.New("Value Length is not a multiple of the size of the values")
+
+// A recoverable problem found while parsing, ie. an element whose number of
+// values does not match its VM. Warnings are errors in strict mode.
+type Warning struct {
+	Tag Tag
+	P   uint32 // position of the element in the file
+	Err error
+}
+
+func (w *Warning) Error() string {
+	return fmt.Sprintf("%08d %s: %s", w.P, w.Tag, w.Err)
+}
+
+func (w *Warning) Unwrap() error {
+	return w.Err
+}
+
+// Record and log a warning about an element
+func (p *Parser) warn(buffer *dicomBuffer, elem *DicomElement, err error) {
+	w := Warning{elem.Tag(), elem.P, err}
+	buffer.warnings = append(buffer.warnings, w)
+	p.log().Printf("dicom: warning: %s", &w)
+}
@@ -0,0 +1,53 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestParseWarnings(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(file.Warnings) != 0 {
+		t.Errorf("Unexpected warnings %v", file.Warnings)
+	}
+
+	for i := range file.Elements {
+		if file.Elements[i].Tag() == TagImageOrientationPatient {
+			file.Elements[i].Value = Strings{"1", "0", "0"}
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	if err := file.Write(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err = p.Parse(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(file.Warnings) != 1 || file.Warnings[0].Tag != TagImageOrientationPatient {
+		t.Fatalf("Incorrect warnings %v", file.Warnings)
+	}
+
+	var vmErr *VMError
+	if !errors.As(&file.Warnings[0], &vmErr) || vmErr.Count != 3 {
+		t.Errorf("Expected a VMError, got %v", file.Warnings[0].Err)
+	}
+
+	strict, _ := NewParser(Strict())
+	_, err = strict.Parse(buf.Bytes())
+	var confErr *ConformanceError
+	if !errors.As(err, &confErr) || confErr.Tag != TagImageOrientationPatient || !errors.As(err, &vmErr) {
+		t.Errorf("Expected a ConformanceError in strict mode, got %v", err)
+	}
+
+}
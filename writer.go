@@ -0,0 +1,455 @@
+package dicom
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+var ErrValueTooLong = errors.New("Value too long for a 16-bit Value Length")
+
+const undefinedLength uint32 = 0xffffffff
+
+// Bulk values at least this long are written straight to the output of a
+// streaming writer rather than copied to its buffer
+const streamedValueLength = 4096
+
+type dicomWriter struct {
+	*bytes.Buffer
+	bo       binary.ByteOrder
+	implicit bool
+	scratch  []byte        // reused to encode the value of every element
+	out      io.Writer     // flushed to after every top level element, if set
+	err      error         // the first error writing to out
+	charset  *characterSet // of the Specific Character Set written, nil for raw values
+	strict   bool          // validate the elements before writing them
+}
+
+// Options of WriteWithOptions and WriteDataSetWithOptions
+type WriteOptions struct {
+	// Reject the elements that strict parsing rejects, ie. whose number of
+	// values does not match the VM of the dictionary or whose values do not
+	// conform to their VR, with a *ConformanceError
+	Strict bool
+}
+
+func newDicomWriter(bo binary.ByteOrder, implicit bool) *dicomWriter {
+	return &dicomWriter{
+		new(bytes.Buffer),
+		bo,
+		implicit,
+		nil,
+		nil,
+		nil,
+		nil,
+		false,
+	}
+}
+
+// Write the buffered bytes to the output of a streaming writer
+func (buffer *dicomWriter) flush() error {
+	if buffer.out != nil && buffer.err == nil && buffer.Len() > 0 {
+		_, buffer.err = buffer.out.Write(buffer.Bytes())
+		buffer.Reset()
+	}
+	return buffer.err
+}
+
+// Write a bulk value, bypassing the buffer of a streaming writer when long
+func (buffer *dicomWriter) writeBulk(b []byte) {
+	if buffer.out == nil || len(b) < streamedValueLength {
+		buffer.Write(b)
+		return
+	}
+	if buffer.flush() == nil {
+		_, buffer.err = buffer.out.Write(b)
+	}
+}
+
+// Write the elements of the data set to w as they are encoded, so that the
+// data set is never buffered as a whole
+func (buffer *dicomWriter) stream(w io.Writer, elems []DicomElement) error {
+
+	buffer.out = w
+	return profile("write", func(context.Context) error {
+		for i := range elems {
+			if elem := &elems[i]; elem.Group != 0x0002 {
+				if err := buffer.writeElement(elem); err != nil {
+					return err
+				}
+				if err := buffer.flush(); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// Write the file to w: preamble, file meta information and data set,
+// encoded with the transfer syntax of the file. The group length of the file
+// meta information is recalculated, and sequences and items are written with
+// undefined lengths. The data set is written to w element by element, so w
+// may have been partly written to when an element fails to encode.
+func (file *DicomFile) Write(w io.Writer) error {
+	return file.WriteWithOptions(w, WriteOptions{})
+}
+
+// Write the file to w like Write, validating its elements as set in opts
+func (file *DicomFile) WriteWithOptions(w io.Writer, opts WriteOptions) error {
+
+	bo, implicit, err := file.getTransferSyntax()
+	if err != nil {
+		return err
+	}
+
+	// file meta information is always explicit VR little endian
+	meta := newDicomWriter(binary.LittleEndian, false)
+	meta.strict = opts.Strict
+	for i := range file.Elements {
+		elem := &file.Elements[i]
+		if elem.Group == 0x0002 && elem.Element != 0x0000 {
+			if err := meta.writeElement(elem); err != nil {
+				return err
+			}
+		}
+	}
+
+	header := newDicomWriter(binary.LittleEndian, false)
+	header.Write(make([]byte, 128)) // preamble
+	header.WriteString(magic_word)
+	header.writeElement(&DicomElement{
+		Group:   TagFileMetaInformationGroupLength.Group,
+		Element: TagFileMetaInformationGroupLength.Element,
+		Vr:      "UL",
+		Value:   UInt32s{uint32(meta.Len())},
+	})
+
+	for _, buffer := range []*dicomWriter{header, meta} {
+		if _, err := w.Write(buffer.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	ts, _ := file.lookupString(TagTransferSyntaxUID)
+	return writeDataSet(w, file.Elements, bo, implicit, ts == deflated_explicit_vr_le, opts)
+}
+
+// Write the data set of the file to w, without preamble nor file meta
+// information, encoded with the transfer syntax ts. Like Write, the data set
+// is written element by element.
+func (file *DicomFile) WriteDataSet(w io.Writer, ts string) error {
+	return file.WriteDataSetWithOptions(w, ts, WriteOptions{})
+}
+
+// Write the data set of the file to w like WriteDataSet, validating its
+// elements as set in opts
+func (file *DicomFile) WriteDataSetWithOptions(w io.Writer, ts string, opts WriteOptions) error {
+
+	bo, implicit, err := transferSyntax(ts)
+	if err != nil {
+		return err
+	}
+
+	return writeDataSet(w, file.Elements, bo, implicit, ts == deflated_explicit_vr_le, opts)
+}
+
+// Write the elements of a data set, deflated for the Deflated Explicit VR
+// Little Endian transfer syntax
+func writeDataSet(w io.Writer, elems []DicomElement, bo binary.ByteOrder, implicit, deflated bool, opts WriteOptions) error {
+
+	buffer := newDicomWriter(bo, implicit)
+	buffer.strict = opts.Strict
+	if !deflated {
+		return buffer.stream(w, elems)
+	}
+
+	fw, err := flate.NewWriter(w, flate.DefaultCompression)
+	if err != nil {
+		return err
+	}
+	if err := buffer.stream(fw, elems); err != nil {
+		return err
+	}
+	return fw.Close()
+}
+
+// Write a data element, along with the items of sequences and encapsulated
+// pixel data
+func (buffer *dicomWriter) writeElement(elem *DicomElement) error {
+
+	if buffer.strict {
+		if err := standardParser().validate(elem); err != nil {
+			return &ConformanceError{elem.Tag(), elem.P, err}
+		}
+	}
+
+	vr := writtenVR(elem)
+	if elem.IndentLevel == 0 && elem.Tag() == TagSpecificCharacterSet {
+		buffer.charset, _ = characterSetOf(elem)
+	}
+
+	if vr == "SQ" {
+		var seq Sequence
+		if elem.Value != nil || elem.lazy != nil {
+			var err error
+			if seq, err = elem.GetSequence(); err != nil {
+				return err
+			}
+		}
+		buffer.writeHeader(elem.Tag(), vr, undefinedLength)
+		for _, item := range seq {
+			buffer.writeHeader(TagItem, "", undefinedLength)
+			for _, child := range item.Elements {
+				if err := buffer.writeElement(child); err != nil {
+					return err
+				}
+			}
+			buffer.writeHeader(TagItemDelimitationItem, "", 0)
+
+			// large sequences are not buffered as a whole either
+			if buffer.Len() >= streamedValueLength {
+				if err := buffer.flush(); err != nil {
+					return err
+				}
+			}
+		}
+		buffer.writeHeader(TagSequenceDelimitationItem, "", 0)
+		return nil
+	}
+
+	if pixels, ok := elem.Value.(*PixelData); ok {
+		buffer.writeHeader(elem.Tag(), vr, undefinedLength)
+		buffer.writeHeader(TagItem, "", uint32(4*len(pixels.Offsets)))
+		for _, offset := range pixels.Offsets {
+			buffer.writeUInt32(offset)
+		}
+		for _, fragment := range pixels.Fragments {
+			buffer.writeHeader(TagItem, "", uint32(len(fragment)+len(fragment)%2))
+			buffer.writeBulk(fragment)
+			if len(fragment)%2 != 0 {
+				buffer.WriteByte(0x00)
+			}
+		}
+		buffer.writeHeader(TagSequenceDelimitationItem, "", 0)
+		return nil
+	}
+
+	// binary values are written as they are rather than copied
+	if v, ok := elem.Value.(Bytes); ok {
+		if !buffer.implicit && !isLongVR(vr) && len(v) > 0xffff {
+			return ErrValueTooLong
+		}
+		buffer.writeHeader(elem.Tag(), vr, uint32(len(v)+len(v)%2))
+		buffer.writeBulk(v)
+		if len(v)%2 != 0 {
+			buffer.WriteByte(0x00)
+		}
+		return nil
+	}
+
+	value, err := buffer.appendValue(buffer.scratch[:0], elem, vr)
+	if err != nil {
+		return err
+	}
+	buffer.scratch = value
+
+	if !buffer.implicit && !isLongVR(vr) && len(value) > 0xffff {
+		return ErrValueTooLong
+	}
+
+	buffer.writeHeader(elem.Tag(), vr, uint32(len(value)))
+	buffer.writeBulk(value)
+
+	return nil
+}
+
+// Write a tag, VR and value length. Items and delimiters are always written
+// without VR.
+func (buffer *dicomWriter) writeHeader(tag Tag, vr string, vl uint32) {
+
+	buffer.writeUInt16(tag.Group)
+	buffer.writeUInt16(tag.Element)
+
+	if buffer.implicit || tag.Group == pixeldata_group {
+		buffer.writeUInt32(vl)
+		return
+	}
+
+	buffer.WriteString(vr)
+	if isLongVR(vr) {
+		buffer.Write([]byte{0, 0}) // reserved
+		buffer.writeUInt32(vl)
+	} else {
+		buffer.writeUInt16(uint16(vl))
+	}
+}
+
+// Encode the values of an element, padded to an even length
+func (buffer *dicomWriter) encodeValue(elem *DicomElement, vr string) ([]byte, error) {
+	return buffer.appendValue(nil, elem, vr)
+}
+
+// Append the encoded values of an element, padded to an even length, to b
+func (buffer *dicomWriter) appendValue(b []byte, elem *DicomElement, vr string) ([]byte, error) {
+
+	start := len(b)
+	bo := buffer.bo
+
+	switch v := elem.Value.(type) {
+	case nil:
+	case Strings:
+		if vr == "DS" {
+			values, err := fitDS(v)
+			if err != nil {
+				return nil, err
+			}
+			v = values
+		}
+		for i, s := range v {
+			if i > 0 {
+				b = append(b, '\\')
+			}
+			if buffer.charset != nil && usesCharacterSet(vr) {
+				encoded, err := buffer.charset.encode(s)
+				if err != nil {
+					return nil, err
+				}
+				b = append(b, encoded...)
+				continue
+			}
+			b = append(b, s...)
+		}
+		if (len(b)-start)%2 != 0 {
+			if vr == "UI" {
+				return append(b, 0x00), nil
+			}
+			return append(b, ' '), nil
+		}
+		return b, nil
+	case UInt16s:
+		for _, u := range v {
+			b = appendUint16(bo, b, u)
+		}
+	case Int16s:
+		for _, i := range v {
+			b = appendUint16(bo, b, uint16(i))
+		}
+	case UInt32s:
+		for _, u := range v {
+			b = appendUint32(bo, b, u)
+		}
+	case Int32s:
+		for _, i := range v {
+			b = appendUint32(bo, b, uint32(i))
+		}
+	case UInt64s:
+		for _, u := range v {
+			b = appendUint64(bo, b, u)
+		}
+	case Int64s:
+		for _, i := range v {
+			b = appendUint64(bo, b, uint64(i))
+		}
+	case Float32s:
+		for _, f := range v {
+			b = appendUint32(bo, b, math.Float32bits(f))
+		}
+	case Float64s:
+		for _, f := range v {
+			b = appendUint64(bo, b, math.Float64bits(f))
+		}
+	case Tags:
+		for _, t := range v {
+			b = appendUint16(bo, b, t.Group)
+			b = appendUint16(bo, b, t.Element)
+		}
+	case Bytes:
+		b = append(b, v...)
+	default:
+		return nil, ErrWrongValueType
+	}
+
+	if (len(b)-start)%2 != 0 {
+		b = append(b, 0x00)
+	}
+	return b, nil
+}
+
+func appendUint16(bo binary.ByteOrder, b []byte, v uint16) []byte {
+	b = append(b, 0, 0)
+	bo.PutUint16(b[len(b)-2:], v)
+	return b
+}
+
+func appendUint32(bo binary.ByteOrder, b []byte, v uint32) []byte {
+	b = append(b, 0, 0, 0, 0)
+	bo.PutUint32(b[len(b)-4:], v)
+	return b
+}
+
+func appendUint64(bo binary.ByteOrder, b []byte, v uint64) []byte {
+	b = append(b, 0, 0, 0, 0, 0, 0, 0, 0)
+	bo.PutUint64(b[len(b)-8:], v)
+	return b
+}
+
+func (buffer *dicomWriter) writeUInt16(v uint16) {
+	buffer.Write(appendUint16(buffer.bo, buffer.AvailableBuffer(), v))
+}
+
+func (buffer *dicomWriter) writeUInt32(v uint32) {
+	buffer.Write(appendUint32(buffer.bo, buffer.AvailableBuffer(), v))
+}
+
+// Pad a binary value to an even length with a NULL byte
+func padBytes(b []byte) []byte {
+	if len(b)%2 == 0 {
+		return b
+	}
+	return append(b[:len(b):len(b)], 0x00)
+}
+
+// VRs with a 32-bit Value Length in explicit VR (PS 3.5 7.1.2)
+func isLongVR(vr string) bool {
+	switch vr {
+	case "NA", "OB", "OD", "OF", "OL", "OV", "OW", "SQ", "SV", "UN", "UC", "UR", "UT", "UV":
+		return true
+	}
+	return false
+}
+
+// Resolve the ambiguous VRs of the dictionary (ie. "OX" for PixelData) to the
+// VR matching the values of the element
+func writtenVR(elem *DicomElement) string {
+
+	switch elem.Value.(type) {
+	case *PixelData:
+		return "OB"
+	case Sequence:
+		return "SQ"
+	}
+
+	switch elem.Vr {
+	case "OX":
+		if _, ok := elem.Value.(UInt16s); ok {
+			return "OW"
+		}
+		return "OB"
+	case "XS":
+		if _, ok := elem.Value.(Int16s); ok {
+			return "SS"
+		}
+		return "US"
+	case "UP":
+		return "UL"
+	case "":
+		return "UN"
+	}
+
+	return elem.Vr
+}
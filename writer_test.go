@@ -0,0 +1,139 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// Records the writes made to it
+type chunkWriter struct {
+	bytes.Buffer
+	chunks []int
+	fail   bool
+}
+
+var errWrite = errors.New("write failed")
+
+func (w *chunkWriter) Write(b []byte) (int, error) {
+	if w.fail {
+		return 0, errWrite
+	}
+	w.chunks = append(w.chunks, len(b))
+	return w.Buffer.Write(b)
+}
+
+func TestWriteStreams(t *testing.T) {
+
+	parser, _ := NewParser()
+	data, err := parser.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pixels, _ := data.LookupElementByTag(TagPixelData)
+	fragment := pixels.Value.(*PixelData).Fragments[0]
+
+	w := &chunkWriter{}
+	if err := data.Write(w); err != nil {
+		t.Fatal(err)
+	}
+
+	// the fragment is written as it is, and nothing else is buffered with it
+	found := false
+	for _, n := range w.chunks {
+		if n == len(fragment) {
+			found = true
+		}
+		if n > len(fragment) {
+			t.Errorf("Incorrect write of %d bytes", n)
+		}
+	}
+	if !found {
+		t.Error("The pixel data fragment should be written without a copy")
+	}
+
+	again, err := parser.Parse(w.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Equal(data, again, EqualOptions{}) {
+		t.Error("The file written should parse back the same")
+	}
+
+	if err := data.Write(&chunkWriter{fail: true}); err != errWrite {
+		t.Errorf("Incorrect error %v", err)
+	}
+
+}
+
+func TestWriteStrict(t *testing.T) {
+
+	file := &DicomFile{}
+	file.appendDataElement(&DicomElement{Group: 0x0002, Element: 0x0010, Name: "TransferSyntaxUID", Vr: "UI", Value: Strings{explicit_vr_little_endian}})
+	file.appendDataElement(&DicomElement{Group: 0x0010, Element: 0x0020, Name: "PatientID", Vr: "LO", Value: Strings{"7DkT2Tp", "ANON"}})
+
+	if err := file.Write(new(bytes.Buffer)); err != nil {
+		t.Fatal(err)
+	}
+
+	var vmErr *VMError
+	err := file.WriteWithOptions(new(bytes.Buffer), WriteOptions{Strict: true})
+	if !errors.As(err, &vmErr) || vmErr.Element != 0x0020 {
+		t.Errorf("Expected a *VMError for PatientID, got %v", err)
+	}
+	err = file.WriteDataSetWithOptions(new(bytes.Buffer), ExplicitVRLittleEndian, WriteOptions{Strict: true})
+	if !errors.As(err, &vmErr) {
+		t.Errorf("Expected a *VMError, got %v", err)
+	}
+
+	file.Elements[1].Value = Strings{"7DkT2Tp"}
+	if err := file.WriteWithOptions(new(bytes.Buffer), WriteOptions{Strict: true}); err != nil {
+		t.Errorf("Valid file not written: %v", err)
+	}
+
+}
+
+func TestWriteStrictUID(t *testing.T) {
+
+	file := &DicomFile{}
+	file.appendDataElement(&DicomElement{Group: 0x0002, Element: 0x0010, Name: "TransferSyntaxUID", Vr: "UI", Value: Strings{explicit_vr_little_endian}})
+	file.appendDataElement(&DicomElement{Group: 0x0020, Element: 0x000D, Name: "StudyInstanceUID", Vr: "UI", Value: Strings{"1.2.03"}})
+
+	var conformanceErr *ConformanceError
+	err := file.WriteWithOptions(new(bytes.Buffer), WriteOptions{Strict: true})
+	if !errors.Is(err, ErrInvalidUID) || !errors.As(err, &conformanceErr) || conformanceErr.Tag != TagStudyInstanceUID {
+		t.Errorf("Expected ErrInvalidUID for StudyInstanceUID, got %v", err)
+	}
+
+	file.Elements[1].Value = Strings{"1.2.3"}
+	if err := file.WriteWithOptions(new(bytes.Buffer), WriteOptions{Strict: true}); err != nil {
+		t.Errorf("Valid UID not written: %v", err)
+	}
+
+}
+
+func TestWriteStrictValue(t *testing.T) {
+
+	file := &DicomFile{}
+	file.appendDataElement(&DicomElement{Group: 0x0002, Element: 0x0010, Name: "TransferSyntaxUID", Vr: "UI", Value: Strings{explicit_vr_little_endian}})
+	file.appendDataElement(&DicomElement{Group: 0x0008, Element: 0x0050, Name: "AccessionNumber", Vr: "SH", Value: Strings{strings.Repeat("1", 35)}})
+
+	var conformanceErr *ConformanceError
+	err := file.WriteWithOptions(new(bytes.Buffer), WriteOptions{Strict: true})
+	if !errors.Is(err, ErrValueLongerThanVR) || !errors.As(err, &conformanceErr) || conformanceErr.Tag != (Tag{0x0008, 0x0050}) {
+		t.Errorf("Expected ErrValueLongerThanVR for AccessionNumber, got %v", err)
+	}
+
+	file.Elements[1].Value = Strings{"1"}
+	file.appendDataElement(&DicomElement{Group: 0x0008, Element: 0x0060, Name: "Modality", Vr: "CS", Value: Strings{"ct"}})
+	if err := file.WriteWithOptions(new(bytes.Buffer), WriteOptions{Strict: true}); err == nil {
+		t.Errorf("Lowercase CS written")
+	}
+
+	file.Elements[2].Value = Strings{"CT"}
+	if err := file.WriteWithOptions(new(bytes.Buffer), WriteOptions{Strict: true}); err != nil {
+		t.Errorf("Valid file not written: %v", err)
+	}
+
+}
@@ -0,0 +1,343 @@
+package dicom
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"sort"
+)
+
+var ErrNoTile = errors.New("No tile at this position")
+
+// Size of the first read of the header of a slide level, doubled until the
+// header fits in at most maxSlideHeader bytes
+var slideHeaderChunk = 1 << 20
+
+const maxSlideHeader = 1 << 28
+
+// A level of a Whole Slide Microscopy pyramid (PS 3.3 C.8.12.4): an image of
+// Width x Height pixels, the Total Pixel Matrix, stored as tiles of
+// TileWidth x TileHeight pixels in the frames of its pixel data. Only the
+// header and the positions of the frames are read when opening a level, and
+// tiles are read from the file as they are requested.
+type SlideLevel struct {
+	Header                *DicomFile // the elements before the pixel data
+	Width, Height         int
+	TileWidth, TileHeight int
+
+	r      io.ReaderAt
+	attrs  *imageAttrs
+	ts     string
+	bo     binary.ByteOrder
+	native bool
+	frames [][]extent // of the fragments of each frame
+	tiles  map[image.Point]int
+}
+
+// The position and length of a part of a file
+type extent struct {
+	offset int64
+	length int64
+}
+
+// Open a level of a pyramid stored in r, a file of size bytes. The first
+// focal plane and optical path of TILED_FULL levels are mapped to tiles, and
+// the first frame found at each position of TILED_SPARSE levels.
+func OpenSlideLevel(r io.ReaderAt, size int64) (*SlideLevel, error) {
+
+	p, err := NewParser(HeaderOnly())
+	if err != nil {
+		return nil, err
+	}
+
+	// parse larger and larger chunks, up to the pixel data
+	var header *DicomFile
+	var start int64
+	for chunk := int64(slideHeaderChunk); ; chunk *= 2 {
+		if chunk > size {
+			chunk = size
+		}
+		buff := make([]byte, chunk)
+		if _, err := r.ReadAt(buff, 0); err != nil && err != io.EOF {
+			return nil, err
+		}
+		header = &DicomFile{}
+		n, err := p.parse(buff, header, ignoreElement)
+		// a chunk cut in the middle of the header is a broken file
+		switch {
+		case err == nil && int64(n) < chunk:
+			start = int64(n)
+		case chunk == size && err == nil:
+			return nil, fmt.Errorf("%w: no pixel data", ErrUnsupportedImage)
+		case chunk == size || chunk >= maxSlideHeader || err != nil && !errors.Is(err, ErrBrokenFile):
+			return nil, err
+		default:
+			continue
+		}
+		break
+	}
+
+	level := &SlideLevel{Header: header, r: r, tiles: map[image.Point]int{}}
+	if level.attrs, err = header.imageAttrs(); err != nil {
+		return nil, err
+	}
+	if level.ts, err = header.lookupString(TagTransferSyntaxUID); err != nil {
+		return nil, err
+	}
+	bo, implicit, err := header.getTransferSyntax()
+	if err != nil {
+		return nil, err
+	}
+	level.bo = bo
+	level.TileWidth, level.TileHeight = level.attrs.columns, level.attrs.rows
+
+	for _, attr := range []struct {
+		tag Tag
+		dst *int
+	}{
+		{TagTotalPixelMatrixColumns, &level.Width},
+		{TagTotalPixelMatrixRows, &level.Height},
+	} {
+		elem, err := header.LookupElementByTag(attr.tag)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, attr.tag)
+		}
+		n, err := elem.GetUInt32()
+		if err != nil {
+			return nil, err
+		}
+		*attr.dst = int(n)
+	}
+
+	if err := level.readFrames(start, size, implicit); err != nil {
+		return nil, err
+	}
+	if err := level.mapTiles(); err != nil {
+		return nil, err
+	}
+
+	return level, nil
+}
+
+// The number of columns and rows of tiles
+func (level *SlideLevel) Grid() (int, int) {
+	return (level.Width + level.TileWidth - 1) / level.TileWidth, (level.Height + level.TileHeight - 1) / level.TileHeight
+}
+
+// Read the encoded frame of the tile at column x and row y of the grid, as
+// stored in the pixel data. Returns ErrNoTile for the positions without
+// tile of TILED_SPARSE levels.
+func (level *SlideLevel) TileData(x, y int) ([]byte, error) {
+
+	frame, ok := level.tiles[image.Point{x, y}]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d,%d", ErrNoTile, x, y)
+	}
+
+	var data []byte
+	for _, e := range level.frames[frame] {
+		buff := make([]byte, e.length)
+		if _, err := level.r.ReadAt(buff, e.offset); err != nil {
+			return nil, err
+		}
+		data = append(data, buff...)
+	}
+	return data, nil
+}
+
+// Read and decode the tile at column x and row y of the grid, like Images
+// decodes frames
+func (level *SlideLevel) Tile(x, y int) (image.Image, error) {
+
+	data, err := level.TileData(x, y)
+	if err != nil {
+		return nil, err
+	}
+	if !level.native {
+		return level.attrs.decodeFrame(data, level.ts)
+	}
+
+	attrs := *level.attrs
+	attrs.frames = 1
+	images, err := attrs.decodeNative(data, level.bo)
+	if err != nil {
+		return nil, err
+	}
+	return images[0], nil
+}
+
+// Locate the frames of the pixel data starting at offset start: every frame
+// of native pixel data, or the fragments of encapsulated pixel data, whose
+// headers only are read
+func (level *SlideLevel) readFrames(start, size int64, implicit bool) error {
+
+	// tag, VR and reserved bytes in explicit VR, and length
+	head := make([]byte, 12)
+	if implicit {
+		head = head[:8]
+	}
+	if n, _ := level.r.ReadAt(head, start); n < len(head) {
+		return ErrBrokenFile
+	}
+	if tag := (Tag{level.bo.Uint16(head), level.bo.Uint16(head[2:])}); tag != TagPixelData {
+		return fmt.Errorf("%w: %s rather than PixelData", ErrUnsupportedImage, tag)
+	}
+	vl := level.bo.Uint32(head[len(head)-4:])
+	start += int64(len(head))
+
+	if vl != undefinedLength {
+		level.native = true
+		frameSize := int64(level.attrs.rows * level.attrs.columns * level.attrs.samples * level.attrs.bitsAllocated / 8)
+		if start+frameSize*int64(level.attrs.frames) > size {
+			return ErrPixelDataTruncated
+		}
+		for i := 0; i < level.attrs.frames; i++ {
+			level.frames = append(level.frames, []extent{{start + int64(i)*frameSize, frameSize}})
+		}
+		return nil
+	}
+
+	// item headers are always little endian
+	var offsets []uint32
+	var fragments []extent
+	for first := true; ; first = false {
+		if n, _ := level.r.ReadAt(head[:8], start); n < 8 {
+			return ErrPixelDataTruncated
+		}
+		tag := Tag{binary.LittleEndian.Uint16(head), binary.LittleEndian.Uint16(head[2:])}
+		length := int64(binary.LittleEndian.Uint32(head[4:]))
+		if tag == TagSequenceDelimitationItem {
+			break
+		}
+		if tag != TagItem || start+8+length > size {
+			return ErrBrokenFile
+		}
+		if first {
+			table := make([]byte, length)
+			if _, err := level.r.ReadAt(table, start+8); err != nil {
+				return err
+			}
+			for i := 0; i+4 <= len(table); i += 4 {
+				offsets = append(offsets, binary.LittleEndian.Uint32(table[i:]))
+			}
+		} else {
+			fragments = append(fragments, extent{start + 8, length})
+		}
+		start += 8 + length
+	}
+
+	// one fragment per frame, or frames starting at the offsets of the
+	// Basic Offset Table
+	if len(fragments) == level.attrs.frames {
+		for _, fragment := range fragments {
+			level.frames = append(level.frames, []extent{fragment})
+		}
+		return nil
+	}
+	if len(offsets) != level.attrs.frames || len(fragments) == 0 {
+		return fmt.Errorf("%w: %d fragments for %d frames", ErrUnsupportedImage, len(fragments), level.attrs.frames)
+	}
+	level.frames = make([][]extent, level.attrs.frames)
+	frame, first := -1, fragments[0].offset-8
+	for _, fragment := range fragments {
+		for frame+1 < len(offsets) && int64(offsets[frame+1]) <= fragment.offset-8-first {
+			frame++
+		}
+		if frame >= 0 {
+			level.frames[frame] = append(level.frames[frame], fragment)
+		}
+	}
+	return nil
+}
+
+// Map the positions of the tiles to frames, with the Dimension Organization
+// Type
+func (level *SlideLevel) mapTiles() error {
+
+	organization, err := level.Header.lookupString(TagDimensionOrganizationType)
+	if err != nil {
+		return err
+	}
+	columns, rows := level.Grid()
+
+	if organization == "TILED_FULL" {
+		for frame := 0; frame < len(level.frames) && frame < columns*rows; frame++ {
+			level.tiles[image.Point{frame % columns, frame / columns}] = frame
+		}
+		return nil
+	}
+
+	elem, err := level.Header.LookupElementByTag(TagPerFrameFunctionalGroupsSequence)
+	if err != nil {
+		return fmt.Errorf("%w: %s without %s", ErrNotFound, organization, TagPerFrameFunctionalGroupsSequence)
+	}
+	frames, err := elem.GetSequence()
+	if err != nil {
+		return err
+	}
+	for frame, item := range frames {
+		if frame >= len(level.frames) {
+			break
+		}
+		position := item.lookup(TagPlanePositionSlideSequence)
+		if position == nil {
+			return fmt.Errorf("%w: frame %d without %s", ErrNotFound, frame+1, TagPlanePositionSlideSequence)
+		}
+		seq, err := position.GetSequence()
+		if err != nil || len(seq) == 0 {
+			return ErrBrokenFile
+		}
+		var xy [2]int32
+		for i, tag := range []Tag{TagColumnPositionInTotalImagePixelMatrix, TagRowPositionInTotalImagePixelMatrix} {
+			elem := seq[0].lookup(tag)
+			if elem == nil {
+				return fmt.Errorf("%w: frame %d without %s", ErrNotFound, frame+1, tag)
+			}
+			if xy[i], err = Get[int32](elem); err != nil {
+				return err
+			}
+		}
+		// positions are those of the top left pixel, from 1
+		tile := image.Point{int(xy[0]-1) / level.TileWidth, int(xy[1]-1) / level.TileHeight}
+		if _, ok := level.tiles[tile]; !ok {
+			level.tiles[tile] = frame
+		}
+	}
+
+	return nil
+}
+
+// A Whole Slide Microscopy pyramid: levels of the same slide, from the
+// largest to the smallest
+type Slide struct {
+	Levels []*SlideLevel
+}
+
+// Create the pyramid of the VOLUME levels, skipping the thumbnail, label and
+// overview images
+func NewSlide(levels ...*SlideLevel) *Slide {
+
+	slide := &Slide{}
+	for _, level := range levels {
+		if imageType, err := level.Header.LookupElementByTag(TagImageType); err == nil {
+			if values, err := imageType.GetStrings(); err == nil && len(values) > 2 && values[2] != "VOLUME" {
+				continue
+			}
+		}
+		slide.Levels = append(slide.Levels, level)
+	}
+	sort.SliceStable(slide.Levels, func(i, j int) bool { return slide.Levels[i].Width > slide.Levels[j].Width })
+
+	return slide
+}
+
+// Read and decode the tile at column x and row y of a level, 0 being the
+// largest
+func (slide *Slide) Tile(level, x, y int) (image.Image, error) {
+	if level < 0 || level >= len(slide.Levels) {
+		return nil, fmt.Errorf("%w: level %d of %d", ErrNoTile, level, len(slide.Levels))
+	}
+	return slide.Levels[level].Tile(x, y)
+}
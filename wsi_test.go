@@ -0,0 +1,163 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"io/ioutil"
+	"testing"
+)
+
+// Write a slide level with the elements of attrs, and open it
+func slideLevel(t *testing.T, attrs []itemValue) *SlideLevel {
+
+	p, _ := NewParser()
+	file := &DicomFile{}
+	for _, attr := range attrs {
+		elem, err := p.NewElement(attr.tag, attr.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.insertElement(elem)
+	}
+	buffer := new(bytes.Buffer)
+	if err := file.Write(buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	level, err := OpenSlideLevel(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return level
+}
+
+func TestSlideLevelTiledFull(t *testing.T) {
+
+	// read the header in several chunks
+	defer func(chunk int) { slideHeaderChunk = chunk }(slideHeaderChunk)
+	slideHeaderChunk = 160
+
+	// 3x2 tiles of 2x2 pixels, valued by tile
+	pixels := make(Bytes, 0, 24)
+	for tile := 0; tile < 6; tile++ {
+		pixels = append(pixels, byte(tile), byte(tile), byte(tile), 10)
+	}
+
+	level := slideLevel(t, []itemValue{
+		{TagFileMetaInformationVersion, Bytes{0x00, 0x01}},
+		{TagTransferSyntaxUID, Strings{explicit_vr_little_endian}},
+		{TagSOPClassUID, Strings{VLWholeSlideMicroscopyImageStorage}},
+		{TagImageType, Strings{"ORIGINAL", "PRIMARY", "VOLUME", "NONE"}},
+		{TagDimensionOrganizationType, Strings{"TILED_FULL"}},
+		{TagSamplesPerPixel, UInt16s{1}},
+		{TagPhotometricInterpretation, Strings{"MONOCHROME2"}},
+		{TagNumberOfFrames, Strings{"6"}},
+		{TagRows, UInt16s{2}},
+		{TagColumns, UInt16s{2}},
+		{TagBitsAllocated, UInt16s{8}},
+		{TagBitsStored, UInt16s{8}},
+		{TagPixelRepresentation, UInt16s{0}},
+		{TagTotalPixelMatrixColumns, UInt32s{5}},
+		{TagTotalPixelMatrixRows, UInt32s{4}},
+		{TagPixelData, pixels},
+	})
+
+	if columns, rows := level.Grid(); columns != 3 || rows != 2 {
+		t.Fatalf("Expected 3x2 tiles, got %dx%d", columns, rows)
+	}
+
+	data, err := level.TileData(2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, []byte{5, 5, 5, 10}) {
+		t.Errorf("Incorrect tile data %v", data)
+	}
+	img, err := level.Tile(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gray := img.(*image.Gray); gray.Bounds().Dx() != 2 || gray.Pix[0] != gray.Pix[2] || gray.Pix[0] >= gray.Pix[3] {
+		t.Errorf("Incorrect tile %v", gray.Pix)
+	}
+	if _, err := level.TileData(3, 0); !errors.Is(err, ErrNoTile) {
+		t.Errorf("Expected ErrNoTile, got %v", err)
+	}
+}
+
+func TestSlideTiledSparse(t *testing.T) {
+
+	buff, err := ioutil.ReadFile("examples/I_000000.dcm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, _ := NewParser()
+	source, err := p.Parse(buff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	elem, _ := source.LookupElementByTag(TagPixelData)
+	fragments := elem.Value.(*PixelData).Fragments[:3]
+
+	// 3 of the 4 tiles of 512x512 pixels
+	var frames Sequence
+	for _, position := range [][2]int32{{1, 1}, {513, 1}, {1, 513}} {
+		plane, err := newLevelItem([]itemValue{
+			{TagColumnPositionInTotalImagePixelMatrix, Int32s{position[0]}},
+			{TagRowPositionInTotalImagePixelMatrix, Int32s{position[1]}},
+		}, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		item, err := newItem([]itemValue{{TagPlanePositionSlideSequence, Sequence{plane}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		frames = append(frames, item)
+	}
+
+	attrs := []itemValue{
+		{TagFileMetaInformationVersion, Bytes{0x00, 0x01}},
+		{TagTransferSyntaxUID, Strings{jpegBaseline}},
+		{TagSOPClassUID, Strings{VLWholeSlideMicroscopyImageStorage}},
+		{TagDimensionOrganizationType, Strings{"TILED_SPARSE"}},
+		{TagSamplesPerPixel, UInt16s{1}},
+		{TagPhotometricInterpretation, Strings{"MONOCHROME2"}},
+		{TagNumberOfFrames, Strings{"3"}},
+		{TagRows, UInt16s{512}},
+		{TagColumns, UInt16s{512}},
+		{TagBitsAllocated, UInt16s{8}},
+		{TagBitsStored, UInt16s{8}},
+		{TagPixelRepresentation, UInt16s{0}},
+		{TagTotalPixelMatrixColumns, UInt32s{1024}},
+		{TagTotalPixelMatrixRows, UInt32s{1024}},
+		{TagPerFrameFunctionalGroupsSequence, frames},
+		{TagPixelData, &PixelData{Fragments: fragments}},
+	}
+	large := slideLevel(t, append(attrs, itemValue{TagImageType, Strings{"ORIGINAL", "PRIMARY", "VOLUME", "NONE"}}))
+	thumbnail := slideLevel(t, append(attrs, itemValue{TagImageType, Strings{"DERIVED", "PRIMARY", "THUMBNAIL", "RESAMPLED"}}))
+
+	slide := NewSlide(thumbnail, large)
+	if len(slide.Levels) != 1 || slide.Levels[0] != large {
+		t.Fatalf("Expected the VOLUME level only, got %d levels", len(slide.Levels))
+	}
+
+	data, err := large.TileData(1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, fragments[1]) {
+		t.Errorf("Expected the second frame")
+	}
+	img, err := slide.Tile(0, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Bounds().Dx() != 512 || img.Bounds().Dy() != 512 {
+		t.Errorf("Incorrect tile bounds %v", img.Bounds())
+	}
+	if _, err := slide.Tile(0, 1, 1); !errors.Is(err, ErrNoTile) {
+		t.Errorf("Expected ErrNoTile, got %v", err)
+	}
+}
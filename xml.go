@@ -0,0 +1,337 @@
+package dicom
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidXML = errors.New("Invalid Native DICOM Model XML")
+
+// The highest value number read, bounding the values allocated
+const maxXMLValues = 1 << 16
+
+// A data set in the Native DICOM Model of PS 3.19 A.1
+type xmlDataSet struct {
+	XMLName    xml.Name       `xml:"NativeDicomModel"`
+	Attributes []xmlAttribute `xml:"DicomAttribute"`
+}
+
+type xmlAttribute struct {
+	Tag            string          `xml:"tag,attr"`
+	VR             string          `xml:"vr,attr"`
+	Keyword        string          `xml:"keyword,attr,omitempty"`
+	PrivateCreator string          `xml:"privateCreator,attr,omitempty"`
+	Values         []xmlValue      `xml:"Value"`
+	PersonNames    []xmlPersonName `xml:"PersonName"`
+	Items          []xmlItem       `xml:"Item"`
+	InlineBinary   string          `xml:"InlineBinary,omitempty"`
+	BulkData       *xmlBulkData    `xml:"BulkData"`
+}
+
+type xmlValue struct {
+	Number int    `xml:"number,attr"`
+	Value  string `xml:",chardata"`
+}
+
+type xmlItem struct {
+	Number     int            `xml:"number,attr"`
+	Attributes []xmlAttribute `xml:"DicomAttribute"`
+}
+
+type xmlPersonName struct {
+	Number      int           `xml:"number,attr"`
+	Alphabetic  *xmlNameGroup `xml:"Alphabetic"`
+	Ideographic *xmlNameGroup `xml:"Ideographic"`
+	Phonetic    *xmlNameGroup `xml:"Phonetic"`
+}
+
+// A component group of a person name. The components are pointers so that
+// empty components before the last one present, which a value such as
+// "Family^Given^^^" holds, are written as empty elements and read back.
+type xmlNameGroup struct {
+	FamilyName *string `xml:"FamilyName"`
+	GivenName  *string `xml:"GivenName"`
+	MiddleName *string `xml:"MiddleName"`
+	NamePrefix *string `xml:"NamePrefix"`
+	NameSuffix *string `xml:"NameSuffix"`
+}
+
+type xmlBulkData struct {
+	URI string `xml:"uri,attr"`
+}
+
+// Write the data set of the file in the Native DICOM Model of PS 3.19 A.1.
+// Binary values are written inline. Group lengths are left out.
+func (file *DicomFile) WriteXML(w io.Writer) error {
+
+	if err := file.parseSequences(); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	attrs, err := xmlAttributes(file.topLevel())
+	if err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(xmlDataSet{Attributes: attrs}); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// The attributes of a data set, with the private creators of private
+// elements
+func xmlAttributes(elems []*DicomElement) ([]xmlAttribute, error) {
+
+	creators := map[Tag]string{}
+	for _, elem := range elems {
+		if elem.Group%2 == 1 && elem.Element >= 0x0010 && elem.Element <= 0x00FF {
+			if s, err := elem.GetString(); err == nil {
+				creators[elem.Tag()] = strings.TrimSpace(s)
+			}
+		}
+	}
+
+	var attrs []xmlAttribute
+	for _, elem := range elems {
+		if elem.Element == 0x0000 || isDelimiter(elem) {
+			continue
+		}
+
+		vr := writtenVR(elem)
+		attr := xmlAttribute{Tag: fmt.Sprintf("%04X%04X", elem.Group, elem.Element), VR: vr}
+		if elem.Group%2 == 1 {
+			attr.PrivateCreator = creators[Tag{elem.Group, elem.Element >> 8}]
+		} else if elem.Name != unknown_group_name {
+			attr.Keyword = elem.Name
+		}
+
+		switch v := elem.value().(type) {
+		case nil:
+		case Sequence:
+			for i, item := range v {
+				itemAttrs, err := xmlAttributes(item.Elements)
+				if err != nil {
+					return nil, err
+				}
+				attr.Items = append(attr.Items, xmlItem{i + 1, itemAttrs})
+			}
+		case Strings:
+			for i, s := range v {
+				if vr == "PN" {
+					attr.PersonNames = append(attr.PersonNames, xmlPersonNameOf(i+1, s))
+				} else {
+					attr.Values = append(attr.Values, xmlValue{i + 1, s})
+				}
+			}
+		case Tags:
+			for i, t := range v {
+				attr.Values = append(attr.Values, xmlValue{i + 1, fmt.Sprintf("%04X%04X", t.Group, t.Element)})
+			}
+		default:
+			if isBinaryVR(vr) {
+				b, err := encodeBinary(elem, vr)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", elem.Tag(), err)
+				}
+				attr.InlineBinary = base64.StdEncoding.EncodeToString(b)
+				break
+			}
+			for i, n := range sliceValues(elem.Value) {
+				attr.Values = append(attr.Values, xmlValue{i + 1, fmt.Sprint(n)})
+			}
+		}
+		attrs = append(attrs, attr)
+	}
+
+	return attrs, nil
+}
+
+// A person name, its component groups split into components, empty ones
+// included
+func xmlPersonNameOf(number int, s string) xmlPersonName {
+
+	name := xmlPersonName{Number: number}
+	if s == "" {
+		return name
+	}
+	groups := []**xmlNameGroup{&name.Alphabetic, &name.Ideographic, &name.Phonetic}
+	for i, group := range strings.SplitN(s, "=", 3) {
+		*groups[i] = &xmlNameGroup{}
+		if group == "" {
+			continue
+		}
+		components := (*groups[i]).components()
+		for j, component := range strings.SplitN(group, "^", 5) {
+			component := component
+			*components[j] = &component
+		}
+	}
+
+	return name
+}
+
+// The components of a component group, in order
+func (group *xmlNameGroup) components() []**string {
+	return []**string{&group.FamilyName, &group.GivenName, &group.MiddleName, &group.NamePrefix, &group.NameSuffix}
+}
+
+// The person name of its component groups, up to the last one present
+func (name *xmlPersonName) String() string {
+
+	groups := []*xmlNameGroup{name.Alphabetic, name.Ideographic, name.Phonetic}
+	for len(groups) > 0 && groups[len(groups)-1] == nil {
+		groups = groups[:len(groups)-1]
+	}
+
+	strs := make([]string, len(groups))
+	for i, group := range groups {
+		if group == nil {
+			continue
+		}
+		var components []string
+		for j, component := range group.components() {
+			if *component != nil {
+				for len(components) < j {
+					components = append(components, "")
+				}
+				components = append(components, **component)
+			}
+		}
+		strs[i] = strings.Join(components, "^")
+	}
+
+	return strings.Join(strs, "=")
+}
+
+// Read a data set in the Native DICOM Model of PS 3.19 A.1. The values of
+// the attributes given by BulkData references are left empty, with a
+// warning wrapping ErrBulkData naming their URI. Encapsulated pixel data is
+// rebuilt from its inline binary as *PixelData.
+func ReadXML(r io.Reader) (*DicomFile, error) {
+
+	var ds xmlDataSet
+	if err := xml.NewDecoder(r).Decode(&ds); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidXML, err)
+	}
+
+	file := &DicomFile{}
+	elems, err := xmlElements(ds.Attributes, 0, &file.Warnings)
+	if err != nil {
+		return nil, err
+	}
+	file.Elements = make([]DicomElement, len(elems))
+	for i, elem := range elems {
+		file.Elements[i] = *elem
+	}
+	if err := file.decodeFragments(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidXML, err)
+	}
+
+	return file, nil
+}
+
+// The elements of the attributes of a data set at level, sorted by tag
+func xmlElements(attrs []xmlAttribute, level uint8, warnings *[]Warning) ([]*DicomElement, error) {
+
+	elems := make([]*DicomElement, 0, len(attrs))
+	for _, attr := range attrs {
+		t, err := strconv.ParseUint(attr.Tag, 16, 32)
+		if err != nil || len(attr.Tag) != 8 {
+			return nil, fmt.Errorf("%w: attribute %q", ErrInvalidXML, attr.Tag)
+		}
+		tag := Tag{uint16(t >> 16), uint16(t)}
+		elem := &DicomElement{Group: tag.Group, Element: tag.Element, Name: elementName(tag), Vr: attr.VR, IndentLevel: level}
+
+		if elem.Value, err = attr.value(level, warnings); err != nil {
+			return nil, fmt.Errorf("%s: %w", tag, err)
+		}
+		if attr.BulkData != nil {
+			*warnings = append(*warnings, Warning{Tag: tag, Err: fmt.Errorf("%w: %s", ErrBulkData, attr.BulkData.URI)})
+		}
+		elems = append(elems, elem)
+	}
+
+	sort.Slice(elems, func(i, j int) bool {
+		return tagLess(elems[i].Tag(), elems[j].Tag())
+	})
+
+	return elems, nil
+}
+
+// The value of an attribute of an element at level
+func (attr *xmlAttribute) value(level uint8, warnings *[]Warning) (Value, error) {
+
+	if attr.InlineBinary != "" {
+		b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(attr.InlineBinary))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidXML, err)
+		}
+		return decodeValue(attr.VR, b), nil
+	}
+
+	switch attr.VR {
+	case "SQ":
+		sort.SliceStable(attr.Items, func(i, j int) bool { return attr.Items[i].Number < attr.Items[j].Number })
+		seq := make(Sequence, len(attr.Items))
+		for i, item := range attr.Items {
+			elems, err := xmlElements(item.Attributes, level+1, warnings)
+			if err != nil {
+				return nil, err
+			}
+			seq[i] = &Item{Elements: elems}
+		}
+		return seq, nil
+	case "PN":
+		values := map[int]string{}
+		for i := range attr.PersonNames {
+			values[attr.PersonNames[i].Number] = attr.PersonNames[i].String()
+		}
+		return numberedValues(values)
+	}
+
+	values := map[int]string{}
+	for _, v := range attr.Values {
+		values[v.Number] = v.Value
+	}
+	strs, err := numberedValues(values)
+	if err != nil {
+		return nil, err
+	}
+	value, err := valueFromStrings(attr.VR, strs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidXML, err)
+	}
+	return value, nil
+}
+
+// The values of an attribute by number, from 1, those missing being empty
+func numberedValues(values map[int]string) (Strings, error) {
+
+	count := 0
+	for number := range values {
+		if number < 1 || number > maxXMLValues {
+			return nil, fmt.Errorf("%w: value number %d", ErrInvalidXML, number)
+		}
+		if number > count {
+			count = number
+		}
+	}
+
+	strs := make(Strings, count)
+	for number, s := range values {
+		strs[number-1] = s
+	}
+	return strs, nil
+}
@@ -0,0 +1,153 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestXMLRoundTrip(t *testing.T) {
+
+	p, _ := NewParser()
+	file, err := p.Parse(readFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buffer := new(bytes.Buffer)
+	if err := file.WriteXML(buffer); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buffer.String(), `<DicomAttribute tag="00100010" vr="PN" keyword="PatientName">`) {
+		t.Errorf("PatientName not written")
+	}
+
+	decoded, err := ReadXML(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := EqualOptions{IgnoreTags: []Tag{TagFileMetaInformationGroupLength}}
+	if diffs := Diff(file, decoded, opts); len(diffs) != 0 {
+		t.Errorf("Incorrect data set decoded %v", diffs)
+	}
+
+	elem, err := decoded.LookupElementByTag(TagPixelData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := elem.Value.(*PixelData); !ok {
+		t.Errorf("Expected *PixelData, got %T", elem.Value)
+	}
+	if data, err := decoded.ImageData(); err != nil || !data.Encapsulated || len(data.Frames) != 1 {
+		t.Errorf("Incorrect image data %v", err)
+	}
+}
+
+func TestWriteXMLInvalidBinary(t *testing.T) {
+
+	file := &DicomFile{}
+	file.appendDataElement(&DicomElement{Group: 0x0009, Element: 0x1001, Vr: "OB", Value: unknownValue{}})
+
+	if err := file.WriteXML(new(bytes.Buffer)); !errors.Is(err, ErrWrongValueType) {
+		t.Errorf("Expected ErrWrongValueType, got %v", err)
+	}
+
+}
+
+func TestReadXML(t *testing.T) {
+
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+<NativeDicomModel xml:space="preserve">
+  <DicomAttribute tag="00100010" vr="PN" keyword="PatientName">
+    <PersonName number="1">
+      <Alphabetic><FamilyName>Yamada</FamilyName><GivenName>Tarou</GivenName></Alphabetic>
+      <Ideographic><FamilyName>山田</FamilyName><GivenName>太郎</GivenName></Ideographic>
+    </PersonName>
+  </DicomAttribute>
+  <DicomAttribute tag="00080008" vr="CS" keyword="ImageType">
+    <Value number="1">ORIGINAL</Value>
+    <Value number="3">AXIAL</Value>
+  </DicomAttribute>
+  <DicomAttribute tag="00081140" vr="SQ" keyword="ReferencedImageSequence">
+    <Item number="1">
+      <DicomAttribute tag="00081155" vr="UI" keyword="ReferencedSOPInstanceUID"><Value number="1">1.2.3</Value></DicomAttribute>
+    </Item>
+  </DicomAttribute>
+  <DicomAttribute tag="00280010" vr="US" keyword="Rows"><Value number="1">512</Value></DicomAttribute>
+  <DicomAttribute tag="00090010" vr="LO"><Value number="1">ACME</Value></DicomAttribute>
+  <DicomAttribute tag="00091001" vr="UN" privateCreator="ACME"><InlineBinary>AQI=</InlineBinary></DicomAttribute>
+  <DicomAttribute tag="7FE00010" vr="OW" keyword="PixelData"><BulkData uri="http://pacs/bulk/1"/></DicomAttribute>
+</NativeDicomModel>`
+
+	file, err := ReadXML(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for tag, expected := range map[Tag]string{
+		TagPatientName: "[Yamada^Tarou=山田^太郎]",
+		TagImageType:   "[ORIGINAL  AXIAL]",
+		TagRows:        "[512]",
+	} {
+		elem, err := file.LookupElementByTag(tag)
+		if err != nil {
+			t.Errorf("%s: %v", tag, err)
+			continue
+		}
+		if s := fmt.Sprint(elem.Value); s != expected {
+			t.Errorf("Expected %s %s, got %s", tag, expected, s)
+		}
+	}
+	if item := file.sequence(TagReferencedImageSequence); len(item) != 1 || item[0].Elements[0].IndentLevel != 1 {
+		t.Errorf("Incorrect sequence %v", item)
+	}
+	if len(file.Warnings) != 1 || !errors.Is(&file.Warnings[0], ErrBulkData) {
+		t.Errorf("Incorrect warnings %v", file.Warnings)
+	}
+
+	// written back with the private creator
+	buffer := new(bytes.Buffer)
+	if err := file.WriteXML(buffer); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buffer.String(), `<DicomAttribute tag="00091001" vr="UN" privateCreator="ACME">`) {
+		t.Errorf("Private creator not written")
+	}
+
+	for _, invalid := range []string{
+		`<NativeDicomModel><DicomAttribute tag="0010" vr="LO"/></NativeDicomModel>`,
+		`<NativeDicomModel><DicomAttribute tag="00280010" vr="US"><Value number="1">-1</Value></DicomAttribute></NativeDicomModel>`,
+		`<NativeDicomModel><DicomAttribute tag="00280010" vr="US"><Value number="0">1</Value></DicomAttribute></NativeDicomModel>`,
+		`<DicomAttribute tag="00280010" vr="US"/>`,
+	} {
+		if _, err := ReadXML(strings.NewReader(invalid)); !errors.Is(err, ErrInvalidXML) {
+			t.Errorf("%s: expected ErrInvalidXML, got %v", invalid, err)
+		}
+	}
+}
+
+func TestXMLPersonName(t *testing.T) {
+
+	for _, name := range []string{"", "Stressecho^Example^^^", "Yamada^Tarou=山田^太郎=", "^Tarou", "=山田", "A^B^C^D^E=F"} {
+		xmlName := xmlPersonNameOf(1, name)
+		if s := xmlName.String(); s != name {
+			t.Errorf("Expected %q, got %q", name, s)
+		}
+	}
+
+	file := &DicomFile{}
+	file.Elements = append(file.Elements, DicomElement{Group: TagPatientName.Group, Element: TagPatientName.Element, Vr: "PN", Value: Strings{"Stressecho^Example^^^"}})
+	buffer := new(bytes.Buffer)
+	if err := file.WriteXML(buffer); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := ReadXML(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, err := decoded.LookupElementByTag(TagPatientName); err != nil || fmt.Sprint(s.Value) != "[Stressecho^Example^^^]" {
+		t.Errorf("Incorrect patient name %v, %v", s, err)
+	}
+}